@@ -17,6 +17,11 @@ func MakeConfig(c *def.App) (out *nine.Config) {
 		LogDir:                   C.Str("app", "logdir"),
 		LogLevel:                 C.Str("log", "level"),
 		Subsystems:               C.Map("log", "subsystem"),
+		LogNoWrite:               C.Bool("log", "nowrite"),
+		LogFormat:                C.Str("log", "format"),
+		LogFiles:                 C.Tags("log", "file"),
+		LogMaxSize:               C.Int("log", "maxsize"),
+		LogMaxAge:                C.Duration("log", "maxage"),
 		Network:                  C.Str("p2p", "network"),
 		AddPeers:                 C.Tags("p2p", "addpeer"),
 		ConnectPeers:             C.Tags("p2p", "connect"),
@@ -73,6 +78,12 @@ func MakeConfig(c *def.App) (out *nine.Config) {
 		MiningAddrs:              C.Tags("mining", "addresses"),
 		MinerListener:            C.Str("mining", "listener"),
 		MinerPass:                C.Str("mining", "pass"),
+		MinerController:          C.Str("mining", "controller"),
+		MinerSwitch:              C.Duration("mining", "switch"),
+		StratumListeners:         C.Tags("stratum", "listen"),
+		StratumDifficulty:        C.Tags("stratum", "difficulty"),
+		StratumShareTime:         C.Duration("stratum", "sharetime"),
+		StratumPass:              C.Str("stratum", "pass"),
 		BlockMinSize:             C.Int("block", "minsize"),
 		BlockMaxSize:             C.Int("block", "maxsize"),
 		BlockMinWeight:           C.Int("block", "minweight"),
@@ -82,6 +93,7 @@ func MakeConfig(c *def.App) (out *nine.Config) {
 		NoPeerBloomFilters:       C.Bool("p2p", "nobloomfilters"),
 		NoCFilters:               C.Bool("p2p", "nocfilters"),
 		SigCacheMaxSize:          C.Int("chain", "sigcachemaxsize"),
+		PowWorkers:               C.Int("chain", "powworkers"),
 		BlocksOnly:               C.Bool("p2p", "blocksonly"),
 		TxIndex:                  C.Bool("chain", "txindex"),
 		AddrIndex:                C.Bool("chain", "addrindex"),
@@ -95,10 +107,15 @@ func MakeConfig(c *def.App) (out *nine.Config) {
 		CAFile:                   C.Str("tls", "cafile"),
 		OneTimeTLSKey:            C.Bool("tls", "onetime"),
 		ServerTLS:                C.Bool("tls", "server"),
+		ClientAuth:               C.Bool("tls", "clientauth"),
+		ClientCert:               C.Str("tls", "clientcert"),
+		ClientKey:                C.Str("tls", "clientkey"),
 		LegacyRPCListeners:       C.Tags("rpc", "listen"),
 		LegacyRPCMaxClients:      C.Int("rpc", "maxclients"),
 		LegacyRPCMaxWebsockets:   C.Int("rpc", "maxwebsockets"),
 		ExperimentalRPCListeners: &[]string{},
+		GUIListen:                C.Str("gui", "listen"),
+		GUINoBrowser:             C.Bool("gui", "nobrowser"),
 		State:                    node.StateCfg,
 	}
 	return
@@ -0,0 +1,96 @@
+package app
+import (
+	"fmt"
+	"strings"
+	"time"
+	"git.parallelcoin.io/dev/9/cmd/def"
+)
+// configUsage is printed when the arguments of config are not understood
+const configUsage = `usage: config get <group.name>
+       config set <group.name> <value>
+       config unset <group.name>
+       config diff
+       config validate`
+// configRow returns the row named group.name in ap
+func configRow(
+	ap *def.App, key string) (r *def.Row, err error) {
+	gn := strings.SplitN(key, ".", 2)
+	if len(gn) == 2 {
+		if r, ok := ap.Cats[gn[0]][gn[1]]; ok {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("no configuration row %s", key)
+}
+// formatValue prints a value of a row the way it is given to config set and the commandline overrides, lists are separated by commas
+func formatValue(
+	v interface{}) string {
+	switch V := v.(type) {
+	case nil:
+		return ""
+	case []string:
+		return strings.Join(V, ",")
+	case time.Duration:
+		return V.String()
+	default:
+		return fmt.Sprint(V)
+	}
+}
+// configGet prints the value of a row
+func configGet(
+	ap *def.App, key string) error {
+	r, err := configRow(ap, key)
+	if err != nil {
+		return err
+	}
+	fmt.Println(formatValue(r.Value.Get()))
+	return nil
+}
+// configSet puts a value into a row through its validator and saves the configuration
+func configSet(
+	ap *def.App, key, value string) error {
+	r, err := configRow(ap, key)
+	if err != nil {
+		return err
+	}
+	if !setOverride(r, value) {
+		return fmt.Errorf("invalid value '%s' for %s", value, key)
+	}
+	ap.SaveConfig()
+	return nil
+}
+// configUnset puts the default value back into a row and saves the configuration
+func configUnset(
+	ap *def.App, key string) error {
+	r, err := configRow(ap, key)
+	if err != nil {
+		return err
+	}
+	r.Value.Put(r.Default.Get())
+	ap.SaveConfig()
+	return nil
+}
+// configDiff prints the name, value and default separated by tabs of every row whose value is not the default
+func configDiff(
+	ap *def.App) {
+	for _, group := range ap.Cats.GetSortedKeys() {
+		cat := ap.Cats[group]
+		for _, name := range cat.GetSortedKeys() {
+			r := cat[name]
+			value, dflt := formatValue(r.Value.Get()), formatValue(r.Default.Get())
+			if value != dflt {
+				fmt.Printf("%s.%s\t%s\t%s\n", group, name, value, dflt)
+			}
+		}
+	}
+}
+// configValidate prints the problems found loading the configuration file and returns false if there are any
+func configValidate(
+	ap *def.App) bool {
+	fmt.Printf("schema version %d, loaded from version %d\n",
+		def.ConfigVersion(), ap.LoadedVersion)
+	for _, x := range ap.Problems {
+		fmt.Println(x)
+	}
+	return len(ap.Problems) == 0
+}
@@ -0,0 +1,150 @@
+package app
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"git.parallelcoin.io/dev/9/cmd/def"
+)
+// newConfigTestApp returns an App with a few rows that saves its configuration in datadir
+func newConfigTestApp(
+	datadir string) *def.App {
+	return NewApp("test",
+		Group("app",
+			Dir("datadir", Default(datadir)),
+		),
+		Group("rpc",
+			Tag("user", Default("user")),
+			Int("maxclients", Default(10)),
+		),
+	)
+}
+// TestConfigSchema ensures configuration files carry the version of the schema, are migrated when loaded, and that rows that don't exist and invalid values are reported instead of loaded.
+func TestConfigSchema(
+	t *testing.T) {
+	datadir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(datadir)
+	ap := newConfigTestApp(datadir)
+	j, err := json.Marshal(ap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(j), fmt.Sprintf(`"%s":%d`, def.VersionKey, def.ConfigVersion())) {
+		t.Fatalf("version missing from %s", j)
+	}
+	// A migration renaming a row is run on files of older versions only.
+	migrations := def.Migrations
+	defer func() { def.Migrations = migrations }()
+	def.Migrations = append(def.Migrations[:len(def.Migrations):len(def.Migrations)],
+		def.Migration{
+			Description: "rename rpc.name to rpc.user",
+			Migrate: func(cats def.CatsJSON) {
+				def.RenameRow(cats, "rpc", "name", "rpc", "user")
+			},
+		})
+	old := `{"rpc":{"name":{"value":"renamed"},"maxclients":{"value":"many"},"typo":{"value":1}},"nogroup":{}}`
+	if err = json.Unmarshal([]byte(old), ap); err != nil {
+		t.Fatal(err)
+	}
+	if ap.LoadedVersion != 0 {
+		t.Errorf("got loaded version %d, expected 0", ap.LoadedVersion)
+	}
+	if got := *ap.Cats.Str("rpc", "user"); got != "renamed" {
+		t.Errorf("got user %s, expected the renamed row", got)
+	}
+	if got := *ap.Cats.Int("rpc", "maxclients"); got != 10 {
+		t.Errorf("got maxclients %d, expected the invalid value to be skipped", got)
+	}
+	if len(ap.Problems) != 3 {
+		t.Errorf("got problems %v, expected 3", ap.Problems)
+	}
+	// The rename is not run again on a file of the current version.
+	current := fmt.Sprintf(`{"%s":%d,"rpc":{"user":{"value":"kept"}}}`,
+		def.VersionKey, def.ConfigVersion())
+	if err = json.Unmarshal([]byte(current), ap); err != nil {
+		t.Fatal(err)
+	}
+	if got := *ap.Cats.Str("rpc", "user"); got != "kept" || len(ap.Problems) != 0 {
+		t.Errorf("got user %s and problems %v", got, ap.Problems)
+	}
+	newer := fmt.Sprintf(`{"%s":%d}`, def.VersionKey, def.ConfigVersion()+1)
+	if err = json.Unmarshal([]byte(newer), ap); err == nil {
+		t.Error("a newer schema version was loaded")
+	}
+}
+// TestConfigMigrations ensures a configuration file written before the schema had a version, where mining.listener was a list, loads with the first address of the list.
+func TestConfigMigrations(
+	t *testing.T) {
+	ap := NewApp("test",
+		Group("mining",
+			Addr("listener", 11045),
+		),
+	)
+	conf, err := ioutil.ReadFile(filepath.Join("testdata", "baseline-config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(conf, ap); err != nil {
+		t.Fatal(err)
+	}
+	if got := *ap.Cats.Str("mining", "listener"); got != "127.0.0.1:11045" {
+		t.Errorf("got listener %s, expected the first of the list", got)
+	}
+	for _, x := range ap.Problems {
+		if strings.Contains(x, "mining.listener") {
+			t.Errorf("listener was not loaded: %s", x)
+		}
+	}
+}
+// TestConfigCommand ensures config set validates and stores values, unset restores defaults and values are formatted the way they are given.
+func TestConfigCommand(
+	t *testing.T) {
+	datadir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(datadir)
+	ap := newConfigTestApp(datadir)
+	if err := configSet(ap, "rpc.maxclients", "3"); err != nil {
+		t.Fatal(err)
+	}
+	if got := formatValue(ap.Cats["rpc"]["maxclients"].Value.Get()); got != "3" {
+		t.Errorf("got %s, expected 3", got)
+	}
+	if err := configSet(ap, "rpc.maxclients", "x"); err == nil {
+		t.Error("invalid value was set")
+	}
+	if err := configSet(ap, "rpc.nothing", "x"); err == nil {
+		t.Error("row that doesn't exist was set")
+	}
+	if err := configUnset(ap, "rpc.maxclients"); err != nil {
+		t.Fatal(err)
+	}
+	if got := *ap.Cats.Int("rpc", "maxclients"); got != 10 {
+		t.Errorf("got %d after unset, expected the default 10", got)
+	}
+	// The saved file loads into a new App.
+	if err := configSet(ap, "rpc.user", "saved"); err != nil {
+		t.Fatal(err)
+	}
+	conf, err := ioutil.ReadFile(filepath.Join(datadir, "config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := newConfigTestApp(datadir)
+	if err = json.Unmarshal(conf, loaded); err != nil {
+		t.Fatal(err)
+	}
+	if got := *loaded.Cats.Str("rpc", "user"); got != "saved" {
+		t.Errorf("got user %s from the saved file, expected saved", got)
+	}
+	if got := formatValue([]string{"a", "b"}); got != "a,b" {
+		t.Errorf("got list %s, expected a,b", got)
+	}
+}
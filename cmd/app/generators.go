@@ -81,7 +81,9 @@ func Group(name string, g ...def.CatGenerator) def.AppGenerator {
 func Cmd(name string, g ...def.CommandGenerator) def.AppGenerator {
 	G := def.CommandGenerators(g)
 	return func(ctx *def.App) {
-		ctx.Commands[name] = G.RunAll()
+		c := G.RunAll()
+		c.Name = name
+		ctx.Commands[name] = c
 	}
 }
 // def.Command Item Generators
@@ -125,6 +127,12 @@ func Handler(hnd func(args []string, tokens def.Tokens, app *def.App) int) def.C
 		ctx.Handler = hnd
 	}
 }
+// Rest makes the arguments after a def.Command its own, they are not matched against the other commands
+func Rest() def.CommandGenerator {
+	return func(ctx *def.Command) {
+		ctx.Rest = true
+	}
+}
 // Group Item Generators
 // File is an item storing a filename
 func File(name string, g ...def.RowGenerator) def.CatGenerator {
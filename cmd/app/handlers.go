@@ -1,17 +1,29 @@
 package app
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
+	"time"
 	"git.parallelcoin.io/dev/9/cmd/conf"
 	"git.parallelcoin.io/dev/9/cmd/ctl"
 	"git.parallelcoin.io/dev/9/cmd/def"
+	"git.parallelcoin.io/dev/9/cmd/gui"
 	"git.parallelcoin.io/dev/9/cmd/ll"
 	"git.parallelcoin.io/dev/9/cmd/nine"
 	"git.parallelcoin.io/dev/9/cmd/node"
+	"git.parallelcoin.io/dev/9/cmd/testnet"
+	"git.parallelcoin.io/dev/9/cmd/top"
 	"git.parallelcoin.io/dev/9/cmd/walletmain"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining/worker"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
 	"git.parallelcoin.io/dev/9/pkg/util"
 	"git.parallelcoin.io/dev/9/pkg/util/cl"
+	"git.parallelcoin.io/dev/9/pkg/util/interrupt"
+	"git.parallelcoin.io/dev/9/pkg/util/supervisor"
 )
 // Log is the logger for node
 var Log = cl.NewSubSystem("cmd/config", ll.DEFAULT)
@@ -66,6 +78,8 @@ func Help(args []string, tokens def.Tokens, ap *def.App) int {
 				ac.Short)
 			// }
 		}
+		fmt.Printf("any configuration row can be set for one run with %sgroup.name=value or the environment variable %s, lists are separated by commas\n\n",
+			FlagPrefix, EnvName("group", "name"))
 	} else {
 		// some number of other commands were mentioned
 		fmt.Println(
@@ -99,16 +113,6 @@ func Conf(args []string, tokens def.Tokens, ap *def.App) int {
 	}
 	return r
 }
-// // New ???
-// func New(args []string, tokens def.Tokens, ap *def.App) int {
-// 	fmt.Println("running New", args, getTokens(tokens))
-// 	return 0
-// }
-// // Copy duplicates a configuration to create new one(s) based on it
-// func Copy(args []string, tokens def.Tokens, ap *def.App) int {
-// 	fmt.Println("running Copy", args, getTokens(tokens))
-// 	return 0
-// }
 // List prints the available commands for ctl
 func List(args []string, tokens def.Tokens, ap *def.App) int {
 	if j := validateProxyListeners(ap); j != 0 {
@@ -142,11 +146,36 @@ func Ctl(args []string, tokens def.Tokens, ap *def.App) int {
 	ctl.Main(args[i:], ap.Config)
 	return 0
 }
-// Node launches the full node
+// Node launches the full node and runs it until it shuts down
 func Node(args []string, tokens def.Tokens, ap *def.App) int {
+	done := make(chan error, 1)
+	if startNode(ap, done) != 0 {
+		return 1
+	}
+	if err := <-done; err != nil {
+		log <- cl.Error{"node stopped:", err}
+		return 1
+	}
+	return 0
+}
+// startNode validates the configuration of the node and starts it, the result of the node is sent to done when it shuts down
+func startNode(ap *def.App, done chan<- error) int {
+	if prepareNode(ap) != 0 {
+		return 1
+	}
+	// run the node!
+	ap.Started = make(chan struct{})
+	go func() {
+		done <- node.Main(nil, ap.Started)
+	}()
+	return 0
+}
+// prepareNode validates the configuration of the node and hands it to the node
+func prepareNode(ap *def.App) int {
 	node.StateCfg = ap.Config.State
 	node.Cfg = ap.Config
 	cl.Register.SetAllLevels(*ap.Config.LogLevel)
+	configureLog(ap)
 	setAppDataDir(ap, "node")
 	_ = nine.ActiveNetParams //= activenetparams
 	if validateWhitelists(ap) != 0 ||
@@ -156,18 +185,20 @@ func Node(args []string, tokens def.Tokens, ap *def.App) int {
 		validateBlockLimits(ap) != 0 ||
 		validateUAComments(ap) != 0 ||
 		validateMiner(ap) != 0 ||
+		validateStratum(ap) != 0 ||
 		validateCheckpoints(ap) != 0 ||
 		validateAddresses(ap) != 0 ||
 		validateDialers(ap) != 0 {
 		return 1
 	}
-	// run the node!
-	ap.Started = make(chan struct{})
-	go node.Main(nil, ap.Started)
+	node.LoadConfig = func() (*nine.Config, []string, []string, error) {
+		return reloadConfig(ap, overrides)
+	}
 	return 0
 }
 // Wallet launches the wallet server
 func Wallet(args []string, tokens def.Tokens, ap *def.App) int {
+	configureLog(ap)
 	setAppDataDir(ap, "wallet")
 	netDir := walletmain.NetworkDir(*ap.Config.AppDataDir,
 		ap.Config.ActiveNetParams.Params)
@@ -187,7 +218,9 @@ func Wallet(args []string, tokens def.Tokens, ap *def.App) int {
 	return 0
 }
 // Shell runs a combined full node and wallet server for use in the common standard
-// configuration provided by many bitcoin and bitcoin fork servers
+// configuration provided by many bitcoin and bitcoin fork servers. The node, wallet
+// server and miners are run as services under a supervisor that restarts the wallet
+// and miners when they fail and stops them in order.
 func Shell(args []string, tokens def.Tokens, ap *def.App) int {
 	setAppDataDir(ap, "node")
 	netDir := walletmain.NetworkDir(
@@ -200,24 +233,94 @@ func Shell(args []string, tokens def.Tokens, ap *def.App) int {
 			ap.Config, ap.Config.ActiveNetParams, wdb); e != nil {
 			panic("could not create wallet " + e.Error())
 		}
-	} else {
-		Node(args, tokens, ap)
-		<-ap.Started
-		log <- cl.Info{"starting wallet server"}
-		if e := walletmain.Main(ap.Config, ap.Config.ActiveNetParams, netDir); e != nil {
+		return 0
+	}
+	if prepareNode(ap) != 0 {
+		return 1
+	}
+	sup := supervisor.New(shellServices(ap, netDir)...)
+	node.Supervised = true
+	node.ServiceStatus = func() []json.ServiceStatusResult {
+		return serviceStatus(sup.Status())
+	}
+	interrupt.AddHandler(sup.Stop)
+	if err := sup.Start(); err != nil {
+		log <- cl.Warn{err}
+	}
+	<-sup.Done()
+	for _, s := range sup.Status() {
+		if s.Name == "node" && s.State == supervisor.Failed {
 			return 1
 		}
 	}
 	return 0
 }
-// Test runs a testnet based on a set of configuration directories
-func Test(args []string, tokens def.Tokens, ap *def.App) int {
-	cl.Register.SetAllLevels(*ap.Config.LogLevel)
-	fmt.Println("running Test", args, getTokens(tokens))
+// Testnet creates the configurations of a local test network of nodes connected to each other, or runs all of its nodes
+func Testnet(args []string, tokens def.Tokens, ap *def.App) int {
+	// the datadir the configuration of the nodes is copied from can follow the arguments of testnet
+	var rest []string
+	for i, x := range args {
+		if ap.Commands["testnet"].RE.MatchString(x) {
+			rest = args[i+1:]
+			break
+		}
+	}
+	if dd, ok := tokens["datadir"]; ok && len(rest) > 2 && rest[len(rest)-1] == dd.Value {
+		rest = rest[:len(rest)-1]
+	}
+	var err error
+	switch {
+	case len(rest) == 3 && rest[0] == "create":
+		var n int
+		if n, err = strconv.Atoi(rest[2]); err == nil {
+			err = testnet.Create(ap, rest[1], n)
+		}
+	case len(rest) == 2 && rest[0] == "run":
+		err = testnet.Run(rest[1])
+	default:
+		fmt.Fprintln(os.Stderr, "usage: testnet create <basename> <integer> | testnet run <basename>")
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+// Config reads and changes single rows of the configuration file, lists the rows that differ from their defaults and checks the file for rows that don't exist and invalid values
+func Config(args []string, tokens def.Tokens, ap *def.App) int {
+	var rest []string
+	for i, x := range args {
+		if ap.Commands["config"].RE.MatchString(x) {
+			rest = args[i+1:]
+			break
+		}
+	}
+	var err error
+	switch {
+	case len(rest) == 2 && rest[0] == "get":
+		err = configGet(ap, rest[1])
+	case len(rest) == 3 && rest[0] == "set":
+		err = configSet(ap, rest[1], rest[2])
+	case len(rest) == 2 && rest[0] == "unset":
+		err = configUnset(ap, rest[1])
+	case len(rest) == 1 && rest[0] == "diff":
+		configDiff(ap)
+	case len(rest) == 1 && rest[0] == "validate":
+		if !configValidate(ap) {
+			return 1
+		}
+	default:
+		fmt.Fprintln(os.Stderr, configUsage)
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
 	return 0
 }
-// Create generates a set of configurations that are set to connect to each other
-// in a testnet
+// Create runs the prompt to create a new wallet in the data directory
 func Create(args []string, tokens def.Tokens, ap *def.App) int {
 	netDir := walletmain.NetworkDir(
 		filepath.Join(*ap.Config.DataDir, "wallet"),
@@ -234,25 +337,176 @@ func Create(args []string, tokens def.Tokens, ap *def.App) int {
 	}
 	return 0
 }
-// // TestHandler ???
-// func TestHandler(args []string, tokens def.Tokens, ap *def.App) int {
-// 	return 0
-// }
-// GUI runs a shell in the background and a GUI interface for wallet and node
+// GUI runs a shell and serves the GUI wallet, which talks to its wallet server over RPC, to the web browser
 func GUI(args []string, tokens def.Tokens, ap *def.App) int {
-	return 0
+	setAppDataDir(ap, "node")
+	netDir := walletmain.NetworkDir(
+		filepath.Join(*ap.Config.DataDir, "wallet"),
+		ap.Config.ActiveNetParams.Params)
+	if !util.FileExists(netDir) {
+		// the shell only creates the wallet when there is none yet
+		if r := Shell(args, tokens, ap); r != 0 {
+			return r
+		}
+	}
+	srv, err := gui.New(ap.Config, filepath.Join(*ap.Config.DataDir, "gui"))
+	if err != nil {
+		log <- cl.Error{"could not create the GUI:", err}
+		return 1
+	}
+	url, err := srv.Start(*ap.Config.GUIListen)
+	if err != nil {
+		log <- cl.Error{"could not start the GUI:", err}
+		return 1
+	}
+	defer srv.Stop()
+	fmt.Println("GUI wallet at", url)
+	if !*ap.Config.GUINoBrowser {
+		if err = gui.OpenBrowser(url); err != nil {
+			log <- cl.Warn{"could not open the web browser:", err}
+		}
+	}
+	return Shell(args, tokens, ap)
+}
+// Top shows the dashboard of a running node and wallet in the terminal
+func Top(args []string, tokens def.Tokens, ap *def.App) int {
+	// the log would write over the dashboard
+	cl.Register.SetAllLevels("off")
+	return top.Run(ap.Config)
 }
 // Mine runs the standalone miner
 func Mine(args []string, tokens def.Tokens, ap *def.App) int {
+	cl.Register.SetAllLevels(*ap.Config.LogLevel)
+	if ap.Config.MinerPass == nil || *ap.Config.MinerPass == "" {
+		fmt.Fprintln(os.Stderr,
+			"mining.pass must be set to the password of the mining dispatcher")
+		return 1
+	}
+	if ap.Config.MinerController == nil || *ap.Config.MinerController == "" {
+		fmt.Fprintln(os.Stderr,
+			"mining.controller must be set to the address of the mining dispatcher")
+		return 1
+	}
+	w := worker.New(&worker.Config{
+		Controller:  *ap.Config.MinerController,
+		Key:         fork.Argon2i([]byte(*ap.Config.MinerPass)),
+		Algo:        *ap.Config.Algo,
+		NumThreads:  *ap.Config.GenThreads,
+		Switch:      *ap.Config.MinerSwitch,
+		ChainParams: ap.Config.ActiveNetParams.Params,
+	})
+	if err := w.Start(); err != nil {
+		fmt.Fprintln(os.Stderr, "unable to start miner:", err)
+		return 1
+	}
+	interrupt.AddHandler(w.Stop)
+	<-interrupt.HandlersDone
 	return 0
 }
-// GenCerts generates TLS certificates
+// GenCerts generates TLS key pairs for the node and wallet RPC servers, with the addresses they are configured to listen and be reached on, and for RPC clients, all signed by the certification authority made by GenCA
 func GenCerts(args []string, tokens def.Tokens, ap *def.App) int {
+	caCert, caKey, err := readCA(ap)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "cannot open certification authority, run genca first:", err)
+		return 1
+	}
+	var nodeHosts, walletHosts []string
+	if ap.Config.RPCListeners != nil {
+		nodeHosts = append(nodeHosts, *ap.Config.RPCListeners...)
+	}
+	if ap.Config.RPCConnect != nil {
+		nodeHosts = append(nodeHosts, *ap.Config.RPCConnect)
+	}
+	if ap.Config.LegacyRPCListeners != nil {
+		walletHosts = append(walletHosts, *ap.Config.LegacyRPCListeners...)
+	}
+	if ap.Config.WalletServer != nil {
+		walletHosts = append(walletHosts, *ap.Config.WalletServer)
+	}
+	pairs := []struct {
+		cert, key string
+		hosts     []string
+	}{
+		{"node.cert", "node.key", nodeHosts},
+		{"wallet.cert", "wallet.key", walletHosts},
+		{*ap.Config.ClientCert, *ap.Config.ClientKey, nil},
+	}
+	for i, p := range pairs {
+		pairs[i].cert = util.CleanAndExpandPath(p.cert, *ap.Config.DataDir)
+		pairs[i].key = util.CleanAndExpandPath(p.key, *ap.Config.DataDir)
+		if util.FileExists(pairs[i].cert) || util.FileExists(pairs[i].key) {
+			fmt.Println(pairs[i].cert, "or", pairs[i].key,
+				"already exists, refusing to overwrite")
+			return 1
+		}
+	}
+	validUntil := time.Now().Add(10 * 365 * 24 * time.Hour)
+	for _, p := range pairs {
+		certFile, keyFile := p.cert, p.key
+		cert, key, err := util.NewSignedTLSCertPair("9 autogenerated cert",
+			validUntil, p.hosts, caCert, caKey)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "cannot generate key pair:", err)
+			return 1
+		}
+		if err = writeKeyPair(certFile, keyFile, cert, key); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Println("wrote", certFile, "and", keyFile)
+	}
+	fmt.Println("set tls.cert and tls.key to the node or wallet pair to serve RPC with it,",
+		"and enable tls.clientauth to only accept clients with a certificate")
 	return 0
 }
-// GenCA creates a signing key that GenCerts will use if present to sign keys that
-// it can be used to certify for multiple nodes connected to each other
-// (wallet/node and RPC)
+// GenCA creates a signing key and certificate for a certification authority that GenCerts uses to sign the key pairs of nodes, wallets and RPC clients connected to each other, so each only needs to trust the certificate in tls.cafile
 func GenCA(args []string, tokens def.Tokens, ap *def.App) int {
+	caFile, caKeyFile := caFiles(ap)
+	if util.FileExists(caFile) || util.FileExists(caKeyFile) {
+		fmt.Println(caFile, "or", caKeyFile, "already exists, refusing to overwrite")
+		return 1
+	}
+	validUntil := time.Now().Add(10 * 365 * 24 * time.Hour)
+	cert, key, err := util.NewTLSCA("9 autogenerated certification authority", validUntil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "cannot generate certification authority:", err)
+		return 1
+	}
+	if err = writeKeyPair(caFile, caKeyFile, cert, key); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Println("wrote", caFile, "and", caKeyFile)
 	return 0
 }
+// caFiles returns the paths of the certificate and signing key of the certification authority
+func caFiles(ap *def.App) (caFile, caKeyFile string) {
+	caFile = util.CleanAndExpandPath(*ap.Config.CAFile, *ap.Config.DataDir)
+	caKeyFile = filepath.Join(*ap.Config.DataDir, "ca.key")
+	return
+}
+// readCA reads the certificate and signing key of the certification authority
+func readCA(ap *def.App) (cert, key []byte, err error) {
+	caFile, caKeyFile := caFiles(ap)
+	if cert, err = ioutil.ReadFile(caFile); err != nil {
+		return
+	}
+	key, err = ioutil.ReadFile(caKeyFile)
+	return
+}
+// writeKeyPair writes a PEM-encoded certificate and key, the key only readable by the user, removing the certificate again if the key can't be written
+func writeKeyPair(certFile, keyFile string, cert, key []byte) error {
+	for _, dir := range []string{filepath.Dir(certFile), filepath.Dir(keyFile)} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	if err := ioutil.WriteFile(certFile, cert, 0644); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(keyFile, key, 0600); err != nil {
+		os.Remove(certFile)
+		return err
+	}
+	return nil
+}
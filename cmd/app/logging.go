@@ -0,0 +1,66 @@
+package app
+import (
+	"strings"
+	"time"
+	"git.parallelcoin.io/dev/9/cmd/def"
+	"git.parallelcoin.io/dev/9/pkg/util"
+	"git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// logFile is a log file with the subsystems written to it, all of them if there are none
+type logFile struct {
+	path       string
+	subsystems []string
+}
+// configureLog sets the format of the log and opens the log files of the log group for the commands that keep running
+func configureLog(ap *def.App) {
+	cfg := ap.Config
+	if cfg.LogFormat != nil {
+		if err := cl.SetFormat(*cfg.LogFormat); err != nil {
+			log <- cl.Warn{"configuration:", err}
+		}
+	}
+	if cfg.LogFiles == nil || (cfg.LogNoWrite != nil && *cfg.LogNoWrite) {
+		return
+	}
+	var maxSize int64
+	if cfg.LogMaxSize != nil {
+		maxSize = int64(*cfg.LogMaxSize) << 20
+	}
+	var maxAge time.Duration
+	if cfg.LogMaxAge != nil {
+		maxAge = *cfg.LogMaxAge
+	}
+	for _, x := range logFiles(*cfg.LogFiles, *datadir, func(name string) bool {
+		return cl.Register.Get(name) != nil
+	}) {
+		if err := cl.AddFile(x.path, maxSize, maxAge, x.subsystems...); err != nil {
+			log <- cl.Error{"opening log file:", err}
+		}
+	}
+}
+// logFiles returns the log files of the file row of the log group in the order they are first given. An entry starting with the name of a subsystem and a colon writes that subsystem to the path after it, any other entry is a path all of the subsystems are written to. Relative paths are in dataDir.
+func logFiles(
+	entries []string, dataDir string, isSubsystem func(string) bool) (files []logFile) {
+	index := make(map[string]int)
+	all := make(map[string]bool)
+	for _, x := range entries {
+		path, subsystem := x, ""
+		if i := strings.Index(x, ":"); i > 0 && isSubsystem(x[:i]) {
+			subsystem, path = x[:i], x[i+1:]
+		}
+		path = util.CleanAndExpandPath(path, dataDir)
+		i, ok := index[path]
+		if !ok {
+			i = len(files)
+			index[path] = i
+			files = append(files, logFile{path: path})
+		}
+		if subsystem == "" {
+			all[path] = true
+			files[i].subsystems = nil
+		} else if !all[path] {
+			files[i].subsystems = append(files[i].subsystems, subsystem)
+		}
+	}
+	return
+}
@@ -0,0 +1,23 @@
+package app
+import (
+	"reflect"
+	"testing"
+)
+// TestLogFiles ensures the log file entries are split into paths in the data directory with the subsystems written to them
+func TestLogFiles(
+	t *testing.T) {
+	isSubsystem := func(name string) bool {
+		return name == "node" || name == "chain/mining"
+	}
+	got := logFiles([]string{"node:node.log", "chain/mining:node.log", "all.log",
+		"node:/var/log/all.log", "/var/log/all.log", "notasubsystem:x.log"}, "/data", isSubsystem)
+	want := []logFile{
+		{path: "/data/node.log", subsystems: []string{"node", "chain/mining"}},
+		{path: "/data/all.log"},
+		{path: "/var/log/all.log"},
+		{path: "/data/notasubsystem:x.log"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, expected %+v", got, want)
+	}
+}
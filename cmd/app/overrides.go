@@ -0,0 +1,125 @@
+package app
+import (
+	"fmt"
+	"os"
+	"strings"
+	"git.parallelcoin.io/dev/9/cmd/def"
+)
+// EnvPrefix is the start of the names of the environment variables that override configuration rows, followed by the group and the name of the row in upper case, as in NINE_RPC_USER
+const EnvPrefix = "NINE_"
+// FlagPrefix starts a commandline argument that overrides a configuration row, in the form --group.name=value
+const FlagPrefix = "--"
+// override is a value for a configuration row given for a single run
+type override struct {
+	group, name, value, source string
+}
+// EnvName returns the name of the environment variable that overrides the configuration row name in group
+func EnvName(
+	group, name string) string {
+	return EnvPrefix + strings.ToUpper(envSafe(group)+"_"+envSafe(name))
+}
+// envSafe replaces every character that can't be in the name of an environment variable with an underscore
+func envSafe(
+	s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		}
+		return '_'
+	}, s)
+}
+// splitFlags separates the --group.name=value arguments from the rest of the commandline. A flag without a value sets a bool row to true.
+func splitFlags(
+	args []string) (rest []string, flags []override, err error) {
+	for i, x := range args {
+		if i == 0 || !strings.HasPrefix(x, FlagPrefix) {
+			rest = append(rest, x)
+			continue
+		}
+		kv := strings.SplitN(strings.TrimPrefix(x, FlagPrefix), "=", 2)
+		gn := strings.SplitN(kv[0], ".", 2)
+		if len(gn) != 2 || gn[0] == "" || gn[1] == "" {
+			return nil, nil, fmt.Errorf(
+				"invalid argument '%s', expected %sgroup.name=value", x, FlagPrefix)
+		}
+		o := override{group: gn[0], name: gn[1], value: "true", source: x}
+		if len(kv) == 2 {
+			o.value = kv[1]
+		}
+		flags = append(flags, o)
+	}
+	return
+}
+// envOverrides returns the overrides of the rows of ap found in environ, which is in the form returned by os.Environ, sorted by group and name
+func envOverrides(
+	ap *def.App, environ []string) (envs []override) {
+	vars := make(map[string]string)
+	for _, x := range environ {
+		kv := strings.SplitN(x, "=", 2)
+		if len(kv) == 2 && strings.HasPrefix(kv[0], EnvPrefix) {
+			vars[kv[0]] = kv[1]
+		}
+	}
+	for _, group := range ap.Cats.GetSortedKeys() {
+		cat := ap.Cats[group]
+		for _, name := range cat.GetSortedKeys() {
+			env := EnvName(group, name)
+			if v, ok := vars[env]; ok {
+				envs = append(envs, override{group: group, name: name, value: v, source: env})
+			}
+		}
+	}
+	return
+}
+// lookupOverride returns the last value given for a row, the one that takes precedence
+func lookupOverride(
+	overrides []override, group, name string) (value string, ok bool) {
+	for _, x := range overrides {
+		if x.group == group && x.name == name {
+			value, ok = x.value, true
+		}
+	}
+	return
+}
+// applyOverrides puts the overrides into the rows of ap in order through their validators without saving the configuration, so they last only for this run
+func applyOverrides(
+	ap *def.App, overrides []override) error {
+	for _, x := range overrides {
+		r, ok := ap.Cats[x.group][x.name]
+		if !ok {
+			return fmt.Errorf("%s: no configuration row %s.%s", x.source, x.group, x.name)
+		}
+		app := r.App
+		r.App = nil
+		valid := setOverride(r, x.value)
+		r.App = app
+		if !valid {
+			return fmt.Errorf("%s: invalid value '%s' for %s.%s", x.source, x.value, x.group, x.name)
+		}
+	}
+	return nil
+}
+// setOverride validates a value given as a string into a row, lists are separated by commas and replace the previous list
+func setOverride(
+	r *def.Row, value string) bool {
+	if r.Type != "stringslice" {
+		return r.Validate(r, value)
+	}
+	list := []string{}
+	for _, x := range strings.Split(value, ",") {
+		if x = strings.TrimSpace(x); x != "" {
+			list = append(list, x)
+		}
+	}
+	r.Value.Put([]string{})
+	return r.Validate(r, list)
+}
+// printOverrideError prints an error with an override and how to write them
+func printOverrideError(
+	err error) {
+	fmt.Fprintln(os.Stderr, err)
+	fmt.Fprintf(os.Stderr,
+		"configuration rows are set for one run with %sgroup.name=value or the environment variable %s\n",
+		FlagPrefix, EnvName("group", "name"))
+}
@@ -0,0 +1,61 @@
+package app
+import (
+	"reflect"
+	"testing"
+)
+// TestOverrides ensures configuration rows are set from the environment and the commandline through their validators, with the commandline taking precedence and lists replacing the configured list.
+func TestOverrides(
+	t *testing.T) {
+	ap := NewApp("test",
+		Group("rpc",
+			Tag("user", Default("file")),
+			Addrs("listen", 11048, Default("127.0.0.1:11048")),
+			Enable("disabletls"),
+			Int("maxclients", Default(10)),
+		),
+	)
+	args, flags, err := splitFlags([]string{"9", "--rpc.user=flag", "node",
+		"--rpc.disabletls", "--rpc.listen=127.0.0.1:11111, 127.0.0.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(args, []string{"9", "node"}) {
+		t.Fatalf("got args %v, expected the overrides to be removed", args)
+	}
+	envs := envOverrides(ap, []string{"NINE_RPC_USER=env",
+		"NINE_RPC_MAXCLIENTS=3", "NINE_RPC_NOTAROW=1", "HOME=/"})
+	if len(envs) != 2 {
+		t.Fatalf("got %d environment overrides, expected 2", len(envs))
+	}
+	if err = applyOverrides(ap, append(envs, flags...)); err != nil {
+		t.Fatal(err)
+	}
+	if got := *ap.Cats.Str("rpc", "user"); got != "flag" {
+		t.Errorf("got user %s, expected the flag to take precedence", got)
+	}
+	if got := *ap.Cats.Int("rpc", "maxclients"); got != 3 {
+		t.Errorf("got maxclients %d, expected 3 from the environment", got)
+	}
+	if got := *ap.Cats.Bool("rpc", "disabletls"); !got {
+		t.Error("flag without a value did not enable disabletls")
+	}
+	want := []string{"127.0.0.1:11111", "127.0.0.2:11048"}
+	if got := *ap.Cats.Tags("rpc", "listen"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got listen %v, expected %v", got, want)
+	}
+	for _, x := range [][]string{
+		{"9", "--rpc.maxclients=many"},
+		{"9", "--rpc.notarow=1"},
+	} {
+		_, flags, err = splitFlags(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = applyOverrides(ap, flags); err == nil {
+			t.Errorf("%v was accepted", x)
+		}
+	}
+	if _, _, err = splitFlags([]string{"9", "--user=x"}); err == nil {
+		t.Error("override without a group was accepted")
+	}
+}
@@ -11,8 +11,18 @@ import (
 	"git.parallelcoin.io/dev/9/pkg/util/cl"
 )
 var datadir = new(string)
+// overrides are the configuration rows set from the environment and commandline for this run, they are applied again when the configuration is reloaded
+var overrides []override
 // Parse commandline
 func Parse(ap *def.App, args []string) int {
+	// configuration overrides are taken out so the commands and handlers don't see them
+	args, flags, err := splitFlags(args)
+	if err != nil {
+		printOverrideError(err)
+		return 1
+	}
+	// the environment comes before the commandline so flags take precedence
+	overrides = append(envOverrides(ap, os.Environ()), flags...)
 	cmd, tokens := parseCLI(ap, args)
 	if cmd == nil {
 		cmd = ap.Commands["help"]
@@ -20,11 +30,22 @@ func Parse(ap *def.App, args []string) int {
 	// get datadir from cli args if given
 	if dd, ok := tokens["datadir"]; ok {
 		datadir = &dd.Value
-		pwd, _ := os.Getwd()
-		*datadir = filepath.Join(pwd, *datadir)
+		if !filepath.IsAbs(*datadir) {
+			pwd, _ := os.Getwd()
+			*datadir = filepath.Join(pwd, *datadir)
+		}
 		dd.Value = *datadir
 		ap.Cats["app"]["datadir"].Value.Put(*datadir)
 		DataDir = *datadir
+	} else if dd, ok := lookupOverride(overrides, "app", "datadir"); ok {
+		dd = util.CleanAndExpandPath(dd, "")
+		if !filepath.IsAbs(dd) {
+			pwd, _ := os.Getwd()
+			dd = filepath.Join(pwd, dd)
+		}
+		datadir = &dd
+		ap.Cats["app"]["datadir"].Value.Put(*datadir)
+		DataDir = *datadir
 	} else {
 		ddd := util.AppDataDir("9", false)
 		ap.Cats["app"]["datadir"].Put(ddd)
@@ -70,7 +91,8 @@ func Parse(ap *def.App, args []string) int {
 	}
 	e := json.Unmarshal(conf, ap)
 	if e != nil {
-		panic(e)
+		fmt.Fprintln(os.Stderr, configFile+":", e)
+		return 1
 	}
 	// now we can initialise the App
 	for i, x := range ap.Cats {
@@ -80,11 +102,31 @@ func Parse(ap *def.App, args []string) int {
 			ap.Cats[i][j] = temp
 		}
 	}
+	if len(overrides) > 0 && cmd.Name == "config" {
+		printOverrideError(fmt.Errorf("config reads and writes the configuration file, it can't be overridden"))
+		return 1
+	}
+	// values given for this run replace those in the file but are not saved
+	if err = applyOverrides(ap, overrides); err != nil {
+		printOverrideError(err)
+		return 1
+	}
 	ap.Config = MakeConfig(ap)
 	ap.Config.ActiveNetParams = node.ActiveNetParams
 	if ap.Config.LogLevel != nil {
 		cl.Register.SetAllLevels(*ap.Config.LogLevel)
 	}
+	if ap.LoadedVersion < def.ConfigVersion() {
+		// the file is left as it is until config set or unset writes it, so nothing in it is lost by loading it
+		log <- cl.Infof{"configuration %s is of schema version %d, it is migrated to %d when config next writes it",
+			configFile, ap.LoadedVersion, def.ConfigVersion()}
+	}
+	// config validate reports the problems itself
+	if cmd.Name != "config" {
+		for _, x := range ap.Problems {
+			log <- cl.Warn{"configuration", configFile + ":", x}
+		}
+	}
 	// run as configured
 	r := cmd.Handler(
 		args,
@@ -101,9 +143,14 @@ func parseCLI(ap *def.App, args []string) (cmd *def.Command, tokens def.Tokens)
 	}
 	commandsFound := make(map[string]int)
 	tokens = make(def.Tokens)
+	rest := false
 	for _, x := range args[1:] {
+		if rest {
+			break
+		}
 		for i, y := range ap.Commands {
 			if y.RE.MatchString(x) {
+				rest = y.Rest
 				if _, ok := commandsFound[i]; ok {
 					tokens[i] = def.Token{Value: x, Cmd: *y}
 					commandsFound[i]++
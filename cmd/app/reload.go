@@ -0,0 +1,91 @@
+package app
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"git.parallelcoin.io/dev/9/cmd/def"
+	"git.parallelcoin.io/dev/9/cmd/nine"
+	"git.parallelcoin.io/dev/9/pkg/ifc"
+	"git.parallelcoin.io/dev/9/pkg/util"
+	"git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// liveRows are the configuration rows a running node applies when its configuration is reloaded, changes to any other rows take effect after a restart
+var liveRows = map[string]bool{
+	"log.level":         true,
+	"mining.addresses":  true,
+	"mining.genthreads": true,
+	"p2p.banduration":   true,
+	"p2p.banthreshold":  true,
+	"p2p.disableban":    true,
+	"p2p.maxpeers":      true,
+	"p2p.minrelaytxfee": true,
+}
+// reloadConfig reads the configuration file of ap again with the overrides of this run and validates it the way it is when the node starts. The changed live rows are updated in ap to match the file, and the configuration made from the file is returned for the node to apply them from. Changed rows that are not live are listed in restart and left as they are in ap, as the node keeps running with them, so they are reported again by the next reload until the node is restarted.
+func reloadConfig(
+	ap *def.App, overrides []override) (cfg *nine.Config, changed, restart []string, err error) {
+	configFile := util.CleanAndExpandPath(filepath.Join(*datadir, "config"), *datadir)
+	conf, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return
+	}
+	fresh := copyRows(ap)
+	// the network validator selects the network, which can't change until a restart
+	activeNet := *nine.ActiveNetParams
+	err = json.Unmarshal(conf, fresh)
+	if err == nil {
+		err = applyOverrides(fresh, overrides)
+	}
+	*nine.ActiveNetParams = activeNet
+	if err != nil {
+		return
+	}
+	for _, x := range fresh.Problems {
+		log <- cl.Warn{"configuration", configFile + ":", x}
+	}
+	fresh.Config = MakeConfig(fresh)
+	fresh.Config.ActiveNetParams = ap.Config.ActiveNetParams
+	state := *ap.Config.State
+	fresh.Config.State = &state
+	if validateBlockLimits(fresh) != 0 || validateMiner(fresh) != 0 {
+		return nil, nil, nil, errors.New("invalid configuration, nothing was changed")
+	}
+	for _, group := range ap.Cats.GetSortedKeys() {
+		cat := ap.Cats[group]
+		for _, name := range cat.GetSortedKeys() {
+			value := fresh.Cats[group][name].Value.Get()
+			if formatValue(cat[name].Value.Get()) == formatValue(value) {
+				continue
+			}
+			key := fmt.Sprintf("%s.%s", group, name)
+			changed = append(changed, key)
+			if !liveRows[key] {
+				restart = append(restart, key)
+				continue
+			}
+			cat[name].Value.Put(value)
+		}
+	}
+	return fresh.Config, changed, restart, nil
+}
+// copyRows returns an App with copies of the rows of ap holding their current values, that are not saved when they change
+func copyRows(
+	ap *def.App) *def.App {
+	out := &def.App{Name: ap.Name, Cats: make(def.Cats)}
+	for i, x := range ap.Cats {
+		out.Cats[i] = make(def.Cat)
+		for j, y := range x {
+			r := *y
+			r.App = nil
+			value := y.Value.Get()
+			// validators append to lists, which must not reach the lists of ap
+			if list, ok := value.([]string); ok {
+				value = append([]string{}, list...)
+			}
+			r.Value = ifc.NewIface().Put(value)
+			out.Cats[i][j] = &r
+		}
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package app
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"git.parallelcoin.io/dev/9/cmd/def"
+	"git.parallelcoin.io/dev/9/cmd/node"
+)
+// newReloadTestApp returns an App with the rows the node validates when its configuration is reloaded, saving its configuration in dir
+func newReloadTestApp(
+	dir string) *def.App {
+	ap := NewApp("test",
+		Group("app",
+			Dir("datadir", Default(dir)),
+		),
+		Group("block",
+			Int("maxsize", Default(node.DefaultBlockMaxSize)),
+			Int("maxweight", Default(node.DefaultBlockMaxWeight)),
+			Int("minsize", Default(0)),
+			Int("minweight", Default(0)),
+			Int("prioritysize", Default(0)),
+		),
+		Group("chain",
+			Enable("rejectnonstd"),
+			Enable("relaynonstd"),
+		),
+		Group("mining",
+			Enable("generate"),
+			Tag("pass", Default("pass")),
+		),
+		Group("p2p",
+			Addrs("listen", 11047, Default("127.0.0.1:11047")),
+			Int("maxpeers", Default(node.DefaultMaxPeers)),
+			Float("minrelaytxfee", Default(0.0001)),
+		),
+	)
+	ap.Config = MakeConfig(ap)
+	return ap
+}
+// TestReloadConfig ensures a reload updates the changed live rows and leaves the changed rows that need a restart as they are, so every reload reports them until the node is restarted
+func TestReloadConfig(
+	t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(d string) { *datadir = d }(*datadir)
+	*datadir = dir
+	ap := newReloadTestApp(dir)
+	ap.SaveConfig()
+	// the file is edited while the node runs
+	edit := newReloadTestApp(dir)
+	edit.Cats["p2p"]["listen"].Value.Put([]string{})
+	if !edit.Cats["p2p"]["listen"].Put("127.0.0.1:25047") ||
+		!edit.Cats["p2p"]["maxpeers"].Put(8) {
+		t.Fatal("invalid edit")
+	}
+	for i := 0; i < 2; i++ {
+		cfg, changed, restart, err := reloadConfig(ap, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantChanged := []string{"p2p.listen", "p2p.maxpeers"}
+		if i > 0 {
+			wantChanged = wantChanged[:1]
+		}
+		if !reflect.DeepEqual(changed, wantChanged) ||
+			!reflect.DeepEqual(restart, []string{"p2p.listen"}) {
+			t.Fatalf("reload %d: got changed %v and restart %v", i, changed, restart)
+		}
+		if *cfg.MaxPeers != 8 || *ap.Cats.Int("p2p", "maxpeers") != 8 {
+			t.Errorf("reload %d: maxpeers was not updated", i)
+		}
+		if got := *ap.Cats.Tags("p2p", "listen"); !reflect.DeepEqual(got, []string{"127.0.0.1:11047"}) {
+			t.Errorf("reload %d: got listen %v, expected it to wait for a restart", i, got)
+		}
+	}
+}
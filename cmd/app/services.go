@@ -0,0 +1,133 @@
+package app
+import (
+	"errors"
+	"git.parallelcoin.io/dev/9/cmd/ctl"
+	"git.parallelcoin.io/dev/9/cmd/def"
+	"git.parallelcoin.io/dev/9/cmd/node"
+	"git.parallelcoin.io/dev/9/cmd/walletmain"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+	"git.parallelcoin.io/dev/9/pkg/util/supervisor"
+)
+// errNodeStopped is the error of the miners when the node they mine with is not running
+var errNodeStopped = errors.New("the node is not running")
+// shellServices are the services of the shell in the order they are started: the node, the miners and Stratum server using it when they are enabled and the wallet server with the wallet in netDir. The node is critical and not restarted, the others are restarted when they fail.
+func shellServices(ap *def.App, netDir string) (services []supervisor.Service) {
+	cfg := ap.Config
+	services = append(services, supervisor.Service{
+		Name:     "node",
+		Restart:  supervisor.Never,
+		Critical: true,
+		Run: func(ready func(), quit <-chan struct{}) error {
+			return node.Run(nil, readyOnClose(ready, quit), quit)
+		},
+		Health: func() error {
+			_, err := ctl.Call(cfg, false, "getblockcount")
+			return err
+		},
+	})
+	if *cfg.Generate {
+		services = append(services, supervisor.Service{
+			Name:    "miner",
+			Restart: supervisor.OnFailure,
+			Run: func(ready func(), quit <-chan struct{}) error {
+				m := node.CPUMiner()
+				if m == nil {
+					return errNodeStopped
+				}
+				m.Start()
+				ready()
+				<-quit
+				m.Stop()
+				return nil
+			},
+		})
+	}
+	if cfg.MinerListener != nil && *cfg.MinerListener != "" {
+		services = append(services, supervisor.Service{
+			Name:    "dispatch",
+			Restart: supervisor.OnFailure,
+			Run: func(ready func(), quit <-chan struct{}) error {
+				c := node.MinerController()
+				if c == nil {
+					return errNodeStopped
+				}
+				if err := c.Start(); err != nil {
+					return err
+				}
+				ready()
+				<-quit
+				c.Stop()
+				return nil
+			},
+			Health: func() error {
+				if c := node.MinerController(); c == nil || !c.IsMining() {
+					return errors.New("the miner controller is not listening")
+				}
+				return nil
+			},
+		})
+	}
+	if cfg.StratumListeners != nil && len(*cfg.StratumListeners) > 0 {
+		services = append(services, supervisor.Service{
+			Name:    "stratum",
+			Restart: supervisor.OnFailure,
+			Run: func(ready func(), quit <-chan struct{}) error {
+				s := node.Stratum()
+				if s == nil {
+					return errNodeStopped
+				}
+				if err := s.Start(); err != nil {
+					return err
+				}
+				ready()
+				<-quit
+				s.Stop()
+				return nil
+			},
+			Health: func() error {
+				if s := node.Stratum(); s == nil || !s.IsRunning() {
+					return errors.New("the Stratum server is not listening")
+				}
+				return nil
+			},
+		})
+	}
+	services = append(services, supervisor.Service{
+		Name:    "wallet",
+		Restart: supervisor.OnFailure,
+		Run: func(ready func(), quit <-chan struct{}) error {
+			return walletmain.Run(cfg, cfg.ActiveNetParams, netDir, readyOnClose(ready, quit), quit)
+		},
+		Health: func() error {
+			_, err := ctl.Call(cfg, true, "getblockcount")
+			return err
+		},
+	})
+	return
+}
+// readyOnClose returns a channel that calls ready when it is closed before quit is
+func readyOnClose(ready func(), quit <-chan struct{}) chan struct{} {
+	started := make(chan struct{})
+	go func() {
+		select {
+		case <-started:
+			ready()
+		case <-quit:
+		}
+	}()
+	return started
+}
+// serviceStatus converts the state of the services for the getservicestatus command
+func serviceStatus(status []supervisor.Status) (result []json.ServiceStatusResult) {
+	for _, s := range status {
+		result = append(result, json.ServiceStatusResult{
+			Name:     s.Name,
+			State:    s.State,
+			Restart:  s.Restart.String(),
+			Restarts: s.Restarts,
+			Since:    s.Since.Unix(),
+			Error:    s.Err,
+		})
+	}
+	return
+}
@@ -15,6 +15,7 @@ import (
 	"git.parallelcoin.io/dev/9/cmd/nine"
 	"git.parallelcoin.io/dev/9/cmd/node"
 	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining/stratum"
 	"git.parallelcoin.io/dev/9/pkg/ifc"
 	"git.parallelcoin.io/dev/9/pkg/peer/connmgr"
 	"git.parallelcoin.io/dev/9/pkg/util"
@@ -145,7 +146,7 @@ func GenAddrs(name string, port int) func(r *def.Row, in interface{}) bool {
 }
 func getAlgoOptions() (options []string) {
 	var modernd = "random"
-	for _, x := range fork.P9AlgoVers {
+	for _, x := range fork.Plan9(0).AlgoVers {
 		options = append(options, x)
 	}
 	options = append(options, modernd)
@@ -584,11 +585,11 @@ func validateProxyListeners(ap *def.App) int {
 	if ap.Config.Proxy != nil {
 		*ap.Config.Proxy = ""
 	}
-	// if proxy is enabled or listeners list is empty, or connect peers are set,
-	// disable p2p listener
-	if ap.Config.Proxy != nil ||
-		ap.Config.ConnectPeers != nil ||
-		ap.Config.Listeners == nil {
+	// if proxy is enabled or connect peers are set without listeners other than
+	// the default being given, or listeners list is empty, disable p2p listener
+	if ((ap.Config.Proxy != nil || ap.Config.ConnectPeers != nil) &&
+		!listenersGiven(ap)) ||
+		ap.Config.Listeners == nil || len(*ap.Config.Listeners) < 1 {
 		if ap.Config.DisableListen == nil {
 			acd := true
 			ap.Config.DisableListen = &acd
@@ -603,6 +604,18 @@ func validateProxyListeners(ap *def.App) int {
 	}
 	return 0
 }
+// listenersGiven returns whether the p2p listeners are other than the default of the p2p.listen row, as a node on a proxy or with connect peers only listens on listeners that were asked for
+func listenersGiven(ap *def.App) bool {
+	if ap.Config.Listeners == nil || len(*ap.Config.Listeners) < 1 {
+		return false
+	}
+	r, ok := ap.Cats["p2p"]["listen"]
+	if !ok || r.Default == nil {
+		return true
+	}
+	d, _ := r.Default.Get().(string)
+	return len(*ap.Config.Listeners) != 1 || (*ap.Config.Listeners)[0] != d
+}
 func validatePasswords(ap *def.App) int {
 	// Check to make sure limited and admin users don't have the same username
 	if *ap.Config.Username != "" && *ap.Config.Username == *ap.Config.LimitUser {
@@ -729,12 +742,20 @@ func validateMiner(ap *def.App) int {
 		}
 	}
 	// Ensure there is at least one mining address when the generate flag
-	// is set.
-	if (*ap.Config.Generate ||
-		ap.Config.MinerListener != nil) &&
-		ap.Config.MiningAddrs != nil {
-		str := "%s: the generate flag is set, but there are no mining addresses specified "
-		err := fmt.Errorf(str, "runNode")
+	// is set or the miner controller or stratum server is enabled, naming
+	// the setting that needs it.
+	var setting string
+	switch {
+	case *ap.Config.Generate:
+		setting = "mining.generate is set"
+	case ap.Config.MinerListener != nil && *ap.Config.MinerListener != "":
+		setting = "the miner controller is enabled by mining.listener"
+	case ap.Config.StratumListeners != nil && len(*ap.Config.StratumListeners) > 0:
+		setting = "the stratum server is enabled by stratum.listen"
+	}
+	if setting != "" && len(ap.Config.State.ActiveMiningAddrs) == 0 {
+		str := "%s: %s, but there are no mining addresses specified "
+		err := fmt.Errorf(str, "runNode", setting)
 		fmt.Fprintln(os.Stderr, err)
 		return 1
 	}
@@ -743,6 +764,25 @@ func validateMiner(ap *def.App) int {
 	}
 	return 0
 }
+func validateStratum(ap *def.App) int {
+	// Check the stratum listeners and share difficulties parse with the algorithms of the network.
+	// log <- cl.Debug{"checking stratum listeners and difficulties"}
+	if ap.Config.StratumListeners != nil {
+		if _, err := stratum.ParseListeners(ap.Config.ActiveNetParams.Params,
+			*ap.Config.StratumListeners, node.DefaultStratumPort); err != nil {
+			fmt.Fprintln(os.Stderr, "runNode:", err)
+			return 1
+		}
+	}
+	if ap.Config.StratumDifficulty != nil {
+		if _, err := stratum.ParseDifficulty(ap.Config.ActiveNetParams.Params,
+			*ap.Config.StratumDifficulty); err != nil {
+			fmt.Fprintln(os.Stderr, "runNode:", err)
+			return 1
+		}
+	}
+	return 0
+}
 func validateCheckpoints(ap *def.App) int {
 	var err error
 	// Check the checkpoints for syntax errors.
@@ -856,7 +896,8 @@ func validateDialers(ap *def.App) int {
 }
 func validateAddresses(ap *def.App) int {
 	// TODO: simplify this to a boolean and one slice for config fercryinoutloud
-	if ap.Config.AddPeers != nil && ap.Config.ConnectPeers != nil {
+	if ap.Config.AddPeers != nil && ap.Config.ConnectPeers != nil &&
+		len(*ap.Config.AddPeers) > 0 && len(*ap.Config.ConnectPeers) > 0 {
 		fmt.Println("ERROR:", cl.Ine(),
 			"cannot have addpeers at the same time as connectpeers")
 		return 1
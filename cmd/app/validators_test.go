@@ -0,0 +1,53 @@
+package app
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+// TestProxyListeners ensures connect peers disable the p2p listener unless listeners other than the default are given, as the nodes of a test network are
+func TestProxyListeners(
+	t *testing.T) {
+	datadir, err := ioutil.TempDir("", "listeners")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(datadir)
+	tests := []struct {
+		connect, listen []string
+		nolisten        bool
+	}{
+		{nil, nil, false},
+		{[]string{"127.0.0.1:25057"}, nil, true},
+		{[]string{"127.0.0.1:25057"}, []string{"127.0.0.1:25047"}, false},
+		{nil, []string{"127.0.0.1:25047"}, false},
+	}
+	for i, x := range tests {
+		ap := NewApp("test",
+			Group("app",
+				Dir("datadir", Default(datadir)),
+			),
+			Group("p2p",
+				Addrs("connect", 11047),
+				Enable("nolisten"),
+				Addrs("listen", 11047, Default("127.0.0.1:11047")),
+			),
+		)
+		for name, value := range map[string][]string{"connect": x.connect, "listen": x.listen} {
+			if value == nil {
+				continue
+			}
+			ap.Cats["p2p"][name].Value.Put([]string{})
+			if !ap.Cats["p2p"][name].Put(value) {
+				t.Fatalf("test %d: invalid %s %v", i, name, value)
+			}
+		}
+		ap.Config = MakeConfig(ap)
+		if j := validateProxyListeners(ap); j != 0 {
+			t.Fatalf("test %d: validator failed", i)
+		}
+		if got := *ap.Config.DisableListen; got != x.nolisten {
+			t.Errorf("test %d: connect %v listen %v: got nolisten %v, expected %v",
+				i, x.connect, x.listen, got, x.nolisten)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package ctl
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"git.parallelcoin.io/dev/9/cmd/nine"
+	rpchelp "git.parallelcoin.io/dev/9/pkg/rpc/help"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+	"github.com/btcsuite/golangcrypto/ssh/terminal"
+)
+// historySize is how many of the last lines entered into the console are kept, as many as the terminal can recall
+const historySize = 100
+// secretCommands are the commands whose lines hold passphrases or private keys, or return private keys, and are neither saved in the history file nor recalled
+var secretCommands = map[string]bool{
+	"createencryptedwallet":  true,
+	"dumpprivkey":            true,
+	"encryptwallet":          true,
+	"importprivkey":          true,
+	"walletpassphrase":       true,
+	"walletpassphrasechange": true,
+}
+// builtins are the commands of the console itself rather than of the servers
+var builtins = []string{"exit", "quit", "usage", "use"}
+// console is a session of the console sending commands to the full node or the wallet server
+type console struct {
+	cfg    *nine.Config
+	wallet bool
+	out    io.Writer
+}
+// stdio is what the terminal of the console reads from and writes to, swapped out while the history is loaded into it
+type stdio struct {
+	io.Reader
+	io.Writer
+}
+// Console reads commands from the terminal and sends them to the full node, or to the wallet server after "use wallet", until exit or the end of input. Tab completes the names of commands and their parameters, and the lines entered are kept in a history file in the data directory for the next session.
+func Console(
+	cfg *nine.Config) {
+	c := &console{cfg: cfg, wallet: *cfg.Wallet, out: os.Stdout}
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		// commands piped in are run one per line without a prompt
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() && c.run(scanner.Text()) {
+		}
+		return
+	}
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "cannot open the console:", err)
+		return
+	}
+	defer terminal.Restore(fd, state)
+	var historyFile string
+	if cfg.DataDir != nil {
+		historyFile = filepath.Join(*cfg.DataDir, "ctl_history")
+	}
+	history := loadHistory(historyFile)
+	t := c.terminal(fd, history)
+	fmt.Fprintf(t, "commands go to the %s, tab completes, \"use node|wallet\" switches servers, \"usage <command>\" describes a command and exit or ctrl-d leaves\n", c.server())
+	for {
+		line, err := t.ReadLine()
+		if err != nil {
+			break
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if isSecret(line) {
+			// the terminal recalls every line it reads, so the line is dropped from it by starting over with the history without it
+			t = c.terminal(fd, history)
+		} else {
+			if history = append(history, line); len(history) > historySize {
+				history = history[len(history)-historySize:]
+			}
+			saveHistory(historyFile, history)
+		}
+		if !c.run(line) {
+			break
+		}
+		t.SetPrompt(c.prompt())
+	}
+}
+// terminal opens the terminal of the console on fd with the lines of history to recall, sending what is written to it to standard output
+func (c *console) terminal(
+	fd int, history []string) *terminal.Terminal {
+	// the terminal has no way to set its history other than entering the lines into it
+	rw := &stdio{strings.NewReader(strings.Join(history, "\r") + "\r"), ioutil.Discard}
+	t := terminal.NewTerminal(rw, c.prompt())
+	for range history {
+		t.ReadLine()
+	}
+	rw.Reader, rw.Writer = os.Stdin, os.Stdout
+	if width, height, err := terminal.GetSize(fd); err == nil && width > 0 {
+		t.SetSize(width, height)
+	}
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		line, pos, candidates := complete(line, pos, c.wallet)
+		if len(candidates) > 1 {
+			fmt.Fprintln(t, strings.Join(candidates, "  "))
+		}
+		return line, pos, true
+	}
+	c.out = t
+	return t
+}
+// prompt is the prompt showing which server commands are sent to
+func (c *console) prompt() string {
+	if c.wallet {
+		return "wallet> "
+	}
+	return "node> "
+}
+// server is the server commands are sent to and its address
+func (c *console) server() string {
+	if c.wallet {
+		return "wallet server @ " + *c.cfg.WalletServer
+	}
+	return "full node @ " + *c.cfg.RPCConnect
+}
+// run runs one line entered into the console, returning false when the console is to be closed
+func (c *console) run(
+	line string) bool {
+	args, err := splitArgs(line)
+	if err != nil {
+		fmt.Fprintln(c.out, err)
+		return true
+	}
+	if len(args) == 0 {
+		return true
+	}
+	method := args[0]
+	switch method {
+	case "exit", "quit":
+		return false
+	case "use":
+		if len(args) != 2 || (args[1] != "node" && args[1] != "wallet") {
+			fmt.Fprintln(c.out, "usage: use node|wallet")
+			return true
+		}
+		c.wallet = args[1] == "wallet"
+		fmt.Fprintln(c.out, "commands go to the", c.server())
+		return true
+	case "usage":
+		if len(args) != 2 {
+			fmt.Fprintln(c.out, "usage: usage <command>")
+			return true
+		}
+		c.usage(args[1])
+		return true
+	}
+	usageFlags, err := json.MethodUsageFlags(method)
+	if err != nil {
+		fmt.Fprintf(c.out, "Unrecognized command '%s'\n", method)
+		return true
+	}
+	if usageFlags&unusableFlags != 0 {
+		fmt.Fprintf(c.out, "The '%s' command can only be used via websockets\n", method)
+		return true
+	}
+	params, err := namedParams(method, args[1:])
+	if err != nil {
+		fmt.Fprintf(c.out, "%s command: %v\n", method, err)
+		return true
+	}
+	result, err := Call(c.cfg, c.wallet, method, params...)
+	if err == nil {
+		err = printResult(c.out, result)
+	}
+	if err != nil {
+		fmt.Fprintln(c.out, err)
+	}
+	return true
+}
+// usage describes a command with its parameters from the help of the wallet, or otherwise from the help of the full node
+func (c *console) usage(
+	method string) {
+	usage, err := json.MethodUsageText(method)
+	if err != nil {
+		fmt.Fprintf(c.out, "Unrecognized command '%s'\n", method)
+		return
+	}
+	descs := rpchelp.HelpDescs[0].Descs
+	if synopsis, ok := descs[method+"--synopsis"]; ok {
+		fmt.Fprintln(c.out, usage)
+		fmt.Fprintln(c.out, synopsis)
+		names, _ := json.MethodParamNames(method)
+		for _, name := range names {
+			if desc, ok := descs[method+"-"+name]; ok {
+				fmt.Fprintf(c.out, "  %s: %s\n", name, desc)
+			}
+		}
+		return
+	}
+	if result, err := Call(c.cfg, false, "help", method); err == nil {
+		printResult(c.out, result)
+		return
+	}
+	fmt.Fprintln(c.out, usage)
+}
+// commands returns the commands the console can send to the wallet server if wallet is true or otherwise to the full node
+func commands(
+	wallet bool) (methods []string) {
+	for _, method := range json.RegisteredCmdMethods() {
+		flags, err := json.MethodUsageFlags(method)
+		if err != nil || flags&unusableFlags != 0 || (!wallet && flags&json.UFWalletOnly != 0) {
+			continue
+		}
+		methods = append(methods, method)
+	}
+	return
+}
+// complete completes the word of line ending at pos, if pos is at the end of the word, as a command or builtin if it is the first word and as a parameter name of the command otherwise, returning the new line and position and the candidates the word could be completed to. With several candidates the word is only completed as far as they agree.
+func complete(
+	line string, pos int, wallet bool) (newLine string, newPos int, candidates []string) {
+	head, tail := line[:pos], line[pos:]
+	if tail != "" && tail[0] != ' ' {
+		return line, pos, nil
+	}
+	start := strings.LastIndex(head, " ") + 1
+	word, before := head[start:], strings.Fields(head[:start])
+	var options []string
+	switch {
+	case len(before) == 0:
+		options = append(commands(wallet), builtins...)
+	case len(before) == 1 && before[0] == "use":
+		options = []string{"node", "wallet"}
+	case len(before) == 1 && before[0] == "usage":
+		options = commands(wallet)
+	default:
+		names, _ := json.MethodParamNames(before[0])
+		for _, name := range names {
+			options = append(options, name+"=")
+		}
+	}
+	for _, option := range options {
+		if strings.HasPrefix(option, word) {
+			candidates = append(candidates, option)
+		}
+	}
+	sort.Strings(candidates)
+	switch len(candidates) {
+	case 0:
+		return line, pos, nil
+	case 1:
+		word = candidates[0]
+		if !strings.HasSuffix(word, "=") {
+			word += " "
+		}
+	default:
+		word = candidates[0]
+		for _, candidate := range candidates[1:] {
+			for !strings.HasPrefix(candidate, word) {
+				word = word[:len(word)-1]
+			}
+		}
+	}
+	head = head[:start] + word
+	return head + tail, len(head), candidates
+}
+// splitArgs splits a line into words at spaces, a word starting with a quote running to the matching quote with the quotes removed so that it can hold spaces or be empty
+func splitArgs(
+	line string) (args []string, err error) {
+	for {
+		line = strings.TrimLeft(line, " \t")
+		if line == "" {
+			return
+		}
+		if quote := line[0]; quote == '"' || quote == '\'' {
+			end := strings.IndexByte(line[1:], quote)
+			if end < 0 {
+				return nil, fmt.Errorf("missing closing %c", quote)
+			}
+			args = append(args, line[1:end+1])
+			line = line[end+2:]
+			continue
+		}
+		end := strings.IndexAny(line, " \t")
+		if end < 0 {
+			end = len(line)
+		}
+		args = append(args, line[:end])
+		line = line[end:]
+	}
+}
+// namedParams puts the arguments of a command in the order of its parameters, an argument of the form name=value going to the parameter of that name and the others filling the parameters in order. The parameters before the last one given must all be given.
+func namedParams(
+	method string, args []string) ([]interface{}, error) {
+	names, err := json.MethodParamNames(method)
+	if err != nil {
+		return nil, err
+	}
+	var values []*string
+	set := func(i int, value string) error {
+		for len(values) <= i {
+			values = append(values, nil)
+		}
+		if values[i] != nil {
+			return fmt.Errorf("%s is given more than once", names[i])
+		}
+		values[i] = &value
+		return nil
+	}
+	next := 0
+	for _, arg := range args {
+		if eq := strings.IndexByte(arg, '='); eq > 0 {
+			if i := indexOf(names, arg[:eq]); i >= 0 {
+				if err = set(i, arg[eq+1:]); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		for next < len(values) && values[next] != nil {
+			next++
+		}
+		if err = set(next, arg); err != nil {
+			return nil, err
+		}
+	}
+	params := make([]interface{}, len(values))
+	for i, value := range values {
+		if value == nil {
+			return nil, fmt.Errorf("%s is not given but a parameter after it is", names[i])
+		}
+		params[i] = *value
+	}
+	return params, nil
+}
+// indexOf returns the index of s in list or -1 if it is not in it
+func indexOf(
+	list []string, s string) int {
+	for i, x := range list {
+		if x == s {
+			return i
+		}
+	}
+	return -1
+}
+// isSecret returns whether line runs one of the secret commands
+func isSecret(
+	line string) bool {
+	fields := strings.Fields(line)
+	return len(fields) > 0 && secretCommands[strings.ToLower(strings.Trim(fields[0], `"'`))]
+}
+// loadHistory returns the lines of the history file, none if there is none, leaving out the lines of secret commands written by earlier versions
+func loadHistory(
+	path string) (history []string) {
+	if path == "" {
+		return
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		if len(line) > 0 && !isSecret(string(line)) {
+			history = append(history, string(line))
+		}
+	}
+	if len(history) > historySize {
+		history = history[len(history)-historySize:]
+	}
+	return
+}
+// saveHistory writes the lines of the history to the history file, which only the user can read
+func saveHistory(
+	path string, history []string) {
+	if path == "" {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	// a file created by an earlier version may be readable by others
+	f.Chmod(0600)
+	f.WriteString(strings.Join(history, "\n") + "\n")
+}
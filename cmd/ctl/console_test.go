@@ -0,0 +1,117 @@
+package ctl
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+// TestSplitArgs ensures words are split at spaces with quoted words kept whole
+func TestSplitArgs(
+	t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"", nil},
+		{"  getblockcount ", []string{"getblockcount"}},
+		{`sendmany "" {"Paddr":1}`, []string{"sendmany", "", `{"Paddr":1}`}},
+		{`getbalance 'my account' 6`, []string{"getbalance", "my account", "6"}},
+	}
+	for _, test := range tests {
+		got, err := splitArgs(test.line)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("splitArgs(%q) = %q, %v, expected %q", test.line, got, err, test.want)
+		}
+	}
+	if _, err := splitArgs(`getbalance "account`); err == nil {
+		t.Error("splitArgs of an unclosed quote did not fail")
+	}
+}
+// TestNamedParams ensures named arguments go to their parameters and the others fill the parameters in order
+func TestNamedParams(
+	t *testing.T) {
+	tests := []struct {
+		args []string
+		want []interface{}
+		fail bool
+	}{
+		{[]string{"hash"}, []interface{}{"hash"}, false},
+		{[]string{"verbose=false", "hash"}, []interface{}{"hash", "false"}, false},
+		{[]string{"verbosetx=true", "hash", "false"}, []interface{}{"hash", "false", "true"}, false},
+		{[]string{"verbosetx=true", "hash"}, nil, true},
+		{[]string{"hash", "hash=other"}, nil, true},
+	}
+	for _, test := range tests {
+		got, err := namedParams("getblock", test.args)
+		if (err != nil) != test.fail || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("namedParams(getblock, %q) = %v, %v", test.args, got, err)
+		}
+	}
+}
+// TestComplete ensures commands, builtins and parameter names are completed as far as the candidates agree
+func TestComplete(
+	t *testing.T) {
+	tests := []struct {
+		line       string
+		pos        int
+		wallet     bool
+		want       string
+		candidates int
+	}{
+		{"getblockco", 10, false, "getblockcount ", 1},
+		{"getblockh", 9, false, "getblockh", 2},
+		{"us", 2, false, "us", 2},
+		{"usa", 3, false, "usage ", 1},
+		{"use w", 5, false, "use wallet ", 1},
+		{"getblock abc v", 14, false, "getblock abc verbose", 2},
+		{"getblock abc verbosetx=true", 18, false, "getblock abc verbosetx=true", 0},
+		{"getblock abc verbosetx=true", 27, false, "getblock abc verbosetx=true", 0},
+		{"walletpassph", 12, false, "walletpassph", 0},
+		{"walletpassphrasech", 18, true, "walletpassphrasechange ", 1},
+	}
+	for _, test := range tests {
+		line, pos, candidates := complete(test.line, test.pos, test.wallet)
+		if line != test.want || len(candidates) != test.candidates {
+			t.Errorf("complete(%q, %d) = %q with candidates %v, expected %q with %d", test.line, test.pos, line, candidates, test.want, test.candidates)
+		}
+		if test.candidates > 0 && pos != len(line) {
+			t.Errorf("complete(%q, %d) left the cursor at %d", test.line, test.pos, pos)
+		}
+	}
+}
+// TestHistory ensures the history file can only be read by the user and the lines of secret commands are left out of it
+func TestHistory(
+	t *testing.T) {
+	dir, err := ioutil.TempDir("", "ctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ctl_history")
+	// a history file of an earlier version, readable by everyone and holding a passphrase
+	old := "getblockcount\nwalletpassphrase secret 60\n\"importprivkey\" key\ngetinfo\n"
+	if err = ioutil.WriteFile(path, []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+	history := loadHistory(path)
+	if want := []string{"getblockcount", "getinfo"}; !reflect.DeepEqual(history, want) {
+		t.Errorf("loadHistory = %q, expected %q", history, want)
+	}
+	saveHistory(path, history)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("history file has mode %v, expected 0600", mode)
+	}
+	for _, line := range []string{"dumpprivkey Paddr", "WalletPassphraseChange a b", "  encryptwallet x"} {
+		if !isSecret(line) {
+			t.Errorf("%q is not secret", line)
+		}
+	}
+	if isSecret("getwalletinfo") {
+		t.Error("getwalletinfo is secret")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"git.parallelcoin.io/dev/9/cmd/nine"
 	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+	"git.parallelcoin.io/dev/9/pkg/util"
 	"github.com/btcsuite/go-socks/socks"
 )
 // newHTTPClient returns a new HTTP client that is configured according to the proxy and TLS settings in the associated connection configuration.
@@ -39,10 +40,26 @@ func newHTTPClient(cfg *nine.Config) (*http.Client, error) {
 		}
 		pool := x509.NewCertPool()
 		pool.AppendCertsFromPEM(pem)
+		// Servers with certificates issued by the certificate authority are trusted too.
+		if cfg.CAFile != nil && util.FileExists(*cfg.CAFile) {
+			if pem, err = ioutil.ReadFile(*cfg.CAFile); err != nil {
+				return nil, err
+			}
+			pool.AppendCertsFromPEM(pem)
+		}
 		tlsConfig = &tls.Config{
 			RootCAs:            pool,
 			InsecureSkipVerify: *cfg.TLSSkipVerify,
 		}
+		// Present the client certificate to servers that require one.
+		if cfg.ClientCert != nil && cfg.ClientKey != nil &&
+			util.FileExists(*cfg.ClientCert) && util.FileExists(*cfg.ClientKey) {
+			keypair, err := tls.LoadX509KeyPair(*cfg.ClientCert, *cfg.ClientKey)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{keypair}
+		}
 	}
 	// Create and return the new HTTP client potentially configured with a proxy and TLS.
 	client := http.Client{
@@ -53,15 +70,28 @@ func newHTTPClient(cfg *nine.Config) (*http.Client, error) {
 	}
 	return &client, nil
 }
-// sendPostRequest sends the marshalled JSON-RPC command using HTTP-POST mode to the server described in the passed config struct.  It also attempts to unmarshal the response as a JSON-RPC response and returns either the result field or the error field depending on whether or not there is an error.
-func sendPostRequest(marshalledJSON []byte, cfg *nine.Config) ([]byte, error) {
+// Call sends the command method with its params to the wallet server if wallet is true or otherwise to the full node, and returns the result. The params are converted to the types of the command the way the arguments of ctl are.
+func Call(
+	cfg *nine.Config, wallet bool, method string, params ...interface{}) (js.RawMessage, error) {
+	cmd, err := json.NewCmd(method, params...)
+	if err != nil {
+		return nil, err
+	}
+	marshalledJSON, err := json.MarshalCmd(1, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return sendPostRequest(marshalledJSON, cfg, wallet)
+}
+// sendPostRequest sends the marshalled JSON-RPC command using HTTP-POST mode to the wallet server if wallet is true or otherwise the full node described in the passed config struct.  It also attempts to unmarshal the response as a JSON-RPC response and returns either the result field or the error field depending on whether or not there is an error.
+func sendPostRequest(marshalledJSON []byte, cfg *nine.Config, wallet bool) ([]byte, error) {
 	// Generate a request to the configured RPC server.
 	protocol := "http"
 	if !*cfg.NoTLS {
 		protocol = "https"
 	}
 	serverAddr := *cfg.RPCConnect
-	if *cfg.Wallet {
+	if wallet {
 		serverAddr = *cfg.WalletServer
 	}
 	url := protocol + "://" + serverAddr
@@ -18,23 +18,13 @@ func Main(
 	args []string,
 	cfg *nine.Config,
 ) {
-	// Ensure the specified method identifies a valid registered command and is one of the usable types.
-	method := "help"
-	if len(args) >= 1 {
-		method = args[0]
-	} else {
-		args = []string{method}
-		fmt.Println("ERROR: no command given", args)
-		fmt.Print("commands available from ")
-		if *cfg.Wallet {
-			fmt.Printf("wallet server @ %s\n", *cfg.WalletServer)
-		} else {
-			fmt.Printf("full node @ %s\n", *cfg.RPCConnect)
-		}
-		fmt.Println()
-		ListCommands()
+	// Without a command the console is opened to enter commands interactively.
+	if len(args) == 0 {
+		Console(cfg)
 		return
 	}
+	// Ensure the specified method identifies a valid registered command and is one of the usable types.
+	method := args[0]
 	usageFlags, err := json.MethodUsageFlags(method)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unrecognized command '%s'\n", method)
@@ -97,31 +87,38 @@ func Main(
 		os.Exit(1)
 	}
 	// Send the JSON-RPC request to the server using the user-specified connection configuration.
-	result, err := sendPostRequest(marshalledJSON, cfg)
+	result, err := sendPostRequest(marshalledJSON, cfg, *cfg.Wallet)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	if err = printResult(os.Stdout, result); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+// printResult writes the result of a command to w, indenting objects and arrays, unquoting strings and writing nothing for null.
+func printResult(
+	w io.Writer, result []byte) error {
 	// Choose how to display the result based on its type.
 	strResult := string(result)
 	switch {
 	case strings.HasPrefix(strResult, "{") || strings.HasPrefix(strResult, "["):
 		var dst bytes.Buffer
 		if err := js.Indent(&dst, result, "", "  "); err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to format result: %v", err)
-			os.Exit(1)
+			return fmt.Errorf("Failed to format result: %v", err)
 		}
-		fmt.Println(dst.String())
+		fmt.Fprintln(w, dst.String())
 	case strings.HasPrefix(strResult, `"`):
 		var str string
 		if err := js.Unmarshal(result, &str); err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to unmarshal result: %v", err)
-			os.Exit(1)
+			return fmt.Errorf("Failed to unmarshal result: %v", err)
 		}
-		fmt.Println(str)
+		fmt.Fprintln(w, str)
 	case strResult != "null":
-		fmt.Println(strResult)
+		fmt.Fprintln(w, strResult)
 	}
+	return nil
 }
 // commandUsage display the usage for a specific command.
 func commandUsage(
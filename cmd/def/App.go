@@ -22,6 +22,10 @@ type App struct {
 	Commands Commands
 	Config   *nine.Config
 	Started  chan struct{}
+	// LoadedVersion is the version of the schema of the configuration file that was loaded, before it was migrated
+	LoadedVersion int
+	// Problems are the lines of the loaded configuration file that are not rows or have invalid values
+	Problems []string
 }
 
 // AppGenerator is a function that configures an App
@@ -59,13 +63,15 @@ func (r *App) SaveConfig() {
 // MarshalJSON cherrypicks Cats for the values needed to correctly configure it
 // and some extra information to make the JSON output friendly to human editors
 func (r *App) MarshalJSON() ([]byte, error) {
-	out := make(CatsJSON)
+	out := make(map[string]interface{})
+	out[VersionKey] = ConfigVersion()
 	for i, x := range r.Cats {
-		out[i] = make(CatJSON)
+		cat := make(CatJSON)
+		out[i] = cat
 		for j, y := range x {
 			min, _ := y.Min.Get().(int)
 			max, _ := y.Max.Get().(int)
-			out[i][j] = Line{
+			cat[j] = Line{
 				Value:   y.Value.Get(),
 				Default: y.Default.Get(),
 				Min:     min,
@@ -78,36 +84,82 @@ func (r *App) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON takes the cherrypicked JSON output of Marshal and puts it back into
-// an App
+// an App, after migrating it to the current version of the schema. Lines that are not rows of the App and values that are not valid are skipped and listed in Problems.
 func (r *App) UnmarshalJSON(data []byte) error {
-	out := make(CatsJSON)
-	e := json.Unmarshal(data, &out)
+	raw := make(map[string]json.RawMessage)
+	e := json.Unmarshal(data, &raw)
 	if e != nil {
 		return e
 	}
-	for i, x := range out {
-		for j, y := range x {
-			R := r.Cats[i][j]
+	version := 0
+	if v, ok := raw[VersionKey]; ok {
+		if e = json.Unmarshal(v, &version); e != nil {
+			return fmt.Errorf("configuration schema version: %v", e)
+		}
+		delete(raw, VersionKey)
+	}
+	out := make(CatsJSON)
+	for i, x := range raw {
+		cat := make(CatJSON)
+		if e = json.Unmarshal(x, &cat); e != nil {
+			return fmt.Errorf("configuration group %s: %v", i, e)
+		}
+		out[i] = cat
+	}
+	if e = Migrate(out, version); e != nil {
+		return e
+	}
+	r.LoadedVersion = version
+	r.Problems = nil
+	for _, i := range out.GetSortedKeys() {
+		x := out[i]
+		if _, ok := r.Cats[i]; !ok {
+			r.Problems = append(r.Problems, fmt.Sprintf("unknown group %s", i))
+			continue
+		}
+		for _, j := range x.GetSortedKeys() {
+			y := x[j]
+			R, ok := r.Cats[i][j]
+			if !ok {
+				r.Problems = append(r.Problems, fmt.Sprintf("unknown row %s.%s", i, j))
+				continue
+			}
 			if y.Value != nil {
 				switch R.Type {
 				case "int", "port":
-					y.Value = int(y.Value.(float64))
+					// JSON numbers are decoded as float64, anything else is left for the validator to reject
+					if f, ok := y.Value.(float64); ok {
+						y.Value = int(f)
+					}
 				case "duration":
-					y.Value = time.Duration(int(y.Value.(float64)))
+					if f, ok := y.Value.(float64); ok {
+						y.Value = time.Duration(int(f))
+					}
 				case "stringslice":
-					rt, ok := y.Value.([]string)
+					// JSON arrays are decoded as []interface{}
+					rt, ok := y.Value.([]interface{})
 					ro := []string{}
 					if ok {
 						for _, z := range rt {
-							R.Validate(R, z)
-							ro = append(ro, z)
+							if zs, ok := z.(string); ok {
+								ro = append(ro, zs)
+							}
 						}
-						R.Value.Put(ro)
+						y.Value = ro
 					}
 					// case "float":
 				}
 			}
-			R.Validate(R, y.Value)
+			// validators save the configuration, which must not happen while it is only partly loaded
+			app := R.App
+			R.App = nil
+			valid := y.Value == nil || R.Validate(R, y.Value)
+			R.App = app
+			if !valid {
+				r.Problems = append(r.Problems,
+					fmt.Sprintf("invalid value %v for %s.%s, keeping %v", y.Value, i, j, R.Value.Get()))
+				continue
+			}
 			R.Value.Put(y.Value)
 		}
 	}
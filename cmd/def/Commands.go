@@ -18,6 +18,8 @@ type Command struct {
 	Opts      Optional
 	Precedent Precedent
 	Handler   CommandHandler
+	// Rest is set when the arguments after the command are its own and are not matched against the other commands
+	Rest bool
 }
 
 // CommandGenerator is a function that configures a Command
@@ -0,0 +1,88 @@
+package def
+
+import "fmt"
+
+// VersionKey is the key of the version of the schema in a configuration file, beside the groups
+const VersionKey = "version"
+
+// Migration upgrades a configuration file from the version before it to its own version
+type Migration struct {
+	Description string
+	Migrate     func(cats CatsJSON)
+}
+
+// Migrations are the upgrades of the configuration file in order. The version of a file is the number of migrations that have been run on it, a file without a version is version 0. New migrations are only ever appended.
+var Migrations = []Migration{
+	{
+		Description: "add the version of the schema",
+		Migrate:     func(cats CatsJSON) {},
+	},
+	{
+		Description: "mining.listener is one address instead of a list",
+		Migrate: func(cats CatsJSON) {
+			FirstOfList(cats, "mining", "listener")
+		},
+	},
+}
+
+// ConfigVersion returns the version of the schema of the configuration files written by this build
+func ConfigVersion() int {
+	return len(Migrations)
+}
+
+// Migrate runs the migrations after version on cats in order, a version newer than ConfigVersion can't be read
+func Migrate(cats CatsJSON, version int) error {
+	if version < 0 || version > ConfigVersion() {
+		return fmt.Errorf(
+			"configuration schema version %d is not supported, the newest known version is %d",
+			version, ConfigVersion())
+	}
+	for _, x := range Migrations[version:] {
+		x.Migrate(cats)
+	}
+	return nil
+}
+
+// RenameRow moves the line of a row to a new group and name, for migrations
+func RenameRow(cats CatsJSON, group, name, newGroup, newName string) {
+	line, ok := cats[group][name]
+	if !ok {
+		return
+	}
+	delete(cats[group], name)
+	if len(cats[group]) == 0 {
+		delete(cats, group)
+	}
+	if _, ok := cats[newGroup]; !ok {
+		cats[newGroup] = make(CatJSON)
+	}
+	cats[newGroup][newName] = line
+}
+
+// RemoveRow deletes the line of a row that no longer exists, for migrations
+func RemoveRow(cats CatsJSON, group, name string) {
+	delete(cats[group], name)
+	if len(cats[group]) == 0 {
+		delete(cats, group)
+	}
+}
+
+// FirstOfList replaces the value and default of a row that was a list with their first element, or nothing if the list was empty, for migrations
+func FirstOfList(cats CatsJSON, group, name string) {
+	line, ok := cats[group][name]
+	if !ok {
+		return
+	}
+	first := func(in interface{}) interface{} {
+		list, ok := in.([]interface{})
+		if !ok {
+			return in
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		return list[0]
+	}
+	line.Value, line.Default = first(line.Value), first(line.Default)
+	cats[group][name] = line
+}
@@ -0,0 +1,150 @@
+package gui
+import (
+	js "encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+)
+// maxBody is the most a request to the API can send
+const maxBody = 1 << 20
+// rpcRequest is a command for the wallet server sent by the pages, its parameters are strings converted to the types of the command the way the arguments of ctl are
+type rpcRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+}
+// Overview is the balance and transactions of the wallet shown on the home, send and history pages
+type Overview struct {
+	Balance                 float64                       `json:"balance"`
+	Unconfirmed             float64                       `json:"unconfirmed"`
+	Total                   float64                       `json:"total"`
+	ListTransactions        []json.ListTransactionsResult `json:"listtransactions"`
+	ListAllTransactions     []json.ListTransactionsResult `json:"listalltransactions"`
+	ListAllSendTransactions []json.ListTransactionsResult `json:"listallsendtransactions"`
+}
+// decode reads the JSON body of a request into v, only requests that change something are posted
+func decode(
+	r *http.Request, v interface{}) error {
+	if r.Method != http.MethodPost {
+		return errors.New("expected a POST request")
+	}
+	return js.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBody)).Decode(v)
+}
+// handleRPC sends a command from the pages to the wallet server and returns its result
+func (s *Server) handleRPC(
+	r *http.Request) (interface{}, error) {
+	var req rpcRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	params := make([]interface{}, len(req.Params))
+	for i, x := range req.Params {
+		params[i] = x
+	}
+	return s.call(req.Method, params...)
+}
+// handleOverview returns the balance and transactions of the wallet
+func (s *Server) handleOverview(
+	r *http.Request) (interface{}, error) {
+	var o Overview
+	if err := s.callInto(&o.Balance, "getbalance"); err != nil {
+		return nil, err
+	}
+	if err := s.callInto(&o.Unconfirmed, "getunconfirmedbalance"); err != nil {
+		return nil, err
+	}
+	o.Total = o.Balance + o.Unconfirmed
+	if err := s.callInto(&o.ListTransactions, "listtransactions", "*", 10); err != nil {
+		return nil, err
+	}
+	if err := s.callInto(&o.ListAllTransactions, "listalltransactions"); err != nil {
+		return nil, err
+	}
+	o.ListAllSendTransactions = []json.ListTransactionsResult{}
+	for _, x := range o.ListAllTransactions {
+		if x.Category == "send" {
+			o.ListAllSendTransactions = append(o.ListAllSendTransactions, x)
+		}
+	}
+	return &o, nil
+}
+// callInto sends a command to the wallet server and decodes its result into v
+func (s *Server) callInto(
+	v interface{}, method string, params ...interface{}) error {
+	result, err := s.call(method, params...)
+	if err != nil {
+		return err
+	}
+	return js.Unmarshal(result, v)
+}
+// handleAddressBook returns the address book, a post stores or with an empty address deletes a label
+func (s *Server) handleAddressBook(
+	r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodPost {
+		var l Label
+		if err := decode(r, &l); err != nil {
+			return nil, err
+		}
+		if l.Label = strings.TrimSpace(l.Label); l.Label == "" {
+			return nil, errors.New("a label is needed")
+		}
+		if l.Address != "" {
+			if err := s.validAddress(l.Address); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.store.setLabel(l); err != nil {
+			return nil, err
+		}
+	}
+	labels, err := s.store.labels()
+	return map[string][]Label{"labels": labels}, err
+}
+// handleRequests returns the payments requested, a post adds one
+func (s *Server) handleRequests(
+	r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodPost {
+		var p Request
+		if err := decode(r, &p); err != nil {
+			return nil, err
+		}
+		if err := s.validAddress(p.Address); err != nil {
+			return nil, err
+		}
+		p.Time = time.Now().Unix()
+		if err := s.store.addRequest(p); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.requests()
+}
+// handleConf returns the settings of the interface, a post changes them
+func (s *Server) handleConf(
+	r *http.Request) (interface{}, error) {
+	if r.Method == http.MethodPost {
+		var c Conf
+		if err := decode(r, &c); err != nil {
+			return nil, err
+		}
+		if !hasLanguage(c.Interface.Lang) {
+			return nil, errors.New("no translation to " + c.Interface.Lang)
+		}
+		if err := s.store.setConf(c); err != nil {
+			return nil, err
+		}
+	}
+	return s.store.conf()
+}
+// validAddress asks the wallet server whether address is an address of its network
+func (s *Server) validAddress(
+	address string) error {
+	var v json.ValidateAddressWalletResult
+	if err := s.callInto(&v, "validateaddress", address); err != nil {
+		return err
+	}
+	if !v.IsValid {
+		return errors.New("invalid address " + address)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// +build ignore
+
+// genwww packs the files of the www directory into wwwdata.go, run it with go generate after changing them
+package main
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/gob"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+// file is a file of the www directory, they are packed in order so the output only changes with them
+type file struct {
+	Name    string
+	Content []byte
+}
+func main() {
+	var files []file
+	err := filepath.Walk("www", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return err
+		}
+		name, err := filepath.Rel("www", path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		files = append(files, file{filepath.ToSlash(name), content})
+		return err
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var packed bytes.Buffer
+	w, _ := zlib.NewWriterLevel(&packed, zlib.BestCompression)
+	if err = gob.NewEncoder(w).Encode(files); err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	out := fmt.Sprintf("package gui\n// Auto-generated file (see genwww.go)\n// DO NOT EDIT\nvar wwwData = %q\n",
+		base64.StdEncoding.EncodeToString(packed.Bytes()))
+	if err = ioutil.WriteFile("wwwdata.go", []byte(out), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -1,7 +1,123 @@
+// Package gui serves the wallet GUI to the browser from a HTTP server on the loopback interface. The pages talk to the wallet server over its RPC, which passes the commands it doesn't know on to the full node, and keep the address book, payment requests and settings of the interface in their own directory.
 package gui
 import (
-	"git.parallelcoin.io/dev/9/cmd"
+	"crypto/rand"
+	"encoding/hex"
+	js "encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"git.parallelcoin.io/dev/9/cmd/ctl"
+	"git.parallelcoin.io/dev/9/cmd/nine"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
 )
-func Start(args []string, tokens cmd.Tokens, app *cmd.App) int {
-	return 0
+// TokenHeader is the header the pages send the token of the server in with every request to the API, requests without it are refused so that other sites open in the browser can't use the wallet
+const TokenHeader = "X-Gui-Token"
+// TokenParam is the query parameter of the address of the index page that carries the token, the page is only served with it so that only who was given the address can use the wallet
+const TokenParam = "token"
+// Caller sends a command to the wallet server and returns its result
+type Caller func(method string, params ...interface{}) (js.RawMessage, error)
+// Server serves the pages of the GUI and the API they use
+type Server struct {
+	call     Caller
+	store    *store
+	token    string
+	host     string
+	listener net.Listener
+	http     *http.Server
+}
+// New returns a server for the GUI that calls the wallet server configured in cfg and keeps its own data in dir
+func New(
+	cfg *nine.Config, dir string) (*Server, error) {
+	return NewWithCaller(func(method string, params ...interface{}) (js.RawMessage, error) {
+		return ctl.Call(cfg, true, method, params...)
+	}, dir)
+}
+// NewWithCaller returns a server for the GUI that sends its commands through call and keeps its own data in dir
+func NewWithCaller(
+	call Caller, dir string) (*Server, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	return &Server{
+		call:  call,
+		store: &store{dir: dir},
+		token: hex.EncodeToString(token),
+	}, nil
+}
+// Start listens on listen, which must be a loopback address, and serves the GUI until Stop is called. It returns the address to open in the browser, which carries the token of the server.
+func (s *Server) Start(
+	listen string) (url string, err error) {
+	host, _, err := net.SplitHostPort(listen)
+	if err != nil {
+		return
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return "", errors.New("the GUI can only listen on a loopback address, not " + listen)
+	}
+	if s.listener, err = net.Listen("tcp", listen); err != nil {
+		return
+	}
+	s.host = s.listener.Addr().String()
+	s.http = &http.Server{Handler: s.Handler()}
+	go func() {
+		if err := s.http.Serve(s.listener); err != http.ErrServerClosed {
+			log <- cl.Error{"GUI server stopped:", err}
+		}
+	}()
+	url = "http://" + s.host + "/?" + TokenParam + "=" + s.token
+	log <- cl.Info{"GUI listening on", s.host}
+	return
+}
+// Stop shuts down the server
+func (s *Server) Stop() {
+	if s.http != nil {
+		s.http.Close()
+	}
+}
+// Handler returns the handler of the pages and the API of the server
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handlePage)
+	mux.HandleFunc("/vuedata.js", s.handleVueData)
+	mux.HandleFunc("/api/rpc", s.api(s.handleRPC))
+	mux.HandleFunc("/api/overview", s.api(s.handleOverview))
+	mux.HandleFunc("/api/addressbook", s.api(s.handleAddressBook))
+	mux.HandleFunc("/api/requests", s.api(s.handleRequests))
+	mux.HandleFunc("/api/conf", s.api(s.handleConf))
+	return s.checkHost(mux)
+}
+// checkHost refuses requests for any other host than the address the server listens on, so that a site can't reach the server through a name of its own that resolves to the loopback address
+func (s *Server) checkHost(
+	next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.host != "" && r.Host != s.host {
+			http.Error(w, "unknown host", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+// api wraps a handler of the API that returns a value to be sent as JSON, refusing requests without the token of the server
+func (s *Server) api(
+	handler func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(TokenHeader) != s.token {
+			http.Error(w, "missing or wrong token", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		result, err := handler(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			js.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		js.NewEncoder(w).Encode(result)
+	}
 }
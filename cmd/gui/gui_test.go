@@ -0,0 +1,110 @@
+package gui
+import (
+	"bytes"
+	js "encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+// stubCaller answers validateaddress with the addresses starting with P being valid and records the other commands
+type stubCaller struct {
+	method string
+	params []interface{}
+}
+func (c *stubCaller) call(
+	method string, params ...interface{}) (js.RawMessage, error) {
+	if method == "validateaddress" {
+		valid := strings.HasPrefix(params[0].(string), "P")
+		return js.Marshal(map[string]bool{"isvalid": valid})
+	}
+	c.method, c.params = method, params
+	if method == "fail" {
+		return nil, errors.New("failed")
+	}
+	return js.RawMessage(`"ok"`), nil
+}
+// do sends a request to the handler of s as the page would and returns the status and body of the response
+func do(
+	t *testing.T, s *Server, host, path, token string, body interface{}) (int, string) {
+	method, in := http.MethodGet, []byte(nil)
+	if body != nil {
+		method = http.MethodPost
+		var err error
+		if in, err = js.Marshal(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := httptest.NewRequest(method, "http://"+host+path, bytes.NewReader(in))
+	if token != "" {
+		r.Header.Set(TokenHeader, token)
+	}
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, r)
+	out, _ := ioutil.ReadAll(w.Result().Body)
+	return w.Code, string(out)
+}
+// TestServer ensures the API refuses requests without the token or for another host, passes commands on to the wallet server and keeps the address book
+func TestServer(
+	t *testing.T) {
+	dir, err := ioutil.TempDir("", "gui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c := &stubCaller{}
+	s, err := NewWithCaller(c.call, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.host = "127.0.0.1:11049"
+	if code, _ := do(t, s, s.host, "/api/conf", "", nil); code != http.StatusForbidden {
+		t.Errorf("got status %d without the token, expected %d", code, http.StatusForbidden)
+	}
+	if code, _ := do(t, s, "rebound.example:11049", "/api/conf", s.token, nil); code != http.StatusForbidden {
+		t.Errorf("got status %d for another host, expected %d", code, http.StatusForbidden)
+	}
+	if code, _ := do(t, s, s.host, "/", "", nil); code != http.StatusForbidden {
+		t.Errorf("got status %d for the index page without the token, expected %d", code, http.StatusForbidden)
+	}
+	code, body := do(t, s, s.host, "/?"+TokenParam+"="+s.token, "", nil)
+	if code != http.StatusOK || !strings.Contains(body, s.token) {
+		t.Errorf("got status %d for the index page, expected it with the token", code)
+	}
+	if _, body = do(t, s, s.host, "/vuedata.js", "", nil); !strings.Contains(body, `"home"`) {
+		t.Error("vuedata.js does not have the home page")
+	}
+	code, body = do(t, s, s.host, "/api/rpc", s.token,
+		rpcRequest{Method: "getbalance", Params: []string{"*", "1"}})
+	if code != http.StatusOK || body != "\"ok\"\n" {
+		t.Errorf("got %d %s from rpc", code, body)
+	}
+	if c.method != "getbalance" || !reflect.DeepEqual(c.params, []interface{}{"*", "1"}) {
+		t.Errorf("sent %s %v, expected getbalance [* 1]", c.method, c.params)
+	}
+	if code, body = do(t, s, s.host, "/api/rpc", s.token, rpcRequest{Method: "fail"}); code != http.StatusBadRequest || !strings.Contains(body, "failed") {
+		t.Errorf("got %d %s for a failing command, expected the error", code, body)
+	}
+	if code, _ = do(t, s, s.host, "/api/addressbook", s.token, Label{"loki", "invalid"}); code != http.StatusBadRequest {
+		t.Errorf("got status %d storing an invalid address, expected %d", code, http.StatusBadRequest)
+	}
+	for _, l := range []Label{{"loki", "Paddress1"}, {"thor", "Paddress2"}, {"loki", ""}} {
+		if code, body = do(t, s, s.host, "/api/addressbook", s.token, l); code != http.StatusOK {
+			t.Fatalf("got %d %s storing %v", code, body, l)
+		}
+	}
+	var book map[string][]Label
+	if err = js.Unmarshal([]byte(body), &book); err != nil {
+		t.Fatal(err)
+	}
+	if want := []Label{{"thor", "Paddress2"}}; !reflect.DeepEqual(book["labels"], want) {
+		t.Errorf("got address book %v, expected %v", book["labels"], want)
+	}
+	if code, _ = do(t, s, s.host, "/api/conf", s.token, Conf{InterfaceConf{"xx"}}); code != http.StatusBadRequest {
+		t.Errorf("got status %d saving a language without a translation", code)
+	}
+}
@@ -1,7 +0,0 @@
-var BlocksC = {
-  template: vuedata.data.pages.blocks,
-  props:{
-    vlng:Object,
-    vicons:Object,
-  }
-}
\ No newline at end of file
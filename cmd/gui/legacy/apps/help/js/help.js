@@ -1,14 +0,0 @@
-var HelpC = {
-  template: vuedata.data.pages.help,
-  props:{
-    vlng:Object,
-    vicons:Object,
-  },
-  data(){
-      return{
-        activeTab: 0,
-      }
-  }
-  
-
-}
\ No newline at end of file
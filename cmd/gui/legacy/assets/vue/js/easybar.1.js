@@ -1,5 +0,0 @@
-/*!
-* easy-bar.js v1.0.4
-* (c) 2018-2018 PengYuan-Jiang
-*/
-!function(e,l){"object"==typeof exports&&"undefined"!=typeof module?module.exports=l():"function"==typeof define&&define.amd?define(l):e.EasyBar=l()}(this,function(){"use strict";var o="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},t=function(e,l){if(!(e instanceof l))throw new TypeError("Cannot call a class as a function")},e=function(){function r(e,l){for(var o=0;o<l.length;o++){var r=l[o];r.enumerable=r.enumerable||!1,r.configurable=!0,"value"in r&&(r.writable=!0),Object.defineProperty(e,r.key,r)}}return function(e,l,o){return l&&r(e.prototype,l),o&&r(e,o),e}}(),s=function(){function s(){t(this,s)}return e(s,null,[{key:"hasClass",value:function(e,l){return e.classList?e.classList.contains(l):new RegExp("\\b"+l+"\\b").test(e.className)}},{key:"addClass",value:function(l){for(var e=arguments.length,o=Array(1<e?e-1:0),r=1;r<e;r++)o[r-1]=arguments[r];o&&0<o.length&&o.forEach(function(e){e&&(l.classList?l.classList.add(e):s.hasClass(l,e)||(l.className+=" "+e))})}},{key:"removeClass",value:function(l){for(var e=arguments.length,o=Array(1<e?e-1:0),r=1;r<e;r++)o[r-1]=arguments[r];o&&0<o.length&&o.forEach(function(e){e&&(l.classList?l.classList.remove(e):l.className=l.className.replace(new RegExp("\\b"+e+"\\b","g"),""))})}},{key:"compatStyle",value:function(e,l,o){var r=l.slice(0,1).toUpperCase()+l.substring(1);e.style["webkit"+r]=o,e.style["moz"+r]=o,e.style["ms"+r]=o,e.style["o"+r]=o,e.style[l]=o}},{key:"style",value:function(e,l,o){e.style[l]!=o&&(e.style[l]=o)}},{key:"debounce",value:function(r,s,t){var a=null;return function(){var e=this,l=arguments;clearTimeout(a);var o=s?s.apply(e,l):void 0;if(o?a=setTimeout(function(){r.apply(e,l)},o):r.apply(e,l),t)return t.apply(e,l)}}},{key:"throttle",value:function(s,t,a){var i,n;return function(){var e=this,l=+new Date,o=arguments,r=t?t.apply(e,o):void 0;if(i&&r&&l<i+r?(clearTimeout(n),n=setTimeout(function(){i=l,s.apply(e,o)},r)):(i=l,s.apply(e,o)),a)return a.apply(e,o)}}}]),s}(),a={resizeRefresh:!0,barfloat:!0,preventParentScroll:!1,scrollBarBehavior:null,scrollThrottle:10,draggerThrottle:10,observerThrottle:200,resizeDebounce:200,scrollingPhantomDelay:1e3,draggingPhantomDelay:1e3,clsBox:"",clsBoxScrolling:"",clsBoxScrollingPhantom:"",clsBoxDragging:"",clsBoxDraggingPhantomClass:"",clsBoxVisibleBarV:"",clsBoxInvisibleBarV:"",clsBoxVisibleBarH:"",clsBoxInvisibleBarH:"",clsBoxClip:"",clsContent:"",clsBarV:"",clsBarH:"",clsTrack:"",clsThumb:""},i={clsBox:"eb",clsBoxScrolling:"eb-scrolling",clsBoxScrollingPhantom:"eb-scrolling-phantom",clsBoxDragging:"eb-dragging",clsBoxDraggingPhantomClass:"eb-dragging-phantom",clsBoxVisibleBarV:"eb-visible-v",clsBoxInvisibleBarV:"eb-invisible-v",clsBoxVisibleBarH:"eb-visible-h",clsBoxInvisibleBarH:"eb-invisible-h",clsBoxClip:"eb-clip",clsContent:"eb-content",clsBarV:"eb-bar-v",clsBarH:"eb-bar-h",clsTrack:"eb-track",clsThumb:"eb-thumb"};!function(e,l){void 0===l&&(l={});var o=l.insertAt;if(e&&"undefined"!=typeof document){var r=document.head||document.getElementsByTagName("head")[0],s=document.createElement("style");s.type="text/css","top"===o&&r.firstChild?r.insertBefore(s,r.firstChild):r.appendChild(s),s.styleSheet?s.styleSheet.cssText=e:s.appendChild(document.createTextNode(e))}}(".hide-native-bar::-webkit-scrollbar{display:none}.hide-native-bar::-o-scrollbar{display:none}.hide-native-bar{-ms-overflow-style:none}.eb>.eb-bar-h,.eb>.eb-bar-v{z-index:1;-webkit-box-sizing:border-box;box-sizing:border-box}.eb>.eb-bar-v{left:auto;top:0;right:0;bottom:0;width:16px;padding:4px}.eb.eb-visible-h>.eb-bar-v{bottom:8px}.eb>.eb-bar-h{left:0;top:auto;right:0;bottom:0;height:16px;padding:4px}.eb.eb-visible-v>.eb-bar-h{right:8px}.eb>.eb-bar-h>.eb-track,.eb>.eb-bar-v>.eb-track{-webkit-transition:background-color .3s;transition:background-color .3s;border-radius:20px;width:100%;height:100%}.eb>.eb-bar-v>.eb-track{min-height:150px}.eb>.eb-bar-h>.eb-track{min-width:150px}.eb.eb-visible-h.eb-scrolling-phantom>.eb-bar-h .eb-track,.eb.eb-visible-h>.eb-bar-h:hover .eb-track,.eb.eb-visible-v.eb-scrolling-phantom>.eb-bar-v .eb-track,.eb.eb-visible-v>.eb-bar-v:hover .eb-track{-webkit-transition:background-color .1s;transition:background-color .1s;background-color:rgba(0,0,0,.1)}.eb>.eb-bar-v .eb-thumb{width:100%;min-height:50px}.eb>.eb-bar-h .eb-thumb{height:100%;min-width:50px}.eb.eb-visible-h>.eb-bar-h .eb-thumb,.eb.eb-visible-v>.eb-bar-v .eb-thumb{-webkit-transition:background-color .3s;transition:background-color .3s;background-color:rgba(0,0,0,.15);border-radius:20px}.eb.eb-visible-h.eb-scrolling-phantom>.eb-bar-h .eb-thumb,.eb.eb-visible-h>.eb-bar-h:hover .eb-thumb,.eb.eb-visible-v.eb-scrolling-phantom>.eb-bar-v .eb-thumb,.eb.eb-visible-v>.eb-bar-v:hover .eb-thumb{-webkit-transition:background-color .1s;transition:background-color .1s;background-color:rgba(0,0,0,.3)}");var n="hide-native-bar";function r(){var e=document.createElement("div");e.style.position="absolute",e.style.overflow="hidden";var l=document.createElement("div");l.style.position="relative",l.style.overflow="hidden";var o=document.createElement("div");return o.style.position="relative",l.appendChild(o),e.appendChild(l),{scrollBarBox:e,scrollBarTrack:l,scrollBarThumb:o}}function c(l){l.scrollHandler||(l.scrollHandler=s.throttle(function(){var e;l.isBinded&&(g(l),m(l),(e=l).scrollingClassTimeout&&clearTimeout(e.scrollingClassTimeout),s.addClass(e.scrollBox,i.clsBoxScrolling,e.config.clsBoxScrolling),e.scrollingClassTimeout=setTimeout(function(){s.removeClass(e.scrollBox,i.clsBoxScrolling,e.config.clsBoxScrolling),e.scrollingClassTimeout&&delete e.scrollingClassTimeout},e.config.scrollThrottle+5),e.scrollingPhantomClassTimeout&&clearTimeout(e.scrollingPhantomClassTimeout),s.addClass(e.scrollBox,i.clsBoxScrollingPhantom,e.config.clsBoxScrollingPhantom),e.scrollingPhantomClassTimeout=setTimeout(function(){s.removeClass(e.scrollBox,i.clsBoxScrollingPhantom,e.config.clsBoxScrollingPhantom),e.scrollingPhantomClassTimeout&&delete e.scrollingPhantomClassTimeout},e.config.scrollThrottle+e.config.scrollingPhantomDelay))},function(){return l.config.scrollThrottle}))}function B(e){e.wheelHandler&&(e.scrollCevBox.removeEventListener("wheel",e.wheelHandler,0),delete e.wheelHandler)}function h(e){e.resizeHandler&&(window.removeEventListener("resize",e.resizeHandler,0),delete e.resizeHandler)}function d(e){var l;e.scrollCevBox||(e.scrollBox=e.rootEl,e.scrollBoxClip=document.createElement("div"),e.scrollCev=e.rootEl.firstElementChild,e.scrollCevBox=document.createElement("div"),s.addClass(e.scrollBox,i.clsBox,e.config.clsBox),e.scrollBox.style.position="relative",e.scrollBox.style.overflow="hidden",s.addClass(e.scrollBoxClip,i.clsBoxClip,e.config.clsBoxClip),e.scrollBoxClip.style.height="100%",e.scrollBoxClip.style.width="100%",e.scrollBoxClip.style.overflow="hidden",e.scrollBoxClip.style.boxSizing="border-box",s.addClass(e.scrollCevBox,i.clsContent,e.config.clsContent),s.addClass(e.scrollCevBox,n),e.scrollCevBox.style.display="block",e.scrollCevBox.style.overflow="hidden",e.scrollCevBox.style.height="100%",e.scrollCevBox.style.width="100%",e.scrollBox.removeChild(e.scrollCev),e.scrollCevBox.appendChild(e.scrollCev),e.scrollBoxClip.appendChild(e.scrollCevBox),e.scrollBox.appendChild(e.scrollBoxClip),e.scrollCevBox.addEventListener("scroll",e.scrollHandler,0),e.mutationObserver=(l=e,("undefined"==typeof MutationObserver?"undefined":o(MutationObserver))===o(void 0)?null:new MutationObserver(s.throttle(function(){l.isBinded&&f(l)},function(){return l.config.observerThrottle}))),e.mutationObserver&&e.mutationObserver.observe(e.scrollCevBox,{childList:!0,characterData:!0,subtree:!0}))}function u(e){var l=void 0;e.barBehavior.vBarEnable&&e.barBehavior.vBarShow?(e.vBar||(l=r(),e.vBar={scrollBarBox:l.scrollBarBox,scrollBarTrack:l.scrollBarTrack,scrollBarThumb:l.scrollBarThumb},s.compatStyle(e.vBar.scrollBarBox,"userSelect","none"),e.scrollBox.appendChild(e.vBar.scrollBarBox),e.vBar.scrollBarThumb.addEventListener("mousedown",e.mouseDown,0),e.vBar.scrollBarThumb.addEventListener("touchstart",e.mouseDown,0)),s.addClass(e.vBar.scrollBarBox,i.clsBarV,e.config.clsBarV),s.addClass(e.vBar.scrollBarTrack,i.clsTrack,e.config.clsTrack),s.addClass(e.vBar.scrollBarThumb,i.clsThumb,e.config.clsThumb)):v(e),e.barBehavior.hBarEnable&&e.barBehavior.hBarShow?(e.hBar||(l=r(),e.hBar={scrollBarBox:l.scrollBarBox,scrollBarTrack:l.scrollBarTrack,scrollBarThumb:l.scrollBarThumb},s.compatStyle(e.hBar.scrollBarBox,"userSelect","none"),e.scrollBox.appendChild(e.hBar.scrollBarBox),e.hBar.scrollBarThumb.addEventListener("mousedown",e.mouseDown,0),e.hBar.scrollBarThumb.addEventListener("touchstart",e.mouseDown,0)),s.addClass(e.hBar.scrollBarBox,i.clsBarH,e.config.clsBarH),s.addClass(e.hBar.scrollBarTrack,i.clsTrack,e.config.clsTrack),s.addClass(e.hBar.scrollBarThumb,i.clsThumb,e.config.clsThumb)):b(e),function(e){if(e.vBar||e.hBar){var l=function(){var e=document.body,l=document.createElement("div"),o=document.createElement("div");l.className=n,l.style.position="absolute",l.style.pointerEvents="none",l.style.bottom="0",l.style.right="0",l.style.width="100px",l.style.height="100px",l.style.overflow="hidden",o.style.width="100%",o.style.height="100%",l.appendChild(o),e.appendChild(l);var r=o.offsetWidth,s=o.offsetHeight;l.style.overflow="scroll";var t=r-o.offsetWidth,a=s-o.offsetHeight;return e.removeChild(l),{v:t,h:a}}();e.vBar?(e.scrollCevBox.style.overflowY="scroll",e.scrollCevBox.style.width="calc(100% + "+l.v+"px)"):(e.scrollCevBox.style.overflowY="hidden",e.scrollCevBox.style.width="100%"),e.hBar?(e.scrollCevBox.style.overflowX="scroll",e.scrollCevBox.style.height="calc(100% + "+l.h+"px)"):(e.scrollCevBox.style.overflowX="hidden",e.scrollCevBox.style.height="100%")}else e.scrollCevBox.style.overflow="hidden",e.scrollCevBox.style.height="100%",e.scrollCevBox.style.width="100%"}(e)}function v(e){e.vBar&&(e.vBar.scrollBarThumb.removeEventListener("mousedown",e.mouseDown,0),e.vBar.scrollBarThumb.removeEventListener("touchstart",e.mouseDown,0),e.scrollBox.removeChild(e.vBar.scrollBarBox),delete e.vBar)}function b(e){e.hBar&&(e.hBar.scrollBarThumb.removeEventListener("mousedown",e.mouseDown,0),e.hBar.scrollBarThumb.removeEventListener("touchstart",e.mouseDown,0),e.scrollBox.removeChild(e.hBar.scrollBarBox),delete e.hBar)}function g(e){var l=0,o=0;e.scrollBoxClip.style.width="100%",e.scrollBoxClip.style.height="100%",e.vBar&&(e.vBar.scrollBarBox.style.display=""),e.hBar&&(e.hBar.scrollBarBox.style.display=""),e.vBar&&e.scrollCevBox.clientHeight<e.scrollCevBox.scrollHeight&&(l=e.vBar.scrollBarBox.clientWidth,e.config.barfloat||(e.scrollBoxClip.style.width="calc(100% - "+l+"px)"),e.vBar.show=0<l),e.hBar&&e.scrollCevBox.clientWidth<e.scrollCevBox.scrollWidth&&(o=e.hBar.scrollBarBox.clientHeight,e.config.barfloat||(e.scrollBoxClip.style.height="calc(100% - "+o+"px)"),e.hBar.show=0<o),l<=0&&0<o?e.vBar&&e.scrollCevBox.clientHeight<e.scrollCevBox.scrollHeight&&(l=e.vBar.scrollBarBox.clientWidth,e.config.barfloat||(e.scrollBoxClip.style.width="calc(100% - "+l+"px)"),e.vBar.show=0<l):0<l&&o<=0&&e.hBar&&e.scrollCevBox.clientWidth<e.scrollCevBox.scrollWidth&&(o=e.hBar.scrollBarBox.clientHeight,e.config.barfloat||(e.scrollBoxClip.style.height="calc(100% - "+o+"px)"),e.hBar.show=0<o),l<=0&&e.vBar&&(e.vBar.scrollBarBox.style.display="none"),o<=0&&e.hBar&&(e.hBar.scrollBarBox.style.display="none"),function(e){var l=void 0;(l=e.vBar)&&l.show?(s.removeClass(e.scrollBox,i.clsBoxInvisibleBarV,e.config.clsBoxInvisibleBarV),s.addClass(e.scrollBox,i.clsBoxVisibleBarV,e.config.clsBoxVisibleBarV)):(s.removeClass(e.scrollBox,i.clsBoxVisibleBarV,e.config.clsBoxVisibleBarV),s.addClass(e.scrollBox,i.clsBoxInvisibleBarV,e.config.clsBoxInvisibleBarV));(l=e.hBar)&&l.show?(s.removeClass(e.scrollBox,i.clsBoxInvisibleBarH,e.config.clsBoxInvisibleBarH),s.addClass(e.scrollBox,i.clsBoxVisibleBarH,e.config.clsBoxVisibleBarH)):(s.removeClass(e.scrollBox,i.clsBoxVisibleBarH,e.config.clsBoxVisibleBarH),s.addClass(e.scrollBox,i.clsBoxInvisibleBarH,e.config.clsBoxInvisibleBarH))}(e)}function m(e){var l=void 0,o=void 0,r=void 0,s=void 0,t=void 0;(l=e.vBar)&&l.show&&(o=0==e.scrollCevBox.scrollHeight?1:e.scrollCevBox.clientHeight/e.scrollCevBox.scrollHeight,r=l.scrollBarTrack.clientHeight*o,l.scrollBarThumb.style.height=parseInt(Math.round(r))+"px",t=(l.scrollBarTrack.clientHeight-l.scrollBarThumb.clientHeight)/(e.scrollCevBox.scrollHeight-e.scrollCevBox.clientHeight),s=e.scrollCevBox.scrollTop*t,l.scrollBarThumb.style.top=parseInt(Math.round(s))+"px"),(l=e.hBar)&&l.show&&(o=0==e.scrollCevBox.scrollWidth?1:e.scrollCevBox.clientWidth/e.scrollCevBox.scrollWidth,r=l.scrollBarTrack.clientWidth*o,l.scrollBarThumb.style.width=parseInt(Math.round(r))+"px",t=(l.scrollBarTrack.clientWidth-l.scrollBarThumb.clientWidth)/(e.scrollCevBox.scrollWidth-e.scrollCevBox.clientWidth),s=e.scrollCevBox.scrollLeft*t,l.scrollBarThumb.style.left=parseInt(Math.round(s))+"px")}function x(e,l){var o,n,r;e.nextTickHandler=l,c(e),(o=e).mouseDown||(o.mouseDown=function(e){if(!o.isBinded)return!1;if(!e.targetTouches&&1!==e.which)return!1;var l=e.targetTouches?e.targetTouches[0]:e;if(o.vBar&&this==o.vBar.scrollBarThumb)o.vBar.barDragging=!0,o.vBar.startMouse=l.clientY-o.vBar.scrollBarThumb.getBoundingClientRect().top;else{if(!o.hBar||this!=o.hBar.scrollBarThumb)return!1;o.hBar.barDragging=!0,o.hBar.startMouse=l.clientX-o.hBar.scrollBarThumb.getBoundingClientRect().left}s.addClass(o.scrollBox,i.clsBoxDragging,o.config.clsBoxDragging),o.draggingPhantomClassTimer&&clearTimeout(o.draggingPhantomClassTimer),s.addClass(o.scrollBox,i.clsBoxDraggingPhantomClass,o.config.clsBoxDraggingPhantomClass),document.addEventListener("mousemove",o.mouseMove,1),document.addEventListener("mouseup",o.mouseUp,1),document.addEventListener("touchmove",o.mouseMove,1),document.addEventListener("touchend",o.mouseUp,1),e.preventDefault(),e.stopPropagation()}),(n=e).mouseMove||(n.mouseMove=s.throttle(function(e){if(n.isBinded){var l=e.targetTouches?e.targetTouches[0]:e;r=l,i=a=t=s=void 0,(s=(o=n).vBar)&&s.barDragging&&s.show&&((t=r.clientY-s.scrollBarTrack.getBoundingClientRect().top)<=s.startMouse&&(a=0),t>s.startMouse&&(a=t-s.startMouse),a+s.scrollBarThumb.clientHeight>=s.scrollBarTrack.clientHeight&&(a=s.scrollBarTrack.clientHeight-s.scrollBarThumb.clientHeight),i=(o.scrollCevBox.scrollHeight-o.scrollCevBox.clientHeight)/(s.scrollBarTrack.clientHeight-s.scrollBarThumb.clientHeight),o.scrollCevBox.scrollTop=a*i),(s=o.hBar)&&s.barDragging&&s.show&&((t=r.clientX-s.scrollBarTrack.getBoundingClientRect().left)<=s.startMouse&&(a=0),t>s.startMouse&&(a=t-s.startMouse),a+s.scrollBarThumb.clientWidth>=s.scrollBarTrack.clientWidth&&(a=s.scrollBarTrack.clientWidth-s.scrollBarThumb.clientWidth),i=(o.scrollCevBox.scrollWidth-o.scrollCevBox.clientWidth)/(s.scrollBarTrack.clientWidth-s.scrollBarThumb.clientWidth),o.scrollCevBox.scrollLeft=a*i)}var o,r,s,t,a,i},function(){return n.config.draggerThrottle},function(e){e.stopPropagation()})),(r=e).mouseUp||(r.mouseUp=function(e){r.isBinded&&(r.vBar&&(r.vBar.barDragging=!1),r.hBar&&(r.hBar.barDragging=!1),s.removeClass(r.scrollBox,i.clsBoxDragging,r.config.clsBoxDragging),r.draggingPhantomClassTimer&&clearTimeout(r.draggingPhantomClassTimer),r.draggingPhantomClassTimer=setTimeout(function(){s.removeClass(r.scrollBox,i.clsBoxDraggingPhantomClass,r.config.clsBoxDraggingPhantomClass),r.draggingPhantomClassTimer&&delete r.draggingPhantomClassTimer},r.config.draggingPhantomDelay),document.removeEventListener("mousemove",r.mouseMove,1),document.removeEventListener("mouseup",r.mouseUp,1),document.removeEventListener("touchmove",r.mouseMove,1),document.removeEventListener("touchend",r.mouseUp,1),e.stopPropagation())})}function l(e){var c,l;d(e),u(e),(c=e).config.preventParentScroll?c.wheelHandler||(c.wheelHandler=function(e){if(c.isBinded){if(1<=c.visibleArea)return!1;var l=c.scrollCevBox.scrollHeight-c.scrollCevBox.clientHeight,o=c.scrollCevBox.scrollTop,r=e.deltaY<0,s=0<e.deltaY;if(o<=0&&r||l<=o&&s)return e.preventDefault(),!1;var t=c.scrollCevBox.scrollHeight-c.scrollCevBox.clientHeight,a=c.scrollCevBox.scrollLeft,i=e.deltaX<0,n=0<e.deltaX;return a<=0&&i||t<=a&&n?(e.preventDefault(),!1):(e.stopPropagation(),!0)}},c.scrollCevBox.addEventListener("wheel",c.wheelHandler,0)):B(c),(l=e).config.resizeRefresh?l.resizeHandler||(l.resizeHandler=s.debounce(function(){l.isBinded&&f(l)},function(){return l.config.resizeDebounce}),window.addEventListener("resize",l.resizeHandler,0)):h(l)}function f(e){var l=function(){e&&(g(e),m(e))};e.nextTickHandler?e.nextTickHandler(l):l()}function C(e){var l,o,r;(l=e).draggingPhantomClassTimer&&clearTimeout(l.draggingPhantomClassTimer),l.scrollingClassTimeout&&clearTimeout(l.scrollingClassTimeout),l.scrollingPhantomClassTimeout&&clearTimeout(l.scrollingPhantomClassTimeout),h(e),B(e),v(o=e),b(o),(r=e).scrollCevBox&&(s.removeClass(r.scrollBox,i.clsBox,r.config.clsBox),r.scrollBox.style.position="",r.scrollBox.style.overflow="",r.scrollCev.style.display="",r.scrollBox.removeChild(r.scrollBoxClip),r.scrollCevBox.removeChild(r.scrollCev),r.scrollBox.appendChild(r.scrollCev),r.scrollCevBox.removeEventListener("scroll",r.scrollHandler,0),r.mutationObserver&&(r.mutationObserver.disconnect(),delete r.mutationObserver),delete r.scrollCev,delete r.scrollCevBox,delete r.scrollBoxClip,delete r.scrollBox)}function p(e,l){if(l)for(var o in l)e.config[o]=l[o];e.barBehavior=function(e){var l="show",o="show",r=void 0;(r=(e=e||"").match(/^\s*(show|hide|none)\s*$/i))?2<=r.length&&(l=o=r[1].toLowerCase()):(r=e.match(/^\s*(show|hide|none)\s+(show|hide|none)\s*$/i))&&3<=r.length&&(l=r[1].toLowerCase(),o=r[2].toLowerCase());var s=!0,t=!0;"none"==l?t=s=!1:"hide"==l&&(t=!(s=!0));var a=!0,i=!0;return"none"==o?i=a=!1:"hide"==o&&(i=!(a=!0)),{vBarEnable:s,vBarShow:t,hBarEnable:a,hBarShow:i}}(e.config.scrollBarBehavior)}return function(){function r(e,l){if(t(this,r),!e.firstElementChild)return{};this.rootEl=e,this.config={},p(this,a),x(this,l)}return e(r,[{key:"bind",value:function(e){return this.update(e),this._isBinded=!0,this}},{key:"update",value:function(e){return p(this,e),l(this),f(this),this}},{key:"refreshBar",value:function(){return f(this),this}},{key:"unBind",value:function(){return this._isBinded=!1,C(this),this}},{key:"isBinded",get:function(){return!!this._isBinded}}],[{key:"bind",value:function(e,l,o){return e._easyBar||(e._easyBar=new r(e,o).bind(l)),e._easyBar}},{key:"update",value:function(e,l){e._easyBar&&e._easyBar.update(l)}},{key:"get",value:function(e){return e._easyBar}},{key:"refreshBar",value:function(e){e._easyBar&&e._easyBar.refreshBar()}},{key:"unBind",value:function(e){e._easyBar&&(e._easyBar.unBind(),delete e._easyBar)}},{key:"install",value:function(o){o.directive("bar",{inserted:function(e,l){r.bind(e,l&&l.value?l.value:null,o.nextTick)},update:function(e,l){r.update(e,l&&l.value?l.value:null)},componentUpdated:function(e){r.refreshBar(e)},unbind:function(e){r.unBind(e)}}),Object.defineProperty(o.prototype,"$EasyBar",{get:function(){return r}})}}]),r}()});
\ No newline at end of file
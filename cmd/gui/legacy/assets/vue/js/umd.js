@@ -1,37 +0,0 @@
-/**
- * VueLayers
- * Web map Vue components with the power of OpenLayers
- *
- * @package vuelayers
- * @author Vladimir Vershinin <ghettovoice@gmail.com>
- * @version 0.11.1
- * @license MIT
- * @copyright (c) 2017-2018, Vladimir Vershinin <ghettovoice@gmail.com>
- */
-!function(t,e){"object"==typeof exports&&"undefined"!=typeof module?module.exports=e(require("vue")):"function"==typeof define&&define.amd?define("vuelayers",["vue"],e):t.VueLayers=e(t.Vue)}(this,function(t){"use strict";t=t&&t.hasOwnProperty("default")?t.default:t;var e={}.toString,i=function(t){return e.call(t).slice(8,-1)},n=Object("z").propertyIsEnumerable(0)?Object:function(t){return"String"==i(t)?t.split(""):Object(t)},o=function(t){if(void 0==t)throw TypeError("Can't call method on  "+t);return t},s=function(t){return n(o(t))},a={f:{}.propertyIsEnumerable},h=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}},u=function(t){return"object"==typeof t?null!==t:"function"==typeof t},l=function(t,e){if(!u(t))return t;var i,n;if(e&&"function"==typeof(i=t.toString)&&!u(n=i.call(t)))return n;if("function"==typeof(i=t.valueOf)&&!u(n=i.call(t)))return n;if(!e&&"function"==typeof(i=t.toString)&&!u(n=i.call(t)))return n;throw TypeError("Can't convert object to primitive value")},c={}.hasOwnProperty,p=function(t,e){return c.call(t,e)},f=function(t){try{return!!t()}catch(t){return!0}},d=!f(function(){return 7!=Object.defineProperty({},"a",{get:function(){return 7}}).a});"undefined"!=typeof window?window:"undefined"!=typeof global?global:"undefined"!=typeof self&&self;function y(t,e){return t(e={exports:{}},e.exports),e.exports}var v=y(function(t){var e=t.exports="undefined"!=typeof window&&window.Math==Math?window:"undefined"!=typeof self&&self.Math==Math?self:Function("return this")();"number"==typeof __g&&(__g=e)}),_=v.document,m=u(_)&&u(_.createElement),E=function(t){return m?_.createElement(t):{}},x=!d&&!f(function(){return 7!=Object.defineProperty(E("div"),"a",{get:function(){return 7}}).a}),S=Object.getOwnPropertyDescriptor,T={f:d?S:function(t,e){if(t=s(t),e=l(e,!0),x)try{return S(t,e)}catch(t){}if(p(t,e))return h(!a.f.call(t,e),t[e])}},w=y(function(t){var e=t.exports={version:"2.5.7"};"number"==typeof __e&&(__e=e)}),C=(w.version,function(t){if("function"!=typeof t)throw TypeError(t+" is not a function!");return t}),R=function(t,e,i){if(C(t),void 0===e)return t;switch(i){case 1:return function(i){return t.call(e,i)};case 2:return function(i,n){return t.call(e,i,n)};case 3:return function(i,n,r){return t.call(e,i,n,r)}}return function(){return t.apply(e,arguments)}},O=function(t){if(!u(t))throw TypeError(t+" is not an object!");return t},I=Object.defineProperty,P={f:d?Object.defineProperty:function(t,e,i){if(O(t),e=l(e,!0),O(i),x)try{return I(t,e,i)}catch(t){}if("get"in i||"set"in i)throw TypeError("Accessors not supported!");return"value"in i&&(t[e]=i.value),t}},F=d?function(t,e,i){return P.f(t,e,h(1,i))}:function(t,e,i){return t[e]=i,t},L=function(t,e,i){var n,r,o,s=t&L.F,a=t&L.G,h=t&L.S,u=t&L.P,l=t&L.B,c=t&L.W,f=a?w:w[e]||(w[e]={}),d=f.prototype,g=a?v:h?v[e]:(v[e]||{}).prototype;for(n in a&&(i=e),i)(r=!s&&g&&void 0!==g[n])&&p(f,n)||(o=r?g[n]:i[n],f[n]=a&&"function"!=typeof g[n]?i[n]:l&&r?R(o,v):c&&g[n]==o?function(t){var e=function(e,i,n){if(this instanceof t){switch(arguments.length){case 0:return new t;case 1:return new t(e);case 2:return new t(e,i)}return new t(e,i,n)}return t.apply(this,arguments)};return e.prototype=t.prototype,e}(o):u&&"function"==typeof o?R(Function.call,o):o,u&&((f.virtual||(f.virtual={}))[n]=o,t&L.R&&d&&!d[n]&&F(d,n,o)))};L.F=1,L.G=2,L.S=4,L.P=8,L.B=16,L.W=32,L.U=64,L.R=128;var M=L,A=function(t,e){var i=(w.Object||{})[t]||Object[t],n={};n[t]=e(i),M(M.S+M.F*f(function(){i(1)}),"Object",n)},N=T.f;A("getOwnPropertyDescriptor",function(){return function(t,e){return N(s(t),e)}});var k,j=w.Object,G=function(t,e){return j.getOwnPropertyDescriptor(t,e)},D=F,$=0,V=Math.random(),z=function(t){return"Symbol(".concat(void 0===t?"":t,")_",(++$+V).toString(36))},Y=y(function(t){var e=z("meta"),i=P.f,n=0,r=Object.isExtensible||function(){return!0},o=!f(function(){return r(Object.preventExtensions({}))}),s=function(t){i(t,e,{value:{i:"O"+ ++n,w:{}}})},a=t.exports={KEY:e,NEED:!1,fastKey:function(t,i){if(!u(t))return"symbol"==typeof t?t:("string"==typeof t?"S":"P")+t;if(!p(t,e)){if(!r(t))return"F";if(!i)return"E";s(t)}return t[e].i},getWeak:function(t,i){if(!p(t,e)){if(!r(t))return!0;if(!i)return!1;s(t)}return t[e].w},onFreeze:function(t){return o&&a.NEED&&r(t)&&!p(t,e)&&s(t),t}}}),X=(Y.KEY,Y.NEED,Y.fastKey,Y.getWeak,Y.onFreeze,y(function(t){var e=v["__core-js_shared__"]||(v["__core-js_shared__"]={});(t.exports=function(t,i){return e[t]||(e[t]=void 0!==i?i:{})})("versions",[]).push({version:w.version,mode:"pure",copyright:"© 2018 Denis Pushkarev (zloirock.ru)"})})),U=y(function(t){var e=X("wks"),i=v.Symbol,n="function"==typeof i;(t.exports=function(t){return e[t]||(e[t]=n&&i[t]||(n?i:z)("Symbol."+t))}).store=e}),B=P.f,K=U("toStringTag"),W=function(t,e,i){t&&!p(t=i?t:t.prototype,K)&&B(t,K,{configurable:!0,value:e})},H={f:U},Z=P.f,q=function(t){var e=w.Symbol||(w.Symbol={});"_"==t.charAt(0)||t in e||Z(e,t,{value:H.f(t)})},J=Math.ceil,Q=Math.floor,tt=function(t){return isNaN(t=+t)?0:(t>0?Q:J)(t)},et=Math.min,it=function(t){return t>0?et(tt(t),9007199254740991):0},nt=Math.max,rt=Math.min,ot=X("keys"),st=function(t){return ot[t]||(ot[t]=z(t))},at=(k=!1,function(t,e,i){var n,r=s(t),o=it(r.length),a=function(t,e){return(t=tt(t))<0?nt(t+e,0):rt(t,e)}(i,o);if(k&&e!=e){for(;o>a;)if((n=r[a++])!=n)return!0}else for(;o>a;a++)if((k||a in r)&&r[a]===e)return k||a||0;return!k&&-1}),ht=st("IE_PROTO"),ut=function(t,e){var i,n=s(t),r=0,o=[];for(i in n)i!=ht&&p(n,i)&&o.push(i);for(;e.length>r;)p(n,i=e[r++])&&(~at(o,i)||o.push(i));return o},lt="constructor,hasOwnProperty,isPrototypeOf,propertyIsEnumerable,toLocaleString,toString,valueOf".split(","),ct=Object.keys||function(t){return ut(t,lt)},pt={f:Object.getOwnPropertySymbols},ft=Array.isArray||function(t){return"Array"==i(t)},dt=d?Object.defineProperties:function(t,e){O(t);for(var i,n=ct(e),r=n.length,o=0;r>o;)P.f(t,i=n[o++],e[i]);return t},gt=v.document,yt=gt&&gt.documentElement,vt=st("IE_PROTO"),_t=function(){},mt=function(){var t,e=E("iframe"),i=lt.length;for(e.style.display="none",yt.appendChild(e),e.src="javascript:",(t=e.contentWindow.document).open(),t.write("<script>document.F=Object<\/script>"),t.close(),mt=t.F;i--;)delete mt.prototype[lt[i]];return mt()},Et=Object.create||function(t,e){var i;return null!==t?(_t.prototype=O(t),i=new _t,_t.prototype=null,i[vt]=t):i=mt(),void 0===e?i:dt(i,e)},xt=lt.concat("length","prototype"),St={f:Object.getOwnPropertyNames||function(t){return ut(t,xt)}},Tt=St.f,wt={}.toString,bt="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[],Ct={f:function(t){return bt&&"[object Window]"==wt.call(t)?function(t){try{return Tt(t)}catch(t){return bt.slice()}}(t):Tt(s(t))}},Rt=Y.KEY,Ot=T.f,It=P.f,Pt=Ct.f,Ft=v.Symbol,Lt=v.JSON,Mt=Lt&&Lt.stringify,At=U("_hidden"),Nt=U("toPrimitive"),kt={}.propertyIsEnumerable,jt=X("symbol-registry"),Gt=X("symbols"),Dt=X("op-symbols"),$t=Object.prototype,Vt="function"==typeof Ft,zt=v.QObject,Yt=!zt||!zt.prototype||!zt.prototype.findChild,Xt=d&&f(function(){return 7!=Et(It({},"a",{get:function(){return It(this,"a",{value:7}).a}})).a})?function(t,e,i){var n=Ot($t,e);n&&delete $t[e],It(t,e,i),n&&t!==$t&&It($t,e,n)}:It,Ut=function(t){var e=Gt[t]=Et(Ft.prototype);return e._k=t,e},Bt=Vt&&"symbol"==typeof Ft.iterator?function(t){return"symbol"==typeof t}:function(t){return t instanceof Ft},Kt=function(t,e,i){return t===$t&&Kt(Dt,e,i),O(t),e=l(e,!0),O(i),p(Gt,e)?(i.enumerable?(p(t,At)&&t[At][e]&&(t[At][e]=!1),i=Et(i,{enumerable:h(0,!1)})):(p(t,At)||It(t,At,h(1,{})),t[At][e]=!0),Xt(t,e,i)):It(t,e,i)},Wt=function(t,e){O(t);for(var i,n=function(t){var e=ct(t),i=pt.f;if(i)for(var n,r=i(t),o=a.f,s=0;r.length>s;)o.call(t,n=r[s++])&&e.push(n);return e}(e=s(e)),r=0,o=n.length;o>r;)Kt(t,i=n[r++],e[i]);return t},Ht=function(t){var e=kt.call(this,t=l(t,!0));return!(this===$t&&p(Gt,t)&&!p(Dt,t))&&(!(e||!p(this,t)||!p(Gt,t)||p(this,At)&&this[At][t])||e)},Zt=function(t,e){if(t=s(t),e=l(e,!0),t!==$t||!p(Gt,e)||p(Dt,e)){var i=Ot(t,e);return!i||!p(Gt,e)||p(t,At)&&t[At][e]||(i.enumerable=!0),i}},qt=function(t){for(var e,i=Pt(s(t)),n=[],r=0;i.length>r;)p(Gt,e=i[r++])||e==At||e==Rt||n.push(e);return n},Jt=function(t){for(var e,i=t===$t,n=Pt(i?Dt:s(t)),r=[],o=0;n.length>o;)!p(Gt,e=n[o++])||i&&!p($t,e)||r.push(Gt[e]);return r};Vt||(D((Ft=function(){if(this instanceof Ft)throw TypeError("Symbol is not a constructor!");var t=z(arguments.length>0?arguments[0]:void 0),e=function(i){this===$t&&e.call(Dt,i),p(this,At)&&p(this[At],t)&&(this[At][t]=!1),Xt(this,t,h(1,i))};return d&&Yt&&Xt($t,t,{configurable:!0,set:e}),Ut(t)}).prototype,"toString",function(){return this._k}),T.f=Zt,P.f=Kt,St.f=Ct.f=qt,a.f=Ht,pt.f=Jt,H.f=function(t){return Ut(U(t))}),M(M.G+M.W+M.F*!Vt,{Symbol:Ft});for(var Qt="hasInstance,isConcatSpreadable,iterator,match,replace,search,species,split,toPrimitive,toStringTag,unscopables".split(","),te=0;Qt.length>te;)U(Qt[te++]);for(var ee=ct(U.store),ie=0;ee.length>ie;)q(ee[ie++]);M(M.S+M.F*!Vt,"Symbol",{for:function(t){return p(jt,t+="")?jt[t]:jt[t]=Ft(t)},keyFor:function(t){if(!Bt(t))throw TypeError(t+" is not a symbol!");for(var e in jt)if(jt[e]===t)return e},useSetter:function(){Yt=!0},useSimple:function(){Yt=!1}}),M(M.S+M.F*!Vt,"Object",{create:function(t,e){return void 0===e?Et(t):Wt(Et(t),e)},defineProperty:Kt,defineProperties:Wt,getOwnPropertyDescriptor:Zt,getOwnPropertyNames:qt,getOwnPropertySymbols:Jt}),Lt&&M(M.S+M.F*(!Vt||f(function(){var t=Ft();return"[null]"!=Mt([t])||"{}"!=Mt({a:t})||"{}"!=Mt(Object(t))})),"JSON",{stringify:function(t){for(var e,i,n=[t],r=1;arguments.length>r;)n.push(arguments[r++]);if(i=e=n[1],(u(e)||void 0!==t)&&!Bt(t))return ft(e)||(e=function(t,e){if("function"==typeof i&&(e=i.call(this,t,e)),!Bt(e))return e}),n[1]=e,Mt.apply(Lt,n)}}),Ft.prototype[Nt]||F(Ft.prototype,Nt,Ft.prototype.valueOf),W(Ft,"Symbol"),W(Math,"Math",!0),W(v.JSON,"JSON",!0);var ne=w.Object.getOwnPropertySymbols,re=function(t){return Object(o(t))};A("keys",function(){return function(t){return ct(re(t))}});var oe=w.Object.keys;M(M.S+M.F*!d,"Object",{defineProperty:P.f});var se=w.Object,ae=function(t,e,i){return se.defineProperty(t,e,i)};function he(t,e,i){return e in t?ae(t,e,{value:i,enumerable:!0,configurable:!0,writable:!0}):t[e]=i,t}function ue(t){for(var e=1;e<arguments.length;e++){var i=null!=arguments[e]?arguments[e]:{},n=oe(i);"function"==typeof ne&&(n=n.concat(ne(i).filter(function(t){return G(i,t).enumerable}))),n.forEach(function(e){he(t,e,i[e])})}return t}var le=Object.assign,ce=!le||f(function(){var t={},e={},i=Symbol(),n="abcdefghijklmnopqrst";return t[i]=7,n.split("").forEach(function(t){e[t]=t}),7!=le({},t)[i]||Object.keys(le({},e)).join("")!=n})?function(t,e){for(var i=re(t),r=arguments.length,o=1,s=pt.f,h=a.f;r>o;)for(var u,l=n(arguments[o++]),c=s?ct(l).concat(s(l)):ct(l),p=c.length,f=0;p>f;)h.call(l,u=c[f++])&&(i[u]=l[u]);return i}:le;M(M.S+M.F,"Object",{assign:ce});var pe=w.Object.assign,fe=y(function(t){!function(e){var i,n=Object.prototype,r=n.hasOwnProperty,o="function"==typeof Symbol?Symbol:{},s=o.iterator||"@@iterator",a=o.asyncIterator||"@@asyncIterator",h=o.toStringTag||"@@toStringTag",u=e.regeneratorRuntime;if(u)t.exports=u;else{(u=e.regeneratorRuntime=t.exports).wrap=m;var l="suspendedStart",c="suspendedYield",p="executing",f="completed",d={},g={};g[s]=function(){return this};var y=Object.getPrototypeOf,v=y&&y(y(P([])));v&&v!==n&&r.call(v,s)&&(g=v);var _=T.prototype=x.prototype=Object.create(g);S.prototype=_.constructor=T,T.constructor=S,T[h]=S.displayName="GeneratorFunction",u.isGeneratorFunction=function(t){var e="function"==typeof t&&t.constructor;return!!e&&(e===S||"GeneratorFunction"===(e.displayName||e.name))},u.mark=function(t){return Object.setPrototypeOf?Object.setPrototypeOf(t,T):(t.__proto__=T,h in t||(t[h]="GeneratorFunction")),t.prototype=Object.create(_),t},u.awrap=function(t){return{__await:t}},w(b.prototype),b.prototype[a]=function(){return this},u.AsyncIterator=b,u.async=function(t,e,i,n){var r=new b(m(t,e,i,n));return u.isGeneratorFunction(e)?r:r.next().then(function(t){return t.done?t.value:r.next()})},w(_),_[h]="Generator",_[s]=function(){return this},_.toString=function(){return"[object Generator]"},u.keys=function(t){var e=[];for(var i in t)e.push(i);return e.reverse(),function i(){for(;e.length;){var n=e.pop();if(n in t)return i.value=n,i.done=!1,i}return i.done=!0,i}},u.values=P,I.prototype={constructor:I,reset:function(t){if(this.prev=0,this.next=0,this.sent=this._sent=i,this.done=!1,this.delegate=null,this.method="next",this.arg=i,this.tryEntries.forEach(O),!t)for(var e in this)"t"===e.charAt(0)&&r.call(this,e)&&!isNaN(+e.slice(1))&&(this[e]=i)},stop:function(){this.done=!0;var t=this.tryEntries[0].completion;if("throw"===t.type)throw t.arg;return this.rval},dispatchException:function(t){if(this.done)throw t;var e=this;function n(n,r){return a.type="throw",a.arg=t,e.next=n,r&&(e.method="next",e.arg=i),!!r}for(var o=this.tryEntries.length-1;o>=0;--o){var s=this.tryEntries[o],a=s.completion;if("root"===s.tryLoc)return n("end");if(s.tryLoc<=this.prev){var h=r.call(s,"catchLoc"),u=r.call(s,"finallyLoc");if(h&&u){if(this.prev<s.catchLoc)return n(s.catchLoc,!0);if(this.prev<s.finallyLoc)return n(s.finallyLoc)}else if(h){if(this.prev<s.catchLoc)return n(s.catchLoc,!0)}else{if(!u)throw new Error("try statement without catch or finally");if(this.prev<s.finallyLoc)return n(s.finallyLoc)}}}},abrupt:function(t,e){for(var i=this.tryEntries.length-1;i>=0;--i){var n=this.tryEntries[i];if(n.tryLoc<=this.prev&&r.call(n,"finallyLoc")&&this.prev<n.finallyLoc){var o=n;break}}o&&("break"===t||"continue"===t)&&o.tryLoc<=e&&e<=o.finallyLoc&&(o=null);var s=o?o.completion:{};return s.type=t,s.arg=e,o?(this.method="next",this.next=o.finallyLoc,d):this.complete(s)},complete:function(t,e){if("throw"===t.type)throw t.arg;return"break"===t.type||"continue"===t.type?this.next=t.arg:"return"===t.type?(this.rval=this.arg=t.arg,this.method="return",this.next="end"):"normal"===t.type&&e&&(this.next=e),d},finish:function(t){for(var e=this.tryEntries.length-1;e>=0;--e){var i=this.tryEntries[e];if(i.finallyLoc===t)return this.complete(i.completion,i.afterLoc),O(i),d}},catch:function(t){for(var e=this.tryEntries.length-1;e>=0;--e){var i=this.tryEntries[e];if(i.tryLoc===t){var n=i.completion;if("throw"===n.type){var r=n.arg;O(i)}return r}}throw new Error("illegal catch attempt")},delegateYield:function(t,e,n){return this.delegate={iterator:P(t),resultName:e,nextLoc:n},"next"===this.method&&(this.arg=i),d}}}function m(t,e,i,n){var r=e&&e.prototype instanceof x?e:x,o=Object.create(r.prototype),s=new I(n||[]);return o._invoke=function(t,e,i){var n=l;return function(r,o){if(n===p)throw new Error("Generator is already running");if(n===f){if("throw"===r)throw o;return F()}for(i.method=r,i.arg=o;;){var s=i.delegate;if(s){var a=C(s,i);if(a){if(a===d)continue;return a}}if("next"===i.method)i.sent=i._sent=i.arg;else if("throw"===i.method){if(n===l)throw n=f,i.arg;i.dispatchException(i.arg)}else"return"===i.method&&i.abrupt("return",i.arg);n=p;var h=E(t,e,i);if("normal"===h.type){if(n=i.done?f:c,h.arg===d)continue;return{value:h.arg,done:i.done}}"throw"===h.type&&(n=f,i.method="throw",i.arg=h.arg)}}}(t,i,s),o}function E(t,e,i){try{return{type:"normal",arg:t.call(e,i)}}catch(t){return{type:"throw",arg:t}}}function x(){}function S(){}function T(){}function w(t){["next","throw","return"].forEach(function(e){t[e]=function(t){return this._invoke(e,t)}})}function b(t){var e;this._invoke=function(i,n){function o(){return new Promise(function(e,o){!function e(i,n,o,s){var a=E(t[i],t,n);if("throw"!==a.type){var h=a.arg,u=h.value;return u&&"object"==typeof u&&r.call(u,"__await")?Promise.resolve(u.__await).then(function(t){e("next",t,o,s)},function(t){e("throw",t,o,s)}):Promise.resolve(u).then(function(t){h.value=t,o(h)},function(t){return e("throw",t,o,s)})}s(a.arg)}(i,n,e,o)})}return e=e?e.then(o,o):o()}}function C(t,e){var n=t.iterator[e.method];if(n===i){if(e.delegate=null,"throw"===e.method){if(t.iterator.return&&(e.method="return",e.arg=i,C(t,e),"throw"===e.method))return d;e.method="throw",e.arg=new TypeError("The iterator does not provide a 'throw' method")}return d}var r=E(n,t.iterator,e.arg);if("throw"===r.type)return e.method="throw",e.arg=r.arg,e.delegate=null,d;var o=r.arg;return o?o.done?(e[t.resultName]=o.value,e.next=t.nextLoc,"return"!==e.method&&(e.method="next",e.arg=i),e.delegate=null,d):o:(e.method="throw",e.arg=new TypeError("iterator result is not an object"),e.delegate=null,d)}function R(t){var e={tryLoc:t[0]};1 in t&&(e.catchLoc=t[1]),2 in t&&(e.finallyLoc=t[2],e.afterLoc=t[3]),this.tryEntries.push(e)}function O(t){var e=t.completion||{};e.type="normal",delete e.arg,t.completion=e}function I(t){this.tryEntries=[{tryLoc:"root"}],t.forEach(R,this),this.reset(!0)}function P(t){if(t){var e=t[s];if(e)return e.call(t);if("function"==typeof t.next)return t;if(!isNaN(t.length)){var n=-1,o=function e(){for(;++n<t.length;)if(r.call(t,n))return e.value=t[n],e.done=!1,e;return e.value=i,e.done=!0,e};return o.next=o}}return{next:F}}function F(){return{value:i,done:!0}}}(function(){return this||"object"==typeof self&&self}()||Function("return this")())}),de=function(){return this||"object"==typeof self&&self}()||Function("return this")(),ge=de.regeneratorRuntime&&Object.getOwnPropertyNames(de).indexOf("regeneratorRuntime")>=0,ye=ge&&de.regeneratorRuntime;de.regeneratorRuntime=void 0;var ve=fe;if(ge)de.regeneratorRuntime=ye;else try{delete de.regeneratorRuntime}catch(t){de.regeneratorRuntime=void 0}var _e=ve,me=st("IE_PROTO"),Ee=Object.prototype,xe=Object.getPrototypeOf||function(t){return t=re(t),p(t,me)?t[me]:"function"==typeof t.constructor&&t instanceof t.constructor?t.constructor.prototype:t instanceof Object?Ee:null};A("getPrototypeOf",function(){return function(t){return xe(re(t))}});var Se=w.Object.getPrototypeOf,Te={},we={};F(we,U("iterator"),function(){return this});var be,Ce=function(t,e,i){t.prototype=Et(we,{next:h(1,i)}),W(t,e+" Iterator")},Re=U("iterator"),Oe=!([].keys&&"next"in[].keys()),Ie=function(){return this},Pe=function(t,e,i,n,r,o,s){Ce(i,e,n);var a,h,u,l=function(t){if(!Oe&&t in d)return d[t];switch(t){case"keys":case"values":return function(){return new i(this,t)}}return function(){return new i(this,t)}},c=e+" Iterator",p="values"==r,f=!1,d=t.prototype,g=d[Re]||d["@@iterator"]||r&&d[r],y=g||l(r),v=r?p?l("entries"):y:void 0,_="Array"==e&&d.entries||g;if(_&&(u=xe(_.call(new t)))!==Object.prototype&&u.next&&W(u,c,!0),p&&g&&"values"!==g.name&&(f=!0,y=function(){return g.call(this)}),s&&(Oe||f||!d[Re])&&F(d,Re,y),Te[e]=y,Te[c]=Ie,r)if(a={values:p?y:l("values"),keys:o?y:l("keys"),entries:v},s)for(h in a)h in d||D(d,h,a[h]);else M(M.P+M.F*(Oe||f),e,a);return a},Fe=(be=!0,function(t,e){var i,n,r=String(o(t)),s=tt(e),a=r.length;return s<0||s>=a?be?"":void 0:(i=r.charCodeAt(s))<55296||i>56319||s+1===a||(n=r.charCodeAt(s+1))<56320||n>57343?be?r.charAt(s):i:be?r.slice(s,s+2):n-56320+(i-55296<<10)+65536});Pe(String,"String",function(t){this._t=String(t),this._i=0},function(){var t,e=this._t,i=this._i;return i>=e.length?{value:void 0,done:!0}:(t=Fe(e,i),this._i+=t.length,{value:t,done:!1})});var Le=function(t,e){return{value:e,done:!!t}};Pe(Array,"Array",function(t,e){this._t=s(t),this._i=0,this._k=e},function(){var t=this._t,e=this._k,i=this._i++;return!t||i>=t.length?(this._t=void 0,Le(1)):Le(0,"keys"==e?i:"values"==e?t[i]:[i,t[i]])},"values");Te.Arguments=Te.Array;for(var Me=U("toStringTag"),Ae="CSSRuleList,CSSStyleDeclaration,CSSValueList,ClientRectList,DOMRectList,DOMStringList,DOMTokenList,DataTransferItemList,FileList,HTMLAllCollection,HTMLCollection,HTMLFormElement,HTMLSelectElement,MediaList,MimeTypeArray,NamedNodeMap,NodeList,PaintRequestList,Plugin,PluginArray,SVGLengthList,SVGNumberList,SVGPathSegList,SVGPointList,SVGStringList,SVGTransformList,SourceBufferList,StyleSheetList,TextTrackCueList,TextTrackList,TouchList".split(","),Ne=0;Ne<Ae.length;Ne++){var ke=Ae[Ne],je=v[ke],Ge=je&&je.prototype;Ge&&!Ge[Me]&&F(Ge,Me,ke),Te[ke]=Te.Array}var De=H.f("iterator");q("asyncIterator"),q("observable");var $e=w.Symbol;function Ve(t){return(Ve="function"==typeof $e&&"symbol"==typeof De?function(t){return typeof t}:function(t){return t&&"function"==typeof $e&&t.constructor===$e&&t!==$e.prototype?"symbol":typeof t})(t)}function ze(t){return(ze="function"==typeof $e&&"symbol"===Ve(De)?function(t){return Ve(t)}:function(t){return t&&"function"==typeof $e&&t.constructor===$e&&t!==$e.prototype?"symbol":Ve(t)})(t)}var Ye="\t\n\v\f\r   ᠎             　\u2028\u2029\ufeff",Xe="["+Ye+"]",Ue=RegExp("^"+Xe+Xe+"*"),Be=RegExp(Xe+Xe+"*$"),Ke=function(t,e,i){var n={},r=f(function(){return!!Ye[t]()||"​"!="​"[t]()}),o=n[t]=r?e(We):Ye[t];i&&(n[i]=o),M(M.P+M.F*r,"String",n)},We=Ke.trim=function(t,e){return t=String(o(t)),1&e&&(t=t.replace(Ue,"")),2&e&&(t=t.replace(Be,"")),t},He=Ke,Ze=v.parseFloat,qe=He.trim,Je=1/Ze(Ye+"-0")!=-1/0?function(t){var e=qe(String(t),3),i=Ze(e);return 0===i&&"-"==e.charAt(0)?-0:i}:Ze;M(M.G+M.F*(parseFloat!=Je),{parseFloat:Je});var Qe=w.parseFloat;M(M.S,"Array",{isArray:ft});var ti=w.Array.isArray,ei=function(t,e,i,n){try{return n?e(O(i)[0],i[1]):e(i)}catch(e){var r=t.return;throw void 0!==r&&O(r.call(t)),e}},ii=U("iterator"),ni=Array.prototype,ri=function(t){return void 0!==t&&(Te.Array===t||ni[ii]===t)},oi=function(t,e,i){e in t?P.f(t,e,h(0,i)):t[e]=i},si=U("toStringTag"),ai="Arguments"==i(function(){return arguments}()),hi=function(t){var e,n,r;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(n=function(t,e){try{return t[e]}catch(t){}}(e=Object(t),si))?n:ai?i(e):"Object"==(r=i(e))&&"function"==typeof e.callee?"Arguments":r},ui=U("iterator"),li=w.getIteratorMethod=function(t){if(void 0!=t)return t[ui]||t["@@iterator"]||Te[hi(t)]},ci=U("iterator"),pi=!1;try{[7][ci]().return=function(){pi=!0}}catch(t){}var fi=function(t,e){if(!e&&!pi)return!1;var i=!1;try{var n=[7],r=n[ci]();r.next=function(){return{done:i=!0}},n[ci]=function(){return r},t(n)}catch(t){}return i};M(M.S+M.F*!fi(function(t){}),"Array",{from:function(t){var e,i,n,r,o=re(t),s="function"==typeof this?this:Array,a=arguments.length,h=a>1?arguments[1]:void 0,u=void 0!==h,l=0,c=li(o);if(u&&(h=R(h,a>2?arguments[2]:void 0,2)),void 0==c||s==Array&&ri(c))for(i=new s(e=it(o.length));e>l;l++)oi(i,l,u?h(o[l],l):o[l]);else for(r=c.call(o),i=new s;!(n=r.next()).done;l++)oi(i,l,u?ei(r,h,[n.value,l],!0):n.value);return i.length=l,i}});var di,gi=w.Array.from,yi=_e.mark(Qi),vi=("undefined"!=typeof global?global:"undefined"!=typeof window?window:{}).isFinite||Ri,_i=Object.prototype,mi=Object.prototype,Ei=_i.toString,xi=mi.toString,Si=(di={},Ei).call(di),Ti=(di=0,Ei).call(di),wi=(di="",Ei).call(di),bi=(di=!0,Ei).call(di),Ci=xi.call(Object);function Ri(){}function Oi(t){return function(){return t}}function Ii(){return[]}function Pi(t){return t}function Fi(t){return Ei.call(t)===wi}function Li(t){return ti(t)}function Mi(t){return ji(t)&&t.hasOwnProperty("length")}function Ai(t){return"number"==typeof t&&vi(t)}function Ni(t){return"function"==typeof t}function ki(t){return!isNaN(Qe(t))&&vi(t)}function ji(t){return null!=t&&"object"===ze(t)}function Gi(t){if(!ji(t)||Ei.call(t)!==Si)return!1;var e=Se(t);if(null==e)return!0;var i=e.constructor;return"function"==typeof i&&i instanceof i&&xi.call(i)===Ci}function Di(){for(var t=arguments.length,e=new Array(t),i=0;i<t;i++)e[i]=arguments[i];return e.find(function(t){return null!=t})}function $i(t){var e={},i=function(t){return ki(t)||Fi(t)||Li(t)||(e=t,Ei.call(e)===bi)||Gi(t);var e};return oe(t).forEach(function(n){i(t[n])&&(e[n]=t[n])}),e}function Vi(t,e){var i=new RegExp(oe(e).map(function(t){return"(\\{"+t+"\\})"}).join("|"),"ig");return t.replace(i,function(t){return e[t.substr(1,t.length-2)]||""})}function zi(t,e){if(t===e)return!0;if(null==t||null==e||!ji(t)&&!ji(e))return t!=t&&e!=e;var i=oe(t),n=oe(e);if(i.length!==n.length)return!1;var r=[],o=function(i,n){for(var o=0,s=i.length;o<s;o++){var a=i[o];if(!r.includes(a)){if(!1===e.hasOwnProperty(a))return!1;var h=n[o];if(!zi(t[a],e[h]))return!1;r.push(h)}}return!0};return!1!==o(i,n)&&o(n,i)}function Yi(t){return!t||Mi(t)&&0===t.length||ji(t)&&0===oe(t).length}function Xi(t){return!Yi(t)}function Ui(t,e){for(var i=oe(t),n=0,r=i.length;n<r;n++){var o=i[n];if(!1===e(t[o],o))return}}function Bi(t,e,i){var n=i;return Ui(t,function(t,i){n=e(n,t,i)}),n}function Ki(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Xi;return Bi(t,function(t,i,n){return e(i,n)&&(Li(t)?t.push(i):t[n]=i),t},Li(t)?[]:{})}function Wi(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Pi;return Bi(t,function(t,i,n){return t[n]=e(i,n),t},Li(t)?[]:{})}function Hi(t){return Wi(t,arguments.length>1&&void 0!==arguments[1]?arguments[1]:Pi)}function Zi(t,e){for(var i=arguments.length,n=new Array(i>2?i-2:0),r=2;r<i;r++)n[r-2]=arguments[r];return Bi(n=ti(e)?e:[e].concat(n),function(e,i){return e[i]=t[i],e},{})}function qi(t){return 0===(t=String(t)).length?"":t[0].toUpperCase()+t.slice(1)}function Ji(t){return 0===(t=String(t)).length?"":t[0].toLowerCase()+t.slice(1)}function Qi(t,e){var i,n,r=arguments;return _e.wrap(function(o){for(;;)switch(o.prev=o.next){case 0:i=r.length>2&&void 0!==r[2]?r[2]:1,n=t;case 2:if(!(n<e)){o.next=8;break}return o.next=5,n;case 5:n+=i,o.next=2;break;case 8:case"end":return o.stop()}},yi,this)}function tn(t,e){var i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:zi;return Ki(t,function(t){return!function(t,e){var i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:zi;return Ki(t,function(t){return i(t,e)}).shift()}(e,t,i)})}var en=0;function nn(t){return t.ol_uid||(t.ol_uid=++en)}var rn="5.2.0",on=function(t){function e(e){var i="Assertion failed. See https://openlayers.org/en/"+rn.split("-")[0]+"/doc/errors/#"+e+" for details.";t.call(this,i),this.code=e,this.name="AssertionError",this.message=i}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Error);function sn(t,e){if(!t)throw new on(e)}var an={BOTTOM_LEFT:"bottom-left",BOTTOM_RIGHT:"bottom-right",TOP_LEFT:"top-left",TOP_RIGHT:"top-right"},hn={UNKNOWN:0,INTERSECTING:1,ABOVE:2,RIGHT:4,BELOW:8,LEFT:16};function un(t){for(var e=vn(),i=0,n=t.length;i<n;++i)bn(e,t[i]);return e}function ln(t,e,i){return i?(i[0]=t[0]-e,i[1]=t[1]-e,i[2]=t[2]+e,i[3]=t[3]+e,i):[t[0]-e,t[1]-e,t[2]+e,t[3]+e]}function cn(t,e){return e?(e[0]=t[0],e[1]=t[1],e[2]=t[2],e[3]=t[3],e):t.slice()}function pn(t,e,i){var n,r;return(n=e<t[0]?t[0]-e:t[2]<e?e-t[2]:0)*n+(r=i<t[1]?t[1]-i:t[3]<i?i-t[3]:0)*r}function fn(t,e){return gn(t,e[0],e[1])}function dn(t,e){return t[0]<=e[0]&&e[2]<=t[2]&&t[1]<=e[1]&&e[3]<=t[3]}function gn(t,e,i){return t[0]<=e&&e<=t[2]&&t[1]<=i&&i<=t[3]}function yn(t,e){var i=t[0],n=t[1],r=t[2],o=t[3],s=e[0],a=e[1],h=hn.UNKNOWN;return s<i?h|=hn.LEFT:s>r&&(h|=hn.RIGHT),a<n?h|=hn.BELOW:a>o&&(h|=hn.ABOVE),h===hn.UNKNOWN&&(h=hn.INTERSECTING),h}function vn(){return[1/0,1/0,-1/0,-1/0]}function _n(t,e,i,n,r){return r?(r[0]=t,r[1]=e,r[2]=i,r[3]=n,r):[t,e,i,n]}function mn(t){return _n(1/0,1/0,-1/0,-1/0,t)}function En(t,e){var i=t[0],n=t[1];return _n(i,n,i,n,e)}function xn(t,e){return function(t,e){for(var i=0,n=e.length;i<n;++i)bn(t,e[i]);return t}(mn(e),t)}function Sn(t,e,i,n,r){return Cn(mn(r),t,e,i,n)}function Tn(t,e){return t[0]==e[0]&&t[2]==e[2]&&t[1]==e[1]&&t[3]==e[3]}function wn(t,e){return e[0]<t[0]&&(t[0]=e[0]),e[2]>t[2]&&(t[2]=e[2]),e[1]<t[1]&&(t[1]=e[1]),e[3]>t[3]&&(t[3]=e[3]),t}function bn(t,e){e[0]<t[0]&&(t[0]=e[0]),e[0]>t[2]&&(t[2]=e[0]),e[1]<t[1]&&(t[1]=e[1]),e[1]>t[3]&&(t[3]=e[1])}function Cn(t,e,i,n,r){for(;i<n;i+=r)Rn(t,e[i],e[i+1]);return t}function Rn(t,e,i){t[0]=Math.min(t[0],e),t[1]=Math.min(t[1],i),t[2]=Math.max(t[2],e),t[3]=Math.max(t[3],i)}function On(t,e,i){var n;return(n=e.call(i,Pn(t)))?n:(n=e.call(i,Fn(t)))?n:(n=e.call(i,jn(t)))?n:(n=e.call(i,kn(t)))||!1}function In(t){var e=0;return $n(t)||(e=Gn(t)*An(t)),e}function Pn(t){return[t[0],t[1]]}function Fn(t){return[t[2],t[1]]}function Ln(t){return[(t[0]+t[2])/2,(t[1]+t[3])/2]}function Mn(t,e,i,n,r){var o=e*n[0]/2,s=e*n[1]/2,a=Math.cos(i),h=Math.sin(i),u=o*a,l=o*h,c=s*a,p=s*h,f=t[0],d=t[1],g=f-u+p,y=f-u-p,v=f+u-p,_=f+u+p,m=d-l-c,E=d-l+c,x=d+l+c,S=d+l-c;return _n(Math.min(g,y,v,_),Math.min(m,E,x,S),Math.max(g,y,v,_),Math.max(m,E,x,S),r)}function An(t){return t[3]-t[1]}function Nn(t,e,i){var n=i||[1/0,1/0,-1/0,-1/0];return Dn(t,e)?(t[0]>e[0]?n[0]=t[0]:n[0]=e[0],t[1]>e[1]?n[1]=t[1]:n[1]=e[1],t[2]<e[2]?n[2]=t[2]:n[2]=e[2],t[3]<e[3]?n[3]=t[3]:n[3]=e[3]):mn(n),n}function kn(t){return[t[0],t[3]]}function jn(t){return[t[2],t[3]]}function Gn(t){return t[2]-t[0]}function Dn(t,e){return t[0]<=e[2]&&t[2]>=e[0]&&t[1]<=e[3]&&t[3]>=e[1]}function $n(t){return t[2]<t[0]||t[3]<t[1]}function Vn(t,e){var i=(t[2]-t[0])/2*(e-1),n=(t[3]-t[1])/2*(e-1);t[0]-=i,t[2]+=i,t[1]-=n,t[3]+=n}function zn(t,e,i){var n=[t[0],t[1],t[0],t[3],t[2],t[1],t[2],t[3]];return e(n,n,2),function(t,e,i){return _n(Math.min.apply(null,t),Math.min.apply(null,e),Math.max.apply(null,t),Math.max.apply(null,e),i)}([n[0],n[2],n[4],n[6]],[n[1],n[3],n[5],n[7]],i)}function Yn(t,e,i){return Math.min(Math.max(t,e),i)}var Xn="cosh"in Math?Math.cosh:function(t){var e=Math.exp(t);return(e+1/e)/2};function Un(t,e,i,n,r,o){var s=r-i,a=o-n;if(0!==s||0!==a){var h=((t-i)*s+(e-n)*a)/(s*s+a*a);h>1?(i=r,n=o):h>0&&(i+=s*h,n+=a*h)}return Bn(t,e,i,n)}function Bn(t,e,i,n){var r=i-t,o=n-e;return r*r+o*o}function Kn(t){return 180*t/Math.PI}function Wn(t){return t*Math.PI/180}function Hn(t,e){var i=t%e;return i*e<0?i+e:i}function Zn(t,e,i){return t+i*(e-t)}var qn="function"==typeof Object.assign?Object.assign:function(t,e){var i=arguments;if(void 0===t||null===t)throw new TypeError("Cannot convert undefined or null to object");for(var n=Object(t),r=1,o=arguments.length;r<o;++r){var s=i[r];if(void 0!==s&&null!==s)for(var a in s)s.hasOwnProperty(a)&&(n[a]=s[a])}return n};function Jn(t){for(var e in t)delete t[e]}function Qn(t){var e=[];for(var i in t)e.push(t[i]);return e}function tr(t){var e;for(e in t)return!1;return!e}function er(t,e,i){return void 0===i&&(i=[0,0]),i[0]=t[0]+2*e,i[1]=t[1]+2*e,i}function ir(t,e,i){return void 0===i&&(i=[0,0]),i[0]=t[0]*e+.5|0,i[1]=t[1]*e+.5|0,i}function nr(t,e){return Array.isArray(t)?t:(void 0===e?e=[t,t]:e[0]=e[1]=t,e)}var rr=.5,or={IDLE:0,LOADING:1,LOADED:2,ERROR:3,EMPTY:4,ABORT:5};function sr(t){return Math.pow(t,3)}function ar(t){return 1-sr(1-t)}function hr(t){return 3*t*t-2*t*t*t}function ur(t){return t}var lr=function(){this.disposed_=!1};function cr(t,e,i,n){for(var r,o=0,s=t.length;o<s;++o)if((r=t[o]).listener===e&&r.bindTo===i)return n&&(r.deleteIndex=o),r}function pr(t,e){var i=t.ol_lm;return i?i[e]:void 0}function fr(t){var e=t.ol_lm;return e||(e=t.ol_lm={}),e}function dr(t,e){var i=pr(t,e);if(i){for(var n=0,r=i.length;n<r;++n)t.removeEventListener(e,i[n].boundListener),Jn(i[n]);i.length=0;var o=t.ol_lm;o&&(delete o[e],0===Object.keys(o).length&&delete t.ol_lm)}}function gr(t,e,i,n,r){var o=fr(t),s=o[e];s||(s=o[e]=[]);var a=cr(s,i,n,!1);return a?r||(a.callOnce=!1):(a={bindTo:n,callOnce:!!r,listener:i,target:t,type:e},t.addEventListener(e,function(t){var e=function(e){var i=t.listener,n=t.bindTo||t.target;return t.callOnce&&_r(t),i.call(n,e)};return t.boundListener=e,e}(a)),s.push(a)),a}function yr(t,e,i,n){return gr(t,e,i,n,!0)}function vr(t,e,i,n){var r=pr(t,e);if(r){var o=cr(r,i,n,!0);o&&_r(o)}}function _r(t){if(t&&t.target){t.target.removeEventListener(t.type,t.boundListener);var e=pr(t.target,t.type);if(e){var i="deleteIndex"in t?t.deleteIndex:e.indexOf(t);-1!==i&&e.splice(i,1),0===e.length&&dr(t.target,t.type)}Jn(t)}}function mr(){return!0}function Er(){return!1}function xr(){}lr.prototype.dispose=function(){this.disposed_||(this.disposed_=!0,this.disposeInternal())},lr.prototype.disposeInternal=function(){};var Sr=function(t){this.propagationStopped,this.type=t,this.target=null};function Tr(t){t.stopPropagation()}Sr.prototype.preventDefault=function(){this.propagationStopped=!0},Sr.prototype.stopPropagation=function(){this.propagationStopped=!0};var wr=function(t){function e(){t.call(this),this.pendingRemovals_={},this.dispatching_={},this.listeners_={}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.addEventListener=function(t,e){var i=this.listeners_[t];i||(i=this.listeners_[t]=[]),-1===i.indexOf(e)&&i.push(e)},e.prototype.dispatchEvent=function(t){var e="string"==typeof t?new Sr(t):t,i=e.type;e.target=this;var n,r=this.listeners_[i];if(r){i in this.dispatching_||(this.dispatching_[i]=0,this.pendingRemovals_[i]=0),++this.dispatching_[i];for(var o=0,s=r.length;o<s;++o)if(!1===r[o].call(this,e)||e.propagationStopped){n=!1;break}if(--this.dispatching_[i],0===this.dispatching_[i]){var a=this.pendingRemovals_[i];for(delete this.pendingRemovals_[i];a--;)this.removeEventListener(i,xr);delete this.dispatching_[i]}return n}},e.prototype.disposeInternal=function(){!function(t){var e=fr(t);for(var i in e)dr(t,i)}(this)},e.prototype.getListeners=function(t){return this.listeners_[t]},e.prototype.hasListener=function(t){return t?t in this.listeners_:Object.keys(this.listeners_).length>0},e.prototype.removeEventListener=function(t,e){var i=this.listeners_[t];if(i){var n=i.indexOf(e);t in this.pendingRemovals_?(i[n]=xr,++this.pendingRemovals_[t]):(i.splice(n,1),0===i.length&&delete this.listeners_[t])}},e}(lr),br={CHANGE:"change",CLEAR:"clear",CONTEXTMENU:"contextmenu",CLICK:"click",DBLCLICK:"dblclick",DRAGENTER:"dragenter",DRAGOVER:"dragover",DROP:"drop",ERROR:"error",KEYDOWN:"keydown",KEYPRESS:"keypress",LOAD:"load",MOUSEDOWN:"mousedown",MOUSEMOVE:"mousemove",MOUSEOUT:"mouseout",MOUSEUP:"mouseup",MOUSEWHEEL:"mousewheel",MSPOINTERDOWN:"MSPointerDown",RESIZE:"resize",TOUCHSTART:"touchstart",TOUCHMOVE:"touchmove",TOUCHEND:"touchend",WHEEL:"wheel"},Cr=function(t){function e(e,i,n){t.call(this);var r=n||{};this.tileCoord=e,this.state=i,this.interimTile=null,this.key="",this.transition_=void 0===r.transition?250:r.transition,this.transitionStarts_={}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.changed=function(){this.dispatchEvent(br.CHANGE)},e.prototype.getKey=function(){return this.key+"/"+this.tileCoord},e.prototype.getInterimTile=function(){if(!this.interimTile)return this;var t=this.interimTile;do{if(t.getState()==or.LOADED)return t;t=t.interimTile}while(t);return this},e.prototype.refreshInterimChain=function(){if(this.interimTile){var t=this.interimTile,e=this;do{if(t.getState()==or.LOADED){t.interimTile=null;break}t.getState()==or.LOADING?e=t:t.getState()==or.IDLE?e.interimTile=t.interimTile:e=t,t=e.interimTile}while(t)}},e.prototype.getTileCoord=function(){return this.tileCoord},e.prototype.getState=function(){return this.state},e.prototype.setState=function(t){this.state=t,this.changed()},e.prototype.load=function(){},e.prototype.getAlpha=function(t,e){if(!this.transition_)return 1;var i=this.transitionStarts_[t];if(i){if(-1===i)return 1}else i=e,this.transitionStarts_[t]=i;var n=e-i+1e3/60;return n>=this.transition_?1:sr(n/this.transition_)},e.prototype.inTransition=function(t){return!!this.transition_&&-1!==this.transitionStarts_[t]},e.prototype.endTransition=function(t){this.transition_&&(this.transitionStarts_[t]=-1)},e}(wr);function Rr(t,e){var i=document.createElement("canvas");return t&&(i.width=t),e&&(i.height=e),i.getContext("2d")}function Or(t,e){var i=e.parentNode;i&&i.replaceChild(t,e)}function Ir(t){return t&&t.parentNode?t.parentNode.removeChild(t):null}function Pr(t){for(;t.lastChild;)t.removeChild(t.lastChild)}var Fr=function(t){function e(e,i,n,r,o,s){t.call(this,e,i,s),this.crossOrigin_=r,this.src_=n,this.image_=new Image,null!==r&&(this.image_.crossOrigin=r),this.imageListenerKeys_=null,this.tileLoadFunction_=o}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.state==or.LOADING&&(this.unlistenImage_(),this.image_=Lr()),this.interimTile&&this.interimTile.dispose(),this.state=or.ABORT,this.changed(),t.prototype.disposeInternal.call(this)},e.prototype.getImage=function(){return this.image_},e.prototype.getKey=function(){return this.src_},e.prototype.handleImageError_=function(){this.state=or.ERROR,this.unlistenImage_(),this.image_=Lr(),this.changed()},e.prototype.handleImageLoad_=function(){this.image_.naturalWidth&&this.image_.naturalHeight?this.state=or.LOADED:this.state=or.EMPTY,this.unlistenImage_(),this.changed()},e.prototype.load=function(){this.state==or.ERROR&&(this.state=or.IDLE,this.image_=new Image,null!==this.crossOrigin_&&(this.image_.crossOrigin=this.crossOrigin_)),this.state==or.IDLE&&(this.state=or.LOADING,this.changed(),this.imageListenerKeys_=[yr(this.image_,br.ERROR,this.handleImageError_,this),yr(this.image_,br.LOAD,this.handleImageLoad_,this)],this.tileLoadFunction_(this,this.src_))},e.prototype.unlistenImage_=function(){this.imageListenerKeys_.forEach(_r),this.imageListenerKeys_=null},e}(Cr);function Lr(){var t=Rr(1,1);return t.fillStyle="rgba(0,0,0,0)",t.fillRect(0,0,1,1),t.canvas}var Mr=function(t){function e(e){t.call(this),this.highWaterMark=void 0!==e?e:2048,this.count_=0,this.entries_={},this.oldest_=null,this.newest_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.canExpireCache=function(){return this.getCount()>this.highWaterMark},e.prototype.clear=function(){this.count_=0,this.entries_={},this.oldest_=null,this.newest_=null,this.dispatchEvent(br.CLEAR)},e.prototype.containsKey=function(t){return this.entries_.hasOwnProperty(t)},e.prototype.forEach=function(t,e){for(var i=this.oldest_;i;)t.call(e,i.value_,i.key_,this),i=i.newer},e.prototype.get=function(t){var e=this.entries_[t];return sn(void 0!==e,15),e===this.newest_?e.value_:(e===this.oldest_?(this.oldest_=this.oldest_.newer,this.oldest_.older=null):(e.newer.older=e.older,e.older.newer=e.newer),e.newer=null,e.older=this.newest_,this.newest_.newer=e,this.newest_=e,e.value_)},e.prototype.remove=function(t){var e=this.entries_[t];return sn(void 0!==e,15),e===this.newest_?(this.newest_=e.older,this.newest_&&(this.newest_.newer=null)):e===this.oldest_?(this.oldest_=e.newer,this.oldest_&&(this.oldest_.older=null)):(e.newer.older=e.older,e.older.newer=e.newer),delete this.entries_[t],--this.count_,e.value_},e.prototype.getCount=function(){return this.count_},e.prototype.getKeys=function(){var t,e=new Array(this.count_),i=0;for(t=this.newest_;t;t=t.older)e[i++]=t.key_;return e},e.prototype.getValues=function(){var t,e=new Array(this.count_),i=0;for(t=this.newest_;t;t=t.older)e[i++]=t.value_;return e},e.prototype.peekLast=function(){return this.oldest_.value_},e.prototype.peekLastKey=function(){return this.oldest_.key_},e.prototype.peekFirstKey=function(){return this.newest_.key_},e.prototype.pop=function(){var t=this.oldest_;return delete this.entries_[t.key_],t.newer&&(t.newer.older=null),this.oldest_=t.newer,this.oldest_||(this.newest_=null),--this.count_,t.value_},e.prototype.replace=function(t,e){this.get(t),this.entries_[t].value_=e},e.prototype.set=function(t,e){sn(!(t in this.entries_),16);var i={key_:t,newer:null,older:this.newest_,value_:e};this.newest_?this.newest_.newer=i:this.oldest_=i,this.newest_=i,this.entries_[t]=i,++this.count_},e.prototype.setSize=function(t){this.highWaterMark=t},e.prototype.prune=function(){for(;this.canExpireCache();)this.pop()},e}(wr);function Ar(t,e,i,n){return void 0!==n?(n[0]=t,n[1]=e,n[2]=i,n):[t,e,i]}function Nr(t,e,i){return t+"/"+e+"/"+i}function kr(t){return Nr(t[0],t[1],t[2])}function jr(t){return(t[1]<<t[0])+t[2]}var Gr=function(t){function e(e){t.call(this,e)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.expireCache=function(t){for(;this.canExpireCache();){var e=this.peekLast(),i=e.tileCoord[0].toString();if(i in t&&t[i].contains(e.tileCoord))break;this.pop().dispose()}},e.prototype.pruneExceptNewestZ=function(){if(0!==this.getCount()){var t=function(t){return t.split("/").map(Number)}(this.peekFirstKey())[0];this.forEach(function(e){e.tileCoord[0]!==t&&(this.remove(kr(e.tileCoord)),e.dispose())},this)}},e}(Mr),Dr={POINT:"Point",LINE_STRING:"LineString",LINEAR_RING:"LinearRing",POLYGON:"Polygon",MULTI_POINT:"MultiPoint",MULTI_LINE_STRING:"MultiLineString",MULTI_POLYGON:"MultiPolygon",GEOMETRY_COLLECTION:"GeometryCollection",CIRCLE:"Circle"},$r=6371008.8;function Vr(t,e,i){var n=i||$r,r=Wn(t[1]),o=Wn(e[1]),s=(o-r)/2,a=Wn(e[0]-t[0])/2,h=Math.sin(s)*Math.sin(s)+Math.sin(a)*Math.sin(a)*Math.cos(r)*Math.cos(o);return 2*n*Math.atan2(Math.sqrt(h),Math.sqrt(1-h))}function zr(t,e,i,n){var r=n||$r,o=Wn(t[1]),s=Wn(t[0]),a=e/r,h=Math.asin(Math.sin(o)*Math.cos(a)+Math.cos(o)*Math.sin(a)*Math.cos(i));return[Kn(s+Math.atan2(Math.sin(i)*Math.sin(a)*Math.cos(o),Math.cos(a)-Math.sin(o)*Math.sin(h))),Kn(h)]}var Yr={DEGREES:"degrees",FEET:"ft",METERS:"m",PIXELS:"pixels",TILE_PIXELS:"tile-pixels",USFEET:"us-ft"},Xr={};Xr[Yr.DEGREES]=2*Math.PI*6370997/360,Xr[Yr.FEET]=.3048,Xr[Yr.METERS]=1,Xr[Yr.USFEET]=1200/3937;var Ur=function(t){this.code_=t.code,this.units_=t.units,this.extent_=void 0!==t.extent?t.extent:null,this.worldExtent_=void 0!==t.worldExtent?t.worldExtent:null,this.axisOrientation_=void 0!==t.axisOrientation?t.axisOrientation:"enu",this.global_=void 0!==t.global&&t.global,this.canWrapX_=!(!this.global_||!this.extent_),this.getPointResolutionFunc_=t.getPointResolution,this.defaultTileGrid_=null,this.metersPerUnit_=t.metersPerUnit};Ur.prototype.canWrapX=function(){return this.canWrapX_},Ur.prototype.getCode=function(){return this.code_},Ur.prototype.getExtent=function(){return this.extent_},Ur.prototype.getUnits=function(){return this.units_},Ur.prototype.getMetersPerUnit=function(){return this.metersPerUnit_||Xr[this.units_]},Ur.prototype.getWorldExtent=function(){return this.worldExtent_},Ur.prototype.getAxisOrientation=function(){return this.axisOrientation_},Ur.prototype.isGlobal=function(){return this.global_},Ur.prototype.setGlobal=function(t){this.global_=t,this.canWrapX_=!(!t||!this.extent_)},Ur.prototype.getDefaultTileGrid=function(){return this.defaultTileGrid_},Ur.prototype.setDefaultTileGrid=function(t){this.defaultTileGrid_=t},Ur.prototype.setExtent=function(t){this.extent_=t,this.canWrapX_=!(!this.global_||!t)},Ur.prototype.setWorldExtent=function(t){this.worldExtent_=t},Ur.prototype.setGetPointResolution=function(t){this.getPointResolutionFunc_=t},Ur.prototype.getPointResolutionFunc=function(){return this.getPointResolutionFunc_};var Br=6378137,Kr=Math.PI*Br,Wr=[-Kr,-Kr,Kr,Kr],Hr=[-180,-85,180,85],Zr=function(t){function e(e){t.call(this,{code:e,units:Yr.METERS,extent:Wr,global:!0,worldExtent:Hr,getPointResolution:function(t,e){return t/Xn(e[1]/Br)}})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Ur),qr=[new Zr("EPSG:3857"),new Zr("EPSG:102100"),new Zr("EPSG:102113"),new Zr("EPSG:900913"),new Zr("urn:ogc:def:crs:EPSG:6.18:3:3857"),new Zr("urn:ogc:def:crs:EPSG::3857"),new Zr("http://www.opengis.net/gml/srs/epsg.xml#3857")];function Jr(t,e,i){var n=t.length,r=i>1?i:2,o=e;void 0===o&&(o=r>2?t.slice():new Array(n));for(var s=Kr,a=0;a<n;a+=r){o[a]=s*t[a]/180;var h=Br*Math.log(Math.tan(Math.PI*(t[a+1]+90)/360));h>s?h=s:h<-s&&(h=-s),o[a+1]=h}return o}function Qr(t,e,i){var n=t.length,r=i>1?i:2,o=e;void 0===o&&(o=r>2?t.slice():new Array(n));for(var s=0;s<n;s+=r)o[s]=180*t[s]/Kr,o[s+1]=360*Math.atan(Math.exp(t[s+1]/Br))/Math.PI-90;return o}var to=[-180,-90,180,90],eo=6378137*Math.PI/180,io=function(t){function e(e,i){t.call(this,{code:e,units:Yr.DEGREES,extent:to,axisOrientation:i,global:!0,metersPerUnit:eo,worldExtent:to})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Ur),no=[new io("CRS:84"),new io("EPSG:4326","neu"),new io("urn:ogc:def:crs:EPSG::4326","neu"),new io("urn:ogc:def:crs:EPSG:6.6:4326","neu"),new io("urn:ogc:def:crs:OGC:1.3:CRS84"),new io("urn:ogc:def:crs:OGC:2:84"),new io("http://www.opengis.net/gml/srs/epsg.xml#4326","neu"),new io("urn:x-ogc:def:crs:EPSG:4326","neu")],ro={};var oo,so,ao,ho={};function uo(t,e,i){var n=t.getCode(),r=e.getCode();n in ho||(ho[n]={}),ho[n][r]=i}function lo(t,e,i){var n;if(void 0!==e){for(var r=0,o=t.length;r<o;++r)e[r]=t[r];n=e}else n=t.slice();return n}function co(t,e,i){if(void 0!==e&&t!==e){for(var n=0,r=t.length;n<r;++n)e[n]=t[n];t=e}return t}function po(t){!function(t,e){ro[t]=e}(t.getCode(),t),uo(t,t,lo)}function fo(t){var e=null;if(t instanceof Ur)e=t;else if("string"==typeof t){e=function(t){return ro[t]||null}(t)}return e}function go(t,e,i,n){var r,o=(t=fo(t)).getPointResolutionFunc();if(o)r=o(e,i);else if(t.getUnits()==Yr.DEGREES&&!n||n==Yr.DEGREES)r=e;else{var s=mo(t,fo("EPSG:4326")),a=[i[0]-e/2,i[1],i[0]+e/2,i[1],i[0],i[1]-e/2,i[0],i[1]+e/2];r=(Vr((a=s(a,a,2)).slice(0,2),a.slice(2,4))+Vr(a.slice(4,6),a.slice(6,8)))/2;var h=n?Xr[n]:t.getMetersPerUnit();void 0!==h&&(r/=h)}return r}function yo(t){!function(t){t.forEach(po)}(t),t.forEach(function(e){t.forEach(function(t){e!==t&&uo(e,t,lo)})})}function vo(t,e){return t?"string"==typeof t?fo(t):t:fo(e)}function _o(t,e){if(t===e)return!0;var i=t.getUnits()===e.getUnits();return t.getCode()===e.getCode()?i:mo(t,e)===lo&&i}function mo(t,e){var i=function(t,e){var i;return t in ho&&e in ho[t]&&(i=ho[t][e]),i}(t.getCode(),e.getCode());return i||(i=co),i}function Eo(t,e){return mo(fo(t),fo(e))}function xo(t,e,i){return Eo(e,i)(t,void 0,t.length)}function So(t,e,i){return zn(t,Eo(e,i))}function To(t,e,i,n){var r=xo(i,e,t),o=go(e,n,i),s=e.getMetersPerUnit();void 0!==s&&(o*=s);var a=t.getMetersPerUnit();void 0!==a&&(o/=a);var h=t.getExtent();if(!h||fn(h,r)){var u=go(t,o,r)/o;isFinite(u)&&u>0&&(o/=u)}return o}function wo(t,e,i,n){var r=i-t,o=n-e,s=Math.sqrt(r*r+o*o);return[Math.round(i+r/s),Math.round(n+o/s)]}function bo(t,e,i,n,r,o,s,a,h,u,l){var c=Rr(Math.round(i*t),Math.round(i*e));if(0===h.length)return c.canvas;c.scale(i,i);var p=[1/0,1/0,-1/0,-1/0];h.forEach(function(t,e,i){wn(p,t.extent)});var f=Gn(p),d=An(p),g=Rr(Math.round(i*f/n),Math.round(i*d/n)),y=i/n;h.forEach(function(t,e,i){var n=t.extent[0]-p[0],r=-(t.extent[3]-p[3]),o=Gn(t.extent),s=An(t.extent);g.drawImage(t.image,u,u,t.image.width-2*u,t.image.height-2*u,n*y,r*y,o*y,s*y)});var v=kn(s);return a.getTriangles().forEach(function(t,e,r){var s=t.source,a=t.target,h=s[0][0],u=s[0][1],l=s[1][0],f=s[1][1],d=s[2][0],y=s[2][1],_=(a[0][0]-v[0])/o,m=-(a[0][1]-v[1])/o,E=(a[1][0]-v[0])/o,x=-(a[1][1]-v[1])/o,S=(a[2][0]-v[0])/o,T=-(a[2][1]-v[1])/o,w=h,b=u;h=0,u=0;var C=function(t){for(var e=t.length,i=0;i<e;i++){for(var n=i,r=Math.abs(t[i][i]),o=i+1;o<e;o++){var s=Math.abs(t[o][i]);s>r&&(r=s,n=o)}if(0===r)return null;var a=t[n];t[n]=t[i],t[i]=a;for(var h=i+1;h<e;h++)for(var u=-t[h][i]/t[i][i],l=i;l<e+1;l++)i==l?t[h][l]=0:t[h][l]+=u*t[i][l]}for(var c=new Array(e),p=e-1;p>=0;p--){c[p]=t[p][e]/t[p][p];for(var f=p-1;f>=0;f--)t[f][e]-=t[f][p]*c[p]}return c}([[l-=w,f-=b,0,0,E-_],[d-=w,y-=b,0,0,S-_],[0,0,l,f,x-m],[0,0,d,y,T-m]]);if(C){c.save(),c.beginPath();var R=(_+E+S)/3,O=(m+x+T)/3,I=wo(R,O,_,m),P=wo(R,O,E,x),F=wo(R,O,S,T);c.moveTo(P[0],P[1]),c.lineTo(I[0],I[1]),c.lineTo(F[0],F[1]),c.clip(),c.transform(C[0],C[2],C[1],C[3],_,m),c.translate(p[0]-w,p[3]-b),c.scale(n/i,-n/i),c.drawImage(g.canvas,0,0),c.restore()}}),l&&(c.save(),c.strokeStyle="black",c.lineWidth=1,a.getTriangles().forEach(function(t,e,i){var n=t.target,r=(n[0][0]-v[0])/o,s=-(n[0][1]-v[1])/o,a=(n[1][0]-v[0])/o,h=-(n[1][1]-v[1])/o,u=(n[2][0]-v[0])/o,l=-(n[2][1]-v[1])/o;c.beginPath(),c.moveTo(a,h),c.lineTo(r,s),c.lineTo(u,l),c.closePath(),c.stroke()}),c.restore()),c.canvas}yo(qr),yo(no),oo=qr,so=Jr,ao=Qr,no.forEach(function(t){oo.forEach(function(e){uo(t,e,so),uo(e,t,ao)})});var Co=function(t,e,i,n,r){this.sourceProj_=t,this.targetProj_=e;var o={},s=Eo(this.targetProj_,this.sourceProj_);this.transformInv_=function(t){var e=t[0]+"/"+t[1];return o[e]||(o[e]=s(t)),o[e]},this.maxSourceExtent_=n,this.errorThresholdSquared_=r*r,this.triangles_=[],this.wrapsXInSource_=!1,this.canWrapXInSource_=this.sourceProj_.canWrapX()&&!!n&&!!this.sourceProj_.getExtent()&&Gn(n)==Gn(this.sourceProj_.getExtent()),this.sourceWorldWidth_=this.sourceProj_.getExtent()?Gn(this.sourceProj_.getExtent()):null,this.targetWorldWidth_=this.targetProj_.getExtent()?Gn(this.targetProj_.getExtent()):null;var a=kn(i),h=jn(i),u=Fn(i),l=Pn(i),c=this.transformInv_(a),p=this.transformInv_(h),f=this.transformInv_(u),d=this.transformInv_(l);if(this.addQuad_(a,h,u,l,c,p,f,d,10),this.wrapsXInSource_){var g=1/0;this.triangles_.forEach(function(t,e,i){g=Math.min(g,t.source[0][0],t.source[1][0],t.source[2][0])}),this.triangles_.forEach(function(t){if(Math.max(t.source[0][0],t.source[1][0],t.source[2][0])-g>this.sourceWorldWidth_/2){var e=[[t.source[0][0],t.source[0][1]],[t.source[1][0],t.source[1][1]],[t.source[2][0],t.source[2][1]]];e[0][0]-g>this.sourceWorldWidth_/2&&(e[0][0]-=this.sourceWorldWidth_),e[1][0]-g>this.sourceWorldWidth_/2&&(e[1][0]-=this.sourceWorldWidth_),e[2][0]-g>this.sourceWorldWidth_/2&&(e[2][0]-=this.sourceWorldWidth_);var i=Math.min(e[0][0],e[1][0],e[2][0]);Math.max(e[0][0],e[1][0],e[2][0])-i<this.sourceWorldWidth_/2&&(t.source=e)}}.bind(this))}o={}};Co.prototype.addTriangle_=function(t,e,i,n,r,o){this.triangles_.push({source:[n,r,o],target:[t,e,i]})},Co.prototype.addQuad_=function(t,e,i,n,r,o,s,a,h){var u=un([r,o,s,a]),l=this.sourceWorldWidth_?Gn(u)/this.sourceWorldWidth_:null,c=this.sourceWorldWidth_,p=this.sourceProj_.canWrapX()&&l>.5&&l<1,f=!1;if(h>0){if(this.targetProj_.isGlobal()&&this.targetWorldWidth_)f|=Gn(un([t,e,i,n]))/this.targetWorldWidth_>.25;!p&&this.sourceProj_.isGlobal()&&l&&(f|=l>.25)}if(f||!this.maxSourceExtent_||Dn(u,this.maxSourceExtent_)){if(!(f||isFinite(r[0])&&isFinite(r[1])&&isFinite(o[0])&&isFinite(o[1])&&isFinite(s[0])&&isFinite(s[1])&&isFinite(a[0])&&isFinite(a[1]))){if(!(h>0))return;f=!0}if(h>0){if(!f){var d,g=[(t[0]+i[0])/2,(t[1]+i[1])/2],y=this.transformInv_(g);if(p)d=(Hn(r[0],c)+Hn(s[0],c))/2-Hn(y[0],c);else d=(r[0]+s[0])/2-y[0];var v=(r[1]+s[1])/2-y[1];f=d*d+v*v>this.errorThresholdSquared_}if(f){if(Math.abs(t[0]-i[0])<=Math.abs(t[1]-i[1])){var _=[(e[0]+i[0])/2,(e[1]+i[1])/2],m=this.transformInv_(_),E=[(n[0]+t[0])/2,(n[1]+t[1])/2],x=this.transformInv_(E);this.addQuad_(t,e,_,E,r,o,m,x,h-1),this.addQuad_(E,_,i,n,x,m,s,a,h-1)}else{var S=[(t[0]+e[0])/2,(t[1]+e[1])/2],T=this.transformInv_(S),w=[(i[0]+n[0])/2,(i[1]+n[1])/2],b=this.transformInv_(w);this.addQuad_(t,S,w,n,r,T,b,a,h-1),this.addQuad_(S,e,i,w,T,o,s,b,h-1)}return}}if(p){if(!this.canWrapXInSource_)return;this.wrapsXInSource_=!0}this.addTriangle_(t,i,n,r,s,a),this.addTriangle_(t,e,i,r,o,s)}},Co.prototype.calculateSourceExtent=function(){var t=[1/0,1/0,-1/0,-1/0];return this.triangles_.forEach(function(e,i,n){var r=e.source;bn(t,r[0]),bn(t,r[1]),bn(t,r[2])}),t},Co.prototype.getTriangles=function(){return this.triangles_};var Ro=function(t){function e(e,i,n,r,o,s,a,h,u,l,c){t.call(this,o,or.IDLE),this.renderEdges_=void 0!==c&&c,this.pixelRatio_=a,this.gutter_=h,this.canvas_=null,this.sourceTileGrid_=i,this.targetTileGrid_=r,this.wrappedTileCoord_=s||o,this.sourceTiles_=[],this.sourcesListenerKeys_=null,this.sourceZ_=0;var p=r.getTileCoordExtent(this.wrappedTileCoord_),f=this.targetTileGrid_.getExtent(),d=this.sourceTileGrid_.getExtent(),g=f?Nn(p,f):p;if(0!==In(g)){var y=e.getExtent();y&&(d=d?Nn(d,y):y);var v=r.getResolution(this.wrappedTileCoord_[0]),_=To(e,n,Ln(g),v);if(!isFinite(_)||_<=0)this.state=or.EMPTY;else{var m=void 0!==l?l:rr;if(this.triangulation_=new Co(e,n,g,d,_*m),0!==this.triangulation_.getTriangles().length){this.sourceZ_=i.getZForResolution(_);var E=this.triangulation_.calculateSourceExtent();if(d&&(e.canWrapX()?(E[1]=Yn(E[1],d[1],d[3]),E[3]=Yn(E[3],d[1],d[3])):E=Nn(E,d)),In(E)){for(var x=i.getTileRangeForExtentAndZ(E,this.sourceZ_),S=x.minX;S<=x.maxX;S++)for(var T=x.minY;T<=x.maxY;T++){var w=u(this.sourceZ_,S,T,a);w&&this.sourceTiles_.push(w)}0===this.sourceTiles_.length&&(this.state=or.EMPTY)}else this.state=or.EMPTY}else this.state=or.EMPTY}}else this.state=or.EMPTY}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.state==or.LOADING&&this.unlistenSources_(),t.prototype.disposeInternal.call(this)},e.prototype.getImage=function(){return this.canvas_},e.prototype.reproject_=function(){var t=[];if(this.sourceTiles_.forEach(function(e,i,n){e&&e.getState()==or.LOADED&&t.push({extent:this.sourceTileGrid_.getTileCoordExtent(e.tileCoord),image:e.getImage()})}.bind(this)),this.sourceTiles_.length=0,0===t.length)this.state=or.ERROR;else{var e=this.wrappedTileCoord_[0],i=this.targetTileGrid_.getTileSize(e),n="number"==typeof i?i:i[0],r="number"==typeof i?i:i[1],o=this.targetTileGrid_.getResolution(e),s=this.sourceTileGrid_.getResolution(this.sourceZ_),a=this.targetTileGrid_.getTileCoordExtent(this.wrappedTileCoord_);this.canvas_=bo(n,r,this.pixelRatio_,s,this.sourceTileGrid_.getExtent(),o,a,this.triangulation_,t,this.gutter_,this.renderEdges_),this.state=or.LOADED}this.changed()},e.prototype.load=function(){if(this.state==or.IDLE){this.state=or.LOADING,this.changed();var t=0;this.sourcesListenerKeys_=[],this.sourceTiles_.forEach(function(e,i,n){var r=e.getState();if(r==or.IDLE||r==or.LOADING){t++;var o=gr(e,br.CHANGE,function(i){var n=e.getState();n!=or.LOADED&&n!=or.ERROR&&n!=or.EMPTY||(_r(o),0===--t&&(this.unlistenSources_(),this.reproject_()))},this);this.sourcesListenerKeys_.push(o)}}.bind(this)),this.sourceTiles_.forEach(function(t,e,i){t.getState()==or.IDLE&&t.load()}),0===t&&setTimeout(this.reproject_.bind(this),0)}},e.prototype.unlistenSources_=function(){this.sourcesListenerKeys_.forEach(_r),this.sourcesListenerKeys_=null},e}(Cr);function Oo(t,e){var i=/\{z\}/g,n=/\{x\}/g,r=/\{y\}/g,o=/\{-y\}/g;return function(s,a,h){return s?t.replace(i,s[0].toString()).replace(n,s[1].toString()).replace(r,function(){return(-s[2]-1).toString()}).replace(o,function(){var t=s[0],i=e.getFullTileRange(t);return sn(i,55),(i.getHeight()+s[2]).toString()}):void 0}}function Io(t,e){for(var i=t.length,n=new Array(i),r=0;r<i;++r)n[r]=Oo(t[r],e);return Po(n)}function Po(t){return 1===t.length?t[0]:function(e,i,n){if(e){var r=Hn(jr(e),t.length);return t[r](e,i,n)}}}function Fo(t,e,i){}function Lo(t){var e=[],i=/\{([a-z])-([a-z])\}/.exec(t);if(i){var n,r=i[1].charCodeAt(0),o=i[2].charCodeAt(0);for(n=r;n<=o;++n)e.push(t.replace(i[0],String.fromCharCode(n)));return e}if(i=i=/\{(\d+)-(\d+)\}/.exec(t)){for(var s=parseInt(i[2],10),a=parseInt(i[1],10);a<=s;a++)e.push(t.replace(i[0],a.toString()));return e}return e.push(t),e}var Mo="propertychange",Ao=function(t){function e(){t.call(this),this.revision_=0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.changed=function(){++this.revision_,this.dispatchEvent(br.CHANGE)},e.prototype.getRevision=function(){return this.revision_},e.prototype.on=function(t,e){if(Array.isArray(t)){for(var i=t.length,n=new Array(i),r=0;r<i;++r)n[r]=gr(this,t[r],e);return n}return gr(this,t,e)},e.prototype.once=function(t,e){if(Array.isArray(t)){for(var i=t.length,n=new Array(i),r=0;r<i;++r)n[r]=yr(this,t[r],e);return n}return yr(this,t,e)},e.prototype.un=function(t,e){if(Array.isArray(t))for(var i=0,n=t.length;i<n;++i)vr(this,t[i],e);else vr(this,t,e)},e}(wr),No=function(t){function e(e,i,n){t.call(this,e),this.key=i,this.oldValue=n}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),ko=function(t){function e(e){t.call(this),nn(this),this.values_={},void 0!==e&&this.setProperties(e)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.get=function(t){var e;return this.values_.hasOwnProperty(t)&&(e=this.values_[t]),e},e.prototype.getKeys=function(){return Object.keys(this.values_)},e.prototype.getProperties=function(){return qn({},this.values_)},e.prototype.notify=function(t,e){var i;i=Go(t),this.dispatchEvent(new No(i,t,e)),i=Mo,this.dispatchEvent(new No(i,t,e))},e.prototype.set=function(t,e,i){if(i)this.values_[t]=e;else{var n=this.values_[t];this.values_[t]=e,n!==e&&this.notify(t,n)}},e.prototype.setProperties=function(t,e){for(var i in t)this.set(i,t[i],e)},e.prototype.unset=function(t,e){if(t in this.values_){var i=this.values_[t];delete this.values_[t],e||this.notify(t,i)}},e}(Ao),jo={};function Go(t){return jo.hasOwnProperty(t)?jo[t]:jo[t]="change:"+t}var Do={UNDEFINED:"undefined",LOADING:"loading",READY:"ready",ERROR:"error"},$o=function(t){function e(e){t.call(this),this.projection_=fo(e.projection),this.attributions_=this.adaptAttributions_(e.attributions),this.loading=!1,this.state_=void 0!==e.state?e.state:Do.READY,this.wrapX_=void 0!==e.wrapX&&e.wrapX}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.adaptAttributions_=function(t){return t?Array.isArray(t)?function(e){return t}:"function"==typeof t?t:function(e){return[t]}:null},e.prototype.getAttributions=function(){return this.attributions_},e.prototype.getProjection=function(){return this.projection_},e.prototype.getResolutions=function(){},e.prototype.getState=function(){return this.state_},e.prototype.getWrapX=function(){return this.wrapX_},e.prototype.refresh=function(){this.changed()},e.prototype.setAttributions=function(t){this.attributions_=this.adaptAttributions_(t),this.changed()},e.prototype.setState=function(t){this.state_=t,this.changed()},e}(ko);$o.prototype.forEachFeatureAtCoordinate=xr;var Vo=42,zo=256,Yo=function(t,e,i,n){this.minX=t,this.maxX=e,this.minY=i,this.maxY=n};function Xo(t,e,i,n,r){return void 0!==r?(r.minX=t,r.maxX=e,r.minY=i,r.maxY=n,r):new Yo(t,e,i,n)}function Uo(t,e){return t>e?1:t<e?-1:0}function Bo(t,e){return t.indexOf(e)>=0}function Ko(t,e,i){var n,r=t.length;if(t[0]<=e)return 0;if(e<=t[r-1])return r-1;if(i>0){for(n=1;n<r;++n)if(t[n]<e)return n-1}else if(i<0){for(n=1;n<r;++n)if(t[n]<=e)return n}else for(n=1;n<r;++n){if(t[n]==e)return n;if(t[n]<e)return t[n-1]-e<e-t[n]?n-1:n}return r-1}function Wo(t,e,i){for(;e<i;){var n=t[e];t[e]=t[i],t[i]=n,++e,--i}}function Ho(t,e){for(var i=Array.isArray(e)?e:[e],n=i.length,r=0;r<n;r++)t[t.length]=i[r]}function Zo(t,e){var i=t.length;if(i!==e.length)return!1;for(var n=0;n<i;n++)if(t[n]!==e[n])return!1;return!0}Yo.prototype.contains=function(t){return this.containsXY(t[1],t[2])},Yo.prototype.containsTileRange=function(t){return this.minX<=t.minX&&t.maxX<=this.maxX&&this.minY<=t.minY&&t.maxY<=this.maxY},Yo.prototype.containsXY=function(t,e){return this.minX<=t&&t<=this.maxX&&this.minY<=e&&e<=this.maxY},Yo.prototype.equals=function(t){return this.minX==t.minX&&this.minY==t.minY&&this.maxX==t.maxX&&this.maxY==t.maxY},Yo.prototype.extend=function(t){t.minX<this.minX&&(this.minX=t.minX),t.maxX>this.maxX&&(this.maxX=t.maxX),t.minY<this.minY&&(this.minY=t.minY),t.maxY>this.maxY&&(this.maxY=t.maxY)},Yo.prototype.getHeight=function(){return this.maxY-this.minY+1},Yo.prototype.getSize=function(){return[this.getWidth(),this.getHeight()]},Yo.prototype.getWidth=function(){return this.maxX-this.minX+1},Yo.prototype.intersects=function(t){return this.minX<=t.maxX&&this.maxX>=t.minX&&this.minY<=t.maxY&&this.maxY>=t.minY};var qo=[0,0,0],Jo=function(t){var e,i,n,r;if(this.minZoom=void 0!==t.minZoom?t.minZoom:0,this.resolutions_=t.resolutions,sn((e=this.resolutions_,i=!0,n=function(t,e){return e-t}||Uo,e.every(function(t,r){if(0===r)return!0;var o=n(e[r-1],t);return!(o>0||i&&0===o)})),17),!t.origins)for(var o=0,s=this.resolutions_.length-1;o<s;++o)if(r){if(this.resolutions_[o]/this.resolutions_[o+1]!==r){r=void 0;break}}else r=this.resolutions_[o]/this.resolutions_[o+1];this.zoomFactor_=r,this.maxZoom=this.resolutions_.length-1,this.origin_=void 0!==t.origin?t.origin:null,this.origins_=null,void 0!==t.origins&&(this.origins_=t.origins,sn(this.origins_.length==this.resolutions_.length,20));var a=t.extent;void 0===a||this.origin_||this.origins_||(this.origin_=kn(a)),sn(!this.origin_&&this.origins_||this.origin_&&!this.origins_,18),this.tileSizes_=null,void 0!==t.tileSizes&&(this.tileSizes_=t.tileSizes,sn(this.tileSizes_.length==this.resolutions_.length,19)),this.tileSize_=void 0!==t.tileSize?t.tileSize:this.tileSizes_?null:zo,sn(!this.tileSize_&&this.tileSizes_||this.tileSize_&&!this.tileSizes_,22),this.extent_=void 0!==a?a:null,this.fullTileRanges_=null,this.tmpSize_=[0,0],void 0!==t.sizes?this.fullTileRanges_=t.sizes.map(function(t,e){return new Yo(Math.min(0,t[0]),Math.max(t[0]-1,-1),Math.min(0,t[1]),Math.max(t[1]-1,-1))},this):a&&this.calculateTileRanges_(a)};function Qo(t){var e=t.getDefaultTileGrid();return e||(e=ns(t),t.setDefaultTileGrid(e)),e}function ts(t,e,i,n){var r=void 0!==n?n:an.TOP_LEFT,o=is(t,e,i);return new Jo({extent:t,origin:function(t,e){var i;return e===an.BOTTOM_LEFT?i=Pn(t):e===an.BOTTOM_RIGHT?i=Fn(t):e===an.TOP_LEFT?i=kn(t):e===an.TOP_RIGHT?i=jn(t):sn(!1,13),i}(t,r),resolutions:o,tileSize:i})}function es(t){var e={};return qn(e,void 0!==t?t:{}),void 0===e.extent&&(e.extent=fo("EPSG:3857").getExtent()),e.resolutions=is(e.extent,e.maxZoom,e.tileSize),delete e.maxZoom,new Jo(e)}function is(t,e,i){for(var n=void 0!==e?e:Vo,r=An(t),o=Gn(t),s=nr(void 0!==i?i:zo),a=Math.max(o/s[0],r/s[1]),h=n+1,u=new Array(h),l=0;l<h;++l)u[l]=a/Math.pow(2,l);return u}function ns(t,e,i,n){return ts(rs(t),e,i,n)}function rs(t){var e=(t=fo(t)).getExtent();if(!e){var i=180*Xr[Yr.DEGREES]/t.getMetersPerUnit();e=_n(-i,-i,i,i)}return e}Jo.prototype.forEachTileCoord=function(t,e,i){for(var n=this.getTileRangeForExtentAndZ(t,e),r=n.minX,o=n.maxX;r<=o;++r)for(var s=n.minY,a=n.maxY;s<=a;++s)i([e,r,s])},Jo.prototype.forEachTileCoordParentTileRange=function(t,e,i,n,r){var o,s,a,h=null,u=t[0]-1;for(2===this.zoomFactor_?(s=t[1],a=t[2]):h=this.getTileCoordExtent(t,r);u>=this.minZoom;){if(o=2===this.zoomFactor_?Xo(s=Math.floor(s/2),s,a=Math.floor(a/2),a,n):this.getTileRangeForExtentAndZ(h,u,n),e.call(i,u,o))return!0;--u}return!1},Jo.prototype.getExtent=function(){return this.extent_},Jo.prototype.getMaxZoom=function(){return this.maxZoom},Jo.prototype.getMinZoom=function(){return this.minZoom},Jo.prototype.getOrigin=function(t){return this.origin_?this.origin_:this.origins_[t]},Jo.prototype.getResolution=function(t){return this.resolutions_[t]},Jo.prototype.getResolutions=function(){return this.resolutions_},Jo.prototype.getTileCoordChildTileRange=function(t,e,i){if(t[0]<this.maxZoom){if(2===this.zoomFactor_){var n=2*t[1],r=2*t[2];return Xo(n,n+1,r,r+1,e)}var o=this.getTileCoordExtent(t,i);return this.getTileRangeForExtentAndZ(o,t[0]+1,e)}return null},Jo.prototype.getTileRangeExtent=function(t,e,i){var n=this.getOrigin(t),r=this.getResolution(t),o=nr(this.getTileSize(t),this.tmpSize_),s=n[0]+e.minX*o[0]*r,a=n[0]+(e.maxX+1)*o[0]*r;return _n(s,n[1]+e.minY*o[1]*r,a,n[1]+(e.maxY+1)*o[1]*r,i)},Jo.prototype.getTileRangeForExtentAndZ=function(t,e,i){var n=qo;this.getTileCoordForXYAndZ_(t[0],t[1],e,!1,n);var r=n[1],o=n[2];return this.getTileCoordForXYAndZ_(t[2],t[3],e,!0,n),Xo(r,n[1],o,n[2],i)},Jo.prototype.getTileCoordCenter=function(t){var e=this.getOrigin(t[0]),i=this.getResolution(t[0]),n=nr(this.getTileSize(t[0]),this.tmpSize_);return[e[0]+(t[1]+.5)*n[0]*i,e[1]+(t[2]+.5)*n[1]*i]},Jo.prototype.getTileCoordExtent=function(t,e){var i=this.getOrigin(t[0]),n=this.getResolution(t[0]),r=nr(this.getTileSize(t[0]),this.tmpSize_),o=i[0]+t[1]*r[0]*n,s=i[1]+t[2]*r[1]*n;return _n(o,s,o+r[0]*n,s+r[1]*n,e)},Jo.prototype.getTileCoordForCoordAndResolution=function(t,e,i){return this.getTileCoordForXYAndResolution_(t[0],t[1],e,!1,i)},Jo.prototype.getTileCoordForXYAndResolution_=function(t,e,i,n,r){var o=this.getZForResolution(i),s=i/this.getResolution(o),a=this.getOrigin(o),h=nr(this.getTileSize(o),this.tmpSize_),u=n?.5:0,l=n?0:.5,c=Math.floor((t-a[0])/i+u),p=Math.floor((e-a[1])/i+l),f=s*c/h[0],d=s*p/h[1];return n?(f=Math.ceil(f)-1,d=Math.ceil(d)-1):(f=Math.floor(f),d=Math.floor(d)),Ar(o,f,d,r)},Jo.prototype.getTileCoordForXYAndZ_=function(t,e,i,n,r){var o=this.getOrigin(i),s=this.getResolution(i),a=nr(this.getTileSize(i),this.tmpSize_),h=n?.5:0,u=n?0:.5,l=Math.floor((t-o[0])/s+h),c=Math.floor((e-o[1])/s+u),p=l/a[0],f=c/a[1];return n?(p=Math.ceil(p)-1,f=Math.ceil(f)-1):(p=Math.floor(p),f=Math.floor(f)),Ar(i,p,f,r)},Jo.prototype.getTileCoordForCoordAndZ=function(t,e,i){return this.getTileCoordForXYAndZ_(t[0],t[1],e,!1,i)},Jo.prototype.getTileCoordResolution=function(t){return this.resolutions_[t[0]]},Jo.prototype.getTileSize=function(t){return this.tileSize_?this.tileSize_:this.tileSizes_[t]},Jo.prototype.getFullTileRange=function(t){return this.fullTileRanges_?this.fullTileRanges_[t]:null},Jo.prototype.getZForResolution=function(t,e){return Yn(Ko(this.resolutions_,t,e||0),this.minZoom,this.maxZoom)},Jo.prototype.calculateTileRanges_=function(t){for(var e=this.resolutions_.length,i=new Array(e),n=this.minZoom;n<e;++n)i[n]=this.getTileRangeForExtentAndZ(t,n);this.fullTileRanges_=i};var os=function(t){function e(e){t.call(this,{attributions:e.attributions,extent:e.extent,projection:e.projection,state:e.state,wrapX:e.wrapX}),this.opaque_=void 0!==e.opaque&&e.opaque,this.tilePixelRatio_=void 0!==e.tilePixelRatio?e.tilePixelRatio:1,this.tileGrid=void 0!==e.tileGrid?e.tileGrid:null,this.tileCache=new Gr(e.cacheSize),this.tmpSize=[0,0],this.key_="",this.tileOptions={transition:e.transition}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.canExpireCache=function(){return this.tileCache.canExpireCache()},e.prototype.expireCache=function(t,e){var i=this.getTileCacheForProjection(t);i&&i.expireCache(e)},e.prototype.forEachLoadedTile=function(t,e,i,n){var r=this.getTileCacheForProjection(t);if(!r)return!1;for(var o,s,a,h=!0,u=i.minX;u<=i.maxX;++u)for(var l=i.minY;l<=i.maxY;++l)s=Nr(e,u,l),a=!1,r.containsKey(s)&&(a=(o=r.get(s)).getState()===or.LOADED)&&(a=!1!==n(o)),a||(h=!1);return h},e.prototype.getGutterForProjection=function(t){return 0},e.prototype.getKey=function(){return this.key_},e.prototype.setKey=function(t){this.key_!==t&&(this.key_=t,this.changed())},e.prototype.getOpaque=function(t){return this.opaque_},e.prototype.getResolutions=function(){return this.tileGrid.getResolutions()},e.prototype.getTile=function(t,e,i,n,r){},e.prototype.getTileGrid=function(){return this.tileGrid},e.prototype.getTileGridForProjection=function(t){return this.tileGrid?this.tileGrid:Qo(t)},e.prototype.getTileCacheForProjection=function(t){var e=this.getProjection();return e&&!_o(e,t)?null:this.tileCache},e.prototype.getTilePixelRatio=function(t){return this.tilePixelRatio_},e.prototype.getTilePixelSize=function(t,e,i){var n=this.getTileGridForProjection(i),r=this.getTilePixelRatio(e),o=nr(n.getTileSize(t),this.tmpSize);return 1==r?o:ir(o,r,this.tmpSize)},e.prototype.getTileCoordForTileUrlFunction=function(t,e){var i=void 0!==e?e:this.getProjection(),n=this.getTileGridForProjection(i);return this.getWrapX()&&i.isGlobal()&&(t=function(t,e,i){var n=e[0],r=t.getTileCoordCenter(e),o=rs(i);if(fn(o,r))return e;var s=Gn(o),a=Math.ceil((o[0]-r[0])/s);return r[0]+=s*a,t.getTileCoordForCoordAndZ(r,n)}(n,t,i)),function(t,e){var i=t[0],n=t[1],r=t[2];if(e.getMinZoom()>i||i>e.getMaxZoom())return!1;var o,s=e.getExtent();return!(o=s?e.getTileRangeForExtentAndZ(s,i):e.getFullTileRange(i))||o.containsXY(n,r)}(t,n)?t:null},e.prototype.refresh=function(){this.tileCache.clear(),this.changed()},e}($o);os.prototype.useTile=xr;var ss=function(t){function e(e,i){t.call(this,e),this.tile=i}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),as="tileloadstart",hs="tileloadend",us="tileloaderror",ls=function(t){function e(e){t.call(this,{attributions:e.attributions,cacheSize:e.cacheSize,extent:e.extent,opaque:e.opaque,projection:e.projection,state:e.state,tileGrid:e.tileGrid,tilePixelRatio:e.tilePixelRatio,wrapX:e.wrapX,transition:e.transition}),this.tileLoadFunction=e.tileLoadFunction,this.tileUrlFunction=this.fixedTileUrlFunction?this.fixedTileUrlFunction.bind(this):Fo,this.urls=null,e.urls?this.setUrls(e.urls):e.url&&this.setUrl(e.url),e.tileUrlFunction&&this.setTileUrlFunction(e.tileUrlFunction),this.tileLoadingKeys_={}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getTileLoadFunction=function(){return this.tileLoadFunction},e.prototype.getTileUrlFunction=function(){return this.tileUrlFunction},e.prototype.getUrls=function(){return this.urls},e.prototype.handleTileChange=function(t){var e,i=t.target,n=nn(i),r=i.getState();r==or.LOADING?(this.tileLoadingKeys_[n]=!0,e=as):n in this.tileLoadingKeys_&&(delete this.tileLoadingKeys_[n],e=r==or.ERROR?us:r==or.LOADED||r==or.ABORT?hs:void 0),void 0!=e&&this.dispatchEvent(new ss(e,i))},e.prototype.setTileLoadFunction=function(t){this.tileCache.clear(),this.tileLoadFunction=t,this.changed()},e.prototype.setTileUrlFunction=function(t,e){this.tileUrlFunction=t,this.tileCache.pruneExceptNewestZ(),void 0!==e?this.setKey(e):this.changed()},e.prototype.setUrl=function(t){var e=this.urls=Lo(t);this.setTileUrlFunction(this.fixedTileUrlFunction?this.fixedTileUrlFunction.bind(this):Io(e,this.tileGrid),t)},e.prototype.setUrls=function(t){this.urls=t;var e=t.join("\n");this.setTileUrlFunction(this.fixedTileUrlFunction?this.fixedTileUrlFunction.bind(this):Io(t,this.tileGrid),e)},e.prototype.useTile=function(t,e,i){var n=Nr(t,e,i);this.tileCache.containsKey(n)&&this.tileCache.get(n)},e}(os);ls.prototype.fixedTileUrlFunction;var cs=function(t){function e(e){t.call(this,{attributions:e.attributions,cacheSize:e.cacheSize,extent:e.extent,opaque:e.opaque,projection:e.projection,state:e.state,tileGrid:e.tileGrid,tileLoadFunction:e.tileLoadFunction?e.tileLoadFunction:ps,tilePixelRatio:e.tilePixelRatio,tileUrlFunction:e.tileUrlFunction,url:e.url,urls:e.urls,wrapX:e.wrapX,transition:e.transition}),this.crossOrigin=void 0!==e.crossOrigin?e.crossOrigin:null,this.tileClass=void 0!==e.tileClass?e.tileClass:Fr,this.tileCacheForProjection={},this.tileGridForProjection={},this.reprojectionErrorThreshold_=e.reprojectionErrorThreshold,this.renderReprojectionEdges_=!1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.canExpireCache=function(){if(this.tileCache.canExpireCache())return!0;for(var t in this.tileCacheForProjection)if(this.tileCacheForProjection[t].canExpireCache())return!0;return!1},e.prototype.expireCache=function(t,e){var i=this.getTileCacheForProjection(t);for(var n in this.tileCache.expireCache(this.tileCache==i?e:{}),this.tileCacheForProjection){var r=this.tileCacheForProjection[n];r.expireCache(r==i?e:{})}},e.prototype.getGutterForProjection=function(t){return this.getProjection()&&t&&!_o(this.getProjection(),t)?0:this.getGutter()},e.prototype.getGutter=function(){return 0},e.prototype.getOpaque=function(e){return!(this.getProjection()&&e&&!_o(this.getProjection(),e))&&t.prototype.getOpaque.call(this,e)},e.prototype.getTileGridForProjection=function(t){var e=this.getProjection();if(!this.tileGrid||e&&!_o(e,t)){var i=nn(t).toString();return i in this.tileGridForProjection||(this.tileGridForProjection[i]=Qo(t)),this.tileGridForProjection[i]}return this.tileGrid},e.prototype.getTileCacheForProjection=function(t){var e=this.getProjection();if(!e||_o(e,t))return this.tileCache;var i=nn(t).toString();return i in this.tileCacheForProjection||(this.tileCacheForProjection[i]=new Gr(this.tileCache.highWaterMark)),this.tileCacheForProjection[i]},e.prototype.createTile_=function(t,e,i,n,r,o){var s=[t,e,i],a=this.getTileCoordForTileUrlFunction(s,r),h=a?this.tileUrlFunction(a,n,r):void 0,u=new this.tileClass(s,void 0!==h?or.IDLE:or.EMPTY,void 0!==h?h:"",this.crossOrigin,this.tileLoadFunction,this.tileOptions);return u.key=o,gr(u,br.CHANGE,this.handleTileChange,this),u},e.prototype.getTile=function(t,e,i,n,r){var o=this.getProjection();if(o&&r&&!_o(o,r)){var s,a=this.getTileCacheForProjection(r),h=[t,e,i],u=kr(h);a.containsKey(u)&&(s=a.get(u));var l=this.getKey();if(s&&s.key==l)return s;var c=this.getTileGridForProjection(o),p=this.getTileGridForProjection(r),f=this.getTileCoordForTileUrlFunction(h,r),d=new Ro(o,c,r,p,h,f,this.getTilePixelRatio(n),this.getGutter(),function(t,e,i,n){return this.getTileInternal(t,e,i,n,o)}.bind(this),this.reprojectionErrorThreshold_,this.renderReprojectionEdges_);return d.key=l,s?(d.interimTile=s,d.refreshInterimChain(),a.replace(u,d)):a.set(u,d),d}return this.getTileInternal(t,e,i,n,o||r)},e.prototype.getTileInternal=function(t,e,i,n,r){var o=null,s=Nr(t,e,i),a=this.getKey();if(this.tileCache.containsKey(s)){if((o=this.tileCache.get(s)).key!=a){var h=o;o=this.createTile_(t,e,i,n,r,a),h.getState()==or.IDLE?o.interimTile=h.interimTile:o.interimTile=h,o.refreshInterimChain(),this.tileCache.replace(s,o)}}else o=this.createTile_(t,e,i,n,r,a),this.tileCache.set(s,o);return o},e.prototype.setRenderReprojectionEdges=function(t){if(this.renderReprojectionEdges_!=t){for(var e in this.renderReprojectionEdges_=t,this.tileCacheForProjection)this.tileCacheForProjection[e].clear();this.changed()}},e.prototype.setTileGridForProjection=function(t,e){var i=fo(t);if(i){var n=nn(i).toString();n in this.tileGridForProjection||(this.tileGridForProjection[n]=e)}},e}(ls);function ps(t,e){t.getImage().src=e}function fs(t,e){var i=[];Object.keys(e).forEach(function(t){null!==e[t]&&void 0!==e[t]&&i.push(t+"="+encodeURIComponent(e[t]))});var n=i.join("&");return(t=-1===(t=t.replace(/[?&]$/,"")).indexOf("?")?t+"?":t+"&")+n}var ds=function(t){function e(e){var i=e||{};t.call(this,{attributions:i.attributions,cacheSize:i.cacheSize,crossOrigin:i.crossOrigin,projection:i.projection,reprojectionErrorThreshold:i.reprojectionErrorThreshold,tileGrid:i.tileGrid,tileLoadFunction:i.tileLoadFunction,url:i.url,urls:i.urls,wrapX:void 0===i.wrapX||i.wrapX,transition:i.transition}),this.params_=i.params||{},this.tmpExtent_=[1/0,1/0,-1/0,-1/0],this.setKey(this.getKeyForParams_())}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getKeyForParams_=function(){var t=0,e=[];for(var i in this.params_)e[t++]=i+"-"+this.params_[i];return e.join("/")},e.prototype.getParams=function(){return this.params_},e.prototype.getRequestUrl_=function(t,e,i,n,r,o){var s=this.urls;if(s){var a,h=r.getCode().split(":").pop();if(o.SIZE=e[0]+","+e[1],o.BBOX=i.join(","),o.BBOXSR=h,o.IMAGESR=h,o.DPI=Math.round(o.DPI?o.DPI*n:90*n),1==s.length)a=s[0];else a=s[Hn(jr(t),s.length)];return fs(a.replace(/MapServer\/?$/,"MapServer/export").replace(/ImageServer\/?$/,"ImageServer/exportImage"),o)}},e.prototype.getTilePixelRatio=function(t){return t},e.prototype.fixedTileUrlFunction=function(t,e,i){var n=this.getTileGrid();if(n||(n=this.getTileGridForProjection(i)),!(n.getResolutions().length<=t[0])){var r=n.getTileCoordExtent(t,this.tmpExtent_),o=nr(n.getTileSize(t[0]),this.tmpSize);1!=e&&(o=ir(o,e,this.tmpSize));var s={F:"image",FORMAT:"PNG32",TRANSPARENT:!0};return qn(s,this.params_),this.getRequestUrl_(t,o,r,e,i,s)}},e.prototype.updateParams=function(t){qn(this.params_,t),this.setKey(this.getKeyForParams_())},e}(cs),gs=w.JSON||(w.JSON={stringify:JSON.stringify}),ys=function(t){return gs.stringify.apply(gs,arguments)},vs=function(t){return Bi(t,function(t,e,i){return i=i.toUpperCase(),["FORMAT","F","LAYERS","LAYERDEFS","DYNAMICLAYERS","DPI","TRANSPARENT","TIME","LAYERTIMEOPTIONS","GDBVERSION","MAPSCALE","ROTATION","DATUMTRANSFORMATIONS","MAPRANGEVALUES","LAYERRANGEVALUES","LAYERPARAMETERVALUES","HISTORICMOMENT"].includes(i)?t:(t[i]=e,t)},{})},_s=function(t){return null==t?t:"object"===ze(t)?ys(t):t},ms={props:{extParams:Object,format:{type:String,default:"PNG32"},layers:String,layerDefs:[Object,String],dynamicLayers:[Object,String],dpi:Number,transparent:{type:Boolean,default:!0},time:String,layerTimeOptions:[Object,String],gdbVersion:String,mapScale:String,rotation:Number,datumTransformations:[Array,String],mapRangeValues:[Array,String],layerRangeValues:[Array,String],layerParameterValues:[Array,String],historicMoment:Number},computed:{cleanExtParams:function(){return this.extParams?vs(this.extParams):void 0},allParams:function(){return ue({},this.cleanExtParams,{LAYERS:this.layers,FORMAT:this.format,LAYERDEFS:_s(this.layerDefs),DYNAMICLAYERS:_s(this.dynamicLayers),DPI:this.dpi,TRANSPARENT:this.transparent,TIME:_s(this.time),LAYERTIMEOPTIONS:_s(this.layerTimeOptions),GDBVERSION:this.gdbVersion,MAPSCALE:this.mapScale,ROTATION:this.rotation,DATUMTRANSFORMATIONS:_s(this.datumTransformations),MAPRANGEVALUES:_s(this.mapRangeValues),LAYERRANGEVALUES:_s(this.layerRangeValues),LAYERPARAMETERVALUES:_s(this.layerParameterValues),HISTORICMOMENT:_s(this.historicMoment)})}},methods:{},watch:{layers:function(t){this.$source&&this.$source.updateParams({LAYERS:t})},format:function(t){this.$source&&this.$source.updateParams({FORMAT:t})},layerDefs:function(t){this.$source&&this.$source.updateParams({LAYERDEFS:_s(t)})},dynamicLayers:function(t){this.$source&&this.$source.updateParams({DYNAMICLAYERS:_s(t)})},dpi:function(t){this.$source&&this.$source.updateParams({DPI:t})},transparent:function(t){this.$source&&this.$source.updateParams({TRANSPARENT:t})},time:function(t){this.$source&&this.$source.updateParams({TIME:_s(t)})},layerTimeOptions:function(t){this.$source&&this.$source.updateParams({LAYERTIMEOPTIONS:_s(t)})},gdbVersion:function(t){this.$source&&this.$source.updateParams({GDBVERSION:t})},mapScale:function(t){this.$source&&this.$source.updateParams({MAPSCALE:t})},rotation:function(t){this.$source&&this.$source.updateParams({ROTATION:t})},datumTransformations:function(t){this.$source&&this.$source.updateParams({DATUMTRANSFORMATIONS:_s(t)})},mapRangeValues:function(t){this.$source&&this.$source.updateParams({MAPRANGEVALUES:_s(t)})},layerRangeValues:function(t){this.$source&&this.$source.updateParams({LAYERRANGEVALUES:_s(t)})},layerParameterValues:function(t){this.$source&&this.$source.updateParams({LAYERPARAMETERVALUES:_s(t)})},historicMoment:function(t){this.$source&&this.$source.updateParams({HISTORICMOMENT:t})},extParams:function(t){this.$source&&this.$source.updateParams(t?vs(t):void 0)}}};function Es(t,e,i,n,r,o){for(var s=o||[],a=0,h=e;h<i;h+=n){var u=t[h],l=t[h+1];s[a++]=r[0]*u+r[2]*l+r[4],s[a++]=r[1]*u+r[3]*l+r[5]}return o&&s.length!=a&&(s.length=a),s}var xs=new Array(6);function Ss(t,e){var i=t[0],n=t[1],r=t[2],o=t[3],s=t[4],a=t[5],h=e[0],u=e[1],l=e[2],c=e[3],p=e[4],f=e[5];return t[0]=i*h+r*u,t[1]=n*h+o*u,t[2]=i*l+r*c,t[3]=n*l+o*c,t[4]=i*p+r*f+s,t[5]=n*p+o*f+a,t}function Ts(t,e,i,n,r,o,s){return t[0]=e,t[1]=i,t[2]=n,t[3]=r,t[4]=o,t[5]=s,t}function ws(t,e){return t[0]=e[0],t[1]=e[1],t[2]=e[2],t[3]=e[3],t[4]=e[4],t[5]=e[5],t}function bs(t,e){var i=e[0],n=e[1];return e[0]=t[0]*i+t[2]*n+t[4],e[1]=t[1]*i+t[3]*n+t[5],e}function Cs(t,e,i){return Ss(t,Ts(xs,e,0,0,i,0,0))}function Rs(t,e,i){return Ss(t,Ts(xs,1,0,0,1,e,i))}function Os(t,e,i,n,r,o,s,a){var h=Math.sin(o),u=Math.cos(o);return t[0]=n*u,t[1]=r*h,t[2]=-n*h,t[3]=r*u,t[4]=s*n*u-a*n*h+e,t[5]=s*r*h+a*r*u+i,t}var Is=[1,0,0,1,0,0],Ps=function(t){function e(){t.call(this),this.extent_=[1/0,1/0,-1/0,-1/0],this.extentRevision_=-1,this.simplifiedGeometryCache={},this.simplifiedGeometryMaxMinSquaredTolerance=0,this.simplifiedGeometryRevision=0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){},e.prototype.closestPointXY=function(t,e,i,n){},e.prototype.getClosestPoint=function(t,e){var i=e||[NaN,NaN];return this.closestPointXY(t[0],t[1],i,1/0),i},e.prototype.intersectsCoordinate=function(t){return this.containsXY(t[0],t[1])},e.prototype.computeExtent=function(t){},e.prototype.getExtent=function(t){return this.extentRevision_!=this.getRevision()&&(this.extent_=this.computeExtent(this.extent_),this.extentRevision_=this.getRevision()),function(t,e){return e?(e[0]=t[0],e[1]=t[1],e[2]=t[2],e[3]=t[3],e):t}(this.extent_,t)},e.prototype.rotate=function(t,e){},e.prototype.scale=function(t,e,i){},e.prototype.simplify=function(t){return this.getSimplifiedGeometry(t*t)},e.prototype.getSimplifiedGeometry=function(t){},e.prototype.getType=function(){},e.prototype.applyTransform=function(t){},e.prototype.intersectsExtent=function(t){},e.prototype.translate=function(t,e){},e.prototype.transform=function(t,e){var i=(t=fo(t)).getUnits()==Yr.TILE_PIXELS?function(i,n,r){var o=t.getExtent(),s=t.getWorldExtent(),a=An(s)/An(o);return Os(Is,s[0],s[3],a,-a,0,0,0),Es(i,0,i.length,r,Is,n),Eo(t,e)(i,n,r)}:Eo(t,e);return this.applyTransform(i),this},e}(ko);Ps.prototype.containsXY=Er;var Fs=/^#([a-f0-9]{3}|[a-f0-9]{4}(?:[a-f0-9]{2}){0,2})$/i,Ls=/^([a-z]*)$/i;function Ms(t){return"string"==typeof t?t:ks(t)}var As=function(){var t={},e=0;return function(i){var n;if(t.hasOwnProperty(i))n=t[i];else{if(e>=1024){var r=0;for(var o in t)0==(3&r++)&&(delete t[o],--e)}n=function(t){var e,i,n,r,o;Ls.exec(t)&&(t=function(t){var e=document.createElement("div");if(e.style.color=t,""!==e.style.color){document.body.appendChild(e);var i=getComputedStyle(e).color;return document.body.removeChild(e),i}return""}(t));if(Fs.exec(t)){var s,a=t.length-1;s=a<=4?1:2;var h=4===a||8===a;e=parseInt(t.substr(1+0*s,s),16),i=parseInt(t.substr(1+1*s,s),16),n=parseInt(t.substr(1+2*s,s),16),r=h?parseInt(t.substr(1+3*s,s),16):255,1==s&&(e=(e<<4)+e,i=(i<<4)+i,n=(n<<4)+n,h&&(r=(r<<4)+r)),o=[e,i,n,r/255]}else 0==t.indexOf("rgba(")?Ns(o=t.slice(5,-1).split(",").map(Number)):0==t.indexOf("rgb(")?((o=t.slice(4,-1).split(",").map(Number)).push(1),Ns(o)):sn(!1,14);return o}(i),t[i]=n,++e}return n}}();function Ns(t){return t[0]=Yn(t[0]+.5|0,0,255),t[1]=Yn(t[1]+.5|0,0,255),t[2]=Yn(t[2]+.5|0,0,255),t[3]=Yn(t[3],0,1),t}function ks(t){var e=t[0];e!=(0|e)&&(e=e+.5|0);var i=t[1];i!=(0|i)&&(i=i+.5|0);var n=t[2];return n!=(0|n)&&(n=n+.5|0),"rgba("+e+","+i+","+n+","+(void 0===t[3]?1:t[3])+")"}function js(t){return function(t){return"string"==typeof t||t instanceof CanvasPattern||t instanceof CanvasGradient}(t)?t:ks(t)}var Gs=["experimental-webgl","webgl","webkit-3d","moz-webgl"];if("undefined"!=typeof window&&"WebGLRenderingContext"in window)try{var Ds=function(t,e){for(var i=Gs.length,n=0;n<i;++n)try{var r=t.getContext(Gs[n],e);if(r)return r}catch(t){}return null}(document.createElement("canvas"),{failIfMajorPerformanceCaveat:!0});Ds&&(Ds.getParameter(Ds.MAX_TEXTURE_SIZE),Ds.getSupportedExtensions())}catch(t){}var $s="undefined"!=typeof navigator?navigator.userAgent.toLowerCase():"",Vs=-1!==$s.indexOf("firefox"),zs=-1!==$s.indexOf("safari")&&-1==$s.indexOf("chrom"),Ys=-1!==$s.indexOf("webkit")&&-1==$s.indexOf("edge"),Xs=-1!==$s.indexOf("macintosh"),Us=window.devicePixelRatio||1,Bs=function(){var t=!1;try{t=!!document.createElement("canvas").getContext("2d").setLineDash}catch(t){}return t}(),Ks="geolocation"in navigator,Ws="ontouchstart"in window,Hs="PointerEvent"in window,Zs=!!navigator.msPointerEnabled,qs={IDLE:0,LOADING:1,LOADED:2,ERROR:3},Js="ol-hidden",Qs="ol-selectable",ta="ol-unselectable",ea="ol-unsupported",ia="ol-control",na="ol-collapsed",ra=function(){var t,e={};return function(i){if(t||(t=document.createElement("div").style),!(i in e)){t.font=i;var n=t.fontFamily;if(t.font="",!n)return null;e[i]=n.split(/,\s?/)}return e[i]}}(),oa=[0,0,0,1],sa=[],aa=[0,0,0,1],ha=[0,0,0,0],ua=new Mr,la={},ca=null,pa={},fa=function(){var t,e,i=60,n=la,r="32px ",o=["monospace","serif"],s=o.length,a="wmytzilWMYTZIL@#/&?$%10";function h(t){for(var i=da(),n=100;n<=700;n+=300){for(var h=n+" ",u=!0,l=0;l<s;++l){var c=o[l];if(i.font=h+r+c,e=i.measureText(a).width,t!=c){i.font=h+r+t+","+c;var p=i.measureText(a).width;u=u&&p!=e}}if(u)return!0}return!1}function u(){var e=!0;for(var r in n)n[r]<i&&(h(r)?(n[r]=i,Jn(pa),ca=null,ua.clear()):(++n[r],e=!1));e&&(clearInterval(t),t=void 0)}return function(e){var r=ra(e);if(r)for(var o=0,s=r.length;o<s;++o){var a=r[o];a in n||(n[a]=i,h(a)||(n[a]=0,void 0===t&&(t=setInterval(u,32))))}}}();function da(){return ca||(ca=Rr(1,1)),ca}var ga,ya,va=(ya=pa,function(t){var e=ya[t];return void 0==e&&(ga||((ga=document.createElement("span")).textContent="M",ga.style.margin=ga.style.padding="0 !important",ga.style.position="absolute !important",ga.style.left="-99999px !important"),ga.style.font=t,document.body.appendChild(ga),e=ya[t]=ga.offsetHeight,document.body.removeChild(ga)),e});function _a(t,e){var i=da();return t!=i.font&&(i.font=t),i.measureText(e).width}function ma(t,e,i,n){0!==e&&(t.translate(i,n),t.rotate(e),t.translate(-i,-n))}var Ea=[1,0,0,1,0,0];function xa(t,e,i,n,r,o,s,a,h,u,l){var c;1!=i&&(c=t.globalAlpha,t.globalAlpha=c*i),e&&t.setTransform.apply(t,e),t.drawImage(n,r,o,s,a,h,u,s*l,a*l),c&&(t.globalAlpha=c),e&&t.setTransform.apply(t,Ea)}var Sa=function(t){this.opacity_=t.opacity,this.rotateWithView_=t.rotateWithView,this.rotation_=t.rotation,this.scale_=t.scale};Sa.prototype.getOpacity=function(){return this.opacity_},Sa.prototype.getRotateWithView=function(){return this.rotateWithView_},Sa.prototype.getRotation=function(){return this.rotation_},Sa.prototype.getScale=function(){return this.scale_},Sa.prototype.getSnapToPixel=function(){return!1},Sa.prototype.getAnchor=function(){},Sa.prototype.getImage=function(t){},Sa.prototype.getHitDetectionImage=function(t){},Sa.prototype.getImageState=function(){},Sa.prototype.getImageSize=function(){},Sa.prototype.getHitDetectionImageSize=function(){},Sa.prototype.getOrigin=function(){},Sa.prototype.getSize=function(){},Sa.prototype.setOpacity=function(t){this.opacity_=t},Sa.prototype.setRotateWithView=function(t){this.rotateWithView_=t},Sa.prototype.setRotation=function(t){this.rotation_=t},Sa.prototype.setScale=function(t){this.scale_=t},Sa.prototype.setSnapToPixel=function(t){},Sa.prototype.listenImageChange=function(t,e){},Sa.prototype.load=function(){},Sa.prototype.unlistenImageChange=function(t,e){};var Ta=function(t){function e(e){var i=void 0!==e.rotateWithView&&e.rotateWithView;t.call(this,{opacity:1,rotateWithView:i,rotation:void 0!==e.rotation?e.rotation:0,scale:1}),this.checksums_=null,this.canvas_=null,this.hitDetectionCanvas_=null,this.fill_=void 0!==e.fill?e.fill:null,this.origin_=[0,0],this.points_=e.points,this.radius_=void 0!==e.radius?e.radius:e.radius1,this.radius2_=e.radius2,this.angle_=void 0!==e.angle?e.angle:0,this.stroke_=void 0!==e.stroke?e.stroke:null,this.anchor_=null,this.size_=null,this.imageSize_=null,this.hitDetectionImageSize_=null,this.atlasManager_=e.atlasManager,this.render_(this.atlasManager_)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){var t=new e({fill:this.getFill()?this.getFill().clone():void 0,points:this.getPoints(),radius:this.getRadius(),radius2:this.getRadius2(),angle:this.getAngle(),stroke:this.getStroke()?this.getStroke().clone():void 0,rotation:this.getRotation(),rotateWithView:this.getRotateWithView(),atlasManager:this.atlasManager_});return t.setOpacity(this.getOpacity()),t.setScale(this.getScale()),t},e.prototype.getAnchor=function(){return this.anchor_},e.prototype.getAngle=function(){return this.angle_},e.prototype.getFill=function(){return this.fill_},e.prototype.getHitDetectionImage=function(t){return this.hitDetectionCanvas_},e.prototype.getImage=function(t){return this.canvas_},e.prototype.getImageSize=function(){return this.imageSize_},e.prototype.getHitDetectionImageSize=function(){return this.hitDetectionImageSize_},e.prototype.getImageState=function(){return qs.LOADED},e.prototype.getOrigin=function(){return this.origin_},e.prototype.getPoints=function(){return this.points_},e.prototype.getRadius=function(){return this.radius_},e.prototype.getRadius2=function(){return this.radius2_},e.prototype.getSize=function(){return this.size_},e.prototype.getStroke=function(){return this.stroke_},e.prototype.listenImageChange=function(t,e){},e.prototype.load=function(){},e.prototype.unlistenImageChange=function(t,e){},e.prototype.render_=function(t){var e,i,n="",r="",o=0,s=null,a=0,h=0;this.stroke_&&(null===(i=this.stroke_.getColor())&&(i=aa),i=js(i),void 0===(h=this.stroke_.getWidth())&&(h=1),s=this.stroke_.getLineDash(),a=this.stroke_.getLineDashOffset(),Bs||(s=null,a=0),void 0===(r=this.stroke_.getLineJoin())&&(r="round"),void 0===(n=this.stroke_.getLineCap())&&(n="round"),void 0===(o=this.stroke_.getMiterLimit())&&(o=10));var u=2*(this.radius_+h)+1,l={strokeStyle:i,strokeWidth:h,size:u,lineCap:n,lineDash:s,lineDashOffset:a,lineJoin:r,miterLimit:o};if(void 0===t){var c=Rr(u,u);this.canvas_=c.canvas,e=u=this.canvas_.width,this.draw_(l,c,0,0),this.createHitDetectionCanvas_(l)}else{u=Math.round(u);var p,f=!this.fill_;f&&(p=this.drawHitDetectionCanvas_.bind(this,l));var d=this.getChecksum(),g=t.add(d,u,u,this.draw_.bind(this,l),p);this.canvas_=g.image,this.origin_=[g.offsetX,g.offsetY],e=g.image.width,f?(this.hitDetectionCanvas_=g.hitImage,this.hitDetectionImageSize_=[g.hitImage.width,g.hitImage.height]):(this.hitDetectionCanvas_=this.canvas_,this.hitDetectionImageSize_=[e,e])}this.anchor_=[u/2,u/2],this.size_=[u,u],this.imageSize_=[e,e]},e.prototype.draw_=function(t,e,i,n){var r,o,s;e.setTransform(1,0,0,1,0,0),e.translate(i,n),e.beginPath();var a=this.points_;if(a===1/0)e.arc(t.size/2,t.size/2,this.radius_,0,2*Math.PI,!0);else{var h=void 0!==this.radius2_?this.radius2_:this.radius_;for(h!==this.radius_&&(a*=2),r=0;r<=a;r++)o=2*r*Math.PI/a-Math.PI/2+this.angle_,s=r%2==0?this.radius_:h,e.lineTo(t.size/2+s*Math.cos(o),t.size/2+s*Math.sin(o))}if(this.fill_){var u=this.fill_.getColor();null===u&&(u=oa),e.fillStyle=js(u),e.fill()}this.stroke_&&(e.strokeStyle=t.strokeStyle,e.lineWidth=t.strokeWidth,t.lineDash&&(e.setLineDash(t.lineDash),e.lineDashOffset=t.lineDashOffset),e.lineCap=t.lineCap,e.lineJoin=t.lineJoin,e.miterLimit=t.miterLimit,e.stroke()),e.closePath()},e.prototype.createHitDetectionCanvas_=function(t){if(this.hitDetectionImageSize_=[t.size,t.size],this.fill_)this.hitDetectionCanvas_=this.canvas_;else{var e=Rr(t.size,t.size);this.hitDetectionCanvas_=e.canvas,this.drawHitDetectionCanvas_(t,e,0,0)}},e.prototype.drawHitDetectionCanvas_=function(t,e,i,n){e.setTransform(1,0,0,1,0,0),e.translate(i,n),e.beginPath();var r=this.points_;if(r===1/0)e.arc(t.size/2,t.size/2,this.radius_,0,2*Math.PI,!0);else{var o,s,a,h=void 0!==this.radius2_?this.radius2_:this.radius_;for(h!==this.radius_&&(r*=2),o=0;o<=r;o++)a=2*o*Math.PI/r-Math.PI/2+this.angle_,s=o%2==0?this.radius_:h,e.lineTo(t.size/2+s*Math.cos(a),t.size/2+s*Math.sin(a))}e.fillStyle=oa,e.fill(),this.stroke_&&(e.strokeStyle=t.strokeStyle,e.lineWidth=t.strokeWidth,t.lineDash&&(e.setLineDash(t.lineDash),e.lineDashOffset=t.lineDashOffset),e.stroke()),e.closePath()},e.prototype.getChecksum=function(){var t=this.stroke_?this.stroke_.getChecksum():"-",e=this.fill_?this.fill_.getChecksum():"-";if(!this.checksums_||t!=this.checksums_[1]||e!=this.checksums_[2]||this.radius_!=this.checksums_[3]||this.radius2_!=this.checksums_[4]||this.angle_!=this.checksums_[5]||this.points_!=this.checksums_[6]){var i="r"+t+e+(void 0!==this.radius_?this.radius_.toString():"-")+(void 0!==this.radius2_?this.radius2_.toString():"-")+(void 0!==this.angle_?this.angle_.toString():"-")+(void 0!==this.points_?this.points_.toString():"-");this.checksums_=[i,t,e,this.radius_,this.radius2_,this.angle_,this.points_]}return this.checksums_[0]},e}(Sa),wa=function(t){function e(e){var i=e||{};t.call(this,{points:1/0,fill:i.fill,radius:i.radius,stroke:i.stroke,atlasManager:i.atlasManager})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){var t=new e({fill:this.getFill()?this.getFill().clone():void 0,stroke:this.getStroke()?this.getStroke().clone():void 0,radius:this.getRadius(),atlasManager:this.atlasManager_});return t.setOpacity(this.getOpacity()),t.setScale(this.getScale()),t},e.prototype.setRadius=function(t){this.radius_=t,this.render_(this.atlasManager_)},e}(Ta),ba=function(t){var e=t||{};this.color_=void 0!==e.color?e.color:null,this.checksum_=void 0};ba.prototype.clone=function(){var t=this.getColor();return new ba({color:t&&t.slice?t.slice():t||void 0})},ba.prototype.getColor=function(){return this.color_},ba.prototype.setColor=function(t){this.color_=t,this.checksum_=void 0},ba.prototype.getChecksum=function(){return void 0===this.checksum_&&(this.color_ instanceof CanvasPattern||this.color_ instanceof CanvasGradient?this.checksum_=nn(this.color_).toString():this.checksum_="f"+(this.color_?Ms(this.color_):"-")),this.checksum_};var Ca=function(t){var e=t||{};this.color_=void 0!==e.color?e.color:null,this.lineCap_=e.lineCap,this.lineDash_=void 0!==e.lineDash?e.lineDash:null,this.lineDashOffset_=e.lineDashOffset,this.lineJoin_=e.lineJoin,this.miterLimit_=e.miterLimit,this.width_=e.width,this.checksum_=void 0};Ca.prototype.clone=function(){var t=this.getColor();return new Ca({color:t&&t.slice?t.slice():t||void 0,lineCap:this.getLineCap(),lineDash:this.getLineDash()?this.getLineDash().slice():void 0,lineDashOffset:this.getLineDashOffset(),lineJoin:this.getLineJoin(),miterLimit:this.getMiterLimit(),width:this.getWidth()})},Ca.prototype.getColor=function(){return this.color_},Ca.prototype.getLineCap=function(){return this.lineCap_},Ca.prototype.getLineDash=function(){return this.lineDash_},Ca.prototype.getLineDashOffset=function(){return this.lineDashOffset_},Ca.prototype.getLineJoin=function(){return this.lineJoin_},Ca.prototype.getMiterLimit=function(){return this.miterLimit_},Ca.prototype.getWidth=function(){return this.width_},Ca.prototype.setColor=function(t){this.color_=t,this.checksum_=void 0},Ca.prototype.setLineCap=function(t){this.lineCap_=t,this.checksum_=void 0},Ca.prototype.setLineDash=function(t){this.lineDash_=t,this.checksum_=void 0},Ca.prototype.setLineDashOffset=function(t){this.lineDashOffset_=t,this.checksum_=void 0},Ca.prototype.setLineJoin=function(t){this.lineJoin_=t,this.checksum_=void 0},Ca.prototype.setMiterLimit=function(t){this.miterLimit_=t,this.checksum_=void 0},Ca.prototype.setWidth=function(t){this.width_=t,this.checksum_=void 0},Ca.prototype.getChecksum=function(){return void 0===this.checksum_&&(this.checksum_="s",this.color_?"string"==typeof this.color_?this.checksum_+=this.color_:this.checksum_+=nn(this.color_).toString():this.checksum_+="-",this.checksum_+=","+(void 0!==this.lineCap_?this.lineCap_.toString():"-")+","+(this.lineDash_?this.lineDash_.toString():"-")+","+(void 0!==this.lineDashOffset_?this.lineDashOffset_:"-")+","+(void 0!==this.lineJoin_?this.lineJoin_:"-")+","+(void 0!==this.miterLimit_?this.miterLimit_.toString():"-")+","+(void 0!==this.width_?this.width_.toString():"-")),this.checksum_};var Ra=function(t){var e=t||{};this.geometry_=null,this.geometryFunction_=Fa,void 0!==e.geometry&&this.setGeometry(e.geometry),this.fill_=void 0!==e.fill?e.fill:null,this.image_=void 0!==e.image?e.image:null,this.renderer_=void 0!==e.renderer?e.renderer:null,this.stroke_=void 0!==e.stroke?e.stroke:null,this.text_=void 0!==e.text?e.text:null,this.zIndex_=e.zIndex};Ra.prototype.clone=function(){var t=this.getGeometry();return t&&t.clone&&(t=t.clone()),new Ra({geometry:t,fill:this.getFill()?this.getFill().clone():void 0,image:this.getImage()?this.getImage().clone():void 0,stroke:this.getStroke()?this.getStroke().clone():void 0,text:this.getText()?this.getText().clone():void 0,zIndex:this.getZIndex()})},Ra.prototype.getRenderer=function(){return this.renderer_},Ra.prototype.setRenderer=function(t){this.renderer_=t},Ra.prototype.getGeometry=function(){return this.geometry_},Ra.prototype.getGeometryFunction=function(){return this.geometryFunction_},Ra.prototype.getFill=function(){return this.fill_},Ra.prototype.setFill=function(t){this.fill_=t},Ra.prototype.getImage=function(){return this.image_},Ra.prototype.setImage=function(t){this.image_=t},Ra.prototype.getStroke=function(){return this.stroke_},Ra.prototype.setStroke=function(t){this.stroke_=t},Ra.prototype.getText=function(){return this.text_},Ra.prototype.setText=function(t){this.text_=t},Ra.prototype.getZIndex=function(){return this.zIndex_},Ra.prototype.setGeometry=function(t){"function"==typeof t?this.geometryFunction_=t:"string"==typeof t?this.geometryFunction_=function(e){return e.get(t)}:t?void 0!==t&&(this.geometryFunction_=function(){return t}):this.geometryFunction_=Fa,this.geometry_=t},Ra.prototype.setZIndex=function(t){this.zIndex_=t};var Oa=null;function Ia(t,e){if(!Oa){var i=new ba({color:"rgba(255,255,255,0.4)"}),n=new Ca({color:"#3399CC",width:1.25});Oa=[new Ra({image:new wa({fill:i,stroke:n,radius:5}),fill:i,stroke:n})]}return Oa}function Pa(){var t={},e=[255,255,255,1],i=[0,153,255,1];return t[Dr.POLYGON]=[new Ra({fill:new ba({color:[255,255,255,.5]})})],t[Dr.MULTI_POLYGON]=t[Dr.POLYGON],t[Dr.LINE_STRING]=[new Ra({stroke:new Ca({color:e,width:5})}),new Ra({stroke:new Ca({color:i,width:3})})],t[Dr.MULTI_LINE_STRING]=t[Dr.LINE_STRING],t[Dr.CIRCLE]=t[Dr.POLYGON].concat(t[Dr.LINE_STRING]),t[Dr.POINT]=[new Ra({image:new wa({radius:6,fill:new ba({color:i}),stroke:new Ca({color:e,width:1.5})}),zIndex:1/0})],t[Dr.MULTI_POINT]=t[Dr.POINT],t[Dr.GEOMETRY_COLLECTION]=t[Dr.POLYGON].concat(t[Dr.LINE_STRING],t[Dr.POINT]),t}function Fa(t){return t.getGeometry()}var La=function(t){function e(e){if(t.call(this),this.id_=void 0,this.geometryName_="geometry",this.style_=null,this.styleFunction_=void 0,this.geometryChangeKey_=null,gr(this,Go(this.geometryName_),this.handleGeometryChanged_,this),void 0!==e)if(e instanceof Ps||!e){var i=e;this.setGeometry(i)}else{var n=e;this.setProperties(n)}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){var t=new e(this.getProperties());t.setGeometryName(this.getGeometryName());var i=this.getGeometry();i&&t.setGeometry(i.clone());var n=this.getStyle();return n&&t.setStyle(n),t},e.prototype.getGeometry=function(){return this.get(this.geometryName_)},e.prototype.getId=function(){return this.id_},e.prototype.getGeometryName=function(){return this.geometryName_},e.prototype.getStyle=function(){return this.style_},e.prototype.getStyleFunction=function(){return this.styleFunction_},e.prototype.handleGeometryChange_=function(){this.changed()},e.prototype.handleGeometryChanged_=function(){this.geometryChangeKey_&&(_r(this.geometryChangeKey_),this.geometryChangeKey_=null);var t=this.getGeometry();t&&(this.geometryChangeKey_=gr(t,br.CHANGE,this.handleGeometryChange_,this)),this.changed()},e.prototype.setGeometry=function(t){this.set(this.geometryName_,t)},e.prototype.setStyle=function(t){var e,i;this.style_=t,this.styleFunction_=t?"function"==typeof(e=t)?e:(Array.isArray(e)?i=e:(sn(e instanceof Ra,41),i=[e]),function(){return i}):void 0,this.changed()},e.prototype.setId=function(t){this.id_=t,this.changed()},e.prototype.setGeometryName=function(t){vr(this,Go(this.geometryName_),this.handleGeometryChanged_,this),this.geometryName_=t,gr(this,Go(this.geometryName_),this.handleGeometryChanged_,this),this.handleGeometryChanged_()},e}(ko);for(var Ma=y(function(t){var e="undefined"!=typeof crypto&&crypto.getRandomValues&&crypto.getRandomValues.bind(crypto)||"undefined"!=typeof msCrypto&&"function"==typeof window.msCrypto.getRandomValues&&msCrypto.getRandomValues.bind(msCrypto);if(e){var i=new Uint8Array(16);t.exports=function(){return e(i),i}}else{var n=new Array(16);t.exports=function(){for(var t,e=0;e<16;e++)0==(3&e)&&(t=4294967296*Math.random()),n[e]=t>>>((3&e)<<3)&255;return n}}}),Aa=[],Na=0;Na<256;++Na)Aa[Na]=(Na+256).toString(16).substr(1);var ka=function(t,e){var i=e||0,n=Aa;return[n[t[i++]],n[t[i++]],n[t[i++]],n[t[i++]],"-",n[t[i++]],n[t[i++]],"-",n[t[i++]],n[t[i++]],"-",n[t[i++]],n[t[i++]],"-",n[t[i++]],n[t[i++]],n[t[i++]],n[t[i++]],n[t[i++]],n[t[i++]]].join("")};var ja=function(t,e,i){var n=e&&i||0;"string"==typeof t&&(e="binary"===t?new Array(16):null,t=null);var r=(t=t||{}).random||(t.rng||Ma)();if(r[6]=15&r[6]|64,r[8]=63&r[8]|128,e)for(var o=0;o<16;++o)e[n+o]=r[o];return e||ka(r)};function Ga(t){return null==t.getId()&&t.setId(ja()),t}function Da(e){var i,n;if(Gi(e)||e instanceof t)i=e.id;else if(e instanceof La)i=e.getId();else{if(!Fi(e)&&(n=e,Ei.call(n)!==Ti))throw new Error("Illegal feature format");i=e}return i}function $a(t,e){if(!(t instanceof e))throw new TypeError("Cannot call a class as a function")}function Va(t){if(void 0===t)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return t}function za(t,e){return!e||"object"!==ze(e)&&"function"!=typeof e?Va(t):e}var Ya=function(t,e){if(O(t),!u(e)&&null!==e)throw TypeError(e+": can't set as prototype!")},Xa={set:Object.setPrototypeOf||("__proto__"in{}?function(t,e,i){try{(i=R(Function.call,T.f(Object.prototype,"__proto__").set,2))(t,[]),e=!(t instanceof Array)}catch(t){e=!0}return function(t,n){return Ya(t,n),e?t.__proto__=n:i(t,n),t}}({},!1):void 0),check:Ya};M(M.S,"Object",{setPrototypeOf:Xa.set});var Ua=w.Object.setPrototypeOf;function Ba(t){return(Ba=Ua?Se:function(t){return t.__proto__||Se(t)})(t)}M(M.S,"Object",{create:Et});var Ka=w.Object,Wa=function(t,e){return Ka.create(t,e)};function Ha(t,e){return(Ha=Ua||function(t,e){return t.__proto__=e,t})(t,e)}function Za(t,e){if("function"!=typeof e&&null!==e)throw new TypeError("Super expression must either be null or a function");t.prototype=Wa(e&&e.prototype,{constructor:{value:t,writable:!0,configurable:!0}}),e&&Ha(t,e)}var qa=function(t,e,i){for(var n in e)i&&t[n]?t[n]=e[n]:F(t,n,e[n]);return t},Ja=function(t,e,i,n){if(!(t instanceof e)||void 0!==n&&n in t)throw TypeError(i+": incorrect invocation!");return t},Qa=y(function(t){var e={},i={},n=t.exports=function(t,n,r,o,s){var a,h,u,l,c=s?function(){return t}:li(t),p=R(r,o,n?2:1),f=0;if("function"!=typeof c)throw TypeError(t+" is not iterable!");if(ri(c)){for(a=it(t.length);a>f;f++)if((l=n?p(O(h=t[f])[0],h[1]):p(t[f]))===e||l===i)return l}else for(u=c.call(t);!(h=u.next()).done;)if((l=ei(u,p,h.value,n))===e||l===i)return l};n.BREAK=e,n.RETURN=i}),th=U("species"),eh=function(t){var e="function"==typeof w[t]?w[t]:v[t];d&&e&&!e[th]&&P.f(e,th,{configurable:!0,get:function(){return this}})},ih=function(t,e){if(!u(t)||t._t!==e)throw TypeError("Incompatible receiver, "+e+" required!");return t},nh=P.f,rh=Y.fastKey,oh=d?"_s":"size",sh=function(t,e){var i,n=rh(e);if("F"!==n)return t._i[n];for(i=t._f;i;i=i.n)if(i.k==e)return i},ah={getConstructor:function(t,e,i,n){var r=t(function(t,o){Ja(t,r,e,"_i"),t._t=e,t._i=Et(null),t._f=void 0,t._l=void 0,t[oh]=0,void 0!=o&&Qa(o,i,t[n],t)});return qa(r.prototype,{clear:function(){for(var t=ih(this,e),i=t._i,n=t._f;n;n=n.n)n.r=!0,n.p&&(n.p=n.p.n=void 0),delete i[n.i];t._f=t._l=void 0,t[oh]=0},delete:function(t){var i=ih(this,e),n=sh(i,t);if(n){var r=n.n,o=n.p;delete i._i[n.i],n.r=!0,o&&(o.n=r),r&&(r.p=o),i._f==n&&(i._f=r),i._l==n&&(i._l=o),i[oh]--}return!!n},forEach:function(t){ih(this,e);for(var i,n=R(t,arguments.length>1?arguments[1]:void 0,3);i=i?i.n:this._f;)for(n(i.v,i.k,this);i&&i.r;)i=i.p},has:function(t){return!!sh(ih(this,e),t)}}),d&&nh(r.prototype,"size",{get:function(){return ih(this,e)[oh]}}),r},def:function(t,e,i){var n,r,o=sh(t,e);return o?o.v=i:(t._l=o={i:r=rh(e,!0),k:e,v:i,p:n=t._l,n:void 0,r:!1},t._f||(t._f=o),n&&(n.n=o),t[oh]++,"F"!==r&&(t._i[r]=o)),t},getEntry:sh,setStrong:function(t,e,i){Pe(t,e,function(t,i){this._t=ih(t,e),this._k=i,this._l=void 0},function(){for(var t=this._k,e=this._l;e&&e.r;)e=e.p;return this._t&&(this._l=e=e?e.n:this._t._f)?Le(0,"keys"==t?e.k:"values"==t?e.v:[e.k,e.v]):(this._t=void 0,Le(1))},i?"entries":"values",!i,!0),eh(e)}},hh=U("species"),uh=function(t,e){return new(function(t){var e;return ft(t)&&("function"!=typeof(e=t.constructor)||e!==Array&&!ft(e.prototype)||(e=void 0),u(e)&&null===(e=e[hh])&&(e=void 0)),void 0===e?Array:e}(t))(e)},lh=P.f,ch=function(t,e){var i=1==t,r=2==t,o=3==t,s=4==t,a=6==t,h=5==t||a,u=e||uh;return function(e,l,c){for(var p,f,d=re(e),g=n(d),y=R(l,c,3),v=it(g.length),_=0,m=i?u(e,v):r?u(e,0):void 0;v>_;_++)if((h||_ in g)&&(f=y(p=g[_],_,d),t))if(i)m[_]=f;else if(f)switch(t){case 3:return!0;case 5:return p;case 6:return _;case 2:m.push(p)}else if(s)return!1;return a?-1:o||s?s:m}}(0);!function(t,e,i,n,r,o){var s=v[t],a=s,h=r?"set":"add",l=a&&a.prototype,c={};d&&"function"==typeof a&&(o||l.forEach&&!f(function(){(new a).entries().next()}))?(a=e(function(e,i){Ja(e,a,t,"_c"),e._c=new s,void 0!=i&&Qa(i,r,e[h],e)}),ch("add,clear,delete,forEach,get,has,set,keys,values,entries,toJSON".split(","),function(t){var e="add"==t||"set"==t;t in l&&(!o||"clear"!=t)&&F(a.prototype,t,function(i,n){if(Ja(this,a,t),!e&&o&&!u(i))return"get"==t&&void 0;var r=this._c[t](0===i?0:i,n);return e?this:r})}),o||lh(a.prototype,"size",{get:function(){return this._c.size}})):(a=n.getConstructor(e,t,r,h),qa(a.prototype,i),Y.NEED=!0),W(a,t),c[t]=a,M(M.G+M.W+M.F,c),o||n.setStrong(a,t,r)}("Map",function(t){return function(){return t(this,arguments.length>0?arguments[0]:void 0)}},{get:function(t){var e=ah.getEntry(ih(this,"Map"),t);return e&&e.v},set:function(t,e){return ah.def(ih(this,"Map"),0===t?0:t,e)}},ah,!0);M(M.P+M.R,"Map",{toJSON:function(t){return function(){if(hi(this)!=t)throw TypeError(t+"#toJSON isn't generic");return Qa(this,!1,(i=[]).push,i,e),i;var e,i}}("Map")});var ph;ph="Map",M(M.S,ph,{of:function(){for(var t=arguments.length,e=new Array(t);t--;)e[t]=arguments[t];return new this(e)}});!function(t){M(M.S,t,{from:function(t){var e,i,n,r,o=arguments[1];return C(this),(e=void 0!==o)&&C(o),void 0==t?new this:(i=[],e?(n=0,r=R(o,arguments[2],2),Qa(t,!1,function(t){i.push(r(t,n++))})):Qa(t,!1,i.push,i),new this(i))}})}("Map");var fh=w.Map;var dh=function(t,e,i){var n=void 0===i;switch(e.length){case 0:return n?t():t.call(i);case 1:return n?t(e[0]):t.call(i,e[0]);case 2:return n?t(e[0],e[1]):t.call(i,e[0],e[1]);case 3:return n?t(e[0],e[1],e[2]):t.call(i,e[0],e[1],e[2]);case 4:return n?t(e[0],e[1],e[2],e[3]):t.call(i,e[0],e[1],e[2],e[3])}return t.apply(i,e)},gh=[].slice,yh={},vh=Function.bind||function(t){var e=C(this),i=gh.call(arguments,1),n=function(){var r=i.concat(gh.call(arguments));return this instanceof n?function(t,e,i){if(!(e in yh)){for(var n=[],r=0;r<e;r++)n[r]="a["+r+"]";yh[e]=Function("F,a","return new F("+n.join(",")+")")}return yh[e](t,i)}(e,r.length,r):dh(e,r,t)};return u(e.prototype)&&(n.prototype=e.prototype),n},_h=(v.Reflect||{}).construct,mh=f(function(){function t(){}return!(_h(function(){},[],t)instanceof t)}),Eh=!f(function(){_h(function(){})});M(M.S+M.F*(mh||Eh),"Reflect",{construct:function(t,e){C(t),O(e);var i=arguments.length<3?t:C(arguments[2]);if(Eh&&!mh)return _h(t,e,i);if(t==i){switch(e.length){case 0:return new t;case 1:return new t(e[0]);case 2:return new t(e[0],e[1]);case 3:return new t(e[0],e[1],e[2]);case 4:return new t(e[0],e[1],e[2],e[3])}var n=[null];return n.push.apply(n,e),new(vh.apply(t,n))}var r=i.prototype,o=Et(u(r)?r:Object.prototype),s=Function.apply.call(t,o,e);return u(s)?s:o}});var xh=w.Reflect.construct;function Sh(t,e,i){return(Sh=function(){if("undefined"==typeof Reflect||!xh)return!1;if(xh.sham)return!1;if("function"==typeof Proxy)return!0;try{return Date.prototype.toString.call(xh(Date,[],function(){})),!0}catch(t){return!1}}()?xh:function(t,e,i){var n=[null];n.push.apply(n,e);var r=new(Function.bind.apply(t,n));return i&&Ha(r,i.prototype),r}).apply(null,arguments)}function Th(t){var e="function"==typeof fh?new fh:void 0;return(Th=function(t){if(null===t||(i=t,-1===Function.toString.call(i).indexOf("[native code]")))return t;var i;if("function"!=typeof t)throw new TypeError("Super expression must either be null or a function");if(void 0!==e){if(e.has(t))return e.get(t);e.set(t,n)}function n(){return Sh(t,arguments,Ba(this).constructor)}return n.prototype=Wa(t.prototype,{constructor:{value:n,enumerable:!1,writable:!0,configurable:!0}}),Ha(n,t)})(t)}var wh=function(t){function e(t){var i;return $a(this,e),(i=za(this,Ba(e).call(this,t))).name=i.constructor.name,"function"==typeof Error.captureStackTrace?Error.captureStackTrace(Va(Va(i)),i.constructor):i.stack=new Error(t).stack,i}return Za(e,Th(Error)),e}();function bh(t,e){if(!t)throw new wh(e||"Assertion failed")}function Ch(t,e){bh(t instanceof e,"value is an instance of ".concat(e.name))}function Rh(t){bh(t.$map,'component has "$map" property')}function Oh(t){bh(t.$view,'component has "$view" property')}function Ih(t){bh(t.$geolocation,'component has "$geolocation" property')}function Ph(t){bh(t.$layer,'component has "$layer" property')}function Fh(t){bh(t.$source,'component has "$source" property')}function Lh(t){bh(t.$geometry,'component has "$geometry" property')}function Mh(t){bh(t.$interaction,'component has "$interaction" property')}function Ah(t){bh(t.$overlay,'component has "$overlay" property')}function Nh(t){bh(t.$graticule,'component has "$graticule" property')}var kh="EPSG:4326",jh="EPSG:3857",Gh=28,Dh=256,$h={CANVAS:"canvas",WEBGL:"webgl"},Vh={POINT:"Point",LINE_STRING:"LineString",POLYGON:"Polygon",MULTI_POINT:"MultiPoint",MULTI_LINE_STRING:"MultiLineString",MULTI_POLYGON:"MultiPolygon",GEOMETRY_COLLECTION:"GeometryCollection",CIRCLE:"Circle"},zh={BOTTOM_LEFT:"bottom-left",BOTTOM_RIGHT:"bottom-right",TOP_LEFT:"top-left",TOP_RIGHT:"top-right"},Yh={DEGREES:"degrees",FEET:"ft",METERS:"m",PIXELS:"pixels",TILE_PIXELS:"tile-pixels",USFEET:"us-ft"},Xh={BOTTOM_LEFT:"bottom-left",BOTTOM_CENTER:"bottom-center",BOTTOM_RIGHT:"bottom-right",CENTER_LEFT:"center-left",CENTER_CENTER:"center-center",CENTER_RIGHT:"center-right",TOP_LEFT:"top-left",TOP_CENTER:"top-center",TOP_RIGHT:"top-right"};function Uh(t,e){for(var i=0;i<e.length;i++){var n=e[i];n.enumerable=n.enumerable||!1,n.configurable=!0,"value"in n&&(n.writable=!0),ae(t,n.key,n)}}function Bh(t,e,i){return e&&Uh(t.prototype,e),i&&Uh(t,i),t}M(M.S,"Reflect",{get:function t(e,i){var n,r,o=arguments.length<3?e:arguments[2];return O(e)===o?e[i]:(n=T.f(e,i))?p(n,"value")?n.value:void 0!==n.get?n.get.call(o):void 0:u(r=xe(e))?t(r,i,o):void 0}});var Kh=w.Reflect.get;function Wh(t,e,i){return(Wh="undefined"!=typeof Reflect&&Kh?Kh:function(t,e,i){var n=function(t,e){for(;!Object.prototype.hasOwnProperty.call(t,e)&&null!==(t=Ba(t)););return t}(t,e);if(n){var r=G(n,e);return r.get?r.get.call(i):r.value}})(t,e,i||t)}var Hh=function(){this.dataProjection=null,this.defaultFeatureProjection=null};function Zh(t,e,i){var n,r=i?fo(i.featureProjection):null,o=i?fo(i.dataProjection):null;if(n=r&&o&&!_o(r,o)?t instanceof Ps?(e?t.clone():t).transform(e?r:o,e?o:r):So(t,o,r):t,e&&i&&void 0!==i.decimals){var s=Math.pow(10,i.decimals);n===t&&(n=n.clone()),n.applyTransform(function(t){for(var e=0,i=t.length;e<i;++e)t[e]=Math.round(t[e]*s)/s;return t})}return n}Hh.prototype.getReadOptions=function(t,e){var i;return e&&(i={dataProjection:e.dataProjection?e.dataProjection:this.readProjection(t),featureProjection:e.featureProjection}),this.adaptOptions(i)},Hh.prototype.adaptOptions=function(t){return qn({dataProjection:this.dataProjection,featureProjection:this.defaultFeatureProjection},t)},Hh.prototype.getLastExtent=function(){return null},Hh.prototype.getType=function(){},Hh.prototype.readFeature=function(t,e){},Hh.prototype.readFeatures=function(t,e){},Hh.prototype.readGeometry=function(t,e){},Hh.prototype.readProjection=function(t){},Hh.prototype.writeFeature=function(t,e){},Hh.prototype.writeFeatures=function(t,e){},Hh.prototype.writeGeometry=function(t,e){};var qh={ARRAY_BUFFER:"arraybuffer",JSON:"json",TEXT:"text",XML:"xml"},Jh=function(t){function e(){t.call(this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getType=function(){return qh.JSON},e.prototype.readFeature=function(t,e){return this.readFeatureFromObject(Qh(t),this.getReadOptions(t,e))},e.prototype.readFeatures=function(t,e){return this.readFeaturesFromObject(Qh(t),this.getReadOptions(t,e))},e.prototype.readFeatureFromObject=function(t,e){},e.prototype.readFeaturesFromObject=function(t,e){},e.prototype.readGeometry=function(t,e){return this.readGeometryFromObject(Qh(t),this.getReadOptions(t,e))},e.prototype.readGeometryFromObject=function(t,e){},e.prototype.readProjection=function(t){return this.readProjectionFromObject(Qh(t))},e.prototype.readProjectionFromObject=function(t){},e.prototype.writeFeature=function(t,e){return JSON.stringify(this.writeFeatureObject(t,e))},e.prototype.writeFeatureObject=function(t,e){},e.prototype.writeFeatures=function(t,e){return JSON.stringify(this.writeFeaturesObject(t,e))},e.prototype.writeFeaturesObject=function(t,e){},e.prototype.writeGeometry=function(t,e){return JSON.stringify(this.writeGeometryObject(t,e))},e.prototype.writeGeometryObject=function(t,e){},e}(Hh);function Qh(t){if("string"==typeof t){var e=JSON.parse(t);return e||null}return null!==t?t:null}var tu=function(t){function e(e){t.call(this),this.geometries_=e||null,this.listenGeometriesChange_()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.unlistenGeometriesChange_=function(){if(this.geometries_)for(var t=0,e=this.geometries_.length;t<e;++t)vr(this.geometries_[t],br.CHANGE,this.changed,this)},e.prototype.listenGeometriesChange_=function(){if(this.geometries_)for(var t=0,e=this.geometries_.length;t<e;++t)gr(this.geometries_[t],br.CHANGE,this.changed,this)},e.prototype.clone=function(){var t=new e(null);return t.setGeometries(this.geometries_),t},e.prototype.closestPointXY=function(t,e,i,n){if(n<pn(this.getExtent(),t,e))return n;for(var r=this.geometries_,o=0,s=r.length;o<s;++o)n=r[o].closestPointXY(t,e,i,n);return n},e.prototype.containsXY=function(t,e){for(var i=this.geometries_,n=0,r=i.length;n<r;++n)if(i[n].containsXY(t,e))return!0;return!1},e.prototype.computeExtent=function(t){mn(t);for(var e=this.geometries_,i=0,n=e.length;i<n;++i)wn(t,e[i].getExtent());return t},e.prototype.getGeometries=function(){return eu(this.geometries_)},e.prototype.getGeometriesArray=function(){return this.geometries_},e.prototype.getSimplifiedGeometry=function(t){if(this.simplifiedGeometryRevision!=this.getRevision()&&(Jn(this.simplifiedGeometryCache),this.simplifiedGeometryMaxMinSquaredTolerance=0,this.simplifiedGeometryRevision=this.getRevision()),t<0||0!==this.simplifiedGeometryMaxMinSquaredTolerance&&t<this.simplifiedGeometryMaxMinSquaredTolerance)return this;var i=t.toString();if(this.simplifiedGeometryCache.hasOwnProperty(i))return this.simplifiedGeometryCache[i];for(var n=[],r=this.geometries_,o=!1,s=0,a=r.length;s<a;++s){var h=r[s],u=h.getSimplifiedGeometry(t);n.push(u),u!==h&&(o=!0)}if(o){var l=new e(null);return l.setGeometriesArray(n),this.simplifiedGeometryCache[i]=l,l}return this.simplifiedGeometryMaxMinSquaredTolerance=t,this},e.prototype.getType=function(){return Dr.GEOMETRY_COLLECTION},e.prototype.intersectsExtent=function(t){for(var e=this.geometries_,i=0,n=e.length;i<n;++i)if(e[i].intersectsExtent(t))return!0;return!1},e.prototype.isEmpty=function(){return 0===this.geometries_.length},e.prototype.rotate=function(t,e){for(var i=this.geometries_,n=0,r=i.length;n<r;++n)i[n].rotate(t,e);this.changed()},e.prototype.scale=function(t,e,i){var n=i;n||(n=Ln(this.getExtent()));for(var r=this.geometries_,o=0,s=r.length;o<s;++o)r[o].scale(t,e,n);this.changed()},e.prototype.setGeometries=function(t){this.setGeometriesArray(eu(t))},e.prototype.setGeometriesArray=function(t){this.unlistenGeometriesChange_(),this.geometries_=t,this.listenGeometriesChange_(),this.changed()},e.prototype.applyTransform=function(t){for(var e=this.geometries_,i=0,n=e.length;i<n;++i)e[i].applyTransform(t);this.changed()},e.prototype.translate=function(t,e){for(var i=this.geometries_,n=0,r=i.length;n<r;++n)i[n].translate(t,e);this.changed()},e.prototype.disposeInternal=function(){this.unlistenGeometriesChange_(),t.prototype.disposeInternal.call(this)},e}(Ps);function eu(t){for(var e=[],i=0,n=t.length;i<n;++i)e.push(t[i].clone());return e}var iu={XY:"XY",XYZ:"XYZ",XYM:"XYM",XYZM:"XYZM"},nu=function(t){function e(){t.call(this),this.layout=iu.XY,this.stride=2,this.flatCoordinates=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.computeExtent=function(t){return Sn(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t)},e.prototype.getCoordinates=function(){},e.prototype.getFirstCoordinate=function(){return this.flatCoordinates.slice(0,this.stride)},e.prototype.getFlatCoordinates=function(){return this.flatCoordinates},e.prototype.getLastCoordinate=function(){return this.flatCoordinates.slice(this.flatCoordinates.length-this.stride)},e.prototype.getLayout=function(){return this.layout},e.prototype.getSimplifiedGeometry=function(t){if(this.simplifiedGeometryRevision!=this.getRevision()&&(Jn(this.simplifiedGeometryCache),this.simplifiedGeometryMaxMinSquaredTolerance=0,this.simplifiedGeometryRevision=this.getRevision()),t<0||0!==this.simplifiedGeometryMaxMinSquaredTolerance&&t<=this.simplifiedGeometryMaxMinSquaredTolerance)return this;var e=t.toString();if(this.simplifiedGeometryCache.hasOwnProperty(e))return this.simplifiedGeometryCache[e];var i=this.getSimplifiedGeometryInternal(t);return i.getFlatCoordinates().length<this.flatCoordinates.length?(this.simplifiedGeometryCache[e]=i,i):(this.simplifiedGeometryMaxMinSquaredTolerance=t,this)},e.prototype.getSimplifiedGeometryInternal=function(t){return this},e.prototype.getStride=function(){return this.stride},e.prototype.setFlatCoordinates=function(t,e){this.stride=ru(t),this.layout=t,this.flatCoordinates=e},e.prototype.setCoordinates=function(t,e){},e.prototype.setLayout=function(t,e,i){var n;if(t)n=ru(t);else{for(var r=0;r<i;++r){if(0===e.length)return this.layout=iu.XY,void(this.stride=2);e=e[0]}t=function(t){var e;2==t?e=iu.XY:3==t?e=iu.XYZ:4==t&&(e=iu.XYZM);return e}(n=e.length)}this.layout=t,this.stride=n},e.prototype.applyTransform=function(t){this.flatCoordinates&&(t(this.flatCoordinates,this.flatCoordinates,this.stride),this.changed())},e.prototype.rotate=function(t,e){var i=this.getFlatCoordinates();if(i){var n=this.getStride();!function(t,e,i,n,r,o,s){for(var a=s||[],h=Math.cos(r),u=Math.sin(r),l=o[0],c=o[1],p=0,f=e;f<i;f+=n){var d=t[f]-l,g=t[f+1]-c;a[p++]=l+d*h-g*u,a[p++]=c+d*u+g*h;for(var y=f+2;y<f+n;++y)a[p++]=t[y]}s&&a.length!=p&&(a.length=p)}(i,0,i.length,n,t,e,i),this.changed()}},e.prototype.scale=function(t,e,i){var n=e;void 0===n&&(n=t);var r=i;r||(r=Ln(this.getExtent()));var o=this.getFlatCoordinates();if(o){var s=this.getStride();!function(t,e,i,n,r,o,s,a){for(var h=a||[],u=s[0],l=s[1],c=0,p=e;p<i;p+=n){var f=t[p]-u,d=t[p+1]-l;h[c++]=u+r*f,h[c++]=l+o*d;for(var g=p+2;g<p+n;++g)h[c++]=t[g]}a&&h.length!=c&&(h.length=c)}(o,0,o.length,s,t,n,r,o),this.changed()}},e.prototype.translate=function(t,e){var i=this.getFlatCoordinates();if(i){var n=this.getStride();!function(t,e,i,n,r,o,s){for(var a=s||[],h=0,u=e;u<i;u+=n){a[h++]=t[u]+r,a[h++]=t[u+1]+o;for(var l=u+2;l<u+n;++l)a[h++]=t[l]}s&&a.length!=h&&(a.length=h)}(i,0,i.length,n,t,e,i),this.changed()}},e}(Ps);function ru(t){var e;return t==iu.XY?e=2:t==iu.XYZ||t==iu.XYM?e=3:t==iu.XYZM&&(e=4),e}function ou(t,e,i,n,r,o,s){var a,h=t[e],u=t[e+1],l=t[i]-h,c=t[i+1]-u;if(0===l&&0===c)a=e;else{var p=((r-h)*l+(o-u)*c)/(l*l+c*c);if(p>1)a=i;else{if(p>0){for(var f=0;f<n;++f)s[f]=Zn(t[e+f],t[i+f],p);return void(s.length=n)}a=e}}for(var d=0;d<n;++d)s[d]=t[a+d];s.length=n}function su(t,e,i,n,r){var o=t[e],s=t[e+1];for(e+=n;e<i;e+=n){var a=t[e],h=t[e+1],u=Bn(o,s,a,h);u>r&&(r=u),o=a,s=h}return r}function au(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o){var a=i[o];r=su(t,e,a,n,r),e=a}return r}function hu(t,e,i,n,r,o,s,a,h,u,l){if(e==i)return u;var c,p;if(0===r){if((p=Bn(s,a,t[e],t[e+1]))<u){for(c=0;c<n;++c)h[c]=t[e+c];return h.length=n,p}return u}for(var f=l||[NaN,NaN],d=e+n;d<i;)if(ou(t,d-n,d,n,s,a,f),(p=Bn(s,a,f[0],f[1]))<u){for(u=p,c=0;c<n;++c)h[c]=f[c];h.length=n,d+=n}else d+=n*Math.max((Math.sqrt(p)-Math.sqrt(u))/r|0,1);if(o&&(ou(t,i-n,e,n,s,a,f),(p=Bn(s,a,f[0],f[1]))<u)){for(u=p,c=0;c<n;++c)h[c]=f[c];h.length=n}return u}function uu(t,e,i,n,r,o,s,a,h,u,l){for(var c=l||[NaN,NaN],p=0,f=i.length;p<f;++p){var d=i[p];u=hu(t,e,d,n,r,o,s,a,h,u,c),e=d}return u}function lu(t,e,i,n){for(var r=0,o=i.length;r<o;++r)t[e++]=i[r];return e}function cu(t,e,i,n){for(var r=0,o=i.length;r<o;++r)for(var s=i[r],a=0;a<n;++a)t[e++]=s[a];return e}function pu(t,e,i,n,r){for(var o=r||[],s=0,a=0,h=i.length;a<h;++a){var u=cu(t,e,i[a],n);o[s++]=u,e=u}return o.length=s,o}function fu(t,e,i,n,r){for(var o=void 0!==r?r:[],s=0,a=e;a<i;a+=n)o[s++]=t.slice(a,a+n);return o.length=s,o}function du(t,e,i,n,r){for(var o=void 0!==r?r:[],s=0,a=0,h=i.length;a<h;++a){var u=i[a];o[s++]=fu(t,e,u,n,o[s]),e=u}return o.length=s,o}function gu(t,e,i,n,r){for(var o=void 0!==r?r:[],s=0,a=0,h=i.length;a<h;++a){var u=i[a];o[s++]=du(t,e,u,n,o[s]),e=u[u.length-1]}return o.length=s,o}function yu(t,e,i,n,r,o){var s=NaN,a=NaN,h=(i-e)/n;if(1===h)s=t[e],a=t[e+1];else if(2==h)s=(1-r)*t[e]+r*t[e+n],a=(1-r)*t[e+1]+r*t[e+n+1];else if(0!==h){for(var u=t[e],l=t[e+1],c=0,p=[0],f=e+n;f<i;f+=n){var d=t[f],g=t[f+1];c+=Math.sqrt((d-u)*(d-u)+(g-l)*(g-l)),p.push(c),u=d,l=g}var y=r*c,v=function(t,e,i){for(var n,r,o=i||Uo,s=0,a=t.length,h=!1;s<a;)(r=+o(t[n=s+(a-s>>1)],e))<0?s=n+1:(a=n,h=!r);return h?s:~s}(p,y);if(v<0){var _=(y-p[-v-2])/(p[-v-1]-p[-v-2]),m=e+(-v-2)*n;s=Zn(t[m],t[m+n],_),a=Zn(t[m+1],t[m+n+1],_)}else s=t[e+v*n],a=t[e+v*n+1]}return o?(o[0]=s,o[1]=a,o):[s,a]}function vu(t,e,i,n,r,o){if(i==e)return null;var s;if(r<t[e+n-1])return o?((s=t.slice(e,e+n))[n-1]=r,s):null;if(t[i-1]<r)return o?((s=t.slice(i-n,i))[n-1]=r,s):null;if(r==t[e+n-1])return t.slice(e,e+n);for(var a=e/n,h=i/n;a<h;){var u=a+h>>1;r<t[(u+1)*n-1]?h=u:a=u+1}var l=t[a*n-1];if(r==l)return t.slice((a-1)*n,(a-1)*n+n);var c=(r-l)/(t[(a+1)*n-1]-l);s=[];for(var p=0;p<n-1;++p)s.push(Zn(t[(a-1)*n+p],t[a*n+p],c));return s.push(r),s}function _u(t,e,i,n,r){return!On(r,function(r){return!mu(t,e,i,n,r[0],r[1])})}function mu(t,e,i,n,r,o){for(var s=0,a=t[i-n],h=t[i-n+1];e<i;e+=n){var u=t[e],l=t[e+1];h<=o?l>o&&(u-a)*(o-h)-(r-a)*(l-h)>0&&s++:l<=o&&(u-a)*(o-h)-(r-a)*(l-h)<0&&s--,a=u,h=l}return 0!==s}function Eu(t,e,i,n,r,o){if(0===i.length)return!1;if(!mu(t,e,i[0],n,r,o))return!1;for(var s=1,a=i.length;s<a;++s)if(mu(t,i[s-1],i[s],n,r,o))return!1;return!0}function xu(t,e,i,n,r,o){for(var s,a=[t[e],t[e+1]],h=[];e+n<i;e+=n){if(h[0]=t[e+n],h[1]=t[e+n+1],s=r.call(o,a,h))return s;a[0]=h[0],a[1]=h[1]}return!1}function Su(t,e,i,n,r){var o=Cn([1/0,1/0,-1/0,-1/0],t,e,i,n);return!!Dn(r,o)&&(!!dn(r,o)||(o[0]>=r[0]&&o[2]<=r[2]||(o[1]>=r[1]&&o[3]<=r[3]||xu(t,e,i,n,function(t,e){return function(t,e,i){var n=!1,r=yn(t,e),o=yn(t,i);if(r===hn.INTERSECTING||o===hn.INTERSECTING)n=!0;else{var s,a,h=t[0],u=t[1],l=t[2],c=t[3],p=e[0],f=e[1],d=i[0],g=i[1],y=(g-f)/(d-p);o&hn.ABOVE&&!(r&hn.ABOVE)&&(n=(s=d-(g-c)/y)>=h&&s<=l),n||!(o&hn.RIGHT)||r&hn.RIGHT||(n=(a=g-(d-l)*y)>=u&&a<=c),n||!(o&hn.BELOW)||r&hn.BELOW||(n=(s=d-(g-u)/y)>=h&&s<=l),n||!(o&hn.LEFT)||r&hn.LEFT||(n=(a=g-(d-h)*y)>=u&&a<=c)}return n}(r,t,e)}))))}function Tu(t,e,i,n,r){if(!function(t,e,i,n,r){return!!(Su(t,e,i,n,r)||mu(t,e,i,n,r[0],r[1])||mu(t,e,i,n,r[0],r[3])||mu(t,e,i,n,r[2],r[1])||mu(t,e,i,n,r[2],r[3]))}(t,e,i[0],n,r))return!1;if(1===i.length)return!0;for(var o=1,s=i.length;o<s;++o)if(_u(t,i[o-1],i[o],n,r))return!1;return!0}function wu(t,e,i,n){for(var r=t[e],o=t[e+1],s=0,a=e+n;a<i;a+=n){var h=t[a],u=t[a+1];s+=Math.sqrt((h-r)*(h-r)+(u-o)*(u-o)),r=h,o=u}return s}function bu(t,e,i,n,r,o,s){var a=(i-e)/n;if(a<3){for(;e<i;e+=n)o[s++]=t[e],o[s++]=t[e+1];return s}var h=new Array(a);h[0]=1,h[a-1]=1;for(var u=[e,i-n],l=0;u.length>0;){for(var c=u.pop(),p=u.pop(),f=0,d=t[p],g=t[p+1],y=t[c],v=t[c+1],_=p+n;_<c;_+=n){var m=Un(t[_],t[_+1],d,g,y,v);m>f&&(l=_,f=m)}f>r&&(h[(l-e)/n]=1,p+n<l&&u.push(p,l),l+n<c&&u.push(l,c))}for(var E=0;E<a;++E)h[E]&&(o[s++]=t[e+E*n],o[s++]=t[e+E*n+1]);return s}function Cu(t,e){return e*Math.round(t/e)}function Ru(t,e,i,n,r,o,s){if(e==i)return s;var a,h,u=Cu(t[e],r),l=Cu(t[e+1],r);e+=n,o[s++]=u,o[s++]=l;do{if(a=Cu(t[e],r),h=Cu(t[e+1],r),(e+=n)==i)return o[s++]=a,o[s++]=h,s}while(a==u&&h==l);for(;e<i;){var c=Cu(t[e],r),p=Cu(t[e+1],r);if(e+=n,c!=a||p!=h){var f=a-u,d=h-l,g=c-u,y=p-l;f*y==d*g&&(f<0&&g<f||f==g||f>0&&g>f)&&(d<0&&y<d||d==y||d>0&&y>d)?(a=c,h=p):(o[s++]=a,o[s++]=h,u=a,l=h,a=c,h=p)}}return o[s++]=a,o[s++]=h,s}function Ou(t,e,i,n,r,o,s,a){for(var h=0,u=i.length;h<u;++h){var l=i[h];s=Ru(t,e,l,n,r,o,s),a.push(s),e=l}return s}nu.prototype.containsXY=Er;var Iu=function(t){function e(e,i){t.call(this),this.flatMidpoint_=null,this.flatMidpointRevision_=-1,this.maxDelta_=-1,this.maxDeltaRevision_=-1,void 0===i||Array.isArray(e[0])?this.setCoordinates(e,i):this.setFlatCoordinates(i,e)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.appendCoordinate=function(t){this.flatCoordinates?Ho(this.flatCoordinates,t):this.flatCoordinates=t.slice(),this.changed()},e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),this.layout)},e.prototype.closestPointXY=function(t,e,i,n){return n<pn(this.getExtent(),t,e)?n:(this.maxDeltaRevision_!=this.getRevision()&&(this.maxDelta_=Math.sqrt(su(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,0)),this.maxDeltaRevision_=this.getRevision()),hu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,this.maxDelta_,!1,t,e,i,n))},e.prototype.forEachSegment=function(t){return xu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t)},e.prototype.getCoordinateAtM=function(t,e){if(this.layout!=iu.XYM&&this.layout!=iu.XYZM)return null;var i=void 0!==e&&e;return vu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t,i)},e.prototype.getCoordinates=function(){return fu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride)},e.prototype.getCoordinateAt=function(t,e){return yu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t,e)},e.prototype.getLength=function(){return wu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride)},e.prototype.getFlatMidpoint=function(){return this.flatMidpointRevision_!=this.getRevision()&&(this.flatMidpoint_=this.getCoordinateAt(.5,this.flatMidpoint_),this.flatMidpointRevision_=this.getRevision()),this.flatMidpoint_},e.prototype.getSimplifiedGeometryInternal=function(t){var i=[];return i.length=bu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t,i,0),new e(i,iu.XY)},e.prototype.getType=function(){return Dr.LINE_STRING},e.prototype.intersectsExtent=function(t){return Su(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t)},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,1),this.flatCoordinates||(this.flatCoordinates=[]),this.flatCoordinates.length=cu(this.flatCoordinates,0,t,this.stride),this.changed()},e}(nu),Pu=function(t){function e(e,i,n){if(t.call(this),this.ends_=[],this.maxDelta_=-1,this.maxDeltaRevision_=-1,Array.isArray(e[0]))this.setCoordinates(e,i);else if(void 0!==i&&n)this.setFlatCoordinates(i,e),this.ends_=n;else{for(var r=this.getLayout(),o=[],s=[],a=0,h=e.length;a<h;++a){var u=e[a];0===a&&(r=u.getLayout()),Ho(o,u.getFlatCoordinates()),s.push(o.length)}this.setFlatCoordinates(r,o),this.ends_=s}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.appendLineString=function(t){this.flatCoordinates?Ho(this.flatCoordinates,t.getFlatCoordinates().slice()):this.flatCoordinates=t.getFlatCoordinates().slice(),this.ends_.push(this.flatCoordinates.length),this.changed()},e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),this.layout,this.ends_.slice())},e.prototype.closestPointXY=function(t,e,i,n){return n<pn(this.getExtent(),t,e)?n:(this.maxDeltaRevision_!=this.getRevision()&&(this.maxDelta_=Math.sqrt(au(this.flatCoordinates,0,this.ends_,this.stride,0)),this.maxDeltaRevision_=this.getRevision()),uu(this.flatCoordinates,0,this.ends_,this.stride,this.maxDelta_,!1,t,e,i,n))},e.prototype.getCoordinateAtM=function(t,e,i){if(this.layout!=iu.XYM&&this.layout!=iu.XYZM||0===this.flatCoordinates.length)return null;var n=void 0!==e&&e,r=void 0!==i&&i;return function(t,e,i,n,r,o,s){if(s)return vu(t,e,i[i.length-1],n,r,o);var a;if(r<t[n-1])return o?((a=t.slice(0,n))[n-1]=r,a):null;if(t[t.length-1]<r)return o?((a=t.slice(t.length-n))[n-1]=r,a):null;for(var h=0,u=i.length;h<u;++h){var l=i[h];if(e!=l){if(r<t[e+n-1])return null;if(r<=t[l-1])return vu(t,e,l,n,r,!1);e=l}}return null}(this.flatCoordinates,0,this.ends_,this.stride,t,n,r)},e.prototype.getCoordinates=function(){return du(this.flatCoordinates,0,this.ends_,this.stride)},e.prototype.getEnds=function(){return this.ends_},e.prototype.getLineString=function(t){return t<0||this.ends_.length<=t?null:new Iu(this.flatCoordinates.slice(0===t?0:this.ends_[t-1],this.ends_[t]),this.layout)},e.prototype.getLineStrings=function(){for(var t=this.flatCoordinates,e=this.ends_,i=this.layout,n=[],r=0,o=0,s=e.length;o<s;++o){var a=e[o],h=new Iu(t.slice(r,a),i);n.push(h),r=a}return n},e.prototype.getFlatMidpoints=function(){for(var t=[],e=this.flatCoordinates,i=0,n=this.ends_,r=this.stride,o=0,s=n.length;o<s;++o){var a=n[o];Ho(t,yu(e,i,a,r,.5)),i=a}return t},e.prototype.getSimplifiedGeometryInternal=function(t){var i=[],n=[];return i.length=function(t,e,i,n,r,o,s,a){for(var h=0,u=i.length;h<u;++h){var l=i[h];s=bu(t,e,l,n,r,o,s),a.push(s),e=l}return s}(this.flatCoordinates,0,this.ends_,this.stride,t,i,0,n),new e(i,iu.XY,n)},e.prototype.getType=function(){return Dr.MULTI_LINE_STRING},e.prototype.intersectsExtent=function(t){return function(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o){if(Su(t,e,i[o],n,r))return!0;e=i[o]}return!1}(this.flatCoordinates,0,this.ends_,this.stride,t)},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,2),this.flatCoordinates||(this.flatCoordinates=[]);var i=pu(this.flatCoordinates,0,t,this.stride,this.ends_);this.flatCoordinates.length=0===i.length?0:i[i.length-1],this.changed()},e}(nu),Fu=function(t){function e(e,i){t.call(this),this.setCoordinates(e,i)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),this.layout)},e.prototype.closestPointXY=function(t,e,i,n){var r=this.flatCoordinates,o=Bn(t,e,r[0],r[1]);if(o<n){for(var s=this.stride,a=0;a<s;++a)i[a]=r[a];return i.length=s,o}return n},e.prototype.getCoordinates=function(){return this.flatCoordinates?this.flatCoordinates.slice():[]},e.prototype.computeExtent=function(t){return En(this.flatCoordinates,t)},e.prototype.getType=function(){return Dr.POINT},e.prototype.intersectsExtent=function(t){return gn(t,this.flatCoordinates[0],this.flatCoordinates[1])},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,0),this.flatCoordinates||(this.flatCoordinates=[]),this.flatCoordinates.length=lu(this.flatCoordinates,0,t,this.stride),this.changed()},e}(nu),Lu=function(t){function e(e,i){t.call(this),i&&!Array.isArray(e[0])?this.setFlatCoordinates(i,e):this.setCoordinates(e,i)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.appendPoint=function(t){this.flatCoordinates?Ho(this.flatCoordinates,t.getFlatCoordinates()):this.flatCoordinates=t.getFlatCoordinates().slice(),this.changed()},e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),this.layout)},e.prototype.closestPointXY=function(t,e,i,n){if(n<pn(this.getExtent(),t,e))return n;for(var r=this.flatCoordinates,o=this.stride,s=0,a=r.length;s<a;s+=o){var h=Bn(t,e,r[s],r[s+1]);if(h<n){n=h;for(var u=0;u<o;++u)i[u]=r[s+u];i.length=o}}return n},e.prototype.getCoordinates=function(){return fu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride)},e.prototype.getPoint=function(t){var e=this.flatCoordinates?this.flatCoordinates.length/this.stride:0;return t<0||e<=t?null:new Fu(this.flatCoordinates.slice(t*this.stride,(t+1)*this.stride),this.layout)},e.prototype.getPoints=function(){for(var t=this.flatCoordinates,e=this.layout,i=this.stride,n=[],r=0,o=t.length;r<o;r+=i){var s=new Fu(t.slice(r,r+i),e);n.push(s)}return n},e.prototype.getType=function(){return Dr.MULTI_POINT},e.prototype.intersectsExtent=function(t){for(var e=this.flatCoordinates,i=this.stride,n=0,r=e.length;n<r;n+=i){if(gn(t,e[n],e[n+1]))return!0}return!1},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,1),this.flatCoordinates||(this.flatCoordinates=[]),this.flatCoordinates.length=cu(this.flatCoordinates,0,t,this.stride),this.changed()},e}(nu);function Mu(t,e,i,n){for(var r=0,o=t[i-n],s=t[i-n+1];e<i;e+=n){var a=t[e],h=t[e+1];r+=s*a-o*h,o=a,s=h}return r/2}function Au(t,e,i,n){for(var r=0,o=0,s=i.length;o<s;++o){var a=i[o];r+=Mu(t,e,a,n),e=a}return r}var Nu=function(t){function e(e,i){t.call(this),this.maxDelta_=-1,this.maxDeltaRevision_=-1,void 0===i||Array.isArray(e[0])?this.setCoordinates(e,i):this.setFlatCoordinates(i,e)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),this.layout)},e.prototype.closestPointXY=function(t,e,i,n){return n<pn(this.getExtent(),t,e)?n:(this.maxDeltaRevision_!=this.getRevision()&&(this.maxDelta_=Math.sqrt(su(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,0)),this.maxDeltaRevision_=this.getRevision()),hu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,this.maxDelta_,!0,t,e,i,n))},e.prototype.getArea=function(){return Mu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride)},e.prototype.getCoordinates=function(){return fu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride)},e.prototype.getSimplifiedGeometryInternal=function(t){var i=[];return i.length=bu(this.flatCoordinates,0,this.flatCoordinates.length,this.stride,t,i,0),new e(i,iu.XY)},e.prototype.getType=function(){return Dr.LINEAR_RING},e.prototype.intersectsExtent=function(t){},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,1),this.flatCoordinates||(this.flatCoordinates=[]),this.flatCoordinates.length=cu(this.flatCoordinates,0,t,this.stride),this.changed()},e}(nu);function ku(t,e,i,n,r,o,s){for(var a,h,u,l,c,p,f,d=r[o+1],g=[],y=0,v=i.length;y<v;++y){var _=i[y];for(l=t[_-n],p=t[_-n+1],a=e;a<_;a+=n)c=t[a],f=t[a+1],(d<=p&&f<=d||p<=d&&d<=f)&&(u=(d-p)/(f-p)*(c-l)+l,g.push(u)),l=c,p=f}var m=NaN,E=-1/0;for(g.sort(Uo),l=g[0],a=1,h=g.length;a<h;++a){c=g[a];var x=Math.abs(c-l);x>E&&Eu(t,e,i,n,u=(l+c)/2,d)&&(m=u,E=x),l=c}return isNaN(m)&&(m=r[o]),s?(s.push(m,d,E),s):[m,d,E]}function ju(t,e,i,n,r){for(var o=[],s=0,a=i.length;s<a;++s){var h=i[s];o=ku(t,e,h,n,r,2*s,o),e=h[h.length-1]}return o}function Gu(t,e,i,n){for(;e<i-n;){for(var r=0;r<n;++r){var o=t[e+r];t[e+r]=t[i-n+r],t[i-n+r]=o}e+=n,i-=n}}function Du(t,e,i,n){for(var r=0,o=t[i-n],s=t[i-n+1];e<i;e+=n){var a=t[e],h=t[e+1];r+=(a-o)*(h+s),o=a,s=h}return r>0}function $u(t,e,i,n,r){for(var o=void 0!==r&&r,s=0,a=i.length;s<a;++s){var h=i[s],u=Du(t,e,h,n);if(0===s){if(o&&u||!o&&!u)return!1}else if(o&&!u||!o&&u)return!1;e=h}return!0}function Vu(t,e,i,n,r){for(var o=void 0!==r&&r,s=0,a=i.length;s<a;++s){var h=i[s],u=Du(t,e,h,n);(0===s?o&&u||!o&&!u:o&&!u||!o&&u)&&Gu(t,e,h,n),e=h}return e}function zu(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o)e=Vu(t,e,i[o],n,r);return e}var Yu=function(t){function e(e,i,n){t.call(this),this.ends_=[],this.flatInteriorPointRevision_=-1,this.flatInteriorPoint_=null,this.maxDelta_=-1,this.maxDeltaRevision_=-1,this.orientedRevision_=-1,this.orientedFlatCoordinates_=null,void 0!==i&&n?(this.setFlatCoordinates(i,e),this.ends_=n):this.setCoordinates(e,i)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.appendLinearRing=function(t){this.flatCoordinates?Ho(this.flatCoordinates,t.getFlatCoordinates()):this.flatCoordinates=t.getFlatCoordinates().slice(),this.ends_.push(this.flatCoordinates.length),this.changed()},e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),this.layout,this.ends_.slice())},e.prototype.closestPointXY=function(t,e,i,n){return n<pn(this.getExtent(),t,e)?n:(this.maxDeltaRevision_!=this.getRevision()&&(this.maxDelta_=Math.sqrt(au(this.flatCoordinates,0,this.ends_,this.stride,0)),this.maxDeltaRevision_=this.getRevision()),uu(this.flatCoordinates,0,this.ends_,this.stride,this.maxDelta_,!0,t,e,i,n))},e.prototype.containsXY=function(t,e){return Eu(this.getOrientedFlatCoordinates(),0,this.ends_,this.stride,t,e)},e.prototype.getArea=function(){return Au(this.getOrientedFlatCoordinates(),0,this.ends_,this.stride)},e.prototype.getCoordinates=function(t){var e;return void 0!==t?Vu(e=this.getOrientedFlatCoordinates().slice(),0,this.ends_,this.stride,t):e=this.flatCoordinates,du(e,0,this.ends_,this.stride)},e.prototype.getEnds=function(){return this.ends_},e.prototype.getFlatInteriorPoint=function(){if(this.flatInteriorPointRevision_!=this.getRevision()){var t=Ln(this.getExtent());this.flatInteriorPoint_=ku(this.getOrientedFlatCoordinates(),0,this.ends_,this.stride,t,0),this.flatInteriorPointRevision_=this.getRevision()}return this.flatInteriorPoint_},e.prototype.getInteriorPoint=function(){return new Fu(this.getFlatInteriorPoint(),iu.XYM)},e.prototype.getLinearRingCount=function(){return this.ends_.length},e.prototype.getLinearRing=function(t){return t<0||this.ends_.length<=t?null:new Nu(this.flatCoordinates.slice(0===t?0:this.ends_[t-1],this.ends_[t]),this.layout)},e.prototype.getLinearRings=function(){for(var t=this.layout,e=this.flatCoordinates,i=this.ends_,n=[],r=0,o=0,s=i.length;o<s;++o){var a=i[o],h=new Nu(e.slice(r,a),t);n.push(h),r=a}return n},e.prototype.getOrientedFlatCoordinates=function(){if(this.orientedRevision_!=this.getRevision()){var t=this.flatCoordinates;$u(t,0,this.ends_,this.stride)?this.orientedFlatCoordinates_=t:(this.orientedFlatCoordinates_=t.slice(),this.orientedFlatCoordinates_.length=Vu(this.orientedFlatCoordinates_,0,this.ends_,this.stride)),this.orientedRevision_=this.getRevision()}return this.orientedFlatCoordinates_},e.prototype.getSimplifiedGeometryInternal=function(t){var i=[],n=[];return i.length=Ou(this.flatCoordinates,0,this.ends_,this.stride,Math.sqrt(t),i,0,n),new e(i,iu.XY,n)},e.prototype.getType=function(){return Dr.POLYGON},e.prototype.intersectsExtent=function(t){return Tu(this.getOrientedFlatCoordinates(),0,this.ends_,this.stride,t)},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,2),this.flatCoordinates||(this.flatCoordinates=[]);var i=pu(this.flatCoordinates,0,t,this.stride,this.ends_);this.flatCoordinates.length=0===i.length?0:i[i.length-1],this.changed()},e}(nu);function Xu(t,e,i,n){for(var r=i||32,o=[],s=0;s<r;++s)Ho(o,zr(t,e,2*Math.PI*s/r,n));return o.push(o[0],o[1]),new Yu(o,iu.XY,[o.length])}function Uu(t){var e=t[0],i=t[1],n=t[2],r=t[3],o=[e,i,e,r,n,r,n,i,e,i];return new Yu(o,iu.XY,[o.length])}function Bu(t,e,i){for(var n=e||32,r=t.getStride(),o=t.getLayout(),s=t.getCenter(),a=r*(n+1),h=new Array(a),u=0;u<a;u+=r){h[u]=0,h[u+1]=0;for(var l=2;l<r;l++)h[u+l]=s[l]}var c=[h.length],p=new Yu(h,o,c);return function(t,e,i,n){for(var r=t.getFlatCoordinates(),o=t.getStride(),s=r.length/o-1,a=n||0,h=0;h<=s;++h){var u=h*o,l=a+2*Hn(h,s)*Math.PI/s;r[u]=e[0]+i*Math.cos(l),r[u+1]=e[1]+i*Math.sin(l)}t.changed()}(p,s,t.getRadius(),i),p}function Ku(t,e,i,n){for(var r=[],o=[1/0,1/0,-1/0,-1/0],s=0,a=i.length;s<a;++s){var h=i[s];o=Sn(t,e,h[0],n),r.push((o[0]+o[2])/2,(o[1]+o[3])/2),e=h[h.length-1]}return r}var Wu=function(t){function e(e,i,n){if(t.call(this),this.endss_=[],this.flatInteriorPointsRevision_=-1,this.flatInteriorPoints_=null,this.maxDelta_=-1,this.maxDeltaRevision_=-1,this.orientedRevision_=-1,this.orientedFlatCoordinates_=null,!n&&!Array.isArray(e[0])){for(var r=this.getLayout(),o=[],s=[],a=0,h=e.length;a<h;++a){var u=e[a];0===a&&(r=u.getLayout());for(var l=o.length,c=u.getEnds(),p=0,f=c.length;p<f;++p)c[p]+=l;Ho(o,u.getFlatCoordinates()),s.push(c)}i=r,e=o,n=s}void 0!==i&&n?(this.setFlatCoordinates(i,e),this.endss_=n):this.setCoordinates(e,i)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.appendPolygon=function(t){var e;if(this.flatCoordinates){var i=this.flatCoordinates.length;Ho(this.flatCoordinates,t.getFlatCoordinates());for(var n=0,r=(e=t.getEnds().slice()).length;n<r;++n)e[n]+=i}else this.flatCoordinates=t.getFlatCoordinates().slice(),e=t.getEnds().slice(),this.endss_.push();this.endss_.push(e),this.changed()},e.prototype.clone=function(){for(var t=this.endss_.length,i=new Array(t),n=0;n<t;++n)i[n]=this.endss_[n].slice();return new e(this.flatCoordinates.slice(),this.layout,i)},e.prototype.closestPointXY=function(t,e,i,n){return n<pn(this.getExtent(),t,e)?n:(this.maxDeltaRevision_!=this.getRevision()&&(this.maxDelta_=Math.sqrt(function(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o){var a=i[o];r=au(t,e,a,n,r),e=a[a.length-1]}return r}(this.flatCoordinates,0,this.endss_,this.stride,0)),this.maxDeltaRevision_=this.getRevision()),function(t,e,i,n,r,o,s,a,h,u,l){for(var c=l||[NaN,NaN],p=0,f=i.length;p<f;++p){var d=i[p];u=uu(t,e,d,n,r,o,s,a,h,u,c),e=d[d.length-1]}return u}(this.getOrientedFlatCoordinates(),0,this.endss_,this.stride,this.maxDelta_,!0,t,e,i,n))},e.prototype.containsXY=function(t,e){return function(t,e,i,n,r,o){if(0===i.length)return!1;for(var s=0,a=i.length;s<a;++s){var h=i[s];if(Eu(t,e,h,n,r,o))return!0;e=h[h.length-1]}return!1}(this.getOrientedFlatCoordinates(),0,this.endss_,this.stride,t,e)},e.prototype.getArea=function(){return function(t,e,i,n){for(var r=0,o=0,s=i.length;o<s;++o){var a=i[o];r+=Au(t,e,a,n),e=a[a.length-1]}return r}(this.getOrientedFlatCoordinates(),0,this.endss_,this.stride)},e.prototype.getCoordinates=function(t){var e;return void 0!==t?zu(e=this.getOrientedFlatCoordinates().slice(),0,this.endss_,this.stride,t):e=this.flatCoordinates,gu(e,0,this.endss_,this.stride)},e.prototype.getEndss=function(){return this.endss_},e.prototype.getFlatInteriorPoints=function(){if(this.flatInteriorPointsRevision_!=this.getRevision()){var t=Ku(this.flatCoordinates,0,this.endss_,this.stride);this.flatInteriorPoints_=ju(this.getOrientedFlatCoordinates(),0,this.endss_,this.stride,t),this.flatInteriorPointsRevision_=this.getRevision()}return this.flatInteriorPoints_},e.prototype.getInteriorPoints=function(){return new Lu(this.getFlatInteriorPoints().slice(),iu.XYM)},e.prototype.getOrientedFlatCoordinates=function(){if(this.orientedRevision_!=this.getRevision()){var t=this.flatCoordinates;!function(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o)if(!$u(t,e,i[o],n,r))return!1;return!0}(t,0,this.endss_,this.stride)?(this.orientedFlatCoordinates_=t.slice(),this.orientedFlatCoordinates_.length=zu(this.orientedFlatCoordinates_,0,this.endss_,this.stride)):this.orientedFlatCoordinates_=t,this.orientedRevision_=this.getRevision()}return this.orientedFlatCoordinates_},e.prototype.getSimplifiedGeometryInternal=function(t){var i=[],n=[];return i.length=function(t,e,i,n,r,o,s,a){for(var h=0,u=i.length;h<u;++h){var l=i[h],c=[];s=Ou(t,e,l,n,r,o,s,c),a.push(c),e=l[l.length-1]}return s}(this.flatCoordinates,0,this.endss_,this.stride,Math.sqrt(t),i,0,n),new e(i,iu.XY,n)},e.prototype.getPolygon=function(t){if(t<0||this.endss_.length<=t)return null;var e;if(0===t)e=0;else{var i=this.endss_[t-1];e=i[i.length-1]}var n=this.endss_[t].slice(),r=n[n.length-1];if(0!==e)for(var o=0,s=n.length;o<s;++o)n[o]-=e;return new Yu(this.flatCoordinates.slice(e,r),this.layout,n)},e.prototype.getPolygons=function(){for(var t=this.layout,e=this.flatCoordinates,i=this.endss_,n=[],r=0,o=0,s=i.length;o<s;++o){var a=i[o].slice(),h=a[a.length-1];if(0!==r)for(var u=0,l=a.length;u<l;++u)a[u]-=r;var c=new Yu(e.slice(r,h),t,a);n.push(c),r=h}return n},e.prototype.getType=function(){return Dr.MULTI_POLYGON},e.prototype.intersectsExtent=function(t){return function(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o){var a=i[o];if(Tu(t,e,a,n,r))return!0;e=a[a.length-1]}return!1}(this.getOrientedFlatCoordinates(),0,this.endss_,this.stride,t)},e.prototype.setCoordinates=function(t,e){this.setLayout(e,t,3),this.flatCoordinates||(this.flatCoordinates=[]);var i=function(t,e,i,n,r){for(var o=r||[],s=0,a=0,h=i.length;a<h;++a){var u=pu(t,e,i[a],n,o[s]);o[s++]=u,e=u[u.length-1]}return o.length=s,o}(this.flatCoordinates,0,t,this.stride,this.endss_);if(0===i.length)this.flatCoordinates.length=0;else{var n=i[i.length-1];this.flatCoordinates.length=0===n.length?0:n[n.length-1]}this.changed()},e}(nu),Hu=function(t){function e(e){var i=e||{};t.call(this),this.dataProjection=fo(i.dataProjection?i.dataProjection:"EPSG:4326"),i.featureProjection&&(this.defaultFeatureProjection=fo(i.featureProjection)),this.geometryName_=i.geometryName,this.extractGeometryName_=i.extractGeometryName}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.readFeatureFromObject=function(t,e){var i=null,n=Ju((i="Feature"===t.type?t:{type:"Feature",geometry:t}).geometry,e),r=new La;return this.geometryName_?r.setGeometryName(this.geometryName_):this.extractGeometryName_&&void 0!==i.geometry_name&&r.setGeometryName(i.geometry_name),r.setGeometry(n),void 0!==i.id&&r.setId(i.id),i.properties&&r.setProperties(i.properties),r},e.prototype.readFeaturesFromObject=function(t,e){var i=null;if("FeatureCollection"===t.type){i=[];for(var n=t.features,r=0,o=n.length;r<o;++r)i.push(this.readFeatureFromObject(n[r],e))}else i=[this.readFeatureFromObject(t,e)];return i},e.prototype.readGeometryFromObject=function(t,e){return Ju(t,e)},e.prototype.readProjectionFromObject=function(t){var e,i=t.crs;return i?"name"==i.type?e=fo(i.properties.name):sn(!1,36):e=this.dataProjection,e},e.prototype.writeFeatureObject=function(t,e){e=this.adaptOptions(e);var i={type:"Feature"},n=t.getId();void 0!==n&&(i.id=n);var r=t.getGeometry();i.geometry=r?Qu(r,e):null;var o=t.getProperties();return delete o[t.getGeometryName()],tr(o)?i.properties=null:i.properties=o,i},e.prototype.writeFeaturesObject=function(t,e){e=this.adaptOptions(e);for(var i=[],n=0,r=t.length;n<r;++n)i.push(this.writeFeatureObject(t[n],e));return{type:"FeatureCollection",features:i}},e.prototype.writeGeometryObject=function(t,e){return Qu(t,this.adaptOptions(e))},e}(Jh),Zu={Point:function(t){return new Fu(t.coordinates)},LineString:function(t){return new Iu(t.coordinates)},Polygon:function(t){return new Yu(t.coordinates)},MultiPoint:function(t){return new Lu(t.coordinates)},MultiLineString:function(t){return new Pu(t.coordinates)},MultiPolygon:function(t){return new Wu(t.coordinates)},GeometryCollection:function(t,e){var i=t.geometries.map(function(t){return Ju(t,e)});return new tu(i)}},qu={Point:function(t,e){return{type:"Point",coordinates:t.getCoordinates()}},LineString:function(t,e){return{type:"LineString",coordinates:t.getCoordinates()}},Polygon:function(t,e){var i;e&&(i=e.rightHanded);return{type:"Polygon",coordinates:t.getCoordinates(i)}},MultiPoint:function(t,e){return{type:"MultiPoint",coordinates:t.getCoordinates()}},MultiLineString:function(t,e){return{type:"MultiLineString",coordinates:t.getCoordinates()}},MultiPolygon:function(t,e){var i;e&&(i=e.rightHanded);return{type:"MultiPolygon",coordinates:t.getCoordinates(i)}},GeometryCollection:function(t,e){return{type:"GeometryCollection",geometries:t.getGeometriesArray().map(function(t){var i=qn({},e);return delete i.featureProjection,Qu(t,i)})}},Circle:function(t){return{type:"GeometryCollection",geometries:[]}}};function Ju(t,e){return t?Zh((0,Zu[t.type])(t),!1,e):null}function Qu(t,e){return(0,qu[t.getType()])(Zh(t,!0,e),e)}var tl=function(t,e,i,n,r){var o,s,a=8*r-n-1,h=(1<<a)-1,u=h>>1,l=-7,c=i?r-1:0,p=i?-1:1,f=t[e+c];for(c+=p,o=f&(1<<-l)-1,f>>=-l,l+=a;l>0;o=256*o+t[e+c],c+=p,l-=8);for(s=o&(1<<-l)-1,o>>=-l,l+=n;l>0;s=256*s+t[e+c],c+=p,l-=8);if(0===o)o=1-u;else{if(o===h)return s?NaN:1/0*(f?-1:1);s+=Math.pow(2,n),o-=u}return(f?-1:1)*s*Math.pow(2,o-n)},el=function(t,e,i,n,r,o){var s,a,h,u=8*o-r-1,l=(1<<u)-1,c=l>>1,p=23===r?Math.pow(2,-24)-Math.pow(2,-77):0,f=n?0:o-1,d=n?1:-1,g=e<0||0===e&&1/e<0?1:0;for(e=Math.abs(e),isNaN(e)||e===1/0?(a=isNaN(e)?1:0,s=l):(s=Math.floor(Math.log(e)/Math.LN2),e*(h=Math.pow(2,-s))<1&&(s--,h*=2),(e+=s+c>=1?p/h:p*Math.pow(2,1-c))*h>=2&&(s++,h/=2),s+c>=l?(a=0,s=l):s+c>=1?(a=(e*h-1)*Math.pow(2,r),s+=c):(a=e*Math.pow(2,c-1)*Math.pow(2,r),s=0));r>=8;t[i+f]=255&a,f+=d,a/=256,r-=8);for(s=s<<r|a,u+=r;u>0;t[i+f]=255&s,f+=d,s/=256,u-=8);t[i+f-d]|=128*g},il=nl;function nl(t){this.buf=ArrayBuffer.isView&&ArrayBuffer.isView(t)?t:new Uint8Array(t||0),this.pos=0,this.type=0,this.length=this.buf.length}nl.Varint=0,nl.Fixed64=1,nl.Bytes=2,nl.Fixed32=5;function rl(t){return t.type===nl.Bytes?t.readVarint()+t.pos:t.pos+1}function ol(t,e,i){return i?4294967296*e+(t>>>0):4294967296*(e>>>0)+(t>>>0)}function sl(t,e,i){var n=e<=16383?1:e<=2097151?2:e<=268435455?3:Math.ceil(Math.log(e)/(7*Math.LN2));i.realloc(n);for(var r=i.pos-1;r>=t;r--)i.buf[r+n]=i.buf[r]}function al(t,e){for(var i=0;i<t.length;i++)e.writeVarint(t[i])}function hl(t,e){for(var i=0;i<t.length;i++)e.writeSVarint(t[i])}function ul(t,e){for(var i=0;i<t.length;i++)e.writeFloat(t[i])}function ll(t,e){for(var i=0;i<t.length;i++)e.writeDouble(t[i])}function cl(t,e){for(var i=0;i<t.length;i++)e.writeBoolean(t[i])}function pl(t,e){for(var i=0;i<t.length;i++)e.writeFixed32(t[i])}function fl(t,e){for(var i=0;i<t.length;i++)e.writeSFixed32(t[i])}function dl(t,e){for(var i=0;i<t.length;i++)e.writeFixed64(t[i])}function gl(t,e){for(var i=0;i<t.length;i++)e.writeSFixed64(t[i])}function yl(t,e){return(t[e]|t[e+1]<<8|t[e+2]<<16)+16777216*t[e+3]}function vl(t,e,i){t[i]=e,t[i+1]=e>>>8,t[i+2]=e>>>16,t[i+3]=e>>>24}function _l(t,e){return(t[e]|t[e+1]<<8|t[e+2]<<16)+(t[e+3]<<24)}nl.prototype={destroy:function(){this.buf=null},readFields:function(t,e,i){for(i=i||this.length;this.pos<i;){var n=this.readVarint(),r=n>>3,o=this.pos;this.type=7&n,t(r,e,this),this.pos===o&&this.skip(n)}return e},readMessage:function(t,e){return this.readFields(t,e,this.readVarint()+this.pos)},readFixed32:function(){var t=yl(this.buf,this.pos);return this.pos+=4,t},readSFixed32:function(){var t=_l(this.buf,this.pos);return this.pos+=4,t},readFixed64:function(){var t=yl(this.buf,this.pos)+4294967296*yl(this.buf,this.pos+4);return this.pos+=8,t},readSFixed64:function(){var t=yl(this.buf,this.pos)+4294967296*_l(this.buf,this.pos+4);return this.pos+=8,t},readFloat:function(){var t=tl(this.buf,this.pos,!0,23,4);return this.pos+=4,t},readDouble:function(){var t=tl(this.buf,this.pos,!0,52,8);return this.pos+=8,t},readVarint:function(t){var e,i,n=this.buf;return e=127&(i=n[this.pos++]),i<128?e:(e|=(127&(i=n[this.pos++]))<<7,i<128?e:(e|=(127&(i=n[this.pos++]))<<14,i<128?e:(e|=(127&(i=n[this.pos++]))<<21,i<128?e:function(t,e,i){var n,r,o=i.buf;if(r=o[i.pos++],n=(112&r)>>4,r<128)return ol(t,n,e);if(r=o[i.pos++],n|=(127&r)<<3,r<128)return ol(t,n,e);if(r=o[i.pos++],n|=(127&r)<<10,r<128)return ol(t,n,e);if(r=o[i.pos++],n|=(127&r)<<17,r<128)return ol(t,n,e);if(r=o[i.pos++],n|=(127&r)<<24,r<128)return ol(t,n,e);if(r=o[i.pos++],n|=(1&r)<<31,r<128)return ol(t,n,e);throw new Error("Expected varint not more than 10 bytes")}(e|=(15&(i=n[this.pos]))<<28,t,this))))},readVarint64:function(){return this.readVarint(!0)},readSVarint:function(){var t=this.readVarint();return t%2==1?(t+1)/-2:t/2},readBoolean:function(){return Boolean(this.readVarint())},readString:function(){var t=this.readVarint()+this.pos,e=function(t,e,i){var n="",r=e;for(;r<i;){var o,s,a,h=t[r],u=null,l=h>239?4:h>223?3:h>191?2:1;if(r+l>i)break;1===l?h<128&&(u=h):2===l?128==(192&(o=t[r+1]))&&(u=(31&h)<<6|63&o)<=127&&(u=null):3===l?(o=t[r+1],s=t[r+2],128==(192&o)&&128==(192&s)&&((u=(15&h)<<12|(63&o)<<6|63&s)<=2047||u>=55296&&u<=57343)&&(u=null)):4===l&&(o=t[r+1],s=t[r+2],a=t[r+3],128==(192&o)&&128==(192&s)&&128==(192&a)&&((u=(15&h)<<18|(63&o)<<12|(63&s)<<6|63&a)<=65535||u>=1114112)&&(u=null)),null===u?(u=65533,l=1):u>65535&&(u-=65536,n+=String.fromCharCode(u>>>10&1023|55296),u=56320|1023&u),n+=String.fromCharCode(u),r+=l}return n}(this.buf,this.pos,t);return this.pos=t,e},readBytes:function(){var t=this.readVarint()+this.pos,e=this.buf.subarray(this.pos,t);return this.pos=t,e},readPackedVarint:function(t,e){var i=rl(this);for(t=t||[];this.pos<i;)t.push(this.readVarint(e));return t},readPackedSVarint:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readSVarint());return t},readPackedBoolean:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readBoolean());return t},readPackedFloat:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readFloat());return t},readPackedDouble:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readDouble());return t},readPackedFixed32:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readFixed32());return t},readPackedSFixed32:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readSFixed32());return t},readPackedFixed64:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readFixed64());return t},readPackedSFixed64:function(t){var e=rl(this);for(t=t||[];this.pos<e;)t.push(this.readSFixed64());return t},skip:function(t){var e=7&t;if(e===nl.Varint)for(;this.buf[this.pos++]>127;);else if(e===nl.Bytes)this.pos=this.readVarint()+this.pos;else if(e===nl.Fixed32)this.pos+=4;else{if(e!==nl.Fixed64)throw new Error("Unimplemented type: "+e);this.pos+=8}},writeTag:function(t,e){this.writeVarint(t<<3|e)},realloc:function(t){for(var e=this.length||16;e<this.pos+t;)e*=2;if(e!==this.length){var i=new Uint8Array(e);i.set(this.buf),this.buf=i,this.length=e}},finish:function(){return this.length=this.pos,this.pos=0,this.buf.subarray(0,this.length)},writeFixed32:function(t){this.realloc(4),vl(this.buf,t,this.pos),this.pos+=4},writeSFixed32:function(t){this.realloc(4),vl(this.buf,t,this.pos),this.pos+=4},writeFixed64:function(t){this.realloc(8),vl(this.buf,-1&t,this.pos),vl(this.buf,Math.floor(t*(1/4294967296)),this.pos+4),this.pos+=8},writeSFixed64:function(t){this.realloc(8),vl(this.buf,-1&t,this.pos),vl(this.buf,Math.floor(t*(1/4294967296)),this.pos+4),this.pos+=8},writeVarint:function(t){(t=+t||0)>268435455||t<0?function(t,e){var i,n;t>=0?(i=t%4294967296|0,n=t/4294967296|0):(n=~(-t/4294967296),4294967295^(i=~(-t%4294967296))?i=i+1|0:(i=0,n=n+1|0));if(t>=0x10000000000000000||t<-0x10000000000000000)throw new Error("Given varint doesn't fit into 10 bytes");e.realloc(10),function(t,e,i){i.buf[i.pos++]=127&t|128,t>>>=7,i.buf[i.pos++]=127&t|128,t>>>=7,i.buf[i.pos++]=127&t|128,t>>>=7,i.buf[i.pos++]=127&t|128,t>>>=7,i.buf[i.pos]=127&t}(i,0,e),function(t,e){var i=(7&t)<<4;if(e.buf[e.pos++]|=i|((t>>>=3)?128:0),!t)return;if(e.buf[e.pos++]=127&t|((t>>>=7)?128:0),!t)return;if(e.buf[e.pos++]=127&t|((t>>>=7)?128:0),!t)return;if(e.buf[e.pos++]=127&t|((t>>>=7)?128:0),!t)return;if(e.buf[e.pos++]=127&t|((t>>>=7)?128:0),!t)return;e.buf[e.pos++]=127&t}(n,e)}(t,this):(this.realloc(4),this.buf[this.pos++]=127&t|(t>127?128:0),t<=127||(this.buf[this.pos++]=127&(t>>>=7)|(t>127?128:0),t<=127||(this.buf[this.pos++]=127&(t>>>=7)|(t>127?128:0),t<=127||(this.buf[this.pos++]=t>>>7&127))))},writeSVarint:function(t){this.writeVarint(t<0?2*-t-1:2*t)},writeBoolean:function(t){this.writeVarint(Boolean(t))},writeString:function(t){t=String(t),this.realloc(4*t.length),this.pos++;var e=this.pos;this.pos=function(t,e,i){for(var n,r,o=0;o<e.length;o++){if((n=e.charCodeAt(o))>55295&&n<57344){if(!r){n>56319||o+1===e.length?(t[i++]=239,t[i++]=191,t[i++]=189):r=n;continue}if(n<56320){t[i++]=239,t[i++]=191,t[i++]=189,r=n;continue}n=r-55296<<10|n-56320|65536,r=null}else r&&(t[i++]=239,t[i++]=191,t[i++]=189,r=null);n<128?t[i++]=n:(n<2048?t[i++]=n>>6|192:(n<65536?t[i++]=n>>12|224:(t[i++]=n>>18|240,t[i++]=n>>12&63|128),t[i++]=n>>6&63|128),t[i++]=63&n|128)}return i}(this.buf,t,this.pos);var i=this.pos-e;i>=128&&sl(e,i,this),this.pos=e-1,this.writeVarint(i),this.pos+=i},writeFloat:function(t){this.realloc(4),el(this.buf,t,this.pos,!0,23,4),this.pos+=4},writeDouble:function(t){this.realloc(8),el(this.buf,t,this.pos,!0,52,8),this.pos+=8},writeBytes:function(t){var e=t.length;this.writeVarint(e),this.realloc(e);for(var i=0;i<e;i++)this.buf[this.pos++]=t[i]},writeRawMessage:function(t,e){this.pos++;var i=this.pos;t(e,this);var n=this.pos-i;n>=128&&sl(i,n,this),this.pos=i-1,this.writeVarint(n),this.pos+=n},writeMessage:function(t,e,i){this.writeTag(t,nl.Bytes),this.writeRawMessage(e,i)},writePackedVarint:function(t,e){this.writeMessage(t,al,e)},writePackedSVarint:function(t,e){this.writeMessage(t,hl,e)},writePackedBoolean:function(t,e){this.writeMessage(t,cl,e)},writePackedFloat:function(t,e){this.writeMessage(t,ul,e)},writePackedDouble:function(t,e){this.writeMessage(t,ll,e)},writePackedFixed32:function(t,e){this.writeMessage(t,pl,e)},writePackedSFixed32:function(t,e){this.writeMessage(t,fl,e)},writePackedFixed64:function(t,e){this.writeMessage(t,dl,e)},writePackedSFixed64:function(t,e){this.writeMessage(t,gl,e)},writeBytesField:function(t,e){this.writeTag(t,nl.Bytes),this.writeBytes(e)},writeFixed32Field:function(t,e){this.writeTag(t,nl.Fixed32),this.writeFixed32(e)},writeSFixed32Field:function(t,e){this.writeTag(t,nl.Fixed32),this.writeSFixed32(e)},writeFixed64Field:function(t,e){this.writeTag(t,nl.Fixed64),this.writeFixed64(e)},writeSFixed64Field:function(t,e){this.writeTag(t,nl.Fixed64),this.writeSFixed64(e)},writeVarintField:function(t,e){this.writeTag(t,nl.Varint),this.writeVarint(e)},writeSVarintField:function(t,e){this.writeTag(t,nl.Varint),this.writeSVarint(e)},writeStringField:function(t,e){this.writeTag(t,nl.Bytes),this.writeString(e)},writeFloatField:function(t,e){this.writeTag(t,nl.Fixed32),this.writeFloat(e)},writeDoubleField:function(t,e){this.writeTag(t,nl.Fixed64),this.writeDouble(e)},writeBooleanField:function(t,e){this.writeVarintField(t,Boolean(e))}};var ml=[1,0,0,1,0,0],El=function(t,e,i,n,r){this.extent_,this.id_=r,this.type_=t,this.flatCoordinates_=e,this.flatInteriorPoints_=null,this.flatMidpoints_=null,this.ends_=i,this.properties_=n};El.prototype.get=function(t){return this.properties_[t]},El.prototype.getExtent=function(){return this.extent_||(this.extent_=this.type_===Dr.POINT?En(this.flatCoordinates_):Sn(this.flatCoordinates_,0,this.flatCoordinates_.length,2)),this.extent_},El.prototype.getFlatInteriorPoint=function(){if(!this.flatInteriorPoints_){var t=Ln(this.getExtent());this.flatInteriorPoints_=ku(this.flatCoordinates_,0,this.ends_,2,t,0)}return this.flatInteriorPoints_},El.prototype.getFlatInteriorPoints=function(){if(!this.flatInteriorPoints_){var t=Ku(this.flatCoordinates_,0,this.ends_,2);this.flatInteriorPoints_=ju(this.flatCoordinates_,0,this.ends_,2,t)}return this.flatInteriorPoints_},El.prototype.getFlatMidpoint=function(){return this.flatMidpoints_||(this.flatMidpoints_=yu(this.flatCoordinates_,0,this.flatCoordinates_.length,2,.5)),this.flatMidpoints_},El.prototype.getFlatMidpoints=function(){if(!this.flatMidpoints_){this.flatMidpoints_=[];for(var t=this.flatCoordinates_,e=0,i=this.ends_,n=0,r=i.length;n<r;++n){var o=i[n],s=yu(t,e,o,2,.5);Ho(this.flatMidpoints_,s),e=o}}return this.flatMidpoints_},El.prototype.getId=function(){return this.id_},El.prototype.getOrientedFlatCoordinates=function(){return this.flatCoordinates_},El.prototype.getGeometry=function(){return this},El.prototype.getProperties=function(){return this.properties_},El.prototype.getStride=function(){return 2},El.prototype.getType=function(){return this.type_},El.prototype.transform=function(t,e){var i=(t=fo(t)).getExtent(),n=t.getWorldExtent(),r=An(n)/An(i);Os(ml,n[0],n[3],r,-r,0,0,0),Es(this.flatCoordinates_,0,this.flatCoordinates_.length,2,ml,this.flatCoordinates_)},El.prototype.getEnds=El.prototype.getEndss=function(){return this.ends_},El.prototype.getFlatCoordinates=El.prototype.getOrientedFlatCoordinates,El.prototype.getSimplifiedGeometry=El.prototype.getGeometry,El.prototype.getStyleFunction=xr;var xl=function(t){function e(e){t.call(this);var i=e||{};this.dataProjection=new Ur({code:"",units:Yr.TILE_PIXELS}),this.featureClass_=i.featureClass?i.featureClass:El,this.geometryName_=i.geometryName,this.layerName_=i.layerName?i.layerName:"layer",this.layers_=i.layers?i.layers:null,this.extent_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.readRawGeometry_=function(t,e,i,n){t.pos=e.geometry;for(var r=t.readVarint()+t.pos,o=1,s=0,a=0,h=0,u=0,l=0;t.pos<r;){if(!s){var c=t.readVarint();o=7&c,s=c>>3}s--,1===o||2===o?(a+=t.readSVarint(),h+=t.readSVarint(),1===o&&u>l&&(n.push(u),l=u),i.push(a,h),u+=2):7===o?u>l&&(i.push(i[l],i[l+1]),u+=2):sn(!1,59)}u>l&&(n.push(u),l=u)},e.prototype.createFeature_=function(t,e,i){var n,r=e.type;if(0===r)return null;var o=e.id,s=e.properties;s[this.layerName_]=e.layer.name;var a=[],h=[];this.readRawGeometry_(t,e,a,h);var u=function(t,e){var i;1===t?i=1===e?Dr.POINT:Dr.MULTI_POINT:2===t?i=1===e?Dr.LINE_STRING:Dr.MULTI_LINE_STRING:3===t&&(i=Dr.POLYGON);return i}(r,h.length);if(this.featureClass_===El)n=new this.featureClass_(u,a,h,s,o);else{var l;if(u==Dr.POLYGON){for(var c=[],p=0,f=0,d=0,g=h.length;d<g;++d){var y=h[d];Du(a,p,y,2)||(c.push(h.slice(f,d)),f=d),p=y}l=c.length>1?new Wu(a,iu.XY,c):new Yu(a,iu.XY,h)}else l=u===Dr.POINT?new Fu(a,iu.XY):u===Dr.LINE_STRING?new Iu(a,iu.XY):u===Dr.POLYGON?new Yu(a,iu.XY,h):u===Dr.MULTI_POINT?new Lu(a,iu.XY):u===Dr.MULTI_LINE_STRING?new Pu(a,iu.XY,h):null;n=new this.featureClass_,this.geometryName_&&n.setGeometryName(this.geometryName_);var v=Zh(l,!1,this.adaptOptions(i));n.setGeometry(v),n.setId(o),n.setProperties(s)}return n},e.prototype.getLastExtent=function(){return this.extent_},e.prototype.getType=function(){return qh.ARRAY_BUFFER},e.prototype.readFeatures=function(t,e){var i=this.layers_,n=new il(t),r=n.readFields(Sl,{}),o=[];for(var s in r)if(!i||-1!=i.indexOf(s)){for(var a=r[s],h=0,u=a.length;h<u;++h){var l=bl(n,a,h);o.push(this.createFeature_(n,l))}this.extent_=a?[0,0,a.extent,a.extent]:null}return o},e.prototype.readProjection=function(t){return this.dataProjection},e.prototype.setLayers=function(t){this.layers_=t},e}(Hh);function Sl(t,e,i){if(3===t){var n={keys:[],values:[],features:[]},r=i.readVarint()+i.pos;i.readFields(Tl,n,r),n.length=n.features.length,n.length&&(e[n.name]=n)}}function Tl(t,e,i){if(15===t)e.version=i.readVarint();else if(1===t)e.name=i.readString();else if(5===t)e.extent=i.readVarint();else if(2===t)e.features.push(i.pos);else if(3===t)e.keys.push(i.readString());else if(4===t){for(var n=null,r=i.readVarint()+i.pos;i.pos<r;)n=1===(t=i.readVarint()>>3)?i.readString():2===t?i.readFloat():3===t?i.readDouble():4===t?i.readVarint64():5===t?i.readVarint():6===t?i.readSVarint():7===t?i.readBoolean():null;e.values.push(n)}}function wl(t,e,i){if(1==t)e.id=i.readVarint();else if(2==t)for(var n=i.readVarint()+i.pos;i.pos<n;){var r=e.layer.keys[i.readVarint()],o=e.layer.values[i.readVarint()];e.properties[r]=o}else 3==t?e.type=i.readVarint():4==t&&(e.geometry=i.pos)}function bl(t,e,i){t.pos=e.features[i];var n=t.readVarint()+t.pos,r={layer:e,type:0,properties:{}};return t.readFields(wl,r,n),r}var Cl=function(t){function e(e){t.call(this);var i=e||{};this.layerName_=i.layerName,this.layers_=i.layers?i.layers:null,this.dataProjection=fo(i.dataProjection?i.dataProjection:"EPSG:4326")}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.readFeaturesFromObject=function(t,e){if("Topology"==t.type){var i,n=t,r=null,o=null;n.transform&&(r=(i=n.transform).scale,o=i.translate);var s=n.arcs;i&&function(t,e,i){for(var n=0,r=t.length;n<r;++n)Fl(t[n],e,i)}(s,r,o);var a,h=[],u=n.objects,l=this.layerName_;for(var c in u)this.layers_&&-1==this.layers_.indexOf(c)||("GeometryCollection"===u[c].type?(a=u[c],h.push.apply(h,Il(a,s,r,o,l,c,e))):(a=u[c],h.push(Pl(a,s,r,o,l,c,e))));return h}return[]},e.prototype.readProjectionFromObject=function(t){return this.dataProjection},e}(Jh),Rl={Point:function(t,e,i){var n=t.coordinates;e&&i&&Ll(n,e,i);return new Fu(n)},LineString:function(t,e){var i=Ol(t.arcs,e);return new Iu(i)},Polygon:function(t,e){for(var i=[],n=0,r=t.arcs.length;n<r;++n)i[n]=Ol(t.arcs[n],e);return new Yu(i)},MultiPoint:function(t,e,i){var n=t.coordinates;if(e&&i)for(var r=0,o=n.length;r<o;++r)Ll(n[r],e,i);return new Lu(n)},MultiLineString:function(t,e){for(var i=[],n=0,r=t.arcs.length;n<r;++n)i[n]=Ol(t.arcs[n],e);return new Pu(i)},MultiPolygon:function(t,e){for(var i=[],n=0,r=t.arcs.length;n<r;++n){for(var o=t.arcs[n],s=[],a=0,h=o.length;a<h;++a)s[a]=Ol(o[a],e);i[n]=s}return new Wu(i)}};function Ol(t,e){for(var i,n,r=[],o=0,s=t.length;o<s;++o)i=t[o],o>0&&r.pop(),n=i>=0?e[i]:e[~i].slice().reverse(),r.push.apply(r,n);for(var a=0,h=r.length;a<h;++a)r[a]=r[a].slice();return r}function Il(t,e,i,n,r,o,s){for(var a=t.geometries,h=[],u=0,l=a.length;u<l;++u)h[u]=Pl(a[u],e,i,n,r,o,s);return h}function Pl(t,e,i,n,r,o,s){var a,h=t.type,u=Rl[h];a="Point"===h||"MultiPoint"===h?u(t,i,n):u(t,e);var l=new La;l.setGeometry(Zh(a,!1,s)),void 0!==t.id&&l.setId(t.id);var c=t.properties;return r&&(c||(c={}),c[r]=o),c&&l.setProperties(c),l}function Fl(t,e,i){for(var n=0,r=0,o=0,s=t.length;o<s;++o){var a=t[o];n+=a[0],r+=a[1],a[0]=n,a[1]=r,Ll(a,e,i)}}function Ll(t,e,i){t[0]=t[0]*e[0]+i[0],t[1]=t[1]*e[1]+i[1]}function Ml(t,e,i){if(!jl(i=i||{}))throw new Error("options is invalid");var n=i.bbox,r=i.id;if(void 0===t)throw new Error("geometry is required");if(e&&e.constructor!==Object)throw new Error("properties must be an Object");n&&Gl(n),r&&Dl(r);var o={type:"Feature"};return r&&(o.id=r),n&&(o.bbox=n),o.properties=e||{},o.geometry=t,o}function Al(t,e,i){if(!t)throw new Error("coordinates is required");if(!Array.isArray(t))throw new Error("coordinates must be an Array");if(t.length<2)throw new Error("coordinates must be at least 2 numbers long");if(!kl(t[0])||!kl(t[1]))throw new Error("coordinates must contain numbers");return Ml({type:"Point",coordinates:t},e,i)}function Nl(t,e){if(!jl(e=e||{}))throw new Error("options is invalid");var i=e.bbox,n=e.id;if(!t)throw new Error("No features passed");if(!Array.isArray(t))throw new Error("features must be an Array");i&&Gl(i),n&&Dl(n);var r={type:"FeatureCollection"};return n&&(r.id=n),i&&(r.bbox=i),r.features=t,r}function kl(t){return!isNaN(t)&&null!==t&&!Array.isArray(t)}function jl(t){return!!t&&t.constructor===Object}function Gl(t){if(!t)throw new Error("bbox is required");if(!Array.isArray(t))throw new Error("bbox must be an Array");if(4!==t.length&&6!==t.length)throw new Error("bbox must be an Array of 4 or 6 numbers");t.forEach(function(t){if(!kl(t))throw new Error("bbox must only contain numbers")})}function Dl(t){if(!t)throw new Error("id is required");if(-1===["string","number"].indexOf(typeof t))throw new Error("id must be a number or a string")}function $l(t,e,i){if(null!==t)for(var n,r,o,s,a,h,u,l,c=0,p=0,f=t.type,d="FeatureCollection"===f,g="Feature"===f,y=d?t.features.length:1,v=0;v<y;v++){a=(l=!!(u=d?t.features[v].geometry:g?t.geometry:t)&&"GeometryCollection"===u.type)?u.geometries.length:1;for(var _=0;_<a;_++){var m=0,E=0;if(null!==(s=l?u.geometries[_]:u)){h=s.coordinates;var x=s.type;switch(c=!i||"Polygon"!==x&&"MultiPolygon"!==x?0:1,x){case null:break;case"Point":if(!1===e(h,p,v,m,E))return!1;p++,m++;break;case"LineString":case"MultiPoint":for(n=0;n<h.length;n++){if(!1===e(h[n],p,v,m,E))return!1;p++,"MultiPoint"===x&&m++}"LineString"===x&&m++;break;case"Polygon":case"MultiLineString":for(n=0;n<h.length;n++){for(r=0;r<h[n].length-c;r++){if(!1===e(h[n][r],p,v,m,E))return!1;p++}"MultiLineString"===x&&m++,"Polygon"===x&&E++}"Polygon"===x&&m++;break;case"MultiPolygon":for(n=0;n<h.length;n++){for("MultiPolygon"===x&&(E=0),r=0;r<h[n].length;r++){for(o=0;o<h[n][r].length-c;o++){if(!1===e(h[n][r][o],p,v,m,E))return!1;p++}E++}m++}break;case"GeometryCollection":for(n=0;n<s.geometries.length;n++)if(!1===$l(s.geometries[n],e,i))return!1;break;default:throw new Error("Unknown Geometry Type")}}}}}function Vl(t){var e=[];return"FeatureCollection"===t.type?function(t,e){if("Feature"===t.type)e(t,0);else if("FeatureCollection"===t.type)for(var i=0;i<t.features.length&&!1!==e(t.features[i],i);i++);}(t,function(t){$l(t,function(i){e.push(Al(i,t.properties))})}):$l(t,function(i){e.push(Al(i,t.properties))}),Nl(e)}function zl(t,e){if(!jl(e=e||{}))throw new Error("options is invalid");var i=e.properties;if(!t)throw new Error("geojson is required");var n=function(t){var e=[1/0,1/0,-1/0,-1/0];return $l(t,function(t){e[0]>t[0]&&(e[0]=t[0]),e[1]>t[1]&&(e[1]=t[1]),e[2]<t[0]&&(e[2]=t[0]),e[3]<t[1]&&(e[3]=t[1])}),e}(t);return Al([(n[0]+n[2])/2,(n[1]+n[3])/2],i)}function Yl(t){if(!t)throw new Error("geojson is required");switch(t.type){case"Feature":return Xl(t);case"FeatureCollection":return function(t){var e={type:"FeatureCollection"};return Object.keys(t).forEach(function(i){switch(i){case"type":case"features":return;default:e[i]=t[i]}}),e.features=t.features.map(function(t){return Xl(t)}),e}(t);case"Point":case"LineString":case"Polygon":case"MultiPoint":case"MultiLineString":case"MultiPolygon":case"GeometryCollection":return Ul(t);default:throw new Error("unknown GeoJSON type")}}function Xl(t){var e={type:"Feature"};return Object.keys(t).forEach(function(i){switch(i){case"type":case"properties":case"geometry":return;default:e[i]=t[i]}}),e.properties=function t(e){var i={};if(!e)return i;Object.keys(e).forEach(function(n){var r=e[n];"object"==typeof r?null===r?i[n]=null:r.length?i[n]=r.map(function(t){return t}):i[n]=t(r):i[n]=r});return i}(t.properties),e.geometry=Ul(t.geometry),e}function Ul(t){var e={type:t.type};return t.bbox&&(e.bbox=t.bbox),"GeometryCollection"===t.type?(e.geometries=t.geometries.map(function(t){return Ul(t)}),e):(e.coordinates=function t(e){if("object"!=typeof e[0])return e.slice();return e.map(function(e){return t(e)})}(t.coordinates),e)}var Bl={meters:6371008.8,metres:6371008.8,millimeters:6371008800,millimetres:6371008800,centimeters:637100880,centimetres:637100880,kilometers:6371.0088,kilometres:6371.0088,miles:3958.761333810546,nauticalmiles:6371008.8/1852,inches:6371008.8*39.37,yards:6371008.8/1.0936,feet:20902260.511392,radians:1,degrees:6371008.8/111325};function Kl(t){if(null===t||void 0===t)throw new Error("degrees is required");return t%360*Math.PI/180}function Wl(t){if(!t)throw new Error("coord is required");if("Feature"===t.type&&null!==t.geometry&&"Point"===t.geometry.type)return t.geometry.coordinates;if("Point"===t.type)return t.coordinates;if(Array.isArray(t)&&t.length>=2&&void 0===t[0].length&&void 0===t[1].length)return t;throw new Error("coord must be GeoJSON Point or an Array of numbers")}function Hl(t,e,i){if(!(n=i=i||{})||n.constructor!==Object)throw new Error("options is invalid");var n,r=i.units,o=Wl(t),s=Wl(e),a=Kl(s[1]-o[1]),h=Kl(s[0]-o[0]),u=Kl(o[1]),l=Kl(s[1]),c=Math.pow(Math.sin(a/2),2)+Math.pow(Math.sin(h/2),2)*Math.cos(u)*Math.cos(l);return function(t,e){if(void 0===t||null===t)throw new Error("radians is required");if(e&&"string"!=typeof e)throw new Error("units must be a string");var i=Bl[e||"kilometers"];if(!i)throw new Error(e+" units is invalid");return t*i}(2*Math.atan2(Math.sqrt(c),Math.sqrt(1-c)),r)}function Zl(t,e,i){if("object"!=typeof(i=i||{}))throw new Error("options is invalid");var n=i.ignoreBoundary;if(!t)throw new Error("point is required");if(!e)throw new Error("polygon is required");var r=function(t){if(!t)throw new Error("coord is required");if("Feature"===t.type&&null!==t.geometry&&"Point"===t.geometry.type)return t.geometry.coordinates;if("Point"===t.type)return t.coordinates;if(Array.isArray(t)&&t.length>=2&&void 0===t[0].length&&void 0===t[1].length)return t;throw new Error("coord must be GeoJSON Point or an Array of numbers")}(t),o=function(t){if(!t)throw new Error("coords is required");if("Feature"===t.type&&null!==t.geometry)return t.geometry.coordinates;if(t.coordinates)return t.coordinates;if(Array.isArray(t))return t;throw new Error("coords must be GeoJSON Feature, Geometry Object or an Array")}(e),s=e.geometry?e.geometry.type:e.type,a=e.bbox;if(a&&!1===function(t,e){return e[0]<=t[0]&&e[1]<=t[1]&&e[2]>=t[0]&&e[3]>=t[1]}(r,a))return!1;"Polygon"===s&&(o=[o]);for(var h=0,u=!1;h<o.length&&!u;h++)if(ql(r,o[h][0],n)){for(var l=!1,c=1;c<o[h].length&&!l;)ql(r,o[h][c],!n)&&(l=!0),c++;l||(u=!0)}return u}function ql(t,e,i){var n=!1;e[0][0]===e[e.length-1][0]&&e[0][1]===e[e.length-1][1]&&(e=e.slice(0,e.length-1));for(var r=0,o=e.length-1;r<e.length;o=r++){var s=e[r][0],a=e[r][1],h=e[o][0],u=e[o][1];if(t[1]*(s-h)+a*(h-t[0])+u*(t[0]-s)==0&&(s-t[0])*(h-t[0])<=0&&(a-t[1])*(u-t[1])<=0)return!i;a>t[1]!=u>t[1]&&t[0]<(h-s)*(t[1]-a)/(u-a)+s&&(n=!n)}return n}function Jl(t){for(var e=function(t){if("FeatureCollection"!==t.type)return"Feature"!==t.type?Nl([Ml(t)]):Nl([t]);return t}(t),i=zl(e),n=!1,r=0;!n&&r<e.features.length;){var o,s=e.features[r].geometry,a=!1;if("Point"===s.type)i.geometry.coordinates[0]===s.coordinates[0]&&i.geometry.coordinates[1]===s.coordinates[1]&&(n=!0);else if("MultiPoint"===s.type){var h=!1;for(o=0;!h&&o<s.coordinates.length;)i.geometry.coordinates[0]===s.coordinates[o][0]&&i.geometry.coordinates[1]===s.coordinates[o][1]&&(n=!0,h=!0),o++}else if("LineString"===s.type)for(o=0;!a&&o<s.coordinates.length-1;)Ql(i.geometry.coordinates[0],i.geometry.coordinates[1],s.coordinates[o][0],s.coordinates[o][1],s.coordinates[o+1][0],s.coordinates[o+1][1])&&(a=!0,n=!0),o++;else if("MultiLineString"===s.type)for(var u=0;u<s.coordinates.length;){a=!1,o=0;for(var l=s.coordinates[u];!a&&o<l.length-1;)Ql(i.geometry.coordinates[0],i.geometry.coordinates[1],l[o][0],l[o][1],l[o+1][0],l[o+1][1])&&(a=!0,n=!0),o++;u++}else"Polygon"!==s.type&&"MultiPolygon"!==s.type||Zl(i,s)&&(n=!0);r++}if(n)return i;var c=Nl([]);for(r=0;r<e.features.length;r++)c.features=c.features.concat(Vl(e.features[r]).features);return Al(function(t,e){if(!t)throw new Error("targetPoint is required");if(!e)throw new Error("points is required");var i,n=1/0;return function(t,e){if("Feature"===t.type)e(t,0);else if("FeatureCollection"===t.type)for(var i=0;i<t.features.length&&!1!==e(t.features[i],i);i++);}(e,function(e,r){var o=Hl(t,e);o<n&&((i=Yl(e)).properties.featureIndex=r,i.properties.distanceToPoint=o,n=o)}),i}(i,c).geometry.coordinates)}function Ql(t,e,i,n,r,o){return Math.sqrt((r-i)*(r-i)+(o-n)*(o-n))===Math.sqrt((t-i)*(t-i)+(e-n)*(e-n))+Math.sqrt((r-t)*(r-t)+(o-e)*(o-e))}var tc=function(t){function e(e,i,n){if(t.call(this),void 0!==n&&void 0===i)this.setFlatCoordinates(n,e);else{var r=i||0;this.setCenterAndRadius(e,r,n)}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){return new e(this.flatCoordinates.slice(),void 0,this.layout)},e.prototype.closestPointXY=function(t,e,i,n){var r=this.flatCoordinates,o=t-r[0],s=e-r[1],a=o*o+s*s;if(a<n){if(0===a)for(var h=0;h<this.stride;++h)i[h]=r[h];else{var u=this.getRadius()/Math.sqrt(a);i[0]=r[0]+u*o,i[1]=r[1]+u*s;for(var l=2;l<this.stride;++l)i[l]=r[l]}return i.length=this.stride,a}return n},e.prototype.containsXY=function(t,e){var i=this.flatCoordinates,n=t-i[0],r=e-i[1];return n*n+r*r<=this.getRadiusSquared_()},e.prototype.getCenter=function(){return this.flatCoordinates.slice(0,this.stride)},e.prototype.computeExtent=function(t){var e=this.flatCoordinates,i=e[this.stride]-e[0];return _n(e[0]-i,e[1]-i,e[0]+i,e[1]+i,t)},e.prototype.getRadius=function(){return Math.sqrt(this.getRadiusSquared_())},e.prototype.getRadiusSquared_=function(){var t=this.flatCoordinates[this.stride]-this.flatCoordinates[0],e=this.flatCoordinates[this.stride+1]-this.flatCoordinates[1];return t*t+e*e},e.prototype.getType=function(){return Dr.CIRCLE},e.prototype.intersectsExtent=function(t){if(Dn(t,this.getExtent())){var e=this.getCenter();return t[0]<=e[0]&&t[2]>=e[0]||(t[1]<=e[1]&&t[3]>=e[1]||On(t,this.intersectsCoordinate,this))}return!1},e.prototype.setCenter=function(t){var e=this.stride,i=this.flatCoordinates[e]-this.flatCoordinates[0],n=t.slice();n[e]=n[0]+i;for(var r=1;r<e;++r)n[e+r]=t[r];this.setFlatCoordinates(this.layout,n),this.changed()},e.prototype.setCenterAndRadius=function(t,e,i){this.setLayout(i,t,0),this.flatCoordinates||(this.flatCoordinates=[]);var n=this.flatCoordinates,r=lu(n,0,t,this.stride);n[r++]=n[0]+e;for(var o=1,s=this.stride;o<s;++o)n[r++]=n[o];n.length=r,this.changed()},e.prototype.getCoordinates=function(){},e.prototype.setCoordinates=function(t,e){},e.prototype.setRadius=function(t){this.flatCoordinates[this.stride]=this.flatCoordinates[0]+t,this.changed()},e}(nu);function ec(t,e){var i=ti(t)?t:[t,e];return new Fu(i)}function ic(t){return new Iu(t)}function nc(t){return new Yu(t)}function rc(t){return new Lu(t)}function oc(t){return new Pu(t)}function sc(t){return new Wu(t)}function ac(t){return new tu(t)}function hc(t,e){return Xu(t,e)}function uc(t){return[Vh.MULTI_POINT,Vh.MULTI_LINE_STRING,Vh.MULTI_POLYGON,Vh.GEOMETRY_COLLECTION].includes(t.type||t.getType())}function lc(t){t instanceof tc&&(t=ec(t.getCenter()));var e=t.type||t.getType();return!1===[Vh.GEOMETRY_COLLECTION].includes(e)?t:(t.geometries||t.getGeometries())[0]}function cc(t){var e=lc(t),i=Jl({type:e.type||e.getType(),coordinates:e.coordinates||e.getCoordinates()});if(i&&i.geometry)return i.geometry.coordinates}tc.prototype.transform;var pc,fc=Object.freeze({createPointGeom:ec,createLineGeom:ic,createPolygonGeom:nc,createMultiPointGeom:rc,createMultiLineGeom:oc,createMultiPolygonGeom:sc,createGeomCollection:ac,createCircularPolygon:hc,isMultiGeom:uc,toSimpleGeom:lc,findPointOnSurface:cc});function dc(t){return function(t,e){var i=xo(t,void 0!==e?e:"EPSG:3857","EPSG:4326"),n=i[0];return(n<-180||n>180)&&(i[0]=Hn(n+180,360)-180),i}(t,arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh)}function gc(t){return function(t,e){return xo(t,"EPSG:4326",void 0!==e?e:"EPSG:3857")}(t,arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh)}function yc(t,e,i){return xo(t,e,i)}function vc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return dc(t,e)})}function _c(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return gc(t,e)})}function mc(t,e,i){return t.map(function(t){return xo(t,e,i)})}function Ec(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return vc(t,e)})}function xc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return _c(t,e)})}function Sc(t,e,i){return t.map(function(t){return mc(t,e,i)})}function Tc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return dc(t,e)})}function wc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return gc(t,e)})}function bc(t,e,i){return t.map(function(t){return yc(t,e,i)})}function Cc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return vc(t,e)})}function Rc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return _c(t,e)})}function Oc(t,e,i){return t.map(function(t){return mc(t,e,i)})}function Ic(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return Ec(t,e)})}function Pc(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return t.map(function(t){return xc(t,e)})}function Fc(t,e,i){return t.map(function(t){return Sc(t,e,i)})}var Lc=(he(pc={},Vh.POINT,{toLonLat:dc,fromLonLat:gc,transform:yc}),he(pc,Vh.LINE_STRING,{toLonLat:vc,fromLonLat:_c,transform:mc}),he(pc,Vh.POLYGON,{toLonLat:Ec,fromLonLat:xc,transform:Sc}),he(pc,Vh.MULTI_POINT,{toLonLat:Tc,fromLonLat:wc,transform:bc}),he(pc,Vh.MULTI_LINE_STRING,{toLonLat:Cc,fromLonLat:Rc,transform:Oc}),he(pc,Vh.MULTI_POLYGON,{toLonLat:Ic,fromLonLat:Pc,transform:Fc}),pc);function Mc(t){return t&&Ni(t.getArray)}function Ac(t){return t&&Ni(t.getAttributions)&&Ni(t.getFeatureById)}function Nc(t){return Ni(t.getCenter)&&Ni(t.getRadius)}function kc(t){return new Gc(t)}function jc(t){return new xl(t)}var Gc=function(t){function e(){return $a(this,e),za(this,Ba(e).apply(this,arguments))}return Za(e,Hu),Bh(e,[{key:"writeGeometryObject",value:function(t,i){return Nc(t)&&(t=hc(yc(t.getCenter(),i.featureProjection||this.defaultFeatureProjection,kh),t.getRadius()),i.featureProjection=kh),Wh(Ba(e.prototype),"writeGeometryObject",this).call(this,t,i)}},{key:"writeFeatureObject",value:function(t,e){var i={type:"Feature"},n=t.getId();void 0!==n&&(i.id=n);var r=t.getGeometry();i.geometry=r?this.writeGeometryObject(r,e):null;var o=t.getProperties();return delete o[t.getGeometryName()],Yi(o)?i.properties=null:i.properties=o,i}}]),e}(),Dc=kc();function $c(t,e,i){var n,r,o=Dc.writeFeatureObject(t,{featureProjection:e,dataProjection:i});return ti((n=o,Di(new Function("object","try { return object.".concat("properties.features"," } catch (e) {}"))(n),r)))&&(o.properties.features=o.properties.features.map(function(t){return t instanceof La?$c(t,e,i):t})),o}function Vc(t,e,i){var n=Dc.readFeature(t,{featureProjection:e,dataProjection:i});return ti(n.get("features"))&&n.set("features",n.get("features").map(function(t){return Gi(t)?Vc(t,e,i):t})),n}function zc(t,e,i){return Dc.writeGeometryObject(t,{featureProjection:e,dataProjection:i})}function Yc(t,e,i){return i=Xc(t,i),Dc.readGeometry(t,{featureProjection:e,dataProjection:i})}function Xc(t,e){return Dc.readProjection(t)||e}var Uc={computed:{viewProjection:function(){return this.rev&&this.$view?this.$view.getProjection().getCode():jh},resolvedDataProjection:function(){return Di(this.dataProjection,this.projection,this.$map&&this.$map.get("dataProjection"),this.$options.dataProjection,this.viewProjection)}},methods:{pointToViewProj:function(t){return yc(t,this.resolvedDataProjection,this.viewProjection)},pointToDataProj:function(t){return yc(t,this.viewProjection,this.resolvedDataProjection)},lineToViewProj:function(t){return mc(t,this.resolvedDataProjection,this.viewProjection)},lineToDataProj:function(t){return mc(t,this.viewProjection,this.resolvedDataProjection)},polygonToViewProj:function(t){return Sc(t,this.resolvedDataProjection,this.viewProjection)},polygonToDataProj:function(t){return Sc(t,this.viewProjection,this.resolvedDataProjection)},multiPointToViewProj:function(t){return bc(t,this.resolvedDataProjection,this.viewProjection)},multiPointToDataProj:function(t){return bc(t,this.viewProjection,this.resolvedDataProjection)},multiLineToViewProj:function(t){return Oc(t,this.resolvedDataProjection,this.viewProjection)},multiLineToDataProj:function(t){return Oc(t,this.viewProjection,this.resolvedDataProjection)},multiPolygonToViewProj:function(t){return Fc(t,this.resolvedDataProjection,this.viewProjection)},multiPolygonToDataProj:function(t){return Fc(t,this.viewProjection,this.resolvedDataProjection)},extentToViewProj:function(t){return So(t,this.resolvedDataProjection,this.viewProjection)},extentToDataProj:function(t){return So(t,this.viewProjection,this.resolvedDataProjection)},writeGeometryInDataProj:function(t){return zc(t,this.viewProjection,this.resolvedDataProjection)},writeGeometryInViewProj:function(t){return zc(t)},readGeometryInDataProj:function(t){return Yc(t,this.viewProjection,this.resolvedDataProjection)},writeFeatureInDataProj:function(t){return $c(t,this.viewProjection,this.resolvedDataProjection)},writeFeatureInViewProj:function(t){return $c(t)},readFeatureInDataProj:function(t){return Vc(t,this.viewProjection,this.resolvedDataProjection)}}},Bc={mixins:[Uc],methods:{getFeaturesTarget:function(){throw new Error("Not implemented method")},addFeatures:function(t){t.forEach(this.addFeature.bind(this))},addFeature:function(e){e instanceof t?e=e.$feature:Gi(e)&&(e=this.readFeatureInDataProj(e)),Ch(e,La),this.prepareFeature(e),this.getFeaturesTarget().has(e)||this.getFeaturesTarget().add(e)},removeFeatures:function(t){t.forEach(this.removeFeature.bind(this))},removeFeature:function(e){e instanceof t?e=e.$feature:Gi(e)&&(e=this.getFeatureById(e.id)),e&&this.getFeaturesTarget().has(e)&&this.getFeaturesTarget().remove(e)},clearFeatures:function(){this.getFeaturesTarget().clear()},getFeatureById:function(t){return this.getFeaturesTarget().findByKey(t)},getFeatures:function(){return this.getFeaturesTarget().elements},getServices:function(){var t=this;return{get featuresContainer(){return t}}},prepareFeature:function(t){return Ga(t)}}};M(M.S+M.F*!d,"Object",{defineProperties:dt});var Kc=w.Object,Wc=function(t,e){return Kc.defineProperties(t,e)},Hc=function(t,e){return(Hc=Object.setPrototypeOf||{__proto__:[]}instanceof Array&&function(t,e){t.__proto__=e}||function(t,e){for(var i in e)e.hasOwnProperty(i)&&(t[i]=e[i])})(t,e)};function Zc(t,e){function i(){this.constructor=t}Hc(t,e),t.prototype=null===e?Object.create(e):(i.prototype=e.prototype,new i)}function qc(t){return"function"==typeof t}var Jc=!1,Qc={Promise:void 0,set useDeprecatedSynchronousErrorHandling(t){t&&(new Error).stack;Jc=t},get useDeprecatedSynchronousErrorHandling(){return Jc}};function tp(t){setTimeout(function(){throw t})}var ep={closed:!0,next:function(t){},error:function(t){if(Qc.useDeprecatedSynchronousErrorHandling)throw t;tp(t)},complete:function(){}},ip=Array.isArray||function(t){return t&&"number"==typeof t.length};function np(t){return null!=t&&"object"==typeof t}var rp,op={e:{}};function sp(){try{return rp.apply(this,arguments)}catch(t){return op.e=t,op}}function ap(t){return rp=t,sp}function hp(t){return Error.call(this),this.message=t?t.length+" errors occurred during unsubscription:\n"+t.map(function(t,e){return e+1+") "+t.toString()}).join("\n  "):"",this.name="UnsubscriptionError",this.errors=t,this}hp.prototype=Object.create(Error.prototype);var up=hp,lp=function(){function t(t){this.closed=!1,this._parent=null,this._parents=null,this._subscriptions=null,t&&(this._unsubscribe=t)}var e;return t.prototype.unsubscribe=function(){var t,e=!1;if(!this.closed){var i=this._parent,n=this._parents,r=this._unsubscribe,o=this._subscriptions;this.closed=!0,this._parent=null,this._parents=null,this._subscriptions=null;for(var s=-1,a=n?n.length:0;i;)i.remove(this),i=++s<a&&n[s]||null;if(qc(r))ap(r).call(this)===op&&(e=!0,t=t||(op.e instanceof up?cp(op.e.errors):[op.e]));if(ip(o))for(s=-1,a=o.length;++s<a;){var h=o[s];if(np(h))if(ap(h.unsubscribe).call(h)===op){e=!0,t=t||[];var u=op.e;u instanceof up?t=t.concat(cp(u.errors)):t.push(u)}}if(e)throw new up(t)}},t.prototype.add=function(e){if(!e||e===t.EMPTY)return t.EMPTY;if(e===this)return this;var i=e;switch(typeof e){case"function":i=new t(e);case"object":if(i.closed||"function"!=typeof i.unsubscribe)return i;if(this.closed)return i.unsubscribe(),i;if("function"!=typeof i._addParent){var n=i;(i=new t)._subscriptions=[n]}break;default:throw new Error("unrecognized teardown "+e+" added to Subscription.")}return(this._subscriptions||(this._subscriptions=[])).push(i),i._addParent(this),i},t.prototype.remove=function(t){var e=this._subscriptions;if(e){var i=e.indexOf(t);-1!==i&&e.splice(i,1)}},t.prototype._addParent=function(t){var e=this._parent,i=this._parents;e&&e!==t?i?-1===i.indexOf(t)&&i.push(t):this._parents=[t]:this._parent=t},t.EMPTY=((e=new t).closed=!0,e),t}();function cp(t){return t.reduce(function(t,e){return t.concat(e instanceof up?e.errors:e)},[])}var pp="function"==typeof Symbol?Symbol("rxSubscriber"):"@@rxSubscriber_"+Math.random(),fp=function(t){function e(i,n,r){var o=t.call(this)||this;switch(o.syncErrorValue=null,o.syncErrorThrown=!1,o.syncErrorThrowable=!1,o.isStopped=!1,o._parentSubscription=null,arguments.length){case 0:o.destination=ep;break;case 1:if(!i){o.destination=ep;break}if("object"==typeof i){i instanceof e?(o.syncErrorThrowable=i.syncErrorThrowable,o.destination=i,i.add(o)):(o.syncErrorThrowable=!0,o.destination=new dp(o,i));break}default:o.syncErrorThrowable=!0,o.destination=new dp(o,i,n,r)}return o}return Zc(e,t),e.prototype[pp]=function(){return this},e.create=function(t,i,n){var r=new e(t,i,n);return r.syncErrorThrowable=!1,r},e.prototype.next=function(t){this.isStopped||this._next(t)},e.prototype.error=function(t){this.isStopped||(this.isStopped=!0,this._error(t))},e.prototype.complete=function(){this.isStopped||(this.isStopped=!0,this._complete())},e.prototype.unsubscribe=function(){this.closed||(this.isStopped=!0,t.prototype.unsubscribe.call(this))},e.prototype._next=function(t){this.destination.next(t)},e.prototype._error=function(t){this.destination.error(t),this.unsubscribe()},e.prototype._complete=function(){this.destination.complete(),this.unsubscribe()},e.prototype._unsubscribeAndRecycle=function(){var t=this._parent,e=this._parents;return this._parent=null,this._parents=null,this.unsubscribe(),this.closed=!1,this.isStopped=!1,this._parent=t,this._parents=e,this._parentSubscription=null,this},e}(lp),dp=function(t){function e(e,i,n,r){var o,s=t.call(this)||this;s._parentSubscriber=e;var a=s;return qc(i)?o=i:i&&(o=i.next,n=i.error,r=i.complete,i!==ep&&(qc((a=Object.create(i)).unsubscribe)&&s.add(a.unsubscribe.bind(a)),a.unsubscribe=s.unsubscribe.bind(s))),s._context=a,s._next=o,s._error=n,s._complete=r,s}return Zc(e,t),e.prototype.next=function(t){if(!this.isStopped&&this._next){var e=this._parentSubscriber;Qc.useDeprecatedSynchronousErrorHandling&&e.syncErrorThrowable?this.__tryOrSetError(e,this._next,t)&&this.unsubscribe():this.__tryOrUnsub(this._next,t)}},e.prototype.error=function(t){if(!this.isStopped){var e=this._parentSubscriber,i=Qc.useDeprecatedSynchronousErrorHandling;if(this._error)i&&e.syncErrorThrowable?(this.__tryOrSetError(e,this._error,t),this.unsubscribe()):(this.__tryOrUnsub(this._error,t),this.unsubscribe());else if(e.syncErrorThrowable)i?(e.syncErrorValue=t,e.syncErrorThrown=!0):tp(t),this.unsubscribe();else{if(this.unsubscribe(),i)throw t;tp(t)}}},e.prototype.complete=function(){var t=this;if(!this.isStopped){var e=this._parentSubscriber;if(this._complete){var i=function(){return t._complete.call(t._context)};Qc.useDeprecatedSynchronousErrorHandling&&e.syncErrorThrowable?(this.__tryOrSetError(e,i),this.unsubscribe()):(this.__tryOrUnsub(i),this.unsubscribe())}else this.unsubscribe()}},e.prototype.__tryOrUnsub=function(t,e){try{t.call(this._context,e)}catch(t){if(this.unsubscribe(),Qc.useDeprecatedSynchronousErrorHandling)throw t;tp(t)}},e.prototype.__tryOrSetError=function(t,e,i){if(!Qc.useDeprecatedSynchronousErrorHandling)throw new Error("bad call");try{e.call(this._context,i)}catch(e){return Qc.useDeprecatedSynchronousErrorHandling?(t.syncErrorValue=e,t.syncErrorThrown=!0,!0):(tp(e),!0)}return!1},e.prototype._unsubscribe=function(){var t=this._parentSubscriber;this._context=null,this._parentSubscriber=null,t.unsubscribe()},e}(fp);function gp(t,e){return function(i){return i.lift(new yp(t,e))}}var yp=function(){function t(t,e){this.compare=t,this.keySelector=e}return t.prototype.call=function(t,e){return e.subscribe(new vp(t,this.compare,this.keySelector))},t}(),vp=function(t){function e(e,i,n){var r=t.call(this,e)||this;return r.keySelector=n,r.hasKey=!1,"function"==typeof i&&(r.compare=i),r}return Zc(e,t),e.prototype.compare=function(t,e){return t===e},e.prototype._next=function(t){var e=t;if(this.keySelector&&(e=ap(this.keySelector)(t))===op)return this.destination.error(op.e);var i=!1;if(this.hasKey){if((i=ap(this.compare)(this.key,e))===op)return this.destination.error(op.e)}else this.hasKey=!0;!1===Boolean(i)&&(this.key=e,this.destination.next(t))},e}(fp);function _p(t,e){return function(i){if("function"!=typeof t)throw new TypeError("argument is not a function. Are you looking for `mapTo()`?");return i.lift(new mp(t,e))}}var mp=function(){function t(t,e){this.project=t,this.thisArg=e}return t.prototype.call=function(t,e){return e.subscribe(new Ep(t,this.project,this.thisArg))},t}(),Ep=function(t){function e(e,i,n){var r=t.call(this,e)||this;return r.project=i,r.count=0,r.thisArg=n||r,r}return Zc(e,t),e.prototype._next=function(t){var e;try{e=this.project.call(this.thisArg,t,this.count++)}catch(t){return void this.destination.error(t)}this.destination.next(e)},e}(fp),xp=function(t){function e(e,i){var n=t.call(this,e,i)||this;return n.scheduler=e,n.work=i,n.pending=!1,n}return Zc(e,t),e.prototype.schedule=function(t,e){if(void 0===e&&(e=0),this.closed)return this;this.state=t;var i=this.id,n=this.scheduler;return null!=i&&(this.id=this.recycleAsyncId(n,i,e)),this.pending=!0,this.delay=e,this.id=this.id||this.requestAsyncId(n,this.id,e),this},e.prototype.requestAsyncId=function(t,e,i){return void 0===i&&(i=0),setInterval(t.flush.bind(t,this),i)},e.prototype.recycleAsyncId=function(t,e,i){if(void 0===i&&(i=0),null!==i&&this.delay===i&&!1===this.pending)return e;clearInterval(e)},e.prototype.execute=function(t,e){if(this.closed)return new Error("executing a cancelled action");this.pending=!1;var i=this._execute(t,e);if(i)return i;!1===this.pending&&null!=this.id&&(this.id=this.recycleAsyncId(this.scheduler,this.id,null))},e.prototype._execute=function(t,e){var i=!1,n=void 0;try{this.work(t)}catch(t){i=!0,n=!!t&&t||new Error(t)}if(i)return this.unsubscribe(),n},e.prototype._unsubscribe=function(){var t=this.id,e=this.scheduler,i=e.actions,n=i.indexOf(this);this.work=null,this.state=null,this.pending=!1,this.scheduler=null,-1!==n&&i.splice(n,1),null!=t&&(this.id=this.recycleAsyncId(e,t,null)),this.delay=null},e}(function(t){function e(e,i){return t.call(this)||this}return Zc(e,t),e.prototype.schedule=function(t,e){return void 0===e&&(e=0),this},e}(lp)),Sp=function(){function t(e,i){void 0===i&&(i=t.now),this.SchedulerAction=e,this.now=i}return t.prototype.schedule=function(t,e,i){return void 0===e&&(e=0),new this.SchedulerAction(this,t).schedule(i,e)},t.now=function(){return Date.now()},t}(),Tp=new(function(t){function e(i,n){void 0===n&&(n=Sp.now);var r=t.call(this,i,function(){return e.delegate&&e.delegate!==r?e.delegate.now():n()})||this;return r.actions=[],r.active=!1,r.scheduled=void 0,r}return Zc(e,t),e.prototype.schedule=function(i,n,r){return void 0===n&&(n=0),e.delegate&&e.delegate!==this?e.delegate.schedule(i,n,r):t.prototype.schedule.call(this,i,n,r)},e.prototype.flush=function(t){var e=this.actions;if(this.active)e.push(t);else{var i;this.active=!0;do{if(i=t.execute(t.state,t.delay))break}while(t=e.shift());if(this.active=!1,i){for(;t=e.shift();)t.unsubscribe();throw i}}},e}(Sp))(xp),wp=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return Zc(e,t),e.prototype.notifyNext=function(t,e,i,n,r){this.destination.next(e)},e.prototype.notifyError=function(t,e){this.destination.error(t)},e.prototype.notifyComplete=function(t){this.destination.complete()},e}(fp),bp=function(t){function e(e,i,n){var r=t.call(this)||this;return r.parent=e,r.outerValue=i,r.outerIndex=n,r.index=0,r}return Zc(e,t),e.prototype._next=function(t){this.parent.notifyNext(this.outerValue,t,this.outerIndex,this.index++,this)},e.prototype._error=function(t){this.parent.notifyError(t,this),this.unsubscribe()},e.prototype._complete=function(){this.parent.notifyComplete(this),this.unsubscribe()},e}(fp);var Cp="function"==typeof Symbol&&Symbol.observable||"@@observable";function Rp(){}var Op=function(){function t(t){this._isScalar=!1,t&&(this._subscribe=t)}return t.prototype.lift=function(e){var i=new t;return i.source=this,i.operator=e,i},t.prototype.subscribe=function(t,e,i){var n=this.operator,r=function(t,e,i){if(t){if(t instanceof fp)return t;if(t[pp])return t[pp]()}return t||e||i?new fp(t,e,i):new fp(ep)}(t,e,i);if(n?n.call(r,this.source):r.add(this.source||Qc.useDeprecatedSynchronousErrorHandling&&!r.syncErrorThrowable?this._subscribe(r):this._trySubscribe(r)),Qc.useDeprecatedSynchronousErrorHandling&&r.syncErrorThrowable&&(r.syncErrorThrowable=!1,r.syncErrorThrown))throw r.syncErrorValue;return r},t.prototype._trySubscribe=function(t){try{return this._subscribe(t)}catch(e){Qc.useDeprecatedSynchronousErrorHandling&&(t.syncErrorThrown=!0,t.syncErrorValue=e),!function(t){for(;t;){var e=t,i=e.closed,n=e.destination,r=e.isStopped;if(i||r)return!1;t=n&&n instanceof fp?n:null}return!0}(t)?console.warn(e):t.error(e)}},t.prototype.forEach=function(t,e){var i=this;return new(e=Ip(e))(function(e,n){var r;r=i.subscribe(function(e){try{t(e)}catch(t){n(t),r&&r.unsubscribe()}},n,e)})},t.prototype._subscribe=function(t){var e=this.source;return e&&e.subscribe(t)},t.prototype[Cp]=function(){return this},t.prototype.pipe=function(){for(var t,e=[],i=0;i<arguments.length;i++)e[i]=arguments[i];return 0===e.length?this:((t=e)?1===t.length?t[0]:function(e){return t.reduce(function(t,e){return e(t)},e)}:Rp)(this)},t.prototype.toPromise=function(t){var e=this;return new(t=Ip(t))(function(t,i){var n;e.subscribe(function(t){return n=t},function(t){return i(t)},function(){return t(n)})})},t.create=function(e){return new t(e)},t}();function Ip(t){if(t||(t=Qc.Promise||Promise),!t)throw new Error("no Promise impl found");return t}var Pp=function(t){return function(e){for(var i=0,n=t.length;i<n&&!e.closed;i++)e.next(t[i]);e.closed||e.complete()}},Fp=function(t){return function(e){return t.then(function(t){e.closed||(e.next(t),e.complete())},function(t){return e.error(t)}).then(null,tp),e}};var Lp=function(){return"function"==typeof Symbol&&Symbol.iterator?Symbol.iterator:"@@iterator"}(),Mp=function(t){return function(e){for(var i=t[Lp]();;){var n=i.next();if(n.done){e.complete();break}if(e.next(n.value),e.closed)break}return"function"==typeof i.return&&e.add(function(){i.return&&i.return()}),e}},Ap=function(t){return function(e){var i=t[Cp]();if("function"!=typeof i.subscribe)throw new TypeError("Provided object does not correctly implement Symbol.observable");return i.subscribe(e)}},Np=function(t){return t&&"number"==typeof t.length&&"function"!=typeof t};function kp(t){return t&&"function"!=typeof t.subscribe&&"function"==typeof t.then}var jp=function(t){if(t instanceof Op)return function(e){return t._isScalar?(e.next(t.value),void e.complete()):t.subscribe(e)};if(t&&"function"==typeof t[Cp])return Ap(t);if(Np(t))return Pp(t);if(kp(t))return Fp(t);if(t&&"function"==typeof t[Lp])return Mp(t);var e=np(t)?"an invalid object":"'"+t+"'";throw new TypeError("You provided "+e+" where a stream was expected. You can provide an Observable, Promise, Array, or Iterable.")};function Gp(t,e,i,n,r){if(void 0===r&&(r=new bp(t,i,n)),!r.closed)return jp(e)(r)}var Dp={leading:!0,trailing:!1};function $p(t,e,i){return void 0===e&&(e=Tp),void 0===i&&(i=Dp),function(n){return n.lift(new Vp(t,e,i.leading,i.trailing))}}var Vp=function(){function t(t,e,i,n){this.duration=t,this.scheduler=e,this.leading=i,this.trailing=n}return t.prototype.call=function(t,e){return e.subscribe(new zp(t,this.duration,this.scheduler,this.leading,this.trailing))},t}(),zp=function(t){function e(e,i,n,r,o){var s=t.call(this,e)||this;return s.duration=i,s.scheduler=n,s.leading=r,s.trailing=o,s._hasTrailingValue=!1,s._trailingValue=null,s}return Zc(e,t),e.prototype._next=function(t){this.throttled?this.trailing&&(this._trailingValue=t,this._hasTrailingValue=!0):(this.add(this.throttled=this.scheduler.schedule(Yp,this.duration,{subscriber:this})),this.leading&&this.destination.next(t))},e.prototype._complete=function(){this._hasTrailingValue?(this.destination.next(this._trailingValue),this.destination.complete()):this.destination.complete()},e.prototype.clearThrottle=function(){var t=this.throttled;t&&(this.trailing&&this._hasTrailingValue&&(this.destination.next(this._trailingValue),this._trailingValue=null,this._hasTrailingValue=!1),t.unsubscribe(),this.remove(t),this.throttled=null)},e}(fp);function Yp(t){t.subscriber.clearThrottle()}function Xp(t,e,i,n,r){return r?(r[0]=t,r[1]=e,r[2]=i,r[3]=n,r):[t,e,i,n]}function Up(t,e){var i;if(e===zh.BOTTOM_LEFT)i=Pn(t);else if(e===zh.BOTTOM_RIGHT)i=Fn(t);else if(e===zh.TOP_LEFT)i=kn(t);else{if(e!==zh.TOP_RIGHT)throw new Error("Invalid corner");i=jn(t)}return i}function Bp(t){var e=(t=fo(t)).getExtent();if(!e){var i=180*Xr[Yh.DEGREES]/t.getMetersPerUnit();e=Xp(-i,-i,i,i)}return e}var Kp=U("iterator"),Wp=w.isIterable=function(t){var e=Object(t);return void 0!==e[Kp]||"@@iterator"in e||Te.hasOwnProperty(hi(e))};function Hp(t){return function(t){if(ti(t)){for(var e=0,i=new Array(t.length);e<t.length;e++)i[e]=t[e];return i}}(t)||function(t){if(Wp(Object(t))||"[object Arguments]"===Object.prototype.toString.call(t))return gi(t)}(t)||function(){throw new TypeError("Invalid attempt to spread non-iterable instance")}()}function Zp(t,e){return new Op(e?function(i){var n=new lp,r=0;return n.add(e.schedule(function(){r!==t.length?(i.next(t[r++]),i.closed||n.add(this.schedule())):i.complete()})),n}:Pp(t))}function qp(t,e){if(!e)return t instanceof Op?t:new Op(jp(t));if(null!=t){if(function(t){return t&&"function"==typeof t[Cp]}(t))return function(t,e){return new Op(e?function(i){var n=new lp;return n.add(e.schedule(function(){var r=t[Cp]();n.add(r.subscribe({next:function(t){n.add(e.schedule(function(){return i.next(t)}))},error:function(t){n.add(e.schedule(function(){return i.error(t)}))},complete:function(){n.add(e.schedule(function(){return i.complete()}))}}))})),n}:Ap(t))}(t,e);if(kp(t))return function(t,e){return new Op(e?function(i){var n=new lp;return n.add(e.schedule(function(){return t.then(function(t){n.add(e.schedule(function(){i.next(t),n.add(e.schedule(function(){return i.complete()}))}))},function(t){n.add(e.schedule(function(){return i.error(t)}))})})),n}:Fp(t))}(t,e);if(Np(t))return Zp(t,e);if(function(t){return t&&"function"==typeof t[Lp]}(t)||"string"==typeof t)return function(t,e){if(!t)throw new Error("Iterable cannot be null");return new Op(e?function(i){var n,r=new lp;return r.add(function(){n&&"function"==typeof n.return&&n.return()}),r.add(e.schedule(function(){n=t[Lp](),r.add(e.schedule(function(){if(!i.closed){var t,e;try{var r=n.next();t=r.value,e=r.done}catch(t){return void i.error(t)}e?i.complete():(i.next(t),this.schedule())}}))})),r}:Mp(t))}(t,e)}throw new TypeError((null!==t&&typeof t||t)+" is not observable")}var Jp=function(){function t(t,e){void 0===e&&(e=Number.POSITIVE_INFINITY),this.project=t,this.concurrent=e}return t.prototype.call=function(t,e){return e.subscribe(new Qp(t,this.project,this.concurrent))},t}(),Qp=function(t){function e(e,i,n){void 0===n&&(n=Number.POSITIVE_INFINITY);var r=t.call(this,e)||this;return r.project=i,r.concurrent=n,r.hasCompleted=!1,r.buffer=[],r.active=0,r.index=0,r}return Zc(e,t),e.prototype._next=function(t){this.active<this.concurrent?this._tryNext(t):this.buffer.push(t)},e.prototype._tryNext=function(t){var e,i=this.index++;try{e=this.project(t,i)}catch(t){return void this.destination.error(t)}this.active++,this._innerSub(e,t,i)},e.prototype._innerSub=function(t,e,i){var n=new bp(this,void 0,void 0);this.destination.add(n),Gp(this,t,e,i,n)},e.prototype._complete=function(){this.hasCompleted=!0,0===this.active&&0===this.buffer.length&&this.destination.complete(),this.unsubscribe()},e.prototype.notifyNext=function(t,e,i,n,r){this.destination.next(e)},e.prototype.notifyComplete=function(t){var e=this.buffer;this.remove(t),this.active--,e.length>0?this._next(e.shift()):0===this.active&&this.hasCompleted&&this.destination.complete()},e}(wp);function tf(t){return t}function ef(t){return void 0===t&&(t=Number.POSITIVE_INFINITY),function t(e,i,n){return void 0===n&&(n=Number.POSITIVE_INFINITY),"function"==typeof i?function(r){return r.pipe(t(function(t,n){return qp(e(t,n)).pipe(_p(function(e,r){return i(t,e,n,r)}))},n))}:("number"==typeof i&&(n=i),function(t){return t.lift(new Jp(e,n))})}(tf,t)}function nf(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];var i,n=Number.POSITIVE_INFINITY,r=null,o=t[t.length-1];return(i=o)&&"function"==typeof i.schedule?(r=t.pop(),t.length>1&&"number"==typeof t[t.length-1]&&(n=t.pop())):"number"==typeof o&&(n=t.pop()),null===r&&1===t.length&&t[0]instanceof Op?t[0]:ef(n)(Zp(t,r))}function rf(t,e,i){return ti(e)?nf.apply(void 0,Hp(e.map(function(e){var i,n;return"object"===ze(e)?(i=e.event,n=e.selector):i=e,rf(t,i,n)}))):function t(e,i,n){return n?t(e,i).pipe(_p(function(t){return ip(t)?n.apply(void 0,t):n(t)})):new Op(function(t){var n,r=function(){for(var e=[],i=0;i<arguments.length;i++)e[i]=arguments[i];return t.next(1===e.length?e[0]:e)};try{n=e(r)}catch(e){return void t.error(e)}if(qc(i))return function(){return i(r,n)}})}(function(i){return t.on(e,i)},function(i){return t.un(e,i)},i)}var of=function(t,e,i){if(!t)throw new TypeError("argument dest is required");if(!e)throw new TypeError("argument src is required");void 0===i&&(i=!0);return Object.getOwnPropertyNames(e).forEach(function(n){if(i||!sf.call(t,n)){var r=Object.getOwnPropertyDescriptor(e,n);Object.defineProperty(t,n,r)}}),t},sf=Object.prototype.hasOwnProperty;function af(){for(var t,e=arguments.length,i=new Array(e),n=0;n<e;n++)i[n]=arguments[n];return"object"!==ze(i[i.length-1])&&(t=i.pop()),i.slice(1).reduce(function(e,i,n){return of(e,i,t)},i[0])}var hf,uf,lf,cf=U("species"),pf=function(t,e){var i,n=O(t).constructor;return void 0===n||void 0==(i=O(n)[cf])?e:C(i)},ff=v.process,df=v.setImmediate,gf=v.clearImmediate,yf=v.MessageChannel,vf=v.Dispatch,_f=0,mf={},Ef=function(){var t=+this;if(mf.hasOwnProperty(t)){var e=mf[t];delete mf[t],e()}},xf=function(t){Ef.call(t.data)};df&&gf||(df=function(t){for(var e=[],i=1;arguments.length>i;)e.push(arguments[i++]);return mf[++_f]=function(){dh("function"==typeof t?t:Function(t),e)},hf(_f),_f},gf=function(t){delete mf[t]},"process"==i(ff)?hf=function(t){ff.nextTick(R(Ef,t,1))}:vf&&vf.now?hf=function(t){vf.now(R(Ef,t,1))}:yf?(lf=(uf=new yf).port2,uf.port1.onmessage=xf,hf=R(lf.postMessage,lf,1)):v.addEventListener&&"function"==typeof postMessage&&!v.importScripts?(hf=function(t){v.postMessage(t+"","*")},v.addEventListener("message",xf,!1)):hf="onreadystatechange"in E("script")?function(t){yt.appendChild(E("script")).onreadystatechange=function(){yt.removeChild(this),Ef.call(t)}}:function(t){setTimeout(R(Ef,t,1),0)});var Sf={set:df,clear:gf},Tf=Sf.set,wf=v.MutationObserver||v.WebKitMutationObserver,bf=v.process,Cf=v.Promise,Rf="process"==i(bf);var Of,If,Pf,Ff,Lf={f:function(t){return new function(t){var e,i;this.promise=new t(function(t,n){if(void 0!==e||void 0!==i)throw TypeError("Bad Promise constructor");e=t,i=n}),this.resolve=C(e),this.reject=C(i)}(t)}},Mf=function(t){try{return{e:!1,v:t()}}catch(t){return{e:!0,v:t}}},Af=v.navigator,Nf=Af&&Af.userAgent||"",kf=function(t,e){if(O(t),u(e)&&e.constructor===t)return e;var i=Lf.f(t);return(0,i.resolve)(e),i.promise},jf=Sf.set,Gf=function(){var t,e,i,n=function(){var n,r;for(Rf&&(n=bf.domain)&&n.exit();t;){r=t.fn,t=t.next;try{r()}catch(n){throw t?i():e=void 0,n}}e=void 0,n&&n.enter()};if(Rf)i=function(){bf.nextTick(n)};else if(!wf||v.navigator&&v.navigator.standalone)if(Cf&&Cf.resolve){var r=Cf.resolve(void 0);i=function(){r.then(n)}}else i=function(){Tf.call(v,n)};else{var o=!0,s=document.createTextNode("");new wf(n).observe(s,{characterData:!0}),i=function(){s.data=o=!o}}return function(n){var r={fn:n,next:void 0};e&&(e.next=r),t||(t=r,i()),e=r}}(),Df=v.TypeError,$f=v.process,Vf=$f&&$f.versions,zf=Vf&&Vf.v8||"",Yf=v.Promise,Xf="process"==hi($f),Uf=function(){},Bf=If=Lf.f,Kf=!!function(){try{var t=Yf.resolve(1),e=(t.constructor={})[U("species")]=function(t){t(Uf,Uf)};return(Xf||"function"==typeof PromiseRejectionEvent)&&t.then(Uf)instanceof e&&0!==zf.indexOf("6.6")&&-1===Nf.indexOf("Chrome/66")}catch(t){}}(),Wf=function(t){var e;return!(!u(t)||"function"!=typeof(e=t.then))&&e},Hf=function(t,e){if(!t._n){t._n=!0;var i=t._c;Gf(function(){for(var n=t._v,r=1==t._s,o=0,s=function(e){var i,o,s,a=r?e.ok:e.fail,h=e.resolve,u=e.reject,l=e.domain;try{a?(r||(2==t._h&&Jf(t),t._h=1),!0===a?i=n:(l&&l.enter(),i=a(n),l&&(l.exit(),s=!0)),i===e.promise?u(Df("Promise-chain cycle")):(o=Wf(i))?o.call(i,h,u):h(i)):u(n)}catch(t){l&&!s&&l.exit(),u(t)}};i.length>o;)s(i[o++]);t._c=[],t._n=!1,e&&!t._h&&Zf(t)})}},Zf=function(t){jf.call(v,function(){var e,i,n,r=t._v,o=qf(t);if(o&&(e=Mf(function(){Xf?$f.emit("unhandledRejection",r,t):(i=v.onunhandledrejection)?i({promise:t,reason:r}):(n=v.console)&&n.error&&n.error("Unhandled promise rejection",r)}),t._h=Xf||qf(t)?2:1),t._a=void 0,o&&e.e)throw e.v})},qf=function(t){return 1!==t._h&&0===(t._a||t._c).length},Jf=function(t){jf.call(v,function(){var e;Xf?$f.emit("rejectionHandled",t):(e=v.onrejectionhandled)&&e({promise:t,reason:t._v})})},Qf=function(t){var e=this;e._d||(e._d=!0,(e=e._w||e)._v=t,e._s=2,e._a||(e._a=e._c.slice()),Hf(e,!0))},td=function(t){var e,i=this;if(!i._d){i._d=!0,i=i._w||i;try{if(i===t)throw Df("Promise can't be resolved itself");(e=Wf(t))?Gf(function(){var n={_w:i,_d:!1};try{e.call(t,R(td,n,1),R(Qf,n,1))}catch(t){Qf.call(n,t)}}):(i._v=t,i._s=1,Hf(i,!1))}catch(t){Qf.call({_w:i,_d:!1},t)}}};Kf||(Yf=function(t){Ja(this,Yf,"Promise","_h"),C(t),Of.call(this);try{t(R(td,this,1),R(Qf,this,1))}catch(t){Qf.call(this,t)}},(Of=function(t){this._c=[],this._a=void 0,this._s=0,this._d=!1,this._v=void 0,this._h=0,this._n=!1}).prototype=qa(Yf.prototype,{then:function(t,e){var i=Bf(pf(this,Yf));return i.ok="function"!=typeof t||t,i.fail="function"==typeof e&&e,i.domain=Xf?$f.domain:void 0,this._c.push(i),this._a&&this._a.push(i),this._s&&Hf(this,!1),i.promise},catch:function(t){return this.then(void 0,t)}}),Pf=function(){var t=new Of;this.promise=t,this.resolve=R(td,t,1),this.reject=R(Qf,t,1)},Lf.f=Bf=function(t){return t===Yf||t===Ff?new Pf(t):If(t)}),M(M.G+M.W+M.F*!Kf,{Promise:Yf}),W(Yf,"Promise"),eh("Promise"),Ff=w.Promise,M(M.S+M.F*!Kf,"Promise",{reject:function(t){var e=Bf(this);return(0,e.reject)(t),e.promise}}),M(M.S+!0*M.F,"Promise",{resolve:function(t){return kf(this===Ff?Yf:this,t)}}),M(M.S+M.F*!(Kf&&fi(function(t){Yf.all(t).catch(Uf)})),"Promise",{all:function(t){var e=this,i=Bf(e),n=i.resolve,r=i.reject,o=Mf(function(){var i=[],o=0,s=1;Qa(t,!1,function(t){var a=o++,h=!1;i.push(void 0),s++,e.resolve(t).then(function(t){h||(h=!0,i[a]=t,--s||n(i))},r)}),--s||n(i)});return o.e&&r(o.v),i.promise},race:function(t){var e=this,i=Bf(e),n=i.reject,r=Mf(function(){Qa(t,!1,function(t){e.resolve(t).then(i.resolve,n)})});return r.e&&n(r.v),i.promise}}),M(M.P+M.R,"Promise",{finally:function(t){var e=pf(this,w.Promise||v.Promise),i="function"==typeof t;return this.then(i?function(i){return kf(e,t()).then(function(){return i})}:t,i?function(i){return kf(e,t()).then(function(){throw i})}:t)}}),M(M.S,"Promise",{try:function(t){var e=Lf.f(this),i=Mf(t);return(i.e?e.reject:e.resolve)(i.v),e.promise}});var ed=w.Promise;function id(t,e,i,n,r,o,s){try{var a=t[o](s),h=a.value}catch(t){return void i(t)}a.done?e(h):ed.resolve(h).then(n,r)}function nd(t){return function(){var e=this,i=arguments;return new ed(function(n,r){var o=t.apply(e,i);function s(t){id(o,n,r,s,a,"next",t)}function a(t){id(o,n,r,s,a,"throw",t)}s(void 0)})}}function rd(t,e){var i;return void 0===t&&(t=0),void 0===e&&(e=Tp),(ip(i=t)||!(i-parseFloat(i)+1>=0)||t<0)&&(t=0),e&&"function"==typeof e.schedule||(e=Tp),new Op(function(i){return i.add(e.schedule(od,t,{subscriber:i,counter:0,period:t})),i})}function od(t){var e=t.subscriber,i=t.counter,n=t.period;e.next(i),this.schedule({subscriber:e,counter:i+1,period:n},n)}function sd(){return Error.call(this),this.message="no elements in sequence",this.name="EmptyError",this}sd.prototype=Object.create(Error.prototype);var ad=sd;var hd=function(){function t(t,e){this.predicate=t,this.thisArg=e}return t.prototype.call=function(t,e){return e.subscribe(new ud(t,this.predicate,this.thisArg))},t}(),ud=function(t){function e(e,i,n){var r=t.call(this,e)||this;return r.predicate=i,r.thisArg=n,r.count=0,r}return Zc(e,t),e.prototype._next=function(t){var e;try{e=this.predicate.call(this.thisArg,t,this.count++)}catch(t){return void this.destination.error(t)}e&&this.destination.next(t)},e}(fp);function ld(){return Error.call(this),this.message="argument out of range",this.name="ArgumentOutOfRangeError",this}ld.prototype=Object.create(Error.prototype);var cd=ld,pd=new Op(function(t){return t.complete()});function fd(t){return t?function(t){return new Op(function(e){return t.schedule(function(){return e.complete()})})}(t):pd}var dd=function(){function t(t){if(this.total=t,this.total<0)throw new cd}return t.prototype.call=function(t,e){return e.subscribe(new gd(t,this.total))},t}(),gd=function(t){function e(e,i){var n=t.call(this,e)||this;return n.total=i,n.count=0,n}return Zc(e,t),e.prototype._next=function(t){var e=this.total,i=++this.count;i<=e&&(this.destination.next(t),i===e&&(this.destination.complete(),this.unsubscribe()))},e}(fp);var yd=function(){function t(t){this.defaultValue=t}return t.prototype.call=function(t,e){return e.subscribe(new vd(t,this.defaultValue))},t}(),vd=function(t){function e(e,i){var n=t.call(this,e)||this;return n.defaultValue=i,n.isEmpty=!0,n}return Zc(e,t),e.prototype._next=function(t){this.isEmpty=!1,this.destination.next(t)},e.prototype._complete=function(){this.isEmpty&&this.destination.next(this.defaultValue),this.destination.complete()},e}(fp);function _d(t,e,i){return function(n){return n.lift(new md(t,e,i))}}var md=function(){function t(t,e,i){this.nextOrObserver=t,this.error=e,this.complete=i}return t.prototype.call=function(t,e){return e.subscribe(new Ed(t,this.nextOrObserver,this.error,this.complete))},t}(),Ed=function(t){function e(e,i,n,r){var o=t.call(this,e)||this;return o._tapNext=Rp,o._tapError=Rp,o._tapComplete=Rp,o._tapError=n||Rp,o._tapComplete=r||Rp,qc(i)?(o._context=o,o._tapNext=i):i&&(o._context=i,o._tapNext=i.next||Rp,o._tapError=i.error||Rp,o._tapComplete=i.complete||Rp),o}return Zc(e,t),e.prototype._next=function(t){try{this._tapNext.call(this._context,t)}catch(t){return void this.destination.error(t)}this.destination.next(t)},e.prototype._error=function(t){try{this._tapError.call(this._context,t)}catch(t){return void this.destination.error(t)}this.destination.error(t)},e.prototype._complete=function(){try{this._tapComplete.call(this._context)}catch(t){return void this.destination.error(t)}return this.destination.complete()},e}(fp),xd=function(t){return void 0===t&&(t=Sd),_d({hasValue:!1,next:function(){this.hasValue=!0},complete:function(){if(!this.hasValue)throw t()}})};function Sd(){return new ad}function Td(t,e){var i=arguments.length>=2;return function(n){return n.pipe(t?function(t,e){return function(i){return i.lift(new hd(t,e))}}(function(e,i){return t(e,i,n)}):tf,(r=1,function(t){return 0===r?fd():t.lift(new dd(r))}),i?function(t){return void 0===t&&(t=null),function(e){return e.lift(new yd(t))}}(e):xd(function(){return new ad}));var r}}function wd(t){return function(e){return e.lift(new bd(t))}}var bd=function(){function t(t){this.predicate=t}return t.prototype.call=function(t,e){return e.subscribe(new Cd(t,this.predicate))},t}(),Cd=function(t){function e(e,i){var n=t.call(this,e)||this;return n.predicate=i,n.skipping=!0,n.index=0,n}return Zc(e,t),e.prototype._next=function(t){var e=this.destination;this.skipping&&this.tryCallPredicate(t),this.skipping||e.next(t)},e.prototype.tryCallPredicate=function(t){try{var e=this.predicate(t,this.index++);this.skipping=Boolean(e)}catch(t){this.destination.error(t)}},e}(fp),Rd="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(t){return typeof t}:function(t){return t&&"function"==typeof Symbol&&t.constructor===Symbol&&t!==Symbol.prototype?"symbol":typeof t},Od=function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:0,i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{},n=void 0,r=void 0,o=void 0,s=[];return function(){var a,h=function(t){return"function"==typeof t?t():t}(e),u=(new Date).getTime(),l=!n||u-n>h;n=u;for(var c=arguments.length,p=Array(c),f=0;f<c;f++)p[f]=arguments[f];if(l&&i.leading)return i.accumulate?Promise.resolve(t.call(this,[p])).then(function(t){return t[0]}):Promise.resolve(t.call.apply(t,[this].concat(p)));if(r?clearTimeout(o):r=function(){var t={};return t.promise=new Promise(function(e,i){t.resolve=e,t.reject=i}),t}(),s.push(p),o=setTimeout(function(){var e=r;clearTimeout(o),Promise.resolve(i.accumulate?t.call(this,s):t.apply(this,s[s.length-1])).then(e.resolve,e.reject),s=[],r=null}.bind(this),h),i.accumulate){var d=(a=s.length-1,{v:r.promise.then(function(t){return t[a]})});if("object"===(void 0===d?"undefined":Rd(d)))return d.v}return r.promise}};var Id=function(){function t(){$a(this,t),he(this,"pools",Wa(null))}return Bh(t,[{key:"_preparePool",value:function(t){this.pools[t]||(this.pools[t]=Wa(null))}},{key:"set",value:function(t,e){var i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:"default";null!=e&&(this._preparePool(i),this.pools[i][t]={value:e,refs:1})}},{key:"get",value:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"default";this._preparePool(e);var i=this.pools[e][t];if(i&&null!=i.value)return i.refs++,this.pools[e][t]=i,i.value}},{key:"unset",value:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"default";this._preparePool(e);var i=this.pools[e][t];i&&null!=i.value&&(i.refs--,0===i.refs?delete this.pools[e][t]:this.pools[e][t]=i)}},{key:"has",value:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"default";return this._preparePool(e),!!this.pools[e][t]}},{key:"ids",value:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"default";return this._preparePool(t),oe(this.pools[t])}},{key:"refs",value:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"default";return this._preparePool(e),this.has(t,e)?this.pools[e][t].refs:void 0}}]),t}(),Pd="identityMap",Fd={IDENTITY_MAP_PROP:Pd,props:{ident:[String,Number]},methods:{makeSelfIdent:function(){if(this.ident){for(var t=arguments.length,e=new Array(t),i=0;i<t;i++)e[i]=arguments[i];return this.makeIdent.apply(this,[this.ident].concat(e))}},makeIdent:function(){for(var t=arguments.length,e=new Array(t),i=0;i<t;i++)e[i]=arguments[i];return e.filter(Pi).join(".")}},created:function(){(function(){var e=this;this[Pd]||(t[Pd]=t.prototype[Pd]=new Id);Wc(this,{$identityMap:{enumerable:!0,get:function(){return e[Pd]}}})}).call(this)}};var Ld,Md=function(){},Ad={methods:{subscribeAll:function(){},unsubscribeAll:function(){this._rxSubs.forEach(function(t){return t.unsubscribe()}),this._rxSubs=[]},subscribeTo:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Md,i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Md,n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:Md;i=function(t){i(t)};var r=t.subscribe(e,i,n);return this._rxSubs.push(r),r}},beforeCreate:function(){this._rxSubs=[]},destroyed:function(){this.unsubscribeAll()}},Nd={inject:{$services:"services"},provide:function(){return he({},"services",this.getServices())},methods:{getServices:function(){return af({},this.$services||{})}},beforeCreate:function(){for(var t=this.$parent;t&&(null==t._provided||null==t._provided.services);)t=t.$parent;null!=t&&null!=t._provided.services||delete this.$options.inject.$services}},kd={beforeInit:function(){},init:(Ld=nd(_e.mark(function t(){var e,i;return _e.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:return(i=this.makeSelfIdent())&&this.$identityMap.has(i,"instance_promise")?e=this.$identityMap.get(i,"instance_promise"):(e=ed.resolve(this.createOlObject()),i&&this.$identityMap.set(i,e,"instance_promise")),t.next=4,e;case 4:this._olObject=t.sent,this._olObject.vm||(this._olObject.vm=[]),this._olObject.vm.includes(this)||this._olObject.vm.push(this),++this.rev;case 8:case"end":return t.stop()}},t,this)})),function(){return Ld.apply(this,arguments)}),createOlObject:function(){throw new Error("Not implemented method")},deinit:function(){var t=this,e=this.makeSelfIdent();e&&this.$identityMap.unset(e,"instance_promise"),this._olObject&&(this._olObject.vm=this._olObject.vm.filter(function(e){return e!==t}),this._olObject=void 0)},getServices:function(){return Nd.methods.getServices.call(this)},mount:function(){throw new Error("Not implemented method")},unmount:function(){throw new Error("Not implemented method")},refresh:function(){var t=this;return null==this.$olObject?ed.resolve():new ed(function(e){var i=function(){++t.rev,e()};t.$olObject&&Ni(t.$olObject.changed)?(t.$olObject.once("change",i),t.$olObject.changed()):i()})},remount:function(){var t=this;return null==this.$olObject?ed.resolve():ed.resolve(this.unmount()).then(function(){return t.mount()})},recreate:function(){var t=this;return null==this.$olObject?ed.resolve():ed.resolve(this.unmount()).then(function(){return t.deinit()}).then(function(){return t.init()}).then(function(){return t.mount()})}},jd={VM_PROP:"vm",INSTANCE_PROMISE_POOL:"instance_promise",mixins:[Fd,Ad,Nd],props:{},methods:kd,data:function(){return{rev:0}},created:function(){var t=this;this._olObject=void 0,this._createPromise=ed.resolve(this.beforeInit()).then(this.init).then(function(){return t.$emit("created",t),t}),this._mountPromise=rd(100).pipe(wd(function(){return!t._mounted}),Td(),_p(function(){return t})).toPromise(ed),Wc(this,{$olObject:{enumerable:!0,get:function(){return t._olObject}},$createPromise:{enumerable:!0,get:function(){return t._createPromise}},$mountPromise:{enumerable:!0,get:function(){return t._mountPromise}}}),this.scheduleRefresh=Od(function(){return this.refresh()},10),this.scheduleRemount=Od(function(){return this.remount()},10),this.scheduleRecreate=Od(function(){return this.recreate()},10)},mounted:function(){var t=this;this.$createPromise.then(this.mount).then(function(){t._mounted=!0,t.$emit("mounted",t)})},destroyed:function(){var t=this;this.$mountPromise.then(this.unmount).then(this.deinit).then(function(){t.$emit("destroyed",t)})}};function Gd(t,e){return t.reduce(function(t,i){return t[i]=e(i),t},{})}var Dd={mixins:[{render:function(t){var e,i=this.$options.stubVNode||{};if(i.empty){var n=t();return"string"==typeof i.empty?n.text=i.empty:"function"==typeof i.empty&&(n.text=i.empty.call(this)),n}e=!1===i.slots?void 0:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:[];return oe(t).reduce(function(i,n){return e.length&&!e.includes(n)||(i=i.concat(t[n])),i},[])}(this.$slots,i.slots);var r={attrs:"function"==typeof i.attrs?i.attrs.call(this):i.attrs,style:{display:"none !important"}};return t(i.tag||"i",r,e)}},jd],methods:{init:function(){return jd.methods.init.call(this)},deinit:function(){return jd.methods.deinit.call(this)},createOlObject:function(){throw new Error("Not implemented method")},getServices:function(){return jd.methods.getServices.call(this)},refresh:function(){return jd.methods.refresh.call(this)}}},$d={methods:{beforeInit:function(){var t=this;return rd(100).pipe(wd(function(){return!t.$map}),Td(),_p(function(){return t})).toPromise(ed)}}},Vd={coordinates:{type:Array,required:!0,validator:function(t){return t.length}}},zd={mixins:[Dd,$d,Uc],props:Vd,computed:{type:function(){throw new Error("Not implemented computed property")},extent:function(){if(this.extentViewProj&&this.resolvedDataProjection)return this.extentToDataProj(this.extentViewProj)},extentViewProj:function(){if(this.rev&&this.$geometry)return this.$geometry.getExtent()},point:function(){if(this.pointViewProj&&this.resolvedDataProjection)return this.pointToDataProj(this.pointViewProj)},pointViewProj:function(){if(this.rev&&this.$geometry)return cc(this.$geometry)},coordinatesViewProj:function(){if(this.rev&&this.$geometry)return this.$geometry.getCoordinates()}},watch:{coordinates:function(t){this.$geometry&&this.$view&&(Yd({coordinates:t,extent:un(t)},{coordinates:this.getCoordinates(),extent:this.extent})||this.setCoordinates(t))},resolvedDataProjection:function(){this.$geometry&&(this.setupTransformFunctions(),this.setCoordinates(this.coordinates))}},methods:{createOlObject:function(){return this.createGeometry()},createGeometry:function(){throw new Error("Not implemented method")},getCoordinates:function(){return Lh(this),this.toDataProj(this.$geometry.getCoordinates())},setCoordinates:function(t){Lh(this),this.$geometry.setCoordinates(this.toViewProj(t))},init:function(){return this.setupTransformFunctions(),Dd.methods.init.call(this)},setupTransformFunctions:function(){var t=this,e=Lc[this.type].transform;this.toDataProj=function(i){return e(i,t.viewProjection,t.resolvedDataProjection)},this.toViewProj=function(i){return e(i,t.resolvedDataProjection,t.viewProjection)}},deinit:function(){return Dd.methods.deinit.call(this)},refresh:function(){return Dd.methods.refresh.call(this)},getServices:function(){var t=this;return af(Dd.methods.getServices.call(this),{get geometry(){return t.$geometry}})},mount:function(){this.$geometryContainer&&this.$geometryContainer.setGeometry(this),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.$geometryContainer&&this.$geometryContainer.setGeometry(void 0)},subscribeAll:function(){(function(){var t=this;Lh(this);var e=rf(this.$geometry,"change",function(){return{coordinates:t.getCoordinates(),extent:t.extent}}).pipe($p(100),gp(Yd),_p(function(t){var e=t.coordinates;return{prop:"coordinates",value:e}}));this.subscribeTo(e,function(e){var i=e.prop,n=e.value;++t.rev,t.$emit("update:".concat(i),n)})}).call(this)}},stubVNode:{empty:function(){return this.$options.name}},created:function(){var t=this;Wc(this,{$geometry:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$geometryContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.geometryContainer}}})}};function Yd(t,e){return!!zi(t.extent,e.extent)&&zi(t.coordinates,e.coordinates)}var Xd={mixins:[Uc],methods:{getGeometryTarget:function(){throw new Error("Not implemented method")},getGeometry:function(){return this._geometry},getServices:function(){var t=this;return{get geometryContainer(){return t}}},setGeometry:function(e){e instanceof t?e=e.$geometry:Gi(e)&&(e=this.readGeometryInDataProj(e)),e!==this._geometry&&(this._geometry=e);var i=this.getGeometryTarget();i&&e!==i.getGeometry()&&i.setGeometry(e)}},created:function(){this._geometry=void 0,Wc(this,{$geometry:{enumerable:!0,get:this.getGeometry}})}},Ud={attributions:[String,Array],logo:String,projection:String,wrapX:{type:Boolean,default:!0}},Bd={mixins:[Dd,$d],props:Ud,methods:{createOlObject:function(){return this.createSource()},createSource:function(){throw new Error("Not implemented method")},init:function(){return Dd.methods.init.call(this)},deinit:function(){return Dd.methods.deinit.call(this)},getServices:function(){var t=this;return af(Dd.methods.getServices.call(this),{get source(){return t.$source}})},mount:function(){this.$sourceContainer&&this.$sourceContainer.setSource(this),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.$sourceContainer&&this.$sourceContainer.setSource(void 0)},refresh:function(){var t=this;return this.$source&&!Ni(this.$source.clear)?Dd.methods.refresh.call(this):new ed(function(e){var i=function(){++t.rev,e()};t.$source?(t.$source.once("change",i),t.$source.clear()):i()})}},watch:{attributions:function(t){this.$source&&this.$source.setAttributions(t)}},stubVNode:{empty:function(){return this.$options.name}},created:function(){var t=this;Wc(this,{$source:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$sourceContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.sourceContainer}}})}},Kd={crossOrigin:String,projection:{type:String,default:jh}},Wd={mixins:[Bd],props:Kd,methods:{init:function(){return Bd.methods.init.call(this)},deinit:function(){return Bd.methods.deinit.call(this)},mount:function(){Bd.methods.mount.call(this)},unmount:function(){Bd.methods.unmount.call(this)}}};function Hd(t,e,i,n,r){if(ti(e))return nf.apply(void 0,Hp(e.map(function(e){return Hd(t,e)})));r=r||function(t,e){return t.get(e)};var o="change:".concat(e),s=rf(t,o,function(){return r(t,e)}),a=[];return null!=n&&a.push($p(n)),i&&(Ni(i)||(i=zi),a.push(gp(i))),a.push(_p(function(t){return{prop:e,value:t}})),s.pipe.apply(s,a)}var Zd,qd=Object.freeze({observableFromOlChangeEvent:Hd,observableFromOlEvent:rf}),Jd={mixins:[Dd,$d],methods:{createOlObject:function(){return this.createStyle()},createStyle:function(){throw new Error("Not implemented method")},init:function(){return Dd.methods.init.call(this)},deinit:function(){return Dd.methods.deinit.call(this)},getServices:function(){var t=this;return af(Dd.methods.getServices.call(this),{get style(){return t.$style}})},refresh:function(){var t=this;return null==this.$olObject?ed.resolve():this.remount().then(function(){if(t.$map)return t.$map.render(),rf(t.$map,"postcompose").pipe(Td()).toPromise()})}},stubVNode:{empty:function(){return this.$options.name}},created:function(){var t=this;Wc(this,{$style:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$stylesContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.stylesContainer}}})}},Qd={mixins:[Jd],props:{},methods:{init:function(){return Jd.methods.init.call(this)},deinit:function(){return Jd.methods.deinit.call(this)},mount:function(){this.$stylesContainer&&this.$stylesContainer.setImage(this)},unmount:function(){this.$stylesContainer&&this.$stylesContainer.setImage(void 0)},getServices:function(){return Jd.methods.getServices.call(this)},refresh:function(){return this.recreate()}},watch:{},stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}}},tg={id:{type:[String,Number],default:function(){return ja()}},active:{type:Boolean,default:!0},priority:{type:Number,default:0}},eg={createOlObject:(Zd=nd(_e.mark(function t(){var e;return _e.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:return t.next=2,this.createInteraction();case 2:return(e=t.sent).setActive(this.active),e.setProperties({id:this.id,priority:this.priority}),t.abrupt("return",e);case 6:case"end":return t.stop()}},t,this)})),function(){return Zd.apply(this,arguments)}),createInteraction:function(){throw new Error("Not implemented method")},getServices:function(){var t=this;return af(Dd.methods.getServices.call(this),{get interaction(){return t.$interaction}})},init:function(){return Dd.methods.init.call(this)},deinit:function(){return Dd.methods.deinit.call(this)},mount:function(){this.$interactionsContainer&&this.$interactionsContainer.addInteraction(this),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.$interactionsContainer&&this.$interactionsContainer.removeInteraction(this)},refresh:function(){return Dd.methods.refresh.call(this)}},ig={mixins:[Dd,$d],props:tg,methods:eg,watch:{active:function(t){this.$interaction&&t!==this.$interaction.getActive()&&this.$interaction.setActive(t)},priority:function(t){this.$interactionsContainer&&(this.$interaction.set("priority",t),this.$interactionsContainer.sortInteractions())}},stubVNode:{empty:function(){return this.$options.name}},created:function(){var t=this;Wc(this,{$interaction:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$interactionsContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.interactionsContainer}}})}},ng={ACTIVE:"active"},rg=function(t){function e(e){t.call(this),this.map_=null,this.setActive(!0),this.handleEvent=e.handleEvent}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getActive=function(){return this.get(ng.ACTIVE)},e.prototype.getMap=function(){return this.map_},e.prototype.setActive=function(t){this.set(ng.ACTIVE,t)},e.prototype.setMap=function(t){this.map_=t},e}(ko);function og(t,e,i,n){sg(t,e=t.constrainRotation(e,0),i,n)}function sg(t,e,i,n){if(void 0!==e){var r=t.getRotation(),o=t.getCenter();void 0!==r&&o&&n>0?t.animate({rotation:e,anchor:i,duration:n,easing:ar}):t.rotate(e,i)}}function ag(t,e,i,n,r){ug(t,e=t.constrainResolution(e,0,r),i,n)}function hg(t,e,i,n){var r=t.getResolution(),o=t.constrainResolution(r,e,0);if(void 0!==o){var s=t.getResolutions();o=Yn(o,t.getMinResolution()||s[s.length-1],t.getMaxResolution()||s[0])}if(i&&void 0!==o&&o!==r){var a=t.getCenter(),h=t.calculateCenterZoom(o,i);h=t.constrainCenter(h),i=[(o*a[0]-r*h[0])/(o-r),(o*a[1]-r*h[1])/(o-r)]}ug(t,o,i,n)}function ug(t,e,i,n){if(e){var r=t.getResolution(),o=t.getCenter();if(void 0!==r&&o&&e!==r&&n)t.animate({resolution:e,anchor:i,duration:n,easing:ar});else{if(i){var s=t.calculateCenterZoom(e,i);t.setCenter(s)}t.setResolution(e)}}}var lg={methods:{getInteractionsTarget:function(){throw new Error("Not implemented method")},addInteraction:function(e){Ch(e=e instanceof t?e.$interaction:e,rg),!1===this.getInteractionsTarget().has(e)&&(this.getInteractionsTarget().add(e),this.sortInteractions())},removeInteraction:function(e){(e=e instanceof t?e.$interaction:e)&&this.getInteractionsTarget().has(e)&&(this.getInteractionsTarget().remove(e),this.sortInteractions())},getInteractions:function(){return this.getInteractionsTarget().elements},getInteractionById:function(t){return this.getInteractionsTarget().findByKey(t)},sortInteractions:function(t){t||(t=this.getDefaultInteractionsSorter()),this.getInteractionsTarget().sort(t)},getDefaultInteractionsSorter:function(){return function(){return 0}},clearInteractions:function(){this.getInteractionsTarget().clear()},getServices:function(){var t=this;return{get interactionsContainer(){return t}}}}},cg={methods:{getSourceTarget:function(){throw new Error("Not implemented method")},getSource:function(){return this._source},getServices:function(){var t=this;return{get sourceContainer(){return t}}},setSource:function(e){(e=e instanceof t?e.$source:e)!==this._source&&(this._source=e);var i=this.getSourceTarget();i&&e!==i.getSource()&&i.setSource(e)}},created:function(){this._source=void 0}},pg={id:{type:[String,Number],default:function(){return ja()}},extent:{type:Array,validator:function(t){return 4===t.length}},minResolution:Number,maxResolution:Number,opacity:{type:Number,default:1},overlay:{type:Boolean,default:!1},visible:{type:Boolean,default:!0},zIndex:Number},fg={createOlObject:function(){var t=nd(_e.mark(function t(){var e;return _e.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:return t.next=2,this.createLayer();case 2:return(e=t.sent).set("id",this.id),t.abrupt("return",e);case 5:case"end":return t.stop()}},t,this)}));return function(){return t.apply(this,arguments)}}(),createLayer:function(){throw new Error("Not implemented method")},init:function(){return Dd.methods.init.call(this)},deinit:function(){return Dd.methods.deinit.call(this)},isAtPixel:function(t){var e=this;return Rh(this),this.$map.forEachLayerAtPixel(t,function(t){return t===e.$layer})},getServices:function(){var t=this;return af(Dd.methods.getServices.call(this),cg.methods.getServices.call(this),{get layer(){return t.$layer}})},getSourceTarget:function(){return this.$layer},mount:function(){this.overlay&&this.$map?this.setMap(this.$map):this.$layersContainer&&this.$layersContainer.addLayer(this),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.overlay?this.setMap(void 0):this.$layersContainer&&this.$layersContainer.removeLayer(this)},refresh:function(){return Dd.methods.refresh.call(this)},setMap:function(e){Ph(this),e=e instanceof t?e.$map:e,this.$layer.setMap(e)}},dg={mixins:[Dd,$d,cg],props:pg,methods:fg,watch:{id:function(t){this.$layer&&t!==this.$layer.get("id")&&this.$layer.set("id",t)},maxResolution:function(t){this.$layer&&t!==this.$layer.getMaxResolution()&&this.$layer.setMaxResolution(t)},minResolution:function(t){this.$layer&&t!==this.$layer.getMinResolution()&&this.$layer.setMinResolution(t)},opacity:function(t){this.$layer&&t!==this.$layer.getOpacity()&&this.$layer.setOpacity(t)},visible:function(t){this.$layer&&t!==this.$layer.getVisible()&&this.$layer.setVisible(t)},zIndex:function(t){this.$layer&&t!==this.$layer.getZIndex()&&this.$layer.setZIndex(t)},extent:function(t){this.$layer&&!zi(t,this.$layer.getExtent())&&this.$layer.setExtent(t)}},stubVNode:{attrs:function(){return{id:[this.$options.name,this.id].join("-"),class:this.$options.name}}},created:function(){var t=this;Wc(this,{$layer:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$layersContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.layersContainer}}})}},gg={OPACITY:"opacity",VISIBLE:"visible",EXTENT:"extent",Z_INDEX:"zIndex",MAX_RESOLUTION:"maxResolution",MIN_RESOLUTION:"minResolution",SOURCE:"source"},yg=function(t){function e(e){t.call(this);var i=qn({},e);i[gg.OPACITY]=void 0!==e.opacity?e.opacity:1,i[gg.VISIBLE]=void 0===e.visible||e.visible,i[gg.Z_INDEX]=e.zIndex,i[gg.MAX_RESOLUTION]=void 0!==e.maxResolution?e.maxResolution:1/0,i[gg.MIN_RESOLUTION]=void 0!==e.minResolution?e.minResolution:0,this.setProperties(i),this.state_={layer:this,managed:!0},this.type}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getType=function(){return this.type},e.prototype.getLayerState=function(){return this.state_.opacity=Yn(this.getOpacity(),0,1),this.state_.sourceState=this.getSourceState(),this.state_.visible=this.getVisible(),this.state_.extent=this.getExtent(),this.state_.zIndex=this.getZIndex()||0,this.state_.maxResolution=this.getMaxResolution(),this.state_.minResolution=Math.max(this.getMinResolution(),0),this.state_},e.prototype.getLayersArray=function(t){},e.prototype.getLayerStatesArray=function(t){},e.prototype.getExtent=function(){return this.get(gg.EXTENT)},e.prototype.getMaxResolution=function(){return this.get(gg.MAX_RESOLUTION)},e.prototype.getMinResolution=function(){return this.get(gg.MIN_RESOLUTION)},e.prototype.getOpacity=function(){return this.get(gg.OPACITY)},e.prototype.getSourceState=function(){},e.prototype.getVisible=function(){return this.get(gg.VISIBLE)},e.prototype.getZIndex=function(){return this.get(gg.Z_INDEX)},e.prototype.setExtent=function(t){this.set(gg.EXTENT,t)},e.prototype.setMaxResolution=function(t){this.set(gg.MAX_RESOLUTION,t)},e.prototype.setMinResolution=function(t){this.set(gg.MIN_RESOLUTION,t)},e.prototype.setOpacity=function(t){this.set(gg.OPACITY,t)},e.prototype.setVisible=function(t){this.set(gg.VISIBLE,t)},e.prototype.setZIndex=function(t){this.set(gg.Z_INDEX,t)},e}(ko),vg={methods:{getLayersTarget:function(){throw new Error("Not implemented method")},addLayer:function(e){Ch(e=e instanceof t?e.$layer:e,yg),!1===this.getLayersTarget().has(e)&&this.getLayersTarget().add(e)},removeLayer:function(e){(e=e instanceof t?e.$layer:e)&&this.getLayersTarget().has(e)&&this.getLayersTarget().remove(e)},getLayers:function(){return this.getLayersTarget().elements},getLayerById:function(t){return this.getLayersTarget().findByKey(t)},clearLayers:function(){this.getLayersTarget().clear()},getServices:function(){var t=this;return{get layersContainer(){return t}}}}},_g={POSTRENDER:"postrender",MOVESTART:"movestart",MOVEEND:"moveend"},mg={BOTTOM_LEFT:"bottom-left",BOTTOM_CENTER:"bottom-center",BOTTOM_RIGHT:"bottom-right",CENTER_LEFT:"center-left",CENTER_CENTER:"center-center",CENTER_RIGHT:"center-right",TOP_LEFT:"top-left",TOP_CENTER:"top-center",TOP_RIGHT:"top-right"},Eg={ELEMENT:"element",MAP:"map",OFFSET:"offset",POSITION:"position",POSITIONING:"positioning"},xg=function(t){function e(e){t.call(this),this.options=e,this.id=e.id,this.insertFirst=void 0===e.insertFirst||e.insertFirst,this.stopEvent=void 0===e.stopEvent||e.stopEvent,this.element=document.createElement("div"),this.element.className=void 0!==e.className?e.className:"ol-overlay-container "+Qs,this.element.style.position="absolute",this.autoPan=void 0!==e.autoPan&&e.autoPan,this.autoPanAnimation=e.autoPanAnimation||{},this.autoPanMargin=void 0!==e.autoPanMargin?e.autoPanMargin:20,this.rendered={bottom_:"",left_:"",right_:"",top_:"",visible:!0},this.mapPostrenderListenerKey=null,gr(this,Go(Eg.ELEMENT),this.handleElementChanged,this),gr(this,Go(Eg.MAP),this.handleMapChanged,this),gr(this,Go(Eg.OFFSET),this.handleOffsetChanged,this),gr(this,Go(Eg.POSITION),this.handlePositionChanged,this),gr(this,Go(Eg.POSITIONING),this.handlePositioningChanged,this),void 0!==e.element&&this.setElement(e.element),this.setOffset(void 0!==e.offset?e.offset:[0,0]),this.setPositioning(void 0!==e.positioning?e.positioning:mg.TOP_LEFT),void 0!==e.position&&this.setPosition(e.position)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getElement=function(){return this.get(Eg.ELEMENT)},e.prototype.getId=function(){return this.id},e.prototype.getMap=function(){return this.get(Eg.MAP)},e.prototype.getOffset=function(){return this.get(Eg.OFFSET)},e.prototype.getPosition=function(){return this.get(Eg.POSITION)},e.prototype.getPositioning=function(){return this.get(Eg.POSITIONING)},e.prototype.handleElementChanged=function(){Pr(this.element);var t=this.getElement();t&&this.element.appendChild(t)},e.prototype.handleMapChanged=function(){this.mapPostrenderListenerKey&&(Ir(this.element),_r(this.mapPostrenderListenerKey),this.mapPostrenderListenerKey=null);var t=this.getMap();if(t){this.mapPostrenderListenerKey=gr(t,_g.POSTRENDER,this.render,this),this.updatePixelPosition();var e=this.stopEvent?t.getOverlayContainerStopEvent():t.getOverlayContainer();this.insertFirst?e.insertBefore(this.element,e.childNodes[0]||null):e.appendChild(this.element)}},e.prototype.render=function(){this.updatePixelPosition()},e.prototype.handleOffsetChanged=function(){this.updatePixelPosition()},e.prototype.handlePositionChanged=function(){this.updatePixelPosition(),this.get(Eg.POSITION)&&this.autoPan&&this.panIntoView()},e.prototype.handlePositioningChanged=function(){this.updatePixelPosition()},e.prototype.setElement=function(t){this.set(Eg.ELEMENT,t)},e.prototype.setMap=function(t){this.set(Eg.MAP,t)},e.prototype.setOffset=function(t){this.set(Eg.OFFSET,t)},e.prototype.setPosition=function(t){this.set(Eg.POSITION,t)},e.prototype.panIntoView=function(){var t=this.getMap();if(t&&t.getTargetElement()){var e=this.getRect(t.getTargetElement(),t.getSize()),i=this.getElement(),n=this.getRect(i,[function(t){var e=t.offsetWidth,i=getComputedStyle(t);return e+=parseInt(i.marginLeft,10)+parseInt(i.marginRight,10)}(i),function(t){var e=t.offsetHeight,i=getComputedStyle(t);return e+=parseInt(i.marginTop,10)+parseInt(i.marginBottom,10)}(i)]),r=this.autoPanMargin;if(!dn(e,n)){var o=n[0]-e[0],s=e[2]-n[2],a=n[1]-e[1],h=e[3]-n[3],u=[0,0];if(o<0?u[0]=o-r:s<0&&(u[0]=Math.abs(s)+r),a<0?u[1]=a-r:h<0&&(u[1]=Math.abs(h)+r),0!==u[0]||0!==u[1]){var l=t.getView().getCenter(),c=t.getPixelFromCoordinate(l),p=[c[0]+u[0],c[1]+u[1]];t.getView().animate({center:t.getCoordinateFromPixel(p),duration:this.autoPanAnimation.duration,easing:this.autoPanAnimation.easing})}}}},e.prototype.getRect=function(t,e){var i=t.getBoundingClientRect(),n=i.left+window.pageXOffset,r=i.top+window.pageYOffset;return[n,r,n+e[0],r+e[1]]},e.prototype.setPositioning=function(t){this.set(Eg.POSITIONING,t)},e.prototype.setVisible=function(t){this.rendered.visible!==t&&(this.element.style.display=t?"":"none",this.rendered.visible=t)},e.prototype.updatePixelPosition=function(){var t=this.getMap(),e=this.getPosition();if(t&&t.isRendered()&&e){var i=t.getPixelFromCoordinate(e),n=t.getSize();this.updateRenderedPosition(i,n)}else this.setVisible(!1)},e.prototype.updateRenderedPosition=function(t,e){var i=this.element.style,n=this.getOffset(),r=this.getPositioning();this.setVisible(!0);var o=n[0],s=n[1];if(r==mg.BOTTOM_RIGHT||r==mg.CENTER_RIGHT||r==mg.TOP_RIGHT){""!==this.rendered.left_&&(this.rendered.left_=i.left="");var a=Math.round(e[0]-t[0]-o)+"px";this.rendered.right_!=a&&(this.rendered.right_=i.right=a)}else{""!==this.rendered.right_&&(this.rendered.right_=i.right=""),r!=mg.BOTTOM_CENTER&&r!=mg.CENTER_CENTER&&r!=mg.TOP_CENTER||(o-=this.element.offsetWidth/2);var h=Math.round(t[0]+o)+"px";this.rendered.left_!=h&&(this.rendered.left_=i.left=h)}if(r==mg.BOTTOM_LEFT||r==mg.BOTTOM_CENTER||r==mg.BOTTOM_RIGHT){""!==this.rendered.top_&&(this.rendered.top_=i.top="");var u=Math.round(e[1]-t[1]-s)+"px";this.rendered.bottom_!=u&&(this.rendered.bottom_=i.bottom=u)}else{""!==this.rendered.bottom_&&(this.rendered.bottom_=i.bottom=""),r!=mg.CENTER_LEFT&&r!=mg.CENTER_CENTER&&r!=mg.CENTER_RIGHT||(s-=this.element.offsetHeight/2);var l=Math.round(t[1]+s)+"px";this.rendered.top_!=l&&(this.rendered.top_=i.top=l)}},e.prototype.getOptions=function(){return this.options},e}(ko),Sg={methods:{getOverlaysTarget:function(){throw new Error("Not implemented method")},addOverlay:function(e){Ch(e=e instanceof t?e.$overlay:e,xg),!1===this.getOverlaysTarget().has(e)&&this.getOverlaysTarget().add(e)},removeOverlay:function(e){(e=e instanceof t?e.$overlay:e)&&this.getOverlaysTarget().has(e)&&this.getOverlaysTarget().remove(e)},getOverlays:function(){return this.getOverlaysTarget().elements},getOverlayById:function(t){return this.getOverlaysTarget().findByKey(t)},clearOverlays:function(){this.getOverlaysTarget().clear()},getServices:function(){var t=this;return{get overlaysContainer(){return t}}}}},Tg={created:function(){this._styles=void 0},methods:{getDefaultStyles:function(){},getServices:function(){var t=this;return{get stylesContainer(){return t}}},getStyles:function(){return this._styles},addStyle:function(e){var i=this.getStyles();Ni(e instanceof t?e.$style:e)?i=e:(ti(i)||(i=[]),e=e instanceof t?e:{$style:e,condition:!0},i.includes(e)||i.push(e)),this.setStyle(i)},setStyle:function(t){if(t!==this._styles){this._styles=t;var e=this.getStyleTarget();e&&(null===this._styles||this._styles?e.setStyle(this.createStyleFunc()):e.setStyle(void 0))}},removeStyle:function(e){var i=this.getStyles();i===e?i=void 0:ti(i)&&((i=i.filter(function(i){return e instanceof t?i!==e:i.$style!==e})).length||(i=void 0)),this.setStyle(i)},getStyleTarget:function(){throw new Error("Not implemented method")},createStyleFunc:function(){var t=this,e=this.getDefaultStyles();return function(i,n){if(i.getGeometry()){var r=t.getStyles();if(r&&(Ni(r)||Ni(r.$style)))r=(Ni(r)?r:r.$style)(i,n);else ti(r)&&(r=Bi(r,function(t,e){var r=e.$style,o=e.condition;return(null==o||!0===o||Ni(o)&&o(i,n))&&t.push(r),t},[]));return null===r||ti(r)&&r.length?r:(r=e)?Ni(r)?r(i,n):r:void 0}}}}};
-/**
-   * This file is part of ol-tilecache package.
-   * @module ol-tilecache
-   * @license MIT
-   * @author Vladimir Vershinin
-   */
-function wg(t,e){var i=e-t.toString().length+1;return(new Array(parseInt(i>0&&i,10)).join("0")+t).toString().slice(-e)}var bg=function(){return function(t,e){if(Array.isArray(t))return t;if(Symbol.iterator in Object(t))return function(t,e){var i=[],n=!0,r=!1,o=void 0;try{for(var s,a=t[Symbol.iterator]();!(n=(s=a.next()).done)&&(i.push(s.value),!e||i.length!==e);n=!0);}catch(t){r=!0,o=t}finally{try{!n&&a.return&&a.return()}finally{if(r)throw o}}return i}(t,e);throw new TypeError("Invalid attempt to destructure non-iterable instance")}}();
-/**
-   * This file is part of ol-tilecache package.
-   * @module ol-tilecache
-   * @license MIT
-   * @author Vladimir Vershinin
-   */function Cg(t,e,i,n,r){var o,s=t.getZForResolution(n),a=n/t.getResolution(s),h=t.getOrigin(s),u=t.getTileSize(s);o=u,"[object Array]"!==Object.prototype.toString.call(o)&&(u=[u,u]);var l=r?.5:0,c=r?0:.5,p=Math.floor((e-h[0])/n+l),f=Math.floor((i-h[1])/n+c),d=a*p/u[0],g=a*f/u[1];return r?(d=Math.ceil(d)-1,g=Math.ceil(g)-1):(d=Math.floor(d),g=Math.floor(g)),[d,g]}
-/**
-   * This file is part of ol-tilecache package.
-   * @module ol-tilecache
-   * @license MIT
-   * @author Vladimir Vershinin
-   */
-var Rg=/{z}/g,Og=/{0z}/g,Ig=/{x\d?}/g,Pg=/{y\d?}/g,Fg=/{-y\d?}/g,Lg=[-20037508.342789244,-20037508.342789244,20037508.342789244,20037508.342789244];function Mg(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:es(),i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Lg;return Ng(function(t){var e=[],i=/{(\d)-(\d)}/.exec(t)||/{([a-z])-([a-z])}/.exec(t);if(i)for(var n=i[1].charCodeAt(0),r=i[2].charCodeAt(0),o=n;o<=r;++o)e.push(t.replace(i[0],String.fromCharCode(o)));else e.push(t);return e}(t),e,i)}function Ag(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:es(),i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Lg;return function(n){if(null!=n)return t.replace(Rg,kg(n[0])).replace(Og,kg(n[0],!0)).replace(Ig,jg(n[1])).replace(Pg,function(t){return jg(-n[2]-1)(t)}).replace(Fg,function(t){var r,o=n[0],s=function(t,e,i){var n=t.getResolution(i),r=Cg(t,e[0],e[1],n,!1),o=bg(r,2),s=o[0],a=o[1],h=Cg(t,e[2],e[3],n,!0),u=bg(h,2);return{minX:s,minY:a,maxX:u[0],maxY:u[1]}}(e,i,o);return jg((r=s).maxY-r.minY+1+n[2])(t)})}}function Ng(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:es(),i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Lg;return function(t){if(1===t.length)return t[0];return function(e,i,n){if(null!=e){var r=(e[1]<<e[0])+e[2],o=(s=r,a=t.length,(h=s%a)*a<0?h+a:h);return t[o](e,i,n)}var s,a,h}}
-/**
-   * This file is part of ol-tilecache package.
-   * @module ol-tilecache
-   * @license MIT
-   * @author Vladimir Vershinin
-   */(t.map(function(t){return Ag(t,e,i)}))}function kg(t,e){return function(){return e?wg(t,2):t.toString()}}function jg(t){return function(e){var i=e.match(/\d/);return i?wg(t,9).slice(3*(i[0]-1),3*i[0]):t.toString()}}function Gg(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Gh,i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Dh;i=nr(i);for(var n=An(t),r=Gn(t),o=Math.max(r/i[0],n/i[1]),s=e+1,a=new Array(s),h=0;h<s;++h)a[h]=o/Math.pow(2,h);return a}function Dg(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Gh,i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Dh,n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:zh.TOP_LEFT,r=Gg(t,e,i);return new Jo({extent:t,origin:Up(t,n),resolutions:r,tileSize:i})}"undefined"!=typeof window&&window.ol&&(window.ol.tileCacheUrlFn={createTileUrlFunction:Mg,createTileUrlFunctionFromTemplate:Ag,createTileUrlFunctionFromTemplates:Ng});var $g={props:{url:{type:String}},computed:{urlTmpl:function(){return Vi(this.url,Zi(this,this.urlTokens))},urlTokens:function(){return[]}}},Vg={cacheSize:{type:Number,default:2048},crossOrigin:String,maxZoom:{type:Number,default:Gh},minZoom:{type:Number,default:0},opaque:Boolean,projection:{type:String,default:jh},reprojectionErrorThreshold:{type:Number,default:.5},tilePixelRatio:{type:Number,default:1},tileSize:{type:Array,default:function(){return[Dh,Dh]},validator:function(t){return 2===t.length}},tileLoadFunction:Function,url:{type:[String,Function],required:!0},transition:Number},zg={mixins:[Bd,$g],props:Vg,computed:{urlTmpl:function(){return Fi(this.url)?Vi(this.url,Zi(this,this.urlTokens)):""}},methods:{createTileGrid:function(){return Oh(this),es({extent:Bp(this.$view.getProjection()),maxZoom:this.maxZoom,minZoom:this.minZoom,tileSize:this.tileSize})},createUrlFunc:function(){return Ni(this.url)?this.url:(Oh(this),Mg(this.urlTmpl,this._tileGrid,Bp(this.$view.getProjection())))},init:function(){return this._tileGrid=this.createTileGrid(),Bd.methods.init.call(this)},deinit:function(){return this._tileGrid=void 0,Bd.methods.deinit.call(this)},mount:function(){Bd.methods.mount.call(this)},unmount:function(){Bd.methods.mount.call(this)}},watch:{url:function(){this.$source&&(this.$source.setTileUrlFunction(this.createUrlFunc()),this.scheduleRefresh())}}},Yg={updateWhileAnimating:Boolean,updateWhileInteracting:Boolean,renderBuffer:{type:Number,default:100},renderOrder:Function,declutter:Boolean},Xg={mixins:[dg,Tg],props:Yg,methods:{init:function(){return dg.methods.init.call(this)},deinit:function(){return dg.methods.deinit.call(this)},getServices:function(){return af(dg.methods.getServices.call(this),Tg.methods.getServices.call(this))},getStyleTarget:function(){return this.$layer},mount:function(){dg.methods.mount.call(this)},unmount:function(){dg.methods.unmount.call(this)}}};var Ug=function(){function t(t,e){this.dueTime=t,this.scheduler=e}return t.prototype.call=function(t,e){return e.subscribe(new Bg(t,this.dueTime,this.scheduler))},t}(),Bg=function(t){function e(e,i,n){var r=t.call(this,e)||this;return r.dueTime=i,r.scheduler=n,r.debouncedSubscription=null,r.lastValue=null,r.hasValue=!1,r}return Zc(e,t),e.prototype._next=function(t){this.clearDebounce(),this.lastValue=t,this.hasValue=!0,this.add(this.debouncedSubscription=this.scheduler.schedule(Kg,this.dueTime,this))},e.prototype._complete=function(){this.debouncedNext(),this.destination.complete()},e.prototype.debouncedNext=function(){if(this.clearDebounce(),this.hasValue){var t=this.lastValue;this.lastValue=null,this.hasValue=!1,this.destination.next(t)}},e.prototype.clearDebounce=function(){var t=this.debouncedSubscription;null!==t&&(this.remove(t),t.unsubscribe(),this.debouncedSubscription=null)},e}(fp);function Kg(t){t.debouncedNext()}var Wg=function(){function t(e,i){var n=this;$a(this,t),this._adaptee=e,this._getElementKey=i,this._index=Wa(null),this._adaptee.forEach(function(t){return n._addToIndex(n._getElementKey(t),t)})}return Bh(t,[{key:"forEach",value:function(t){Ui(this.elements,t)}},{key:"add",value:function(t){var e=this._getElementKey(t);null!=e&&(this._adaptee.push(t),this._index[e]=t)}},{key:"remove",value:function(t){this._adaptee.remove(t)&&this._removeFromIndex(this._getElementKey(t))}},{key:"has",value:function(t){return!!this.findByKey(this._getElementKey(t))}},{key:"clear",value:function(){this._adaptee.clear(),this._resetIndex()}},{key:"findByKey",value:function(t){return t&&this._index[t]}},{key:"sort",value:function(t){this.elements.sort(t)}},{key:"_resetIndex",value:function(){this._index=Wa(null)}},{key:"_addToIndex",value:function(t,e){return null!=t&&(this._index[t]=e,e.on("propertychange",this._handleElementChange,this),!0)}},{key:"_removeFromIndex",value:function(t){var e=this.findByKey(t);return e&&(e.un("propertychange",this._handleElementChange,this),delete this._index[t]),!!e}},{key:"_handleElementChange",value:function(t){var e=t.target,i=this._getElementKey(e);if(this.findByKey(i)!==e)for(var n in this._index)if(this._index[n]===e){this._removeFromIndex(n);break}this._addToIndex(i,e)}},{key:"adaptee",get:function(){return this._adaptee}},{key:"elements",get:function(){return this._adaptee.getArray()}}]),t}(),Hg=function(){function t(e){$a(this,t),this._adaptee=e}return Bh(t,[{key:"forEach",value:function(t){this.elements.forEach(t)}},{key:"add",value:function(t){this._adaptee.addFeature(t)}},{key:"remove",value:function(t){this._adaptee.removeFeature(t)}},{key:"has",value:function(t){return!!this.findByKey(t.getId())}},{key:"clear",value:function(){this._adaptee.clear()}},{key:"findByKey",value:function(t){return this._adaptee.getFeatureById(t)}},{key:"sort",value:function(t){throw new Error("Not supported")}},{key:"adaptee",get:function(){return this._adaptee}},{key:"elements",get:function(){return this._adaptee.getFeatures()}}]),t}(),Zg={useSpatialIndex:{type:Boolean,default:!0}},qg={mixins:[Bd,Bc,Uc],props:Zg,computed:{featuresViewProj:function(){return this.rev&&this.resolvedDataProjection&&this.$source?this.getFeatures().map(this.writeFeatureInViewProj.bind(this)):[]}},methods:{clear:function(){Bc.methods.clearFeatures.call(this)},getFeaturesTarget:function(){return null==this._featuresTarget&&this.$source&&(this._featuresTarget=new Hg(this.$source)),this._featuresTarget},getServices:function(){return af(Bd.methods.getServices.call(this),Bc.methods.getServices.call(this))},init:function(){return Bd.methods.init.call(this)},deinit:function(){return Bd.methods.deinit.call(this)},mount:function(){Bd.methods.mount.call(this)},unmount:function(){this.clear(),Bd.methods.unmount.call(this)},subscribeAll:function(){(function(){var t=this;Fh(this);var e=rf(this.$source,"addfeature").pipe(_d(function(e){var i=e.feature;t.addFeature(i)})),i=rf(this.$source,"removefeature").pipe(_d(function(e){var i=e.feature;t.removeFeature(i)})),n=rf(this.$source,"changefeature"),r=nf(e,i,n);this.subscribeTo(r,function(e){++t.rev,t.$emit(e.type,e)}),this.subscribeTo(r.pipe((o=100,void 0===s&&(s=Tp),function(t){return t.lift(new Ug(o,s))})),function(){t.$emit("update:features",t.getFeatures().map(t.writeFeatureInDataProj.bind(t)))});var o,s}).call(this)},writeFeatureInDataProj:function(t){return Uc.methods.writeFeatureInDataProj.call(this,t)},writeGeometryInViewProj:function(t){return Uc.methods.writeFeatureInViewProj.call(this,t)}},stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}}};var Jg={methods:{setFill:function(e){(e=e instanceof t?e.$style:e)!==this._fill&&(this._fill=e),this.$style&&e!==this.$style.getFill()&&(this.$style.setFill(e),this.scheduleRefresh())},setStroke:function(e){(e=e instanceof t?e.$style:e)!==this._stroke&&(this._stroke=e),this.$style&&e!==this.$style.getStroke()&&(this.$style.setStroke(e),this.scheduleRefresh())}},stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}},created:function(){this._fill=void 0,this._stroke=void 0}};function Qg(t,e){return[[-1/0,-1/0,1/0,1/0]]}var ty={FRACTION:"fraction",PIXELS:"pixels"},ey=function(){this.cache_={},this.cacheSize_=0,this.maxCacheSize_=32};function iy(t,e,i){return e+":"+t+":"+(i?Ms(i):"null")}ey.prototype.clear=function(){this.cache_={},this.cacheSize_=0},ey.prototype.expire=function(){if(this.cacheSize_>this.maxCacheSize_){var t=0;for(var e in this.cache_){var i=this.cache_[e];0!=(3&t++)||i.hasListener()||(delete this.cache_[e],--this.cacheSize_)}}},ey.prototype.get=function(t,e,i){var n=iy(t,e,i);return n in this.cache_?this.cache_[n]:null},ey.prototype.set=function(t,e,i,n){var r=iy(t,e,i);this.cache_[r]=n,++this.cacheSize_},ey.prototype.setSize=function(t){this.maxCacheSize_=t,this.expire()};var ny=new ey,ry=function(t){function e(e,i,n,r,o,s){t.call(this),this.hitDetectionImage_=null,this.image_=e||new Image,null!==r&&(this.image_.crossOrigin=r),this.canvas_=s?document.createElement("canvas"):null,this.color_=s,this.imageListenerKeys_=null,this.imageState_=o,this.size_=n,this.src_=i,this.tainting_=!1,this.imageState_==qs.LOADED&&this.determineTainting_()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.determineTainting_=function(){var t=Rr(1,1);try{t.drawImage(this.image_,0,0),t.getImageData(0,0,1,1)}catch(t){this.tainting_=!0}},e.prototype.dispatchChangeEvent_=function(){this.dispatchEvent(br.CHANGE)},e.prototype.handleImageError_=function(){this.imageState_=qs.ERROR,this.unlistenImage_(),this.dispatchChangeEvent_()},e.prototype.handleImageLoad_=function(){this.imageState_=qs.LOADED,this.size_&&(this.image_.width=this.size_[0],this.image_.height=this.size_[1]),this.size_=[this.image_.width,this.image_.height],this.unlistenImage_(),this.determineTainting_(),this.replaceColor_(),this.dispatchChangeEvent_()},e.prototype.getImage=function(t){return this.canvas_?this.canvas_:this.image_},e.prototype.getImageState=function(){return this.imageState_},e.prototype.getHitDetectionImage=function(t){if(!this.hitDetectionImage_)if(this.tainting_){var e=this.size_[0],i=this.size_[1],n=Rr(e,i);n.fillRect(0,0,e,i),this.hitDetectionImage_=n.canvas}else this.hitDetectionImage_=this.image_;return this.hitDetectionImage_},e.prototype.getSize=function(){return this.size_},e.prototype.getSrc=function(){return this.src_},e.prototype.load=function(){if(this.imageState_==qs.IDLE){this.imageState_=qs.LOADING,this.imageListenerKeys_=[yr(this.image_,br.ERROR,this.handleImageError_,this),yr(this.image_,br.LOAD,this.handleImageLoad_,this)];try{this.image_.src=this.src_}catch(t){this.handleImageError_()}}},e.prototype.replaceColor_=function(){if(!this.tainting_&&null!==this.color_){this.canvas_.width=this.image_.width,this.canvas_.height=this.image_.height;var t=this.canvas_.getContext("2d");t.drawImage(this.image_,0,0);for(var e=t.getImageData(0,0,this.image_.width,this.image_.height),i=e.data,n=this.color_[0]/255,r=this.color_[1]/255,o=this.color_[2]/255,s=0,a=i.length;s<a;s+=4)i[s]*=n,i[s+1]*=r,i[s+2]*=o;t.putImageData(e,0,0)}},e.prototype.unlistenImage_=function(){this.imageListenerKeys_.forEach(_r),this.imageListenerKeys_=null},e}(wr);var oy={BOTTOM_LEFT:"bottom-left",BOTTOM_RIGHT:"bottom-right",TOP_LEFT:"top-left",TOP_RIGHT:"top-right"},sy=function(t){function e(e){var i=e||{},n=void 0!==i.opacity?i.opacity:1,r=void 0!==i.rotation?i.rotation:0,o=void 0!==i.scale?i.scale:1,s=void 0!==i.rotateWithView&&i.rotateWithView;t.call(this,{opacity:n,rotation:r,scale:o,rotateWithView:s}),this.anchor_=void 0!==i.anchor?i.anchor:[.5,.5],this.normalizedAnchor_=null,this.anchorOrigin_=void 0!==i.anchorOrigin?i.anchorOrigin:oy.TOP_LEFT,this.anchorXUnits_=void 0!==i.anchorXUnits?i.anchorXUnits:ty.FRACTION,this.anchorYUnits_=void 0!==i.anchorYUnits?i.anchorYUnits:ty.FRACTION,this.crossOrigin_=void 0!==i.crossOrigin?i.crossOrigin:null;var a=void 0!==i.img?i.img:null,h=void 0!==i.imgSize?i.imgSize:null,u=i.src;sn(!(void 0!==u&&a),4),sn(!a||a&&h,5),void 0!==u&&0!==u.length||!a||(u=a.src||nn(a).toString()),sn(void 0!==u&&u.length>0,6);var l,c=void 0!==i.src?qs.IDLE:qs.LOADED;this.color_=void 0!==i.color?(l=i.color,Array.isArray(l)?l:As(l)):null,this.iconImage_=function(t,e,i,n,r,o){var s=ny.get(e,n,o);return s||(s=new ry(t,e,i,n,r,o),ny.set(e,n,o,s)),s}(a,u,h,this.crossOrigin_,c,this.color_),this.offset_=void 0!==i.offset?i.offset:[0,0],this.offsetOrigin_=void 0!==i.offsetOrigin?i.offsetOrigin:oy.TOP_LEFT,this.origin_=null,this.size_=void 0!==i.size?i.size:null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clone=function(){return new e({anchor:this.anchor_.slice(),anchorOrigin:this.anchorOrigin_,anchorXUnits:this.anchorXUnits_,anchorYUnits:this.anchorYUnits_,crossOrigin:this.crossOrigin_,color:this.color_&&this.color_.slice?this.color_.slice():this.color_||void 0,src:this.getSrc(),offset:this.offset_.slice(),offsetOrigin:this.offsetOrigin_,size:null!==this.size_?this.size_.slice():void 0,opacity:this.getOpacity(),scale:this.getScale(),rotation:this.getRotation(),rotateWithView:this.getRotateWithView()})},e.prototype.getAnchor=function(){if(this.normalizedAnchor_)return this.normalizedAnchor_;var t=this.anchor_,e=this.getSize();if(this.anchorXUnits_==ty.FRACTION||this.anchorYUnits_==ty.FRACTION){if(!e)return null;t=this.anchor_.slice(),this.anchorXUnits_==ty.FRACTION&&(t[0]*=e[0]),this.anchorYUnits_==ty.FRACTION&&(t[1]*=e[1])}if(this.anchorOrigin_!=oy.TOP_LEFT){if(!e)return null;t===this.anchor_&&(t=this.anchor_.slice()),this.anchorOrigin_!=oy.TOP_RIGHT&&this.anchorOrigin_!=oy.BOTTOM_RIGHT||(t[0]=-t[0]+e[0]),this.anchorOrigin_!=oy.BOTTOM_LEFT&&this.anchorOrigin_!=oy.BOTTOM_RIGHT||(t[1]=-t[1]+e[1])}return this.normalizedAnchor_=t,this.normalizedAnchor_},e.prototype.setAnchor=function(t){this.anchor_=t,this.normalizedAnchor_=null},e.prototype.getColor=function(){return this.color_},e.prototype.getImage=function(t){return this.iconImage_.getImage(t)},e.prototype.getImageSize=function(){return this.iconImage_.getSize()},e.prototype.getHitDetectionImageSize=function(){return this.getImageSize()},e.prototype.getImageState=function(){return this.iconImage_.getImageState()},e.prototype.getHitDetectionImage=function(t){return this.iconImage_.getHitDetectionImage(t)},e.prototype.getOrigin=function(){if(this.origin_)return this.origin_;var t=this.offset_;if(this.offsetOrigin_!=oy.TOP_LEFT){var e=this.getSize(),i=this.iconImage_.getSize();if(!e||!i)return null;t=t.slice(),this.offsetOrigin_!=oy.TOP_RIGHT&&this.offsetOrigin_!=oy.BOTTOM_RIGHT||(t[0]=i[0]-e[0]-t[0]),this.offsetOrigin_!=oy.BOTTOM_LEFT&&this.offsetOrigin_!=oy.BOTTOM_RIGHT||(t[1]=i[1]-e[1]-t[1])}return this.origin_=t,this.origin_},e.prototype.getSrc=function(){return this.iconImage_.getSrc()},e.prototype.getSize=function(){return this.size_?this.size_:this.iconImage_.getSize()},e.prototype.listenImageChange=function(t,e){return gr(this.iconImage_,br.CHANGE,t,e)},e.prototype.load=function(){this.iconImage_.load()},e.prototype.unlistenImageChange=function(t,e){vr(this.iconImage_,br.CHANGE,t,e)},e}(Sa),ay="point",hy="line",uy=function(t){var e=t||{};this.font_=e.font,this.rotation_=e.rotation,this.rotateWithView_=e.rotateWithView,this.scale_=e.scale,this.text_=e.text,this.textAlign_=e.textAlign,this.textBaseline_=e.textBaseline,this.fill_=void 0!==e.fill?e.fill:new ba({color:"#333"}),this.maxAngle_=void 0!==e.maxAngle?e.maxAngle:Math.PI/4,this.placement_=void 0!==e.placement?e.placement:ay,this.overflow_=!!e.overflow,this.stroke_=void 0!==e.stroke?e.stroke:null,this.offsetX_=void 0!==e.offsetX?e.offsetX:0,this.offsetY_=void 0!==e.offsetY?e.offsetY:0,this.backgroundFill_=e.backgroundFill?e.backgroundFill:null,this.backgroundStroke_=e.backgroundStroke?e.backgroundStroke:null,this.padding_=void 0===e.padding?null:e.padding};uy.prototype.clone=function(){return new uy({font:this.getFont(),placement:this.getPlacement(),maxAngle:this.getMaxAngle(),overflow:this.getOverflow(),rotation:this.getRotation(),rotateWithView:this.getRotateWithView(),scale:this.getScale(),text:this.getText(),textAlign:this.getTextAlign(),textBaseline:this.getTextBaseline(),fill:this.getFill()?this.getFill().clone():void 0,stroke:this.getStroke()?this.getStroke().clone():void 0,offsetX:this.getOffsetX(),offsetY:this.getOffsetY(),backgroundFill:this.getBackgroundFill()?this.getBackgroundFill().clone():void 0,backgroundStroke:this.getBackgroundStroke()?this.getBackgroundStroke().clone():void 0})},uy.prototype.getOverflow=function(){return this.overflow_},uy.prototype.getFont=function(){return this.font_},uy.prototype.getMaxAngle=function(){return this.maxAngle_},uy.prototype.getPlacement=function(){return this.placement_},uy.prototype.getOffsetX=function(){return this.offsetX_},uy.prototype.getOffsetY=function(){return this.offsetY_},uy.prototype.getFill=function(){return this.fill_},uy.prototype.getRotateWithView=function(){return this.rotateWithView_},uy.prototype.getRotation=function(){return this.rotation_},uy.prototype.getScale=function(){return this.scale_},uy.prototype.getStroke=function(){return this.stroke_},uy.prototype.getText=function(){return this.text_},uy.prototype.getTextAlign=function(){return this.textAlign_},uy.prototype.getTextBaseline=function(){return this.textBaseline_},uy.prototype.getBackgroundFill=function(){return this.backgroundFill_},uy.prototype.getBackgroundStroke=function(){return this.backgroundStroke_},uy.prototype.getPadding=function(){return this.padding_},uy.prototype.setOverflow=function(t){this.overflow_=t},uy.prototype.setFont=function(t){this.font_=t},uy.prototype.setMaxAngle=function(t){this.maxAngle_=t},uy.prototype.setOffsetX=function(t){this.offsetX_=t},uy.prototype.setOffsetY=function(t){this.offsetY_=t},uy.prototype.setPlacement=function(t){this.placement_=t},uy.prototype.setFill=function(t){this.fill_=t},uy.prototype.setRotation=function(t){this.rotation_=t},uy.prototype.setScale=function(t){this.scale_=t},uy.prototype.setStroke=function(t){this.stroke_=t},uy.prototype.setText=function(t){this.text_=t},uy.prototype.setTextAlign=function(t){this.textAlign_=t},uy.prototype.setTextBaseline=function(t){this.textBaseline_=t},uy.prototype.setBackgroundFill=function(t){this.backgroundFill_=t},uy.prototype.setBackgroundStroke=function(t){this.backgroundStroke_=t},uy.prototype.setPadding=function(t){this.padding_=t};var ly={rgb2hsl:cy,rgb2hsv:py,rgb2hwb:fy,rgb2cmyk:dy,rgb2keyword:gy,rgb2xyz:yy,rgb2lab:vy,rgb2lch:function(t){return by(vy(t))},hsl2rgb:_y,hsl2hsv:function(t){var e=t[0],i=t[1]/100,n=t[2]/100;if(0===n)return[0,0,0];return[e,100*(2*(i*=(n*=2)<=1?n:2-n)/(n+i)),100*((n+i)/2)]},hsl2hwb:function(t){return fy(_y(t))},hsl2cmyk:function(t){return dy(_y(t))},hsl2keyword:function(t){return gy(_y(t))},hsv2rgb:my,hsv2hsl:function(t){var e,i,n=t[0],r=t[1]/100,o=t[2]/100;return e=r*o,[n,100*(e=(e/=(i=(2-r)*o)<=1?i:2-i)||0),100*(i/=2)]},hsv2hwb:function(t){return fy(my(t))},hsv2cmyk:function(t){return dy(my(t))},hsv2keyword:function(t){return gy(my(t))},hwb2rgb:Ey,hwb2hsl:function(t){return cy(Ey(t))},hwb2hsv:function(t){return py(Ey(t))},hwb2cmyk:function(t){return dy(Ey(t))},hwb2keyword:function(t){return gy(Ey(t))},cmyk2rgb:xy,cmyk2hsl:function(t){return cy(xy(t))},cmyk2hsv:function(t){return py(xy(t))},cmyk2hwb:function(t){return fy(xy(t))},cmyk2keyword:function(t){return gy(xy(t))},keyword2rgb:Oy,keyword2hsl:function(t){return cy(Oy(t))},keyword2hsv:function(t){return py(Oy(t))},keyword2hwb:function(t){return fy(Oy(t))},keyword2cmyk:function(t){return dy(Oy(t))},keyword2lab:function(t){return vy(Oy(t))},keyword2xyz:function(t){return yy(Oy(t))},xyz2rgb:Sy,xyz2lab:Ty,xyz2lch:function(t){return by(Ty(t))},lab2xyz:wy,lab2rgb:Cy,lab2lch:by,lch2lab:Ry,lch2xyz:function(t){return wy(Ry(t))},lch2rgb:function(t){return Cy(Ry(t))}};function cy(t){var e,i,n=t[0]/255,r=t[1]/255,o=t[2]/255,s=Math.min(n,r,o),a=Math.max(n,r,o),h=a-s;return a==s?e=0:n==a?e=(r-o)/h:r==a?e=2+(o-n)/h:o==a&&(e=4+(n-r)/h),(e=Math.min(60*e,360))<0&&(e+=360),i=(s+a)/2,[e,100*(a==s?0:i<=.5?h/(a+s):h/(2-a-s)),100*i]}function py(t){var e,i,n=t[0],r=t[1],o=t[2],s=Math.min(n,r,o),a=Math.max(n,r,o),h=a-s;return i=0==a?0:h/a*1e3/10,a==s?e=0:n==a?e=(r-o)/h:r==a?e=2+(o-n)/h:o==a&&(e=4+(n-r)/h),(e=Math.min(60*e,360))<0&&(e+=360),[e,i,a/255*1e3/10]}function fy(t){var e=t[0],i=t[1],n=t[2];return[cy(t)[0],100*(1/255*Math.min(e,Math.min(i,n))),100*(n=1-1/255*Math.max(e,Math.max(i,n)))]}function dy(t){var e,i=t[0]/255,n=t[1]/255,r=t[2]/255;return[100*((1-i-(e=Math.min(1-i,1-n,1-r)))/(1-e)||0),100*((1-n-e)/(1-e)||0),100*((1-r-e)/(1-e)||0),100*e]}function gy(t){return Py[JSON.stringify(t)]}function yy(t){var e=t[0]/255,i=t[1]/255,n=t[2]/255;return[100*(.4124*(e=e>.04045?Math.pow((e+.055)/1.055,2.4):e/12.92)+.3576*(i=i>.04045?Math.pow((i+.055)/1.055,2.4):i/12.92)+.1805*(n=n>.04045?Math.pow((n+.055)/1.055,2.4):n/12.92)),100*(.2126*e+.7152*i+.0722*n),100*(.0193*e+.1192*i+.9505*n)]}function vy(t){var e=yy(t),i=e[0],n=e[1],r=e[2];return n/=100,r/=108.883,i=(i/=95.047)>.008856?Math.pow(i,1/3):7.787*i+16/116,[116*(n=n>.008856?Math.pow(n,1/3):7.787*n+16/116)-16,500*(i-n),200*(n-(r=r>.008856?Math.pow(r,1/3):7.787*r+16/116))]}function _y(t){var e,i,n,r,o,s=t[0]/360,a=t[1]/100,h=t[2]/100;if(0==a)return[o=255*h,o,o];e=2*h-(i=h<.5?h*(1+a):h+a-h*a),r=[0,0,0];for(var u=0;u<3;u++)(n=s+1/3*-(u-1))<0&&n++,n>1&&n--,o=6*n<1?e+6*(i-e)*n:2*n<1?i:3*n<2?e+(i-e)*(2/3-n)*6:e,r[u]=255*o;return r}function my(t){var e=t[0]/60,i=t[1]/100,n=t[2]/100,r=Math.floor(e)%6,o=e-Math.floor(e),s=255*n*(1-i),a=255*n*(1-i*o),h=255*n*(1-i*(1-o));n*=255;switch(r){case 0:return[n,h,s];case 1:return[a,n,s];case 2:return[s,n,h];case 3:return[s,a,n];case 4:return[h,s,n];case 5:return[n,s,a]}}function Ey(t){var e,i,n,o,s=t[0]/360,a=t[1]/100,h=t[2]/100,u=a+h;switch(u>1&&(a/=u,h/=u),i=1-h,n=6*s-(e=Math.floor(6*s)),0!=(1&e)&&(n=1-n),o=a+n*(i-a),e){default:case 6:case 0:r=i,g=o,b=a;break;case 1:r=o,g=i,b=a;break;case 2:r=a,g=i,b=o;break;case 3:r=a,g=o,b=i;break;case 4:r=o,g=a,b=i;break;case 5:r=i,g=a,b=o}return[255*r,255*g,255*b]}function xy(t){var e=t[0]/100,i=t[1]/100,n=t[2]/100,r=t[3]/100;return[255*(1-Math.min(1,e*(1-r)+r)),255*(1-Math.min(1,i*(1-r)+r)),255*(1-Math.min(1,n*(1-r)+r))]}function Sy(t){var e,i,n,r=t[0]/100,o=t[1]/100,s=t[2]/100;return i=-.9689*r+1.8758*o+.0415*s,n=.0557*r+-.204*o+1.057*s,e=(e=3.2406*r+-1.5372*o+-.4986*s)>.0031308?1.055*Math.pow(e,1/2.4)-.055:e*=12.92,i=i>.0031308?1.055*Math.pow(i,1/2.4)-.055:i*=12.92,n=n>.0031308?1.055*Math.pow(n,1/2.4)-.055:n*=12.92,[255*(e=Math.min(Math.max(0,e),1)),255*(i=Math.min(Math.max(0,i),1)),255*(n=Math.min(Math.max(0,n),1))]}function Ty(t){var e=t[0],i=t[1],n=t[2];return i/=100,n/=108.883,e=(e/=95.047)>.008856?Math.pow(e,1/3):7.787*e+16/116,[116*(i=i>.008856?Math.pow(i,1/3):7.787*i+16/116)-16,500*(e-i),200*(i-(n=n>.008856?Math.pow(n,1/3):7.787*n+16/116))]}function wy(t){var e,i,n,r,o=t[0],s=t[1],a=t[2];return o<=8?r=(i=100*o/903.3)/100*7.787+16/116:(i=100*Math.pow((o+16)/116,3),r=Math.pow(i/100,1/3)),[e=e/95.047<=.008856?e=95.047*(s/500+r-16/116)/7.787:95.047*Math.pow(s/500+r,3),i,n=n/108.883<=.008859?n=108.883*(r-a/200-16/116)/7.787:108.883*Math.pow(r-a/200,3)]}function by(t){var e,i=t[0],n=t[1],r=t[2];return(e=360*Math.atan2(r,n)/2/Math.PI)<0&&(e+=360),[i,Math.sqrt(n*n+r*r),e]}function Cy(t){return Sy(wy(t))}function Ry(t){var e,i=t[0],n=t[1];return e=t[2]/360*2*Math.PI,[i,n*Math.cos(e),n*Math.sin(e)]}function Oy(t){return Iy[t]}var Iy={aliceblue:[240,248,255],antiquewhite:[250,235,215],aqua:[0,255,255],aquamarine:[127,255,212],azure:[240,255,255],beige:[245,245,220],bisque:[255,228,196],black:[0,0,0],blanchedalmond:[255,235,205],blue:[0,0,255],blueviolet:[138,43,226],brown:[165,42,42],burlywood:[222,184,135],cadetblue:[95,158,160],chartreuse:[127,255,0],chocolate:[210,105,30],coral:[255,127,80],cornflowerblue:[100,149,237],cornsilk:[255,248,220],crimson:[220,20,60],cyan:[0,255,255],darkblue:[0,0,139],darkcyan:[0,139,139],darkgoldenrod:[184,134,11],darkgray:[169,169,169],darkgreen:[0,100,0],darkgrey:[169,169,169],darkkhaki:[189,183,107],darkmagenta:[139,0,139],darkolivegreen:[85,107,47],darkorange:[255,140,0],darkorchid:[153,50,204],darkred:[139,0,0],darksalmon:[233,150,122],darkseagreen:[143,188,143],darkslateblue:[72,61,139],darkslategray:[47,79,79],darkslategrey:[47,79,79],darkturquoise:[0,206,209],darkviolet:[148,0,211],deeppink:[255,20,147],deepskyblue:[0,191,255],dimgray:[105,105,105],dimgrey:[105,105,105],dodgerblue:[30,144,255],firebrick:[178,34,34],floralwhite:[255,250,240],forestgreen:[34,139,34],fuchsia:[255,0,255],gainsboro:[220,220,220],ghostwhite:[248,248,255],gold:[255,215,0],goldenrod:[218,165,32],gray:[128,128,128],green:[0,128,0],greenyellow:[173,255,47],grey:[128,128,128],honeydew:[240,255,240],hotpink:[255,105,180],indianred:[205,92,92],indigo:[75,0,130],ivory:[255,255,240],khaki:[240,230,140],lavender:[230,230,250],lavenderblush:[255,240,245],lawngreen:[124,252,0],lemonchiffon:[255,250,205],lightblue:[173,216,230],lightcoral:[240,128,128],lightcyan:[224,255,255],lightgoldenrodyellow:[250,250,210],lightgray:[211,211,211],lightgreen:[144,238,144],lightgrey:[211,211,211],lightpink:[255,182,193],lightsalmon:[255,160,122],lightseagreen:[32,178,170],lightskyblue:[135,206,250],lightslategray:[119,136,153],lightslategrey:[119,136,153],lightsteelblue:[176,196,222],lightyellow:[255,255,224],lime:[0,255,0],limegreen:[50,205,50],linen:[250,240,230],magenta:[255,0,255],maroon:[128,0,0],mediumaquamarine:[102,205,170],mediumblue:[0,0,205],mediumorchid:[186,85,211],mediumpurple:[147,112,219],mediumseagreen:[60,179,113],mediumslateblue:[123,104,238],mediumspringgreen:[0,250,154],mediumturquoise:[72,209,204],mediumvioletred:[199,21,133],midnightblue:[25,25,112],mintcream:[245,255,250],mistyrose:[255,228,225],moccasin:[255,228,181],navajowhite:[255,222,173],navy:[0,0,128],oldlace:[253,245,230],olive:[128,128,0],olivedrab:[107,142,35],orange:[255,165,0],orangered:[255,69,0],orchid:[218,112,214],palegoldenrod:[238,232,170],palegreen:[152,251,152],paleturquoise:[175,238,238],palevioletred:[219,112,147],papayawhip:[255,239,213],peachpuff:[255,218,185],peru:[205,133,63],pink:[255,192,203],plum:[221,160,221],powderblue:[176,224,230],purple:[128,0,128],rebeccapurple:[102,51,153],red:[255,0,0],rosybrown:[188,143,143],royalblue:[65,105,225],saddlebrown:[139,69,19],salmon:[250,128,114],sandybrown:[244,164,96],seagreen:[46,139,87],seashell:[255,245,238],sienna:[160,82,45],silver:[192,192,192],skyblue:[135,206,235],slateblue:[106,90,205],slategray:[112,128,144],slategrey:[112,128,144],snow:[255,250,250],springgreen:[0,255,127],steelblue:[70,130,180],tan:[210,180,140],teal:[0,128,128],thistle:[216,191,216],tomato:[255,99,71],turquoise:[64,224,208],violet:[238,130,238],wheat:[245,222,179],white:[255,255,255],whitesmoke:[245,245,245],yellow:[255,255,0],yellowgreen:[154,205,50]},Py={};for(var Fy in Iy)Py[JSON.stringify(Iy[Fy])]=Fy;var Ly=function(){return new jy};for(var My in ly){Ly[My+"Raw"]=function(t){return function(e){return"number"==typeof e&&(e=Array.prototype.slice.call(arguments)),ly[t](e)}}(My);var Ay=/(\w+)2(\w+)/.exec(My),Ny=Ay[1],ky=Ay[2];(Ly[Ny]=Ly[Ny]||{})[ky]=Ly[My]=function(t){return function(e){"number"==typeof e&&(e=Array.prototype.slice.call(arguments));var i=ly[t](e);if("string"==typeof i||void 0===i)return i;for(var n=0;n<i.length;n++)i[n]=Math.round(i[n]);return i}}(My)}var jy=function(){this.convs={}};jy.prototype.routeSpace=function(t,e){var i=e[0];return void 0===i?this.getValues(t):("number"==typeof i&&(i=Array.prototype.slice.call(e)),this.setValues(t,i))},jy.prototype.setValues=function(t,e){return this.space=t,this.convs={},this.convs[t]=e,this},jy.prototype.getValues=function(t){var e=this.convs[t];if(!e){var i=this.space,n=this.convs[i];e=Ly[i][t](n),this.convs[t]=e}return e},["rgb","hsl","hsv","cmyk","keyword"].forEach(function(t){jy.prototype[t]=function(e){return this.routeSpace(t,arguments)}});var Gy=Ly,Dy=function(t){var e,i,n,r;if(e=/^((?:rgb|hs[lv]|cmyk|xyz|lab)a?)\s*\(([^\)]*)\)/.exec(t)){var o=e[1],s="cmyk"===(a=o.replace(/a$/,""))?4:3;i=Gy[a],n=e[2].replace(/^\s+|\s+$/g,"").split(/\s*,\s*/).map(function(t,e){return/%$/.test(t)&&e===s?parseFloat(t)/100:(/%$/.test(t),parseFloat(t))}),o===a&&n.push(1),r=void 0===n[s]?1:n[s],n=n.slice(0,s),i[a]=function(){return n}}else if(/^#[A-Fa-f0-9]+$/.test(t)){var a;s=(a=t.replace(/^#/,"")).length;i=Gy.rgb,n=(n=a.split(3===s?/(.)/:/(..)/)).filter(Boolean).map(function(t){return 3===s?parseInt(t+t,16):parseInt(t,16)}),r=1,i.rgb=function(){return n},n[0]||(n[0]=0),n[1]||(n[1]=0),n[2]||(n[2]=0)}else(i=Gy.keyword).keyword=function(){return t},n=t,r=1;var h={rgb:void 0,hsl:void 0,hsv:void 0,cmyk:void 0,keyword:void 0,hex:void 0};try{h.rgb=i.rgb(n)}catch(t){}try{h.hsl=i.hsl(n)}catch(t){}try{h.hsv=i.hsv(n)}catch(t){}try{h.cmyk=i.cmyk(n)}catch(t){}try{h.keyword=i.keyword(n)}catch(t){}return h.rgb&&(h.hex="#"+h.rgb.map(function(t){var e=t.toString(16);return 1===e.length?"0"+e:e}).join("")),h.rgb&&(h.rgba=h.rgb.concat(r)),h.hsl&&(h.hsla=h.hsl.concat(r)),h.hsv&&(h.hsva=h.hsv.concat(r)),h.cmyk&&(h.cmyka=h.cmyk.concat(r)),h};function $y(){var t={},e=[255,255,255,1],i=[0,153,255,1];return t[Vh.LINE_STRING]=[{strokeColor:e,strokeWidth:5},{strokeColor:i,strokeWidth:3}],t[Vh.MULTI_LINE_STRING]=t[Vh.LINE_STRING],t[Vh.POLYGON]=[{fillColor:[255,255,255,.5]}].concat(t[Vh.LINE_STRING]),t[Vh.MULTI_POLYGON]=t[Vh.POLYGON],t[Vh.CIRCLE]=t[Vh.POLYGON].concat(t[Vh.LINE_STRING]),t[Vh.POINT]=[{imageRadius:6,fillColor:i,strokeColor:e,strokeWidth:1.5,zIndex:1/0}],t[Vh.MULTI_POINT]=t[Vh.POINT],t[Vh.GEOMETRY_COLLECTION]=t[Vh.POLYGON].concat(t[Vh.LINE_STRING],t[Vh.POINT]),t}var Vy=function(t){return null==t||"number"!=typeof t&&(("string"==typeof t||ti(t))&&!t.length||!oe(t).length)};function zy(t){if(!Vy(t)){var e={text:Wy(t),fill:Uy(t),stroke:By(t),image:Ky(t),geometry:Hy(t),zIndex:t.zIndex};return Vy(e)?void 0:new Ra(e)}}var Yy=function(t){return function(e){return t+(t?qi(e):e)}};function Xy(t){var e=t;return"string"==typeof t&&(e=Dy(t).rgba),e}function Uy(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"",i=Yy(e),n=["fillColor"].map(i),r=i("fill");if(t[r]instanceof ba)return t[r];var o=Bi(t,function(t,e,r){return!1===n.includes(r)?t:("color"===(r=Ji(r.replace(new RegExp(i("fill")),"")))&&(e=Xy(e)),t[r]=e,t)},{});return Vy(o)?void 0:new ba(o)}function By(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:"",i=Yy(e),n=["strokeColor","strokeWidth","strokeDash","strokeCap","strokeJoin"].map(i),r=i("stroke");if(t[r]instanceof Ca)return t[r];var o=Bi(t,function(t,e,r){if(!1===n.includes(r))return t;switch(r){case i("strokeColor"):case i("strokeWidth"):r=Ji(r.replace(new RegExp(i("stroke")),""));break;case i("strokeDash"):case i("strokeCap"):case i("strokeJoin"):r="line"+r.replace(new RegExp(i("stroke")),"")}return"color"===r&&(e=Xy(e)),t[r]=e,t},{});return Vy(o)?void 0:new Ca(o)}function Ky(t){var e,i;if(!(Vy(t.imageSrc)&&Vy(t.image)&&Vy(t.imagePoints))||ki(t.imageRadius))return t.image instanceof Sa?t.image:(Vy(t.imageSrc)&&Vy(t.image)?null!=t.imagePoints?(i=Ta,e=ue({},t,{points:t.imagePoints,radius:t.imageRadius,radius1:t.imageRadius1,radius2:t.imageRadius2,angle:t.imageAngle,rotation:t.imageRotation})):(i=wa,e=ue({},t,{radius:t.imageRadius})):(i=sy,e=ue({},t,{anchor:t.imageAnchor,anchorOrigin:t.imageAnchorOrigin,color:t.imageColor,offset:t.imageOffset,offsetOrigin:t.imageOffsetOrigin,opacity:t.imageOpacity,scale:t.imageScale,rotation:t.imageRotation,size:t.imageSize,img:t.image,imgSize:t.imageImgSize,src:t.imageSrc,crossOrigin:t.imageCrossOrigin})),e=ue({},e,{fill:Uy(t,"image")||Uy(t),stroke:By(t,"image")||By(t),snapToPixel:!0}),Vy(e)?void 0:new i(e))}function Wy(t){if(null!=t.text){if(t.text instanceof uy)return t.text;var e={text:t.text},i=["normal",t.textFontSize?t.textFontSize+"px":void 0,t.textFont].filter(function(t){return!!t}).join(" ");return pe(e,Zi(["textAlign","textBaseline"],t),{font:i,fill:Uy(t,"text")||Uy(t),stroke:By(t,"text")||By(t),scale:t.textScale,rotation:t.textRotation,offsetX:t.textOffsetX,offsetY:t.textOffsetY,rotateWithView:t.textRotateWithView,padding:t.textPadding,maxAngle:t.textMaxAngle,overflow:t.textOverflow,placement:t.textPlacement,backgroundFill:Uy(t,"textBackground"),backgroundStroke:By(t,"textBackground")}),Vy(e)?void 0:new uy(e)}}function Hy(t){return Ni(t.geom)?function(e){return t.geom(e,fc)}:t.geom}var Zy=Object.freeze({IndexedCollectionAdapter:Wg,SourceCollectionAdapter:Hg,EPSG_4326:kh,EPSG_3857:jh,MAX_ZOOM:Gh,MIN_ZOOM:0,TILE_SIZE:Dh,TILE_FORMAT:"png",ZOOM_FACTOR:2,CACHE_SIZE:2048,PIXEL_RATIO:1,CROSS_ORIGIN:"anonymous",REPROJ_ERR_THRESHOLD:.5,EARTH_RADIUS:6378137,RENDERER_TYPE:$h,GEOMETRY_TYPE:Vh,EXTENT_CORNER:zh,PROJ_UNIT:Yh,OVERLAY_POSITIONING:Xh,WMS_VERSION:"1.3.0",WMTS_VERSION:"1.0.0",WMTS_REQUEST_ENCODING:"KVP",WMTS_FORMAT:"image/jpeg",LAYER_PROP:"layer",getExtentCenter:Ln,getExtentWidth:Gn,getExtentHeight:An,boundingExtent:un,createOrUpdateExtent:Xp,getExtentCorner:Up,createExtentFromProjection:Bp,initFeature:Ga,getFeatureId:Da,createGeoJsonFmt:kc,createTopoJsonFmt:function(t){return new Cl(t)},createMvtFmt:jc,writeGeoJsonFeature:$c,readGeoJsonFeature:Vc,writeGeoJsonGeometry:zc,readGeoJsonGeometry:Yc,readProjection:Xc,createPointGeom:ec,createLineGeom:ic,createPolygonGeom:nc,createMultiPointGeom:rc,createMultiLineGeom:oc,createMultiPolygonGeom:sc,createGeomCollection:ac,createCircularPolygon:hc,isMultiGeom:uc,toSimpleGeom:lc,findPointOnSurface:cc,loadingAll:Qg,loadingBBox:function(t,e){return[t]},loadingTile:function(t){return function(e,i){var n=t.getZForResolution(i),r=t.getTileRangeForExtentAndZ(e,n),o=[],s=[n,0,0];for(s[1]=r.minX;s[1]<=r.maxX;++s[1])for(s[2]=r.minY;s[2]<=r.maxY;++s[2])o.push(t.getTileCoordExtent(s));return o}},transform:xo,transformExtent:So,getProj:fo,addProj:po,createProj:function(t){return new Ur(t)},pointToLonLat:dc,pointFromLonLat:gc,transformPoint:yc,lineToLonLat:vc,lineFromLonLat:_c,transformLine:mc,polygonToLonLat:Ec,polygonFromLonLat:xc,transformPolygon:Sc,multiPointToLonLat:Tc,multiPointFromLonLat:wc,transformMultiPoint:bc,multiLineToLonLat:Cc,multiLineFromLonLat:Rc,transformMultiLine:Oc,multiPolygonToLonLat:Ic,multiPolygonFromLonLat:Pc,transformMultiPolygon:Fc,transforms:Lc,extentFromLonLat:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh;return So(t,kh,e)},extentToLonLat:function(t){return So(t,arguments.length>1&&void 0!==arguments[1]?arguments[1]:jh,kh)},defaultStyle:function(){return[{fillColor:[255,255,255,.4],strokeColor:"#3399CC",strokeWidth:1.25,imageRadius:5}]},defaultEditStyle:$y,createStyle:zy,normalizeColor:Xy,createFillStyle:Uy,createStrokeStyle:By,createImageStyle:Ky,createTextStyle:Wy,createGeomStyle:Hy,createXyzGrid:es,resolutionsFromExtent:Gg,createGridForExtent:Dg,createGridForProjection:function(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:Gh,i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:Dh,n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:zh.BOTTOM_LEFT;return Dg(Bp(t),e,i,n)},isCollection:Mc,isVectorSource:Ac,isCircle:Nc}),qy=function(t){return Bi(t,function(t,e,i){return i=i.toUpperCase(),["LAYERS","VERSION","STYLES","FORMAT","TRANSPARENT","BGCOLOR","TIME"].includes(i)?t:(t[i]=e,t)},{})},Jy={props:{extParams:Object,layers:{type:String,required:!0},styles:String,version:{type:String,default:"1.3.0"},transparent:{type:Boolean,default:!0},format:{type:String,default:"image/png"},bgColor:String,time:String},computed:{cleanExtParams:function(){return this.extParams?qy(this.extParams):void 0},allParams:function(){return ue({},this.cleanExtParams,{LAYERS:this.layers,STYLES:this.styles,VERSION:this.version,FORMAT:this.format,TRANSPARENT:this.transparent,BGCOLOR:this.bgColor,TIME:this.time})}},methods:{getFeatureInfoUrl:function(t,e,i){var n=arguments.length>3&&void 0!==arguments[3]?arguments[3]:{};return Oh(this),Fh(this),e||(e=this.$view.getResolution()),i||(i=this.projection),n=ue({},this.allParams,n),this.$source.getGetFeatureInfoUrl(t,e,i,n)}},watch:{layers:function(t){this.$source&&this.$source.updateParams({LAYERS:t})},version:function(t){this.$source&&this.$source.updateParams({VERSION:t})},styles:function(t){this.$source&&this.$source.updateParams({STYLES:t})},transparent:function(t){this.$source&&this.$source.updateParams({TRANSPARENT:t})},time:function(t){this.$source&&this.$source.updateParams({TIME:t})},bgColor:function(t){this.$source&&this.$source.updateParams({BGCOLOR:t})},extParams:function(t){this.$source&&this.$source.updateParams(t?qy(t):void 0)}}},Qy=function(t){function e(e){var i=e||{},n=void 0!==i.projection?i.projection:"EPSG:3857",r=void 0!==i.tileGrid?i.tileGrid:es({extent:rs(n),maxZoom:i.maxZoom,minZoom:i.minZoom,tileSize:i.tileSize});t.call(this,{attributions:i.attributions,cacheSize:i.cacheSize,crossOrigin:i.crossOrigin,opaque:i.opaque,projection:n,reprojectionErrorThreshold:i.reprojectionErrorThreshold,tileGrid:r,tileLoadFunction:i.tileLoadFunction,tilePixelRatio:i.tilePixelRatio,tileUrlFunction:i.tileUrlFunction,url:i.url,urls:i.urls,wrapX:void 0===i.wrapX||i.wrapX,transition:i.transition})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(cs),tv={mixins:[zg],methods:{createSource:function(){return new Qy({attributions:this.attributions,cacheSize:this.cacheSize,crossOrigin:this.crossOrigin,maxZoom:this.maxZoom,minZoom:this.minZoom,opaque:this.opaque,projection:this.projection,reprojectionErrorThreshold:this.reprojectionErrorThreshold,tileGrid:this._tileGrid,tilePixelRatio:this.tilePixelRatio,tileUrlFunction:this.createUrlFunc(),tileLoadFunction:this.tileLoadFunction,wrapX:this.wrapX,transition:this.transition})}},watch:{}},ev={url:{type:String,required:!0,validator:function(t){return!!t.length}}};var iv,nv,rv,ov,sv,av=(iv={},rv=void 0,ov=void 0,(sv=("function"==typeof(nv={name:"vl-source-arcgis-rest",mixins:[zg,ms],props:ev,methods:{createSource:function(){return new ds({attributions:this.attributions,cacheSize:this.cacheSize,crossOrigin:this.crossOrigin,params:this.allParams,logo:this.logo,tileGrid:this._tileGrid,projection:this.projection,reprojectionErrorThreshold:this.reprojectionErrorThreshold,tileLoadFunction:this.tileLoadFunction,url:this.urlTmpl,wrapX:this.wrapX,transition:this.transition})}}})?nv.options:nv)||{}).__file="source.vue",sv.render||(sv.render=iv.render,sv.staticRenderFns=iv.staticRenderFns,sv._compiled=!0,ov&&(sv.functional=!0)),sv._scopeId=rv,sv);function hv(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};hv.installed||(hv.installed=!0,e=Zi(e,"dataProjection"),pe(av,e),t.component(av.name,av))}var uv=Object.freeze({default:hv,Source:av,install:hv});var lv=function(t){function e(e){var i=void 0!==e.hidpi&&e.hidpi;t.call(this,{cacheSize:e.cacheSize,crossOrigin:"anonymous",opaque:!0,projection:fo("EPSG:3857"),reprojectionErrorThreshold:e.reprojectionErrorThreshold,state:Do.LOADING,tileLoadFunction:e.tileLoadFunction,tilePixelRatio:i?2:1,wrapX:void 0===e.wrapX||e.wrapX,transition:e.transition}),this.hidpi_=i,this.culture_=void 0!==e.culture?e.culture:"en-us",this.maxZoom_=void 0!==e.maxZoom?e.maxZoom:-1,this.apiKey_=e.key,this.imagerySet_=e.imagerySet,function(t,e,i,n){var r=document.createElement("script"),o="olc_"+nn(e);function s(){delete window[o],r.parentNode.removeChild(r)}r.async=!0,r.src=t+(-1==t.indexOf("?")?"?":"&")+(n||"callback")+"="+o;var a=setTimeout(function(){s(),i&&i()},1e4);window[o]=function(t){clearTimeout(a),s(),e(t)},document.getElementsByTagName("head")[0].appendChild(r)}("https://dev.virtualearth.net/REST/v1/Imagery/Metadata/"+this.imagerySet_+"?uriScheme=https&include=ImageryProviders&key="+this.apiKey_+"&c="+this.culture_,this.handleImageryMetadataResponse.bind(this),void 0,"jsonp")}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getApiKey=function(){return this.apiKey_},e.prototype.getImagerySet=function(){return this.imagerySet_},e.prototype.handleImageryMetadataResponse=function(t){if(200==t.statusCode&&"OK"==t.statusDescription&&"ValidCredentials"==t.authenticationResultCode&&1==t.resourceSets.length&&1==t.resourceSets[0].resources.length){var e=t.resourceSets[0].resources[0],i=-1==this.maxZoom_?e.zoomMax:this.maxZoom_,n=rs(this.getProjection()),r=e.imageWidth==e.imageHeight?e.imageWidth:[e.imageWidth,e.imageHeight],o=es({extent:n,minZoom:e.zoomMin,maxZoom:i,tileSize:r/(this.hidpi_?2:1)});this.tileGrid=o;var s=this.culture_,a=this.hidpi_;if(this.tileUrlFunction=Po(e.imageUrlSubdomains.map(function(t){var i=[0,0,0],n=e.imageUrl.replace("{subdomain}",t).replace("{culture}",s);return function(t,e,r){if(t){Ar(t[0],t[1],-t[2]-1,i);var o=n;return a&&(o+="&dpi=d1&device=mobile"),o.replace("{quadkey}",function(t){var e,i,n=t[0],r=new Array(n),o=1<<n-1;for(e=0;e<n;++e)i=48,t[1]&o&&(i+=1),t[2]&o&&(i+=2),r[e]=String.fromCharCode(i),o>>=1;return r.join("")}(i))}}})),e.imageryProviders){var h=mo(fo("EPSG:4326"),this.getProjection());this.setAttributions(function(t){var i=[],n=t.viewState.zoom;return e.imageryProviders.map(function(e){for(var r=!1,o=e.coverageAreas,s=0,a=o.length;s<a;++s){var u=o[s];if(n>=u.zoomMin&&n<=u.zoomMax){var l=u.bbox;if(Dn(zn([l[1],l[0],l[3],l[2]],h),t.extent)){r=!0;break}}}r&&i.push(e.attribution)}),i.push('<a class="ol-attribution-bing-tos" href="https://www.microsoft.com/maps/product/terms.html">Terms of Use</a>'),i})}this.setState(Do.READY)}else this.setState(Do.ERROR)},e}(cs);var cv=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-bingmaps",mixins:[zg],props:{hidpi:{type:Boolean,default:!1},culture:{type:String,default:"en-us"},apiKey:{type:String,required:!0},imagerySet:{type:String,required:!0},maxZoom:{type:Number,default:21},url:String},methods:{createSource:function(){return new lv({cacheSize:this.cacheSize,hidpi:this.hidpi,culture:this.culture,key:this.apiKey,imagerySet:this.imagerySet,maxZoom:this.maxZoom,reprojectionErrorThreshold:this.reprojectionErrorThreshold,wrapX:this.wrapX,transition:this.transition,tileLoadFunction:this.tileLoadFunction})}},watch:Gd(["apiKey","imagerySet"],function(){return function(){this.scheduleRecreate()}})},void 0,void 0);function pv(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};pv.installed||(pv.installed=!0,e=Zi(e,"dataProjection"),pe(cv,e),t.component(cv.name,cv))}var fv=Object.freeze({default:pv,Source:cv,install:pv});var dv=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-circle",mixins:[zd],props:{coordinates:{type:Array,required:!0,validator:function(t){return 2===t.length}},radius:{type:Number,default:0}},computed:{type:Oi(Vh.POINT),coordinatesViewProj:function(){if(this.rev&&this.$geometry)return this.$geometry.getCenter()}},methods:{createGeometry:function(){return new tc(this.toViewProj(this.coordinates),this.radius)},getCoordinates:function(){return Lh(this),this.toDataProj(this.$geometry.getCenter())},setCoordinates:function(t){Lh(this),this.$geometry.setCenter(this.toViewProj(t))}},watch:{radius:function(t){this.$geometry&&t!==this.$geometry.getRadius()&&this.$geometry.setRadius(t)}}},void 0,void 0);function gv(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};gv.installed||(gv.installed=!0,e=Zi(e,"dataProjection"),pe(dv,e),t.component(dv.name,dv))}var yv=Object.freeze({default:gv,Geom:dv,install:gv}),vv={radius:{type:Number,default:5}};var _v=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-circle",mixins:[Qd,Jg],props:vv,methods:{createStyle:function(){return new wa({radius:this.radius,fill:this._fill,stroke:this._stroke})},getServices:function(){var t=this;return af(Qd.methods.getServices.call(this),{get stylesContainer(){return t}})},setFill:function(e){(e=e instanceof t?e.$style:e)!==this._fill&&(this._fill=e,this.scheduleRefresh())},setStroke:function(e){(e=e instanceof t?e.$style:e)!==this._stroke&&(this._stroke=e,this.scheduleRefresh())}},watch:{radius:function(t){this.$style&&!zi(t,this.$style.getRadius())&&this.scheduleRefresh()}}},void 0,void 0);function mv(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};mv.installed||(mv.installed=!0,e=Zi(e,"dataProjection"),pe(_v,e),t.component(_v.name,_v))}var Ev=Object.freeze({default:mv,Style:_v,install:mv});function xv(t,e,i){var n=void 0!==i?t.toFixed(i):""+t,r=n.indexOf(".");return(r=-1===r?n.length:r)>e?n:new Array(1+e-r).join("0")+n}function Sv(t,e){for(var i=(""+t).split("."),n=(""+e).split("."),r=0;r<Math.max(i.length,n.length);r++){var o=parseInt(i[r]||"0",10),s=parseInt(n[r]||"0",10);if(o>s)return 1;if(s>o)return-1}return 0}function Tv(t,e){return t[0]+=e[0],t[1]+=e[1],t}function wv(t,e){var i,n,r=t[0],o=t[1],s=e[0],a=e[1],h=s[0],u=s[1],l=a[0],c=a[1],p=l-h,f=c-u,d=0===p&&0===f?0:(p*(r-h)+f*(o-u))/(p*p+f*f||0);return d<=0?(i=h,n=u):d>=1?(i=l,n=c):(i=h+d*p,n=u+d*f),[i,n]}function bv(t,e,i){var n=Hn(e+180,360)-180,r=Math.abs(3600*n),o=i||0,s=Math.pow(10,o),a=Math.floor(r/3600),h=Math.floor((r-3600*a)/60),u=r-3600*a-60*h;return(u=Math.ceil(u*s)/s)>=60&&(u=0,h+=1),h>=60&&(h=0,a+=1),a+"° "+xv(h,2)+"′ "+xv(u,2,o)+"″"+(0==n?"":" "+t.charAt(n<0?1:0))}function Cv(t,e){for(var i=!0,n=t.length-1;n>=0;--n)if(t[n]!=e[n]){i=!1;break}return i}function Rv(t,e){var i=Math.cos(e),n=Math.sin(e),r=t[0]*i-t[1]*n,o=t[1]*i+t[0]*n;return t[0]=r,t[1]=o,t}function Ov(t,e){return t[0]*=e,t[1]*=e,t}function Iv(t,e){var i=t[0]-e[0],n=t[1]-e[1];return i*i+n*n}function Pv(t,e){return Math.sqrt(Iv(t,e))}function Fv(t,e){return Iv(t,wv(t,e))}var Lv={ADD:"add",REMOVE:"remove"},Mv="length",Av=function(t){function e(e,i){t.call(this,e),this.element=i}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),Nv=function(t){function e(e,i){t.call(this);var n=i||{};if(this.unique_=!!n.unique,this.array_=e||[],this.unique_)for(var r=0,o=this.array_.length;r<o;++r)this.assertUnique_(this.array_[r],r);this.updateLength_()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clear=function(){for(;this.getLength()>0;)this.pop()},e.prototype.extend=function(t){for(var e=0,i=t.length;e<i;++e)this.push(t[e]);return this},e.prototype.forEach=function(t){for(var e=this.array_,i=0,n=e.length;i<n;++i)t(e[i],i,e)},e.prototype.getArray=function(){return this.array_},e.prototype.item=function(t){return this.array_[t]},e.prototype.getLength=function(){return this.get(Mv)},e.prototype.insertAt=function(t,e){this.unique_&&this.assertUnique_(e),this.array_.splice(t,0,e),this.updateLength_(),this.dispatchEvent(new Av(Lv.ADD,e))},e.prototype.pop=function(){return this.removeAt(this.getLength()-1)},e.prototype.push=function(t){this.unique_&&this.assertUnique_(t);var e=this.getLength();return this.insertAt(e,t),this.getLength()},e.prototype.remove=function(t){for(var e=this.array_,i=0,n=e.length;i<n;++i)if(e[i]===t)return this.removeAt(i)},e.prototype.removeAt=function(t){var e=this.array_[t];return this.array_.splice(t,1),this.updateLength_(),this.dispatchEvent(new Av(Lv.REMOVE,e)),e},e.prototype.setAt=function(t,e){var i=this.getLength();if(t<i){this.unique_&&this.assertUnique_(e,t);var n=this.array_[t];this.array_[t]=e,this.dispatchEvent(new Av(Lv.REMOVE,n)),this.dispatchEvent(new Av(Lv.ADD,e))}else{for(var r=i;r<t;++r)this.insertAt(r,void 0);this.insertAt(t,e)}},e.prototype.updateLength_=function(){this.set(Mv,this.array_.length)},e.prototype.assertUnique_=function(t,e){for(var i=0,n=this.array_.length;i<n;++i)if(this.array_[i]===t&&i!==e)throw new on(58)},e}(ko);function kv(t,e,i,n){return function(r,o,s){var a=new XMLHttpRequest;a.open("GET","function"==typeof t?t(r,o,s):t,!0),e.getType()==qh.ARRAY_BUFFER&&(a.responseType="arraybuffer"),a.onload=function(t){if(!a.status||a.status>=200&&a.status<300){var r,o=e.getType();o==qh.JSON||o==qh.TEXT?r=a.responseText:o==qh.XML?(r=a.responseXML)||(r=(new DOMParser).parseFromString(a.responseText,"application/xml")):o==qh.ARRAY_BUFFER&&(r=a.response),r?i.call(this,e.readFeatures(r,{featureProjection:s}),e.readProjection(r),e.getLastExtent()):n.call(this)}else n.call(this)}.bind(this),a.onerror=function(){n.call(this)}.bind(this),a.send()}}var jv={ADDFEATURE:"addfeature",CHANGEFEATURE:"changefeature",CLEAR:"clear",REMOVEFEATURE:"removefeature"},Gv=y(function(t,e){t.exports=function(){function t(t,e,i){var n=t[e];t[e]=t[i],t[i]=n}function e(t,e){return t<e?-1:t>e?1:0}return function(i,n,r,o,s){!function e(i,n,r,o,s){for(;o>r;){if(o-r>600){var a=o-r+1,h=n-r+1,u=Math.log(a),l=.5*Math.exp(2*u/3),c=.5*Math.sqrt(u*l*(a-l)/a)*(h-a/2<0?-1:1),p=Math.max(r,Math.floor(n-h*l/a+c)),f=Math.min(o,Math.floor(n+(a-h)*l/a+c));e(i,n,p,f,s)}var d=i[n],g=r,y=o;for(t(i,r,n),s(i[o],d)>0&&t(i,r,o);g<y;){for(t(i,g,y),g++,y--;s(i[g],d)<0;)g++;for(;s(i[y],d)>0;)y--}0===s(i[r],d)?t(i,r,y):t(i,++y,o),y<=n&&(r=y+1),n<=y&&(o=y-1)}}(i,n,r||0,o||i.length-1,s||e)}}()}),Dv=Vv,$v=Vv;function Vv(t,e){if(!(this instanceof Vv))return new Vv(t,e);this._maxEntries=Math.max(4,t||9),this._minEntries=Math.max(2,Math.ceil(.4*this._maxEntries)),e&&this._initFormat(e),this.clear()}function zv(t,e,i){if(!i)return e.indexOf(t);for(var n=0;n<e.length;n++)if(i(t,e[n]))return n;return-1}function Yv(t,e){Xv(t,0,t.children.length,e,t)}function Xv(t,e,i,n,r){r||(r=Jv(null)),r.minX=1/0,r.minY=1/0,r.maxX=-1/0,r.maxY=-1/0;for(var o,s=e;s<i;s++)o=t.children[s],Uv(r,t.leaf?n(o):o);return r}function Uv(t,e){return t.minX=Math.min(t.minX,e.minX),t.minY=Math.min(t.minY,e.minY),t.maxX=Math.max(t.maxX,e.maxX),t.maxY=Math.max(t.maxY,e.maxY),t}function Bv(t,e){return t.minX-e.minX}function Kv(t,e){return t.minY-e.minY}function Wv(t){return(t.maxX-t.minX)*(t.maxY-t.minY)}function Hv(t){return t.maxX-t.minX+(t.maxY-t.minY)}function Zv(t,e){return t.minX<=e.minX&&t.minY<=e.minY&&e.maxX<=t.maxX&&e.maxY<=t.maxY}function qv(t,e){return e.minX<=t.maxX&&e.minY<=t.maxY&&e.maxX>=t.minX&&e.maxY>=t.minY}function Jv(t){return{children:t,height:1,leaf:!0,minX:1/0,minY:1/0,maxX:-1/0,maxY:-1/0}}function Qv(t,e,i,n,r){for(var o,s=[e,i];s.length;)(i=s.pop())-(e=s.pop())<=n||(o=e+Math.ceil((i-e)/n/2)*n,Gv(t,o,e,i,r),s.push(e,o,o,i))}Vv.prototype={all:function(){return this._all(this.data,[])},search:function(t){var e=this.data,i=[],n=this.toBBox;if(!qv(t,e))return i;for(var r,o,s,a,h=[];e;){for(r=0,o=e.children.length;r<o;r++)s=e.children[r],qv(t,a=e.leaf?n(s):s)&&(e.leaf?i.push(s):Zv(t,a)?this._all(s,i):h.push(s));e=h.pop()}return i},collides:function(t){var e=this.data,i=this.toBBox;if(!qv(t,e))return!1;for(var n,r,o,s,a=[];e;){for(n=0,r=e.children.length;n<r;n++)if(o=e.children[n],qv(t,s=e.leaf?i(o):o)){if(e.leaf||Zv(t,s))return!0;a.push(o)}e=a.pop()}return!1},load:function(t){if(!t||!t.length)return this;if(t.length<this._minEntries){for(var e=0,i=t.length;e<i;e++)this.insert(t[e]);return this}var n=this._build(t.slice(),0,t.length-1,0);if(this.data.children.length)if(this.data.height===n.height)this._splitRoot(this.data,n);else{if(this.data.height<n.height){var r=this.data;this.data=n,n=r}this._insert(n,this.data.height-n.height-1,!0)}else this.data=n;return this},insert:function(t){return t&&this._insert(t,this.data.height-1),this},clear:function(){return this.data=Jv([]),this},remove:function(t,e){if(!t)return this;for(var i,n,r,o,s=this.data,a=this.toBBox(t),h=[],u=[];s||h.length;){if(s||(s=h.pop(),n=h[h.length-1],i=u.pop(),o=!0),s.leaf&&-1!==(r=zv(t,s.children,e)))return s.children.splice(r,1),h.push(s),this._condense(h),this;o||s.leaf||!Zv(s,a)?n?(i++,s=n.children[i],o=!1):s=null:(h.push(s),u.push(i),i=0,n=s,s=s.children[0])}return this},toBBox:function(t){return t},compareMinX:Bv,compareMinY:Kv,toJSON:function(){return this.data},fromJSON:function(t){return this.data=t,this},_all:function(t,e){for(var i=[];t;)t.leaf?e.push.apply(e,t.children):i.push.apply(i,t.children),t=i.pop();return e},_build:function(t,e,i,n){var r,o=i-e+1,s=this._maxEntries;if(o<=s)return Yv(r=Jv(t.slice(e,i+1)),this.toBBox),r;n||(n=Math.ceil(Math.log(o)/Math.log(s)),s=Math.ceil(o/Math.pow(s,n-1))),(r=Jv([])).leaf=!1,r.height=n;var a,h,u,l,c=Math.ceil(o/s),p=c*Math.ceil(Math.sqrt(s));for(Qv(t,e,i,p,this.compareMinX),a=e;a<=i;a+=p)for(Qv(t,a,u=Math.min(a+p-1,i),c,this.compareMinY),h=a;h<=u;h+=c)l=Math.min(h+c-1,u),r.children.push(this._build(t,h,l,n-1));return Yv(r,this.toBBox),r},_chooseSubtree:function(t,e,i,n){for(var r,o,s,a,h,u,l,c,p,f;n.push(e),!e.leaf&&n.length-1!==i;){for(l=c=1/0,r=0,o=e.children.length;r<o;r++)h=Wv(s=e.children[r]),p=t,f=s,(u=(Math.max(f.maxX,p.maxX)-Math.min(f.minX,p.minX))*(Math.max(f.maxY,p.maxY)-Math.min(f.minY,p.minY))-h)<c?(c=u,l=h<l?h:l,a=s):u===c&&h<l&&(l=h,a=s);e=a||e.children[0]}return e},_insert:function(t,e,i){var n=this.toBBox,r=i?t:n(t),o=[],s=this._chooseSubtree(r,this.data,e,o);for(s.children.push(t),Uv(s,r);e>=0&&o[e].children.length>this._maxEntries;)this._split(o,e),e--;this._adjustParentBBoxes(r,o,e)},_split:function(t,e){var i=t[e],n=i.children.length,r=this._minEntries;this._chooseSplitAxis(i,r,n);var o=this._chooseSplitIndex(i,r,n),s=Jv(i.children.splice(o,i.children.length-o));s.height=i.height,s.leaf=i.leaf,Yv(i,this.toBBox),Yv(s,this.toBBox),e?t[e-1].children.push(s):this._splitRoot(i,s)},_splitRoot:function(t,e){this.data=Jv([t,e]),this.data.height=t.height+1,this.data.leaf=!1,Yv(this.data,this.toBBox)},_chooseSplitIndex:function(t,e,i){var n,r,o,s,a,h,u,l,c,p,f,d,g,y;for(h=u=1/0,n=e;n<=i-e;n++)r=Xv(t,0,n,this.toBBox),o=Xv(t,n,i,this.toBBox),c=r,p=o,void 0,void 0,void 0,void 0,f=Math.max(c.minX,p.minX),d=Math.max(c.minY,p.minY),g=Math.min(c.maxX,p.maxX),y=Math.min(c.maxY,p.maxY),s=Math.max(0,g-f)*Math.max(0,y-d),a=Wv(r)+Wv(o),s<h?(h=s,l=n,u=a<u?a:u):s===h&&a<u&&(u=a,l=n);return l},_chooseSplitAxis:function(t,e,i){var n=t.leaf?this.compareMinX:Bv,r=t.leaf?this.compareMinY:Kv;this._allDistMargin(t,e,i,n)<this._allDistMargin(t,e,i,r)&&t.children.sort(n)},_allDistMargin:function(t,e,i,n){t.children.sort(n);var r,o,s=this.toBBox,a=Xv(t,0,e,s),h=Xv(t,i-e,i,s),u=Hv(a)+Hv(h);for(r=e;r<i-e;r++)o=t.children[r],Uv(a,t.leaf?s(o):o),u+=Hv(a);for(r=i-e-1;r>=e;r--)o=t.children[r],Uv(h,t.leaf?s(o):o),u+=Hv(h);return u},_adjustParentBBoxes:function(t,e,i){for(var n=i;n>=0;n--)Uv(e[n],t)},_condense:function(t){for(var e,i=t.length-1;i>=0;i--)0===t[i].children.length?i>0?(e=t[i-1].children).splice(e.indexOf(t[i]),1):this.clear():Yv(t[i],this.toBBox)},_initFormat:function(t){var e=["return a"," - b",";"];this.compareMinX=new Function("a","b",e.join(t[0])),this.compareMinY=new Function("a","b",e.join(t[1])),this.toBBox=new Function("a","return {minX: a"+t[0]+", minY: a"+t[1]+", maxX: a"+t[2]+", maxY: a"+t[3]+"};")}},Dv.default=$v;var t_=function(t){this.rbush_=Dv(t,void 0),this.items_={}};t_.prototype.insert=function(t,e){var i={minX:t[0],minY:t[1],maxX:t[2],maxY:t[3],value:e};this.rbush_.insert(i),this.items_[nn(e)]=i},t_.prototype.load=function(t,e){for(var i=new Array(e.length),n=0,r=e.length;n<r;n++){var o=t[n],s=e[n],a={minX:o[0],minY:o[1],maxX:o[2],maxY:o[3],value:s};i[n]=a,this.items_[nn(s)]=a}this.rbush_.load(i)},t_.prototype.remove=function(t){var e=nn(t),i=this.items_[e];return delete this.items_[e],null!==this.rbush_.remove(i)},t_.prototype.update=function(t,e){var i=this.items_[nn(e)];Tn([i.minX,i.minY,i.maxX,i.maxY],t)||(this.remove(e),this.insert(t,e))},t_.prototype.getAll=function(){return this.rbush_.all().map(function(t){return t.value})},t_.prototype.getInExtent=function(t){var e={minX:t[0],minY:t[1],maxX:t[2],maxY:t[3]};return this.rbush_.search(e).map(function(t){return t.value})},t_.prototype.forEach=function(t,e){return this.forEach_(this.getAll(),t,e)},t_.prototype.forEachInExtent=function(t,e,i){return this.forEach_(this.getInExtent(t),e,i)},t_.prototype.forEach_=function(t,e,i){for(var n,r=0,o=t.length;r<o;r++)if(n=e.call(i,t[r]))return n;return n},t_.prototype.isEmpty=function(){return tr(this.items_)},t_.prototype.clear=function(){this.rbush_.clear(),this.items_={}},t_.prototype.getExtent=function(t){var e=this.rbush_.data;return _n(e.minX,e.minY,e.maxX,e.maxY,t)},t_.prototype.concat=function(t){for(var e in this.rbush_.load(t.rbush_.all()),t.items_)this.items_[0|e]=t.items_[0|e]};var e_=function(t){function e(e,i){t.call(this,e),this.feature=i}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),i_=function(t){function e(e){var i,n,r=e||{};t.call(this,{attributions:r.attributions,projection:void 0,state:Do.READY,wrapX:void 0===r.wrapX||r.wrapX}),this.loader_=xr,this.format_=r.format,this.overlaps_=void 0==r.overlaps||r.overlaps,this.url_=r.url,void 0!==r.loader?this.loader_=r.loader:void 0!==this.url_&&(sn(this.format_,7),this.loader_=(i=this.url_,n=this.format_,kv(i,n,function(t,e){this.addFeatures(t)},xr))),this.strategy_=void 0!==r.strategy?r.strategy:Qg;var o,s,a=void 0===r.useSpatialIndex||r.useSpatialIndex;this.featuresRtree_=a?new t_:null,this.loadedExtentsRtree_=new t_,this.nullGeometryFeatures_={},this.idIndex_={},this.undefIdIndex_={},this.featureChangeKeys_={},this.featuresCollection_=null,r.features instanceof Nv?s=(o=r.features).getArray():Array.isArray(r.features)&&(s=r.features),a||void 0!==o||(o=new Nv(s)),void 0!==s&&this.addFeaturesInternal(s),void 0!==o&&this.bindFeaturesCollection_(o)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.addFeature=function(t){this.addFeatureInternal(t),this.changed()},e.prototype.addFeatureInternal=function(t){var e=nn(t).toString();if(this.addToIndex_(e,t)){this.setupChangeEvents_(e,t);var i=t.getGeometry();if(i){var n=i.getExtent();this.featuresRtree_&&this.featuresRtree_.insert(n,t)}else this.nullGeometryFeatures_[e]=t;this.dispatchEvent(new e_(jv.ADDFEATURE,t))}},e.prototype.setupChangeEvents_=function(t,e){this.featureChangeKeys_[t]=[gr(e,br.CHANGE,this.handleFeatureChange_,this),gr(e,Mo,this.handleFeatureChange_,this)]},e.prototype.addToIndex_=function(t,e){var i=!0,n=e.getId();return void 0!==n?n.toString()in this.idIndex_?i=!1:this.idIndex_[n.toString()]=e:(sn(!(t in this.undefIdIndex_),30),this.undefIdIndex_[t]=e),i},e.prototype.addFeatures=function(t){this.addFeaturesInternal(t),this.changed()},e.prototype.addFeaturesInternal=function(t){for(var e=[],i=[],n=[],r=0,o=t.length;r<o;r++){var s=t[r],a=nn(s).toString();this.addToIndex_(a,s)&&i.push(s)}for(var h=0,u=i.length;h<u;h++){var l=i[h],c=nn(l).toString();this.setupChangeEvents_(c,l);var p=l.getGeometry();if(p){var f=p.getExtent();e.push(f),n.push(l)}else this.nullGeometryFeatures_[c]=l}this.featuresRtree_&&this.featuresRtree_.load(e,n);for(var d=0,g=i.length;d<g;d++)this.dispatchEvent(new e_(jv.ADDFEATURE,i[d]))},e.prototype.bindFeaturesCollection_=function(t){var e=!1;gr(this,jv.ADDFEATURE,function(i){e||(e=!0,t.push(i.feature),e=!1)}),gr(this,jv.REMOVEFEATURE,function(i){e||(e=!0,t.remove(i.feature),e=!1)}),gr(t,Lv.ADD,function(t){e||(e=!0,this.addFeature(t.element),e=!1)},this),gr(t,Lv.REMOVE,function(t){e||(e=!0,this.removeFeature(t.element),e=!1)},this),this.featuresCollection_=t},e.prototype.clear=function(t){if(t){for(var e in this.featureChangeKeys_){this.featureChangeKeys_[e].forEach(_r)}this.featuresCollection_||(this.featureChangeKeys_={},this.idIndex_={},this.undefIdIndex_={})}else if(this.featuresRtree_)for(var i in this.featuresRtree_.forEach(this.removeFeatureInternal,this),this.nullGeometryFeatures_)this.removeFeatureInternal(this.nullGeometryFeatures_[i]);this.featuresCollection_&&this.featuresCollection_.clear(),this.featuresRtree_&&this.featuresRtree_.clear(),this.loadedExtentsRtree_.clear(),this.nullGeometryFeatures_={};var n=new e_(jv.CLEAR);this.dispatchEvent(n),this.changed()},e.prototype.forEachFeature=function(t){return this.featuresRtree_?this.featuresRtree_.forEach(t):this.featuresCollection_?this.featuresCollection_.forEach(t):void 0},e.prototype.forEachFeatureAtCoordinateDirect=function(t,e){var i=[t[0],t[1],t[0],t[1]];return this.forEachFeatureInExtent(i,function(i){return i.getGeometry().intersectsCoordinate(t)?e(i):void 0})},e.prototype.forEachFeatureInExtent=function(t,e){return this.featuresRtree_?this.featuresRtree_.forEachInExtent(t,e):this.featuresCollection_?this.featuresCollection_.forEach(e):void 0},e.prototype.forEachFeatureIntersectingExtent=function(t,e){return this.forEachFeatureInExtent(t,function(i){if(i.getGeometry().intersectsExtent(t)){var n=e(i);if(n)return n}})},e.prototype.getFeaturesCollection=function(){return this.featuresCollection_},e.prototype.getFeatures=function(){var t;return this.featuresCollection_?t=this.featuresCollection_.getArray():this.featuresRtree_&&(t=this.featuresRtree_.getAll(),tr(this.nullGeometryFeatures_)||Ho(t,Qn(this.nullGeometryFeatures_))),t},e.prototype.getFeaturesAtCoordinate=function(t){var e=[];return this.forEachFeatureAtCoordinateDirect(t,function(t){e.push(t)}),e},e.prototype.getFeaturesInExtent=function(t){return this.featuresRtree_.getInExtent(t)},e.prototype.getClosestFeatureToCoordinate=function(t,e){var i=t[0],n=t[1],r=null,o=[NaN,NaN],s=1/0,a=[-1/0,-1/0,1/0,1/0],h=e||mr;return this.featuresRtree_.forEachInExtent(a,function(t){if(h(t)){var e=t.getGeometry(),u=s;if((s=e.closestPointXY(i,n,o,s))<u){r=t;var l=Math.sqrt(s);a[0]=i-l,a[1]=n-l,a[2]=i+l,a[3]=n+l}}}),r},e.prototype.getExtent=function(t){return this.featuresRtree_.getExtent(t)},e.prototype.getFeatureById=function(t){var e=this.idIndex_[t.toString()];return void 0!==e?e:null},e.prototype.getFormat=function(){return this.format_},e.prototype.getOverlaps=function(){return this.overlaps_},e.prototype.getResolutions=function(){},e.prototype.getUrl=function(){return this.url_},e.prototype.handleFeatureChange_=function(t){var e=t.target,i=nn(e).toString(),n=e.getGeometry();if(n){var r=n.getExtent();i in this.nullGeometryFeatures_?(delete this.nullGeometryFeatures_[i],this.featuresRtree_&&this.featuresRtree_.insert(r,e)):this.featuresRtree_&&this.featuresRtree_.update(r,e)}else i in this.nullGeometryFeatures_||(this.featuresRtree_&&this.featuresRtree_.remove(e),this.nullGeometryFeatures_[i]=e);var o=e.getId();if(void 0!==o){var s=o.toString();i in this.undefIdIndex_?(delete this.undefIdIndex_[i],this.idIndex_[s]=e):this.idIndex_[s]!==e&&(this.removeFromIdIndex_(e),this.idIndex_[s]=e)}else i in this.undefIdIndex_||(this.removeFromIdIndex_(e),this.undefIdIndex_[i]=e);this.changed(),this.dispatchEvent(new e_(jv.CHANGEFEATURE,e))},e.prototype.hasFeature=function(t){var e=t.getId();return void 0!==e?e in this.idIndex_:nn(t).toString()in this.undefIdIndex_},e.prototype.isEmpty=function(){return this.featuresRtree_.isEmpty()&&tr(this.nullGeometryFeatures_)},e.prototype.loadFeatures=function(t,e,i){var n=this,r=this.loadedExtentsRtree_,o=this.strategy_(t,e);this.loading=!1;for(var s=function(t,s){var a=o[t];r.forEachInExtent(a,function(t){return dn(t.extent,a)})||(n.loader_.call(n,a,e,i),r.insert(a,{extent:a.slice()}),n.loading=!0)},a=0,h=o.length;a<h;++a)s(a)},e.prototype.removeLoadedExtent=function(t){var e,i=this.loadedExtentsRtree_;i.forEachInExtent(t,function(i){if(Tn(i.extent,t))return e=i,!0}),e&&i.remove(e)},e.prototype.removeFeature=function(t){var e=nn(t).toString();e in this.nullGeometryFeatures_?delete this.nullGeometryFeatures_[e]:this.featuresRtree_&&this.featuresRtree_.remove(t),this.removeFeatureInternal(t),this.changed()},e.prototype.removeFeatureInternal=function(t){var e=nn(t).toString();this.featureChangeKeys_[e].forEach(_r),delete this.featureChangeKeys_[e];var i=t.getId();void 0!==i?delete this.idIndex_[i.toString()]:delete this.undefIdIndex_[e],this.dispatchEvent(new e_(jv.REMOVEFEATURE,t))},e.prototype.removeFromIdIndex_=function(t){var e=!1;for(var i in this.idIndex_)if(this.idIndex_[i]===t){delete this.idIndex_[i],e=!0;break}return e},e.prototype.setLoader=function(t){this.loader_=t},e}($o),n_=function(t){function e(e){t.call(this,{attributions:e.attributions,extent:e.extent,projection:e.projection,wrapX:e.wrapX}),this.resolution=void 0,this.distance=void 0!==e.distance?e.distance:20,this.features=[],this.geometryFunction=e.geometryFunction||function(t){var e=t.getGeometry();return sn(e instanceof Fu,10),e},this.source=e.source,gr(this.source,br.CHANGE,this.refresh,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getDistance=function(){return this.distance},e.prototype.getSource=function(){return this.source},e.prototype.loadFeatures=function(t,e,i){this.source.loadFeatures(t,e,i),e!==this.resolution&&(this.clear(),this.resolution=e,this.cluster(),this.addFeatures(this.features))},e.prototype.setDistance=function(t){this.distance=t,this.refresh()},e.prototype.refresh=function(){this.clear(),this.cluster(),this.addFeatures(this.features),t.prototype.refresh.call(this)},e.prototype.cluster=function(){if(void 0!==this.resolution){this.features.length=0;for(var t=[1/0,1/0,-1/0,-1/0],e=this.distance*this.resolution,i=this.source.getFeatures(),n={},r=0,o=i.length;r<o;r++){var s=i[r];if(!(nn(s).toString()in n)){var a=this.geometryFunction(s);if(a){En(a.getCoordinates(),t),ln(t,e,t);var h=this.source.getFeaturesInExtent(t);h=h.filter(function(t){var e=nn(t).toString();return!(e in n)&&(n[e]=!0,!0)}),this.features.push(this.createCluster(h))}}}}},e.prototype.createCluster=function(t){for(var e=[0,0],i=t.length-1;i>=0;--i){var n=this.geometryFunction(t[i]);n?Tv(e,n.getCoordinates()):t.splice(i,1)}Ov(e,1/t.length);var r=new La(new Fu(e));return r.set("features",t),r},e}(i_),r_=function(){function t(){$a(this,t)}return Bh(t,[{key:"getSource",value:function(){return this.source}},{key:"setSource",value:function(t){return this.source=t,this}},{key:"setAttributions",value:function(t){return this.attributions=t,this}},{key:"setDistance",value:function(t){return this.distance=t,this}},{key:"setGeometryFunction",value:function(t){return this.geometryFunction=t,this}},{key:"setLogo",value:function(t){return this.logo=t,this}},{key:"setProjection",value:function(t){return this.projection=t,this}},{key:"setWrapX",value:function(t){return this.wrapX=t,this}},{key:"set",value:function(t,e){this.values||(this.values={}),this.values[t]=e}},{key:"build",value:function(){bh(this.source,"source is provided");var t=new n_({attributions:this.attributions,distance:this.distance,geometryFunction:this.geometryFunction,logo:this.logo,projection:this.projection,source:this.source,wrapX:this.wrapX});return t.setProperties(this.values),t}},{key:"promise",value:function(){var t=this;return rd(100).pipe(wd(function(){return!t.source}),Td(),_p(this.build.bind(this))).toPromise(ed)}}]),t}(),o_={distance:{type:Number,default:20},geomFuncFactory:{type:Function,default:function(){return function(t){var e=t.getGeometry();if(e){var i=cc(e);return i?ec(i):void 0}}}}};var s_=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-cluster",mixins:[qg,cg],props:o_,computed:{geomFunc:function(){return this.geomFuncFactory()}},methods:{createSource:function(){return this._sourceBuilder=(new r_).setAttributions(this.attributions).setDistance(this.distance).setGeometryFunction(this.geomFunc).setLogo(this.logo).setProjection(this.projection).setWrapX(this.wrapX),this._sourceBuilder.promise()},getServices:function(){return af(qg.methods.getServices.call(this),cg.methods.getServices.call(this))},getSourceTarget:function(){return this._sourceBuilder}},watch:{distance:function(t){this.$source&&t!==this.$source.getDistance()&&this.$source.setDistance(t)}}},void 0,void 0);function a_(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};a_.installed||(a_.installed=!0,e=Zi(e,"dataProjection"),pe(s_,e),t.component(s_.name,s_))}var h_,u_=Object.freeze({default:a_,Source:s_,install:a_}),l_=a.f,c_=(h_=!1,function(t){for(var e,i=s(t),n=ct(i),r=n.length,o=0,a=[];r>o;)l_.call(i,e=n[o++])&&a.push(h_?[e,i[e]]:i[e]);return a});M(M.S,"Object",{values:function(t){return c_(t)}});var p_=w.Object.values,f_={SINGLECLICK:"singleclick",CLICK:br.CLICK,DBLCLICK:br.DBLCLICK,POINTERDRAG:"pointerdrag",POINTERMOVE:"pointermove",POINTERDOWN:"pointerdown",POINTERUP:"pointerup",POINTEROVER:"pointerover",POINTEROUT:"pointerout",POINTERENTER:"pointerenter",POINTERLEAVE:"pointerleave",POINTERCANCEL:"pointercancel"},d_=function(t){var e=t.originalEvent;return e.altKey&&!(e.metaKey||e.ctrlKey)&&!e.shiftKey},g_=function(t){var e=t.originalEvent;return e.altKey&&!(e.metaKey||e.ctrlKey)&&e.shiftKey},y_=function(t){return t.target.getTargetElement()===document.activeElement},v_=mr,__=function(t){var e=t.originalEvent;return 0==e.button&&!(Ys&&Xs&&e.ctrlKey)},m_=Er,E_=function(t){return"pointermove"==t.type},x_=function(t){return t.type==f_.SINGLECLICK},S_=function(t){var e=t.originalEvent;return!e.altKey&&!(e.metaKey||e.ctrlKey)&&!e.shiftKey},T_=function(t){var e=t.originalEvent;return!e.altKey&&!(e.metaKey||e.ctrlKey)&&e.shiftKey},w_=function(t){var e=t.originalEvent.target.tagName;return"INPUT"!==e&&"SELECT"!==e&&"TEXTAREA"!==e},b_=function(t){return sn(t.pointerEvent,56),"mouse"==t.pointerEvent.pointerType},C_=function(t){var e=t.pointerEvent;return e.isPrimary&&0===e.button},R_=function(t){function e(e,i,n){t.call(this,e),this.map=i,this.frameState=void 0!==n?n:null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),O_=function(t){function e(e,i,n,r,o){t.call(this,e,i,o),this.originalEvent=n,this.pixel=i.getEventPixel(n),this.coordinate=i.getCoordinateFromPixel(this.pixel),this.dragging=void 0!==r&&r}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.preventDefault=function(){t.prototype.preventDefault.call(this),this.originalEvent.preventDefault()},e.prototype.stopPropagation=function(){t.prototype.stopPropagation.call(this),this.originalEvent.stopPropagation()},e}(R_),I_=function(t){function e(e,i,n,r,o){t.call(this,e,i,n.originalEvent,r,o),this.pointerEvent=n}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(O_),P_=function(t,e){this.dispatcher=t,this.mapping_=e};P_.prototype.getEvents=function(){return Object.keys(this.mapping_)},P_.prototype.getHandlerForEvent=function(t){return this.mapping_[t]};var F_=1,L_="mouse";function M_(t){if(!this.isEventSimulatedFromTouch_(t)){F_.toString()in this.pointerMap&&this.cancel(t);var e=D_(t,this.dispatcher);this.pointerMap[F_.toString()]=t,this.dispatcher.down(e,t)}}function A_(t){if(!this.isEventSimulatedFromTouch_(t)){var e=D_(t,this.dispatcher);this.dispatcher.move(e,t)}}function N_(t){if(!this.isEventSimulatedFromTouch_(t)){var e=this.pointerMap[F_.toString()];if(e&&e.button===t.button){var i=D_(t,this.dispatcher);this.dispatcher.up(i,t),this.cleanupMouse()}}}function k_(t){if(!this.isEventSimulatedFromTouch_(t)){var e=D_(t,this.dispatcher);this.dispatcher.enterOver(e,t)}}function j_(t){if(!this.isEventSimulatedFromTouch_(t)){var e=D_(t,this.dispatcher);this.dispatcher.leaveOut(e,t)}}var G_=function(t){function e(e){var i={mousedown:M_,mousemove:A_,mouseup:N_,mouseover:k_,mouseout:j_};t.call(this,e,i),this.pointerMap=e.pointerMap,this.lastTouches=[]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.isEventSimulatedFromTouch_=function(t){for(var e=this.lastTouches,i=t.clientX,n=t.clientY,r=0,o=e.length,s=void 0;r<o&&(s=e[r]);r++){var a=Math.abs(i-s[0]),h=Math.abs(n-s[1]);if(a<=25&&h<=25)return!0}return!1},e.prototype.cancel=function(t){var e=D_(t,this.dispatcher);this.dispatcher.cancel(e,t),this.cleanupMouse()},e.prototype.cleanupMouse=function(){delete this.pointerMap[F_.toString()]},e}(P_);function D_(t,e){var i=e.cloneEvent(t,t),n=i.preventDefault;return i.preventDefault=function(){t.preventDefault(),n()},i.pointerId=F_,i.isPrimary=!0,i.pointerType=L_,i}var $_=xr,V_=Er,z_=Er,Y_=xr,X_=function(t){function e(e){var i=e||{};t.call(this,{handleEvent:i.handleEvent||B_}),this.handleDownEvent_=i.handleDownEvent?i.handleDownEvent:z_,this.handleDragEvent_=i.handleDragEvent?i.handleDragEvent:$_,this.handleMoveEvent_=i.handleMoveEvent?i.handleMoveEvent:Y_,this.handleUpEvent_=i.handleUpEvent?i.handleUpEvent:V_,this.handlingDownUpSequence=!1,this.stopDown=i.stopDown?i.stopDown:K_,this.trackedPointers_={},this.targetPointers=[]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.updateTrackedPointers_=function(t){if(function(t){var e=t.type;return e===f_.POINTERDOWN||e===f_.POINTERDRAG||e===f_.POINTERUP}(t)){var e=t.pointerEvent,i=e.pointerId.toString();t.type==f_.POINTERUP?delete this.trackedPointers_[i]:t.type==f_.POINTERDOWN?this.trackedPointers_[i]=e:i in this.trackedPointers_&&(this.trackedPointers_[i]=e),this.targetPointers=Qn(this.trackedPointers_)}},e}(rg);function U_(t){for(var e=t.length,i=0,n=0,r=0;r<e;r++)i+=t[r].clientX,n+=t[r].clientY;return[i/e,n/e]}function B_(t){if(!(t instanceof I_))return!0;var e=!1;if(this.updateTrackedPointers_(t),this.handlingDownUpSequence){if(t.type==f_.POINTERDRAG)this.handleDragEvent_(t);else if(t.type==f_.POINTERUP){var i=this.handleUpEvent_(t);this.handlingDownUpSequence=i&&this.targetPointers.length>0}}else if(t.type==f_.POINTERDOWN){var n=this.handleDownEvent_(t);n&&t.preventDefault(),this.handlingDownUpSequence=n,e=this.stopDown(n)}else t.type==f_.POINTERMOVE&&this.handleMoveEvent_(t);return!e}function K_(t){return t}var W_={IMAGE:"IMAGE",TILE:"TILE",VECTOR_TILE:"VECTOR_TILE",VECTOR:"VECTOR"},H_="postcompose",Z_="precompose",q_="render",J_="rendercomplete",Q_=function(t){function e(e){var i=qn({},e);delete i.source,t.call(this,i),this.mapPrecomposeKey_=null,this.mapRenderKey_=null,this.sourceChangeKey_=null,e.map&&this.setMap(e.map),gr(this,Go(gg.SOURCE),this.handleSourcePropertyChange_,this);var n=e.source?e.source:null;this.setSource(n)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getLayersArray=function(t){var e=t||[];return e.push(this),e},e.prototype.getLayerStatesArray=function(t){var e=t||[];return e.push(this.getLayerState()),e},e.prototype.getSource=function(){return this.get(gg.SOURCE)||null},e.prototype.getSourceState=function(){var t=this.getSource();return t?t.getState():Do.UNDEFINED},e.prototype.handleSourceChange_=function(){this.changed()},e.prototype.handleSourcePropertyChange_=function(){this.sourceChangeKey_&&(_r(this.sourceChangeKey_),this.sourceChangeKey_=null);var t=this.getSource();t&&(this.sourceChangeKey_=gr(t,br.CHANGE,this.handleSourceChange_,this)),this.changed()},e.prototype.setMap=function(t){this.mapPrecomposeKey_&&(_r(this.mapPrecomposeKey_),this.mapPrecomposeKey_=null),t||this.changed(),this.mapRenderKey_&&(_r(this.mapRenderKey_),this.mapRenderKey_=null),t&&(this.mapPrecomposeKey_=gr(t,Z_,function(t){var e=this.getLayerState();e.managed=!1,void 0===this.getZIndex()&&(e.zIndex=1/0),t.frameState.layerStatesArray.push(e),t.frameState.layerStates[nn(this)]=e},this),this.mapRenderKey_=gr(this,br.CHANGE,t.render,t),this.changed())},e.prototype.setSource=function(t){this.set(gg.SOURCE,t)},e}(yg);function tm(t,e){return t.visible&&e>=t.minResolution&&e<t.maxResolution}var em={IMAGE:"image",VECTOR:"vector"},im="renderOrder",nm=function(t){function e(e){var i=e||{},n=qn({},i);delete n.style,delete n.renderBuffer,delete n.updateWhileAnimating,delete n.updateWhileInteracting,t.call(this,n),this.declutter_=void 0!==i.declutter&&i.declutter,this.renderBuffer_=void 0!==i.renderBuffer?i.renderBuffer:100,this.style_=null,this.styleFunction_=void 0,this.setStyle(i.style),this.updateWhileAnimating_=void 0!==i.updateWhileAnimating&&i.updateWhileAnimating,this.updateWhileInteracting_=void 0!==i.updateWhileInteracting&&i.updateWhileInteracting,this.renderMode_=i.renderMode||em.VECTOR,this.type=W_.VECTOR}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getDeclutter=function(){return this.declutter_},e.prototype.setDeclutter=function(t){this.declutter_=t},e.prototype.getRenderBuffer=function(){return this.renderBuffer_},e.prototype.getRenderOrder=function(){return this.get(im)},e.prototype.getStyle=function(){return this.style_},e.prototype.getStyleFunction=function(){return this.styleFunction_},e.prototype.getUpdateWhileAnimating=function(){return this.updateWhileAnimating_},e.prototype.getUpdateWhileInteracting=function(){return this.updateWhileInteracting_},e.prototype.setRenderOrder=function(t){this.set(im,t)},e.prototype.setStyle=function(t){var e,i,n;this.style_=void 0!==t?t:Ia,this.styleFunction_=null===t?void 0:("function"==typeof(e=this.style_)?i=e:(Array.isArray(e)?n=e:(sn(e instanceof Ra,41),n=[e]),i=function(){return n}),i),this.changed()},e.prototype.getRenderMode=function(){return this.renderMode_},e}(Q_);nm.prototype.getSource;var rm={POINT:"Point",LINE_STRING:"LineString",POLYGON:"Polygon",CIRCLE:"Circle"},om="drawstart",sm="drawend",am=function(t){function e(e,i){t.call(this,e),this.feature=i}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),hm=function(t){function e(e){t.call(this,{handleDownEvent:lm,handleEvent:um,handleUpEvent:cm,stopDown:Er}),this.shouldHandle_=!1,this.downPx_=null,this.downTimeout_,this.lastDragTime_,this.freehand_=!1,this.source_=e.source?e.source:null,this.features_=e.features?e.features:null,this.snapTolerance_=e.snapTolerance?e.snapTolerance:12,this.type_=e.type,this.mode_=function(t){var e;t===Dr.POINT||t===Dr.MULTI_POINT?e=rm.POINT:t===Dr.LINE_STRING||t===Dr.MULTI_LINE_STRING?e=rm.LINE_STRING:t===Dr.POLYGON||t===Dr.MULTI_POLYGON?e=rm.POLYGON:t===Dr.CIRCLE&&(e=rm.CIRCLE);return e}(this.type_),this.stopClick_=!!e.stopClick,this.minPoints_=e.minPoints?e.minPoints:this.mode_===rm.POLYGON?3:2,this.maxPoints_=e.maxPoints?e.maxPoints:1/0,this.finishCondition_=e.finishCondition?e.finishCondition:mr;var i,n=e.geometryFunction;if(!n)if(this.type_===Dr.CIRCLE)n=function(t,e){var i=e||new tc([NaN,NaN]),n=Iv(t[0],t[1]);return i.setCenterAndRadius(t[0],Math.sqrt(n)),i};else{var r,o=this.mode_;o===rm.POINT?r=Fu:o===rm.LINE_STRING?r=Iu:o===rm.POLYGON&&(r=Yu),n=function(t,e){var i=e;return i?o===rm.POLYGON?t[0].length?i.setCoordinates([t[0].concat([t[0][0]])]):i.setCoordinates([]):i.setCoordinates(t):i=new r(t),i}}this.geometryFunction_=n,this.dragVertexDelay_=void 0!==e.dragVertexDelay?e.dragVertexDelay:500,this.finishCoordinate_=null,this.sketchFeature_=null,this.sketchPoint_=null,this.sketchCoords_=null,this.sketchLine_=null,this.sketchLineCoords_=null,this.squaredClickTolerance_=e.clickTolerance?e.clickTolerance*e.clickTolerance:36,this.overlay_=new nm({source:new i_({useSpatialIndex:!1,wrapX:!!e.wrapX&&e.wrapX}),style:e.style?e.style:(i=Pa(),function(t,e){return i[t.getGeometry().getType()]}),updateWhileInteracting:!0}),this.geometryName_=e.geometryName,this.condition_=e.condition?e.condition:S_,this.freehandCondition_,e.freehand?this.freehandCondition_=v_:this.freehandCondition_=e.freehandCondition?e.freehandCondition:T_,gr(this,Go(ng.ACTIVE),this.updateState_,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setMap=function(e){t.prototype.setMap.call(this,e),this.updateState_()},e.prototype.getOverlay=function(){return this.overlay_},e.prototype.handlePointerMove_=function(t){if(this.downPx_&&(!this.freehand_&&this.shouldHandle_||this.freehand_&&!this.shouldHandle_)){var e=this.downPx_,i=t.pixel,n=e[0]-i[0],r=e[1]-i[1],o=n*n+r*r;if(this.shouldHandle_=this.freehand_?o>this.squaredClickTolerance_:o<=this.squaredClickTolerance_,!this.shouldHandle_)return!0}return this.finishCoordinate_?this.modifyDrawing_(t):this.createOrUpdateSketchPoint_(t),!0},e.prototype.atFinish_=function(t){var e=!1;if(this.sketchFeature_){var i=!1,n=[this.finishCoordinate_];if(this.mode_===rm.LINE_STRING?i=this.sketchCoords_.length>this.minPoints_:this.mode_===rm.POLYGON&&(i=this.sketchCoords_[0].length>this.minPoints_,n=[this.sketchCoords_[0][0],this.sketchCoords_[0][this.sketchCoords_[0].length-2]]),i)for(var r=t.map,o=0,s=n.length;o<s;o++){var a=n[o],h=r.getPixelFromCoordinate(a),u=t.pixel,l=u[0]-h[0],c=u[1]-h[1],p=this.freehand_?1:this.snapTolerance_;if(e=Math.sqrt(l*l+c*c)<=p){this.finishCoordinate_=a;break}}}return e},e.prototype.createOrUpdateSketchPoint_=function(t){var e=t.coordinate.slice();this.sketchPoint_?this.sketchPoint_.getGeometry().setCoordinates(e):(this.sketchPoint_=new La(new Fu(e)),this.updateSketchFeatures_())},e.prototype.startDrawing_=function(t){var e=t.coordinate;this.finishCoordinate_=e,this.mode_===rm.POINT?this.sketchCoords_=e.slice():this.mode_===rm.POLYGON?(this.sketchCoords_=[[e.slice(),e.slice()]],this.sketchLineCoords_=this.sketchCoords_[0]):this.sketchCoords_=[e.slice(),e.slice()],this.sketchLineCoords_&&(this.sketchLine_=new La(new Iu(this.sketchLineCoords_)));var i=this.geometryFunction_(this.sketchCoords_);this.sketchFeature_=new La,this.geometryName_&&this.sketchFeature_.setGeometryName(this.geometryName_),this.sketchFeature_.setGeometry(i),this.updateSketchFeatures_(),this.dispatchEvent(new am(om,this.sketchFeature_))},e.prototype.modifyDrawing_=function(t){var e,i,n,r=t.coordinate,o=this.sketchFeature_.getGeometry();(this.mode_===rm.POINT?i=this.sketchCoords_:this.mode_===rm.POLYGON?(i=(e=this.sketchCoords_[0])[e.length-1],this.atFinish_(t)&&(r=this.finishCoordinate_.slice())):i=(e=this.sketchCoords_)[e.length-1],i[0]=r[0],i[1]=r[1],this.geometryFunction_(this.sketchCoords_,o),this.sketchPoint_)&&this.sketchPoint_.getGeometry().setCoordinates(r);if(o instanceof Yu&&this.mode_!==rm.POLYGON){this.sketchLine_||(this.sketchLine_=new La);var s=o.getLinearRing(0);(n=this.sketchLine_.getGeometry())?(n.setFlatCoordinates(s.getLayout(),s.getFlatCoordinates()),n.changed()):(n=new Iu(s.getFlatCoordinates(),s.getLayout()),this.sketchLine_.setGeometry(n))}else this.sketchLineCoords_&&(n=this.sketchLine_.getGeometry()).setCoordinates(this.sketchLineCoords_);this.updateSketchFeatures_()},e.prototype.addToDrawing_=function(t){var e,i,n=t.coordinate,r=this.sketchFeature_.getGeometry();this.mode_===rm.LINE_STRING?(this.finishCoordinate_=n.slice(),(i=this.sketchCoords_).length>=this.maxPoints_&&(this.freehand_?i.pop():e=!0),i.push(n.slice()),this.geometryFunction_(i,r)):this.mode_===rm.POLYGON&&((i=this.sketchCoords_[0]).length>=this.maxPoints_&&(this.freehand_?i.pop():e=!0),i.push(n.slice()),e&&(this.finishCoordinate_=i[0]),this.geometryFunction_(this.sketchCoords_,r)),this.updateSketchFeatures_(),e&&this.finishDrawing()},e.prototype.removeLastPoint=function(){if(this.sketchFeature_){var t,e=this.sketchFeature_.getGeometry();this.mode_===rm.LINE_STRING?((t=this.sketchCoords_).splice(-2,1),this.geometryFunction_(t,e),t.length>=2&&(this.finishCoordinate_=t[t.length-2].slice())):this.mode_===rm.POLYGON&&((t=this.sketchCoords_[0]).splice(-2,1),this.sketchLine_.getGeometry().setCoordinates(t),this.geometryFunction_(this.sketchCoords_,e)),0===t.length&&(this.finishCoordinate_=null),this.updateSketchFeatures_()}},e.prototype.finishDrawing=function(){var t=this.abortDrawing_();if(t){var e=this.sketchCoords_,i=t.getGeometry();this.mode_===rm.LINE_STRING?(e.pop(),this.geometryFunction_(e,i)):this.mode_===rm.POLYGON&&(e[0].pop(),this.geometryFunction_(e,i),e=i.getCoordinates()),this.type_===Dr.MULTI_POINT?t.setGeometry(new Lu([e])):this.type_===Dr.MULTI_LINE_STRING?t.setGeometry(new Pu([e])):this.type_===Dr.MULTI_POLYGON&&t.setGeometry(new Wu([e])),this.dispatchEvent(new am(sm,t)),this.features_&&this.features_.push(t),this.source_&&this.source_.addFeature(t)}},e.prototype.abortDrawing_=function(){this.finishCoordinate_=null;var t=this.sketchFeature_;return t&&(this.sketchFeature_=null,this.sketchPoint_=null,this.sketchLine_=null,this.overlay_.getSource().clear(!0)),t},e.prototype.extend=function(t){var e=t.getGeometry();this.sketchFeature_=t,this.sketchCoords_=e.getCoordinates();var i=this.sketchCoords_[this.sketchCoords_.length-1];this.finishCoordinate_=i.slice(),this.sketchCoords_.push(i.slice()),this.updateSketchFeatures_(),this.dispatchEvent(new am(om,this.sketchFeature_))},e.prototype.updateSketchFeatures_=function(){var t=[];this.sketchFeature_&&t.push(this.sketchFeature_),this.sketchLine_&&t.push(this.sketchLine_),this.sketchPoint_&&t.push(this.sketchPoint_);var e=this.overlay_.getSource();e.clear(!0),e.addFeatures(t)},e.prototype.updateState_=function(){var t=this.getMap(),e=this.getActive();t&&e||this.abortDrawing_(),this.overlay_.setMap(e?t:null)},e}(X_);function um(t){t.originalEvent.type===br.CONTEXTMENU&&t.preventDefault(),this.freehand_=this.mode_!==rm.POINT&&this.freehandCondition_(t);var e=t.type===f_.POINTERMOVE,i=!0;this.lastDragTime_&&t.type===f_.POINTERDRAG&&(Date.now()-this.lastDragTime_>=this.dragVertexDelay_?(this.downPx_=t.pixel,this.shouldHandle_=!this.freehand_,e=!0):this.lastDragTime_=void 0,this.shouldHandle_&&this.downTimeout_&&(clearTimeout(this.downTimeout_),this.downTimeout_=void 0));return this.freehand_&&t.type===f_.POINTERDRAG&&null!==this.sketchFeature_?(this.addToDrawing_(t),i=!1):this.freehand_&&t.type===f_.POINTERDOWN?i=!1:e?(i=t.type===f_.POINTERMOVE)&&this.freehand_?i=this.handlePointerMove_(t):(t.pointerEvent.pointerType==L_||t.type===f_.POINTERDRAG&&!this.downTimeout_)&&this.handlePointerMove_(t):t.type===f_.DBLCLICK&&(i=!1),B_.call(this,t)&&i}function lm(t){return this.shouldHandle_=!this.freehand_,this.freehand_?(this.downPx_=t.pixel,this.finishCoordinate_||this.startDrawing_(t),!0):!!this.condition_(t)&&(this.lastDragTime_=Date.now(),this.downTimeout_=setTimeout(function(){this.handlePointerMove_(new I_(f_.POINTERMOVE,t.map,t.pointerEvent,t.frameState))}.bind(this),this.dragVertexDelay_),this.downPx_=t.pixel,!0)}function cm(t){var e=!0;this.downTimeout_&&(clearTimeout(this.downTimeout_),this.downTimeout_=void 0),this.handlePointerMove_(t);var i=this.mode_===rm.CIRCLE;return this.shouldHandle_?(this.finishCoordinate_?this.freehand_||i?this.finishDrawing():this.atFinish_(t)?this.finishCondition_(t)&&this.finishDrawing():this.addToDrawing_(t):(this.startDrawing_(t),this.mode_===rm.POINT&&this.finishDrawing()),e=!1):this.freehand_&&(this.finishCoordinate_=null,this.abortDrawing_()),!e&&this.stopClick_&&t.stopPropagation(),e}var pm,fm=function(t){return qi(t.replace(/([-_]\w)/g,function(t){return t[1].toUpperCase()}))},dm={source:{type:String,required:!0},clickTolerance:{type:Number,default:6},snapTolerance:{type:Number,default:12},type:{type:String,required:!0,validator:function(t){return p_(Vh).includes(fm(t))}},stopClick:{type:Boolean,default:!1},maxPoints:Number,minPoints:Number,finishCondition:Function,geometryFunction:Function,geometryName:{type:String,default:"geometry"},condition:{type:Function,default:S_},freehand:{type:Boolean,default:!1},freehandCondition:{type:Function,default:T_},wrapX:{type:Boolean,default:!1}},gm={createInteraction:(pm=nd(_e.mark(function t(){var e,i,n;return _e.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:return e=this.makeIdent(this.source),t.next=3,this.$identityMap.get(e,this.$options.INSTANCE_PROMISE_POOL);case 3:return Ni((i=t.sent).getFeatures)&&Mc(n=i.getFeatures())&&(i=n),t.abrupt("return",new hm({source:Ac(i)?i:void 0,features:Mc(i)?i:void 0,clickTolerance:this.clickTolerance,snapTolerance:this.snapTolerance,type:fm(this.type),stopClick:this.stopClick,maxPoints:this.maxPoints,minPoints:this.minPoints,finishCondition:this.finishCondition,style:this.createStyleFunc(),geometryFunction:this.geometryFunction,geometryName:this.geometryName,condition:this.condition,freehand:this.freehand,freehandCondition:this.freehandCondition,wrapX:this.wrapX}));case 6:case"end":return t.stop()}},t,this)})),function(){return pm.apply(this,arguments)}),getDefaultStyles:function(){var t=Hi($y(),function(t){return t.map(zy)});return function(e){if(e.getGeometry())return t[e.getGeometry().getType()]}},getServices:function(){return af(ig.methods.getServices.call(this),Tg.methods.getServices.call(this))},getStyleTarget:function(){return this.$interaction},mount:function(){ig.methods.mount.call(this)},unmount:function(){ig.methods.unmount.call(this)},setStyle:function(t){t!==this._styles&&(this._styles=t,this.scheduleRefresh())},subscribeAll:function(){(function(){var t=this;Mh(this);var e=nf(rf(this.$interaction,"drawstart").pipe(_p(function(t){return Ga(t.feature),t})),rf(this.$interaction,"drawend"));this.subscribeTo(e,function(e){++t.rev,t.$emit(e.type,e)})}).call(this)}},ym=Gd(["source","type"],function(){return function(){this.scheduleRecreate()}});var vm=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="interaction.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-interaction-draw",mixins:[ig,Tg],props:dm,methods:gm,watch:ym,stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}}},void 0,void 0);function _m(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};_m.installed||(_m.installed=!0,e=Zi(e,"dataProjection"),pe(vm,e),t.component(vm.name,vm))}var mm=Object.freeze({default:_m,Interaction:vm,install:_m}),Em={id:{type:[String,Number],default:function(){return ja()}},properties:{type:Object,default:function(){return Wa(null)}}};var xm=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="feature.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this,e=t.$createElement;return(t._self._c||e)("i",{class:[t.$options.name],staticStyle:{display:"none !important"},attrs:{id:[t.$options.name,t.id].join("-")}},[t._t("default",null,{id:t.id,properties:t.properties,geometry:t.geometry,point:t.point})],2)},staticRenderFns:[]},0,{name:"vl-feature",mixins:[jd,$d,Xd,Tg,Uc],props:Em,computed:{geometry:function(){if(this.rev&&this.resolvedDataProjection&&this.$geometry)return this.writeGeometryInDataProj(this.$geometry)},point:function(){if(this.pointViewProj&&this.resolvedDataProjection)return this.pointToDataProj(this.pointViewProj)},geometryViewProj:function(){if(this.rev&&this.resolvedDataProjection&&this.$geometry)return this.writeGeometryInViewProj(this.$geometry)},pointViewProj:function(){if(this.rev&&this.$geometry)return cc(this.$geometry)}},methods:{createOlObject:function(){var t=new La(this.properties);return t.setId(this.id),t.setGeometry(this.$geometry),t},getGeometryTarget:function(){return this.$feature},getServices:function(){var t=this;return af(jd.methods.getServices.call(this),Xd.methods.getServices.call(this),Tg.methods.getServices.call(this),{get feature(){return t.$feature}})},getStyleTarget:function(){return this.$feature},isAtPixel:function(t){var e=this;return Rh(this),this.$map.forEachFeatureAtPixel(t,function(t){return t===e.$feature},{layerFilter:function(t){return t===e.$layer}})},mount:function(){this.$featuresContainer&&this.$featuresContainer.addFeature(this),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.$featuresContainer&&this.$featuresContainer.removeFeature(this)},subscribeAll:function(){(function(){var t=this;e=this,bh(e.$feature,'component has "$feature" property');var e;var i=rf(this.$feature,"propertychange",function(e){var i,n=e.key;return{prop:n,value:(i=n,t.$feature.get(i))}}).pipe($p(100),gp(zi)),n=rf(this.$feature,"change").pipe(_p(function(){return Op.create(function(e){t.$feature.getId()!==t.id&&e.next({prop:"id",value:t.$feature.getId()})})}),ef(),$p(100),gp(zi)),r=nf(i,n);this.subscribeTo(r,function(e){var i=e.prop,n=e.value;++t.rev,"id"===i?t.$emit("update:".concat(i),n):i!==t.$feature.getGeometryName()&&t.$emit("update:properties",pe({},t.properties,he({},i,n)))})}).call(this)}},watch:{id:function(t){this.$feature&&t!==this.$feature.getId()&&this.$feature.setId(t)},properties:function(t){t=$i(t),this.$feature&&!zi(t,$i(this.$feature.getProperties()))&&this.$feature.setProperties($i(t))}},created:function(){var t=this;Wc(this,{$feature:{enumerable:!0,get:function(){return t.$olObject}},$layer:{enumerable:!0,get:function(){return t.$services&&t.$services.layer}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$featuresContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.featuresContainer}}})}},void 0,!1);function Sm(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Sm.installed||(Sm.installed=!0,e=Zi(e,"dataProjection"),pe(xm,e),t.component(xm.name,xm))}var Tm=Object.freeze({default:Sm,Feature:xm,install:Sm}),wm={color:[String,Array]};var bm=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-fill",mixins:[Jd],props:wm,methods:{createStyle:function(){return new ba({color:this.color})},mount:function(){this.$stylesContainer&&this.$stylesContainer.setFill(this)},unmount:function(){this.$stylesContainer&&this.$stylesContainer.setFill(void 0)}},watch:{color:function(t){this.$style&&!zi(t,this.$style.getColor())&&(this.$style.setColor(t),this.scheduleRefresh())}}},void 0,void 0);function Cm(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Cm.installed||(Cm.installed=!0,e=Zi(e,"dataProjection"),pe(bm,e),t.component(bm.name,bm))}var Rm=Object.freeze({default:Cm,Style:bm,install:Cm}),Om={ACCURACY:"accuracy",ACCURACY_GEOMETRY:"accuracyGeometry",ALTITUDE:"altitude",ALTITUDE_ACCURACY:"altitudeAccuracy",HEADING:"heading",POSITION:"position",PROJECTION:"projection",SPEED:"speed",TRACKING:"tracking",TRACKING_OPTIONS:"trackingOptions"},Im=function(t){function e(e){t.call(this);var i=e||{};this.position_=null,this.transform_=co,this.watchId_=void 0,gr(this,Go(Om.PROJECTION),this.handleProjectionChanged_,this),gr(this,Go(Om.TRACKING),this.handleTrackingChanged_,this),void 0!==i.projection&&this.setProjection(i.projection),void 0!==i.trackingOptions&&this.setTrackingOptions(i.trackingOptions),this.setTracking(void 0!==i.tracking&&i.tracking)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.setTracking(!1),t.prototype.disposeInternal.call(this)},e.prototype.handleProjectionChanged_=function(){var t=this.getProjection();t&&(this.transform_=mo(fo("EPSG:4326"),t),this.position_&&this.set(Om.POSITION,this.transform_(this.position_)))},e.prototype.handleTrackingChanged_=function(){if(Ks){var t=this.getTracking();t&&void 0===this.watchId_?this.watchId_=navigator.geolocation.watchPosition(this.positionChange_.bind(this),this.positionError_.bind(this),this.getTrackingOptions()):t||void 0===this.watchId_||(navigator.geolocation.clearWatch(this.watchId_),this.watchId_=void 0)}},e.prototype.positionChange_=function(t){var e=t.coords;this.set(Om.ACCURACY,e.accuracy),this.set(Om.ALTITUDE,null===e.altitude?void 0:e.altitude),this.set(Om.ALTITUDE_ACCURACY,null===e.altitudeAccuracy?void 0:e.altitudeAccuracy),this.set(Om.HEADING,null===e.heading?void 0:Wn(e.heading)),this.position_?(this.position_[0]=e.longitude,this.position_[1]=e.latitude):this.position_=[e.longitude,e.latitude];var i=this.transform_(this.position_);this.set(Om.POSITION,i),this.set(Om.SPEED,null===e.speed?void 0:e.speed);var n=Xu(this.position_,e.accuracy);n.applyTransform(this.transform_),this.set(Om.ACCURACY_GEOMETRY,n),this.changed()},e.prototype.positionError_=function(t){t.type=br.ERROR,this.setTracking(!1),this.dispatchEvent(t)},e.prototype.getAccuracy=function(){return this.get(Om.ACCURACY)},e.prototype.getAccuracyGeometry=function(){return this.get(Om.ACCURACY_GEOMETRY)||null},e.prototype.getAltitude=function(){return this.get(Om.ALTITUDE)},e.prototype.getAltitudeAccuracy=function(){return this.get(Om.ALTITUDE_ACCURACY)},e.prototype.getHeading=function(){return this.get(Om.HEADING)},e.prototype.getPosition=function(){return this.get(Om.POSITION)},e.prototype.getProjection=function(){return this.get(Om.PROJECTION)},e.prototype.getSpeed=function(){return this.get(Om.SPEED)},e.prototype.getTracking=function(){return this.get(Om.TRACKING)},e.prototype.getTrackingOptions=function(){return this.get(Om.TRACKING_OPTIONS)},e.prototype.setProjection=function(t){this.set(Om.PROJECTION,fo(t))},e.prototype.setTracking=function(t){this.set(Om.TRACKING,t)},e.prototype.setTrackingOptions=function(t){this.set(Om.TRACKING_OPTIONS,t)},e}(ko),Pm={tracking:{type:Boolean,default:!0},trackingOptions:Object,projection:String};var Fm=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geoloc.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this,e=t.$createElement;return(t._self._c||e)("i",{class:[t.$options.name],staticStyle:{display:"none !important"}},[t._t("default",null,{accuracy:t.accuracy,altitude:t.altitude,altitudeAccuracy:t.altitudeAccuracy,heading:t.heading,position:t.position,speed:t.speed})],2)},staticRenderFns:[]},0,{name:"vl-geoloc",mixins:[jd,$d,Uc],props:Pm,computed:{accuracy:function(){if(this.rev&&this.$geolocation)return this.$geolocation.getAccuracy()},altitude:function(){if(this.rev&&this.$geolocation)return this.$geolocation.getAltitude()},altitudeAccuracy:function(){if(this.rev&&this.$geolocation)return this.$geolocation.getAltitudeAccuracy()},heading:function(){if(this.rev&&this.$geolocation)return this.$geolocation.getHeading()},speed:function(){if(this.rev&&this.$geolocation)return this.$geolocation.getSpeed()},position:function(){if(this.rev&&this.$geolocation)return this.$geolocation.getPosition()},positionViewProj:function(){if(this.position&&this.resolvedDataProjection)return this.pointToViewProj(this.position)}},methods:{createOlObject:function(){return new Im({tracking:this.tracking,trackingOptions:this.trackingOptions,projection:this.resolvedDataProjection})},mount:function(){this.subscribeAll()},unmount:function(){Ih(this),this.unsubscribeAll(),this.$geolocation.setTracking(!1)},subscribeAll:function(){(function(){var t=this;Ih(this);var e=nf(Hd(this.$geolocation,["accuracy","altitude","altitudeaccuracy","heading","speed"],!0,100),Hd(this.$geolocation,"position",!0,100,function(){return t.position}));this.subscribeTo(e,function(e){var i=e.prop,n=e.value;++t.rev,t.$emit("update:".concat(i),n)})}).call(this)}},watch:{tracking:function(t){this.$geolocation&&t!==this.$geolocation.getTracking()&&this.$geolocation.setTracking(t)},tracingOptions:function(t){this.$geolocation&&this.$geolocation.setTrackingOptions(t)},resolvedDataProjection:function(t){this.$geolocation&&this.$geolocation.setProjection(t)}},stubVNode:{empty:function(){return this.$options.name}},created:function(){var t=this;Wc(this,{$geolocation:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}}})}},void 0,!1);function Lm(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Lm.installed||(Lm.installed=!0,e=Zi(e,"dataProjection"),pe(Fm,e),t.component(Fm.name,Fm))}var Mm=Object.freeze({default:Lm,Geoloc:Fm,install:Lm});function Am(t,e,i){for(var n,r,o,s,a,h,u=[],l=t(0),c=t(1),p=e(l),f=e(c),d=[c,l],g=[f,p],y=[1,0],v={},_=1e5;--_>0&&y.length>0;)o=y.pop(),l=d.pop(),p=g.pop(),(h=o.toString())in v||(u.push(p[0],p[1]),v[h]=!0),s=y.pop(),c=d.pop(),f=g.pop(),Un((r=e(n=t(a=(o+s)/2)))[0],r[1],p[0],p[1],f[0],f[1])<i?(u.push(f[0],f[1]),v[h=s.toString()]=!0):(y.push(s,a,a,o),g.push(f,r,r,p),d.push(c,n,n,l));return u}var Nm=new Ca({color:"rgba(0,0,0,0.2)"}),km=[90,45,30,20,10,5,2,1,.5,.2,.1,.05,.01,.005,.002,.001],jm=function(t){var e=t||{};this.map_=null,this.postcomposeListenerKey_=null,this.projection_=null,this.maxLat_=1/0,this.maxLon_=1/0,this.minLat_=-1/0,this.minLon_=-1/0,this.maxLatP_=1/0,this.maxLonP_=1/0,this.minLatP_=-1/0,this.minLonP_=-1/0,this.targetSize_=void 0!==e.targetSize?e.targetSize:100,this.maxLines_=void 0!==e.maxLines?e.maxLines:100,this.meridians_=[],this.parallels_=[],this.strokeStyle_=void 0!==e.strokeStyle?e.strokeStyle:Nm,this.fromLonLatTransform_=void 0,this.toLonLatTransform_=void 0,this.projectionCenterLonLat_=null,this.meridiansLabels_=null,this.parallelsLabels_=null,1==e.showLabels&&(this.lonLabelFormatter_=void 0==e.lonLabelFormatter?bv.bind(this,"EW"):e.lonLabelFormatter,this.latLabelFormatter_=void 0==e.latLabelFormatter?bv.bind(this,"NS"):e.latLabelFormatter,this.lonLabelPosition_=void 0==e.lonLabelPosition?0:e.lonLabelPosition,this.latLabelPosition_=void 0==e.latLabelPosition?1:e.latLabelPosition,this.lonLabelStyle_=void 0!==e.lonLabelStyle?e.lonLabelStyle:new uy({font:"12px Calibri,sans-serif",textBaseline:"bottom",fill:new ba({color:"rgba(0,0,0,1)"}),stroke:new Ca({color:"rgba(255,255,255,1)",width:3})}),this.latLabelStyle_=void 0!==e.latLabelStyle?e.latLabelStyle:new uy({font:"12px Calibri,sans-serif",textAlign:"end",fill:new ba({color:"rgba(0,0,0,1)"}),stroke:new Ca({color:"rgba(255,255,255,1)",width:3})}),this.meridiansLabels_=[],this.parallelsLabels_=[]),this.setMap(void 0!==e.map?e.map:null)};jm.prototype.addMeridian_=function(t,e,i,n,r,o){var s=this.getMeridian_(t,e,i,n,o);if(Dn(s.getExtent(),r)){if(this.meridiansLabels_){var a=this.getMeridianPoint_(s,r,o);this.meridiansLabels_[o]={geom:a,text:this.lonLabelFormatter_(t)}}this.meridians_[o++]=s}return o},jm.prototype.getMeridianPoint_=function(t,e,i){var n,r=t.getFlatCoordinates(),o=Math.max(e[1],r[1]),s=Math.min(e[3],r[r.length-1]),a=Yn(e[1]+Math.abs(e[1]-e[3])*this.lonLabelPosition_,o,s),h=[r[0],a];return i in this.meridiansLabels_?(n=this.meridiansLabels_[i].geom).setCoordinates(h):n=new Fu(h),n},jm.prototype.addParallel_=function(t,e,i,n,r,o){var s=this.getParallel_(t,e,i,n,o);if(Dn(s.getExtent(),r)){if(this.parallelsLabels_){var a=this.getParallelPoint_(s,r,o);this.parallelsLabels_[o]={geom:a,text:this.latLabelFormatter_(t)}}this.parallels_[o++]=s}return o},jm.prototype.getParallelPoint_=function(t,e,i){var n,r=t.getFlatCoordinates(),o=Math.max(e[0],r[0]),s=Math.min(e[2],r[r.length-2]),a=[Yn(e[0]+Math.abs(e[0]-e[2])*this.latLabelPosition_,o,s),r[1]];return i in this.parallelsLabels_?(n=this.parallelsLabels_[i].geom).setCoordinates(a):n=new Fu(a),n},jm.prototype.createGraticule_=function(t,e,i,n){var r=this.getInterval_(i);if(-1==r)return this.meridians_.length=this.parallels_.length=0,this.meridiansLabels_&&(this.meridiansLabels_.length=0),void(this.parallelsLabels_&&(this.parallelsLabels_.length=0));var o,s,a,h,u=this.toLonLatTransform_(e),l=u[0],c=u[1],p=this.maxLines_,f=[Math.max(t[0],this.minLonP_),Math.max(t[1],this.minLatP_),Math.min(t[2],this.maxLonP_),Math.min(t[3],this.maxLatP_)],d=(f=So(f,this.projection_,"EPSG:4326"))[3],g=f[2],y=f[1],v=f[0];for(h=Yn(l=Math.floor(l/r)*r,this.minLon_,this.maxLon_),s=this.addMeridian_(h,y,d,n,t,0),o=0;h!=this.minLon_&&o++<p;)h=Math.max(h-r,this.minLon_),s=this.addMeridian_(h,y,d,n,t,s);for(h=Yn(l,this.minLon_,this.maxLon_),o=0;h!=this.maxLon_&&o++<p;)h=Math.min(h+r,this.maxLon_),s=this.addMeridian_(h,y,d,n,t,s);for(this.meridians_.length=s,this.meridiansLabels_&&(this.meridiansLabels_.length=s),a=Yn(c=Math.floor(c/r)*r,this.minLat_,this.maxLat_),s=this.addParallel_(a,v,g,n,t,0),o=0;a!=this.minLat_&&o++<p;)a=Math.max(a-r,this.minLat_),s=this.addParallel_(a,v,g,n,t,s);for(a=Yn(c,this.minLat_,this.maxLat_),o=0;a!=this.maxLat_&&o++<p;)a=Math.min(a+r,this.maxLat_),s=this.addParallel_(a,v,g,n,t,s);this.parallels_.length=s,this.parallelsLabels_&&(this.parallelsLabels_.length=s)},jm.prototype.getInterval_=function(t){for(var e=this.projectionCenterLonLat_[0],i=this.projectionCenterLonLat_[1],n=-1,r=Math.pow(this.targetSize_*t,2),o=[],s=[],a=0,h=km.length;a<h;++a){var u=km[a]/2;if(o[0]=e-u,o[1]=i-u,s[0]=e+u,s[1]=i+u,this.fromLonLatTransform_(o,o),this.fromLonLatTransform_(s,s),Math.pow(s[0]-o[0],2)+Math.pow(s[1]-o[1],2)<=r)break;n=km[a]}return n},jm.prototype.getMap=function(){return this.map_},jm.prototype.getMeridian_=function(t,e,i,n,r){var o=function(t,e,i,n,r){return Am(function(n){return[t,e+(i-e)*n]},Eo(fo("EPSG:4326"),n),r)}(t,e,i,this.projection_,n),s=this.meridians_[r];return s?(s.setFlatCoordinates(iu.XY,o),s.changed()):s=this.meridians_[r]=new Iu(o,iu.XY),s},jm.prototype.getMeridians=function(){return this.meridians_},jm.prototype.getParallel_=function(t,e,i,n,r){var o=function(t,e,i,n,r){return Am(function(n){return[e+(i-e)*n,t]},Eo(fo("EPSG:4326"),n),r)}(t,e,i,this.projection_,n),s=this.parallels_[r];return s?(s.setFlatCoordinates(iu.XY,o),s.changed()):s=new Iu(o,iu.XY),s},jm.prototype.getParallels=function(){return this.parallels_},jm.prototype.handlePostCompose_=function(t){var e,i,n,r,o=t.vectorContext,s=t.frameState,a=s.extent,h=s.viewState,u=h.center,l=h.projection,c=h.resolution,p=s.pixelRatio,f=c*c/(4*p*p);for((!this.projection_||!_o(this.projection_,l))&&this.updateProjectionInfo_(l),this.createGraticule_(a,u,c,f),o.setFillStrokeStyle(null,this.strokeStyle_),e=0,i=this.meridians_.length;e<i;++e)n=this.meridians_[e],o.drawGeometry(n);for(e=0,i=this.parallels_.length;e<i;++e)n=this.parallels_[e],o.drawGeometry(n);if(this.meridiansLabels_)for(e=0,i=this.meridiansLabels_.length;e<i;++e)r=this.meridiansLabels_[e],this.lonLabelStyle_.setText(r.text),o.setTextStyle(this.lonLabelStyle_),o.drawGeometry(r.geom);if(this.parallelsLabels_)for(e=0,i=this.parallelsLabels_.length;e<i;++e)r=this.parallelsLabels_[e],this.latLabelStyle_.setText(r.text),o.setTextStyle(this.latLabelStyle_),o.drawGeometry(r.geom)},jm.prototype.updateProjectionInfo_=function(t){var e=fo("EPSG:4326"),i=t.getWorldExtent(),n=So(i,e,t);this.maxLat_=i[3],this.maxLon_=i[2],this.minLat_=i[1],this.minLon_=i[0],this.maxLatP_=n[3],this.maxLonP_=n[2],this.minLatP_=n[1],this.minLonP_=n[0],this.fromLonLatTransform_=Eo(e,t),this.toLonLatTransform_=Eo(t,e),this.projectionCenterLonLat_=this.toLonLatTransform_(Ln(t.getExtent())),this.projection_=t},jm.prototype.setMap=function(t){this.map_&&(_r(this.postcomposeListenerKey_),this.postcomposeListenerKey_=null,this.map_.render()),t&&(this.postcomposeListenerKey_=gr(t,H_,this.handlePostCompose_,this),t.render()),this.map_=t};var Gm={maxLines:{type:Number,default:100},targetSize:{type:Number,default:100},showLabels:{type:Boolean,default:!1},lonLabelFormatter:Function,latLabelFormatter:Function,lonLabelPosition:{type:Number,default:0},latLabelPosition:{type:Number,default:1},intervals:{type:Array,default:function(){return[90,45,30,20,10,5,2,1,.5,.2,.1,.05,.01,.005,.002,.001]}}},Dm={meridians:function(){var t=this;return this.$graticule&&this.rev?Wi(this.getMeridians(),function(e){return t.lineToDataProj(e.getCoordinates())}):[]},parallels:function(){var t=this;return this.$graticule&&this.rev?Wi(this.getParallels(),function(e){return t.lineToDataProj(e.getCoordinates())}):[]}},$m={createOlObject:function(){return new jm({maxLines:this.maxLines,targetSize:this.targetSize,showLabels:this.showLabels,strokeStyle:this._strokeStyle,lonLabelFormatter:this.lonLabelFormatter,latLabelFormatter:this.latLabelFormatter,lonLabelPosition:this.lonLabelPosition,latLabelPosition:this.latLabelPosition,lonLabelStyle:this._lonLabelStyle,latLabelStyle:this._latLabelStyle,intervals:this.intervals})},init:function(){return jd.methods.init.call(this)},deinit:function(){return jd.methods.deinit.call(this)},mount:function(){this.$map&&this.$graticule.setMap(this.$map),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.$graticule.setMap(void 0)},getMeridians:function(){return Nh(this),this.$graticule.getMeridians()},getParallels:function(){return Nh(this),this.$graticule.getParallels()},setStroke:function(e){(e=e instanceof t?e.$style:e)!==this._strokeStyle&&(this._strokeStyle=e,this.scheduleRefresh())},setText:function(e){var i;(e=e instanceof t?e.$style:e)&&(i=function(t){if(Mi(t))return t[0]}(e[this.$options.VM_PROP]));var n=function(t){return t.componentInstance&&t.componentInstance===i};null==e&&null==this.$slots.lon||ti(this.$slots.lon)&&this.$slots.lon.some(n)?e!==this._lonLabelStyle&&(this._lonLabelStyle=e,this.scheduleRefresh()):(null==e&&null==this.$slots.lat||ti(this.$slots.lat)&&this.$slots.lat.some(n))&&e!==this._latLabelStyle&&(this._latLabelStyle=e,this.scheduleRefresh())},getServices:function(){var t=this;return af(jd.methods.getServices.call(this),{get stylesContainer(){return t}})},refresh:function(){return this.recreate()},subscribeAll:function(){var t=this;Rh(this);var e=rf(this.$map,"postcompose").pipe($p(1e3/60));this.subscribeTo(e,function(){++t.rev})}},Vm=Gd(oe(Gm),function(){return function(){this.scheduleRefresh()}});var zm=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="graticule.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this.$createElement;return(this._self._c||t)("i",{class:this.$options.name,staticStyle:{display:"none !important"}},[this._t("lon"),this._v(" "),this._t("lat"),this._v(" "),this._t("stroke")],2)},staticRenderFns:[]},0,{name:"vl-graticule",mixins:[jd,$d,Uc],props:Gm,computed:Dm,methods:$m,watch:Vm,stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}},created:function(){var t=this;Wc(this,{$graticule:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}}})}},void 0,!1);function Ym(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Ym.installed||(Ym.installed=!0,e=Zi(e,"dataProjection"),pe(zm,e),t.component(zm.name,zm))}var Xm=Object.freeze({default:Ym,Graticule:zm,install:Ym}),Um={LAYERS:"layers"},Bm=function(t){function e(e){var i=e||{},n=qn({},i);delete n.layers;var r=i.layers;t.call(this,n),this.layersListenerKeys_=[],this.listenerKeys_={},gr(this,Go(Um.LAYERS),this.handleLayersChanged_,this),r?Array.isArray(r)?r=new Nv(r.slice(),{unique:!0}):(sn(r instanceof Nv,43),r=r):r=new Nv(void 0,{unique:!0}),this.setLayers(r)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.handleLayerChange_=function(){this.changed()},e.prototype.handleLayersChanged_=function(){this.layersListenerKeys_.forEach(_r),this.layersListenerKeys_.length=0;var t=this.getLayers();for(var e in this.layersListenerKeys_.push(gr(t,Lv.ADD,this.handleLayersAdd_,this),gr(t,Lv.REMOVE,this.handleLayersRemove_,this)),this.listenerKeys_)this.listenerKeys_[e].forEach(_r);Jn(this.listenerKeys_);for(var i=t.getArray(),n=0,r=i.length;n<r;n++){var o=i[n];this.listenerKeys_[nn(o).toString()]=[gr(o,Mo,this.handleLayerChange_,this),gr(o,br.CHANGE,this.handleLayerChange_,this)]}this.changed()},e.prototype.handleLayersAdd_=function(t){var e=t.element,i=nn(e).toString();this.listenerKeys_[i]=[gr(e,Mo,this.handleLayerChange_,this),gr(e,br.CHANGE,this.handleLayerChange_,this)],this.changed()},e.prototype.handleLayersRemove_=function(t){var e=nn(t.element).toString();this.listenerKeys_[e].forEach(_r),delete this.listenerKeys_[e],this.changed()},e.prototype.getLayers=function(){return this.get(Um.LAYERS)},e.prototype.setLayers=function(t){this.set(Um.LAYERS,t)},e.prototype.getLayersArray=function(t){var e=void 0!==t?t:[];return this.getLayers().forEach(function(t){t.getLayersArray(e)}),e},e.prototype.getLayerStatesArray=function(t){var e=void 0!==t?t:[],i=e.length;this.getLayers().forEach(function(t){t.getLayerStatesArray(e)});for(var n=this.getLayerState(),r=i,o=e.length;r<o;r++){var s=e[r];s.opacity*=n.opacity,s.visible=s.visible&&n.visible,s.maxResolution=Math.min(s.maxResolution,n.maxResolution),s.minResolution=Math.max(s.minResolution,n.minResolution),void 0!==n.extent&&(void 0!==s.extent?s.extent=Nn(s.extent,n.extent):s.extent=n.extent)}return e},e.prototype.getSourceState=function(){return Do.READY},e}(yg);var Km=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="layer.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-layer-group",mixins:[dg,vg],methods:{createLayer:function(){return new Bm({id:this.id,minResolution:this.minResolution,maxResolution:this.maxResolution,opacity:this.opacity,visible:this.visible,extent:this.extent,zIndex:this.zIndex})},getLayersTarget:function(){return Ph(this),null==this._layersTarget&&(this._layersTarget=new Wg(this.$layer.getLayers(),function(t){return t.get("id")})),this._layersTarget},getServices:function(){return af(dg.methods.getServices.call(this),vg.methods.getServices.call(this))}}},void 0,void 0);function Wm(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Wm.installed||(Wm.installed=!0,e=Zi(e,"dataProjection"),pe(Km,e),t.component(Km.name,Km))}var Hm=Object.freeze({default:Wm,Layer:Km,install:Wm}),Zm={src:{type:String,required:!0},size:{type:Array,validator:function(t){return 2===t.length}},anchor:{type:Array,default:function(){return[.5,.5]},validator:function(t){return 2===t.length}},anchorOrigin:{type:String,default:"top-left"},anchorXUnits:{type:String,default:"fraction"},anchorYUnits:{type:String,default:"fraction"},color:[Array,String],crossOrigin:String,offset:{type:Array,default:function(){return[0,0]},validator:function(t){return 2===t.length}},offsetOrigin:{type:String,default:"top-left"},opacity:{type:Number,default:1},scale:{type:Number,default:1},rotateWithView:{type:Boolean,default:!1},rotation:{type:Number,default:0}};var qm=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-icon",mixins:[Qd],props:Zm,methods:{createStyle:function(){return new sy({anchor:this.anchor,anchorOrigin:this.anchorOrigin,anchorXUnits:this.anchorXUnits,anchorYUnits:this.anchorYUnits,color:this.color,crossOrigin:this.crossOrigin,offset:this.offset,offsetOrigin:this.offsetOrigin,opacity:this.opacity,scale:this.scale,rotateWithView:this.rotateWithView,rotation:this.rotation,size:this.size,src:this.src})}},watch:{src:function(t){this.$style&&!zi(t,this.$style.getSrc())&&this.scheduleRefresh()},size:function(t){this.$style&&!zi(t,this.$style.getSize())&&this.scheduleRefresh()},anchor:function(t){this.$style&&!zi(t,this.$style.getAnchor())&&this.scheduleRefresh()},scale:function(t){this.$style&&!zi(t,this.$style.getScale())&&this.scheduleRefresh()}}},void 0,void 0);function Jm(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Jm.installed||(Jm.installed=!0,e=Zi(e,"dataProjection"),pe(qm,e),t.component(qm.name,qm))}var Qm=Object.freeze({default:Jm,Style:qm,install:Jm}),tE=function(t){function e(e){var i=e||{};t.call(this,i),this.type=W_.IMAGE}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Q_);tE.prototype.getSource;var eE=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="layer.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-layer-image",mixins:[dg],props:{},methods:{createLayer:function(){return new tE({id:this.id,minResolution:this.minResolution,maxResolution:this.maxResolution,opacity:this.opacity,visible:this.visible,extent:this.extent,zIndex:this.zIndex,source:this._source})}}},void 0,void 0);function iE(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};iE.installed||(iE.installed=!0,e=Zi(e,"dataProjection"),pe(eE,e),t.component(eE.name,eE))}var nE=Object.freeze({default:iE,Layer:eE,install:iE}),rE=function(t){function e(e,i,n,r){t.call(this),this.extent=e,this.pixelRatio_=n,this.resolution=i,this.state=r}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.changed=function(){this.dispatchEvent(br.CHANGE)},e.prototype.getExtent=function(){return this.extent},e.prototype.getImage=function(){},e.prototype.getPixelRatio=function(){return this.pixelRatio_},e.prototype.getResolution=function(){return this.resolution},e.prototype.getState=function(){return this.state},e.prototype.load=function(){},e}(wr),oE=function(t){function e(e,i,n,r,o,s){t.call(this,e,i,n,qs.IDLE),this.src_=r,this.image_=new Image,null!==o&&(this.image_.crossOrigin=o),this.imageListenerKeys_=null,this.state=qs.IDLE,this.imageLoadFunction_=s}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getImage=function(){return this.image_},e.prototype.handleImageError_=function(){this.state=qs.ERROR,this.unlistenImage_(),this.changed()},e.prototype.handleImageLoad_=function(){void 0===this.resolution&&(this.resolution=An(this.extent)/this.image_.height),this.state=qs.LOADED,this.unlistenImage_(),this.changed()},e.prototype.load=function(){this.state!=qs.IDLE&&this.state!=qs.ERROR||(this.state=qs.LOADING,this.changed(),this.imageListenerKeys_=[yr(this.image_,br.ERROR,this.handleImageError_,this),yr(this.image_,br.LOAD,this.handleImageLoad_,this)],this.imageLoadFunction_(this,this.src_))},e.prototype.setImage=function(t){this.image_=t},e.prototype.unlistenImage_=function(){this.imageListenerKeys_.forEach(_r),this.imageListenerKeys_=null},e}(rE),sE=function(t){function e(e,i,n,r,o,s){var a=e.getExtent(),h=i.getExtent(),u=h?Nn(n,h):n,l=To(e,i,Ln(u),r),c=new Co(e,i,u,a,l*rr),p=s(c.calculateSourceExtent(),l,o),f=qs.LOADED;p&&(f=qs.IDLE);var d=p?p.getPixelRatio():1;t.call(this,n,r,d,f),this.targetProj_=i,this.maxSourceExtent_=a,this.triangulation_=c,this.targetResolution_=r,this.targetExtent_=n,this.sourceImage_=p,this.sourcePixelRatio_=d,this.canvas_=null,this.sourceListenerKey_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.state==qs.LOADING&&this.unlistenSource_(),t.prototype.disposeInternal.call(this)},e.prototype.getImage=function(){return this.canvas_},e.prototype.getProjection=function(){return this.targetProj_},e.prototype.reproject_=function(){var t=this.sourceImage_.getState();if(t==qs.LOADED){var e=Gn(this.targetExtent_)/this.targetResolution_,i=An(this.targetExtent_)/this.targetResolution_;this.canvas_=bo(e,i,this.sourcePixelRatio_,this.sourceImage_.getResolution(),this.maxSourceExtent_,this.targetResolution_,this.targetExtent_,this.triangulation_,[{extent:this.sourceImage_.getExtent(),image:this.sourceImage_.getImage()}],0)}this.state=t,this.changed()},e.prototype.load=function(){if(this.state==qs.IDLE){this.state=qs.LOADING,this.changed();var t=this.sourceImage_.getState();t==qs.LOADED||t==qs.ERROR?this.reproject_():(this.sourceListenerKey_=gr(this.sourceImage_,br.CHANGE,function(t){var e=this.sourceImage_.getState();e!=qs.LOADED&&e!=qs.ERROR||(this.unlistenSource_(),this.reproject_())},this),this.sourceImage_.load())}},e.prototype.unlistenSource_=function(){_r(this.sourceListenerKey_),this.sourceListenerKey_=null},e}(rE),aE="imageloadstart",hE="imageloadend",uE="imageloaderror",lE=function(t){function e(e,i){t.call(this,e),this.image=i}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),cE=function(t){function e(e){t.call(this,{attributions:e.attributions,extent:e.extent,projection:e.projection,state:e.state}),this.resolutions_=void 0!==e.resolutions?e.resolutions:null,this.reprojectedImage_=null,this.reprojectedRevision_=0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getResolutions=function(){return this.resolutions_},e.prototype.findNearestResolution=function(t){if(this.resolutions_){var e=Ko(this.resolutions_,t,0);t=this.resolutions_[e]}return t},e.prototype.getImage=function(t,e,i,n){var r=this.getProjection();if(r&&n&&!_o(r,n)){if(this.reprojectedImage_){if(this.reprojectedRevision_==this.getRevision()&&_o(this.reprojectedImage_.getProjection(),n)&&this.reprojectedImage_.getResolution()==e&&Tn(this.reprojectedImage_.getExtent(),t))return this.reprojectedImage_;this.reprojectedImage_.dispose(),this.reprojectedImage_=null}return this.reprojectedImage_=new sE(r,n,t,e,i,function(t,e,i){return this.getImageInternal(t,e,i,r)}.bind(this)),this.reprojectedRevision_=this.getRevision(),this.reprojectedImage_}return r&&(n=r),this.getImageInternal(t,e,i,n)},e.prototype.getImageInternal=function(t,e,i,n){},e.prototype.handleImageChange=function(t){var e=t.target;switch(e.getState()){case qs.LOADING:this.loading=!0,this.dispatchEvent(new lE(aE,e));break;case qs.LOADED:this.loading=!1,this.dispatchEvent(new lE(hE,e));break;case qs.ERROR:this.loading=!1,this.dispatchEvent(new lE(uE,e))}},e}($o);function pE(t,e){t.getImage().src=e}var fE=function(t){function e(e){var i=void 0!==e.crossOrigin?e.crossOrigin:null,n=void 0!==e.imageLoadFunction?e.imageLoadFunction:pE;t.call(this,{attributions:e.attributions,projection:fo(e.projection)}),this.url_=e.url,this.imageExtent_=e.imageExtent,this.image_=new oE(this.imageExtent_,void 0,1,this.url_,i,n),this.imageSize_=e.imageSize?e.imageSize:null,gr(this.image_,br.CHANGE,this.handleImageChange,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getImageExtent=function(){return this.imageExtent_},e.prototype.getImageInternal=function(t,e,i,n){return Dn(t,this.image_.getExtent())?this.image_:null},e.prototype.getUrl=function(){return this.url_},e.prototype.handleImageChange=function(e){if(this.image_.getState()==qs.LOADED){var i,n,r=this.image_.getExtent(),o=this.image_.getImage();this.imageSize_?(i=this.imageSize_[0],n=this.imageSize_[1]):(i=o.width,n=o.height);var s=An(r)/n,a=Math.ceil(Gn(r)/s);if(a!=i){var h=Rr(a,n),u=h.canvas;h.drawImage(o,0,0,i,n,0,0,u.width,u.height),this.image_.setImage(u)}}t.prototype.handleImageChange.call(this,e)},e}(cE),dE={extent:{type:Array,required:!0,validator:function(t){return 4===t.length}},loadFunc:Function,size:{type:Array,validator:function(t){return 2===t.length}},url:{type:String,required:!0,validator:function(t){return!!t.length}}};var gE=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-image-static",mixins:[Wd,$g],props:dE,methods:{createSource:function(){return new fE({attributions:this.attributions,crossOrigin:this.crossOrigin,imageExtent:this.extent,imageLoadFunction:this.loadFunc,logo:this.logo,projection:this.projection,imageSize:this.size,url:this.urlTmpl})}},watch:Gd(oe(dE),function(){return function(){this.scheduleRecreate()}})},void 0,void 0);function yE(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};yE.installed||(yE.installed=!0,e=Zi(e,"dataProjection"),pe(gE,e),t.component(gE.name,gE))}var vE=Object.freeze({default:yE,Source:gE,install:yE}),_E={CARMENTA_SERVER:"carmentaserver",GEOSERVER:"geoserver",MAPSERVER:"mapserver",QGIS:"qgis"},mE=[101,101],EE=function(t){function e(e){var i=e||{};t.call(this,{attributions:i.attributions,projection:i.projection,resolutions:i.resolutions}),this.crossOrigin_=void 0!==i.crossOrigin?i.crossOrigin:null,this.url_=i.url,this.imageLoadFunction_=void 0!==i.imageLoadFunction?i.imageLoadFunction:pE,this.params_=i.params||{},this.v13_=!0,this.updateV13_(),this.serverType_=i.serverType,this.hidpi_=void 0===i.hidpi||i.hidpi,this.image_=null,this.imageSize_=[0,0],this.renderedRevision_=0,this.ratio_=void 0!==i.ratio?i.ratio:1.5}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getGetFeatureInfoUrl=function(t,e,i,n){if(void 0!==this.url_){var r=fo(i),o=this.getProjection();o&&o!==r&&(e=To(o,r,t,e),t=xo(t,r,o));var s=Mn(t,e,0,mE),a={SERVICE:"WMS",VERSION:"1.3.0",REQUEST:"GetFeatureInfo",FORMAT:"image/png",TRANSPARENT:!0,QUERY_LAYERS:this.params_.LAYERS};qn(a,this.params_,n);var h=Math.floor((t[0]-s[0])/e),u=Math.floor((s[3]-t[1])/e);return a[this.v13_?"I":"X"]=h,a[this.v13_?"J":"Y"]=u,this.getRequestUrl_(s,mE,1,o||r,a)}},e.prototype.getParams=function(){return this.params_},e.prototype.getImageInternal=function(t,e,i,n){if(void 0===this.url_)return null;e=this.findNearestResolution(e),1==i||this.hidpi_&&void 0!==this.serverType_||(i=1);var r=e/i,o=Ln(t),s=Mn(o,r,0,[Math.ceil(Gn(t)/r),Math.ceil(An(t)/r)]),a=Mn(o,r,0,[Math.ceil(this.ratio_*Gn(t)/r),Math.ceil(this.ratio_*An(t)/r)]),h=this.image_;if(h&&this.renderedRevision_==this.getRevision()&&h.getResolution()==e&&h.getPixelRatio()==i&&dn(h.getExtent(),s))return h;var u={SERVICE:"WMS",VERSION:"1.3.0",REQUEST:"GetMap",FORMAT:"image/png",TRANSPARENT:!0};qn(u,this.params_),this.imageSize_[0]=Math.round(Gn(a)/r),this.imageSize_[1]=Math.round(An(a)/r);var l=this.getRequestUrl_(a,this.imageSize_,i,n,u);return this.image_=new oE(a,e,i,l,this.crossOrigin_,this.imageLoadFunction_),this.renderedRevision_=this.getRevision(),gr(this.image_,br.CHANGE,this.handleImageChange,this),this.image_},e.prototype.getImageLoadFunction=function(){return this.imageLoadFunction_},e.prototype.getRequestUrl_=function(t,e,i,n,r){if(sn(void 0!==this.url_,9),r[this.v13_?"CRS":"SRS"]=n.getCode(),"STYLES"in this.params_||(r.STYLES=""),1!=i)switch(this.serverType_){case _E.GEOSERVER:var o=90*i+.5|0;"FORMAT_OPTIONS"in r?r.FORMAT_OPTIONS+=";dpi:"+o:r.FORMAT_OPTIONS="dpi:"+o;break;case _E.MAPSERVER:r.MAP_RESOLUTION=90*i;break;case _E.CARMENTA_SERVER:case _E.QGIS:r.DPI=90*i;break;default:sn(!1,8)}r.WIDTH=e[0],r.HEIGHT=e[1];var s,a=n.getAxisOrientation();return s=this.v13_&&"ne"==a.substr(0,2)?[t[1],t[0],t[3],t[2]]:t,r.BBOX=s.join(","),fs(this.url_,r)},e.prototype.getUrl=function(){return this.url_},e.prototype.setImageLoadFunction=function(t){this.image_=null,this.imageLoadFunction_=t,this.changed()},e.prototype.setUrl=function(t){t!=this.url_&&(this.url_=t,this.image_=null,this.changed())},e.prototype.updateParams=function(t){qn(this.params_,t),this.updateV13_(),this.image_=null,this.changed()},e.prototype.updateV13_=function(){var t=this.params_.VERSION||"1.3.0";this.v13_=Sv(t,"1.3")>=0},e}(cE);var xE=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-image-wms",mixins:[Wd,$g,Jy],props:{hidpi:{type:Boolean,default:!0},serverType:{type:String,validator:function(t){return!t|p_(_E).includes(t)}},imageLoadFunction:Function,ratio:{type:Number,default:1.5},resolutions:Array,url:{type:String,required:!0,validator:function(t){return!!t.length}}},methods:{createOlObject:function(){return new EE({attributions:this.attributions,crossOrigin:this.crossOrigin,hidpi:this.hidpi,serverType:this.serverType,imageLoadFunction:this.imageLoadFunction,params:this.allParams,projection:this.projection,ratio:this.ratio,resolutions:this.resolutions,url:this.urlTmpl})}}},void 0,void 0);function SE(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};SE.installed||(SE.installed=!0,e=Zi(e,"dataProjection"),pe(xE,e),t.component(xE.name,xE))}var TE=Object.freeze({default:SE,Source:xE,install:SE});var wE=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-line-string",mixins:[zd],props:{},computed:{type:Oi(Vh.LINE_STRING)},methods:{createGeometry:function(){return new Iu(this.toViewProj(this.coordinates))}}},void 0,void 0);function bE(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};bE.installed||(bE.installed=!0,e=Zi(e,"dataProjection"),pe(wE,e),t.component(wE.name,wE))}var CE=Object.freeze({default:bE,Geom:wE,install:bE}),RE=function(t){function e(e){t.call(this),this.element=e.element?e.element:null,this.target_=null,this.map_=null,this.listenerKeys=[],this.render=e.render?e.render:xr,e.target&&this.setTarget(e.target)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){Ir(this.element),t.prototype.disposeInternal.call(this)},e.prototype.getMap=function(){return this.map_},e.prototype.setMap=function(t){this.map_&&Ir(this.element);for(var e=0,i=this.listenerKeys.length;e<i;++e)_r(this.listenerKeys[e]);(this.listenerKeys.length=0,this.map_=t,this.map_)&&((this.target_?this.target_:t.getOverlayContainerStopEvent()).appendChild(this.element),this.render!==xr&&this.listenerKeys.push(gr(t,_g.POSTRENDER,this.render,this)),t.render())},e.prototype.setTarget=function(t){this.target_="string"==typeof t?document.getElementById(t):t},e}(ko),OE=function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),render:i.render||IE,target:i.target}),this.ulElement_=document.createElement("ul"),this.collapsed_=void 0===i.collapsed||i.collapsed,this.collapsible_=void 0===i.collapsible||i.collapsible,this.collapsible_||(this.collapsed_=!1);var n=void 0!==i.className?i.className:"ol-attribution",r=void 0!==i.tipLabel?i.tipLabel:"Attributions",o=void 0!==i.collapseLabel?i.collapseLabel:"»";"string"==typeof o?(this.collapseLabel_=document.createElement("span"),this.collapseLabel_.textContent=o):this.collapseLabel_=o;var s=void 0!==i.label?i.label:"i";"string"==typeof s?(this.label_=document.createElement("span"),this.label_.textContent=s):this.label_=s;var a=this.collapsible_&&!this.collapsed_?this.collapseLabel_:this.label_,h=document.createElement("button");h.setAttribute("type","button"),h.title=r,h.appendChild(a),gr(h,br.CLICK,this.handleClick_,this);var u=n+" "+ta+" "+ia+(this.collapsed_&&this.collapsible_?" "+na:"")+(this.collapsible_?"":" ol-uncollapsible"),l=this.element;l.className=u,l.appendChild(this.ulElement_),l.appendChild(h),this.renderedAttributions_=[],this.renderedVisible_=!0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getSourceAttributions_=function(t){for(var e={},i=[],n=t.layerStatesArray,r=t.viewState.resolution,o=0,s=n.length;o<s;++o){var a=n[o];if(tm(a,r)){var h=a.layer.getSource();if(h){var u=h.getAttributions();if(u){var l=u(t);if(l)if(Array.isArray(l))for(var c=0,p=l.length;c<p;++c)l[c]in e||(i.push(l[c]),e[l[c]]=!0);else l in e||(i.push(l),e[l]=!0)}}}}return i},e.prototype.updateElement_=function(t){if(t){var e=this.getSourceAttributions_(t),i=e.length>0;if(this.renderedVisible_!=i&&(this.element.style.display=i?"":"none",this.renderedVisible_=i),!Zo(e,this.renderedAttributions_)){Pr(this.ulElement_);for(var n=0,r=e.length;n<r;++n){var o=document.createElement("li");o.innerHTML=e[n],this.ulElement_.appendChild(o)}this.renderedAttributions_=e}}else this.renderedVisible_&&(this.element.style.display="none",this.renderedVisible_=!1)},e.prototype.handleClick_=function(t){t.preventDefault(),this.handleToggle_()},e.prototype.handleToggle_=function(){this.element.classList.toggle(na),this.collapsed_?Or(this.collapseLabel_,this.label_):Or(this.label_,this.collapseLabel_),this.collapsed_=!this.collapsed_},e.prototype.getCollapsible=function(){return this.collapsible_},e.prototype.setCollapsible=function(t){this.collapsible_!==t&&(this.collapsible_=t,this.element.classList.toggle("ol-uncollapsible"),!t&&this.collapsed_&&this.handleToggle_())},e.prototype.setCollapsed=function(t){this.collapsible_&&this.collapsed_!==t&&this.handleToggle_()},e.prototype.getCollapsed=function(){return this.collapsed_},e}(RE);function IE(t){this.updateElement_(t.frameState)}var PE,FE=function(){if(!PE){var t=document.body;t.webkitRequestFullscreen?PE="webkitfullscreenchange":t.mozRequestFullScreen?PE="mozfullscreenchange":t.msRequestFullscreen?PE="MSFullscreenChange":t.requestFullscreen&&(PE="fullscreenchange")}return PE};!function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),target:i.target}),this.cssClassName_=void 0!==i.className?i.className:"ol-full-screen";var n=void 0!==i.label?i.label:"⤢";this.labelNode_="string"==typeof n?document.createTextNode(n):n;var r=void 0!==i.labelActive?i.labelActive:"×";this.labelActiveNode_="string"==typeof r?document.createTextNode(r):r;var o=i.tipLabel?i.tipLabel:"Toggle full-screen",s=document.createElement("button");s.className=this.cssClassName_+"-"+ME(),s.setAttribute("type","button"),s.title=o,s.appendChild(this.labelNode_),gr(s,br.CLICK,this.handleClick_,this);var a=this.cssClassName_+" "+ta+" "+ia+" "+(LE()?"":ea),h=this.element;h.className=a,h.appendChild(s),this.keys_=void 0!==i.keys&&i.keys,this.source_=i.source}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.handleClick_=function(t){t.preventDefault(),this.handleFullScreen_()},e.prototype.handleFullScreen_=function(){if(LE()){var t,e=this.getMap();if(e)if(ME())document.exitFullscreen?document.exitFullscreen():document.msExitFullscreen?document.msExitFullscreen():document.mozCancelFullScreen?document.mozCancelFullScreen():document.webkitExitFullscreen&&document.webkitExitFullscreen();else t=this.source_?"string"==typeof this.source_?document.getElementById(this.source_):this.source_:e.getTargetElement(),this.keys_?function(t){t.mozRequestFullScreenWithKeys?t.mozRequestFullScreenWithKeys():t.webkitRequestFullscreen?t.webkitRequestFullscreen(Element.ALLOW_KEYBOARD_INPUT):AE(t)}(t):AE(t)}},e.prototype.handleFullScreenChange_=function(){var t=this.element.firstElementChild,e=this.getMap();ME()?(t.className=this.cssClassName_+"-true",Or(this.labelActiveNode_,this.labelNode_)):(t.className=this.cssClassName_+"-false",Or(this.labelNode_,this.labelActiveNode_)),e&&e.updateSize()},e.prototype.setMap=function(e){t.prototype.setMap.call(this,e),e&&this.listenerKeys.push(gr(document,FE(),this.handleFullScreenChange_,this))}}(RE);function LE(){var t=document.body;return!!(t.webkitRequestFullscreen||t.mozRequestFullScreen&&document.mozFullScreenEnabled||t.msRequestFullscreen&&document.msFullscreenEnabled||t.requestFullscreen&&document.fullscreenEnabled)}function ME(){return!!(document.webkitIsFullScreen||document.mozFullScreen||document.msFullscreenElement||document.fullscreenElement)}function AE(t){t.requestFullscreen?t.requestFullscreen():t.msRequestFullscreen?t.msRequestFullscreen():t.mozRequestFullScreen?t.mozRequestFullScreen():t.webkitRequestFullscreen&&t.webkitRequestFullscreen()}var NE={POINTERMOVE:"pointermove",POINTERDOWN:"pointerdown",POINTERUP:"pointerup",POINTEROVER:"pointerover",POINTEROUT:"pointerout",POINTERENTER:"pointerenter",POINTERLEAVE:"pointerleave",POINTERCANCEL:"pointercancel"},kE=["","unavailable","touch","pen","mouse"];function jE(t){this.pointerMap[t.pointerId.toString()]=t;var e=this.prepareEvent_(t);this.dispatcher.down(e,t)}function GE(t){var e=this.prepareEvent_(t);this.dispatcher.move(e,t)}function DE(t){var e=this.prepareEvent_(t);this.dispatcher.up(e,t),this.cleanup(t.pointerId)}function $E(t){var e=this.prepareEvent_(t);this.dispatcher.leaveOut(e,t)}function VE(t){var e=this.prepareEvent_(t);this.dispatcher.enterOver(e,t)}function zE(t){var e=this.prepareEvent_(t);this.dispatcher.cancel(e,t),this.cleanup(t.pointerId)}function YE(t){var e=this.dispatcher.makeEvent("lostpointercapture",t,t);this.dispatcher.dispatchEvent(e)}function XE(t){var e=this.dispatcher.makeEvent("gotpointercapture",t,t);this.dispatcher.dispatchEvent(e)}var UE=function(t){function e(e){var i={MSPointerDown:jE,MSPointerMove:GE,MSPointerUp:DE,MSPointerOut:$E,MSPointerOver:VE,MSPointerCancel:zE,MSGotPointerCapture:XE,MSLostPointerCapture:YE};t.call(this,e,i),this.pointerMap=e.pointerMap}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.prepareEvent_=function(t){var e=t;return"number"==typeof t.pointerType&&((e=this.dispatcher.cloneEvent(t,t)).pointerType=kE[t.pointerType]),e},e.prototype.cleanup=function(t){delete this.pointerMap[t.toString()]},e}(P_);function BE(t){this.dispatcher.fireNativeEvent(t)}function KE(t){this.dispatcher.fireNativeEvent(t)}function WE(t){this.dispatcher.fireNativeEvent(t)}function HE(t){this.dispatcher.fireNativeEvent(t)}function ZE(t){this.dispatcher.fireNativeEvent(t)}function qE(t){this.dispatcher.fireNativeEvent(t)}function JE(t){this.dispatcher.fireNativeEvent(t)}function QE(t){this.dispatcher.fireNativeEvent(t)}var tx=function(t){function e(e){var i={pointerdown:BE,pointermove:KE,pointerup:WE,pointerout:HE,pointerover:ZE,pointercancel:qE,gotpointercapture:QE,lostpointercapture:JE};t.call(this,e,i)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(P_),ex=!1,ix=function(t){function e(e,i,n){t.call(this,e),this.originalEvent=i;var r=n||{};this.buttons=this.getButtons_(r),this.pressure=this.getPressure_(r,this.buttons),this.bubbles="bubbles"in r&&r.bubbles,this.cancelable="cancelable"in r&&r.cancelable,this.view="view"in r?r.view:null,this.detail="detail"in r?r.detail:null,this.screenX="screenX"in r?r.screenX:0,this.screenY="screenY"in r?r.screenY:0,this.clientX="clientX"in r?r.clientX:0,this.clientY="clientY"in r?r.clientY:0,this.ctrlKey="ctrlKey"in r&&r.ctrlKey,this.altKey="altKey"in r&&r.altKey,this.shiftKey="shiftKey"in r&&r.shiftKey,this.metaKey="metaKey"in r&&r.metaKey,this.button="button"in r?r.button:0,this.relatedTarget="relatedTarget"in r?r.relatedTarget:null,this.pointerId="pointerId"in r?r.pointerId:0,this.width="width"in r?r.width:0,this.height="height"in r?r.height:0,this.tiltX="tiltX"in r?r.tiltX:0,this.tiltY="tiltY"in r?r.tiltY:0,this.pointerType="pointerType"in r?r.pointerType:"",this.hwTimestamp="hwTimestamp"in r?r.hwTimestamp:0,this.isPrimary="isPrimary"in r&&r.isPrimary,i.preventDefault&&(this.preventDefault=function(){i.preventDefault()})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getButtons_=function(t){var e;if(t.buttons||ex)e=t.buttons;else switch(t.which){case 1:e=1;break;case 2:e=4;break;case 3:e=2;break;default:e=0}return e},e.prototype.getPressure_=function(t,e){return t.pressure?t.pressure:e?.5:0},e}(Sr);!function(){try{var t=new MouseEvent("click",{buttons:1});ex=1===t.buttons}catch(t){}}();function nx(t){this.vacuumTouches_(t),this.setPrimaryTouch_(t.changedTouches[0]),this.dedupSynthMouse_(t),this.clickCount_++,this.processTouches_(t,this.overDown_)}function rx(t){this.processTouches_(t,this.moveOverOut_)}function ox(t){this.dedupSynthMouse_(t),this.processTouches_(t,this.upOut_)}function sx(t){this.processTouches_(t,this.cancelOut_)}var ax=function(t){function e(e,i){var n={touchstart:nx,touchmove:rx,touchend:ox,touchcancel:sx};t.call(this,e,n),this.pointerMap=e.pointerMap,this.mouseSource=i,this.firstTouchId_=void 0,this.clickCount_=0,this.resetId_=void 0,this.dedupTimeout_=2500}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.isPrimaryTouch_=function(t){return this.firstTouchId_===t.identifier},e.prototype.setPrimaryTouch_=function(t){var e=Object.keys(this.pointerMap).length;(0===e||1===e&&F_.toString()in this.pointerMap)&&(this.firstTouchId_=t.identifier,this.cancelResetClickCount_())},e.prototype.removePrimaryPointer_=function(t){t.isPrimary&&(this.firstTouchId_=void 0,this.resetClickCount_())},e.prototype.resetClickCount_=function(){this.resetId_=setTimeout(this.resetClickCountHandler_.bind(this),200)},e.prototype.resetClickCountHandler_=function(){this.clickCount_=0,this.resetId_=void 0},e.prototype.cancelResetClickCount_=function(){void 0!==this.resetId_&&clearTimeout(this.resetId_)},e.prototype.touchToPointer_=function(t,e){var i=this.dispatcher.cloneEvent(t,e);return i.pointerId=e.identifier+2,i.bubbles=!0,i.cancelable=!0,i.detail=this.clickCount_,i.button=0,i.buttons=1,i.width=e.webkitRadiusX||e.radiusX||0,i.height=e.webkitRadiusY||e.radiusY||0,i.pressure=e.webkitForce||e.force||.5,i.isPrimary=this.isPrimaryTouch_(e),i.pointerType="touch",i.clientX=e.clientX,i.clientY=e.clientY,i.screenX=e.screenX,i.screenY=e.screenY,i},e.prototype.processTouches_=function(t,e){var i=Array.prototype.slice.call(t.changedTouches),n=i.length;function r(){t.preventDefault()}for(var o=0;o<n;++o){var s=this.touchToPointer_(t,i[o]);s.preventDefault=r,e.call(this,t,s)}},e.prototype.findTouch_=function(t,e){for(var i=t.length,n=0;n<i;n++){if(t[n].identifier===e)return!0}return!1},e.prototype.vacuumTouches_=function(t){var e=t.touches,i=Object.keys(this.pointerMap),n=i.length;if(n>=e.length){for(var r=[],o=0;o<n;++o){var s=i[o],a=this.pointerMap[s];s==F_||this.findTouch_(e,s-2)||r.push(a.out)}for(var h=0;h<r.length;++h)this.cancelOut_(t,r[h])}},e.prototype.overDown_=function(t,e){this.pointerMap[e.pointerId]={target:e.target,out:e,outTarget:e.target},this.dispatcher.over(e,t),this.dispatcher.enter(e,t),this.dispatcher.down(e,t)},e.prototype.moveOverOut_=function(t,e){var i=e,n=this.pointerMap[i.pointerId];if(n){var r=n.out,o=n.outTarget;this.dispatcher.move(i,t),r&&o!==i.target&&(r.relatedTarget=i.target,i.relatedTarget=o,r.target=o,i.target?(this.dispatcher.leaveOut(r,t),this.dispatcher.enterOver(i,t)):(i.target=o,i.relatedTarget=null,this.cancelOut_(t,i))),n.out=i,n.outTarget=i.target}},e.prototype.upOut_=function(t,e){this.dispatcher.up(e,t),this.dispatcher.out(e,t),this.dispatcher.leave(e,t),this.cleanUpPointer_(e)},e.prototype.cancelOut_=function(t,e){this.dispatcher.cancel(e,t),this.dispatcher.out(e,t),this.dispatcher.leave(e,t),this.cleanUpPointer_(e)},e.prototype.cleanUpPointer_=function(t){delete this.pointerMap[t.pointerId],this.removePrimaryPointer_(t)},e.prototype.dedupSynthMouse_=function(t){var e=this.mouseSource.lastTouches,i=t.changedTouches[0];if(this.isPrimaryTouch_(i)){var n=[i.clientX,i.clientY];e.push(n),setTimeout(function(){var t,i,r;i=n,r=(t=e).indexOf(i),r>-1&&t.splice(r,1)},this.dedupTimeout_)}},e}(P_),hx=[["bubbles",!1],["cancelable",!1],["view",null],["detail",null],["screenX",0],["screenY",0],["clientX",0],["clientY",0],["ctrlKey",!1],["altKey",!1],["shiftKey",!1],["metaKey",!1],["button",0],["relatedTarget",null],["buttons",0],["pointerId",0],["width",0],["height",0],["pressure",0],["tiltX",0],["tiltY",0],["pointerType",""],["hwTimestamp",0],["isPrimary",!1],["type",""],["target",null],["currentTarget",null],["which",0]],ux=function(t){function e(e){t.call(this),this.element_=e,this.pointerMap={},this.eventMap_={},this.eventSourceList_=[],this.registerSources()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.registerSources=function(){if(Hs)this.registerSource("native",new tx(this));else if(Zs)this.registerSource("ms",new UE(this));else{var t=new G_(this);this.registerSource("mouse",t),Ws&&this.registerSource("touch",new ax(this,t))}this.register_()},e.prototype.registerSource=function(t,e){var i=e,n=i.getEvents();n&&(n.forEach(function(t){var e=i.getHandlerForEvent(t);e&&(this.eventMap_[t]=e.bind(i))}.bind(this)),this.eventSourceList_.push(i))},e.prototype.register_=function(){for(var t=this.eventSourceList_.length,e=0;e<t;e++){var i=this.eventSourceList_[e];this.addEvents_(i.getEvents())}},e.prototype.unregister_=function(){for(var t=this.eventSourceList_.length,e=0;e<t;e++){var i=this.eventSourceList_[e];this.removeEvents_(i.getEvents())}},e.prototype.eventHandler_=function(t){var e=t.type,i=this.eventMap_[e];i&&i(t)},e.prototype.addEvents_=function(t){t.forEach(function(t){gr(this.element_,t,this.eventHandler_,this)}.bind(this))},e.prototype.removeEvents_=function(t){t.forEach(function(t){vr(this.element_,t,this.eventHandler_,this)}.bind(this))},e.prototype.cloneEvent=function(t,e){for(var i={},n=0,r=hx.length;n<r;n++){var o=hx[n][0];i[o]=t[o]||e[o]||hx[n][1]}return i},e.prototype.down=function(t,e){this.fireEvent(NE.POINTERDOWN,t,e)},e.prototype.move=function(t,e){this.fireEvent(NE.POINTERMOVE,t,e)},e.prototype.up=function(t,e){this.fireEvent(NE.POINTERUP,t,e)},e.prototype.enter=function(t,e){t.bubbles=!1,this.fireEvent(NE.POINTERENTER,t,e)},e.prototype.leave=function(t,e){t.bubbles=!1,this.fireEvent(NE.POINTERLEAVE,t,e)},e.prototype.over=function(t,e){t.bubbles=!0,this.fireEvent(NE.POINTEROVER,t,e)},e.prototype.out=function(t,e){t.bubbles=!0,this.fireEvent(NE.POINTEROUT,t,e)},e.prototype.cancel=function(t,e){this.fireEvent(NE.POINTERCANCEL,t,e)},e.prototype.leaveOut=function(t,e){this.out(t,e),this.contains_(t.target,t.relatedTarget)||this.leave(t,e)},e.prototype.enterOver=function(t,e){this.over(t,e),this.contains_(t.target,t.relatedTarget)||this.enter(t,e)},e.prototype.contains_=function(t,e){return!(!t||!e)&&t.contains(e)},e.prototype.makeEvent=function(t,e,i){return new ix(t,i,e)},e.prototype.fireEvent=function(t,e,i){var n=this.makeEvent(t,e,i);this.dispatchEvent(n)},e.prototype.fireNativeEvent=function(t){var e=this.makeEvent(t.type,t,t);this.dispatchEvent(e)},e.prototype.wrapMouseEvent=function(t,e){return this.makeEvent(t,G_.prepareEvent(e,this),e)},e.prototype.disposeInternal=function(){this.unregister_(),t.prototype.disposeInternal.call(this)},e}(wr),lx=function(t){function e(e,i){t.call(this),this.map_=e,this.clickTimeoutId_=0,this.dragging_=!1,this.dragListenerKeys_=[],this.moveTolerance_=i?i*Us:Us,this.down_=null;var n=this.map_.getViewport();this.activePointers_=0,this.trackedTouches_={},this.pointerEventHandler_=new ux(n),this.documentPointerEventHandler_=null,this.pointerdownListenerKey_=gr(this.pointerEventHandler_,NE.POINTERDOWN,this.handlePointerDown_,this),this.relayedListenerKey_=gr(this.pointerEventHandler_,NE.POINTERMOVE,this.relayEvent_,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.emulateClick_=function(t){var e=new I_(f_.CLICK,this.map_,t);this.dispatchEvent(e),0!==this.clickTimeoutId_?(clearTimeout(this.clickTimeoutId_),this.clickTimeoutId_=0,e=new I_(f_.DBLCLICK,this.map_,t),this.dispatchEvent(e)):this.clickTimeoutId_=setTimeout(function(){this.clickTimeoutId_=0;var e=new I_(f_.SINGLECLICK,this.map_,t);this.dispatchEvent(e)}.bind(this),250)},e.prototype.updateActivePointers_=function(t){var e=t;e.type==f_.POINTERUP||e.type==f_.POINTERCANCEL?delete this.trackedTouches_[e.pointerId]:e.type==f_.POINTERDOWN&&(this.trackedTouches_[e.pointerId]=!0),this.activePointers_=Object.keys(this.trackedTouches_).length},e.prototype.handlePointerUp_=function(t){this.updateActivePointers_(t);var e=new I_(f_.POINTERUP,this.map_,t);this.dispatchEvent(e),e.propagationStopped||this.dragging_||!this.isMouseActionButton_(t)||this.emulateClick_(this.down_),0===this.activePointers_&&(this.dragListenerKeys_.forEach(_r),this.dragListenerKeys_.length=0,this.dragging_=!1,this.down_=null,this.documentPointerEventHandler_.dispose(),this.documentPointerEventHandler_=null)},e.prototype.isMouseActionButton_=function(t){return 0===t.button},e.prototype.handlePointerDown_=function(t){this.updateActivePointers_(t);var e=new I_(f_.POINTERDOWN,this.map_,t);this.dispatchEvent(e),this.down_=t,0===this.dragListenerKeys_.length&&(this.documentPointerEventHandler_=new ux(document),this.dragListenerKeys_.push(gr(this.documentPointerEventHandler_,f_.POINTERMOVE,this.handlePointerMove_,this),gr(this.documentPointerEventHandler_,f_.POINTERUP,this.handlePointerUp_,this),gr(this.pointerEventHandler_,f_.POINTERCANCEL,this.handlePointerUp_,this)))},e.prototype.handlePointerMove_=function(t){if(this.isMoving_(t)){this.dragging_=!0;var e=new I_(f_.POINTERDRAG,this.map_,t,this.dragging_);this.dispatchEvent(e)}t.preventDefault()},e.prototype.relayEvent_=function(t){var e=!(!this.down_||!this.isMoving_(t));this.dispatchEvent(new I_(t.type,this.map_,t,e))},e.prototype.isMoving_=function(t){return this.dragging_||Math.abs(t.clientX-this.down_.clientX)>this.moveTolerance_||Math.abs(t.clientY-this.down_.clientY)>this.moveTolerance_},e.prototype.disposeInternal=function(){this.relayedListenerKey_&&(_r(this.relayedListenerKey_),this.relayedListenerKey_=null),this.pointerdownListenerKey_&&(_r(this.pointerdownListenerKey_),this.pointerdownListenerKey_=null),this.dragListenerKeys_.forEach(_r),this.dragListenerKeys_.length=0,this.documentPointerEventHandler_&&(this.documentPointerEventHandler_.dispose(),this.documentPointerEventHandler_=null),this.pointerEventHandler_&&(this.pointerEventHandler_.dispose(),this.pointerEventHandler_=null),t.prototype.disposeInternal.call(this)},e}(wr),cx={LAYERGROUP:"layergroup",SIZE:"size",TARGET:"target",VIEW:"view"},px=function(t,e){this.priorityFunction_=t,this.keyFunction_=e,this.elements_=[],this.priorities_=[],this.queuedElements_={}};px.prototype.clear=function(){this.elements_.length=0,this.priorities_.length=0,Jn(this.queuedElements_)},px.prototype.dequeue=function(){var t=this.elements_,e=this.priorities_,i=t[0];1==t.length?(t.length=0,e.length=0):(t[0]=t.pop(),e[0]=e.pop(),this.siftUp_(0));var n=this.keyFunction_(i);return delete this.queuedElements_[n],i},px.prototype.enqueue=function(t){sn(!(this.keyFunction_(t)in this.queuedElements_),31);var e=this.priorityFunction_(t);return e!=1/0&&(this.elements_.push(t),this.priorities_.push(e),this.queuedElements_[this.keyFunction_(t)]=!0,this.siftDown_(0,this.elements_.length-1),!0)},px.prototype.getCount=function(){return this.elements_.length},px.prototype.getLeftChildIndex_=function(t){return 2*t+1},px.prototype.getRightChildIndex_=function(t){return 2*t+2},px.prototype.getParentIndex_=function(t){return t-1>>1},px.prototype.heapify_=function(){var t;for(t=(this.elements_.length>>1)-1;t>=0;t--)this.siftUp_(t)},px.prototype.isEmpty=function(){return 0===this.elements_.length},px.prototype.isKeyQueued=function(t){return t in this.queuedElements_},px.prototype.isQueued=function(t){return this.isKeyQueued(this.keyFunction_(t))},px.prototype.siftUp_=function(t){for(var e=this.elements_,i=this.priorities_,n=e.length,r=e[t],o=i[t],s=t;t<n>>1;){var a=this.getLeftChildIndex_(t),h=this.getRightChildIndex_(t),u=h<n&&i[h]<i[a]?h:a;e[t]=e[u],i[t]=i[u],t=u}e[t]=r,i[t]=o,this.siftDown_(s,t)},px.prototype.siftDown_=function(t,e){for(var i=this.elements_,n=this.priorities_,r=i[e],o=n[e];e>t;){var s=this.getParentIndex_(e);if(!(n[s]>o))break;i[e]=i[s],n[e]=n[s],e=s}i[e]=r,n[e]=o},px.prototype.reprioritize=function(){var t,e,i,n=this.priorityFunction_,r=this.elements_,o=this.priorities_,s=0,a=r.length;for(e=0;e<a;++e)(i=n(t=r[e]))==1/0?delete this.queuedElements_[this.keyFunction_(t)]:(o[s]=i,r[s++]=t);r.length=s,o.length=s,this.heapify_()};var fx=function(t){function e(e,i){t.call(this,function(t){return e.apply(null,t)},function(t){return t[0].getKey()}),this.tileChangeCallback_=i,this.tilesLoading_=0,this.tilesLoadingKeys_={}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.enqueue=function(e){var i=t.prototype.enqueue.call(this,e);i&&gr(e[0],br.CHANGE,this.handleTileChange,this);return i},e.prototype.getTilesLoading=function(){return this.tilesLoading_},e.prototype.handleTileChange=function(t){var e=t.target,i=e.getState();if(i===or.LOADED||i===or.ERROR||i===or.EMPTY||i===or.ABORT){vr(e,br.CHANGE,this.handleTileChange,this);var n=e.getKey();n in this.tilesLoadingKeys_&&(delete this.tilesLoadingKeys_[n],--this.tilesLoading_),this.tileChangeCallback_()}},e.prototype.loadMoreTiles=function(t,e){for(var i,n,r,o=0,s=!1;this.tilesLoading_<t&&o<e&&this.getCount()>0;)r=(n=this.dequeue()[0]).getKey(),(i=n.getState())===or.ABORT?s=!0:i!==or.IDLE||r in this.tilesLoadingKeys_||(this.tilesLoadingKeys_[r]=!0,++this.tilesLoading_,++o,n.load());0===o&&s&&this.tileChangeCallback_()},e}(px);function dx(t){return t}function gx(t,e){return void 0!==t?0:void 0}function yx(t,e){return void 0!==t?t+e:void 0}var vx={ANIMATING:0,INTERACTING:1},_x="center",mx="resolution",Ex="rotation",xx=0,Sx=function(t){function e(e){t.call(this);var i=qn({},e);this.hints_=[0,0],this.animations_=[],this.updateAnimationKey_,this.updateAnimations_=this.updateAnimations_.bind(this),this.projection_=vo(i.projection,"EPSG:3857"),this.applyOptions_(i)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.applyOptions_=function(t){var e={};e[_x]=void 0!==t.center?t.center:null;var i=function(t){var e,i,n,r=void 0!==t.minZoom?t.minZoom:xx,o=void 0!==t.maxZoom?t.maxZoom:28,s=void 0!==t.zoomFactor?t.zoomFactor:2;if(void 0!==t.resolutions){var a=t.resolutions;i=a[r],n=void 0!==a[o]?a[o]:a[a.length-1],e=function(t){return function(e,i,n){if(void 0!==e){var r=Ko(t,e,n);r=Yn(r+i,0,t.length-1);var o=Math.floor(r);if(r!=o&&o<t.length-1){var s=t[o]/t[o+1];return t[o]/Math.pow(s,r-o)}return t[o]}}}(a)}else{var h=vo(t.projection,"EPSG:3857"),u=h.getExtent(),l=u?Math.max(Gn(u),An(u)):360*Xr[Yr.DEGREES]/h.getMetersPerUnit(),c=l/zo/Math.pow(2,xx),p=c/Math.pow(2,28-xx);void 0!==(i=t.maxResolution)?r=0:i=c/Math.pow(s,r),void 0===(n=t.minResolution)&&(n=void 0!==t.maxZoom?void 0!==t.maxResolution?i/Math.pow(s,o):c/Math.pow(s,o):p),o=r+Math.floor(Math.log(i/n)/Math.log(s)),n=i/Math.pow(s,o-r),e=function(t,e,i){return function(n,r,o){if(void 0!==n){var s=-o/2+.5,a=Math.floor(Math.log(e/n)/Math.log(t)+s),h=Math.max(a+r,0);return void 0!==i&&(h=Math.min(h,i)),e/Math.pow(t,h)}}}(s,i,o-r)}return{constraint:e,maxResolution:i,minResolution:n,minZoom:r,zoomFactor:s}}(t);this.maxResolution_=i.maxResolution,this.minResolution_=i.minResolution,this.zoomFactor_=i.zoomFactor,this.resolutions_=t.resolutions,this.minZoom_=i.minZoom;var n=function(t){return void 0!==t.extent?(e=t.extent,function(t){return t?[Yn(t[0],e[0],e[2]),Yn(t[1],e[1],e[3])]:void 0}):dx;var e}(t),r=i.constraint,o=function(t){if(void 0===t.enableRotation||t.enableRotation){var e=t.constrainRotation;return void 0===e||!0===e?(o=r||Wn(5),function(t,e){return void 0!==t?Math.abs(t+e)<=o?0:t+e:void 0}):!1===e?yx:"number"==typeof e?(i=e,n=2*Math.PI/i,function(t,e){return void 0!==t?t=Math.floor((t+e)/n+.5)*n:void 0}):yx}return gx;var i,n;var r,o}(t);this.constraints_={center:n,resolution:r,rotation:o},void 0!==t.resolution?e[mx]=t.resolution:void 0!==t.zoom&&(e[mx]=this.constrainResolution(this.maxResolution_,t.zoom-this.minZoom_),this.resolutions_&&(e[mx]=Yn(Number(this.getResolution()||e[mx]),this.minResolution_,this.maxResolution_))),e[Ex]=void 0!==t.rotation?t.rotation:0,this.setProperties(e),this.options_=t},e.prototype.getUpdatedOptions_=function(t){var e=qn({},this.options_);return void 0!==e.resolution?e.resolution=this.getResolution():e.zoom=this.getZoom(),e.center=this.getCenter(),e.rotation=this.getRotation(),qn({},e,t)},e.prototype.animate=function(t){var e,i=arguments,n=arguments.length;if(n>1&&"function"==typeof arguments[n-1]&&(e=arguments[n-1],--n),!this.isDef()){var r=arguments[n-1];return r.center&&this.setCenter(r.center),void 0!==r.zoom&&this.setZoom(r.zoom),void 0!==r.rotation&&this.setRotation(r.rotation),void(e&&Tx(e,!0))}for(var o=Date.now(),s=this.getCenter().slice(),a=this.getResolution(),h=this.getRotation(),u=[],l=0;l<n;++l){var c=i[l],p={start:o,complete:!1,anchor:c.anchor,duration:void 0!==c.duration?c.duration:1e3,easing:c.easing||hr};if(c.center&&(p.sourceCenter=s,p.targetCenter=c.center,s=p.targetCenter),void 0!==c.zoom?(p.sourceResolution=a,p.targetResolution=this.constrainResolution(this.maxResolution_,c.zoom-this.minZoom_,0),a=p.targetResolution):c.resolution&&(p.sourceResolution=a,p.targetResolution=c.resolution,a=p.targetResolution),void 0!==c.rotation){p.sourceRotation=h;var f=Hn(c.rotation-h+Math.PI,2*Math.PI)-Math.PI;p.targetRotation=h+f,h=p.targetRotation}p.callback=e,wx(p)?p.complete=!0:o+=p.duration,u.push(p)}this.animations_.push(u),this.setHint(vx.ANIMATING,1),this.updateAnimations_()},e.prototype.getAnimating=function(){return this.hints_[vx.ANIMATING]>0},e.prototype.getInteracting=function(){return this.hints_[vx.INTERACTING]>0},e.prototype.cancelAnimations=function(){this.setHint(vx.ANIMATING,-this.hints_[vx.ANIMATING]);for(var t=0,e=this.animations_.length;t<e;++t){var i=this.animations_[t];i[0].callback&&Tx(i[0].callback,!1)}this.animations_.length=0},e.prototype.updateAnimations_=function(){if(void 0!==this.updateAnimationKey_&&(cancelAnimationFrame(this.updateAnimationKey_),this.updateAnimationKey_=void 0),this.getAnimating()){for(var t=Date.now(),e=!1,i=this.animations_.length-1;i>=0;--i){for(var n=this.animations_[i],r=!0,o=0,s=n.length;o<s;++o){var a=n[o];if(!a.complete){var h=t-a.start,u=a.duration>0?h/a.duration:1;u>=1?(a.complete=!0,u=1):r=!1;var l=a.easing(u);if(a.sourceCenter){var c=a.sourceCenter[0],p=a.sourceCenter[1],f=c+l*(a.targetCenter[0]-c),d=p+l*(a.targetCenter[1]-p);this.set(_x,[f,d])}if(a.sourceResolution&&a.targetResolution){var g=1===l?a.targetResolution:a.sourceResolution+l*(a.targetResolution-a.sourceResolution);a.anchor&&this.set(_x,this.calculateCenterZoom(g,a.anchor)),this.set(mx,g)}if(void 0!==a.sourceRotation&&void 0!==a.targetRotation){var y=1===l?Hn(a.targetRotation+Math.PI,2*Math.PI)-Math.PI:a.sourceRotation+l*(a.targetRotation-a.sourceRotation);a.anchor&&this.set(_x,this.calculateCenterRotate(y,a.anchor)),this.set(Ex,y)}if(e=!0,!a.complete)break}}if(r){this.animations_[i]=null,this.setHint(vx.ANIMATING,-1);var v=n[0].callback;v&&Tx(v,!0)}}this.animations_=this.animations_.filter(Boolean),e&&void 0===this.updateAnimationKey_&&(this.updateAnimationKey_=requestAnimationFrame(this.updateAnimations_))}},e.prototype.calculateCenterRotate=function(t,e){var i,n=this.getCenter();return void 0!==n&&(Rv(i=[n[0]-e[0],n[1]-e[1]],t-this.getRotation()),Tv(i,e)),i},e.prototype.calculateCenterZoom=function(t,e){var i,n=this.getCenter(),r=this.getResolution();void 0!==n&&void 0!==r&&(i=[e[0]-t*(e[0]-n[0])/r,e[1]-t*(e[1]-n[1])/r]);return i},e.prototype.getSizeFromViewport_=function(){var t=[100,100],e='.ol-viewport[data-view="'+nn(this)+'"]',i=document.querySelector(e);if(i){var n=getComputedStyle(i);t[0]=parseInt(n.width,10),t[1]=parseInt(n.height,10)}return t},e.prototype.constrainCenter=function(t){return this.constraints_.center(t)},e.prototype.constrainResolution=function(t,e,i){var n=e||0,r=i||0;return this.constraints_.resolution(t,n,r)},e.prototype.constrainRotation=function(t,e){var i=e||0;return this.constraints_.rotation(t,i)},e.prototype.getCenter=function(){return this.get(_x)},e.prototype.getConstraints=function(){return this.constraints_},e.prototype.getHints=function(t){return void 0!==t?(t[0]=this.hints_[0],t[1]=this.hints_[1],t):this.hints_.slice()},e.prototype.calculateExtent=function(t){var e=t||this.getSizeFromViewport_(),i=this.getCenter();sn(i,1);var n=this.getResolution();sn(void 0!==n,2);var r=this.getRotation();return sn(void 0!==r,3),Mn(i,n,r,e)},e.prototype.getMaxResolution=function(){return this.maxResolution_},e.prototype.getMinResolution=function(){return this.minResolution_},e.prototype.getMaxZoom=function(){return this.getZoomForResolution(this.minResolution_)},e.prototype.setMaxZoom=function(t){this.applyOptions_(this.getUpdatedOptions_({maxZoom:t}))},e.prototype.getMinZoom=function(){return this.getZoomForResolution(this.maxResolution_)},e.prototype.setMinZoom=function(t){this.applyOptions_(this.getUpdatedOptions_({minZoom:t}))},e.prototype.getProjection=function(){return this.projection_},e.prototype.getResolution=function(){return this.get(mx)},e.prototype.getResolutions=function(){return this.resolutions_},e.prototype.getResolutionForExtent=function(t,e){var i=e||this.getSizeFromViewport_(),n=Gn(t)/i[0],r=An(t)/i[1];return Math.max(n,r)},e.prototype.getResolutionForValueFunction=function(t){var e=t||2,i=this.maxResolution_,n=this.minResolution_,r=Math.log(i/n)/Math.log(e);return function(t){return i/Math.pow(e,t*r)}},e.prototype.getRotation=function(){return this.get(Ex)},e.prototype.getValueForResolutionFunction=function(t){var e=t||2,i=this.maxResolution_,n=this.minResolution_,r=Math.log(i/n)/Math.log(e);return function(t){return Math.log(i/t)/Math.log(e)/r}},e.prototype.getState=function(t){var e=this.getCenter(),i=this.getProjection(),n=this.getResolution(),r=n/t,o=this.getRotation();return{center:[Math.round(e[0]/r)*r,Math.round(e[1]/r)*r],projection:void 0!==i?i:null,resolution:n,rotation:o,zoom:this.getZoom()}},e.prototype.getZoom=function(){var t,e=this.getResolution();return void 0!==e&&(t=this.getZoomForResolution(e)),t},e.prototype.getZoomForResolution=function(t){var e,i,n=this.minZoom_||0;if(this.resolutions_){var r=Ko(this.resolutions_,t,1);n=r,e=this.resolutions_[r],i=r==this.resolutions_.length-1?2:e/this.resolutions_[r+1]}else e=this.maxResolution_,i=this.zoomFactor_;return n+Math.log(e/t)/Math.log(i)},e.prototype.getResolutionForZoom=function(t){return this.constrainResolution(this.maxResolution_,t-this.minZoom_,0)},e.prototype.fit=function(t,e){var i,n=e||{},r=n.size;r||(r=this.getSizeFromViewport_()),t instanceof nu?t.getType()===Dr.CIRCLE?(i=Uu(t=t.getExtent())).rotate(this.getRotation(),Ln(t)):i=t:(sn(Array.isArray(t),24),sn(!$n(t),25),i=Uu(t));var o,s=void 0!==n.padding?n.padding:[0,0,0,0],a=void 0===n.constrainResolution||n.constrainResolution,h=void 0!==n.nearest&&n.nearest;o=void 0!==n.minResolution?n.minResolution:void 0!==n.maxZoom?this.constrainResolution(this.maxResolution_,n.maxZoom-this.minZoom_,0):0;for(var u=i.getFlatCoordinates(),l=this.getRotation(),c=Math.cos(-l),p=Math.sin(-l),f=1/0,d=1/0,g=-1/0,y=-1/0,v=i.getStride(),_=0,m=u.length;_<m;_+=v){var E=u[_]*c-u[_+1]*p,x=u[_]*p+u[_+1]*c;f=Math.min(f,E),d=Math.min(d,x),g=Math.max(g,E),y=Math.max(y,x)}var S=this.getResolutionForExtent([f,d,g,y],[r[0]-s[1]-s[3],r[1]-s[0]-s[2]]);if(S=isNaN(S)?o:Math.max(S,o),a){var T=this.constrainResolution(S,0,0);!h&&T<S&&(T=this.constrainResolution(T,-1,0)),S=T}p=-p;var w=(f+g)/2,b=(d+y)/2,C=[(w+=(s[1]-s[3])/2*S)*c-(b+=(s[0]-s[2])/2*S)*p,b*c+w*p],R=n.callback?n.callback:xr;void 0!==n.duration?this.animate({resolution:S,center:C,duration:n.duration,easing:n.easing},R):(this.setResolution(S),this.setCenter(C),Tx(R,!0))},e.prototype.centerOn=function(t,e,i){var n=this.getRotation(),r=Math.cos(-n),o=Math.sin(-n),s=t[0]*r-t[1]*o,a=t[1]*r+t[0]*o,h=this.getResolution(),u=(s+=(e[0]/2-i[0])*h)*r-(a+=(i[1]-e[1]/2)*h)*(o=-o),l=a*r+s*o;this.setCenter([u,l])},e.prototype.isDef=function(){return!!this.getCenter()&&void 0!==this.getResolution()},e.prototype.rotate=function(t,e){if(void 0!==e){var i=this.calculateCenterRotate(t,e);this.setCenter(i)}this.setRotation(t)},e.prototype.setCenter=function(t){this.set(_x,t),this.getAnimating()&&this.cancelAnimations()},e.prototype.setHint=function(t,e){return this.hints_[t]+=e,this.changed(),this.hints_[t]},e.prototype.setResolution=function(t){this.set(mx,t),this.getAnimating()&&this.cancelAnimations()},e.prototype.setRotation=function(t){this.set(Ex,t),this.getAnimating()&&this.cancelAnimations()},e.prototype.setZoom=function(t){this.setResolution(this.getResolutionForZoom(t))},e}(ko);function Tx(t,e){setTimeout(function(){t(e)},0)}function wx(t){return!(t.sourceCenter&&t.targetCenter&&!Cv(t.sourceCenter,t.targetCenter))&&(t.sourceResolution===t.targetResolution&&t.sourceRotation===t.targetRotation)}var bx=function(t){function e(e){t.call(this);var i=function(t){var e=null;void 0!==t.keyboardEventTarget&&(e="string"==typeof t.keyboardEventTarget?document.getElementById(t.keyboardEventTarget):t.keyboardEventTarget);var i,n,r,o={},s=t.layers instanceof Bm?t.layers:new Bm({layers:t.layers});o[cx.LAYERGROUP]=s,o[cx.TARGET]=t.target,o[cx.VIEW]=void 0!==t.view?t.view:new Sx,void 0!==t.controls&&(Array.isArray(t.controls)?i=new Nv(t.controls.slice()):(sn(t.controls instanceof Nv,47),i=t.controls));void 0!==t.interactions&&(Array.isArray(t.interactions)?n=new Nv(t.interactions.slice()):(sn(t.interactions instanceof Nv,48),n=t.interactions));void 0!==t.overlays?Array.isArray(t.overlays)?r=new Nv(t.overlays.slice()):(sn(t.overlays instanceof Nv,49),r=t.overlays):r=new Nv;return{controls:i,interactions:n,keyboardEventTarget:e,overlays:r,values:o}}(e);this.maxTilesLoading_=void 0!==e.maxTilesLoading?e.maxTilesLoading:16,this.loadTilesWhileAnimating_=void 0!==e.loadTilesWhileAnimating&&e.loadTilesWhileAnimating,this.loadTilesWhileInteracting_=void 0!==e.loadTilesWhileInteracting&&e.loadTilesWhileInteracting,this.pixelRatio_=void 0!==e.pixelRatio?e.pixelRatio:Us,this.animationDelayKey_,this.animationDelay_=function(){this.animationDelayKey_=void 0,this.renderFrame_.call(this,Date.now())}.bind(this),this.coordinateToPixelTransform_=[1,0,0,1,0,0],this.pixelToCoordinateTransform_=[1,0,0,1,0,0],this.frameIndex_=0,this.frameState_=null,this.previousExtent_=null,this.viewPropertyListenerKey_=null,this.viewChangeListenerKey_=null,this.layerGroupPropertyListenerKeys_=null,this.viewport_=document.createElement("div"),this.viewport_.className="ol-viewport"+(Ws?" ol-touch":""),this.viewport_.style.position="relative",this.viewport_.style.overflow="hidden",this.viewport_.style.width="100%",this.viewport_.style.height="100%",this.viewport_.style.msTouchAction="none",this.viewport_.style.touchAction="none",this.overlayContainer_=document.createElement("div"),this.overlayContainer_.className="ol-overlaycontainer",this.viewport_.appendChild(this.overlayContainer_),this.overlayContainerStopEvent_=document.createElement("div"),this.overlayContainerStopEvent_.className="ol-overlaycontainer-stopevent";for(var n=[br.CLICK,br.DBLCLICK,br.MOUSEDOWN,br.TOUCHSTART,br.MSPOINTERDOWN,f_.POINTERDOWN,br.MOUSEWHEEL,br.WHEEL],r=0,o=n.length;r<o;++r)gr(this.overlayContainerStopEvent_,n[r],Tr);for(var s in this.viewport_.appendChild(this.overlayContainerStopEvent_),this.mapBrowserEventHandler_=new lx(this,e.moveTolerance),f_)gr(this.mapBrowserEventHandler_,f_[s],this.handleMapBrowserEvent,this);this.keyboardEventTarget_=i.keyboardEventTarget,this.keyHandlerKeys_=null,gr(this.viewport_,br.CONTEXTMENU,this.handleBrowserEvent,this),gr(this.viewport_,br.WHEEL,this.handleBrowserEvent,this),gr(this.viewport_,br.MOUSEWHEEL,this.handleBrowserEvent,this),this.controls=i.controls||new Nv,this.interactions=i.interactions||new Nv,this.overlays_=i.overlays,this.overlayIdIndex_={},this.renderer_=this.createRenderer(),this.handleResize_,this.focus_=null,this.postRenderFunctions_=[],this.tileQueue_=new fx(this.getTilePriority.bind(this),this.handleTileChange_.bind(this)),this.skippedFeatureUids_={},gr(this,Go(cx.LAYERGROUP),this.handleLayerGroupChanged_,this),gr(this,Go(cx.VIEW),this.handleViewChanged_,this),gr(this,Go(cx.SIZE),this.handleSizeChanged_,this),gr(this,Go(cx.TARGET),this.handleTargetChanged_,this),this.setProperties(i.values),this.controls.forEach(function(t){t.setMap(this)}.bind(this)),gr(this.controls,Lv.ADD,function(t){t.element.setMap(this)},this),gr(this.controls,Lv.REMOVE,function(t){t.element.setMap(null)},this),this.interactions.forEach(function(t){t.setMap(this)}.bind(this)),gr(this.interactions,Lv.ADD,function(t){t.element.setMap(this)},this),gr(this.interactions,Lv.REMOVE,function(t){t.element.setMap(null)},this),this.overlays_.forEach(this.addOverlayInternal_.bind(this)),gr(this.overlays_,Lv.ADD,function(t){this.addOverlayInternal_(t.element)},this),gr(this.overlays_,Lv.REMOVE,function(t){var e=t.element.getId();void 0!==e&&delete this.overlayIdIndex_[e.toString()],t.element.setMap(null)},this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.createRenderer=function(){throw new Error("Use a map type that has a createRenderer method")},e.prototype.addControl=function(t){this.getControls().push(t)},e.prototype.addInteraction=function(t){this.getInteractions().push(t)},e.prototype.addLayer=function(t){this.getLayerGroup().getLayers().push(t)},e.prototype.addOverlay=function(t){this.getOverlays().push(t)},e.prototype.addOverlayInternal_=function(t){var e=t.getId();void 0!==e&&(this.overlayIdIndex_[e.toString()]=t),t.setMap(this)},e.prototype.disposeInternal=function(){this.mapBrowserEventHandler_.dispose(),vr(this.viewport_,br.CONTEXTMENU,this.handleBrowserEvent,this),vr(this.viewport_,br.WHEEL,this.handleBrowserEvent,this),vr(this.viewport_,br.MOUSEWHEEL,this.handleBrowserEvent,this),void 0!==this.handleResize_&&(removeEventListener(br.RESIZE,this.handleResize_,!1),this.handleResize_=void 0),this.animationDelayKey_&&(cancelAnimationFrame(this.animationDelayKey_),this.animationDelayKey_=void 0),this.setTarget(null),t.prototype.disposeInternal.call(this)},e.prototype.forEachFeatureAtPixel=function(t,e,i){if(this.frameState_){var n=this.getCoordinateFromPixel(t),r=void 0!==(i=void 0!==i?i:{}).hitTolerance?i.hitTolerance*this.frameState_.pixelRatio:0,o=void 0!==i.layerFilter?i.layerFilter:mr;return this.renderer_.forEachFeatureAtCoordinate(n,this.frameState_,r,e,null,o,null)}},e.prototype.getFeaturesAtPixel=function(t,e){var i=null;return this.forEachFeatureAtPixel(t,function(t){i||(i=[]),i.push(t)},e),i},e.prototype.forEachLayerAtPixel=function(t,e,i){if(this.frameState_){var n=i||{},r=void 0!==n.hitTolerance?i.hitTolerance*this.frameState_.pixelRatio:0,o=n.layerFilter||mr;return this.renderer_.forEachLayerAtPixel(t,this.frameState_,r,e,null,o,null)}},e.prototype.hasFeatureAtPixel=function(t,e){if(!this.frameState_)return!1;var i=this.getCoordinateFromPixel(t),n=void 0!==(e=void 0!==e?e:{}).layerFilter?e.layerFilter:mr,r=void 0!==e.hitTolerance?e.hitTolerance*this.frameState_.pixelRatio:0;return this.renderer_.hasFeatureAtCoordinate(i,this.frameState_,r,n,null)},e.prototype.getEventCoordinate=function(t){return this.getCoordinateFromPixel(this.getEventPixel(t))},e.prototype.getEventPixel=function(t){var e=this.viewport_.getBoundingClientRect(),i=t.changedTouches?t.changedTouches[0]:t;return[i.clientX-e.left,i.clientY-e.top]},e.prototype.getTarget=function(){return this.get(cx.TARGET)},e.prototype.getTargetElement=function(){var t=this.getTarget();return void 0!==t?"string"==typeof t?document.getElementById(t):t:null},e.prototype.getCoordinateFromPixel=function(t){var e=this.frameState_;return e?bs(e.pixelToCoordinateTransform,t.slice()):null},e.prototype.getControls=function(){return this.controls},e.prototype.getOverlays=function(){return this.overlays_},e.prototype.getOverlayById=function(t){var e=this.overlayIdIndex_[t.toString()];return void 0!==e?e:null},e.prototype.getInteractions=function(){return this.interactions},e.prototype.getLayerGroup=function(){return this.get(cx.LAYERGROUP)},e.prototype.getLayers=function(){return this.getLayerGroup().getLayers()},e.prototype.getPixelFromCoordinate=function(t){var e=this.frameState_;return e?bs(e.coordinateToPixelTransform,t.slice(0,2)):null},e.prototype.getRenderer=function(){return this.renderer_},e.prototype.getSize=function(){return this.get(cx.SIZE)},e.prototype.getView=function(){return this.get(cx.VIEW)},e.prototype.getViewport=function(){return this.viewport_},e.prototype.getOverlayContainer=function(){return this.overlayContainer_},e.prototype.getOverlayContainerStopEvent=function(){return this.overlayContainerStopEvent_},e.prototype.getTilePriority=function(t,e,i,n){var r=this.frameState_;if(!(r&&e in r.wantedTiles))return 1/0;if(!r.wantedTiles[e][t.getKey()])return 1/0;var o=i[0]-r.focus[0],s=i[1]-r.focus[1];return 65536*Math.log(n)+Math.sqrt(o*o+s*s)/n},e.prototype.handleBrowserEvent=function(t,e){var i=e||t.type,n=new O_(i,this,t);this.handleMapBrowserEvent(n)},e.prototype.handleMapBrowserEvent=function(t){if(this.frameState_){this.focus_=t.coordinate,t.frameState=this.frameState_;var e=this.getInteractions().getArray();if(!1!==this.dispatchEvent(t))for(var i=e.length-1;i>=0;i--){var n=e[i];if(n.getActive())if(!n.handleEvent(t))break}}},e.prototype.handlePostRender=function(){var t=this.frameState_,e=this.tileQueue_;if(!e.isEmpty()){var i=this.maxTilesLoading_,n=i;if(t){var r=t.viewHints;r[vx.ANIMATING]&&(i=this.loadTilesWhileAnimating_?8:0,n=2),r[vx.INTERACTING]&&(i=this.loadTilesWhileInteracting_?8:0,n=2)}e.getTilesLoading()<i&&(e.reprioritize(),e.loadMoreTiles(i,n))}!t||!this.hasListener(_g.RENDERCOMPLETE)||t.animate||this.tileQueue_.getTilesLoading()||function t(e){for(var i=0,n=e.length;i<n;++i){var r=e[i];if(r instanceof Bm)return t(r.getLayers().getArray());var o=e[i].getSource();if(o&&o.loading)return!0}return!1}(this.getLayers().getArray())||this.renderer_.dispatchRenderEvent(J_,t);for(var o=this.postRenderFunctions_,s=0,a=o.length;s<a;++s)o[s](this,t);o.length=0},e.prototype.handleSizeChanged_=function(){this.render()},e.prototype.handleTargetChanged_=function(){var t;if(this.getTarget()&&(t=this.getTargetElement()),this.keyHandlerKeys_){for(var e=0,i=this.keyHandlerKeys_.length;e<i;++e)_r(this.keyHandlerKeys_[e]);this.keyHandlerKeys_=null}if(t){t.appendChild(this.viewport_);var n=this.keyboardEventTarget_?this.keyboardEventTarget_:t;this.keyHandlerKeys_=[gr(n,br.KEYDOWN,this.handleBrowserEvent,this),gr(n,br.KEYPRESS,this.handleBrowserEvent,this)],this.handleResize_||(this.handleResize_=this.updateSize.bind(this),addEventListener(br.RESIZE,this.handleResize_,!1))}else this.renderer_.removeLayerRenderers(),Ir(this.viewport_),void 0!==this.handleResize_&&(removeEventListener(br.RESIZE,this.handleResize_,!1),this.handleResize_=void 0);this.updateSize()},e.prototype.handleTileChange_=function(){this.render()},e.prototype.handleViewPropertyChanged_=function(){this.render()},e.prototype.handleViewChanged_=function(){this.viewPropertyListenerKey_&&(_r(this.viewPropertyListenerKey_),this.viewPropertyListenerKey_=null),this.viewChangeListenerKey_&&(_r(this.viewChangeListenerKey_),this.viewChangeListenerKey_=null);var t=this.getView();t&&(this.viewport_.setAttribute("data-view",nn(t)),this.viewPropertyListenerKey_=gr(t,Mo,this.handleViewPropertyChanged_,this),this.viewChangeListenerKey_=gr(t,br.CHANGE,this.handleViewPropertyChanged_,this)),this.render()},e.prototype.handleLayerGroupChanged_=function(){this.layerGroupPropertyListenerKeys_&&(this.layerGroupPropertyListenerKeys_.forEach(_r),this.layerGroupPropertyListenerKeys_=null);var t=this.getLayerGroup();t&&(this.layerGroupPropertyListenerKeys_=[gr(t,Mo,this.render,this),gr(t,br.CHANGE,this.render,this)]),this.render()},e.prototype.isRendered=function(){return!!this.frameState_},e.prototype.renderSync=function(){this.animationDelayKey_&&cancelAnimationFrame(this.animationDelayKey_),this.animationDelay_()},e.prototype.render=function(){void 0===this.animationDelayKey_&&(this.animationDelayKey_=requestAnimationFrame(this.animationDelay_))},e.prototype.removeControl=function(t){return this.getControls().remove(t)},e.prototype.removeInteraction=function(t){return this.getInteractions().remove(t)},e.prototype.removeLayer=function(t){return this.getLayerGroup().getLayers().remove(t)},e.prototype.removeOverlay=function(t){return this.getOverlays().remove(t)},e.prototype.renderFrame_=function(t){var e,i=this.getSize(),n=this.getView(),r=[1/0,1/0,-1/0,-1/0],o=this.frameState_,s=null;if(void 0!==i&&function(t){return t[0]>0&&t[1]>0}(i)&&n&&n.isDef()){for(var a=n.getHints(this.frameState_?this.frameState_.viewHints:void 0),h=this.getLayerGroup().getLayerStatesArray(),u={},l=0,c=h.length;l<c;++l)u[nn(h[l].layer)]=h[l];e=n.getState(this.pixelRatio_),s={animate:!1,coordinateToPixelTransform:this.coordinateToPixelTransform_,extent:r,focus:this.focus_?this.focus_:e.center,index:this.frameIndex_++,layerStates:u,layerStatesArray:h,pixelRatio:this.pixelRatio_,pixelToCoordinateTransform:this.pixelToCoordinateTransform_,postRenderFunctions:[],size:i,skippedFeatureUids:this.skippedFeatureUids_,tileQueue:this.tileQueue_,time:t,usedTiles:{},viewState:e,viewHints:a,wantedTiles:{}}}if(s&&(s.extent=Mn(e.center,e.resolution,e.rotation,s.size,r)),this.frameState_=s,this.renderer_.renderFrame(s),s){if(s.animate&&this.render(),Array.prototype.push.apply(this.postRenderFunctions_,s.postRenderFunctions),o)(!this.previousExtent_||!$n(this.previousExtent_)&&!Tn(s.extent,this.previousExtent_))&&(this.dispatchEvent(new R_(_g.MOVESTART,this,o)),this.previousExtent_=mn(this.previousExtent_));this.previousExtent_&&!s.viewHints[vx.ANIMATING]&&!s.viewHints[vx.INTERACTING]&&!Tn(s.extent,this.previousExtent_)&&(this.dispatchEvent(new R_(_g.MOVEEND,this,s)),cn(s.extent,this.previousExtent_))}this.dispatchEvent(new R_(_g.POSTRENDER,this,s)),setTimeout(this.handlePostRender.bind(this),0)},e.prototype.setLayerGroup=function(t){this.set(cx.LAYERGROUP,t)},e.prototype.setSize=function(t){this.set(cx.SIZE,t)},e.prototype.setTarget=function(t){this.set(cx.TARGET,t)},e.prototype.setView=function(t){this.set(cx.VIEW,t)},e.prototype.skipFeature=function(t){var e=nn(t).toString();this.skippedFeatureUids_[e]=!0,this.render()},e.prototype.updateSize=function(){var t=this.getTargetElement();if(t){var e=getComputedStyle(t);this.setSize([t.offsetWidth-parseFloat(e.borderLeftWidth)-parseFloat(e.paddingLeft)-parseFloat(e.paddingRight)-parseFloat(e.borderRightWidth),t.offsetHeight-parseFloat(e.borderTopWidth)-parseFloat(e.paddingTop)-parseFloat(e.paddingBottom)-parseFloat(e.borderBottomWidth)])}else this.setSize(void 0)},e.prototype.unskipFeature=function(t){var e=nn(t).toString();delete this.skippedFeatureUids_[e],this.render()},e}(ko);var Cx=function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),render:i.render||Rx,target:i.target});var n=void 0!==i.className?i.className:"ol-rotate",r=void 0!==i.label?i.label:"⇧";this.label_=null,"string"==typeof r?(this.label_=document.createElement("span"),this.label_.className="ol-compass",this.label_.textContent=r):(this.label_=r,this.label_.classList.add("ol-compass"));var o=i.tipLabel?i.tipLabel:"Reset rotation",s=document.createElement("button");s.className=n+"-reset",s.setAttribute("type","button"),s.title=o,s.appendChild(this.label_),gr(s,br.CLICK,this.handleClick_,this);var a=n+" "+ta+" "+ia,h=this.element;h.className=a,h.appendChild(s),this.callResetNorth_=i.resetNorth?i.resetNorth:void 0,this.duration_=void 0!==i.duration?i.duration:250,this.autoHide_=void 0===i.autoHide||i.autoHide,this.rotation_=void 0,this.autoHide_&&this.element.classList.add(Js)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.handleClick_=function(t){t.preventDefault(),void 0!==this.callResetNorth_?this.callResetNorth_():this.resetNorth_()},e.prototype.resetNorth_=function(){var t=this.getMap().getView();t&&void 0!==t.getRotation()&&(this.duration_>0?t.animate({rotation:0,duration:this.duration_,easing:ar}):t.setRotation(0))},e}(RE);function Rx(t){var e=t.frameState;if(e){var i=e.viewState.rotation;if(i!=this.rotation_){var n="rotate("+i+"rad)";if(this.autoHide_){var r=this.element.classList.contains(Js);r||0!==i?r&&0!==i&&this.element.classList.remove(Js):this.element.classList.add(Js)}this.label_.style.msTransform=n,this.label_.style.webkitTransform=n,this.label_.style.transform=n}this.rotation_=i}}var Ox=function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),target:i.target});var n=void 0!==i.className?i.className:"ol-zoom",r=void 0!==i.delta?i.delta:1,o=void 0!==i.zoomInLabel?i.zoomInLabel:"+",s=void 0!==i.zoomOutLabel?i.zoomOutLabel:"−",a=void 0!==i.zoomInTipLabel?i.zoomInTipLabel:"Zoom in",h=void 0!==i.zoomOutTipLabel?i.zoomOutTipLabel:"Zoom out",u=document.createElement("button");u.className=n+"-in",u.setAttribute("type","button"),u.title=a,u.appendChild("string"==typeof o?document.createTextNode(o):o),gr(u,br.CLICK,this.handleClick_.bind(this,r));var l=document.createElement("button");l.className=n+"-out",l.setAttribute("type","button"),l.title=h,l.appendChild("string"==typeof s?document.createTextNode(s):s),gr(l,br.CLICK,this.handleClick_.bind(this,-r));var c=n+" "+ta+" "+ia,p=this.element;p.className=c,p.appendChild(u),p.appendChild(l),this.duration_=void 0!==i.duration?i.duration:250}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.handleClick_=function(t,e){e.preventDefault(),this.zoomByDelta_(t)},e.prototype.zoomByDelta_=function(t){var e=this.getMap().getView();if(e){var i=e.getResolution();if(i){var n=e.constrainResolution(i,t);this.duration_>0?(e.getAnimating()&&e.cancelAnimations(),e.animate({resolution:n,duration:this.duration_,easing:ar})):e.setResolution(n)}}},e}(RE);function Ix(t){var e=t||{},i=new Nv;return(void 0===e.zoom||e.zoom)&&i.push(new Ox(e.zoomOptions)),(void 0===e.rotate||e.rotate)&&i.push(new Cx(e.rotateOptions)),(void 0===e.attribution||e.attribution)&&i.push(new OE(e.attributionOptions)),i}var Px=function(t,e,i){this.decay_=t,this.minVelocity_=e,this.delay_=i,this.points_=[],this.angle_=0,this.initialVelocity_=0};Px.prototype.begin=function(){this.points_.length=0,this.angle_=0,this.initialVelocity_=0},Px.prototype.update=function(t,e){this.points_.push(t,e,Date.now())},Px.prototype.end=function(){if(this.points_.length<6)return!1;var t=Date.now()-this.delay_,e=this.points_.length-3;if(this.points_[e+2]<t)return!1;for(var i=e-3;i>0&&this.points_[i+2]>t;)i-=3;var n=this.points_[e+2]-this.points_[i+2];if(n<1e3/60)return!1;var r=this.points_[e]-this.points_[i],o=this.points_[e+1]-this.points_[i+1];return this.angle_=Math.atan2(o,r),this.initialVelocity_=Math.sqrt(r*r+o*o)/n,this.initialVelocity_>this.minVelocity_},Px.prototype.getDistance=function(){return(this.minVelocity_-this.initialVelocity_)/this.decay_},Px.prototype.getAngle=function(){return this.angle_};var Fx=function(t){function e(e){t.call(this,{handleEvent:Lx});var i=e||{};this.delta_=i.delta?i.delta:1,this.duration_=void 0!==i.duration?i.duration:250}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(rg);function Lx(t){var e=!1,i=t.originalEvent;if(t.type==f_.DBLCLICK){var n=t.map,r=t.coordinate,o=i.shiftKey?-this.delta_:this.delta_;hg(n.getView(),o,r,this.duration_),t.preventDefault(),e=!0}return!e}var Mx=function(t){function e(e){t.call(this,{handleDownEvent:kx,handleDragEvent:Ax,handleUpEvent:Nx,stopDown:Er});var i=e||{};this.kinetic_=i.kinetic,this.lastCentroid=null,this.lastPointersCount_,this.panning_=!1,this.condition_=i.condition?i.condition:S_,this.noKinetic_=!1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(X_);function Ax(t){this.panning_||(this.panning_=!0,this.getMap().getView().setHint(vx.INTERACTING,1));var e=this.targetPointers,i=U_(e);if(e.length==this.lastPointersCount_){if(this.kinetic_&&this.kinetic_.update(i[0],i[1]),this.lastCentroid){var n=this.lastCentroid[0]-i[0],r=i[1]-this.lastCentroid[1],o=t.map.getView(),s=[n,r];Ov(s,o.getResolution()),Rv(s,o.getRotation()),Tv(s,o.getCenter()),s=o.constrainCenter(s),o.setCenter(s)}}else this.kinetic_&&this.kinetic_.begin();this.lastCentroid=i,this.lastPointersCount_=e.length}function Nx(t){var e=t.map,i=e.getView();if(0===this.targetPointers.length){if(!this.noKinetic_&&this.kinetic_&&this.kinetic_.end()){var n=this.kinetic_.getDistance(),r=this.kinetic_.getAngle(),o=i.getCenter(),s=e.getPixelFromCoordinate(o),a=e.getCoordinateFromPixel([s[0]-n*Math.cos(r),s[1]-n*Math.sin(r)]);i.animate({center:i.constrainCenter(a),duration:500,easing:ar})}return this.panning_&&(this.panning_=!1,i.setHint(vx.INTERACTING,-1)),!1}return this.kinetic_&&this.kinetic_.begin(),this.lastCentroid=null,!0}function kx(t){if(this.targetPointers.length>0&&this.condition_(t)){var e=t.map.getView();return this.lastCentroid=null,e.getAnimating()&&e.setCenter(t.frameState.viewState.center),this.kinetic_&&this.kinetic_.begin(),this.noKinetic_=this.targetPointers.length>1,!0}return!1}var jx=function(t){function e(e){var i=e||{};t.call(this,{handleDownEvent:$x,handleDragEvent:Gx,handleUpEvent:Dx,stopDown:Er}),this.condition_=i.condition?i.condition:g_,this.lastAngle_=void 0,this.duration_=void 0!==i.duration?i.duration:250}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(X_);function Gx(t){if(b_(t)){var e=t.map,i=e.getView();if(i.getConstraints().rotation!==gx){var n=e.getSize(),r=t.pixel,o=Math.atan2(n[1]/2-r[1],r[0]-n[0]/2);if(void 0!==this.lastAngle_){var s=o-this.lastAngle_;sg(i,i.getRotation()-s)}this.lastAngle_=o}}}function Dx(t){if(!b_(t))return!0;var e=t.map.getView();return e.setHint(vx.INTERACTING,-1),og(e,e.getRotation(),void 0,this.duration_),!1}function $x(t){return!!b_(t)&&(!(!__(t)||!this.condition_(t))&&(t.map.getView().setHint(vx.INTERACTING,1),this.lastAngle_=void 0,!0))}var Vx=function(t){function e(e){t.call(this),this.geometry_=null,this.element_=document.createElement("div"),this.element_.style.position="absolute",this.element_.className="ol-box "+e,this.map_=null,this.startPixel_=null,this.endPixel_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.setMap(null)},e.prototype.render_=function(){var t=this.startPixel_,e=this.endPixel_,i=this.element_.style;i.left=Math.min(t[0],e[0])+"px",i.top=Math.min(t[1],e[1])+"px",i.width=Math.abs(e[0]-t[0])+"px",i.height=Math.abs(e[1]-t[1])+"px"},e.prototype.setMap=function(t){if(this.map_){this.map_.getOverlayContainer().removeChild(this.element_);var e=this.element_.style;e.left=e.top=e.width=e.height="inherit"}this.map_=t,this.map_&&this.map_.getOverlayContainer().appendChild(this.element_)},e.prototype.setPixels=function(t,e){this.startPixel_=t,this.endPixel_=e,this.createOrUpdateGeometry(),this.render_()},e.prototype.createOrUpdateGeometry=function(){var t=this.startPixel_,e=this.endPixel_,i=[t,[t[0],e[1]],e,[e[0],t[1]]].map(this.map_.getCoordinateFromPixel,this.map_);i[4]=i[0].slice(),this.geometry_?this.geometry_.setCoordinates([i]):this.geometry_=new Yu([i])},e.prototype.getGeometry=function(){return this.geometry_},e}(lr),zx={BOXSTART:"boxstart",BOXDRAG:"boxdrag",BOXEND:"boxend"},Yx=function(t){function e(e,i,n){t.call(this,e),this.coordinate=i,this.mapBrowserEvent=n}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr);function Xx(t,e,i){var n=i[0]-e[0],r=i[1]-e[1];return n*n+r*r>=this.minArea_}function Ux(t){b_(t)&&(this.box_.setPixels(this.startPixel_,t.pixel),this.dispatchEvent(new Yx(zx.BOXDRAG,t.coordinate,t)))}function Bx(t){return!b_(t)||(this.box_.setMap(null),this.boxEndCondition_(t,this.startPixel_,t.pixel)&&(this.onBoxEnd_(t),this.dispatchEvent(new Yx(zx.BOXEND,t.coordinate,t))),!1)}function Kx(t){return!!b_(t)&&(!(!__(t)||!this.condition_(t))&&(this.startPixel_=t.pixel,this.box_.setMap(t.map),this.box_.setPixels(this.startPixel_,this.startPixel_),this.dispatchEvent(new Yx(zx.BOXSTART,t.coordinate,t)),!0))}var Wx=function(t){function e(e){var i=e||{},n=i.condition?i.condition:T_;t.call(this,{condition:n,className:i.className||"ol-dragzoom",onBoxEnd:Hx}),this.duration_=void 0!==i.duration?i.duration:200,this.out_=void 0!==i.out&&i.out}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(function(t){function e(e){t.call(this,{handleDownEvent:Kx,handleDragEvent:Ux,handleUpEvent:Bx});var i=e||{};this.box_=new Vx(i.className||"ol-dragbox"),this.minArea_=void 0!==i.minArea?i.minArea:64,this.onBoxEnd_=i.onBoxEnd?i.onBoxEnd:xr,this.startPixel_=null,this.condition_=i.condition?i.condition:v_,this.boxEndCondition_=i.boxEndCondition?i.boxEndCondition:Xx}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getGeometry=function(){return this.box_.getGeometry()},e}(X_));function Hx(){var t=this.getMap(),e=t.getView(),i=t.getSize(),n=this.getGeometry().getExtent();if(this.out_){var r=e.calculateExtent(i),o=xn([t.getPixelFromCoordinate(Pn(n)),t.getPixelFromCoordinate(jn(n))]);Vn(r,1/e.getResolutionForExtent(o,i)),n=r}var s=e.constrainResolution(e.getResolutionForExtent(n,i)),a=Ln(n);a=e.constrainCenter(a),e.animate({resolution:s,center:a,duration:this.duration_,easing:ar})}var Zx={LEFT:37,UP:38,RIGHT:39,DOWN:40},qx=function(t){function e(e){t.call(this,{handleEvent:Jx});var i=e||{};this.defaultCondition_=function(t){return S_(t)&&w_(t)},this.condition_=void 0!==i.condition?i.condition:this.defaultCondition_,this.duration_=void 0!==i.duration?i.duration:100,this.pixelDelta_=void 0!==i.pixelDelta?i.pixelDelta:128}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(rg);function Jx(t){var e=!1;if(t.type==br.KEYDOWN){var i=t.originalEvent.keyCode;if(this.condition_(t)&&(i==Zx.DOWN||i==Zx.LEFT||i==Zx.RIGHT||i==Zx.UP)){var n=t.map.getView(),r=n.getResolution()*this.pixelDelta_,o=0,s=0;i==Zx.DOWN?s=-r:i==Zx.LEFT?o=-r:i==Zx.RIGHT?o=r:s=r;var a=[o,s];Rv(a,n.getRotation()),function(t,e,i){var n=t.getCenter();if(n){var r=t.constrainCenter([n[0]+e[0],n[1]+e[1]]);i?t.animate({duration:i,easing:ur,center:r}):t.setCenter(r)}}(n,a,this.duration_),t.preventDefault(),e=!0}}return!e}var Qx=function(t){function e(e){t.call(this,{handleEvent:tS});var i=e||{};this.condition_=i.condition?i.condition:w_,this.delta_=i.delta?i.delta:1,this.duration_=void 0!==i.duration?i.duration:100}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(rg);function tS(t){var e=!1;if(t.type==br.KEYDOWN||t.type==br.KEYPRESS){var i=t.originalEvent.charCode;if(this.condition_(t)&&(i=="+".charCodeAt(0)||i=="-".charCodeAt(0))){var n=t.map,r=i=="+".charCodeAt(0)?this.delta_:-this.delta_;hg(n.getView(),r,void 0,this.duration_),t.preventDefault(),e=!0}}return!e}var eS={TRACKPAD:"trackpad",WHEEL:"wheel"},iS=function(t){function e(e){t.call(this,{handleEvent:nS});var i=e||{};this.delta_=0,this.duration_=void 0!==i.duration?i.duration:250,this.timeout_=void 0!==i.timeout?i.timeout:80,this.useAnchor_=void 0===i.useAnchor||i.useAnchor,this.constrainResolution_=i.constrainResolution||!1,this.condition_=i.condition?i.condition:v_,this.lastAnchor_=null,this.startTime_=void 0,this.timeoutId_=void 0,this.mode_=void 0,this.trackpadEventGap_=400,this.trackpadTimeoutId_=void 0,this.trackpadDeltaPerZoom_=300,this.trackpadZoomBuffer_=1.5}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.decrementInteractingHint_=function(){this.trackpadTimeoutId_=void 0,this.getMap().getView().setHint(vx.INTERACTING,-1)},e.prototype.handleWheelZoom_=function(t){var e=t.getView();e.getAnimating()&&e.cancelAnimations();hg(e,-Yn(this.delta_,-1,1),this.lastAnchor_,this.duration_),this.mode_=void 0,this.delta_=0,this.lastAnchor_=null,this.startTime_=void 0,this.timeoutId_=void 0},e.prototype.setMouseAnchor=function(t){this.useAnchor_=t,t||(this.lastAnchor_=null)},e}(rg);function nS(t){if(!this.condition_(t))return!0;var e=t.type;if(e!==br.WHEEL&&e!==br.MOUSEWHEEL)return!0;t.preventDefault();var i,n=t.map,r=t.originalEvent;if(this.useAnchor_&&(this.lastAnchor_=t.coordinate),t.type==br.WHEEL?(i=r.deltaY,Vs&&r.deltaMode===WheelEvent.DOM_DELTA_PIXEL&&(i/=Us),r.deltaMode===WheelEvent.DOM_DELTA_LINE&&(i*=40)):t.type==br.MOUSEWHEEL&&(i=-r.wheelDeltaY,zs&&(i/=3)),0===i)return!1;var o=Date.now();if(void 0===this.startTime_&&(this.startTime_=o),(!this.mode_||o-this.startTime_>this.trackpadEventGap_)&&(this.mode_=Math.abs(i)<4?eS.TRACKPAD:eS.WHEEL),this.mode_===eS.TRACKPAD){var s=n.getView();this.trackpadTimeoutId_?clearTimeout(this.trackpadTimeoutId_):s.setHint(vx.INTERACTING,1),this.trackpadTimeoutId_=setTimeout(this.decrementInteractingHint_.bind(this),this.trackpadEventGap_);var a=s.getResolution()*Math.pow(2,i/this.trackpadDeltaPerZoom_),h=s.getMinResolution(),u=s.getMaxResolution(),l=0;if(a<h?(a=Math.max(a,h/this.trackpadZoomBuffer_),l=1):a>u&&(a=Math.min(a,u*this.trackpadZoomBuffer_),l=-1),this.lastAnchor_){var c=s.calculateCenterZoom(a,this.lastAnchor_);s.setCenter(s.constrainCenter(c))}return s.setResolution(a),0===l&&this.constrainResolution_&&s.animate({resolution:s.constrainResolution(a,i>0?-1:1),easing:ar,anchor:this.lastAnchor_,duration:this.duration_}),l>0?s.animate({resolution:h,easing:ar,anchor:this.lastAnchor_,duration:500}):l<0&&s.animate({resolution:u,easing:ar,anchor:this.lastAnchor_,duration:500}),this.startTime_=o,!1}this.delta_+=i;var p=Math.max(this.timeout_-(o-this.startTime_),0);return clearTimeout(this.timeoutId_),this.timeoutId_=setTimeout(this.handleWheelZoom_.bind(this,n),p),!1}var rS=function(t){function e(e){t.call(this,{handleDownEvent:aS,handleDragEvent:oS,handleUpEvent:sS,stopDown:Er});var i=e||{};this.anchor_=null,this.lastAngle_=void 0,this.rotating_=!1,this.rotationDelta_=0,this.threshold_=void 0!==i.threshold?i.threshold:.3,this.duration_=void 0!==i.duration?i.duration:250}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(X_);function oS(t){var e=0,i=this.targetPointers[0],n=this.targetPointers[1],r=Math.atan2(n.clientY-i.clientY,n.clientX-i.clientX);if(void 0!==this.lastAngle_){var o=r-this.lastAngle_;this.rotationDelta_+=o,!this.rotating_&&Math.abs(this.rotationDelta_)>this.threshold_&&(this.rotating_=!0),e=o}this.lastAngle_=r;var s=t.map,a=s.getView();if(a.getConstraints().rotation!==gx){var h=s.getViewport().getBoundingClientRect(),u=U_(this.targetPointers);if(u[0]-=h.left,u[1]-=h.top,this.anchor_=s.getCoordinateFromPixel(u),this.rotating_){var l=a.getRotation();s.render(),sg(a,l+e,this.anchor_)}}}function sS(t){if(this.targetPointers.length<2){var e=t.map.getView();if(e.setHint(vx.INTERACTING,-1),this.rotating_)og(e,e.getRotation(),this.anchor_,this.duration_);return!1}return!0}function aS(t){if(this.targetPointers.length>=2){var e=t.map;return this.anchor_=null,this.lastAngle_=void 0,this.rotating_=!1,this.rotationDelta_=0,this.handlingDownUpSequence||e.getView().setHint(vx.INTERACTING,1),!0}return!1}var hS=function(t){function e(e){t.call(this,{handleDownEvent:cS,handleDragEvent:uS,handleUpEvent:lS,stopDown:Er});var i=e||{};this.constrainResolution_=i.constrainResolution||!1,this.anchor_=null,this.duration_=void 0!==i.duration?i.duration:400,this.lastDistance_=void 0,this.lastScaleDelta_=1}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(X_);function uS(t){var e=1,i=this.targetPointers[0],n=this.targetPointers[1],r=i.clientX-n.clientX,o=i.clientY-n.clientY,s=Math.sqrt(r*r+o*o);void 0!==this.lastDistance_&&(e=this.lastDistance_/s),this.lastDistance_=s;var a=t.map,h=a.getView(),u=h.getResolution(),l=h.getMaxResolution(),c=h.getMinResolution(),p=u*e;p>l?(e=l/u,p=l):p<c&&(e=c/u,p=c),1!=e&&(this.lastScaleDelta_=e);var f=a.getViewport().getBoundingClientRect(),d=U_(this.targetPointers);d[0]-=f.left,d[1]-=f.top,this.anchor_=a.getCoordinateFromPixel(d),a.render(),ug(h,p,this.anchor_)}function lS(t){if(this.targetPointers.length<2){var e=t.map.getView();e.setHint(vx.INTERACTING,-1);var i=e.getResolution();if(this.constrainResolution_||i<e.getMinResolution()||i>e.getMaxResolution()){var n=this.lastScaleDelta_-1;ag(e,i,this.anchor_,this.duration_,n)}return!1}return!0}function cS(t){if(this.targetPointers.length>=2){var e=t.map;return this.anchor_=null,this.lastDistance_=void 0,this.lastScaleDelta_=1,this.handlingDownUpSequence||e.getView().setHint(vx.INTERACTING,1),!0}return!1}var pS="addfeatures",fS=function(t){function e(e,i,n,r){t.call(this,e),this.features=n,this.file=i,this.projection=r}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr);!function(t){function e(e){var i=e||{};t.call(this,{handleEvent:mr}),this.formatConstructors_=i.formatConstructors?i.formatConstructors:[],this.projection_=i.projection?fo(i.projection):null,this.dropListenKeys_=null,this.source_=i.source||null,this.target=i.target?i.target:null}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.handleResult_=function(t,e){var i=e.target.result,n=this.getMap(),r=this.projection_;r||(r=n.getView().getProjection());for(var o=this.formatConstructors_,s=[],a=0,h=o.length;a<h;++a){var u=new(0,o[a]);if((s=this.tryReadFeatures_(u,i,{featureProjection:r}))&&s.length>0)break}this.source_&&(this.source_.clear(),this.source_.addFeatures(s)),this.dispatchEvent(new fS(pS,t,s,r))},e.prototype.registerListeners_=function(){var t=this.getMap();if(t){var e=this.target?this.target:t.getViewport();this.dropListenKeys_=[gr(e,br.DROP,dS,this),gr(e,br.DRAGENTER,gS,this),gr(e,br.DRAGOVER,gS,this),gr(e,br.DROP,gS,this)]}},e.prototype.setActive=function(e){t.prototype.setActive.call(this,e),e?this.registerListeners_():this.unregisterListeners_()},e.prototype.setMap=function(e){this.unregisterListeners_(),t.prototype.setMap.call(this,e),this.getActive()&&this.registerListeners_()},e.prototype.tryReadFeatures_=function(t,e,i){try{return t.readFeatures(e,i)}catch(t){return null}},e.prototype.unregisterListeners_=function(){this.dropListenKeys_&&(this.dropListenKeys_.forEach(_r),this.dropListenKeys_=null)}}(rg);function dS(t){for(var e=t.dataTransfer.files,i=0,n=e.length;i<n;++i){var r=e.item(i),o=new FileReader;o.addEventListener(br.LOAD,this.handleResult_.bind(this,r)),o.readAsText(r)}}function gS(t){t.stopPropagation(),t.preventDefault(),t.dataTransfer.dropEffect="copy"}!function(t){function e(e){var i=e||{};t.call(this,{handleDownEvent:_S,handleDragEvent:yS,handleUpEvent:vS}),this.condition_=i.condition?i.condition:T_,this.lastAngle_=void 0,this.lastMagnitude_=void 0,this.lastScaleDelta_=0,this.duration_=void 0!==i.duration?i.duration:400}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e}(X_);function yS(t){if(b_(t)){var e=t.map,i=e.getSize(),n=t.pixel,r=n[0]-i[0]/2,o=i[1]/2-n[1],s=Math.atan2(o,r),a=Math.sqrt(r*r+o*o),h=e.getView();if(h.getConstraints().rotation!==gx&&void 0!==this.lastAngle_){var u=s-this.lastAngle_;sg(h,h.getRotation()-u)}if(this.lastAngle_=s,void 0!==this.lastMagnitude_)ug(h,this.lastMagnitude_*(h.getResolution()/a));void 0!==this.lastMagnitude_&&(this.lastScaleDelta_=this.lastMagnitude_/a),this.lastMagnitude_=a}}function vS(t){if(!b_(t))return!0;var e=t.map.getView();e.setHint(vx.INTERACTING,-1);var i=this.lastScaleDelta_-1;return og(e,e.getRotation()),ag(e,e.getResolution(),void 0,this.duration_,i),this.lastScaleDelta_=0,!1}function _S(t){return!!b_(t)&&(!!this.condition_(t)&&(t.map.getView().setHint(vx.INTERACTING,1),this.lastAngle_=void 0,this.lastMagnitude_=void 0,!0))}var mS={EXTENTCHANGED:"extentchanged"},ES=function(t){function e(e){t.call(this,mS.EXTENTCHANGED),this.extent=e}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr);!function(t){function e(e){t.call(this,{handleDownEvent:SS,handleDragEvent:TS,handleEvent:xS,handleUpEvent:wS});var i,n=e||{};this.extent_=null,this.pointerHandler_=null,this.pixelTolerance_=void 0!==n.pixelTolerance?n.pixelTolerance:10,this.snappedToVertex_=!1,this.extentFeature_=null,this.vertexFeature_=null,e||(e={}),this.extentOverlay_=new nm({source:new i_({useSpatialIndex:!1,wrapX:!!e.wrapX}),style:e.boxStyle?e.boxStyle:(i=Pa(),function(t,e){return i[Dr.POLYGON]}),updateWhileAnimating:!0,updateWhileInteracting:!0}),this.vertexOverlay_=new nm({source:new i_({useSpatialIndex:!1,wrapX:!!e.wrapX}),style:e.pointerStyle?e.pointerStyle:function(){var t=Pa();return function(e,i){return t[Dr.POINT]}}(),updateWhileAnimating:!0,updateWhileInteracting:!0}),e.extent&&this.setExtent(e.extent)}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.snapToVertex_=function(t,e){var i=e.getCoordinateFromPixel(t),n=this.getExtent();if(n){var r=function(t){return[[[t[0],t[1]],[t[0],t[3]]],[[t[0],t[3]],[t[2],t[3]]],[[t[2],t[3]],[t[2],t[1]]],[[t[2],t[1]],[t[0],t[1]]]]}(n);r.sort(function(t,e){return Fv(i,t)-Fv(i,e)});var o=r[0],s=wv(i,o),a=e.getPixelFromCoordinate(s);if(Pv(t,a)<=this.pixelTolerance_){var h=e.getPixelFromCoordinate(o[0]),u=e.getPixelFromCoordinate(o[1]),l=Iv(a,h),c=Iv(a,u),p=Math.sqrt(Math.min(l,c));return this.snappedToVertex_=p<=this.pixelTolerance_,this.snappedToVertex_&&(s=l>c?o[1]:o[0]),s}}return null},e.prototype.handlePointerMove_=function(t){var e=t.pixel,i=t.map,n=this.snapToVertex_(e,i);n||(n=i.getCoordinateFromPixel(e)),this.createOrUpdatePointerFeature_(n)},e.prototype.createOrUpdateExtentFeature_=function(t){var e=this.extentFeature_;return e?t?e.setGeometry(Uu(t)):e.setGeometry(void 0):(e=new La(t?Uu(t):{}),this.extentFeature_=e,this.extentOverlay_.getSource().addFeature(e)),e},e.prototype.createOrUpdatePointerFeature_=function(t){var e=this.vertexFeature_;e?e.getGeometry().setCoordinates(t):(e=new La(new Fu(t)),this.vertexFeature_=e,this.vertexOverlay_.getSource().addFeature(e));return e},e.prototype.setMap=function(e){this.extentOverlay_.setMap(e),this.vertexOverlay_.setMap(e),t.prototype.setMap.call(this,e)},e.prototype.getExtent=function(){return this.extent_},e.prototype.setExtent=function(t){this.extent_=t||null,this.createOrUpdateExtentFeature_(t),this.dispatchEvent(new ES(this.extent_))}}(X_);function xS(t){return!(t instanceof I_)||(t.type!=f_.POINTERMOVE||this.handlingDownUpSequence||this.handlePointerMove_(t),B_.call(this,t),!1)}function SS(t){var e=t.pixel,i=t.map,n=this.getExtent(),r=this.snapToVertex_(e,i),o=function(t){var e=null,i=null;return t[0]==n[0]?e=n[2]:t[0]==n[2]&&(e=n[0]),t[1]==n[1]?i=n[3]:t[1]==n[3]&&(i=n[1]),null!==e&&null!==i?[e,i]:null};if(r&&n){var s=r[0]==n[0]||r[0]==n[2]?r[0]:null,a=r[1]==n[1]||r[1]==n[3]?r[1]:null;null!==s&&null!==a?this.pointerHandler_=bS(o(r)):null!==s?this.pointerHandler_=CS(o([s,n[1]]),o([s,n[3]])):null!==a&&(this.pointerHandler_=CS(o([n[0],a]),o([n[2],a])))}else r=i.getCoordinateFromPixel(e),this.setExtent([r[0],r[1],r[0],r[1]]),this.pointerHandler_=bS(r);return!0}function TS(t){if(this.pointerHandler_){var e=t.coordinate;this.setExtent(this.pointerHandler_(e)),this.createOrUpdatePointerFeature_(e)}return!0}function wS(t){this.pointerHandler_=null;var e=this.getExtent();return e&&0!==In(e)||this.setExtent(null),!1}function bS(t){return function(e){return un([t,e])}}function CS(t,e){return t[0]==e[0]?function(i){return un([t,[i[0],e[1]]])}:t[1]==e[1]?function(i){return un([t,[e[0],i[1]]])}:null}var RS=0,OS=1,IS={MODIFYSTART:"modifystart",MODIFYEND:"modifyend"},PS=function(t){function e(e,i,n){t.call(this,e),this.features=i,this.mapBrowserEvent=n}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),FS=function(t){function e(e){var i,n;if(t.call(this,{handleDownEvent:MS,handleDragEvent:AS,handleEvent:kS,handleUpEvent:NS}),this.condition_=e.condition?e.condition:C_,this.defaultDeleteCondition_=function(t){return d_(t)&&x_(t)},this.deleteCondition_=e.deleteCondition?e.deleteCondition:this.defaultDeleteCondition_,this.insertVertexCondition_=e.insertVertexCondition?e.insertVertexCondition:v_,this.vertexFeature_=null,this.vertexSegments_=null,this.lastPixel_=[0,0],this.ignoreNextSingleClick_=!1,this.modified_=!1,this.rBush_=new t_,this.pixelTolerance_=void 0!==e.pixelTolerance?e.pixelTolerance:10,this.snappedToVertex_=!1,this.changingFeature_=!1,this.dragSegments_=[],this.overlay_=new nm({source:new i_({useSpatialIndex:!1,wrapX:!!e.wrapX}),style:e.style?e.style:(i=Pa(),function(t,e){return i[Dr.POINT]}),updateWhileAnimating:!0,updateWhileInteracting:!0}),this.SEGMENT_WRITERS_={Point:this.writePointGeometry_,LineString:this.writeLineStringGeometry_,LinearRing:this.writeLineStringGeometry_,Polygon:this.writePolygonGeometry_,MultiPoint:this.writeMultiPointGeometry_,MultiLineString:this.writeMultiLineStringGeometry_,MultiPolygon:this.writeMultiPolygonGeometry_,Circle:this.writeCircleGeometry_,GeometryCollection:this.writeGeometryCollectionGeometry_},this.source_=null,e.source?(this.source_=e.source,n=new Nv(this.source_.getFeatures()),gr(this.source_,jv.ADDFEATURE,this.handleSourceAdd_,this),gr(this.source_,jv.REMOVEFEATURE,this.handleSourceRemove_,this)):n=e.features,!n)throw new Error("The modify interaction requires features or a source");this.features_=n,this.features_.forEach(this.addFeature_.bind(this)),gr(this.features_,Lv.ADD,this.handleFeatureAdd_,this),gr(this.features_,Lv.REMOVE,this.handleFeatureRemove_,this),this.lastPointerEvent_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.addFeature_=function(t){var e=t.getGeometry();e&&e.getType()in this.SEGMENT_WRITERS_&&this.SEGMENT_WRITERS_[e.getType()].call(this,t,e);var i=this.getMap();i&&i.isRendered()&&this.getActive()&&this.handlePointerAtPixel_(this.lastPixel_,i),gr(t,br.CHANGE,this.handleFeatureChange_,this)},e.prototype.willModifyFeatures_=function(t){this.modified_||(this.modified_=!0,this.dispatchEvent(new PS(IS.MODIFYSTART,this.features_,t)))},e.prototype.removeFeature_=function(t){this.removeFeatureSegmentData_(t),this.vertexFeature_&&0===this.features_.getLength()&&(this.overlay_.getSource().removeFeature(this.vertexFeature_),this.vertexFeature_=null),vr(t,br.CHANGE,this.handleFeatureChange_,this)},e.prototype.removeFeatureSegmentData_=function(t){var e=this.rBush_,i=[];e.forEach(function(e){t===e.feature&&i.push(e)});for(var n=i.length-1;n>=0;--n)e.remove(i[n])},e.prototype.setActive=function(e){this.vertexFeature_&&!e&&(this.overlay_.getSource().removeFeature(this.vertexFeature_),this.vertexFeature_=null),t.prototype.setActive.call(this,e)},e.prototype.setMap=function(e){this.overlay_.setMap(e),t.prototype.setMap.call(this,e)},e.prototype.getOverlay=function(){return this.overlay_},e.prototype.handleSourceAdd_=function(t){t.feature&&this.features_.push(t.feature)},e.prototype.handleSourceRemove_=function(t){t.feature&&this.features_.remove(t.feature)},e.prototype.handleFeatureAdd_=function(t){this.addFeature_(t.element)},e.prototype.handleFeatureChange_=function(t){if(!this.changingFeature_){var e=t.target;this.removeFeature_(e),this.addFeature_(e)}},e.prototype.handleFeatureRemove_=function(t){var e=t.element;this.removeFeature_(e)},e.prototype.writePointGeometry_=function(t,e){var i=e.getCoordinates(),n={feature:t,geometry:e,segment:[i,i]};this.rBush_.insert(e.getExtent(),n)},e.prototype.writeMultiPointGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n){var o=i[n],s={feature:t,geometry:e,depth:[n],index:n,segment:[o,o]};this.rBush_.insert(e.getExtent(),s)}},e.prototype.writeLineStringGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length-1;n<r;++n){var o=i.slice(n,n+2),s={feature:t,geometry:e,index:n,segment:o};this.rBush_.insert(un(o),s)}},e.prototype.writeMultiLineStringGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n)for(var o=i[n],s=0,a=o.length-1;s<a;++s){var h=o.slice(s,s+2),u={feature:t,geometry:e,depth:[n],index:s,segment:h};this.rBush_.insert(un(h),u)}},e.prototype.writePolygonGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n)for(var o=i[n],s=0,a=o.length-1;s<a;++s){var h=o.slice(s,s+2),u={feature:t,geometry:e,depth:[n],index:s,segment:h};this.rBush_.insert(un(h),u)}},e.prototype.writeMultiPolygonGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n)for(var o=i[n],s=0,a=o.length;s<a;++s)for(var h=o[s],u=0,l=h.length-1;u<l;++u){var c=h.slice(u,u+2),p={feature:t,geometry:e,depth:[s,n],index:u,segment:c};this.rBush_.insert(un(c),p)}},e.prototype.writeCircleGeometry_=function(t,e){var i=e.getCenter(),n={feature:t,geometry:e,index:RS,segment:[i,i]},r={feature:t,geometry:e,index:OS,segment:[i,i]},o=[n,r];n.featureSegments=r.featureSegments=o,this.rBush_.insert(En(i),n),this.rBush_.insert(e.getExtent(),r)},e.prototype.writeGeometryCollectionGeometry_=function(t,e){for(var i=e.getGeometriesArray(),n=0;n<i.length;++n)this.SEGMENT_WRITERS_[i[n].getType()].call(this,t,i[n])},e.prototype.createOrUpdateVertexFeature_=function(t){var e=this.vertexFeature_;e?e.getGeometry().setCoordinates(t):(e=new La(new Fu(t)),this.vertexFeature_=e,this.overlay_.getSource().addFeature(e));return e},e.prototype.handlePointerMove_=function(t){this.lastPixel_=t.pixel,this.handlePointerAtPixel_(t.pixel,t.map)},e.prototype.handlePointerAtPixel_=function(t,e){var i=e.getCoordinateFromPixel(t),n=ln(En(i),e.getView().getResolution()*this.pixelTolerance_),r=this.rBush_.getInExtent(n);if(r.length>0){r.sort(function(t,e){return jS(i,t)-jS(i,e)});var o=r[0],s=o.segment,a=GS(i,o),h=e.getPixelFromCoordinate(a),u=Pv(t,h);if(u<=this.pixelTolerance_){var l={};if(o.geometry.getType()===Dr.CIRCLE&&o.index===OS)this.snappedToVertex_=!0,this.createOrUpdateVertexFeature_(a);else{var c=e.getPixelFromCoordinate(s[0]),p=e.getPixelFromCoordinate(s[1]),f=Iv(h,c),d=Iv(h,p);u=Math.sqrt(Math.min(f,d)),this.snappedToVertex_=u<=this.pixelTolerance_,this.snappedToVertex_&&(a=f>d?s[1]:s[0]),this.createOrUpdateVertexFeature_(a);for(var g=1,y=r.length;g<y;++g){var v=r[g].segment;if(!(Cv(s[0],v[0])&&Cv(s[1],v[1])||Cv(s[0],v[1])&&Cv(s[1],v[0])))break;l[nn(v)]=!0}}return l[nn(s)]=!0,void(this.vertexSegments_=l)}}this.vertexFeature_&&(this.overlay_.getSource().removeFeature(this.vertexFeature_),this.vertexFeature_=null)},e.prototype.insertVertex_=function(t,e){for(var i,n=t.segment,r=t.feature,o=t.geometry,s=t.depth,a=t.index;e.length<o.getStride();)e.push(0);switch(o.getType()){case Dr.MULTI_LINE_STRING:case Dr.POLYGON:(i=o.getCoordinates())[s[0]].splice(a+1,0,e);break;case Dr.MULTI_POLYGON:(i=o.getCoordinates())[s[1]][s[0]].splice(a+1,0,e);break;case Dr.LINE_STRING:(i=o.getCoordinates()).splice(a+1,0,e);break;default:return}this.setGeometryCoordinates_(o,i);var h=this.rBush_;h.remove(t),this.updateSegmentIndices_(o,a,s,1);var u={segment:[n[0],e],feature:r,geometry:o,depth:s,index:a};h.insert(un(u.segment),u),this.dragSegments_.push([u,1]);var l={segment:[e,n[1]],feature:r,geometry:o,depth:s,index:a+1};h.insert(un(l.segment),l),this.dragSegments_.push([l,0]),this.ignoreNextSingleClick_=!0},e.prototype.removePoint=function(){if(this.lastPointerEvent_&&this.lastPointerEvent_.type!=f_.POINTERDRAG){var t=this.lastPointerEvent_;return this.willModifyFeatures_(t),this.removeVertex_(),this.dispatchEvent(new PS(IS.MODIFYEND,this.features_,t)),this.modified_=!1,!0}return!1},e.prototype.removeVertex_=function(){var t,e,i,n,r,o,s,a,h,u,l,c=this.dragSegments_,p={},f=!1;for(r=c.length-1;r>=0;--r)l=nn((u=(i=c[r])[0]).feature),u.depth&&(l+="-"+u.depth.join("-")),l in p||(p[l]={}),0===i[1]?(p[l].right=u,p[l].index=u.index):1==i[1]&&(p[l].left=u,p[l].index=u.index+1);for(l in p){switch(h=p[l].right,s=p[l].left,a=(o=p[l].index)-1,u=void 0!==s?s:h,a<0&&(a=0),t=e=(n=u.geometry).getCoordinates(),f=!1,n.getType()){case Dr.MULTI_LINE_STRING:e[u.depth[0]].length>2&&(e[u.depth[0]].splice(o,1),f=!0);break;case Dr.LINE_STRING:e.length>2&&(e.splice(o,1),f=!0);break;case Dr.MULTI_POLYGON:t=t[u.depth[1]];case Dr.POLYGON:(t=t[u.depth[0]]).length>4&&(o==t.length-1&&(o=0),t.splice(o,1),f=!0,0===o&&(t.pop(),t.push(t[0]),a=t.length-1))}if(f){this.setGeometryCoordinates_(n,e);var d=[];if(void 0!==s&&(this.rBush_.remove(s),d.push(s.segment[0])),void 0!==h&&(this.rBush_.remove(h),d.push(h.segment[1])),void 0!==s&&void 0!==h){var g={depth:u.depth,feature:u.feature,geometry:u.geometry,index:a,segment:d};this.rBush_.insert(un(g.segment),g)}this.updateSegmentIndices_(n,o,u.depth,-1),this.vertexFeature_&&(this.overlay_.getSource().removeFeature(this.vertexFeature_),this.vertexFeature_=null),c.length=0}}return f},e.prototype.setGeometryCoordinates_=function(t,e){this.changingFeature_=!0,t.setCoordinates(e),this.changingFeature_=!1},e.prototype.updateSegmentIndices_=function(t,e,i,n){this.rBush_.forEachInExtent(t.getExtent(),function(r){r.geometry===t&&(void 0===i||void 0===r.depth||Zo(r.depth,i))&&r.index>e&&(r.index+=n)})},e}(X_);function LS(t,e){return t.index-e.index}function MS(t){if(!this.condition_(t))return!1;this.handlePointerAtPixel_(t.pixel,t.map);var e=t.map.getCoordinateFromPixel(t.pixel);this.dragSegments_.length=0,this.modified_=!1;var i=this.vertexFeature_;if(i){var n=[],r=i.getGeometry().getCoordinates(),o=un([r]),s=this.rBush_.getInExtent(o),a={};s.sort(LS);for(var h=0,u=s.length;h<u;++h){var l=s[h],c=l.segment,p=nn(l.feature),f=l.depth;if(f&&(p+="-"+f.join("-")),a[p]||(a[p]=new Array(2)),l.geometry.getType()===Dr.CIRCLE&&l.index===OS)Cv(GS(e,l),r)&&!a[p][0]&&(this.dragSegments_.push([l,0]),a[p][0]=l);else if(Cv(c[0],r)&&!a[p][0])this.dragSegments_.push([l,0]),a[p][0]=l;else if(Cv(c[1],r)&&!a[p][1]){if((l.geometry.getType()===Dr.LINE_STRING||l.geometry.getType()===Dr.MULTI_LINE_STRING)&&a[p][0]&&0===a[p][0].index)continue;this.dragSegments_.push([l,1]),a[p][1]=l}else this.insertVertexCondition_(t)&&nn(c)in this.vertexSegments_&&!a[p][0]&&!a[p][1]&&n.push([l,r])}n.length&&this.willModifyFeatures_(t);for(var d=n.length-1;d>=0;--d)this.insertVertex_.apply(this,n[d])}return!!this.vertexFeature_}function AS(t){this.ignoreNextSingleClick_=!1,this.willModifyFeatures_(t);for(var e=t.coordinate,i=0,n=this.dragSegments_.length;i<n;++i){for(var r=this.dragSegments_[i],o=r[0],s=o.depth,a=o.geometry,h=void 0,u=o.segment,l=r[1];e.length<a.getStride();)e.push(u[l][e.length]);switch(a.getType()){case Dr.POINT:h=e,u[0]=u[1]=e;break;case Dr.MULTI_POINT:(h=a.getCoordinates())[o.index]=e,u[0]=u[1]=e;break;case Dr.LINE_STRING:(h=a.getCoordinates())[o.index+l]=e,u[l]=e;break;case Dr.MULTI_LINE_STRING:case Dr.POLYGON:(h=a.getCoordinates())[s[0]][o.index+l]=e,u[l]=e;break;case Dr.MULTI_POLYGON:(h=a.getCoordinates())[s[1]][s[0]][o.index+l]=e,u[l]=e;break;case Dr.CIRCLE:u[0]=u[1]=e,o.index===RS?(this.changingFeature_=!0,a.setCenter(e),this.changingFeature_=!1):(this.changingFeature_=!0,a.setRadius(Pv(a.getCenter(),e)),this.changingFeature_=!1)}h&&this.setGeometryCoordinates_(a,h)}this.createOrUpdateVertexFeature_(e)}function NS(t){for(var e=this.dragSegments_.length-1;e>=0;--e){var i=this.dragSegments_[e][0],n=i.geometry;if(n.getType()===Dr.CIRCLE){var r=n.getCenter(),o=i.featureSegments[0],s=i.featureSegments[1];o.segment[0]=o.segment[1]=r,s.segment[0]=s.segment[1]=r,this.rBush_.update(En(r),o),this.rBush_.update(n.getExtent(),s)}else this.rBush_.update(un(i.segment),i)}return this.modified_&&(this.dispatchEvent(new PS(IS.MODIFYEND,this.features_,t)),this.modified_=!1),!1}function kS(t){return!(t instanceof I_)||(this.lastPointerEvent_=t,t.map.getView().getInteracting()||t.type!=f_.POINTERMOVE||this.handlingDownUpSequence||this.handlePointerMove_(t),this.vertexFeature_&&this.deleteCondition_(t)&&(e=!(t.type!=f_.SINGLECLICK||!this.ignoreNextSingleClick_)||this.removePoint()),t.type==f_.SINGLECLICK&&(this.ignoreNextSingleClick_=!1),B_.call(this,t)&&!e);var e}function jS(t,e){var i=e.geometry;if(i.getType()===Dr.CIRCLE){var n=i;if(e.index===OS){var r=Iv(n.getCenter(),t),o=Math.sqrt(r)-n.getRadius();return o*o}}return Fv(t,e.segment)}function GS(t,e){var i=e.geometry;return i.getType()===Dr.CIRCLE&&e.index===OS?i.getClosestPoint(t):wv(t,e.segment)}var DS={SELECT:"select"},$S=function(t){function e(e,i,n,r){t.call(this,e),this.selected=i,this.deselected=n,this.mapBrowserEvent=r}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),VS=function(t){function e(e){t.call(this,{handleEvent:zS});var i=e||{};this.condition_=i.condition?i.condition:x_,this.addCondition_=i.addCondition?i.addCondition:m_,this.removeCondition_=i.removeCondition?i.removeCondition:m_,this.toggleCondition_=i.toggleCondition?i.toggleCondition:T_,this.multi_=!!i.multi&&i.multi,this.filter_=i.filter?i.filter:mr,this.hitTolerance_=i.hitTolerance?i.hitTolerance:0;var n,r,o=new nm({source:new i_({useSpatialIndex:!1,features:i.features,wrapX:i.wrapX}),style:i.style?i.style:(n=Pa(),Ho(n[Dr.POLYGON],n[Dr.LINE_STRING]),Ho(n[Dr.GEOMETRY_COLLECTION],n[Dr.LINE_STRING]),function(t,e){return t.getGeometry()?n[t.getGeometry().getType()]:null}),updateWhileAnimating:!0,updateWhileInteracting:!0});if(this.featureOverlay_=o,i.layers)if("function"==typeof i.layers)r=i.layers;else{var s=i.layers;r=function(t){return Bo(s,t)}}else r=mr;this.layerFilter_=r,this.featureLayerAssociation_={};var a=this.featureOverlay_.getSource().getFeaturesCollection();gr(a,Lv.ADD,this.addFeature_,this),gr(a,Lv.REMOVE,this.removeFeature_,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.addFeatureLayerAssociation_=function(t,e){var i=nn(t);this.featureLayerAssociation_[i]=e},e.prototype.getFeatures=function(){return this.featureOverlay_.getSource().getFeaturesCollection()},e.prototype.getHitTolerance=function(){return this.hitTolerance_},e.prototype.getLayer=function(t){var e=nn(t);return this.featureLayerAssociation_[e]},e.prototype.getOverlay=function(){return this.featureOverlay_},e.prototype.setHitTolerance=function(t){this.hitTolerance_=t},e.prototype.setMap=function(e){var i=this.getMap(),n=this.featureOverlay_.getSource().getFeaturesCollection();i&&n.forEach(i.unskipFeature.bind(i)),t.prototype.setMap.call(this,e),this.featureOverlay_.setMap(e),e&&n.forEach(e.skipFeature.bind(e))},e.prototype.addFeature_=function(t){var e=this.getMap();e&&e.skipFeature(t.element)},e.prototype.removeFeature_=function(t){var e=this.getMap();e&&e.unskipFeature(t.element)},e.prototype.removeFeatureLayerAssociation_=function(t){var e=nn(t);delete this.featureLayerAssociation_[e]},e}(rg);function zS(t){if(!this.condition_(t))return!0;var e=this.addCondition_(t),i=this.removeCondition_(t),n=this.toggleCondition_(t),r=!e&&!i&&!n,o=t.map,s=this.featureOverlay_.getSource().getFeaturesCollection(),a=[],h=[];if(r){Jn(this.featureLayerAssociation_),o.forEachFeatureAtPixel(t.pixel,function(t,e){if(this.filter_(t,e))return h.push(t),this.addFeatureLayerAssociation_(t,e),!this.multi_}.bind(this),{layerFilter:this.layerFilter_,hitTolerance:this.hitTolerance_});for(var u=s.getLength()-1;u>=0;--u){var l=s.item(u),c=h.indexOf(l);c>-1?h.splice(c,1):(s.remove(l),a.push(l))}0!==h.length&&s.extend(h)}else{o.forEachFeatureAtPixel(t.pixel,function(t,r){if(this.filter_(t,r))return!e&&!n||Bo(s.getArray(),t)?(i||n)&&Bo(s.getArray(),t)&&(a.push(t),this.removeFeatureLayerAssociation_(t)):(h.push(t),this.addFeatureLayerAssociation_(t,r)),!this.multi_}.bind(this),{layerFilter:this.layerFilter_,hitTolerance:this.hitTolerance_});for(var p=a.length-1;p>=0;--p)s.remove(a[p]);s.extend(h)}return(h.length>0||a.length>0)&&this.dispatchEvent(new $S(DS.SELECT,h,a,t)),E_(t)}var YS=function(t){function e(e){t.call(this,{handleEvent:XS,handleDownEvent:mr,handleUpEvent:US,stopDown:Er});var i=e||{};this.source_=i.source?i.source:null,this.vertex_=void 0===i.vertex||i.vertex,this.edge_=void 0===i.edge||i.edge,this.features_=i.features?i.features:null,this.featuresListenerKeys_=[],this.featureChangeListenerKeys_={},this.indexedFeaturesExtents_={},this.pendingFeatures_={},this.pixelCoordinate_=null,this.pixelTolerance_=void 0!==i.pixelTolerance?i.pixelTolerance:10,this.sortByDistance_=function(t,e){var i=Fv(this.pixelCoordinate_,t.segment),n=Fv(this.pixelCoordinate_,e.segment);return i-n}.bind(this),this.rBush_=new t_,this.SEGMENT_WRITERS_={Point:this.writePointGeometry_,LineString:this.writeLineStringGeometry_,LinearRing:this.writeLineStringGeometry_,Polygon:this.writePolygonGeometry_,MultiPoint:this.writeMultiPointGeometry_,MultiLineString:this.writeMultiLineStringGeometry_,MultiPolygon:this.writeMultiPolygonGeometry_,GeometryCollection:this.writeGeometryCollectionGeometry_,Circle:this.writeCircleGeometry_}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.addFeature=function(t,e){var i=void 0===e||e,n=nn(t),r=t.getGeometry();if(r){var o=this.SEGMENT_WRITERS_[r.getType()];o&&(this.indexedFeaturesExtents_[n]=r.getExtent([1/0,1/0,-1/0,-1/0]),o.call(this,t,r))}i&&(this.featureChangeListenerKeys_[n]=gr(t,br.CHANGE,this.handleFeatureChange_,this))},e.prototype.forEachFeatureAdd_=function(t){this.addFeature(t)},e.prototype.forEachFeatureRemove_=function(t){this.removeFeature(t)},e.prototype.getFeatures_=function(){var t;return this.features_?t=this.features_:this.source_&&(t=this.source_.getFeatures()),t},e.prototype.handleFeatureAdd_=function(t){var e;t instanceof e_?e=t.feature:t instanceof Av&&(e=t.element),this.addFeature(e)},e.prototype.handleFeatureRemove_=function(t){var e;t instanceof e_?e=t.feature:t instanceof Av&&(e=t.element),this.removeFeature(e)},e.prototype.handleFeatureChange_=function(t){var e=t.target;if(this.handlingDownUpSequence){var i=nn(e);i in this.pendingFeatures_||(this.pendingFeatures_[i]=e)}else this.updateFeature_(e)},e.prototype.removeFeature=function(t,e){var i=void 0===e||e,n=nn(t),r=this.indexedFeaturesExtents_[n];if(r){var o=this.rBush_,s=[];o.forEachInExtent(r,function(e){t===e.feature&&s.push(e)});for(var a=s.length-1;a>=0;--a)o.remove(s[a])}i&&(_r(this.featureChangeListenerKeys_[n]),delete this.featureChangeListenerKeys_[n])},e.prototype.setMap=function(e){var i=this.getMap(),n=this.featuresListenerKeys_,r=this.getFeatures_();i&&(n.forEach(_r),n.length=0,r.forEach(this.forEachFeatureRemove_.bind(this))),t.prototype.setMap.call(this,e),e&&(this.features_?n.push(gr(this.features_,Lv.ADD,this.handleFeatureAdd_,this),gr(this.features_,Lv.REMOVE,this.handleFeatureRemove_,this)):this.source_&&n.push(gr(this.source_,jv.ADDFEATURE,this.handleFeatureAdd_,this),gr(this.source_,jv.REMOVEFEATURE,this.handleFeatureRemove_,this)),r.forEach(this.forEachFeatureAdd_.bind(this)))},e.prototype.snapTo=function(t,e,i){var n=un([i.getCoordinateFromPixel([t[0]-this.pixelTolerance_,t[1]+this.pixelTolerance_]),i.getCoordinateFromPixel([t[0]+this.pixelTolerance_,t[1]-this.pixelTolerance_])]),r=this.rBush_.getInExtent(n);this.vertex_&&!this.edge_&&(r=r.filter(function(t){return t.feature.getGeometry().getType()!==Dr.CIRCLE}));var o,s,a,h,u=!1,l=null,c=null;if(r.length>0){this.pixelCoordinate_=e,r.sort(this.sortByDistance_);var p=r[0].segment,f=r[0].feature.getGeometry().getType()===Dr.CIRCLE;this.vertex_&&!this.edge_?(o=i.getPixelFromCoordinate(p[0]),s=i.getPixelFromCoordinate(p[1]),a=Iv(t,o),h=Iv(t,s),Math.sqrt(Math.min(a,h))<=this.pixelTolerance_&&(u=!0,l=a>h?p[1]:p[0],c=i.getPixelFromCoordinate(l))):this.edge_&&(l=f?function(t,e){var i=e.getRadius(),n=e.getCenter(),r=n[0],o=n[1],s=t[0]-r,a=t[1]-o;0===s&&0===a&&(s=1);var h=Math.sqrt(s*s+a*a);return[r+i*s/h,o+i*a/h]}(e,r[0].feature.getGeometry()):wv(e,p),Pv(t,c=i.getPixelFromCoordinate(l))<=this.pixelTolerance_&&(u=!0,this.vertex_&&!f&&(o=i.getPixelFromCoordinate(p[0]),s=i.getPixelFromCoordinate(p[1]),a=Iv(c,o),h=Iv(c,s),Math.sqrt(Math.min(a,h))<=this.pixelTolerance_&&(l=a>h?p[1]:p[0],c=i.getPixelFromCoordinate(l))))),u&&(c=[Math.round(c[0]),Math.round(c[1])])}return{snapped:u,vertex:l,vertexPixel:c}},e.prototype.updateFeature_=function(t){this.removeFeature(t,!1),this.addFeature(t,!1)},e.prototype.writeCircleGeometry_=function(t,e){for(var i=Bu(e).getCoordinates()[0],n=0,r=i.length-1;n<r;++n){var o=i.slice(n,n+2),s={feature:t,segment:o};this.rBush_.insert(un(o),s)}},e.prototype.writeGeometryCollectionGeometry_=function(t,e){for(var i=e.getGeometriesArray(),n=0;n<i.length;++n){var r=this.SEGMENT_WRITERS_[i[n].getType()];r&&r.call(this,t,i[n])}},e.prototype.writeLineStringGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length-1;n<r;++n){var o=i.slice(n,n+2),s={feature:t,segment:o};this.rBush_.insert(un(o),s)}},e.prototype.writeMultiLineStringGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n)for(var o=i[n],s=0,a=o.length-1;s<a;++s){var h=o.slice(s,s+2),u={feature:t,segment:h};this.rBush_.insert(un(h),u)}},e.prototype.writeMultiPointGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n){var o=i[n],s={feature:t,segment:[o,o]};this.rBush_.insert(e.getExtent(),s)}},e.prototype.writeMultiPolygonGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n)for(var o=i[n],s=0,a=o.length;s<a;++s)for(var h=o[s],u=0,l=h.length-1;u<l;++u){var c=h.slice(u,u+2),p={feature:t,segment:c};this.rBush_.insert(un(c),p)}},e.prototype.writePointGeometry_=function(t,e){var i=e.getCoordinates(),n={feature:t,segment:[i,i]};this.rBush_.insert(e.getExtent(),n)},e.prototype.writePolygonGeometry_=function(t,e){for(var i=e.getCoordinates(),n=0,r=i.length;n<r;++n)for(var o=i[n],s=0,a=o.length-1;s<a;++s){var h=o.slice(s,s+2),u={feature:t,segment:h};this.rBush_.insert(un(h),u)}},e}(X_);function XS(t){var e=this.snapTo(t.pixel,t.coordinate,t.map);return e.snapped&&(t.coordinate=e.vertex.slice(0,2),t.pixel=e.vertexPixel),B_.call(this,t)}function US(t){var e=Qn(this.pendingFeatures_);return e.length&&(e.forEach(this.updateFeature_.bind(this)),this.pendingFeatures_={}),!1}var BS={TRANSLATESTART:"translatestart",TRANSLATING:"translating",TRANSLATEEND:"translateend"},KS=function(t){function e(e,i,n){t.call(this,e),this.features=i,this.coordinate=n}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr);!function(t){function e(e){t.call(this,{handleDownEvent:WS,handleDragEvent:ZS,handleMoveEvent:qS,handleUpEvent:HS});var i,n=e||{};if(this.lastCoordinate_=null,this.features_=void 0!==n.features?n.features:null,n.layers)if("function"==typeof n.layers)i=n.layers;else{var r=n.layers;i=function(t){return Bo(r,t)}}else i=mr;this.layerFilter_=i,this.hitTolerance_=n.hitTolerance?n.hitTolerance:0,this.lastFeature_=null,gr(this,Go(ng.ACTIVE),this.handleActiveChanged_,this)}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.featuresAtPixel_=function(t,e){return e.forEachFeatureAtPixel(t,function(t){if(!this.features_||Bo(this.features_.getArray(),t))return t}.bind(this),{layerFilter:this.layerFilter_,hitTolerance:this.hitTolerance_})},e.prototype.getHitTolerance=function(){return this.hitTolerance_},e.prototype.setHitTolerance=function(t){this.hitTolerance_=t},e.prototype.setMap=function(e){var i=this.getMap();t.prototype.setMap.call(this,e),this.updateState_(i)},e.prototype.handleActiveChanged_=function(){this.updateState_(null)},e.prototype.updateState_=function(t){var e=this.getMap(),i=this.getActive();e&&i||(e=e||t)&&e.getViewport().classList.remove("ol-grab","ol-grabbing")}}(X_);function WS(t){if(this.lastFeature_=this.featuresAtPixel_(t.pixel,t.map),!this.lastCoordinate_&&this.lastFeature_){this.lastCoordinate_=t.coordinate,qS.call(this,t);var e=this.features_||new Nv([this.lastFeature_]);return this.dispatchEvent(new KS(BS.TRANSLATESTART,e,t.coordinate)),!0}return!1}function HS(t){if(this.lastCoordinate_){this.lastCoordinate_=null,qS.call(this,t);var e=this.features_||new Nv([this.lastFeature_]);return this.dispatchEvent(new KS(BS.TRANSLATEEND,e,t.coordinate)),!0}return!1}function ZS(t){if(this.lastCoordinate_){var e=t.coordinate,i=e[0]-this.lastCoordinate_[0],n=e[1]-this.lastCoordinate_[1],r=this.features_||new Nv([this.lastFeature_]);r.forEach(function(t){var e=t.getGeometry();e.translate(i,n),t.setGeometry(e)}),this.lastCoordinate_=e,this.dispatchEvent(new KS(BS.TRANSLATING,r,e))}}function qS(t){var e=t.map.getViewport();this.featuresAtPixel_(t.pixel,t.map)?(e.classList.remove(this.lastCoordinate_?"ol-grab":"ol-grabbing"),e.classList.add(this.lastCoordinate_?"ol-grabbing":"ol-grab")):e.classList.remove("ol-grab","ol-grabbing")}var JS=function(t){function e(e,i,n,r,o){var s=void 0!==o?qs.IDLE:qs.LOADED;t.call(this,e,i,n,s),this.loader_=void 0!==o?o:null,this.canvas_=r,this.error_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getError=function(){return this.error_},e.prototype.handleLoad_=function(t){t?(this.error_=t,this.state=qs.ERROR):this.state=qs.LOADED,this.changed()},e.prototype.load=function(){this.state==qs.IDLE&&(this.state=qs.LOADING,this.changed(),this.loader_(this.handleLoad_.bind(this)))},e.prototype.getImage=function(){return this.canvas_},e}(rE),QS=function(t){function e(e,i,n,r,o){t.call(this,e),this.vectorContext=i,this.frameState=n,this.context=r,this.glContext=o}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Sr),tT=function(){};tT.prototype.drawCustom=function(t,e,i){},tT.prototype.drawGeometry=function(t){},tT.prototype.setStyle=function(t){},tT.prototype.drawCircle=function(t,e){},tT.prototype.drawFeature=function(t,e){},tT.prototype.drawGeometryCollection=function(t,e){},tT.prototype.drawLineString=function(t,e){},tT.prototype.drawMultiLineString=function(t,e){},tT.prototype.drawMultiPoint=function(t,e){},tT.prototype.drawMultiPolygon=function(t,e){},tT.prototype.drawPoint=function(t,e){},tT.prototype.drawPolygon=function(t,e){},tT.prototype.drawText=function(t,e){},tT.prototype.setFillStrokeStyle=function(t,e){},tT.prototype.setImageStyle=function(t,e){},tT.prototype.setTextStyle=function(t,e){};var eT=function(t){function e(e,i,n,r,o){t.call(this),this.context_=e,this.pixelRatio_=i,this.extent_=n,this.transform_=r,this.viewRotation_=o,this.contextFillState_=null,this.contextStrokeState_=null,this.contextTextState_=null,this.fillState_=null,this.strokeState_=null,this.image_=null,this.imageAnchorX_=0,this.imageAnchorY_=0,this.imageHeight_=0,this.imageOpacity_=0,this.imageOriginX_=0,this.imageOriginY_=0,this.imageRotateWithView_=!1,this.imageRotation_=0,this.imageScale_=0,this.imageWidth_=0,this.text_="",this.textOffsetX_=0,this.textOffsetY_=0,this.textRotateWithView_=!1,this.textRotation_=0,this.textScale_=0,this.textFillState_=null,this.textStrokeState_=null,this.textState_=null,this.pixelCoordinates_=[],this.tmpLocalTransform_=[1,0,0,1,0,0]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.drawImages_=function(t,e,i,n){if(this.image_){var r=Es(t,e,i,2,this.transform_,this.pixelCoordinates_),o=this.context_,s=this.tmpLocalTransform_,a=o.globalAlpha;1!=this.imageOpacity_&&(o.globalAlpha=a*this.imageOpacity_);var h=this.imageRotation_;this.imageRotateWithView_&&(h+=this.viewRotation_);for(var u=0,l=r.length;u<l;u+=2){var c=r[u]-this.imageAnchorX_,p=r[u+1]-this.imageAnchorY_;if(0!==h||1!=this.imageScale_){var f=c+this.imageAnchorX_,d=p+this.imageAnchorY_;Os(s,f,d,this.imageScale_,this.imageScale_,h,-f,-d),o.setTransform.apply(o,s)}o.drawImage(this.image_,this.imageOriginX_,this.imageOriginY_,this.imageWidth_,this.imageHeight_,c,p,this.imageWidth_,this.imageHeight_)}0===h&&1==this.imageScale_||o.setTransform(1,0,0,1,0,0),1!=this.imageOpacity_&&(o.globalAlpha=a)}},e.prototype.drawText_=function(t,e,i,n){if(this.textState_&&""!==this.text_){this.textFillState_&&this.setContextFillState_(this.textFillState_),this.textStrokeState_&&this.setContextStrokeState_(this.textStrokeState_),this.setContextTextState_(this.textState_);var r=Es(t,e,i,n,this.transform_,this.pixelCoordinates_),o=this.context_,s=this.textRotation_;for(this.textRotateWithView_&&(s+=this.viewRotation_);e<i;e+=n){var a=r[e]+this.textOffsetX_,h=r[e+1]+this.textOffsetY_;if(0!==s||1!=this.textScale_){var u=Os(this.tmpLocalTransform_,a,h,this.textScale_,this.textScale_,s,-a,-h);o.setTransform.apply(o,u)}this.textStrokeState_&&o.strokeText(this.text_,a,h),this.textFillState_&&o.fillText(this.text_,a,h)}0===s&&1==this.textScale_||o.setTransform(1,0,0,1,0,0)}},e.prototype.moveToLineTo_=function(t,e,i,n,r){var o=this.context_,s=Es(t,e,i,n,this.transform_,this.pixelCoordinates_);o.moveTo(s[0],s[1]);var a=s.length;r&&(a-=2);for(var h=2;h<a;h+=2)o.lineTo(s[h],s[h+1]);return r&&o.closePath(),i},e.prototype.drawRings_=function(t,e,i,n){for(var r=0,o=i.length;r<o;++r)e=this.moveToLineTo_(t,e,i[r],n,!0);return e},e.prototype.drawCircle=function(t){if(Dn(this.extent_,t.getExtent())){if(this.fillState_||this.strokeState_){this.fillState_&&this.setContextFillState_(this.fillState_),this.strokeState_&&this.setContextStrokeState_(this.strokeState_);var e=function(t,e,i){var n=t.getFlatCoordinates();if(n){var r=t.getStride();return Es(n,0,n.length,r,e,i)}return null}(t,this.transform_,this.pixelCoordinates_),i=e[2]-e[0],n=e[3]-e[1],r=Math.sqrt(i*i+n*n),o=this.context_;o.beginPath(),o.arc(e[0],e[1],r,0,2*Math.PI),this.fillState_&&o.fill(),this.strokeState_&&o.stroke()}""!==this.text_&&this.drawText_(t.getCenter(),0,2,2)}},e.prototype.setStyle=function(t){this.setFillStrokeStyle(t.getFill(),t.getStroke()),this.setImageStyle(t.getImage()),this.setTextStyle(t.getText())},e.prototype.drawGeometry=function(t){switch(t.getType()){case Dr.POINT:this.drawPoint(t);break;case Dr.LINE_STRING:this.drawLineString(t);break;case Dr.POLYGON:this.drawPolygon(t);break;case Dr.MULTI_POINT:this.drawMultiPoint(t);break;case Dr.MULTI_LINE_STRING:this.drawMultiLineString(t);break;case Dr.MULTI_POLYGON:this.drawMultiPolygon(t);break;case Dr.GEOMETRY_COLLECTION:this.drawGeometryCollection(t);break;case Dr.CIRCLE:this.drawCircle(t)}},e.prototype.drawFeature=function(t,e){var i=e.getGeometryFunction()(t);i&&Dn(this.extent_,i.getExtent())&&(this.setStyle(e),this.drawGeometry(i))},e.prototype.drawGeometryCollection=function(t){for(var e=t.getGeometriesArray(),i=0,n=e.length;i<n;++i)this.drawGeometry(e[i])},e.prototype.drawPoint=function(t){var e=t.getFlatCoordinates(),i=t.getStride();this.image_&&this.drawImages_(e,0,e.length,i),""!==this.text_&&this.drawText_(e,0,e.length,i)},e.prototype.drawMultiPoint=function(t){var e=t.getFlatCoordinates(),i=t.getStride();this.image_&&this.drawImages_(e,0,e.length,i),""!==this.text_&&this.drawText_(e,0,e.length,i)},e.prototype.drawLineString=function(t){if(Dn(this.extent_,t.getExtent())){if(this.strokeState_){this.setContextStrokeState_(this.strokeState_);var e=this.context_,i=t.getFlatCoordinates();e.beginPath(),this.moveToLineTo_(i,0,i.length,t.getStride(),!1),e.stroke()}if(""!==this.text_){var n=t.getFlatMidpoint();this.drawText_(n,0,2,2)}}},e.prototype.drawMultiLineString=function(t){var e=t.getExtent();if(Dn(this.extent_,e)){if(this.strokeState_){this.setContextStrokeState_(this.strokeState_);var i=this.context_,n=t.getFlatCoordinates(),r=0,o=t.getEnds(),s=t.getStride();i.beginPath();for(var a=0,h=o.length;a<h;++a)r=this.moveToLineTo_(n,r,o[a],s,!1);i.stroke()}if(""!==this.text_){var u=t.getFlatMidpoints();this.drawText_(u,0,u.length,2)}}},e.prototype.drawPolygon=function(t){if(Dn(this.extent_,t.getExtent())){if(this.strokeState_||this.fillState_){this.fillState_&&this.setContextFillState_(this.fillState_),this.strokeState_&&this.setContextStrokeState_(this.strokeState_);var e=this.context_;e.beginPath(),this.drawRings_(t.getOrientedFlatCoordinates(),0,t.getEnds(),t.getStride()),this.fillState_&&e.fill(),this.strokeState_&&e.stroke()}if(""!==this.text_){var i=t.getFlatInteriorPoint();this.drawText_(i,0,2,2)}}},e.prototype.drawMultiPolygon=function(t){if(Dn(this.extent_,t.getExtent())){if(this.strokeState_||this.fillState_){this.fillState_&&this.setContextFillState_(this.fillState_),this.strokeState_&&this.setContextStrokeState_(this.strokeState_);var e=this.context_,i=t.getOrientedFlatCoordinates(),n=0,r=t.getEndss(),o=t.getStride();e.beginPath();for(var s=0,a=r.length;s<a;++s){var h=r[s];n=this.drawRings_(i,n,h,o)}this.fillState_&&e.fill(),this.strokeState_&&e.stroke()}if(""!==this.text_){var u=t.getFlatInteriorPoints();this.drawText_(u,0,u.length,2)}}},e.prototype.setContextFillState_=function(t){var e=this.context_,i=this.contextFillState_;i?i.fillStyle!=t.fillStyle&&(i.fillStyle=e.fillStyle=t.fillStyle):(e.fillStyle=t.fillStyle,this.contextFillState_={fillStyle:t.fillStyle})},e.prototype.setContextStrokeState_=function(t){var e=this.context_,i=this.contextStrokeState_;i?(i.lineCap!=t.lineCap&&(i.lineCap=e.lineCap=t.lineCap),Bs&&(Zo(i.lineDash,t.lineDash)||e.setLineDash(i.lineDash=t.lineDash),i.lineDashOffset!=t.lineDashOffset&&(i.lineDashOffset=e.lineDashOffset=t.lineDashOffset)),i.lineJoin!=t.lineJoin&&(i.lineJoin=e.lineJoin=t.lineJoin),i.lineWidth!=t.lineWidth&&(i.lineWidth=e.lineWidth=t.lineWidth),i.miterLimit!=t.miterLimit&&(i.miterLimit=e.miterLimit=t.miterLimit),i.strokeStyle!=t.strokeStyle&&(i.strokeStyle=e.strokeStyle=t.strokeStyle)):(e.lineCap=t.lineCap,Bs&&(e.setLineDash(t.lineDash),e.lineDashOffset=t.lineDashOffset),e.lineJoin=t.lineJoin,e.lineWidth=t.lineWidth,e.miterLimit=t.miterLimit,e.strokeStyle=t.strokeStyle,this.contextStrokeState_={lineCap:t.lineCap,lineDash:t.lineDash,lineDashOffset:t.lineDashOffset,lineJoin:t.lineJoin,lineWidth:t.lineWidth,miterLimit:t.miterLimit,strokeStyle:t.strokeStyle})},e.prototype.setContextTextState_=function(t){var e=this.context_,i=this.contextTextState_,n=t.textAlign?t.textAlign:"center";i?(i.font!=t.font&&(i.font=e.font=t.font),i.textAlign!=n&&(i.textAlign=e.textAlign=n),i.textBaseline!=t.textBaseline&&(i.textBaseline=e.textBaseline=t.textBaseline)):(e.font=t.font,e.textAlign=n,e.textBaseline=t.textBaseline,this.contextTextState_={font:t.font,textAlign:n,textBaseline:t.textBaseline})},e.prototype.setFillStrokeStyle=function(t,e){if(t){var i=t.getColor();this.fillState_={fillStyle:js(i||oa)}}else this.fillState_=null;if(e){var n=e.getColor(),r=e.getLineCap(),o=e.getLineDash(),s=e.getLineDashOffset(),a=e.getLineJoin(),h=e.getWidth(),u=e.getMiterLimit();this.strokeState_={lineCap:void 0!==r?r:"round",lineDash:o||sa,lineDashOffset:s||0,lineJoin:void 0!==a?a:"round",lineWidth:this.pixelRatio_*(void 0!==h?h:1),miterLimit:void 0!==u?u:10,strokeStyle:js(n||aa)}}else this.strokeState_=null},e.prototype.setImageStyle=function(t){if(t){var e=t.getAnchor(),i=t.getImage(1),n=t.getOrigin(),r=t.getSize();this.imageAnchorX_=e[0],this.imageAnchorY_=e[1],this.imageHeight_=r[1],this.image_=i,this.imageOpacity_=t.getOpacity(),this.imageOriginX_=n[0],this.imageOriginY_=n[1],this.imageRotateWithView_=t.getRotateWithView(),this.imageRotation_=t.getRotation(),this.imageScale_=t.getScale()*this.pixelRatio_,this.imageWidth_=r[0]}else this.image_=null},e.prototype.setTextStyle=function(t){if(t){var e=t.getFill();if(e){var i=e.getColor();this.textFillState_={fillStyle:js(i||oa)}}else this.textFillState_=null;var n=t.getStroke();if(n){var r=n.getColor(),o=n.getLineCap(),s=n.getLineDash(),a=n.getLineDashOffset(),h=n.getLineJoin(),u=n.getWidth(),l=n.getMiterLimit();this.textStrokeState_={lineCap:void 0!==o?o:"round",lineDash:s||sa,lineDashOffset:a||0,lineJoin:void 0!==h?h:"round",lineWidth:void 0!==u?u:1,miterLimit:void 0!==l?l:10,strokeStyle:js(r||aa)}}else this.textStrokeState_=null;var c=t.getFont(),p=t.getOffsetX(),f=t.getOffsetY(),d=t.getRotateWithView(),g=t.getRotation(),y=t.getScale(),v=t.getText(),_=t.getTextAlign(),m=t.getTextBaseline();this.textState_={font:void 0!==c?c:"10px sans-serif",textAlign:void 0!==_?_:"center",textBaseline:void 0!==m?m:"middle"},this.text_=void 0!==v?v:"",this.textOffsetX_=void 0!==p?this.pixelRatio_*p:0,this.textOffsetY_=void 0!==f?this.pixelRatio_*f:0,this.textRotateWithView_=void 0!==d&&d,this.textRotation_=void 0!==g?g:0,this.textScale_=this.pixelRatio_*(void 0!==y?y:1)}else this.text_=""},e}(tT),iT=function(t){function e(e){t.call(this),this.map_=e,this.layerRenderers_={},this.layerRendererListeners_={},this.layerRendererConstructors_=[]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.registerLayerRenderers=function(t){this.layerRendererConstructors_.push.apply(this.layerRendererConstructors_,t)},e.prototype.getLayerRendererConstructors=function(){return this.layerRendererConstructors_},e.prototype.calculateMatrices2D=function(t){var e=t.viewState,i=t.coordinateToPixelTransform,n=t.pixelToCoordinateTransform;Os(i,t.size[0]/2,t.size[1]/2,1/e.resolution,-1/e.resolution,-e.rotation,-e.center[0],-e.center[1]),function(t){var e,i=(e=t)[0]*e[3]-e[1]*e[2];sn(0!==i,32);var n=t[0],r=t[1],o=t[2],s=t[3],a=t[4],h=t[5];t[0]=s/i,t[1]=-r/i,t[2]=-o/i,t[3]=n/i,t[4]=(o*h-s*a)/i,t[5]=-(n*h-r*a)/i}(ws(n,i))},e.prototype.removeLayerRenderers=function(){for(var t in this.layerRenderers_)this.removeLayerRendererByKey_(t).dispose()},e.prototype.forEachFeatureAtCoordinate=function(t,e,i,n,r,o,s){var a,h=e.viewState,u=h.resolution;function l(t,i){var o=nn(t).toString(),s=e.layerStates[nn(i)].managed;if(!(o in e.skippedFeatureUids)||s)return n.call(r,t,s?i:null)}var c=h.projection,p=t;if(c.canWrapX()){var f=c.getExtent(),d=Gn(f),g=t[0];if(g<f[0]||g>f[2])p=[g+d*Math.ceil((f[0]-g)/d),t[1]]}var y,v=e.layerStatesArray;for(y=v.length-1;y>=0;--y){var _=v[y],m=_.layer;if(tm(_,u)&&o.call(s,m)){var E=this.getLayerRenderer(m);if(m.getSource()&&(a=E.forEachFeatureAtCoordinate(m.getSource().getWrapX()?p:t,e,i,l,r)),a)return a}}},e.prototype.forEachLayerAtPixel=function(t,e,i,n,r,o,s){},e.prototype.hasFeatureAtCoordinate=function(t,e,i,n,r){return void 0!==this.forEachFeatureAtCoordinate(t,e,i,mr,this,n,r)},e.prototype.getLayerRenderer=function(t){var e=nn(t).toString();if(e in this.layerRenderers_)return this.layerRenderers_[e];for(var i,n=0,r=this.layerRendererConstructors_.length;n<r;++n){var o=this.layerRendererConstructors_[n];if(o.handles(t)){i=o.create(this,t);break}}if(!i)throw new Error("Unable to create renderer for layer: "+t.getType());return this.layerRenderers_[e]=i,this.layerRendererListeners_[e]=gr(i,br.CHANGE,this.handleLayerRendererChange_,this),i},e.prototype.getLayerRendererByKey=function(t){return this.layerRenderers_[t]},e.prototype.getLayerRenderers=function(){return this.layerRenderers_},e.prototype.getMap=function(){return this.map_},e.prototype.handleLayerRendererChange_=function(){this.map_.render()},e.prototype.removeLayerRendererByKey_=function(t){var e=this.layerRenderers_[t];return delete this.layerRenderers_[t],_r(this.layerRendererListeners_[t]),delete this.layerRendererListeners_[t],e},e.prototype.removeUnusedLayerRenderers_=function(t,e){for(var i in this.layerRenderers_)e&&i in e.layerStates||this.removeLayerRendererByKey_(i).dispose()},e.prototype.scheduleExpireIconCache=function(t){t.postRenderFunctions.push(nT)},e.prototype.scheduleRemoveUnusedLayerRenderers=function(t){for(var e in this.layerRenderers_)if(!(e in t.layerStates))return void t.postRenderFunctions.push(this.removeUnusedLayerRenderers_.bind(this))},e}(lr);function nT(t,e){ny.expire()}function rT(t,e){return t.zIndex-e.zIndex}iT.prototype.renderFrame=xr,iT.prototype.dispatchRenderEvent=xr;var oT=[],sT=function(t){function e(e){t.call(this,e);var i=e.getViewport();this.context_=Rr(),this.canvas_=this.context_.canvas,this.canvas_.style.width="100%",this.canvas_.style.height="100%",this.canvas_.style.display="block",this.canvas_.className=ta,i.insertBefore(this.canvas_,i.childNodes[0]||null),this.renderedVisible_=!0,this.transform_=[1,0,0,1,0,0]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.dispatchRenderEvent=function(t,e){var i=this.getMap(),n=this.context_;if(i.hasListener(t)){var r=e.extent,o=e.pixelRatio,s=e.viewState.rotation,a=this.getTransform(e),h=new eT(n,o,r,a,s),u=new QS(t,h,e,n,null);i.dispatchEvent(u)}},e.prototype.getTransform=function(t){var e=t.viewState,i=this.canvas_.width/2,n=this.canvas_.height/2,r=t.pixelRatio/e.resolution,o=-r,s=-e.rotation,a=-e.center[0],h=-e.center[1];return Os(this.transform_,i,n,r,o,s,a,h)},e.prototype.renderFrame=function(t){if(t){var e=this.context_,i=t.pixelRatio,n=Math.round(t.size[0]*i),r=Math.round(t.size[1]*i);this.canvas_.width!=n||this.canvas_.height!=r?(this.canvas_.width=n,this.canvas_.height=r):e.clearRect(0,0,n,r);var o=t.viewState.rotation;this.calculateMatrices2D(t),this.dispatchRenderEvent(Z_,t);var s=t.layerStatesArray;!function(t,e){var i,n=t.length,r=Array(t.length);for(i=0;i<n;i++)r[i]={index:i,value:t[i]};for(r.sort(function(t,i){return e(t.value,i.value)||t.index-i.index}),i=0;i<t.length;i++)t[i]=r[i].value}(s,rT),o&&(e.save(),ma(e,o,n/2,r/2));var a,h,u,l,c,p=t.viewState.resolution;for(a=0,h=s.length;a<h;++a)u=(c=s[a]).layer,l=this.getLayerRenderer(u),tm(c,p)&&c.sourceState==Do.READY&&l.prepareFrame(t,c)&&l.composeFrame(t,c,e);o&&e.restore(),this.dispatchRenderEvent(H_,t),this.renderedVisible_||(this.canvas_.style.display="",this.renderedVisible_=!0),this.scheduleRemoveUnusedLayerRenderers(t),this.scheduleExpireIconCache(t)}else this.renderedVisible_&&(this.canvas_.style.display="none",this.renderedVisible_=!1)},e.prototype.forEachLayerAtPixel=function(t,e,i,n,r,o,s){var a,h,u=e.viewState.resolution,l=e.layerStatesArray,c=l.length,p=bs(e.pixelToCoordinateTransform,t.slice());for(h=c-1;h>=0;--h){var f=l[h],d=f.layer;if(tm(f,u)&&o.call(s,d))if(a=this.getLayerRenderer(d).forEachLayerAtCoordinate(p,e,i,n,r))return a}},e.prototype.registerLayerRenderers=function(e){t.prototype.registerLayerRenderers.call(this,e);for(var i=0,n=e.length;i<n;++i){var r=e[i];Bo(oT,r)||oT.push(r)}},e}(iT),aT=function(t){function e(e){t.call(this),this.layer_=e}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.createLoadedTileFinder=function(t,e,i){return function(n,r){return t.forEachLoadedTile(e,n,r,function(t){i[n]||(i[n]={}),i[n][t.tileCoord.toString()]=t})}},e.prototype.getLayer=function(){return this.layer_},e.prototype.handleImageChange_=function(t){t.target.getState()===qs.LOADED&&this.renderIfReadyAndVisible()},e.prototype.loadImage=function(t){var e=t.getState();return e!=qs.LOADED&&e!=qs.ERROR&&gr(t,br.CHANGE,this.handleImageChange_,this),e==qs.IDLE&&(t.load(),e=t.getState()),e==qs.LOADED},e.prototype.renderIfReadyAndVisible=function(){var t=this.getLayer();t.getVisible()&&t.getSourceState()==Do.READY&&this.changed()},e.prototype.scheduleExpireCache=function(t,e){if(e.canExpireCache()){var i=function(t,e,i){var n=nn(t).toString();n in i.usedTiles&&t.expireCache(i.viewState.projection,i.usedTiles[n])}.bind(null,e);t.postRenderFunctions.push(i)}},e.prototype.updateUsedTiles=function(t,e,i,n){var r=nn(e).toString(),o=i.toString();r in t?o in t[r]?t[r][o].extend(n):t[r][o]=n:(t[r]={},t[r][o]=n)},e.prototype.manageTilePyramid=function(t,e,i,n,r,o,s,a,h,u){var l=nn(e).toString();l in t.wantedTiles||(t.wantedTiles[l]={});var c,p,f,d,g,y,v=t.wantedTiles[l],_=t.tileQueue;for(y=i.getMinZoom();y<=s;++y)for(p=i.getTileRangeForExtentAndZ(o,y,p),f=i.getResolution(y),d=p.minX;d<=p.maxX;++d)for(g=p.minY;g<=p.maxY;++g)s-y<=a?((c=e.getTile(y,d,g,n,r)).getState()==or.IDLE&&(v[c.getKey()]=!0,_.isKeyQueued(c.getKey())||_.enqueue([c,l,i.getTileCoordCenter(c.tileCoord),f])),void 0!==h&&h.call(u,c)):e.useTile(y,d,g,r)},e}(Ao);aT.prototype.forEachFeatureAtCoordinate=xr,aT.prototype.hasFeatureAtCoordinate=Er;var hT=function(t){function e(e){t.call(this,e),this.renderedResolution,this.transform_=[1,0,0,1,0,0]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.clip=function(t,e,i){var n=e.pixelRatio,r=e.size[0]*n,o=e.size[1]*n,s=e.viewState.rotation,a=kn(i),h=jn(i),u=Fn(i),l=Pn(i);bs(e.coordinateToPixelTransform,a),bs(e.coordinateToPixelTransform,h),bs(e.coordinateToPixelTransform,u),bs(e.coordinateToPixelTransform,l),t.save(),ma(t,-s,r/2,o/2),t.beginPath(),t.moveTo(a[0]*n,a[1]*n),t.lineTo(h[0]*n,h[1]*n),t.lineTo(u[0]*n,u[1]*n),t.lineTo(l[0]*n,l[1]*n),t.clip(),ma(t,s,r/2,o/2)},e.prototype.dispatchComposeEvent_=function(t,e,i,n){var r=this.getLayer();if(r.hasListener(t)){var o=i.size[0]*i.pixelRatio,s=i.size[1]*i.pixelRatio,a=i.viewState.rotation;ma(e,-a,o/2,s/2);var h=void 0!==n?n:this.getTransform(i,0),u=new eT(e,i.pixelRatio,i.extent,h,i.viewState.rotation),l=new QS(t,u,i,e,null);r.dispatchEvent(l),ma(e,a,o/2,s/2)}},e.prototype.forEachLayerAtCoordinate=function(t,e,i,n,r){return this.forEachFeatureAtCoordinate(t,e,i,mr,this)?n.call(r,this.getLayer(),null):void 0},e.prototype.postCompose=function(t,e,i,n){this.dispatchComposeEvent_(H_,t,e,n)},e.prototype.preCompose=function(t,e,i){this.dispatchComposeEvent_(Z_,t,e,i)},e.prototype.dispatchRenderEvent=function(t,e,i){this.dispatchComposeEvent_(q_,t,e,i)},e.prototype.getTransform=function(t,e){var i=t.viewState,n=t.pixelRatio,r=n*t.size[0]/2,o=n*t.size[1]/2,s=n/i.resolution,a=-s,h=-i.rotation,u=-i.center[0]+e,l=-i.center[1];return Os(this.transform_,r,o,s,a,h,u,l)},e.prototype.composeFrame=function(t,e,i){},e.prototype.prepareFrame=function(t,e){},e}(aT),uT=function(t){function e(e){t.call(this,e),this.coordinateToCanvasPixelTransform=[1,0,0,1,0,0],this.hitCanvasContext_=null}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.composeFrame=function(t,e,i){this.preCompose(i,t);var n=this.getImage();if(n){var r=e.extent,o=void 0!==r&&!dn(r,t.extent)&&Dn(r,t.extent);o&&this.clip(i,t,r);var s=this.getImageTransform(),a=i.globalAlpha;i.globalAlpha=e.opacity;var h=s[4],u=s[5],l=n.width*s[0],c=n.height*s[3];i.drawImage(n,0,0,+n.width,+n.height,Math.round(h),Math.round(u),Math.round(l),Math.round(c)),i.globalAlpha=a,o&&i.restore()}this.postCompose(i,t,e)},e.prototype.getImage=function(){},e.prototype.getImageTransform=function(){},e.prototype.forEachFeatureAtCoordinate=function(t,e,i,n,r){var o=this.getLayer(),s=o.getSource(),a=e.viewState.resolution,h=e.viewState.rotation,u=e.skippedFeatureUids;return s.forEachFeatureAtCoordinate(t,a,h,i,u,function(t){return n.call(r,t,o)})},e.prototype.forEachLayerAtCoordinate=function(e,i,n,r,o){if(this.getImage()){if(this.getLayer().getSource().forEachFeatureAtCoordinate!==xr)return t.prototype.forEachLayerAtCoordinate.call(this,arguments);var s=bs(this.coordinateToCanvasPixelTransform,e.slice());Ov(s,i.viewState.resolution/this.renderedResolution),this.hitCanvasContext_||(this.hitCanvasContext_=Rr(1,1)),this.hitCanvasContext_.clearRect(0,0,1,1),this.hitCanvasContext_.drawImage(this.getImage(),s[0],s[1],1,1,0,0,1,1);var a=this.hitCanvasContext_.getImageData(0,0,1,1).data;return a[3]>0?r.call(o,this.getLayer(),a):void 0}},e}(hT),lT=function(t){function e(i){if(t.call(this,i),this.image_=null,this.imageTransform_=[1,0,0,1,0,0],this.skippedFeatures_=[],this.vectorRenderer_=null,i.getType()===W_.VECTOR)for(var n=0,r=oT.length;n<r;++n){var o=oT[n];if(o!==e&&o.handles(i)){this.vectorRenderer_=new o(i);break}}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.vectorRenderer_&&this.vectorRenderer_.dispose(),t.prototype.disposeInternal.call(this)},e.prototype.getImage=function(){return this.image_?this.image_.getImage():null},e.prototype.getImageTransform=function(){return this.imageTransform_},e.prototype.prepareFrame=function(t,e){var i,n=t.pixelRatio,r=t.size,o=t.viewState,s=o.center,a=o.resolution,h=this.getLayer().getSource(),u=t.viewHints,l=this.vectorRenderer_,c=t.extent;if(l||void 0===e.extent||(c=Nn(c,e.extent)),!u[vx.ANIMATING]&&!u[vx.INTERACTING]&&!$n(c)){var p=o.projection,f=this.skippedFeatures_;if(l){var d=l.context,g=qn({},t,{size:[Gn(c)/a,An(c)/a],viewState:qn({},t.viewState,{rotation:0})}),y=Object.keys(g.skippedFeatureUids).sort();i=new JS(c,a,n,d.canvas,function(t){!l.prepareFrame(g,e)||!l.replayGroupChanged&&Zo(f,y)||(d.canvas.width=g.size[0]*n,d.canvas.height=g.size[1]*n,l.compose(d,g,e),f=y,t())})}else i=h.getImage(c,a,n,p);i&&this.loadImage(i)&&(this.image_=i,this.skippedFeatures_=f)}if(this.image_){var v=(i=this.image_).getExtent(),_=i.getResolution(),m=i.getPixelRatio(),E=n*_/(a*m),x=Os(this.imageTransform_,n*r[0]/2,n*r[1]/2,E,E,0,m*(v[0]-s[0])/_,m*(s[1]-v[3])/_);Os(this.coordinateToCanvasPixelTransform,n*r[0]/2-x[4],n*r[1]/2-x[5],n/a,-n/a,0,-s[0],-s[1]),this.renderedResolution=_*n/m}return!!this.image_},e.prototype.forEachFeatureAtCoordinate=function(e,i,n,r,o){return this.vectorRenderer_?this.vectorRenderer_.forEachFeatureAtCoordinate(e,i,n,r,o):t.prototype.forEachFeatureAtCoordinate.call(this,e,i,n,r,o)},e}(uT);lT.handles=function(t){return t.getType()===W_.IMAGE||t.getType()===W_.VECTOR&&t.getRenderMode()===em.IMAGE},lT.create=function(t,e){return new lT(e)};var cT=function(t){function e(e,i){t.call(this,e),this.context=i?null:Rr(),this.oversampling_,this.renderedExtent_=null,this.renderedRevision,this.renderedTiles=[],this.newTiles_=!1,this.tmpExtent=[1/0,1/0,-1/0,-1/0],this.tmpTileRange_=new Yo(0,0,0,0),this.imageTransform_=[1,0,0,1,0,0],this.zDirection=0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.isDrawableTile_=function(t){var e=t.getState(),i=this.getLayer().getUseInterimTilesOnError();return e==or.LOADED||e==or.EMPTY||e==or.ERROR&&!i},e.prototype.getTile=function(t,e,i,n,r){var o=this.getLayer(),s=o.getSource().getTile(t,e,i,n,r);return s.getState()==or.ERROR&&(o.getUseInterimTilesOnError()?o.getPreload()>0&&(this.newTiles_=!0):s.setState(or.LOADED)),this.isDrawableTile_(s)||(s=s.getInterimTile()),s},e.prototype.prepareFrame=function(t,e){var i=t.pixelRatio,n=t.size,r=t.viewState,o=r.projection,s=r.resolution,a=r.center,h=this.getLayer(),u=h.getSource(),l=u.getRevision(),c=u.getTileGridForProjection(o),p=c.getZForResolution(s,this.zDirection),f=c.getResolution(p),d=Math.round(s/f)||1,g=t.extent;if(void 0!==e.extent&&(g=Nn(g,e.extent)),$n(g))return!1;var y=c.getTileRangeForExtentAndZ(g,p),v=c.getTileRangeExtent(p,y),_=u.getTilePixelRatio(i),m={};m[p]={};var E,x,S,T=this.createLoadedTileFinder(u,o,m),w=t.viewHints,b=w[vx.ANIMATING]||w[vx.INTERACTING],C=this.tmpExtent,R=this.tmpTileRange_;for(this.newTiles_=!1,x=y.minX;x<=y.maxX;++x)for(S=y.minY;S<=y.maxY;++S)if(!(Date.now()-t.time>16&&b)){if(E=this.getTile(p,x,S,i,o),this.isDrawableTile_(E)){var O=nn(this);if(E.getState()==or.LOADED){m[p][E.tileCoord.toString()]=E;var I=E.inTransition(O);this.newTiles_||!I&&-1!==this.renderedTiles.indexOf(E)||(this.newTiles_=!0)}if(1===E.getAlpha(O,t.time))continue}var P=c.getTileCoordChildTileRange(E.tileCoord,R,C),F=!1;P&&(F=T(p+1,P)),F||c.forEachTileCoordParentTileRange(E.tileCoord,T,null,R,C)}var L=f*i/_*d;if(!(this.renderedResolution&&Date.now()-t.time>16&&b)&&(this.newTiles_||!this.renderedExtent_||!dn(this.renderedExtent_,g)||this.renderedRevision!=l||d!=this.oversampling_||!b&&L!=this.renderedResolution)){var M=this.context;if(M){var A=u.getTilePixelSize(p,i,o),N=Math.round(y.getWidth()*A[0]/d),k=Math.round(y.getHeight()*A[1]/d),j=M.canvas;j.width!=N||j.height!=k?(this.oversampling_=d,j.width=N,j.height=k):(this.renderedExtent_&&!Tn(v,this.renderedExtent_)&&M.clearRect(0,0,N,k),d=this.oversampling_)}this.renderedTiles.length=0;var G,D,$,V,z,Y,X,U,B,K,W=Object.keys(m).map(Number);for(W.sort(function(t,e){return t===p?1:e===p?-1:t>e?1:t<e?-1:0}),V=0,z=W.length;V<z;++V)for(var H in $=W[V],D=u.getTilePixelSize($,i,o),G=c.getResolution($)/f,X=_*u.getGutterForProjection(o),U=m[$])E=U[H],x=((Y=c.getTileCoordExtent(E.getTileCoord(),C))[0]-v[0])/f*_/d,S=(v[3]-Y[3])/f*_/d,B=D[0]*G/d,K=D[1]*G/d,this.drawTileImage(E,t,e,x,S,B,K,X,p===$),this.renderedTiles.push(E);this.renderedRevision=l,this.renderedResolution=f*i/_*d,this.renderedExtent_=v}var Z=this.renderedResolution/s,q=Os(this.imageTransform_,i*n[0]/2,i*n[1]/2,Z,Z,0,(this.renderedExtent_[0]-a[0])/this.renderedResolution*i,(a[1]-this.renderedExtent_[3])/this.renderedResolution*i);return Os(this.coordinateToCanvasPixelTransform,i*n[0]/2-q[4],i*n[1]/2-q[5],i/s,-i/s,0,-a[0],-a[1]),this.updateUsedTiles(t.usedTiles,u,p,y),this.manageTilePyramid(t,u,c,i,o,g,p,h.getPreload()),this.scheduleExpireCache(t,u),this.renderedTiles.length>0},e.prototype.drawTileImage=function(t,e,i,n,r,o,s,a,h){var u=t.getImage(this.getLayer());if(u){var l=nn(this),c=h?t.getAlpha(l,e.time):1;1!==c||this.getLayer().getSource().getOpaque(e.viewState.projection)||this.context.clearRect(n,r,o,s);var p=c!==this.context.globalAlpha;p&&(this.context.save(),this.context.globalAlpha=c),this.context.drawImage(u,a,a,u.width-2*a,u.height-2*a,n,r,o,s),p&&this.context.restore(),1!==c?e.animate=!0:h&&t.endTransition(l)}},e.prototype.getImage=function(){var t=this.context;return t?t.canvas:null},e.prototype.getImageTransform=function(){return this.imageTransform_},e}(uT);cT.handles=function(t){return t.getType()===W_.TILE},cT.create=function(t,e){return new cT(e)},cT.prototype.getLayer;var pT=function(){};pT.prototype.getReplay=function(t,e){},pT.prototype.isEmpty=function(){};var fT={CIRCLE:"Circle",DEFAULT:"Default",IMAGE:"Image",LINE_STRING:"LineString",POLYGON:"Polygon",TEXT:"Text"};function dT(t,e,i,n,r,o,s,a){for(var h,u,l,c=[],p=t[e]>t[i-n],f=r.length,d=t[e],g=t[e+1],y=t[e+=n],v=t[e+1],_=0,m=Math.sqrt(Math.pow(y-d,2)+Math.pow(v-g,2)),E="",x=0,S=0;S<f;++S){u=p?f-S-1:S;var T=r.charAt(u),w=o(E=p?T+E:E+T)-x;x+=w;for(var b=s+w/2;e<i-n&&_+m<b;)d=y,g=v,y=t[e+=n],v=t[e+1],_+=m,m=Math.sqrt(Math.pow(y-d,2)+Math.pow(v-g,2));var C=b-_,R=Math.atan2(v-g,y-d);if(p&&(R+=R>0?-Math.PI:Math.PI),void 0!==l){var O=R-l;if(O+=O>Math.PI?-2*Math.PI:O<-Math.PI?2*Math.PI:0,Math.abs(O)>a)return null}var I=C/m,P=Zn(d,y,I),F=Zn(g,v,I);l==R?(p&&(h[0]=P,h[1]=F,h[2]=w/2),h[4]=E):(x=w,h=[P,F,w/2,R,E=T],p?c.unshift(h):c.push(h),l=R),s+=w}return c}var gT=0,yT=1,vT=2,_T=3,mT=4,ET=5,xT=6,ST=7,TT=8,wT=9,bT=10,CT=11,RT=12,OT=[TT],IT=[RT],PT=[yT],FT=[_T],LT=[fT.POLYGON,fT.CIRCLE,fT.LINE_STRING,fT.IMAGE,fT.TEXT,fT.DEFAULT],MT={left:0,end:0,center:.5,right:1,start:1,top:0,middle:.5,hanging:.2,alphabetic:.8,ideographic:.8,bottom:1},AT=[1/0,1/0,-1/0,-1/0],NT=[1,0,0,1,0,0],kT=function(t){function e(e,i,n,r,o,s){t.call(this),this.declutterTree=s,this.tolerance=e,this.maxExtent=i,this.overlaps=o,this.pixelRatio=r,this.maxLineWidth=0,this.resolution=n,this.alignFill_,this.beginGeometryInstruction1_=null,this.beginGeometryInstruction2_=null,this.bufferedMaxExtent_=null,this.instructions=[],this.coordinates=[],this.coordinateCache_={},this.renderedTransform_=[1,0,0,1,0,0],this.hitDetectionInstructions=[],this.pixelCoordinates_=null,this.state={},this.viewRotation_=0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.replayTextBackground_=function(t,e,i,n,r,o,s){t.beginPath(),t.moveTo.apply(t,e),t.lineTo.apply(t,i),t.lineTo.apply(t,n),t.lineTo.apply(t,r),t.lineTo.apply(t,e),o&&(this.alignFill_=o[2],this.fill_(t)),s&&(this.setStrokeStyle_(t,s),t.stroke())},e.prototype.replayImage_=function(t,e,i,n,r,o,s,a,h,u,l,c,p,f,d,g,y,v){var _=y||v;e-=r*=p,i-=o*=p;var m,E,x,S,T=d+u>n.width?n.width-u:d,w=a+l>n.height?n.height-l:a,b=g[3]+T*p+g[1],C=g[0]+w*p+g[2],R=e-g[3],O=i-g[0];(_||0!==c)&&(m=[R,O],E=[R+b,O],x=[R+b,O+C],S=[R,O+C]);var I=null;if(0!==c){var P=e+r,F=i+o;I=Os(NT,P,F,1,1,c,-P,-F),mn(AT),bn(AT,bs(NT,m)),bn(AT,bs(NT,E)),bn(AT,bs(NT,x)),bn(AT,bs(NT,S))}else _n(R,O,R+b,O+C,AT);var L=t.canvas,M=v?v[2]*p/2:0,A=AT[0]-M<=L.width&&AT[2]+M>=0&&AT[1]-M<=L.height&&AT[3]+M>=0;if(f&&(e=Math.round(e),i=Math.round(i)),s){if(!A&&1==s[4])return;wn(s,AT);var N=A?[t,I?I.slice(0):null,h,n,u,l,T,w,e,i,p]:null;N&&_&&N.push(y,v,m,E,x,S),s.push(N)}else A&&(_&&this.replayTextBackground_(t,m,E,x,S,y,v),xa(t,I,h,n,u,l,T,w,e,i,p))},e.prototype.applyPixelRatio=function(t){var e=this.pixelRatio;return 1==e?t:t.map(function(t){return t*e})},e.prototype.appendFlatCoordinates=function(t,e,i,n,r,o){var s=this.coordinates.length,a=this.getBufferedMaxExtent();o&&(e+=n);var h,u,l,c=[t[e],t[e+1]],p=[NaN,NaN],f=!0;for(h=e+n;h<i;h+=n)p[0]=t[h],p[1]=t[h+1],(l=yn(a,p))!==u?(f&&(this.coordinates[s++]=c[0],this.coordinates[s++]=c[1]),this.coordinates[s++]=p[0],this.coordinates[s++]=p[1],f=!1):l===hn.INTERSECTING?(this.coordinates[s++]=p[0],this.coordinates[s++]=p[1],f=!1):f=!0,c[0]=p[0],c[1]=p[1],u=l;return(r&&f||h===e+n)&&(this.coordinates[s++]=c[0],this.coordinates[s++]=c[1]),s},e.prototype.drawCustomCoordinates_=function(t,e,i,n,r){for(var o=0,s=i.length;o<s;++o){var a=i[o],h=this.appendFlatCoordinates(t,e,a,n,!1,!1);r.push(h),e=a}return e},e.prototype.drawCustom=function(t,e,i){this.beginGeometry(t,e);var n,r,o,s,a,h=t.getType(),u=t.getStride(),l=this.coordinates.length;if(h==Dr.MULTI_POLYGON){n=(t=t).getOrientedFlatCoordinates(),s=[];var c=t.getEndss();a=0;for(var p=0,f=c.length;p<f;++p){var d=[];a=this.drawCustomCoordinates_(n,a,c[p],u,d),s.push(d)}this.instructions.push([mT,l,s,t,i,gu])}else h==Dr.POLYGON||h==Dr.MULTI_LINE_STRING?(o=[],n=h==Dr.POLYGON?t.getOrientedFlatCoordinates():t.getFlatCoordinates(),a=this.drawCustomCoordinates_(n,0,t.getEnds(),u,o),this.instructions.push([mT,l,o,t,i,du])):h==Dr.LINE_STRING||h==Dr.MULTI_POINT?(n=t.getFlatCoordinates(),r=this.appendFlatCoordinates(n,0,n.length,u,!1,!1),this.instructions.push([mT,l,r,t,i,fu])):h==Dr.POINT&&(n=t.getFlatCoordinates(),this.coordinates.push(n[0],n[1]),r=this.coordinates.length,this.instructions.push([mT,l,r,t,i]));this.endGeometry(t,e)},e.prototype.beginGeometry=function(t,e){this.beginGeometryInstruction1_=[gT,e,0],this.instructions.push(this.beginGeometryInstruction1_),this.beginGeometryInstruction2_=[gT,e,0],this.hitDetectionInstructions.push(this.beginGeometryInstruction2_)},e.prototype.fill_=function(t){if(this.alignFill_){var e=bs(this.renderedTransform_,[0,0]),i=512*this.pixelRatio;t.translate(e[0]%i,e[1]%i),t.rotate(this.viewRotation_)}t.fill(),this.alignFill_&&t.setTransform.apply(t,Ea)},e.prototype.setStrokeStyle_=function(t,e){t.strokeStyle=e[1],t.lineWidth=e[2],t.lineCap=e[3],t.lineJoin=e[4],t.miterLimit=e[5],Bs&&(t.lineDashOffset=e[7],t.setLineDash(e[6]))},e.prototype.renderDeclutter_=function(t,e){if(t&&t.length>5){var i=t[4];if(1==i||i==t.length-5){var n={minX:t[0],minY:t[1],maxX:t[2],maxY:t[3],value:e};if(!this.declutterTree.collides(n)){this.declutterTree.insert(n);for(var r=5,o=t.length;r<o;++r){var s=t[r];s&&(s.length>11&&this.replayTextBackground_(s[0],s[13],s[14],s[15],s[16],s[11],s[12]),xa.apply(void 0,s))}}t.length=5,mn(t)}}},e.prototype.replay_=function(t,e,i,n,r,o,s){var a;this.pixelCoordinates_&&Zo(e,this.renderedTransform_)?a=this.pixelCoordinates_:(this.pixelCoordinates_||(this.pixelCoordinates_=[]),a=Es(this.coordinates,0,this.coordinates.length,2,e,this.pixelCoordinates_),ws(this.renderedTransform_,e));for(var h,u,l,c,p,f,d,g,y,v,_,m,E=!tr(i),x=0,S=n.length,T=0,w=0,b=0,C=null,R=null,O=this.coordinateCache_,I=this.viewRotation_,P={context:t,pixelRatio:this.pixelRatio,resolution:this.resolution,rotation:I},F=this.instructions!=n||this.overlaps?0:200;x<S;){var L=n[x];switch(L[0]){case gT:v=L[1],E&&i[nn(v).toString()]||!v.getGeometry()?x=L[2]:void 0===s||Dn(s,v.getGeometry().getExtent())?++x:x=L[2]+1;break;case yT:w>F&&(this.fill_(t),w=0),b>F&&(t.stroke(),b=0),w||b||(t.beginPath(),c=p=NaN),++x;break;case vT:var M=a[T=L[1]],A=a[T+1],N=a[T+2]-M,k=a[T+3]-A,j=Math.sqrt(N*N+k*k);t.moveTo(M+j,A),t.arc(M,A,j,0,2*Math.PI,!0),++x;break;case _T:t.closePath(),++x;break;case mT:T=L[1],h=L[2];var G=L[3],D=L[4],$=6==L.length?L[5]:void 0;P.geometry=G,P.feature=v,x in O||(O[x]=[]);var V=O[x];$?$(a,T,h,2,V):(V[0]=a[T],V[1]=a[T+1],V.length=2),D(V,P),++x;break;case xT:T=L[1],h=L[2],y=L[3],u=L[4],l=L[5],g=o?null:L[6];var z=L[7],Y=L[8],X=L[9],U=L[10],B=L[11],K=L[12],W=L[13],H=L[14],Z=void 0,q=void 0,J=void 0;for(L.length>16?(Z=L[15],q=L[16],J=L[17]):(Z=ha,q=J=!1),B&&(K+=I);T<h;T+=2)this.replayImage_(t,a[T],a[T+1],y,u,l,g,z,Y,X,U,K,W,r,H,Z,q?C:null,J?R:null);this.renderDeclutter_(g,v),++x;break;case ET:var Q=L[1],tt=L[2],et=L[3];g=o?null:L[4];var it=L[5],nt=L[6],rt=L[7],ot=L[8],st=L[9],at=L[10],ht=L[11],ut=L[12],lt=L[13],ct=L[14],pt=wu(a,Q,tt,2),ft=ot(ut);if(it||ft<=pt){var dt=this.textStates[lt].textAlign,gt=dT(a,Q,tt,2,ut,ot,(pt-ft)*MT[dt],rt);if(gt){var yt=void 0,vt=void 0,_t=void 0,mt=void 0,Et=void 0;if(at)for(yt=0,vt=gt.length;yt<vt;++yt)_t=(Et=gt[yt])[4],mt=this.getImage(_t,lt,"",at),u=Et[2]+ht,l=et*mt.height+2*(.5-et)*ht-st,this.replayImage_(t,Et[0],Et[1],mt,u,l,g,mt.height,1,0,0,Et[3],ct,!1,mt.width,ha,null,null);if(nt)for(yt=0,vt=gt.length;yt<vt;++yt)_t=(Et=gt[yt])[4],mt=this.getImage(_t,lt,nt,""),u=Et[2],l=et*mt.height-st,this.replayImage_(t,Et[0],Et[1],mt,u,l,g,mt.height,1,0,0,Et[3],ct,!1,mt.width,ha,null,null)}}this.renderDeclutter_(g,v),++x;break;case ST:if(void 0!==o){var xt=o(v=L[1]);if(xt)return xt}++x;break;case TT:F?w++:this.fill_(t),++x;break;case wT:for(T=L[1],h=L[2],_=a[T],d=(m=a[T+1])+.5|0,(f=_+.5|0)===c&&d===p||(t.moveTo(_,m),c=f,p=d),T+=2;T<h;T+=2)f=(_=a[T])+.5|0,d=(m=a[T+1])+.5|0,T!=h-2&&f===c&&d===p||(t.lineTo(_,m),c=f,p=d);++x;break;case bT:C=L,this.alignFill_=L[2],w&&(this.fill_(t),w=0,b&&(t.stroke(),b=0)),t.fillStyle=L[1],++x;break;case CT:R=L,b&&(t.stroke(),b=0),this.setStrokeStyle_(t,L),++x;break;case RT:F?b++:t.stroke(),++x;break;default:++x}}w&&this.fill_(t),b&&t.stroke()},e.prototype.replay=function(t,e,i,n,r){this.viewRotation_=i,this.replay_(t,e,n,this.instructions,r,void 0,void 0)},e.prototype.replayHitDetection=function(t,e,i,n,r,o){return this.viewRotation_=i,this.replay_(t,e,n,this.hitDetectionInstructions,!0,r,o)},e.prototype.reverseHitDetectionInstructions=function(){var t,e=this.hitDetectionInstructions;e.reverse();var i,n,r=e.length,o=-1;for(t=0;t<r;++t)(n=(i=e[t])[0])==ST?o=t:n==gT&&(i[2]=t,Wo(this.hitDetectionInstructions,o,t),o=-1)},e.prototype.setFillStrokeStyle=function(t,e){var i=this.state;if(t){var n=t.getColor();i.fillStyle=js(n||oa)}else i.fillStyle=void 0;if(e){var r=e.getColor();i.strokeStyle=js(r||aa);var o=e.getLineCap();i.lineCap=void 0!==o?o:"round";var s=e.getLineDash();i.lineDash=s?s.slice():sa;var a=e.getLineDashOffset();i.lineDashOffset=a||0;var h=e.getLineJoin();i.lineJoin=void 0!==h?h:"round";var u=e.getWidth();i.lineWidth=void 0!==u?u:1;var l=e.getMiterLimit();i.miterLimit=void 0!==l?l:10,i.lineWidth>this.maxLineWidth&&(this.maxLineWidth=i.lineWidth,this.bufferedMaxExtent_=null)}else i.strokeStyle=void 0,i.lineCap=void 0,i.lineDash=null,i.lineDashOffset=void 0,i.lineJoin=void 0,i.lineWidth=void 0,i.miterLimit=void 0},e.prototype.createFill=function(t,e){var i=t.fillStyle,n=[bT,i];return"string"!=typeof i&&n.push(!0),n},e.prototype.applyStroke=function(t){this.instructions.push(this.createStroke(t))},e.prototype.createStroke=function(t){return[CT,t.strokeStyle,t.lineWidth*this.pixelRatio,t.lineCap,t.lineJoin,t.miterLimit,this.applyPixelRatio(t.lineDash),t.lineDashOffset*this.pixelRatio]},e.prototype.updateFillStyle=function(t,e,i){var n=t.fillStyle;"string"==typeof n&&t.currentFillStyle==n||(void 0!==n&&this.instructions.push(e.call(this,t,i)),t.currentFillStyle=n)},e.prototype.updateStrokeStyle=function(t,e){var i=t.strokeStyle,n=t.lineCap,r=t.lineDash,o=t.lineDashOffset,s=t.lineJoin,a=t.lineWidth,h=t.miterLimit;(t.currentStrokeStyle!=i||t.currentLineCap!=n||r!=t.currentLineDash&&!Zo(t.currentLineDash,r)||t.currentLineDashOffset!=o||t.currentLineJoin!=s||t.currentLineWidth!=a||t.currentMiterLimit!=h)&&(void 0!==i&&e.call(this,t),t.currentStrokeStyle=i,t.currentLineCap=n,t.currentLineDash=r,t.currentLineDashOffset=o,t.currentLineJoin=s,t.currentLineWidth=a,t.currentMiterLimit=h)},e.prototype.endGeometry=function(t,e){this.beginGeometryInstruction1_[2]=this.instructions.length,this.beginGeometryInstruction1_=null,this.beginGeometryInstruction2_[2]=this.hitDetectionInstructions.length,this.beginGeometryInstruction2_=null;var i=[ST,e];this.instructions.push(i),this.hitDetectionInstructions.push(i)},e.prototype.getBufferedMaxExtent=function(){if(!this.bufferedMaxExtent_&&(this.bufferedMaxExtent_=cn(this.maxExtent),this.maxLineWidth>0)){var t=this.resolution*(this.maxLineWidth+1)/2;ln(this.bufferedMaxExtent_,t,this.bufferedMaxExtent_)}return this.bufferedMaxExtent_},e}(tT);kT.prototype.finish=xr;var jT=function(t){function e(e,i,n,r,o,s){t.call(this,e,i,n,r,o,s),this.declutterGroup_=null,this.hitDetectionImage_=null,this.image_=null,this.anchorX_=void 0,this.anchorY_=void 0,this.height_=void 0,this.opacity_=void 0,this.originX_=void 0,this.originY_=void 0,this.rotateWithView_=void 0,this.rotation_=void 0,this.scale_=void 0,this.width_=void 0}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.drawCoordinates_=function(t,e,i,n){return this.appendFlatCoordinates(t,e,i,n,!1,!1)},e.prototype.drawPoint=function(t,e){if(this.image_){this.beginGeometry(t,e);var i=t.getFlatCoordinates(),n=t.getStride(),r=this.coordinates.length,o=this.drawCoordinates_(i,0,i.length,n);this.instructions.push([xT,r,o,this.image_,this.anchorX_,this.anchorY_,this.declutterGroup_,this.height_,this.opacity_,this.originX_,this.originY_,this.rotateWithView_,this.rotation_,this.scale_*this.pixelRatio,this.width_]),this.hitDetectionInstructions.push([xT,r,o,this.hitDetectionImage_,this.anchorX_,this.anchorY_,this.declutterGroup_,this.height_,this.opacity_,this.originX_,this.originY_,this.rotateWithView_,this.rotation_,this.scale_,this.width_]),this.endGeometry(t,e)}},e.prototype.drawMultiPoint=function(t,e){if(this.image_){this.beginGeometry(t,e);var i=t.getFlatCoordinates(),n=t.getStride(),r=this.coordinates.length,o=this.drawCoordinates_(i,0,i.length,n);this.instructions.push([xT,r,o,this.image_,this.anchorX_,this.anchorY_,this.declutterGroup_,this.height_,this.opacity_,this.originX_,this.originY_,this.rotateWithView_,this.rotation_,this.scale_*this.pixelRatio,this.width_]),this.hitDetectionInstructions.push([xT,r,o,this.hitDetectionImage_,this.anchorX_,this.anchorY_,this.declutterGroup_,this.height_,this.opacity_,this.originX_,this.originY_,this.rotateWithView_,this.rotation_,this.scale_,this.width_]),this.endGeometry(t,e)}},e.prototype.finish=function(){this.reverseHitDetectionInstructions(),this.anchorX_=void 0,this.anchorY_=void 0,this.hitDetectionImage_=null,this.image_=null,this.height_=void 0,this.scale_=void 0,this.opacity_=void 0,this.originX_=void 0,this.originY_=void 0,this.rotateWithView_=void 0,this.rotation_=void 0,this.width_=void 0},e.prototype.setImageStyle=function(t,e){var i=t.getAnchor(),n=t.getSize(),r=t.getHitDetectionImage(1),o=t.getImage(1),s=t.getOrigin();this.anchorX_=i[0],this.anchorY_=i[1],this.declutterGroup_=e,this.hitDetectionImage_=r,this.image_=o,this.height_=n[1],this.opacity_=t.getOpacity(),this.originX_=s[0],this.originY_=s[1],this.rotateWithView_=t.getRotateWithView(),this.rotation_=t.getRotation(),this.scale_=t.getScale(),this.width_=n[0]},e}(kT),GT=function(t){function e(e,i,n,r,o,s){t.call(this,e,i,n,r,o,s)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.drawFlatCoordinates_=function(t,e,i,n){var r=this.coordinates.length,o=this.appendFlatCoordinates(t,e,i,n,!1,!1),s=[wT,r,o];return this.instructions.push(s),this.hitDetectionInstructions.push(s),i},e.prototype.drawLineString=function(t,e){var i=this.state,n=i.strokeStyle,r=i.lineWidth;if(void 0!==n&&void 0!==r){this.updateStrokeStyle(i,this.applyStroke),this.beginGeometry(t,e),this.hitDetectionInstructions.push([CT,i.strokeStyle,i.lineWidth,i.lineCap,i.lineJoin,i.miterLimit,i.lineDash,i.lineDashOffset],PT);var o=t.getFlatCoordinates(),s=t.getStride();this.drawFlatCoordinates_(o,0,o.length,s),this.hitDetectionInstructions.push(IT),this.endGeometry(t,e)}},e.prototype.drawMultiLineString=function(t,e){var i=this.state,n=i.strokeStyle,r=i.lineWidth;if(void 0!==n&&void 0!==r){this.updateStrokeStyle(i,this.applyStroke),this.beginGeometry(t,e),this.hitDetectionInstructions.push([CT,i.strokeStyle,i.lineWidth,i.lineCap,i.lineJoin,i.miterLimit,i.lineDash,i.lineDashOffset],PT);for(var o=t.getEnds(),s=t.getFlatCoordinates(),a=t.getStride(),h=0,u=0,l=o.length;u<l;++u)h=this.drawFlatCoordinates_(s,h,o[u],a);this.hitDetectionInstructions.push(IT),this.endGeometry(t,e)}},e.prototype.finish=function(){var t=this.state;void 0!=t.lastStroke&&t.lastStroke!=this.coordinates.length&&this.instructions.push(IT),this.reverseHitDetectionInstructions(),this.state=null},e.prototype.applyStroke=function(e){void 0!=e.lastStroke&&e.lastStroke!=this.coordinates.length&&(this.instructions.push(IT),e.lastStroke=this.coordinates.length),e.lastStroke=0,t.prototype.applyStroke.call(this,e),this.instructions.push(PT)},e}(kT),DT=function(t){function e(e,i,n,r,o,s){t.call(this,e,i,n,r,o,s)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.drawFlatCoordinatess_=function(t,e,i,n){var r=this.state,o=void 0!==r.fillStyle,s=void 0!=r.strokeStyle,a=i.length;this.instructions.push(PT),this.hitDetectionInstructions.push(PT);for(var h=0;h<a;++h){var u=i[h],l=this.coordinates.length,c=this.appendFlatCoordinates(t,e,u,n,!0,!s),p=[wT,l,c];this.instructions.push(p),this.hitDetectionInstructions.push(p),s&&(this.instructions.push(FT),this.hitDetectionInstructions.push(FT)),e=u}return o&&(this.instructions.push(OT),this.hitDetectionInstructions.push(OT)),s&&(this.instructions.push(IT),this.hitDetectionInstructions.push(IT)),e},e.prototype.drawCircle=function(t,e){var i=this.state,n=i.fillStyle,r=i.strokeStyle;if(void 0!==n||void 0!==r){this.setFillStrokeStyles_(t),this.beginGeometry(t,e),void 0!==i.fillStyle&&this.hitDetectionInstructions.push([bT,Ms(oa)]),void 0!==i.strokeStyle&&this.hitDetectionInstructions.push([CT,i.strokeStyle,i.lineWidth,i.lineCap,i.lineJoin,i.miterLimit,i.lineDash,i.lineDashOffset]);var o=t.getFlatCoordinates(),s=t.getStride(),a=this.coordinates.length;this.appendFlatCoordinates(o,0,o.length,s,!1,!1);var h=[vT,a];this.instructions.push(PT,h),this.hitDetectionInstructions.push(PT,h),this.hitDetectionInstructions.push(OT),void 0!==i.fillStyle&&this.instructions.push(OT),void 0!==i.strokeStyle&&(this.instructions.push(IT),this.hitDetectionInstructions.push(IT)),this.endGeometry(t,e)}},e.prototype.drawPolygon=function(t,e){var i=this.state,n=i.fillStyle,r=i.strokeStyle;if(void 0!==n||void 0!==r){this.setFillStrokeStyles_(t),this.beginGeometry(t,e),void 0!==i.fillStyle&&this.hitDetectionInstructions.push([bT,Ms(oa)]),void 0!==i.strokeStyle&&this.hitDetectionInstructions.push([CT,i.strokeStyle,i.lineWidth,i.lineCap,i.lineJoin,i.miterLimit,i.lineDash,i.lineDashOffset]);var o=t.getEnds(),s=t.getOrientedFlatCoordinates(),a=t.getStride();this.drawFlatCoordinatess_(s,0,o,a),this.endGeometry(t,e)}},e.prototype.drawMultiPolygon=function(t,e){var i=this.state,n=i.fillStyle,r=i.strokeStyle;if(void 0!==n||void 0!==r){this.setFillStrokeStyles_(t),this.beginGeometry(t,e),void 0!==i.fillStyle&&this.hitDetectionInstructions.push([bT,Ms(oa)]),void 0!==i.strokeStyle&&this.hitDetectionInstructions.push([CT,i.strokeStyle,i.lineWidth,i.lineCap,i.lineJoin,i.miterLimit,i.lineDash,i.lineDashOffset]);for(var o=t.getEndss(),s=t.getOrientedFlatCoordinates(),a=t.getStride(),h=0,u=0,l=o.length;u<l;++u)h=this.drawFlatCoordinatess_(s,h,o[u],a);this.endGeometry(t,e)}},e.prototype.finish=function(){this.reverseHitDetectionInstructions(),this.state=null;var t=this.tolerance;if(0!==t)for(var e=this.coordinates,i=0,n=e.length;i<n;++i)e[i]=Cu(e[i],t)},e.prototype.setFillStrokeStyles_=function(t){var e=this.state;void 0!==e.fillStyle&&this.updateFillStyle(e,this.createFill,t),void 0!==e.strokeStyle&&this.updateStrokeStyle(e,this.applyStroke)},e}(kT);function $T(t,e,i,n,r){var o,s,a,h,u,l,c,p,f,d=i,g=i,y=0,v=0,_=i;for(o=i;o<n;o+=r){var m=e[o],E=e[o+1];void 0!==h&&(p=m-h,f=E-u,a=Math.sqrt(p*p+f*f),void 0!==l&&(v+=s,Math.acos((l*p+c*f)/(s*a))>t&&(v>y&&(y=v,d=_,g=o),v=0,_=o-r)),s=a,l=p,c=f),h=m,u=E}return(v+=a)>y?[_,o]:[d,g]}var VT={Circle:DT,Default:kT,Image:jT,LineString:GT,Polygon:DT,Text:function(t){function e(e,i,n,r,o,s){t.call(this,e,i,n,r,o,s),this.declutterGroup_,this.labels_=null,this.text_="",this.textOffsetX_=0,this.textOffsetY_=0,this.textRotateWithView_=void 0,this.textRotation_=0,this.textFillState_=null,this.fillStates={},this.textStrokeState_=null,this.strokeStates={},this.textState_={},this.textStates={},this.textKey_="",this.fillKey_="",this.strokeKey_="",this.widths_={},ua.prune()}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.drawText=function(t,e){var i=this.textFillState_,n=this.textStrokeState_,r=this.textState_;if(""!==this.text_&&r&&(i||n)){var o,s,a=this.coordinates.length,h=t.getType(),u=null,l=2,c=2;if(r.placement===hy){if(!Dn(this.getBufferedMaxExtent(),t.getExtent()))return;var p;if(u=t.getFlatCoordinates(),c=t.getStride(),h==Dr.LINE_STRING)p=[u.length];else if(h==Dr.MULTI_LINE_STRING)p=t.getEnds();else if(h==Dr.POLYGON)p=t.getEnds().slice(0,1);else if(h==Dr.MULTI_POLYGON){var f=t.getEndss();for(p=[],o=0,s=f.length;o<s;++o)p.push(f[o][0])}this.beginGeometry(t,e);for(var d,g=r.textAlign,y=0,v=0,_=p.length;v<_;++v){if(void 0==g){var m=$T(r.maxAngle,u,y,p[v],c);y=m[0],d=m[1]}else d=p[v];for(o=y;o<d;o+=c)this.coordinates.push(u[o],u[o+1]);l=this.coordinates.length,y=p[v],this.drawChars_(a,l,this.declutterGroup_),a=l}this.endGeometry(t,e)}else{var E=this.getImage(this.text_,this.textKey_,this.fillKey_,this.strokeKey_),x=E.width/this.pixelRatio;switch(h){case Dr.POINT:case Dr.MULTI_POINT:l=(u=t.getFlatCoordinates()).length;break;case Dr.LINE_STRING:u=t.getFlatMidpoint();break;case Dr.CIRCLE:u=t.getCenter();break;case Dr.MULTI_LINE_STRING:l=(u=t.getFlatMidpoints()).length;break;case Dr.POLYGON:if(u=t.getFlatInteriorPoint(),!r.overflow&&u[2]/this.resolution<x)return;c=3;break;case Dr.MULTI_POLYGON:var S=t.getFlatInteriorPoints();for(u=[],o=0,s=S.length;o<s;o+=3)(r.overflow||S[o+2]/this.resolution>=x)&&u.push(S[o],S[o+1]);if(0==(l=u.length))return}l=this.appendFlatCoordinates(u,0,l,c,!1,!1),(r.backgroundFill||r.backgroundStroke)&&(this.setFillStrokeStyle(r.backgroundFill,r.backgroundStroke),r.backgroundFill&&(this.updateFillStyle(this.state,this.createFill,t),this.hitDetectionInstructions.push(this.createFill(this.state,t))),r.backgroundStroke&&(this.updateStrokeStyle(this.state,this.applyStroke),this.hitDetectionInstructions.push(this.createStroke(this.state)))),this.beginGeometry(t,e),this.drawTextImage_(E,a,l),this.endGeometry(t,e)}}},e.prototype.getImage=function(t,e,i,n){var r,o=n+e+t+i+this.pixelRatio;if(!ua.containsKey(o)){var s=n?this.strokeStates[n]||this.textStrokeState_:null,a=i?this.fillStates[i]||this.textFillState_:null,h=this.textStates[e]||this.textState_,u=this.pixelRatio,l=h.scale*u,c=MT[h.textAlign||"center"],p=n&&s.lineWidth?s.lineWidth:0,f=t.split("\n"),d=f.length,g=[],y=function(t,e,i){for(var n=e.length,r=0,o=0;o<n;++o){var s=_a(t,e[o]);r=Math.max(r,s),i.push(s)}return r}(h.font,f,g),v=va(h.font),_=v*d,m=y+p,E=Rr(Math.ceil(m*l),Math.ceil((_+p)*l));r=E.canvas,ua.set(o,r),1!=l&&E.scale(l,l),E.font=h.font,n&&(E.strokeStyle=s.strokeStyle,E.lineWidth=p,E.lineCap=s.lineCap,E.lineJoin=s.lineJoin,E.miterLimit=s.miterLimit,Bs&&s.lineDash.length&&(E.setLineDash(s.lineDash),E.lineDashOffset=s.lineDashOffset)),i&&(E.fillStyle=a.fillStyle),E.textBaseline="middle",E.textAlign="center";var x,S=.5-c,T=c*r.width/l+S*p;if(n)for(x=0;x<d;++x)E.strokeText(f[x],T+S*g[x],.5*(p+v)+x*v);if(i)for(x=0;x<d;++x)E.fillText(f[x],T+S*g[x],.5*(p+v)+x*v)}return ua.get(o)},e.prototype.drawTextImage_=function(t,e,i){var n=this.textState_,r=this.textStrokeState_,o=this.pixelRatio,s=MT[n.textAlign||"center"],a=MT[n.textBaseline],h=r&&r.lineWidth?r.lineWidth:0,u=s*t.width/o+2*(.5-s)*h,l=a*t.height/o+2*(.5-a)*h;this.instructions.push([xT,e,i,t,(u-this.textOffsetX_)*o,(l-this.textOffsetY_)*o,this.declutterGroup_,t.height,1,0,0,this.textRotateWithView_,this.textRotation_,1,t.width,n.padding==ha?ha:n.padding.map(function(t){return t*o}),!!n.backgroundFill,!!n.backgroundStroke]),this.hitDetectionInstructions.push([xT,e,i,t,(u-this.textOffsetX_)*o,(l-this.textOffsetY_)*o,this.declutterGroup_,t.height,1,0,0,this.textRotateWithView_,this.textRotation_,1/o,t.width,n.padding,!!n.backgroundFill,!!n.backgroundStroke])},e.prototype.drawChars_=function(t,e,i){var n=this.textStrokeState_,r=this.textState_,o=this.textFillState_,s=this.strokeKey_;n&&(s in this.strokeStates||(this.strokeStates[s]={strokeStyle:n.strokeStyle,lineCap:n.lineCap,lineDashOffset:n.lineDashOffset,lineWidth:n.lineWidth,lineJoin:n.lineJoin,miterLimit:n.miterLimit,lineDash:n.lineDash}));var a=this.textKey_;this.textKey_ in this.textStates||(this.textStates[this.textKey_]={font:r.font,textAlign:r.textAlign||"center",scale:r.scale});var h=this.fillKey_;o&&(h in this.fillStates||(this.fillStates[h]={fillStyle:o.fillStyle}));var u=this.pixelRatio,l=MT[r.textBaseline],c=this.textOffsetY_*u,p=this.text_,f=r.font,d=r.scale,g=n?n.lineWidth*d/2:0,y=this.widths_[f];y||(this.widths_[f]=y={}),this.instructions.push([ET,t,e,l,i,r.overflow,h,r.maxAngle,function(t){var e=y[t];return e||(e=y[t]=_a(f,t)),e*d*u},c,s,g*u,p,a,1]),this.hitDetectionInstructions.push([ET,t,e,l,i,r.overflow,h,r.maxAngle,function(t){var e=y[t];return e||(e=y[t]=_a(f,t)),e*d},c,s,g,p,a,1/u])},e.prototype.setTextStyle=function(t,e){var i,n,r;if(t){this.declutterGroup_=e;var o=t.getFill();o?((n=this.textFillState_)||(n=this.textFillState_={}),n.fillStyle=js(o.getColor()||oa)):n=this.textFillState_=null;var s=t.getStroke();if(s){(r=this.textStrokeState_)||(r=this.textStrokeState_={});var a=s.getLineDash(),h=s.getLineDashOffset(),u=s.getWidth(),l=s.getMiterLimit();r.lineCap=s.getLineCap()||"round",r.lineDash=a?a.slice():sa,r.lineDashOffset=void 0===h?0:h,r.lineJoin=s.getLineJoin()||"round",r.lineWidth=void 0===u?1:u,r.miterLimit=void 0===l?10:l,r.strokeStyle=js(s.getColor()||aa)}else r=this.textStrokeState_=null;i=this.textState_;var c=t.getFont()||"10px sans-serif";fa(c);var p=t.getScale();i.overflow=t.getOverflow(),i.font=c,i.maxAngle=t.getMaxAngle(),i.placement=t.getPlacement(),i.textAlign=t.getTextAlign(),i.textBaseline=t.getTextBaseline()||"middle",i.backgroundFill=t.getBackgroundFill(),i.backgroundStroke=t.getBackgroundStroke(),i.padding=t.getPadding()||ha,i.scale=void 0===p?1:p;var f=t.getOffsetX(),d=t.getOffsetY(),g=t.getRotateWithView(),y=t.getRotation();this.text_=t.getText()||"",this.textOffsetX_=void 0===f?0:f,this.textOffsetY_=void 0===d?0:d,this.textRotateWithView_=void 0!==g&&g,this.textRotation_=void 0===y?0:y,this.strokeKey_=r?("string"==typeof r.strokeStyle?r.strokeStyle:nn(r.strokeStyle))+r.lineCap+r.lineDashOffset+"|"+r.lineWidth+r.lineJoin+r.miterLimit+"["+r.lineDash.join()+"]":"",this.textKey_=i.font+i.scale+(i.textAlign||"?"),this.fillKey_=n?"string"==typeof n.fillStyle?n.fillStyle:"|"+nn(n.fillStyle):""}else this.text_=""},e}(kT)},zT=function(t){function e(e,i,n,r,o,s,a){t.call(this),this.declutterTree_=s,this.declutterGroup_=null,this.tolerance_=e,this.maxExtent_=i,this.overlaps_=o,this.pixelRatio_=r,this.resolution_=n,this.renderBuffer_=a,this.replaysByZIndex_={},this.hitDetectionContext_=Rr(1,1),this.hitDetectionTransform_=[1,0,0,1,0,0]}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.addDeclutter=function(t){var e=null;return this.declutterTree_&&(t?(e=this.declutterGroup_)[4]++:(e=this.declutterGroup_=[1/0,1/0,-1/0,-1/0]).push(1)),e},e.prototype.clip=function(t,e){var i=this.getClipCoords(e);t.beginPath(),t.moveTo(i[0],i[1]),t.lineTo(i[2],i[3]),t.lineTo(i[4],i[5]),t.lineTo(i[6],i[7]),t.clip()},e.prototype.hasReplays=function(t){for(var e in this.replaysByZIndex_)for(var i=this.replaysByZIndex_[e],n=0,r=t.length;n<r;++n)if(t[n]in i)return!0;return!1},e.prototype.finish=function(){for(var t in this.replaysByZIndex_){var e=this.replaysByZIndex_[t];for(var i in e)e[i].finish()}},e.prototype.forEachFeatureAtCoordinate=function(t,e,i,n,r,o,s){var a,h=2*(n=Math.round(n))+1,u=Os(this.hitDetectionTransform_,n+.5,n+.5,1/e,-1/e,-i,-t[0],-t[1]),l=this.hitDetectionContext_;l.canvas.width!==h||l.canvas.height!==h?(l.canvas.width=h,l.canvas.height=h):l.clearRect(0,0,h,h),void 0!==this.renderBuffer_&&(bn(a=[1/0,1/0,-1/0,-1/0],t),ln(a,e*(this.renderBuffer_+n),a));var c,p,f=function(t){if(void 0!==YT[t])return YT[t];for(var e=2*t+1,i=new Array(e),n=0;n<e;n++)i[n]=new Array(e);var r=t,o=0,s=0;for(;r>=o;)XT(i,t+r,t+o),XT(i,t+o,t+r),XT(i,t-o,t+r),XT(i,t-r,t+o),XT(i,t-r,t-o),XT(i,t-o,t-r),XT(i,t+o,t-r),XT(i,t+r,t-o),2*((s+=1+2*++o)-r)+1>0&&(s+=1-2*(r-=1));return YT[t]=i,i}(n);function d(t){for(var e=l.getImageData(0,0,h,h).data,i=0;i<h;i++)for(var n=0;n<h;n++)if(f[i][n]&&e[4*(n*h+i)+3]>0){var r=void 0;return(!c||p!=fT.IMAGE&&p!=fT.TEXT||-1!==c.indexOf(t))&&(r=o(t)),r||void l.clearRect(0,0,h,h)}}this.declutterTree_&&(c=this.declutterTree_.all().map(function(t){return t.value}));var g,y,v,_,m,E=Object.keys(this.replaysByZIndex_).map(Number);for(E.sort(Uo),g=E.length-1;g>=0;--g){var x=E[g].toString();for(v=this.replaysByZIndex_[x],y=LT.length-1;y>=0;--y)if(void 0!==(_=v[p=LT[y]]))if(!s||p!=fT.IMAGE&&p!=fT.TEXT){if(m=_.replayHitDetection(l,u,i,r,d,a))return m}else{var S=s[x];S?S.push(_,u.slice(0)):s[x]=[_,u.slice(0)]}}},e.prototype.getClipCoords=function(t){var e=this.maxExtent_,i=e[0],n=e[1],r=e[2],o=e[3],s=[i,n,i,o,r,o,r,n];return Es(s,0,8,2,t,s),s},e.prototype.getReplay=function(t,e){var i=void 0!==t?t.toString():"0",n=this.replaysByZIndex_[i];void 0===n&&(n={},this.replaysByZIndex_[i]=n);var r=n[e];void 0===r&&(r=new(0,VT[e])(this.tolerance_,this.maxExtent_,this.resolution_,this.pixelRatio_,this.overlaps_,this.declutterTree_),n[e]=r);return r},e.prototype.getReplays=function(){return this.replaysByZIndex_},e.prototype.isEmpty=function(){return tr(this.replaysByZIndex_)},e.prototype.replay=function(t,e,i,n,r,o,s){var a=Object.keys(this.replaysByZIndex_).map(Number);a.sort(Uo),t.save(),this.clip(t,e);var h,u,l,c,p,f,d=o||LT;for(h=0,u=a.length;h<u;++h){var g=a[h].toString();for(p=this.replaysByZIndex_[g],l=0,c=d.length;l<c;++l){var y=d[l];if(f=p[y],void 0!==f)if(!s||y!=fT.IMAGE&&y!=fT.TEXT)f.replay(t,e,i,n,r);else{var v=s[g];v?v.push(f,e.slice(0)):s[g]=[f,e.slice(0)]}}}t.restore()},e}(pT),YT={0:[[!0]]};function XT(t,e,i){var n,r=Math.floor(t.length/2);if(e>=r)for(n=r;n<e;n++)t[n][i]=!0;else if(e<r)for(n=e+1;n<r;n++)t[n][i]=!0}var UT=.5,BT={Point:function(t,e,i,n){var r=i.getImage();if(r){if(r.getImageState()!=qs.LOADED)return;var o=t.getReplay(i.getZIndex(),fT.IMAGE);o.setImageStyle(r,t.addDeclutter(!1)),o.drawPoint(e,n)}var s=i.getText();if(s){var a=t.getReplay(i.getZIndex(),fT.TEXT);a.setTextStyle(s,t.addDeclutter(!!r)),a.drawText(e,n)}},LineString:function(t,e,i,n){var r=i.getStroke();if(r){var o=t.getReplay(i.getZIndex(),fT.LINE_STRING);o.setFillStrokeStyle(null,r),o.drawLineString(e,n)}var s=i.getText();if(s){var a=t.getReplay(i.getZIndex(),fT.TEXT);a.setTextStyle(s,t.addDeclutter(!1)),a.drawText(e,n)}},Polygon:function(t,e,i,n){var r=i.getFill(),o=i.getStroke();if(r||o){var s=t.getReplay(i.getZIndex(),fT.POLYGON);s.setFillStrokeStyle(r,o),s.drawPolygon(e,n)}var a=i.getText();if(a){var h=t.getReplay(i.getZIndex(),fT.TEXT);h.setTextStyle(a,t.addDeclutter(!1)),h.drawText(e,n)}},MultiPoint:function(t,e,i,n){var r=i.getImage();if(r){if(r.getImageState()!=qs.LOADED)return;var o=t.getReplay(i.getZIndex(),fT.IMAGE);o.setImageStyle(r,t.addDeclutter(!1)),o.drawMultiPoint(e,n)}var s=i.getText();if(s){var a=t.getReplay(i.getZIndex(),fT.TEXT);a.setTextStyle(s,t.addDeclutter(!!r)),a.drawText(e,n)}},MultiLineString:function(t,e,i,n){var r=i.getStroke();if(r){var o=t.getReplay(i.getZIndex(),fT.LINE_STRING);o.setFillStrokeStyle(null,r),o.drawMultiLineString(e,n)}var s=i.getText();if(s){var a=t.getReplay(i.getZIndex(),fT.TEXT);a.setTextStyle(s,t.addDeclutter(!1)),a.drawText(e,n)}},MultiPolygon:function(t,e,i,n){var r=i.getFill(),o=i.getStroke();if(o||r){var s=t.getReplay(i.getZIndex(),fT.POLYGON);s.setFillStrokeStyle(r,o),s.drawMultiPolygon(e,n)}var a=i.getText();if(a){var h=t.getReplay(i.getZIndex(),fT.TEXT);h.setTextStyle(a,t.addDeclutter(!1)),h.drawText(e,n)}},GeometryCollection:function(t,e,i,n){var r,o,s=e.getGeometriesArray();for(r=0,o=s.length;r<o;++r){var a=BT[s[r].getType()];a(t,s[r],i,n)}},Circle:function(t,e,i,n){var r=i.getFill(),o=i.getStroke();if(r||o){var s=t.getReplay(i.getZIndex(),fT.CIRCLE);s.setFillStrokeStyle(r,o),s.drawCircle(e,n)}var a=i.getText();if(a){var h=t.getReplay(i.getZIndex(),fT.TEXT);h.setTextStyle(a,t.addDeclutter(!1)),h.drawText(e,n)}}};function KT(t,e){return nn(t)-nn(e)}function WT(t,e){var i=HT(t,e);return i*i}function HT(t,e){return UT*t/e}function ZT(t,e,i,n,r,o){var s=!1,a=i.getImage();if(a){var h=a.getImageState();h==qs.LOADED||h==qs.ERROR?a.unlistenImageChange(r,o):(h==qs.IDLE&&a.load(),h=a.getImageState(),a.listenImageChange(r,o),s=!0)}return function(t,e,i,n){var r=i.getGeometryFunction()(e);if(!r)return;var o=r.getSimplifiedGeometry(n);if(i.getRenderer())!function t(e,i,n,r){if(i.getType()==Dr.GEOMETRY_COLLECTION){for(var o=i.getGeometries(),s=0,a=o.length;s<a;++s)t(e,o[s],n,r);return}var h=e.getReplay(n.getZIndex(),fT.DEFAULT);h.drawCustom(i,r,n.getRenderer())}(t,o,i,e);else{var s=BT[o.getType()];s(t,o,i,e)}}(t,e,i,n),s}var qT=function(t){function e(e){t.call(this,e),this.declutterTree_=e.getDeclutter()?Dv(9,void 0):null,this.dirty_=!1,this.renderedRevision_=-1,this.renderedResolution_=NaN,this.renderedExtent_=[1/0,1/0,-1/0,-1/0],this.renderedRenderOrder_=null,this.replayGroup_=null,this.replayGroupChanged=!0,this.context=Rr(),gr(ua,br.CLEAR,this.handleFontsChanged_,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){vr(ua,br.CLEAR,this.handleFontsChanged_,this),t.prototype.disposeInternal.call(this)},e.prototype.compose=function(t,e,i){var n=e.extent,r=e.pixelRatio,o=i.managed?e.skippedFeatureUids:{},s=e.viewState,a=s.projection,h=s.rotation,u=a.getExtent(),l=this.getLayer().getSource(),c=this.getTransform(e,0),p=i.extent,f=void 0!==p;f&&this.clip(t,e,p);var d=this.replayGroup_;if(d&&!d.isEmpty()){this.declutterTree_&&this.declutterTree_.clear();var g,y=this.getLayer(),v=0,_=0,m=1!==i.opacity,E=y.hasListener(q_);if(m||E){var x=t.canvas.width,S=t.canvas.height;if(h){var T=Math.round(Math.sqrt(x*x+S*S));v=(T-x)/2,_=(T-S)/2,x=S=T}this.context.canvas.width=x,this.context.canvas.height=S,g=this.context}else g=t;var w=g.globalAlpha;m||(g.globalAlpha=i.opacity),g!=t&&g.translate(v,_);var b=e.viewHints,C=!(b[vx.ANIMATING]||b[vx.INTERACTING]),R=e.size[0]*r,O=e.size[1]*r;if(ma(g,-h,R/2,O/2),d.replay(g,c,h,o,C),l.getWrapX()&&a.canWrapX()&&!dn(u,n)){for(var I,P=n[0],F=Gn(u),L=0;P<u[0];)I=F*--L,c=this.getTransform(e,I),d.replay(g,c,h,o,C),P+=F;for(L=0,P=n[2];P>u[2];)I=F*++L,c=this.getTransform(e,I),d.replay(g,c,h,o,C),P-=F}if(ma(g,h,R/2,O/2),E&&this.dispatchRenderEvent(g,e,c),g!=t){if(m){var M=t.globalAlpha;t.globalAlpha=i.opacity,t.drawImage(g.canvas,-v,-_),t.globalAlpha=M}else t.drawImage(g.canvas,-v,-_);g.translate(-v,-_)}m||(g.globalAlpha=w)}f&&t.restore()},e.prototype.composeFrame=function(t,e,i){var n=this.getTransform(t,0);this.preCompose(i,t,n),this.compose(i,t,e),this.postCompose(i,t,e,n)},e.prototype.forEachFeatureAtCoordinate=function(t,e,i,n,r){if(this.replayGroup_){var o=e.viewState.resolution,s=e.viewState.rotation,a=this.getLayer(),h={};return this.replayGroup_.forEachFeatureAtCoordinate(t,o,s,i,{},function(t){var e=nn(t).toString();if(!(e in h))return h[e]=!0,n.call(r,t,a)},null)}},e.prototype.handleFontsChanged_=function(t){var e=this.getLayer();e.getVisible()&&this.replayGroup_&&e.changed()},e.prototype.handleStyleImageChange_=function(t){this.renderIfReadyAndVisible()},e.prototype.prepareFrame=function(t,e){var i=this.getLayer(),n=i.getSource(),r=t.viewHints[vx.ANIMATING],o=t.viewHints[vx.INTERACTING],s=i.getUpdateWhileAnimating(),a=i.getUpdateWhileInteracting();if(!this.dirty_&&!s&&r||!a&&o)return!0;var h=t.extent,u=t.viewState,l=u.projection,c=u.resolution,p=t.pixelRatio,f=i.getRevision(),d=i.getRenderBuffer(),g=i.getRenderOrder();void 0===g&&(g=KT);var y=ln(h,d*c),v=u.projection.getExtent();if(n.getWrapX()&&u.projection.canWrapX()&&!dn(v,t.extent)){var _=Gn(v),m=Math.max(Gn(y)/2,_);y[0]=v[0]-m,y[2]=v[2]+m}if(!this.dirty_&&this.renderedResolution_==c&&this.renderedRevision_==f&&this.renderedRenderOrder_==g&&dn(this.renderedExtent_,y))return this.replayGroupChanged=!1,!0;this.replayGroup_=null,this.dirty_=!1;var E=new zT(HT(c,p),y,c,p,n.getOverlaps(),this.declutterTree_,i.getRenderBuffer());n.loadFeatures(y,c,l);var x=function(t){var e,n=t.getStyleFunction()||i.getStyleFunction();if(n&&(e=n(t,c)),e){var r=this.renderFeature(t,c,p,e,E);this.dirty_=this.dirty_||r}}.bind(this);if(g){var S=[];n.forEachFeatureInExtent(y,function(t){S.push(t)},this),S.sort(g);for(var T=0,w=S.length;T<w;++T)x(S[T])}else n.forEachFeatureInExtent(y,x,this);return E.finish(),this.renderedResolution_=c,this.renderedRevision_=f,this.renderedRenderOrder_=g,this.renderedExtent_=y,this.replayGroup_=E,this.replayGroupChanged=!0,!0},e.prototype.renderFeature=function(t,e,i,n,r){if(!n)return!1;var o=!1;if(Array.isArray(n))for(var s=0,a=n.length;s<a;++s)o=ZT(r,t,n[s],WT(e,i),this.handleStyleImageChange_,this)||o;else o=ZT(r,t,n,WT(e,i),this.handleStyleImageChange_,this);return o},e}(hT);qT.handles=function(t){return t.getType()===W_.VECTOR},qT.create=function(t,e){return new qT(e)};var JT={IMAGE:"image",HYBRID:"hybrid",VECTOR:"vector"},QT={image:[fT.POLYGON,fT.CIRCLE,fT.LINE_STRING,fT.IMAGE,fT.TEXT],hybrid:[fT.POLYGON,fT.LINE_STRING]},tw={image:[fT.DEFAULT],hybrid:[fT.IMAGE,fT.TEXT,fT.DEFAULT],vector:LT},ew=function(t){function e(e){t.call(this,e,!0),this.declutterTree_=e.getDeclutter()?Dv(9,void 0):null,this.dirty_=!1,this.renderedLayerRevision_,this.tmpTransform_=[1,0,0,1,0,0],this.zDirection=e.getRenderMode()==JT.VECTOR?1:0,gr(ua,br.CLEAR,this.handleFontsChanged_,this)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){vr(ua,br.CLEAR,this.handleFontsChanged_,this),t.prototype.disposeInternal.call(this)},e.prototype.getTile=function(e,i,n,r,o){var s=t.prototype.getTile.call(this,e,i,n,r,o);return s.getState()===or.LOADED&&(this.createReplayGroup_(s,r,o),this.context&&this.renderTileImage_(s,r,o)),s},e.prototype.prepareFrame=function(e,i){var n=this.getLayer(),r=n.getRevision();if(this.renderedLayerRevision_!=r){this.renderedTiles.length=0;var o=n.getRenderMode();this.context||o==JT.VECTOR||(this.context=Rr()),this.context&&o==JT.VECTOR&&(this.context=null)}return this.renderedLayerRevision_=r,t.prototype.prepareFrame.call(this,e,i)},e.prototype.createReplayGroup_=function(t,e,i){var n=this,r=this.getLayer(),o=r.getRevision(),s=r.getRenderOrder()||null,a=t.getReplayState(r);if(a.dirty||a.renderedRevision!=o||a.renderedRenderOrder!=s){for(var h=r.getSource(),u=h.getTileGrid(),l=h.getTileGridForProjection(i).getResolution(t.tileCoord[0]),c=t.extent,p=function(o,p){var f=t.getTile(t.tileKeys[o]);if(f.getState()==or.LOADED){var d=f.tileCoord,g=u.getTileCoordExtent(d),y=Nn(c,g),v=Tn(g,y)?null:ln(y,r.getRenderBuffer()*l,n.tmpExtent),_=f.getProjection(),m=!1;_o(i,_)||(m=!0,f.setProjection(i)),a.dirty=!1;var E=new zT(0,y,l,e,h.getOverlaps(),n.declutterTree_,r.getRenderBuffer()),x=WT(l,e),S=function(t){var e,i=t.getStyleFunction()||r.getStyleFunction();if(i&&(e=i(t,l)),e){var n=this.renderFeature(t,x,e,E);this.dirty_=this.dirty_||n,a.dirty=a.dirty||n}},T=f.getFeatures();s&&s!==a.renderedRenderOrder&&T.sort(s);for(var w=0,b=T.length;w<b;++w){var C=T[w];m&&(_.getUnits()==Yr.TILE_PIXELS&&(_.setWorldExtent(g),_.setExtent(f.getExtent())),C.getGeometry().transform(_,i)),v&&!Dn(v,C.getGeometry().getExtent())||S.call(n,C)}for(var R in E.finish(),E.getReplays());f.setReplayGroup(r,t.tileCoord.toString(),E)}},f=0,d=t.tileKeys.length;f<d;++f)p(f);a.renderedRevision=o,a.renderedRenderOrder=s}},e.prototype.forEachFeatureAtCoordinate=function(t,e,i,n,r){var o=e.viewState.resolution,s=e.viewState.rotation;i=void 0==i?0:i;var a,h,u,l,c,p=this.getLayer(),f={},d=this.renderedTiles;for(u=0,l=d.length;u<l;++u){var g=d[u];if(fn(a=ln(g.extent,i*o,a),t))for(var y=0,v=g.tileKeys.length;y<v;++y){var _=g.getTile(g.tileKeys[y]);_.getState()==or.LOADED&&(c=_.getReplayGroup(p,g.tileCoord.toString()),h=h||c.forEachFeatureAtCoordinate(t,o,s,i,{},function(t){var e=nn(t).toString();if(!(e in f))return f[e]=!0,n.call(r,t,p)},null))}}return h},e.prototype.getReplayTransform_=function(t,e){var i=this.getLayer().getSource().getTileGrid(),n=t.tileCoord,r=i.getResolution(n[0]),o=e.viewState,s=e.pixelRatio,a=o.resolution/s,h=i.getTileCoordExtent(n,this.tmpExtent),u=o.center,l=kn(h),c=e.size,p=Math.round(s*c[0]/2),f=Math.round(s*c[1]/2);return Os(this.tmpTransform_,p,f,r/a,r/a,o.rotation,(l[0]-u[0])/r,(u[1]-l[1])/r)},e.prototype.handleFontsChanged_=function(t){var e=this.getLayer();e.getVisible()&&void 0!==this.renderedLayerRevision_&&e.changed()},e.prototype.handleStyleImageChange_=function(t){this.renderIfReadyAndVisible()},e.prototype.postCompose=function(e,i,n){var r=this.getLayer(),o=r.getRenderMode();if(o!=JT.IMAGE){var s,a,h=r.getDeclutter()?{}:null,u=r.getSource(),l=tw[o],c=i.pixelRatio,p=i.viewState.rotation,f=i.size;p&&ma(e,-p,s=Math.round(c*f[0]/2),a=Math.round(c*f[1]/2)),h&&this.declutterTree_.clear();for(var d=i.viewHints,g=!(d[vx.ANIMATING]||d[vx.INTERACTING]),y=this.renderedTiles,v=u.getTileGridForProjection(i.viewState.projection),_=[],m=[],E=y.length-1;E>=0;--E){var x=y[E];if(x.getState()!=or.ABORT)for(var S=x.tileCoord,T=v.getTileCoordExtent(S,this.tmpExtent)[0]-x.extent[0],w=void 0,b=0,C=x.tileKeys.length;b<C;++b){var R=x.getTile(x.tileKeys[b]);if(R.getState()==or.LOADED){var O=R.getReplayGroup(r,S.toString());if(O&&O.hasReplays(l)){w||(w=this.getTransform(i,T));var I=R.tileCoord[0],P=O.getClipCoords(w);e.save(),e.globalAlpha=n.opacity;for(var F=0,L=_.length;F<L;++F){var M=_[F];I<m[F]&&(e.beginPath(),e.moveTo(P[0],P[1]),e.lineTo(P[2],P[3]),e.lineTo(P[4],P[5]),e.lineTo(P[6],P[7]),e.moveTo(M[6],M[7]),e.lineTo(M[4],M[5]),e.lineTo(M[2],M[3]),e.lineTo(M[0],M[1]),e.clip())}O.replay(e,w,p,{},g,l,h),e.restore(),_.push(P),m.push(I)}}}}h&&function(t,e,i,n){for(var r=Object.keys(t).map(Number).sort(Uo),o={},s=0,a=r.length;s<a;++s)for(var h=t[r[s].toString()],u=0,l=h.length;u<l;){var c=h[u++],p=h[u++];c.replay(e,p,i,o,n)}}(h,e,p,g),p&&ma(e,p,s,a)}t.prototype.postCompose.call(this,e,i,n)},e.prototype.renderFeature=function(t,e,i,n){if(!i)return!1;var r=!1;if(Array.isArray(i))for(var o=0,s=i.length;o<s;++o)r=ZT(n,t,i[o],e,this.handleStyleImageChange_,this)||r;else r=ZT(n,t,i,e,this.handleStyleImageChange_,this);return r},e.prototype.renderTileImage_=function(t,e,i){var n=this.getLayer(),r=t.getReplayState(n),o=n.getRevision(),s=QT[n.getRenderMode()];if(s&&r.renderedTileRevision!==o){r.renderedTileRevision=o;var a=t.wrappedTileCoord,h=a[0],u=n.getSource(),l=u.getTileGridForProjection(i),c=l.getResolution(h),p=t.getContext(n),f=u.getTilePixelSize(h,e,i);p.canvas.width=f[0],p.canvas.height=f[1];for(var d=l.getTileCoordExtent(a,this.tmpExtent),g=0,y=t.tileKeys.length;g<y;++g){var v=t.getTile(t.tileKeys[g]);if(v.getState()==or.LOADED){var _=e/c,m=Ts(this.tmpTransform_,1,0,0,1,0,0);Cs(m,_,-_),Rs(m,-d[0],-d[3]),v.getReplayGroup(n,t.tileCoord.toString()).replay(p,m,0,{},!0,s)}}}},e}(cT);ew.handles=function(t){return t.getType()===W_.VECTOR_TILE},ew.create=function(t,e){return new ew(e)};var iw=function(t){function e(e){(e=qn({},e)).controls||(e.controls=Ix()),e.interactions||(e.interactions=function(t){var e=t||{},i=new Nv,n=new Px(-.005,.05,100);return(void 0===e.altShiftDragRotate||e.altShiftDragRotate)&&i.push(new jx),(void 0===e.doubleClickZoom||e.doubleClickZoom)&&i.push(new Fx({delta:e.zoomDelta,duration:e.zoomDuration})),(void 0===e.dragPan||e.dragPan)&&i.push(new Mx({condition:e.onFocusOnly?y_:void 0,kinetic:n})),(void 0===e.pinchRotate||e.pinchRotate)&&i.push(new rS),(void 0===e.pinchZoom||e.pinchZoom)&&i.push(new hS({constrainResolution:e.constrainResolution,duration:e.zoomDuration})),(void 0===e.keyboard||e.keyboard)&&(i.push(new qx),i.push(new Qx({delta:e.zoomDelta,duration:e.zoomDuration}))),(void 0===e.mouseWheelZoom||e.mouseWheelZoom)&&i.push(new iS({condition:e.onFocusOnly?y_:void 0,constrainResolution:e.constrainResolution,duration:e.zoomDuration})),(void 0===e.shiftDragZoom||e.shiftDragZoom)&&i.push(new Wx({duration:e.zoomDuration})),i}()),t.call(this,e)}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.createRenderer=function(){var t=new sT(this);return t.registerLayerRenderers([lT,cT,qT,ew]),t},e}(bx);!function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),render:i.render||nw,target:i.target}),this.collapsed_=void 0===i.collapsed||i.collapsed,this.collapsible_=void 0===i.collapsible||i.collapsible,this.collapsible_||(this.collapsed_=!1);var n=void 0!==i.className?i.className:"ol-overviewmap",r=void 0!==i.tipLabel?i.tipLabel:"Overview map",o=void 0!==i.collapseLabel?i.collapseLabel:"«";"string"==typeof o?(this.collapseLabel_=document.createElement("span"),this.collapseLabel_.textContent=o):this.collapseLabel_=o;var s=void 0!==i.label?i.label:"»";"string"==typeof s?(this.label_=document.createElement("span"),this.label_.textContent=s):this.label_=s;var a=this.collapsible_&&!this.collapsed_?this.collapseLabel_:this.label_,h=document.createElement("button");h.setAttribute("type","button"),h.title=r,h.appendChild(a),gr(h,br.CLICK,this.handleClick_,this),this.ovmapDiv_=document.createElement("div"),this.ovmapDiv_.className="ol-overviewmap-map",this.ovmap_=new iw({controls:new Nv,interactions:new Nv,view:i.view});var u=this.ovmap_;i.layers&&i.layers.forEach(function(t){u.addLayer(t)}.bind(this));var l=document.createElement("div");l.className="ol-overviewmap-box",l.style.boxSizing="border-box",this.boxOverlay_=new xg({position:[0,0],positioning:mg.BOTTOM_LEFT,element:l}),this.ovmap_.addOverlay(this.boxOverlay_);var c=n+" "+ta+" "+ia+(this.collapsed_&&this.collapsible_?" "+na:"")+(this.collapsible_?"":" ol-uncollapsible"),p=this.element;p.className=c,p.appendChild(this.ovmapDiv_),p.appendChild(h);var f=this,d=this.boxOverlay_,g=this.boxOverlay_.getElement(),y=function(t){var e,i=u.getEventCoordinate({clientX:(e=t).clientX-g.offsetWidth/2,clientY:e.clientY+g.offsetHeight/2});d.setPosition(i)},v=function(t){var e=u.getEventCoordinate(t);f.getMap().getView().setCenter(e),window.removeEventListener("mousemove",y),window.removeEventListener("mouseup",v)};g.addEventListener("mousedown",function(){window.addEventListener("mousemove",y),window.addEventListener("mouseup",v)})}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setMap=function(e){var i=this.getMap();if(e!==i){if(i){var n=i.getView();n&&this.unbindView_(n),this.ovmap_.setTarget(null)}if(t.prototype.setMap.call(this,e),e){this.ovmap_.setTarget(this.ovmapDiv_),this.listenerKeys.push(gr(e,Mo,this.handleMapPropertyChange_,this)),0===this.ovmap_.getLayers().getLength()&&this.ovmap_.setLayerGroup(e.getLayerGroup());var r=e.getView();r&&(this.bindView_(r),r.isDef()&&(this.ovmap_.updateSize(),this.resetExtent_()))}}},e.prototype.handleMapPropertyChange_=function(t){if(t.key===cx.VIEW){var e=t.oldValue;e&&this.unbindView_(e);var i=this.getMap().getView();this.bindView_(i)}},e.prototype.bindView_=function(t){gr(t,Go(Ex),this.handleRotationChanged_,this)},e.prototype.unbindView_=function(t){vr(t,Go(Ex),this.handleRotationChanged_,this)},e.prototype.handleRotationChanged_=function(){this.ovmap_.getView().setRotation(this.getMap().getView().getRotation())},e.prototype.validateExtent_=function(){var t=this.getMap(),e=this.ovmap_;if(t.isRendered()&&e.isRendered()){var i=t.getSize(),n=t.getView().calculateExtent(i),r=e.getSize(),o=e.getView().calculateExtent(r),s=e.getPixelFromCoordinate(kn(n)),a=e.getPixelFromCoordinate(Fn(n)),h=Math.abs(s[0]-a[0]),u=Math.abs(s[1]-a[1]),l=r[0],c=r[1];h<.1*l||u<.1*c||h>.75*l||u>.75*c?this.resetExtent_():dn(o,n)||this.recenter_()}},e.prototype.resetExtent_=function(){var t=this.getMap(),e=this.ovmap_,i=t.getSize(),n=t.getView().calculateExtent(i),r=e.getView(),o=Math.log(7.5)/Math.LN2;Vn(n,1/(.1*Math.pow(2,o/2))),r.fit(n)},e.prototype.recenter_=function(){var t=this.getMap(),e=this.ovmap_,i=t.getView();e.getView().setCenter(i.getCenter())},e.prototype.updateBox_=function(){var t=this.getMap(),e=this.ovmap_;if(t.isRendered()&&e.isRendered()){var i=t.getSize(),n=t.getView(),r=e.getView(),o=n.getRotation(),s=this.boxOverlay_,a=this.boxOverlay_.getElement(),h=n.calculateExtent(i),u=r.getResolution(),l=Pn(h),c=jn(h),p=this.calculateCoordinateRotate_(o,l);s.setPosition(p),a&&(a.style.width=Math.abs((l[0]-c[0])/u)+"px",a.style.height=Math.abs((c[1]-l[1])/u)+"px")}},e.prototype.calculateCoordinateRotate_=function(t,e){var i,n=this.getMap().getView().getCenter();return n&&(Rv(i=[e[0]-n[0],e[1]-n[1]],t),Tv(i,n)),i},e.prototype.handleClick_=function(t){t.preventDefault(),this.handleToggle_()},e.prototype.handleToggle_=function(){this.element.classList.toggle(na),this.collapsed_?Or(this.collapseLabel_,this.label_):Or(this.label_,this.collapseLabel_),this.collapsed_=!this.collapsed_;var t=this.ovmap_;this.collapsed_||t.isRendered()||(t.updateSize(),this.resetExtent_(),yr(t,_g.POSTRENDER,function(t){this.updateBox_()},this))},e.prototype.getCollapsible=function(){return this.collapsible_},e.prototype.setCollapsible=function(t){this.collapsible_!==t&&(this.collapsible_=t,this.element.classList.toggle("ol-uncollapsible"),!t&&this.collapsed_&&this.handleToggle_())},e.prototype.setCollapsed=function(t){this.collapsible_&&this.collapsed_!==t&&this.handleToggle_()},e.prototype.getCollapsed=function(){return this.collapsed_},e.prototype.getOverviewMap=function(){return this.ovmap_}}(RE);function nw(t){this.validateExtent_(),this.updateBox_()}var rw="units",ow={DEGREES:"degrees",IMPERIAL:"imperial",NAUTICAL:"nautical",METRIC:"metric",US:"us"},sw=[1,2,5];!function(t){function e(e){var i=e||{},n=void 0!==i.className?i.className:"ol-scale-line";t.call(this,{element:document.createElement("div"),render:i.render||aw,target:i.target}),this.innerElement_=document.createElement("div"),this.innerElement_.className=n+"-inner",this.element.className=n+" "+ta,this.element.appendChild(this.innerElement_),this.viewState_=null,this.minWidth_=void 0!==i.minWidth?i.minWidth:64,this.renderedVisible_=!1,this.renderedWidth_=void 0,this.renderedHTML_="",gr(this,Go(rw),this.handleUnitsChanged_,this),this.setUnits(i.units||ow.METRIC)}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getUnits=function(){return this.get(rw)},e.prototype.handleUnitsChanged_=function(){this.updateElement_()},e.prototype.setUnits=function(t){this.set(rw,t)},e.prototype.updateElement_=function(){var t=this.viewState_;if(t){var e=t.center,i=t.projection,n=this.getUnits(),r=n==ow.DEGREES?Yr.DEGREES:Yr.METERS,o=go(i,t.resolution,e,r);i.getUnits()!=Yr.DEGREES&&i.getMetersPerUnit()&&r==Yr.METERS&&(o*=i.getMetersPerUnit());var s=this.minWidth_*o,a="";if(n==ow.DEGREES){var h=Xr[Yr.DEGREES];i.getUnits()==Yr.DEGREES?s*=h:o/=h,s<h/60?(a="″",o*=3600):s<h?(a="′",o*=60):a="°"}else n==ow.IMPERIAL?s<.9144?(a="in",o/=.0254):s<1609.344?(a="ft",o/=.3048):(a="mi",o/=1609.344):n==ow.NAUTICAL?(o/=1852,a="nm"):n==ow.METRIC?s<.001?(a="μm",o*=1e6):s<1?(a="mm",o*=1e3):s<1e3?a="m":(a="km",o/=1e3):n==ow.US?s<.9144?(a="in",o*=39.37):s<1609.344?(a="ft",o/=.30480061):(a="mi",o/=1609.3472):sn(!1,33);for(var u,l,c=3*Math.floor(Math.log(this.minWidth_*o)/Math.log(10));;){if(u=sw[(c%3+3)%3]*Math.pow(10,Math.floor(c/3)),l=Math.round(u/o),isNaN(l))return this.element.style.display="none",void(this.renderedVisible_=!1);if(l>=this.minWidth_)break;++c}var p=u+" "+a;this.renderedHTML_!=p&&(this.innerElement_.innerHTML=p,this.renderedHTML_=p),this.renderedWidth_!=l&&(this.innerElement_.style.width=l+"px",this.renderedWidth_=l),this.renderedVisible_||(this.element.style.display="",this.renderedVisible_=!0)}else this.renderedVisible_&&(this.element.style.display="none",this.renderedVisible_=!1)}}(RE);function aw(t){var e=t.frameState;this.viewState_=e?e.viewState:null,this.updateElement_()}var hw={VERTICAL:0,HORIZONTAL:1};!function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),render:i.render||uw}),this.currentResolution_=void 0,this.direction_=hw.VERTICAL,this.dragging_,this.heightLimit_=0,this.widthLimit_=0,this.previousX_,this.previousY_,this.thumbSize_=null,this.sliderInitialized_=!1,this.duration_=void 0!==i.duration?i.duration:200;var n=void 0!==i.className?i.className:"ol-zoomslider",r=document.createElement("button");r.setAttribute("type","button"),r.className=n+"-thumb "+ta;var o=this.element;o.className=n+" "+ta+" "+ia,o.appendChild(r),this.dragger_=new ux(o),gr(this.dragger_,NE.POINTERDOWN,this.handleDraggerStart_,this),gr(this.dragger_,NE.POINTERMOVE,this.handleDraggerDrag_,this),gr(this.dragger_,NE.POINTERUP,this.handleDraggerEnd_,this),gr(o,br.CLICK,this.handleContainerClick_,this),gr(r,br.CLICK,Tr)}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.dragger_.dispose(),t.prototype.disposeInternal.call(this)},e.prototype.setMap=function(e){t.prototype.setMap.call(this,e),e&&e.render()},e.prototype.initSlider_=function(){var t=this.element,e=t.offsetWidth,i=t.offsetHeight,n=t.firstElementChild,r=getComputedStyle(n),o=n.offsetWidth+parseFloat(r.marginRight)+parseFloat(r.marginLeft),s=n.offsetHeight+parseFloat(r.marginTop)+parseFloat(r.marginBottom);this.thumbSize_=[o,s],e>i?(this.direction_=hw.HORIZONTAL,this.widthLimit_=e-o):(this.direction_=hw.VERTICAL,this.heightLimit_=i-s),this.sliderInitialized_=!0},e.prototype.handleContainerClick_=function(t){var e=this.getMap().getView(),i=this.getRelativePosition_(t.offsetX-this.thumbSize_[0]/2,t.offsetY-this.thumbSize_[1]/2),n=this.getResolutionForPosition_(i);e.animate({resolution:e.constrainResolution(n),duration:this.duration_,easing:ar})},e.prototype.handleDraggerStart_=function(t){this.dragging_||t.originalEvent.target!==this.element.firstElementChild||(this.getMap().getView().setHint(vx.INTERACTING,1),this.previousX_=t.clientX,this.previousY_=t.clientY,this.dragging_=!0)},e.prototype.handleDraggerDrag_=function(t){if(this.dragging_){var e=this.element.firstElementChild,i=t.clientX-this.previousX_+parseInt(e.style.left,10),n=t.clientY-this.previousY_+parseInt(e.style.top,10),r=this.getRelativePosition_(i,n);this.currentResolution_=this.getResolutionForPosition_(r),this.getMap().getView().setResolution(this.currentResolution_),this.setThumbPosition_(this.currentResolution_),this.previousX_=t.clientX,this.previousY_=t.clientY}},e.prototype.handleDraggerEnd_=function(t){if(this.dragging_){var e=this.getMap().getView();e.setHint(vx.INTERACTING,-1),e.animate({resolution:e.constrainResolution(this.currentResolution_),duration:this.duration_,easing:ar}),this.dragging_=!1,this.previousX_=void 0,this.previousY_=void 0}},e.prototype.setThumbPosition_=function(t){var e=this.getPositionForResolution_(t),i=this.element.firstElementChild;this.direction_==hw.HORIZONTAL?i.style.left=this.widthLimit_*e+"px":i.style.top=this.heightLimit_*e+"px"},e.prototype.getRelativePosition_=function(t,e){return Yn(this.direction_===hw.HORIZONTAL?t/this.widthLimit_:e/this.heightLimit_,0,1)},e.prototype.getResolutionForPosition_=function(t){return this.getMap().getView().getResolutionForValueFunction()(1-t)},e.prototype.getPositionForResolution_=function(t){return 1-this.getMap().getView().getValueForResolutionFunction()(t)}}(RE);function uw(t){if(t.frameState){this.sliderInitialized_||this.initSlider_();var e=t.frameState.viewState.resolution;e!==this.currentResolution_&&(this.currentResolution_=e,this.setThumbPosition_(e))}}!function(t){function e(e){var i=e||{};t.call(this,{element:document.createElement("div"),target:i.target}),this.extent=i.extent?i.extent:null;var n=void 0!==i.className?i.className:"ol-zoom-extent",r=void 0!==i.label?i.label:"E",o=void 0!==i.tipLabel?i.tipLabel:"Fit to extent",s=document.createElement("button");s.setAttribute("type","button"),s.title=o,s.appendChild("string"==typeof r?document.createTextNode(r):r),gr(s,br.CLICK,this.handleClick_,this);var a=n+" "+ta+" "+ia,h=this.element;h.className=a,h.appendChild(s)}t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.handleClick_=function(t){t.preventDefault(),this.handleZoomToExtent()},e.prototype.handleZoomToExtent=function(){var t=this.getMap().getView(),e=this.extent?this.extent:t.getProjection().getExtent();t.fit(e)}}(RE);var lw={controls:{type:[Object,Boolean],default:!0},keyboardEventTarget:[String,Element,Document],loadTilesWhileAnimating:{type:Boolean,default:!1},loadTilesWhileInteracting:{type:Boolean,default:!1},logo:[String,Object,Element,Boolean],moveTolerance:{type:Number,default:1},pixelRatio:{type:Number,default:function(){return window.devicePixelRatio||1}},renderer:{type:[String,Array],default:function(){return[$h.CANVAS,$h.WEBGL]}},tabindex:[String,Number],dataProjection:String,wrapX:{type:Boolean,default:!0}};var cw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="map.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this.$createElement;return(this._self._c||t)("div",{class:[this.$options.name],attrs:{tabindex:this.tabindex}},[this._t("default")],2)},staticRenderFns:[]},0,{name:"vl-map",mixins:[jd,vg,lg,Sg,Bc,Uc],props:lw,computed:{},methods:{createOlObject:function(){var t=new iw({controls:[],loadTilesWhileAnimating:this.loadTilesWhileAnimating,loadTilesWhileInteracting:this.loadTilesWhileInteracting,pixelRatio:this.pixelRatio,renderer:this.renderer,logo:this.logo,keyboardEventTarget:this.keyboardEventTarget,view:this._view});if(this._view=t.getView(),this._defaultLayer.setMap(t),this.controls){var e="object"===ze(this.controls)?this.controls:void 0;t.getControls().extend(Ix(e).getArray())}return t.set("dataProjection",this.dataProjection),t},getLayersTarget:function(){return Rh(this),null==this._layersTarget&&(this._layersTarget=new Wg(this.$map.getLayers(),function(t){return t.get("id")})),this._layersTarget},getInteractionsTarget:function(){return Rh(this),null==this._interactionsTarget&&(this._interactionsTarget=new Wg(this.$map.getInteractions(),function(t){return t.get("id")})),this._interactionsTarget},getDefaultInteractionsSorter:function(){return function(t,e){var i=t.get("priority")||0,n=e.get("priority")||0;return i===n?0:i-n}},getFeaturesTarget:function(){return null==this._featuresTarget&&(this._featuresTarget=new Hg(this._defaultLayer.getSource())),this._featuresTarget},getOverlaysTarget:function(){return Rh(this),null==this._overlaysTarget&&(this._overlaysTarget=new Wg(this.$map.getOverlays(),function(t){return t.getId()})),this._overlaysTarget},getCoordinateFromPixel:function(t){Rh(this);var e=this.$map.getCoordinateFromPixel(t);return this.pointToDataProj(e)},getServices:function(){var t=this;return af(jd.methods.getServices.call(this),vg.methods.getServices.call(this),lg.methods.getServices.call(this),Sg.methods.getServices.call(this),Bc.methods.getServices.call(this),{get map(){return t.$map},get view(){return t.$view},get viewContainer(){return t}})},focus:function(){this.$el.focus()},forEachFeatureAtPixel:function(t,e){var i=arguments.length>2&&void 0!==arguments[2]?arguments[2]:{};return Rh(this),this.$map.forEachFeatureAtPixel(t,e,i)},forEachLayerAtPixel:function(t,e,i){return Rh(this),this.$map.forEachLayerAtPixel(t,e,void 0,i)},setView:function(e){(e=e instanceof t?e.$view:e)||(e=new Sx),e!==this._view&&(this._view=e),this.$map&&e!==this.$map.getView()&&this.$map.setView(e)},mount:function(){Rh(this),this.$map.setTarget(this.$el),this.subscribeAll(),this.updateSize()},unmount:function(){Rh(this),this.clearLayers(),this.clearInteractions(),this.clearOverlays(),this.unsubscribeAll(),this.$map.setTarget(void 0)},refresh:function(){var t=this;return this.updateSize(),this.render().then(function(){return jd.methods.refresh.call(t)})},render:function(){var t=this;return new ed(function(e){Rh(t),t.$map.once("postrender",function(){return e()}),t.$map.render()})},subscribeAll:function(){(function(){var t=this;Rh(this),Oh(this);var e=nf(rf(this.$map,["click","dblclick","singleclick"]),rf(this.$map,["pointerdrag","pointermove"]).pipe($p(100),gp(function(t,e){return zi(t.coordinate,e.coordinate)}))).pipe(_p(function(e){return ue({},e,{coordinate:t.pointToDataProj(e.coordinate)})})),i=rf(this.$map,["movestart","moveend","postrender","precompose","postcompose"]),n=nf(e,i);this.subscribeTo(n,function(e){return t.$emit(e.type,e)})}).call(this)},updateSize:function(){Rh(this),this.$map.updateSize()}},watch:{dataProjection:function(t){this.$map&&(this.$map.set("dataProjection",t),this.scheduleRefresh())}},created:function(){var t=this;this._view=void 0,this._defaultLayer=new nm({source:new i_({wrapX:this.wrapX})}),Wc(this,{$map:{enumerable:!0,get:function(){return t.$olObject}},$view:{enumerable:!0,get:function(){return t._view}}})}},void 0,!1);var pw={center:{type:Array,default:function(){return[0,0]},validator:function(t){return 2===t.length}},constrainRotation:{type:[Boolean,Number],default:!0},enableRotation:{type:Boolean,default:!0},extent:{type:Array,validator:function(t){return 4===t.length}},maxResolution:Number,minResolution:Number,maxZoom:{type:Number,default:Gh},minZoom:{type:Number,default:0},projection:{type:String,default:jh},resolution:Number,resolutions:Array,rotation:{type:Number,default:0},zoom:{type:Number,default:0},zoomFactor:{type:Number,default:2}};var fw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="view.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this.$createElement;return(this._self._c||t)("i",{class:[this.$options.name],staticStyle:{display:"none !important"}},[this._t("default",null,{center:this.viewCenter,zoom:this.viewZoom,resolution:this.viewResolution,rotation:this.viewRotation})],2)},staticRenderFns:[]},0,{name:"vl-view",mixins:[jd,Uc],props:pw,computed:{viewZoom:function(){return this.rev&&this.$view?Math.round(this.$view.getZoom()):this.zoom},viewRotation:function(){return this.rev&&this.$view?this.$view.getRotation():this.rotation},viewResolution:function(){return this.rev&&this.$view?this.$view.getResolution():this.resolution},viewCenter:function(){if(this.rev&&this.$view)return this.pointToDataProj(this.$view.getCenter())},viewCenterViewProj:function(){if(this.rev&&this.$view)return this.$view.getCenter()},resolvedDataProjection:function(){return Di(this.$viewContainer&&this.$viewContainer.resolvedDataProjection,this.$options.dataProjection,this.viewProjection)}},methods:{animate:function(){for(var t=this,e=arguments.length,i=new Array(e),n=0;n<e;n++)i[n]=arguments[n];Oh(this);var r=Ri;return Ni(i[i.length-1])&&(r=i[i.length-1],i=i.slice(0,i.length-1)),i.forEach(function(e){ti(e.center)&&(e.center=t.pointToViewProj(e.center))}),new ed(function(e){var n;return(n=t.$view).animate.apply(n,Hp(i).concat([function(t){r(t),e(t)}]))})},createOlObject:function(){return new Sx({center:this.pointToViewProj(this.center),constrainRotation:this.constrainRotation,enableRotation:this.enableRotation,extent:this.extent?this.extentToViewProj(this.extent):void 0,maxResolution:this.maxResolution,minResolution:this.minResolution,maxZoom:this.maxZoom,minZoom:this.minZoom,projection:this.projection,resolution:this.resolution,resolutions:this.resolutions,rotation:this.rotation,zoom:this.zoom,zoomFactor:this.zoomFactor})},fit:function(e){var i=this,n=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Oh(this),Gi(e)?e=this.readGeometryInDataProj(e):e instanceof t&&(e=e.$geometry);var r=n.callback||Ri;return new ed(function(t){i.$view.fit(e,ue({},n,{callback:function(e){r(e),t(e)}}))})},mount:function(){this.$viewContainer&&this.$viewContainer.setView(this),this.subscribeAll()},unmount:function(){this.unsubscribeAll(),this.$viewContainer&&this.$viewContainer.setView(void 0)},subscribeAll:function(){(function(){var t=this;Oh(this);var e=1e3/60,i=Hd(this.$view,"resolution",!0,e),n=i.pipe(_p(function(){return{prop:"zoom",value:Math.round(t.$view.getZoom())}}),(o="value",gp(function(t,e){return s?s(t[o],e[o]):t[o]===e[o]}))),r=nf(Hd(this.$view,"center",!0,e,function(){return t.pointToDataProj(t.$view.getCenter())}),Hd(this.$view,"rotation",!0,e),i,n);var o,s;this.subscribeTo(r,function(e){var i=e.prop,n=e.value;++t.rev,t.$emit("update:".concat(i),n)})}).call(this)}},watch:{center:function(t){t=this.pointToViewProj(t),!this.$view||this.$view.getAnimating()||zi(t,this.viewCenterViewProj)||this.$view.setCenter(t)},resolution:function(t){this.$view&&!this.$view.getAnimating()&&t!==this.viewResolution&&this.$view.setResolution(t)},zoom:function(t){t=Math.round(t),this.$view&&!this.$view.getAnimating()&&t!==this.viewZoom&&this.$view.setZoom(t)},rotation:function(t){this.$view&&!this.$view.getAnimating()&&t!==this.viewRotation&&this.$view.setRotation(t)},minZoom:function(t){this.$view&&t!==this.$view.getMinZoom()&&this.$view.setMinZoom(t)},maxZoom:function(t){this.$view&&t!==this.$view.getMaxZoom()&&this.$view.setMaxZoom(t)},resolvedDataProjection:function(){this.$view&&this.$view.setCenter(this.pointToViewProj(this.center))}},stubVNode:{empty:function(){return this.$options.name}},created:function(){var t=this;Wc(this,{$view:{enumerable:!0,get:function(){return t.$olObject}},$viewContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.viewContainer}}})}},void 0,!1);function dw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};dw.installed||(dw.installed=!0,e=Zi(e,"dataProjection"),pe(cw,e),pe(fw,e),t.component(cw.name,cw),t.component(fw.name,fw))}var gw=Object.freeze({default:dw,Map:cw,View:fw,install:dw}),yw='&copy; <a href="https://www.mapbox.com/" target="_blank">MapBox</a>, '+(new Date).getFullYear();var vw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-mapbox",mixins:[tv],props:{accessToken:{type:String,required:!0},attributions:{type:[String,Array],default:yw},mapId:{type:String,required:!0},projection:{type:String,default:jh},tileFormat:{type:String,default:"png"},url:{type:String,default:"https://{a-c}.tiles.mapbox.com/v4/{mapId}/{z}/{x}/{y}{tileNameSuffix}.{tileFormat}?access_token={accessToken}"}},computed:{tileNameSuffix:function(){return(t=function(t){return(t=Di(t,1))>1?2:1}(t=this.tilePixelRatio))>1?["@",t,"x"].join(""):"";var t},urlTokens:function(){return["mapId","accessToken","tileNameSuffix","tileFormat"]}}},void 0,void 0);function _w(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};_w.installed||(_w.installed=!0,e=Zi(e,"dataProjection"),pe(vw,e),t.component(vw.name,vw))}var mw=Object.freeze({default:_w,Source:vw,install:_w}),Ew={source:{type:String,required:!0},condition:{type:Function,default:C_},deleteCondition:{type:Function,default:d_},insertVertexCondition:{type:Function,default:v_},pixelTolerance:{type:Number,default:10},wrapX:{type:Boolean,default:!1}},xw={createInteraction:function(){var t=nd(_e.mark(function t(){var e,i,n;return _e.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:return e=this.makeIdent(this.source),t.next=3,this.$identityMap.get(e,this.$options.INSTANCE_PROMISE_POOL);case 3:return Ni((i=t.sent).getFeatures)&&Mc(n=i.getFeatures())&&(i=n),t.abrupt("return",new FS({source:Ac(i)?i:void 0,features:Mc(i)?i:void 0,deleteCondition:this.deleteCondition,insertVertexCondition:this.insertVertexCondition,pixelTolerance:this.pixelTolerance,style:this.createStyleFunc(),wrapX:this.wrapX}));case 6:case"end":return t.stop()}},t,this)}));return function(){return t.apply(this,arguments)}}(),getDefaultStyles:function(){var t=Hi($y(),function(t){return t.map(zy)});return function(e){if(e.getGeometry())return t[e.getGeometry().getType()]}},getServices:function(){return af(ig.methods.getServices.call(this),Tg.methods.getServices.call(this))},getStyleTarget:function(){return this.$interaction},mount:function(){ig.methods.mount.call(this)},unmount:function(){ig.methods.unmount.call(this)},setStyle:function(t){t!==this._styles&&(this._styles=t,this.scheduleRefresh())},subscribeAll:function(){(function(){var t=this;Mh(this);var e=rf(this.$interaction,["modifystart","modifyend"]);this.subscribeTo(e,function(e){++t.rev,t.$emit(e.type,e)})}).call(this)}},Sw=Gd(["source"],function(){return function(){this.scheduleRecreate()}});var Tw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="interaction.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-interaction-modify",mixins:[ig,Tg],props:Ew,methods:xw,watch:Sw},void 0,void 0);function ww(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};ww.installed||(ww.installed=!0,e=Zi(e,"dataProjection"),pe(Tw,e),t.component(Tw.name,Tw))}var bw=Object.freeze({default:ww,Interaction:Tw,install:ww});var Cw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-multi-line-string",mixins:[zd],props:{},computed:{type:Oi(Vh.MULTI_LINE_STRING)},methods:{createGeometry:function(){return new Pu(this.toViewProj(this.coordinates))}}},void 0,void 0);function Rw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Rw.installed||(Rw.installed=!0,e=Zi(e,"dataProjection"),pe(Cw,e),t.component(Cw.name,Cw))}var Ow=Object.freeze({default:Rw,Geom:Cw,install:Rw});var Iw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-multi-point",mixins:[zd],props:{},computed:{type:Oi(Vh.MULTI_POINT)},methods:{createGeometry:function(){return new Lu(this.toViewProj(this.coordinates))}}},void 0,void 0);function Pw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Pw.installed||(Pw.installed=!0,e=Zi(e,"dataProjection"),pe(Iw,e),t.component(Iw.name,Iw))}var Fw=Object.freeze({default:Pw,Geom:Iw,install:Pw});var Lw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-multi-polygon",mixins:[zd],props:{},computed:{type:Oi(Vh.MULTI_POLYGON)},methods:{createGeometry:function(){return new Wu(this.toViewProj(this.coordinates))}}},void 0,void 0);function Mw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Mw.installed||(Mw.installed=!0,e=Zi(e,"dataProjection"),pe(Lw,e),t.component(Lw.name,Lw))}var Aw=Object.freeze({default:Mw,Geom:Lw,install:Mw}),Nw='&#169; <a href="https://www.openstreetmap.org/copyright">OpenStreetMap</a> contributors.',kw=function(t){function e(e){var i,n=e||{};i=void 0!==n.attributions?n.attributions:[Nw];var r=void 0!==n.crossOrigin?n.crossOrigin:"anonymous",o=void 0!==n.url?n.url:"https://{a-c}.tile.openstreetmap.org/{z}/{x}/{y}.png";t.call(this,{attributions:i,cacheSize:n.cacheSize,crossOrigin:r,opaque:void 0===n.opaque||n.opaque,maxZoom:void 0!==n.maxZoom?n.maxZoom:19,reprojectionErrorThreshold:n.reprojectionErrorThreshold,tileLoadFunction:n.tileLoadFunction,url:o,wrapX:n.wrapX})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Qy);var jw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-osm",mixins:[tv],props:{attributions:{type:[String,Array],default:'&copy; <a href="https://www.openstreetmap.org/copyright" target="_blank">OpenStreetMap</a> contributors.'},maxZoom:{type:Number,default:19},url:{type:String,default:"https://{a-c}.tile.openstreetmap.org/{z}/{x}/{y}.png"}},methods:{createSource:function(){return new kw({url:this.urlTmpl,attributions:this.attributions,crossOrigin:this.crossOrigin,maxZoom:this.maxZoom,cacheSize:this.cacheSize,opaque:this.opaque,reprojectionErrorThreshold:this.reprojectionErrorThreshold,wrapX:this.wrapX,transition:this.transition})}}},void 0,void 0);function Gw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Gw.installed||(Gw.installed=!0,e=Zi(e,"dataProjection"),pe(jw,e),t.component(jw.name,jw))}var Dw=Object.freeze({default:Gw,Source:jw,install:Gw}),$w={id:{type:[String,Number],default:function(){return ja()}},offset:{type:Array,default:function(){return[0,0]},validator:function(t){return 2===t.length}},position:{type:Array,validator:function(t){return 2===t.length},required:!0},positioning:{type:String,default:Xh.TOP_LEFT,validator:function(t){return p_(Xh).includes(t)}},stopEvent:{type:Boolean,default:!0},insertFirst:{type:Boolean,default:!0},autoPan:{type:Boolean,default:!1},autoPanMargin:{type:Number,default:20},autoPanAnimation:Object};var Vw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="overlay.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this,e=t.$createElement;return(t._self._c||e)("div",{class:t.classes,attrs:{id:[t.$options.name,t.id].join("-")}},[t._t("default",null,{id:t.id,position:t.position,offset:t.offset,positioning:t.positioning})],2)},staticRenderFns:[]},0,{name:"vl-overlay",mixins:[jd,$d,Uc],props:$w,computed:{positionViewProj:function(){if(this.rev&&this.$overlay)return this.$overlay.getPosition()},positionDataProj:function(){if(this.rev&&this.$overlay)return this.pointToDataProj(this.$overlay.getPosition())},classes:function(){return[this.$options.name,this.visible?"visible":void 0].filter(Pi)}},methods:{createOlObject:function(){return new xg({id:this.id,offset:this.offset,position:this.pointToViewProj(this.position),positioning:this.positioning,stopEvent:this.stopEvent,insertFirst:this.insertFirst,autoPan:this.autoPan,autoPanMargin:this.autoPanMargin,autoPanAnimation:this.autoPanAnimation})},mount:function(){var t=this;Ah(this),this.$overlay.setElement(this.$el),this.$overlaysContainer&&this.$overlaysContainer.addOverlay(this.$overlay),this.$nextTick(function(){t.$overlay.setPosition(t.positionViewProj.slice()),t.visible=!0}),this.subscribeAll()},unmount:function(){Ah(this),this.unsubscribeAll(),this.$overlay.setElement(void 0),this.$overlaysContainer&&this.$overlaysContainer.removeOverlay(this.$overlay),this.visible=!1},subscribeAll:function(){(function(){var t=this;Ah(this);var e=nf(Hd(this.$overlay,"position",!0,1e3/60,function(){return t.pointToDataProj(t.$overlay.getPosition())}),Hd(this.$overlay,["offset","positioning"],!0,1e3/60));this.subscribeTo(e,function(e){var i=e.prop,n=e.value;++t.rev,t.$emit("update:".concat(i),n)})}).call(this)}},watch:{offset:function(t){this.$overlay&&!zi(t,this.$overlay.getOffset())&&this.$overlay.setOffset(t)},position:function(t){t=this.pointToViewProj(t),this.$overlay&&!zi(t,this.positionViewProj)&&this.$overlay.setPosition(t)},positioning:function(t){this.$overlay&&t!==this.$overlay.getPositioning()&&this.$overlay.setPositioning(t)},resolvedDataProjection:function(){this.$overlay&&this.$overlay.setPosition(this.pointToViewProj(this.position))}},created:function(){var t=this;Wc(this,{$overlay:{enumerable:!0,get:function(){return t.$olObject}},$map:{enumerable:!0,get:function(){return t.$services&&t.$services.map}},$view:{enumerable:!0,get:function(){return t.$services&&t.$services.view}},$overlaysContainer:{enumerable:!0,get:function(){return t.$services&&t.$services.overlaysContainer}}})},data:function(){return{visible:!1}}},void 0,!1);function zw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};zw.installed||(zw.installed=!0,e=Zi(e,"dataProjection"),pe(Vw,e),t.component(Vw.name,Vw))}var Yw=Object.freeze({default:zw,Overlay:Vw,install:zw});var Xw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-point",mixins:[zd],props:{coordinates:{type:Array,required:!0,validator:function(t){return 2===t.length}}},computed:{type:Oi(Vh.POINT)},methods:{createGeometry:function(){return new Fu(this.toViewProj(this.coordinates))}}},void 0,void 0);function Uw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Uw.installed||(Uw.installed=!0,e=Zi(e,"dataProjection"),pe(Xw,e),t.component(Xw.name,Xw))}var Bw=Object.freeze({default:Uw,Geom:Xw,install:Uw});var Kw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="geom.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-geom-polygon",mixins:[zd],props:{},computed:{type:Oi(Vh.POLYGON)},methods:{createGeometry:function(){return new Yu(this.toViewProj(this.coordinates))}}},void 0,void 0);function Ww(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Ww.installed||(Ww.installed=!0,e=Zi(e,"dataProjection"),pe(Kw,e),t.component(Kw.name,Kw))}var Hw=Object.freeze({default:Ww,Geom:Kw,install:Ww}),Zw={points:{type:Number,required:!0},radius:Number,radius1:Number,radius2:Number,angle:{type:Number,default:0},rotation:{type:Number,default:0},rotateWithView:{type:Boolean,default:!1}};var qw=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-reg-shape",mixins:[Qd,Jg],props:Zw,methods:{createStyle:function(){return Ta({points:this.points,radius:this.radius,radius1:this.radius1,radius2:this.radius2,angle:this.angle,rotation:this.rotation,rotateWithView:this.rotateWithView,fill:this._fill,stroke:this._stroke})},getServices:function(){var t=this;return af(Qd.methods.getServices.call(this),{get stylesContainer(){return t}})},setFill:function(e){(e=e instanceof t?e.$style:e)!==this._fill&&(this._fill=e,this.scheduleRefresh())},setStroke:function(e){(e=e instanceof t?e.$style:e)!==this._stroke&&(this._stroke=e,this.scheduleRefresh())}},watch:{points:function(t){this.$style&&!zi(t,this.$style.getPoints())&&this.scheduleRefresh()},radius:function(t){this.$style&&!zi(t,this.$style.getRadius())&&this.scheduleRefresh()},radius1:function(t){this.$style&&!zi(t,this.$style.getRadius())&&this.scheduleRefresh()},radius2:function(t){this.$style&&!zi(t,this.$style.getRadius2())&&this.scheduleRefresh()},angle:function(t){this.$style&&!zi(t,this.$style.getAngle())&&this.scheduleRefresh()},rotation:function(t){this.$style&&!zi(t,this.$style.getRotation())&&this.scheduleRefresh()},rotateWithView:function(t){this.$style&&!zi(t,this.$style.getRotateWithView())&&this.scheduleRefresh()}}},void 0,void 0);function Jw(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Jw.installed||(Jw.installed=!0,e=Zi(e,"dataProjection"),pe(qw,e),t.component(qw.name,qw))}var Qw=Object.freeze({default:Jw,Style:qw,install:Jw}),tb={filter:{type:Function,default:Oi(!0)},hitTolerance:{type:Number,default:0},multi:{type:Boolean,default:!1},features:{type:Array,default:Ii},wrapX:{type:Boolean,default:!0},addCondition:{type:Function,default:m_},condition:{type:Function,default:x_},removeCondition:{type:Function,default:m_},toggleCondition:{type:Function,default:T_}};var eb=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="interaction.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({render:function(){var t=this.$createElement;return(this._self._c||t)("i",{class:[this.$options.name],staticStyle:{display:"none !important"}},[this._t("default",null,{features:this.features})],2)},staticRenderFns:[]},0,{name:"vl-interaction-select",mixins:[ig,Tg,Uc],props:tb,computed:{},methods:{createInteraction:function(){return new VS({multi:this.multi,wrapX:this.wrapX,filter:this.filter,style:this.createStyleFunc(),addCondition:this.addCondition,condition:this.condition,removeCondition:this.removeCondition,toggleCondition:this.toggleCondition})},getDefaultStyles:function(){var t=Hi($y(),function(t){return t.map(zy)});return function(e){if(e.getGeometry())return t[e.getGeometry().getType()]}},getFeatures:function(){return this.$interaction&&this.$interaction.getFeatures().getArray()||[]},getServices:function(){return af(ig.methods.getServices.call(this),Tg.methods.getServices.call(this))},getStyleTarget:function(){return this.$interaction},mount:function(){ig.methods.mount.call(this),this.features.forEach(this.select)},unmount:function(){this.unselectAll(),ig.methods.unmount.call(this)},select:function(e){Rh(this),Mh(this);var i=Da(e);if(!i)throw new Error("Undefined feature id");e instanceof t&&(e=e.$feature),this.$features.map(Da).includes(i)||(e instanceof La||(e=void 0,Ui(this.$map.getLayers().getArray(),function(t){var n=t.getSource();return n&&Ni(n.getFeatureById)&&(e=n.getFeatureById(i)),!e})),e&&this.$interaction.getFeatures().push(e))},unselect:function(e){Mh(this);var i=Da(e);if(!i)throw new Error("Undefined feature id");e instanceof t&&(e=e.$feature);var n=this.$features.map(Da).findIndex(function(t){return t===i});-1!==n&&this.$interaction.getFeatures().removeAt(n)},setStyle:function(t){t!==this._styles&&(this._styles=t,this.scheduleRefresh())},refresh:function(){var t=this;return ed.all([new ed(function(e){if(t.$interaction){var i=t.$interaction.getFeatures();i.once("change",function(){return e()}),i.changed()}else e()}),ig.methods.refresh.call(this)])},subscribeAll:function(){(function(){var t=this;Mh(this);var e=rf(this.$interaction,"select");this.subscribeTo(e,function(e){var i=e.selected,n=e.deselected,r=e.mapBrowserEvent;++t.rev,n.forEach(function(e){return t.$emit("unselect",{feature:e,mapBrowserEvent:r})}),i.forEach(function(e){return t.$emit("select",{feature:e,mapBrowserEvent:r})}),t.$emit("update:features",t.$features.map(t.writeFeatureInDataProj.bind(t)))})}).call(this)},unselectAll:function(){Mh(this),this.$interaction.getFeatures().clear()}},watch:{features:function(t){if(this.$interaction){var e=function(t,e){return Da(t)===Da(e)},i=tn(t,this.$features,e),n=tn(this.$features,t,e);i.forEach(this.select),n.forEach(this.unselect)}}},stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}},created:function(){Wc(this,{$features:{enumerable:!0,get:this.getFeatures}})}},void 0,!1);function ib(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};ib.installed||(ib.installed=!0,e=Zi(e,"dataProjection"),pe(eb,e),t.component(eb.name,eb))}var nb=Object.freeze({default:ib,Interaction:eb,install:ib}),rb={source:{type:String,required:!0},edge:{type:Boolean,default:!0},vertex:{type:Boolean,default:!0},pixelTolerance:{type:Number,default:10}},ob={createInteraction:function(){var t=nd(_e.mark(function t(){var e,i;return _e.wrap(function(t){for(;;)switch(t.prev=t.next){case 0:return e=this.makeIdent(this.source),t.next=3,this.$identityMap.get(e,this.$options.INSTANCE_PROMISE_POOL);case 3:return i=t.sent,t.abrupt("return",new YS({source:i}));case 5:case"end":return t.stop()}},t,this)}));return function(){return t.apply(this,arguments)}}(),mount:function(){ig.methods.mount.call(this)},unmount:function(){ig.methods.unmount.call(this)},subscribeAll:function(){}},sb=Gd(["source"],function(){return function(){this.scheduleRecreate()}});var ab=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="interaction.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-interaction-snap",mixins:[ig],props:rb,methods:ob,watch:sb},void 0,void 0);function hb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};hb.installed||(hb.installed=!0,e=Zi(e,"dataProjection"),pe(ab,e),t.component(ab.name,ab))}var ub=Object.freeze({default:hb,Interaction:ab,install:hb}),lb='<a href="http://maps.sputnik.ru/" target="_blank">Спутник</a> &copy; <a href="http://rt.ru/" target="_blank">Ростелеком</a>, '+(new Date).getFullYear();var cb=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-sputnik",mixins:[tv],props:{url:{type:String,default:"http://tiles.maps.sputnik.ru/{z}/{x}/{y}.png?apikey={apikey}"},apiKey:{type:String},attributions:{type:String,default:lb}},computed:{urlTokens:function(){return["apiKey"]}}},void 0,void 0);function pb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};pb.installed||(pb.installed=!0,e=Zi(e,"dataProjection"),pe(cb,e),t.component(cb.name,cb))}var fb=Object.freeze({default:pb,Source:cb,install:pb}),db=['Map tiles by <a href="https://stamen.com/">Stamen Design</a>, under <a href="https://creativecommons.org/licenses/by/3.0/">CC BY 3.0</a>.',Nw],gb={terrain:{extension:"jpg",opaque:!0},"terrain-background":{extension:"jpg",opaque:!0},"terrain-labels":{extension:"png",opaque:!1},"terrain-lines":{extension:"png",opaque:!1},"toner-background":{extension:"png",opaque:!0},toner:{extension:"png",opaque:!0},"toner-hybrid":{extension:"png",opaque:!1},"toner-labels":{extension:"png",opaque:!1},"toner-lines":{extension:"png",opaque:!1},"toner-lite":{extension:"png",opaque:!0},watercolor:{extension:"jpg",opaque:!0}},yb={terrain:{minZoom:4,maxZoom:18},toner:{minZoom:0,maxZoom:20},watercolor:{minZoom:1,maxZoom:16}},vb=function(t){function e(e){var i=e.layer.indexOf("-"),n=-1==i?e.layer:e.layer.slice(0,i),r=yb[n],o=gb[e.layer],s=void 0!==e.url?e.url:"https://stamen-tiles-{a-d}.a.ssl.fastly.net/"+e.layer+"/{z}/{x}/{y}."+o.extension;t.call(this,{attributions:db,cacheSize:e.cacheSize,crossOrigin:"anonymous",maxZoom:void 0!=e.maxZoom?e.maxZoom:r.maxZoom,minZoom:void 0!=e.minZoom?e.minZoom:r.minZoom,opaque:o.opaque,reprojectionErrorThreshold:e.reprojectionErrorThreshold,tileLoadFunction:e.tileLoadFunction,url:s,wrapX:e.wrapX})}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e}(Qy);var _b=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-stamen",mixins:[tv],props:{layer:{type:String,required:!0},url:String},methods:{createSource:function(){return new vb({cacheSize:this.cacheSize,layer:this.layer,minZoom:this.minZoom,maxZoom:this.maxZoom,opaque:this.opaque,reprojectionErrorThreshold:this.reprojectionErrorThreshold,tileLoadFunction:this.tileLoadFunction,url:this.urlTmpl||void 0,wrapX:this.wrapX})}}},void 0,void 0);function mb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};mb.installed||(mb.installed=!0,e=Zi(e,"dataProjection"),pe(_b,e),t.component(_b.name,_b))}var Eb=Object.freeze({default:mb,Source:_b,install:mb}),xb={color:[Array,String],lineCap:{type:String,default:"round"},lineJoin:{type:String,default:"round"},lineDash:Array,lineDashOffset:{type:Number,default:0},miterLimit:{type:Number,default:10},width:{type:Number,default:1.25}};var Sb=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-stroke",mixins:[Jd],props:xb,watch:{color:function(t){this.$style&&!zi(t,this.$style.getColor())&&(this.$style.setColor(t),this.scheduleRefresh())},lineCap:function(t){this.$style&&!zi(t,this.$style.getLineCap())&&(this.$style.setLineCap(t),this.scheduleRefresh())},lineDash:function(t){this.$style&&!zi(t,this.$style.getLineDash())&&(this.$style.setLineDash(t),this.scheduleRefresh())},lineJoin:function(t){this.$style&&!zi(t,this.$style.getLineJoin())&&(this.$style.setLineJoin(t),this.scheduleRefresh())},width:function(t){this.$style&&!zi(t,this.$style.getWidth())&&(this.$style.setWidth(t),this.scheduleRefresh())}},methods:{createStyle:function(){return new Ca({color:this.color,lineCap:this.lineCap,lineJoin:this.lineJoin,lineDash:this.lineDash,lineDashOffset:this.lineDashOffset,miterLimit:this.miterLimit,width:this.width})},mount:function(){this.$stylesContainer&&this.$stylesContainer.setStroke(this)},unmount:function(){this.$stylesContainer&&this.$stylesContainer.setStroke(void 0)}}},void 0,void 0);function Tb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Tb.installed||(Tb.installed=!0,e=Zi(e,"dataProjection"),pe(Sb,e),t.component(Sb.name,Sb))}var wb=Object.freeze({default:Tb,Style:Sb,install:Tb}),bb={zIndex:{type:Number,default:0},condition:{type:[Function,Boolean],default:!0}};var Cb=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-box",mixins:[Jd,Jg],props:bb,methods:{createStyle:function(){return new Ra({zIndex:this.zIndex,image:this._image,stroke:this._stroke,fill:this._fill,text:this._text,geometry:this._geometry})},getServices:function(){var t=this;return af(Jd.methods.getServices.call(this),{get stylesContainer(){return t}})},mount:function(){this.$stylesContainer&&this.$stylesContainer.addStyle(this)},unmount:function(){this.$stylesContainer&&this.$stylesContainer.removeStyle(this)},setImage:function(e){(e=e instanceof t?e.$style:e)!==this._image&&(this._image=e),this.$style&&e!==this.$style.getImage()&&(this.$style.setImage(e),this.scheduleRefresh())},setGeometry:function(e){(e=e instanceof t?e.$geometry:e)!==this._geometry&&(this._geometry=e),this.$style&&e!==this.$style.getGeometry()&&(this.$style.setGeometry(e),this.scheduleRefresh())},setText:function(e){(e=e instanceof t?e.$style:e)!==this._text&&(this._text=e),this.$style&&e!==this.$style.getText()&&(this.$style.setText(e),this.scheduleRefresh())}},watch:{zIndex:function(t){this.$style&&!zi(t,this.$style.getZIndex())&&(this.$style.setZIndex(t),this.scheduleRefresh())}},created:function(){this._image=void 0,this._text=void 0,this._geometry=void 0}},void 0,void 0);function Rb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Rb.installed||(Rb.installed=!0,e=Zi(e,"dataProjection"),pe(Cb,e),t.component(Cb.name,Cb))}var Ob=Object.freeze({default:Rb,Style:Cb,install:Rb}),Ib={factory:{type:Function,required:!0}};var Pb=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-func",mixins:[Jd,Tg],props:Ib,computed:{styleFunc:function(){var t=this.factory();return Ni(t)||(t=Ri),t}},methods:{createStyle:function(){Rh(this);var t=this.styleFunc,e=this.createStyleFunc();return function(i,n){var r=t(i,n);return null===r||ti(r)&&r.length?r:e(i,n)}},mount:function(){this.$stylesContainer&&this.$stylesContainer.addStyle(this)},unmount:function(){this.$stylesContainer&&this.$stylesContainer.removeStyle(this)},getServices:function(){var t=this;return af(Jd.methods.getServices.call(this),{get stylesContainer(){return t}})},setStyle:function(t){t!==this._styles&&(this._styles=t,this.scheduleRefresh())},refresh:function(){return this.recreate()}},watch:{factory:function(){this.scheduleRefresh()}},stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}}},void 0,void 0);function Fb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Fb.installed||(Fb.installed=!0,e=Zi(e,"dataProjection"),pe(Pb,e),t.component(Pb.name,Pb))}var Lb=Object.freeze({default:Fb,Style:Pb,install:Fb}),Mb={font:{type:String,default:"10px sans-serif"},placement:String,offsetX:{type:Number,default:0},offsetY:{type:Number,default:0},rotateWithView:{type:Boolean,default:!1},rotation:{type:Number,default:0},scale:{type:Number,default:1},text:String,textAlign:String,textBaseline:String};var Ab=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="style.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-style-text",mixins:[Jd,Jg],props:Mb,methods:{createStyle:function(){return new uy({font:this.font,placement:this.placement,offsetX:this.offsetX,offsetY:this.offsetY,rotateWithView:this.rotateWithView,rotation:this.rotation,scale:this.scale,text:this.text,textAlign:this.textAlign,textBaseline:this.textBaseline,fill:this._fill,stroke:this._stroke})},mount:function(){this.$stylesContainer&&this.$stylesContainer.setText(this)},unmount:function(){this.$stylesContainer&&this.$stylesContainer.setText(void 0)},getServices:function(){var t=this;return af(Jd.methods.getServices.call(this),{get stylesContainer(){return t}})}},watch:{font:function(t){this.$style&&!zi(t,this.$style.getFont())&&(this.$style.setFont(t),this.scheduleRefresh())},offsetX:function(t){this.$style&&!zi(t,this.$style.getOffsetX())&&(this.$style.setOffsetX(t),this.scheduleRefresh())},offsetY:function(t){this.$style&&!zi(t,this.$style.getOffsetY())&&(this.$style.setOffsetY(t),this.scheduleRefresh())},rotation:function(t){this.$style&&!zi(t,this.$style.getRotation())&&(this.$style.setRotation(t),this.scheduleRefresh())},scale:function(t){this.$style&&!zi(t,this.$style.getScale())&&(this.$style.setScale(t),this.scheduleRefresh())},text:function(t){this.$style&&!zi(t,this.$style.getText())&&(this.$style.setText(t),this.scheduleRefresh())},textAlign:function(t){this.$style&&!zi(t,this.$style.getTextAlign())&&(this.$style.setTextAlign(t),this.scheduleRefresh())},textBaseline:function(t){this.$style&&!zi(t,this.$style.getTextBaseline())&&(this.$style.setTextBaseline(t),this.scheduleRefresh())}},stubVNode:{empty:!1,attrs:function(){return{class:this.$options.name}}}},void 0,void 0);function Nb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Nb.installed||(Nb.installed=!0,e=Zi(e,"dataProjection"),pe(Ab,e),t.component(Ab.name,Ab))}var kb=Object.freeze({default:Nb,Style:Ab,install:Nb}),jb="preload",Gb="useInterimTilesOnError",Db=function(t){function e(e){var i=e||{},n=qn({},i);delete n.preload,delete n.useInterimTilesOnError,t.call(this,n),this.setPreload(void 0!==i.preload?i.preload:0),this.setUseInterimTilesOnError(void 0===i.useInterimTilesOnError||i.useInterimTilesOnError),this.type=W_.TILE}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getPreload=function(){return this.get(jb)},e.prototype.setPreload=function(t){this.set(jb,t)},e.prototype.getUseInterimTilesOnError=function(){return this.get(Gb)},e.prototype.setUseInterimTilesOnError=function(t){this.set(Gb,t)},e}(Q_);Db.prototype.getSource;var $b={preload:{type:Number,default:0}};var Vb=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="layer.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-layer-tile",mixins:[dg],props:$b,methods:{createLayer:function(){return new Db({id:this.id,minResolution:this.minResolution,maxResolution:this.maxResolution,opacity:this.opacity,visible:this.visible,preload:this.preload,extent:this.extent,zIndex:this.zIndex,source:this._source})}}},void 0,void 0);function zb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};zb.installed||(zb.installed=!0,e=Zi(e,"dataProjection"),pe(Vb,e),t.component(Vb.name,Vb))}var Yb=Object.freeze({default:zb,Layer:Vb,install:zb}),Xb=["vector","image"];var Ub=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="layer.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-layer-vector",mixins:[Xg],props:{renderMode:{type:String,default:"vector",validator:function(t){return Xb.includes(t)}}},methods:{createLayer:function(){return new nm({id:this.id,minResolution:this.minResolution,maxResolution:this.maxResolution,opacity:this.opacity,visible:this.visible,extent:this.extent,zIndex:this.zIndex,updateWhileAnimating:this.updateWhileAnimating,updateWhileInteracting:this.updateWhileInteracting,source:this._source,renderMode:this.renderMode,renderBuffer:this.renderBuffer,renderOrder:this.renderOrder,declutter:this.declutter})}}},void 0,void 0);function Bb(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};Bb.installed||(Bb.installed=!0,e=Zi(e,"dataProjection"),pe(Ub,e),t.component(Ub.name,Ub))}var Kb=Object.freeze({default:Bb,Layer:Ub,install:Bb});var Wb=w.getIterator=function(t){var e=li(t);if("function"!=typeof e)throw TypeError(t+" is not iterable!");return O(e.call(t))};function Hb(t,e){return function(t){if(ti(t))return t}(t)||function(t,e){var i=[],n=!0,r=!1,o=void 0;try{for(var s,a=Wb(t);!(n=(s=a.next()).done)&&(i.push(s.value),!e||i.length!==e);n=!0);}catch(t){r=!0,o=t}finally{try{n||null==a.return||a.return()}finally{if(r)throw o}}return i}(t,e)||function(){throw new TypeError("Invalid attempt to destructure non-iterable instance")}()}var Zb={searchParams:"URLSearchParams"in self,iterable:"Symbol"in self&&"iterator"in Symbol,blob:"FileReader"in self&&"Blob"in self&&function(){try{return new Blob,!0}catch(t){return!1}}(),formData:"FormData"in self,arrayBuffer:"ArrayBuffer"in self};if(Zb.arrayBuffer)var qb=["[object Int8Array]","[object Uint8Array]","[object Uint8ClampedArray]","[object Int16Array]","[object Uint16Array]","[object Int32Array]","[object Uint32Array]","[object Float32Array]","[object Float64Array]"],Jb=ArrayBuffer.isView||function(t){return t&&qb.indexOf(Object.prototype.toString.call(t))>-1};function Qb(t){if("string"!=typeof t&&(t=String(t)),/[^a-z0-9\-#$%&'*+.^_`|~]/i.test(t))throw new TypeError("Invalid character in header field name");return t.toLowerCase()}function tC(t){return"string"!=typeof t&&(t=String(t)),t}function eC(t){var e={next:function(){var e=t.shift();return{done:void 0===e,value:e}}};return Zb.iterable&&(e[Symbol.iterator]=function(){return e}),e}function iC(t){this.map={},t instanceof iC?t.forEach(function(t,e){this.append(e,t)},this):Array.isArray(t)?t.forEach(function(t){this.append(t[0],t[1])},this):t&&Object.getOwnPropertyNames(t).forEach(function(e){this.append(e,t[e])},this)}function nC(t){if(t.bodyUsed)return Promise.reject(new TypeError("Already read"));t.bodyUsed=!0}function rC(t){return new Promise(function(e,i){t.onload=function(){e(t.result)},t.onerror=function(){i(t.error)}})}function oC(t){var e=new FileReader,i=rC(e);return e.readAsArrayBuffer(t),i}function sC(t){if(t.slice)return t.slice(0);var e=new Uint8Array(t.byteLength);return e.set(new Uint8Array(t)),e.buffer}function aC(){return this.bodyUsed=!1,this._initBody=function(t){var e;this._bodyInit=t,t?"string"==typeof t?this._bodyText=t:Zb.blob&&Blob.prototype.isPrototypeOf(t)?this._bodyBlob=t:Zb.formData&&FormData.prototype.isPrototypeOf(t)?this._bodyFormData=t:Zb.searchParams&&URLSearchParams.prototype.isPrototypeOf(t)?this._bodyText=t.toString():Zb.arrayBuffer&&Zb.blob&&((e=t)&&DataView.prototype.isPrototypeOf(e))?(this._bodyArrayBuffer=sC(t.buffer),this._bodyInit=new Blob([this._bodyArrayBuffer])):Zb.arrayBuffer&&(ArrayBuffer.prototype.isPrototypeOf(t)||Jb(t))?this._bodyArrayBuffer=sC(t):this._bodyText=t=Object.prototype.toString.call(t):this._bodyText="",this.headers.get("content-type")||("string"==typeof t?this.headers.set("content-type","text/plain;charset=UTF-8"):this._bodyBlob&&this._bodyBlob.type?this.headers.set("content-type",this._bodyBlob.type):Zb.searchParams&&URLSearchParams.prototype.isPrototypeOf(t)&&this.headers.set("content-type","application/x-www-form-urlencoded;charset=UTF-8"))},Zb.blob&&(this.blob=function(){var t=nC(this);if(t)return t;if(this._bodyBlob)return Promise.resolve(this._bodyBlob);if(this._bodyArrayBuffer)return Promise.resolve(new Blob([this._bodyArrayBuffer]));if(this._bodyFormData)throw new Error("could not read FormData body as blob");return Promise.resolve(new Blob([this._bodyText]))},this.arrayBuffer=function(){return this._bodyArrayBuffer?nC(this)||Promise.resolve(this._bodyArrayBuffer):this.blob().then(oC)}),this.text=function(){var t,e,i,n=nC(this);if(n)return n;if(this._bodyBlob)return t=this._bodyBlob,e=new FileReader,i=rC(e),e.readAsText(t),i;if(this._bodyArrayBuffer)return Promise.resolve(function(t){for(var e=new Uint8Array(t),i=new Array(e.length),n=0;n<e.length;n++)i[n]=String.fromCharCode(e[n]);return i.join("")}(this._bodyArrayBuffer));if(this._bodyFormData)throw new Error("could not read FormData body as text");return Promise.resolve(this._bodyText)},Zb.formData&&(this.formData=function(){return this.text().then(lC)}),this.json=function(){return this.text().then(JSON.parse)},this}iC.prototype.append=function(t,e){t=Qb(t),e=tC(e);var i=this.map[t];this.map[t]=i?i+", "+e:e},iC.prototype.delete=function(t){delete this.map[Qb(t)]},iC.prototype.get=function(t){return t=Qb(t),this.has(t)?this.map[t]:null},iC.prototype.has=function(t){return this.map.hasOwnProperty(Qb(t))},iC.prototype.set=function(t,e){this.map[Qb(t)]=tC(e)},iC.prototype.forEach=function(t,e){for(var i in this.map)this.map.hasOwnProperty(i)&&t.call(e,this.map[i],i,this)},iC.prototype.keys=function(){var t=[];return this.forEach(function(e,i){t.push(i)}),eC(t)},iC.prototype.values=function(){var t=[];return this.forEach(function(e){t.push(e)}),eC(t)},iC.prototype.entries=function(){var t=[];return this.forEach(function(e,i){t.push([i,e])}),eC(t)},Zb.iterable&&(iC.prototype[Symbol.iterator]=iC.prototype.entries);var hC=["DELETE","GET","HEAD","OPTIONS","POST","PUT"];function uC(t,e){var i,n,r=(e=e||{}).body;if(t instanceof uC){if(t.bodyUsed)throw new TypeError("Already read");this.url=t.url,this.credentials=t.credentials,e.headers||(this.headers=new iC(t.headers)),this.method=t.method,this.mode=t.mode,this.signal=t.signal,r||null==t._bodyInit||(r=t._bodyInit,t.bodyUsed=!0)}else this.url=String(t);if(this.credentials=e.credentials||this.credentials||"same-origin",!e.headers&&this.headers||(this.headers=new iC(e.headers)),this.method=(i=e.method||this.method||"GET",n=i.toUpperCase(),hC.indexOf(n)>-1?n:i),this.mode=e.mode||this.mode||null,this.signal=e.signal||this.signal,this.referrer=null,("GET"===this.method||"HEAD"===this.method)&&r)throw new TypeError("Body not allowed for GET or HEAD requests");this._initBody(r)}function lC(t){var e=new FormData;return t.trim().split("&").forEach(function(t){if(t){var i=t.split("="),n=i.shift().replace(/\+/g," "),r=i.join("=").replace(/\+/g," ");e.append(decodeURIComponent(n),decodeURIComponent(r))}}),e}function cC(t,e){e||(e={}),this.type="default",this.status=void 0===e.status?200:e.status,this.ok=this.status>=200&&this.status<300,this.statusText="statusText"in e?e.statusText:"OK",this.headers=new iC(e.headers),this.url=e.url||"",this._initBody(t)}uC.prototype.clone=function(){return new uC(this,{body:this._bodyInit})},aC.call(uC.prototype),aC.call(cC.prototype),cC.prototype.clone=function(){return new cC(this._bodyInit,{status:this.status,statusText:this.statusText,headers:new iC(this.headers),url:this.url})},cC.error=function(){var t=new cC(null,{status:0,statusText:""});return t.type="error",t};var pC=[301,302,303,307,308];cC.redirect=function(t,e){if(-1===pC.indexOf(e))throw new RangeError("Invalid status code");return new cC(null,{status:e,headers:{location:t}})};var fC=self.DOMException;try{new fC}catch(t){(fC=function(t,e){this.message=t,this.name=e;var i=Error(t);this.stack=i.stack}).prototype=Object.create(Error.prototype),fC.prototype.constructor=fC}function dC(t,e){return new Promise(function(i,n){var r=new uC(t,e);if(r.signal&&r.signal.aborted)return n(new fC("Aborted","AbortError"));var o=new XMLHttpRequest;function s(){o.abort()}o.onload=function(){var t,e,n={status:o.status,statusText:o.statusText,headers:(t=o.getAllResponseHeaders()||"",e=new iC,t.replace(/\r?\n[\t ]+/g," ").split(/\r?\n/).forEach(function(t){var i=t.split(":"),n=i.shift().trim();if(n){var r=i.join(":").trim();e.append(n,r)}}),e)};n.url="responseURL"in o?o.responseURL:n.headers.get("X-Request-URL");var r="response"in o?o.response:o.responseText;i(new cC(r,n))},o.onerror=function(){n(new TypeError("Network request failed"))},o.ontimeout=function(){n(new TypeError("Network request failed"))},o.onabort=function(){n(new fC("Aborted","AbortError"))},o.open(r.method,r.url,!0),"include"===r.credentials?o.withCredentials=!0:"omit"===r.credentials&&(o.withCredentials=!1),"responseType"in o&&Zb.blob&&(o.responseType="blob"),r.headers.forEach(function(t,e){o.setRequestHeader(e,t)}),r.signal&&(r.signal.addEventListener("abort",s),o.onreadystatechange=function(){4===o.readyState&&r.signal.removeEventListener("abort",s)}),o.send(void 0===r._bodyInit?null:r._bodyInit)})}dC.polyfill=!0,self.fetch||(self.fetch=dC,self.Headers=iC,self.Request=uC,self.Response=cC);var gC={features:{type:Array,default:Ii},loaderFactory:{type:Function,default:function(t){return function(e,i,n){var r=t.$source.getUrl();return Ni(r)&&(r=r(e,i,n)),Yi(r)?[]:dC(r,{credentials:"same-origin",mode:"cors"}).then(function(t){return t.text()}).then(function(e){return t.$source?t.$source.getFormat().readFeatures(e,{featureProjection:t.viewProjection,dataProjection:t.resolvedDataProjection}):[]})}}},formatFactory:{type:Function,default:function(){return kc()}},url:[String,Function],strategyFactory:{type:Function,default:function(){return Qg}},overlaps:{type:Boolean,default:!0}},yC=function(t,e){return t.id===e.id};function vC(t,e,i){if(Ai((t=t.slice())[0])&&Ai(t[1])){var n=Hb(xo([t[0],t[1]],e,i),2);t[0]=n[0],t[1]=n[1]}if(Ai(t[2])&&Ai(t[3])){var r=Hb(xo([t[2],t[3]],e,i),2);t[2]=r[0],t[3]=r[1]}return t}var _C=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-vector",mixins:[qg],props:gC,computed:{featureIds:function(){return this.features.map(Da)}},methods:{createSource:function(){return new i_({attributions:this.attributions,projection:this.resolvedDataProjection,loader:this.createLoader(),useSpatialIndex:this.useSpatialIndex,wrapX:this.wrapX,logo:this.logo,strategy:this.strategyFactory.call(void 0,this),format:this.formatFactory.call(void 0,this),url:this.createUrlFunc(),overlaps:this.overlaps})},createUrlFunc:function(){var t=this;if(this.url){var e=this.url;return Ni(e)||(e=Oi(this.url)),function(i,n,r){return e(vC(i,r,t.resolvedDataProjection),n,t.resolvedDataProjection)}}},createLoader:function(){var t=this;if(this.url){var e=this.loaderFactory.call(void 0,this);return function(){var i=nd(_e.mark(function i(n,r,o){var s;return _e.wrap(function(i){for(;;)switch(i.prev=i.next){case 0:return i.next=2,e(vC(n,o,t.resolvedDataProjection),r,t.resolvedDataProjection);case 2:s=i.sent,ti(s)&&t.$source.addFeatures(s);case 4:case"end":return i.stop()}},i,this)}));return function(t,e,n){return i.apply(this,arguments)}}()}},mount:function(){qg.methods.mount.call(this),this.addFeatures(this.features)},unmount:function(){this.clear(),qg.methods.unmount.call(this)}},watch:{features:function(t,e){if(this.$source){var i=tn(t,e,yC),n=tn(e,t,yC);this.addFeatures(i),this.removeFeatures(n)}}}},void 0,void 0);function mC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};mC.installed||(mC.installed=!0,e=Zi(e,"dataProjection"),pe(_C,e),t.component(_C.name,_C))}var EC=Object.freeze({default:mC,Source:_C,install:mC}),xC=function(t){function e(e){var i=e||{},n=i.renderMode||JT.HYBRID;sn(void 0==n||n==JT.IMAGE||n==JT.HYBRID||n==JT.VECTOR,28),i.declutter&&n==JT.IMAGE&&(n=JT.HYBRID),i.renderMode=n;var r=qn({},i);delete r.preload,delete r.useInterimTilesOnError,t.call(this,r),this.setPreload(i.preload?i.preload:0),this.setUseInterimTilesOnError(void 0===i.useInterimTilesOnError||i.useInterimTilesOnError),this.type=W_.VECTOR_TILE}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getPreload=function(){return this.get(jb)},e.prototype.getUseInterimTilesOnError=function(){return this.get(Gb)},e.prototype.setPreload=function(t){this.set(jb,t)},e.prototype.setUseInterimTilesOnError=function(t){this.set(Gb,t)},e}(nm);xC.prototype.getSource;var SC=["vector","image","hybrid"];var TC=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="layer.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-layer-vector-tile",mixins:[Xg],props:{renderMode:{type:String,default:"hybrid",validator:function(t){return SC.includes(t)}},preload:{type:Number,default:0}},methods:{createLayer:function(){return new xC({id:this.id,minResolution:this.minResolution,maxResolution:this.maxResolution,opacity:this.opacity,visible:this.visible,preload:this.preload,extent:this.extent,zIndex:this.zIndex,updateWhileAnimating:this.updateWhileAnimating,updateWhileInteracting:this.updateWhileInteracting,source:this._source,renderMode:this.renderMode,renderBuffer:this.renderBuffer,renderOrder:this.renderOrder,declutter:this.declutter})}}},void 0,void 0);function wC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};wC.installed||(wC.installed=!0,e=Zi(e,"dataProjection"),pe(TC,e),t.component(TC.name,TC))}var bC=Object.freeze({default:wC,Layer:TC,install:wC}),CC=function(t){function e(i,n,r,o,s,a,h,u,l,c,p,f,d,g,y){if(t.call(this,i,n,{transition:0}),this.context_={},this.loader_,this.replayState_={},this.sourceTiles_=c,this.tileKeys=[],this.extent=null,this.sourceRevision_=r,this.wrappedTileCoord=a,this.loadListenerKeys_=[],this.sourceTileListenerKeys_=[],a){var v=this.extent=l.getTileCoordExtent(a),_=l.getResolution(y),m=u.getZForResolution(_),E=y!=i[0],x=0;if(u.forEachTileCoord(v,m,function(t){var e=Nn(v,u.getTileCoordExtent(t)),i=u.getExtent();if(i&&(e=Nn(e,i,e)),Gn(e)/_>=.5&&An(e)/_>=.5){++x;var n=t.toString(),r=c[n];if(!r&&!E){var a=h(t,p,f);r=c[n]=new d(t,void 0==a?or.EMPTY:or.IDLE,void 0==a?"":a,o,s),this.sourceTileListenerKeys_.push(gr(r,br.CHANGE,g))}!r||E&&r.getState()!=or.LOADED||(r.consumers++,this.tileKeys.push(n))}}.bind(this)),E&&x==this.tileKeys.length&&this.finishLoading_(),y<=i[0]&&this.state!=or.LOADED)for(;y>l.getMinZoom();){var S=new e(i,n,r,o,s,a,h,u,l,c,p,f,d,xr,--y);if(S.state==or.LOADED){this.interimTile=S;break}}}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.state=or.ABORT,this.changed(),this.interimTile&&this.interimTile.dispose();for(var e=0,i=this.tileKeys.length;e<i;++e){var n=this.tileKeys[e],r=this.getTile(n);r.consumers--,0==r.consumers&&(delete this.sourceTiles_[n],r.dispose())}this.tileKeys.length=0,this.sourceTiles_=null,this.loadListenerKeys_.forEach(_r),this.loadListenerKeys_.length=0,this.sourceTileListenerKeys_.forEach(_r),this.sourceTileListenerKeys_.length=0,t.prototype.disposeInternal.call(this)},e.prototype.getContext=function(t){var e=nn(t).toString();return e in this.context_||(this.context_[e]=Rr()),this.context_[e]},e.prototype.getImage=function(t){return-1==this.getReplayState(t).renderedTileRevision?null:this.getContext(t).canvas},e.prototype.getReplayState=function(t){var e=nn(t).toString();return e in this.replayState_||(this.replayState_[e]={dirty:!1,renderedRenderOrder:null,renderedRevision:-1,renderedTileRevision:-1}),this.replayState_[e]},e.prototype.getKey=function(){return this.tileKeys.join("/")+"-"+this.sourceRevision_},e.prototype.getTile=function(t){return this.sourceTiles_[t]},e.prototype.load=function(){var t=0,e={};this.state==or.IDLE&&this.setState(or.LOADING),this.state==or.LOADING&&this.tileKeys.forEach(function(i){var n=this.getTile(i);if(n.state==or.IDLE&&(n.setLoader(this.loader_),n.load()),n.state==or.LOADING){var r=gr(n,br.CHANGE,function(i){var r=n.getState();if(r==or.LOADED||r==or.ERROR){var o=nn(n);r==or.ERROR?e[o]=!0:(--t,delete e[o]),t-Object.keys(e).length==0&&this.finishLoading_()}}.bind(this));this.loadListenerKeys_.push(r),++t}}.bind(this)),t-Object.keys(e).length==0&&setTimeout(this.finishLoading_.bind(this),0)},e.prototype.finishLoading_=function(){for(var t=this.tileKeys.length,e=0,i=t-1;i>=0;--i){var n=this.getTile(this.tileKeys[i]).getState();n!=or.LOADED&&--t,n==or.EMPTY&&++e}t==this.tileKeys.length?(this.loadListenerKeys_.forEach(_r),this.loadListenerKeys_.length=0,this.setState(or.LOADED)):this.setState(e==this.tileKeys.length?or.EMPTY:or.ERROR)},e}(Cr);function RC(t,e){var i=kv(e,t.getFormat(),t.onLoad.bind(t),t.onError.bind(t));t.setLoader(i)}var OC=[0,0,4096,4096],IC=function(t){function e(e,i,n,r,o,s){t.call(this,e,i,s),this.consumers=0,this.extent_=null,this.format_=r,this.features_=null,this.loader_,this.projection_=null,this.replayGroups_={},this.tileLoadFunction_=o,this.url_=n}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.disposeInternal=function(){this.features_=null,this.replayGroups_={},this.state=or.ABORT,this.changed(),t.prototype.disposeInternal.call(this)},e.prototype.getExtent=function(){return this.extent_||OC},e.prototype.getFormat=function(){return this.format_},e.prototype.getFeatures=function(){return this.features_},e.prototype.getKey=function(){return this.url_},e.prototype.getProjection=function(){return this.projection_},e.prototype.getReplayGroup=function(t,e){return this.replayGroups_[nn(t)+","+e]},e.prototype.load=function(){this.state==or.IDLE&&(this.setState(or.LOADING),this.tileLoadFunction_(this,this.url_),this.loader_(null,NaN,null))},e.prototype.onLoad=function(t,e,i){this.setProjection(e),this.setFeatures(t),this.setExtent(i)},e.prototype.onError=function(){this.setState(or.ERROR)},e.prototype.setExtent=function(t){this.extent_=t},e.prototype.setFeatures=function(t){this.features_=t,this.setState(or.LOADED)},e.prototype.setProjection=function(t){this.projection_=t},e.prototype.setReplayGroup=function(t,e,i){this.replayGroups_[nn(t)+","+e]=i},e.prototype.setLoader=function(t){this.loader_=t},e}(Cr),PC=function(t){function e(e){var i=e.projection||"EPSG:3857",n=e.extent||rs(i),r=e.tileGrid||es({extent:n,maxZoom:e.maxZoom||22,minZoom:e.minZoom,tileSize:e.tileSize||512});t.call(this,{attributions:e.attributions,cacheSize:void 0!==e.cacheSize?e.cacheSize:128,extent:n,opaque:!1,projection:i,state:e.state,tileGrid:r,tileLoadFunction:e.tileLoadFunction?e.tileLoadFunction:RC,tileUrlFunction:e.tileUrlFunction,url:e.url,urls:e.urls,wrapX:void 0===e.wrapX||e.wrapX,transition:e.transition}),this.format_=e.format?e.format:null,this.sourceTiles_={},this.overlaps_=void 0==e.overlaps||e.overlaps,this.tileClass=e.tileClass?e.tileClass:IC,this.tileGrids_={}}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getOverlaps=function(){return this.overlaps_},e.prototype.clear=function(){this.tileCache.clear(),this.sourceTiles_={}},e.prototype.getTile=function(t,e,i,n,r){var o=Nr(t,e,i);if(this.tileCache.containsKey(o))return this.tileCache.get(o);var s=[t,e,i],a=this.getTileCoordForTileUrlFunction(s,r),h=new CC(s,null!==a?or.IDLE:or.EMPTY,this.getRevision(),this.format_,this.tileLoadFunction,a,this.tileUrlFunction,this.tileGrid,this.getTileGridForProjection(r),this.sourceTiles_,n,r,this.tileClass,this.handleTileChange.bind(this),s[0]);return this.tileCache.set(o,h),h},e.prototype.getTileGridForProjection=function(t){var e=t.getCode(),i=this.tileGrids_[e];if(!i){var n=this.tileGrid;i=this.tileGrids_[e]=ns(t,void 0,n?n.getTileSize(n.getMinZoom()):void 0)}return i},e.prototype.getTilePixelRatio=function(t){return t},e.prototype.getTilePixelSize=function(t,e,i){var n=nr(this.getTileGridForProjection(i).getTileSize(t),this.tmpSize);return[Math.round(n[0]*e),Math.round(n[1]*e)]},e}(ls);var FC=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-vector-tile",mixins:[zg],props:{cacheSize:{type:Number,default:128},formatFactory:{type:Function,default:function(){return jc()}},overlaps:{type:Boolean,default:!0}},methods:{createSource:function(){return new PC({attributions:this.attributions,cacheSize:this.cacheSize,format:this.formatFactory(),logo:this.logo,overlaps:this.overlaps,projection:this.projection,tileGrid:this._tileGrid,tileLoadFunction:this.tileLoadFunction,tileUrlFunction:this.createUrlFunc(),wrapX:this.wrapX,transition:this.transition})}}},void 0,void 0);function LC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};LC.installed||(LC.installed=!0,e=Zi(e,"dataProjection"),pe(FC,e),t.component(FC.name,FC))}var MC=Object.freeze({default:LC,Source:FC,install:LC}),AC=function(t){function e(e){var i=e||{},n=i.params||{},r=!("TRANSPARENT"in n)||n.TRANSPARENT;t.call(this,{attributions:i.attributions,cacheSize:i.cacheSize,crossOrigin:i.crossOrigin,opaque:!r,projection:i.projection,reprojectionErrorThreshold:i.reprojectionErrorThreshold,tileClass:i.tileClass,tileGrid:i.tileGrid,tileLoadFunction:i.tileLoadFunction,url:i.url,urls:i.urls,wrapX:void 0===i.wrapX||i.wrapX,transition:i.transition}),this.gutter_=void 0!==i.gutter?i.gutter:0,this.params_=n,this.v13_=!0,this.serverType_=i.serverType,this.hidpi_=void 0===i.hidpi||i.hidpi,this.tmpExtent_=[1/0,1/0,-1/0,-1/0],this.updateV13_(),this.setKey(this.getKeyForParams_())}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getGetFeatureInfoUrl=function(t,e,i,n){var r=fo(i),o=this.getProjection(),s=this.getTileGrid();s||(s=this.getTileGridForProjection(r));var a=s.getTileCoordForCoordAndResolution(t,e);if(!(s.getResolutions().length<=a[0])){var h=s.getResolution(a[0]),u=s.getTileCoordExtent(a,this.tmpExtent_),l=nr(s.getTileSize(a[0]),this.tmpSize),c=this.gutter_;0!==c&&(l=er(l,c,this.tmpSize),u=ln(u,h*c,u)),o&&o!==r&&(h=To(o,r,t,h),u=So(u,r,o),t=xo(t,r,o));var p={SERVICE:"WMS",VERSION:"1.3.0",REQUEST:"GetFeatureInfo",FORMAT:"image/png",TRANSPARENT:!0,QUERY_LAYERS:this.params_.LAYERS};qn(p,this.params_,n);var f=Math.floor((t[0]-u[0])/h),d=Math.floor((u[3]-t[1])/h);return p[this.v13_?"I":"X"]=f,p[this.v13_?"J":"Y"]=d,this.getRequestUrl_(a,l,u,1,o||r,p)}},e.prototype.getGutter=function(){return this.gutter_},e.prototype.getParams=function(){return this.params_},e.prototype.getRequestUrl_=function(t,e,i,n,r,o){var s=this.urls;if(s){if(o.WIDTH=e[0],o.HEIGHT=e[1],o[this.v13_?"CRS":"SRS"]=r.getCode(),"STYLES"in this.params_||(o.STYLES=""),1!=n)switch(this.serverType_){case _E.GEOSERVER:var a=90*n+.5|0;"FORMAT_OPTIONS"in o?o.FORMAT_OPTIONS+=";dpi:"+a:o.FORMAT_OPTIONS="dpi:"+a;break;case _E.MAPSERVER:o.MAP_RESOLUTION=90*n;break;case _E.CARMENTA_SERVER:case _E.QGIS:o.DPI=90*n;break;default:sn(!1,52)}var h,u,l=r.getAxisOrientation(),c=i;if(this.v13_&&"ne"==l.substr(0,2))h=i[0],c[0]=i[1],c[1]=h,h=i[2],c[2]=i[3],c[3]=h;if(o.BBOX=c.join(","),1==s.length)u=s[0];else u=s[Hn(jr(t),s.length)];return fs(u,o)}},e.prototype.getTilePixelRatio=function(t){return this.hidpi_&&void 0!==this.serverType_?t:1},e.prototype.getKeyForParams_=function(){var t=0,e=[];for(var i in this.params_)e[t++]=i+"-"+this.params_[i];return e.join("/")},e.prototype.fixedTileUrlFunction=function(t,e,i){var n=this.getTileGrid();if(n||(n=this.getTileGridForProjection(i)),!(n.getResolutions().length<=t[0])){1==e||this.hidpi_&&void 0!==this.serverType_||(e=1);var r=n.getResolution(t[0]),o=n.getTileCoordExtent(t,this.tmpExtent_),s=nr(n.getTileSize(t[0]),this.tmpSize),a=this.gutter_;0!==a&&(s=er(s,a,this.tmpSize),o=ln(o,r*a,o)),1!=e&&(s=ir(s,e,this.tmpSize));var h={SERVICE:"WMS",VERSION:"1.3.0",REQUEST:"GetMap",FORMAT:"image/png",TRANSPARENT:!0};return qn(h,this.params_),this.getRequestUrl_(t,s,o,e,i,h)}},e.prototype.updateParams=function(t){qn(this.params_,t),this.updateV13_(),this.setKey(this.getKeyForParams_())},e.prototype.updateV13_=function(){var t=this.params_.VERSION||"1.3.0";this.v13_=Sv(t,"1.3")>=0},e}(cs);var NC=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-wms",mixins:[zg,Jy],props:{gutter:Number,hidpi:{type:Boolean,default:!0},serverType:{type:String,validator:function(t){return!t|p_(_E).includes(t)}},url:{type:String,required:!0,validator:function(t){return!!t.length}}},methods:{createSource:function(){return new AC({attributions:this.attributions,cacheSize:this.cacheSize,params:this.allParams,crossOrigin:this.crossOrigin,gutter:this.gutter,hidpi:this.hidpi,logo:this.logo,tileGrid:this._tileGrid,projection:this.projection,reprojectionErrorThreshold:this.reprojectionErrorThreshold,serverType:this.serverType,wrapX:this.wrapX,url:this.urlTmpl,transition:this.transition,tileLoadFunction:this.tileLoadFunction})}}},void 0,void 0);function kC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};kC.installed||(kC.installed=!0,e=Zi(e,"dataProjection"),pe(NC,e),t.component(NC.name,NC))}var jC=Object.freeze({default:kC,Source:NC,install:kC}),GC={KVP:"KVP",REST:"REST"},DC=function(t){function e(e){t.call(this,{extent:e.extent,origin:e.origin,origins:e.origins,resolutions:e.resolutions,tileSize:e.tileSize,tileSizes:e.tileSizes,sizes:e.sizes}),this.matrixIds_=e.matrixIds}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.getMatrixId=function(t){return this.matrixIds_[t]},e.prototype.getMatrixIds=function(){return this.matrixIds_},e}(Jo),$C=function(t){function e(e){var i=void 0!==e.requestEncoding?e.requestEncoding:GC.KVP,n=e.tileGrid,r=e.urls;void 0===r&&void 0!==e.url&&(r=Lo(e.url)),t.call(this,{attributions:e.attributions,cacheSize:e.cacheSize,crossOrigin:e.crossOrigin,projection:e.projection,reprojectionErrorThreshold:e.reprojectionErrorThreshold,tileClass:e.tileClass,tileGrid:n,tileLoadFunction:e.tileLoadFunction,tilePixelRatio:e.tilePixelRatio,tileUrlFunction:Fo,urls:r,wrapX:void 0!==e.wrapX&&e.wrapX,transition:e.transition}),this.version_=void 0!==e.version?e.version:"1.0.0",this.format_=void 0!==e.format?e.format:"image/jpeg",this.dimensions_=void 0!==e.dimensions?e.dimensions:{},this.layer_=e.layer,this.matrixSet_=e.matrixSet,this.style_=e.style,this.requestEncoding_=i,this.setKey(this.getKeyForDimensions_()),r&&r.length>0&&(this.tileUrlFunction=Po(r.map(VC.bind(this))))}return t&&(e.__proto__=t),e.prototype=Object.create(t&&t.prototype),e.prototype.constructor=e,e.prototype.setUrls=function(t){this.urls=t;var e=t.join("\n");this.setTileUrlFunction(this.fixedTileUrlFunction?this.fixedTileUrlFunction.bind(this):Po(t.map(VC.bind(this))),e)},e.prototype.getDimensions=function(){return this.dimensions_},e.prototype.getFormat=function(){return this.format_},e.prototype.getLayer=function(){return this.layer_},e.prototype.getMatrixSet=function(){return this.matrixSet_},e.prototype.getRequestEncoding=function(){return this.requestEncoding_},e.prototype.getStyle=function(){return this.style_},e.prototype.getVersion=function(){return this.version_},e.prototype.getKeyForDimensions_=function(){var t=0,e=[];for(var i in this.dimensions_)e[t++]=i+"-"+this.dimensions_[i];return e.join("/")},e.prototype.updateDimensions=function(t){qn(this.dimensions_,t),this.setKey(this.getKeyForDimensions_())},e}(cs);function VC(t){var e=this.requestEncoding_,i={layer:this.layer_,style:this.style_,tilematrixset:this.matrixSet_};e==GC.KVP&&qn(i,{Service:"WMTS",Request:"GetTile",Version:this.version_,Format:this.format_}),t=e==GC.KVP?fs(t,i):t.replace(/\{(\w+?)\}/g,function(t,e){return e.toLowerCase()in i?i[e.toLowerCase()]:t});var n=this.tileGrid,r=this.dimensions_;return function(i,o,s){if(i){var a={TileMatrix:n.getMatrixId(i[0]),TileCol:i[1],TileRow:-i[2]-1};qn(a,r);var h=t;return h=e==GC.KVP?fs(h,a):h.replace(/\{(\w+?)\}/g,function(t,e){return a[e]})}}}var zC=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-wmts",mixins:[zg],props:{dimensions:Object,format:{type:String,default:"image/jpeg"},layerName:{type:String,required:!0},matrixSet:{type:String,required:!0},requestEncoding:{type:String,default:"KVP"},styleName:{type:String,required:!0},version:{type:String,default:"1.0.0"},url:{type:String,required:!0}},methods:{createSource:function(){return new $C({attributions:this.attributions,cacheSize:this.cacheSize,crossOrigin:this.crossOrigin,dimensions:this.dimensions,format:this.format,layer:this.layerName,logo:this.logo,matrixSet:this.matrixSet,projection:this.projection,reprojectionErrorThreshold:this.reprojectionErrorThreshold,requestEncoding:this.requestEncoding,tileGrid:this._tileGrid,tilePixelRatio:this.tilePixelRatio,style:this.styleName,version:this.version,url:this.urlTmpl,wrapX:this.wrapX,transition:this.transition,tileLoadFunction:this.tileLoadFunction})},createTileGrid:function(){Oh(this);var t=Bp(this.$view.getProjection()),e=Gg(t,this.maxZoom,this.tileSize);return new DC({extent:t,resolutions:e,tileSize:this.tileSize,minZoom:this.minZoom,matrixIds:gi(Qi(this.minZoom,e.length))})}}},void 0,void 0);function YC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};YC.installed||(YC.installed=!0,e=Zi(e,"dataProjection"),pe(zC,e),t.component(zC.name,zC))}var XC=Object.freeze({default:YC,Source:zC,install:YC});var UC=function(t,e,i,n,r,o,s,a){var h=("function"==typeof i?i.options:i)||{};return h.__file="source.vue",h.render||(h.render=t.render,h.staticRenderFns=t.staticRenderFns,h._compiled=!0,r&&(h.functional=!0)),h._scopeId=n,h}({},0,{name:"vl-source-xyz",mixins:[tv],props:{}},void 0,void 0);function BC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};BC.installed||(BC.installed=!0,e=Zi(e,"dataProjection"),pe(UC,e),t.component(UC.name,UC))}var KC=Object.freeze({default:BC,Source:UC,install:BC});function WC(t){var e=arguments.length>1&&void 0!==arguments[1]?arguments[1]:{};WC.installed||(WC.installed=!0,t.use(uv,e),t.use(fv,e),t.use(yv,e),t.use(Ev,e),t.use(u_,e),t.use(mm,e),t.use(Tm,e),t.use(Rm,e),t.use(Mm,e),t.use(Xm,e),t.use(Hm,e),t.use(Qm,e),t.use(nE,e),t.use(vE,e),t.use(TE,e),t.use(CE,e),t.use(gw,e),t.use(mw,e),t.use(bw,e),t.use(Ow,e),t.use(Fw,e),t.use(Aw,e),t.use(Dw,e),t.use(Yw,e),t.use(Bw,e),t.use(Hw,e),t.use(Qw,e),t.use(nb,e),t.use(ub,e),t.use(fb,e),t.use(Eb,e),t.use(wb,e),t.use(Ob,e),t.use(Lb,e),t.use(kb,e),t.use(Yb,e),t.use(Kb,e),t.use(EC,e),t.use(bC,e),t.use(MC,e),t.use(jC,e),t.use(XC,e),t.use(KC,e))}var HC=Object.freeze({default:WC,VERSION:"0.11.1",install:WC,ArcgisRestSource:uv,BingmapsSource:fv,CircleGeom:yv,CircleStyle:Ev,ClusterSource:u_,DrawInteraction:mm,Feature:Tm,FillStyle:Rm,Geoloc:Mm,Graticule:Xm,GroupLayer:Hm,IconStyle:Qm,ImageLayer:nE,ImageStaticSource:vE,ImageWmsSource:TE,LineStringGeom:CE,Map:gw,MapboxSource:mw,ModifyInteraction:bw,MultiLineStringGeom:Ow,MultiPointGeom:Fw,MultiPolygonGeom:Aw,OsmSource:Dw,Overlay:Yw,PointGeom:Bw,PolygonGeom:Hw,RegShapeStyle:Qw,SelectInteraction:nb,SnapInteraction:ub,SputnikSource:fb,StamenSource:Eb,StrokeStyle:wb,StyleBox:Ob,StyleFunc:Lb,TextStyle:kb,TileLayer:Yb,VectorLayer:Kb,VectorSource:EC,VectorTileLayer:bC,VectorTileSource:MC,WmsSource:jC,WmtsSource:XC,XyzSource:KC}),ZC=ue({},HC,{olExt:Zy,rxExt:qd});return void 0!==window.Vue&&window.Vue.use(HC),ZC});
\ No newline at end of file
@@ -0,0 +1,8 @@
+package gui
+import (
+	"git.parallelcoin.io/dev/9/cmd/ll"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// Log is the logger for the GUI server
+var Log = cl.NewSubSystem("cmd/gui", ll.DEFAULT)
+var log = Log.Ch
@@ -0,0 +1,131 @@
+package gui
+import (
+	js "encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+// the files the data of the GUI is kept in
+const (
+	addressBookFile = "addressbook.json"
+	requestsFile    = "requests.json"
+	confFile        = "interface.json"
+)
+// Label is an address in the address book
+type Label struct {
+	Label   string `json:"label"`
+	Address string `json:"address"`
+}
+// Request is a payment requested from someone to an address of the wallet
+type Request struct {
+	Time    int64  `json:"time"`
+	Label   string `json:"label"`
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+	Desc    string `json:"desc"`
+}
+// Conf is the settings of the interface
+type Conf struct {
+	Interface InterfaceConf `json:"Interface"`
+}
+// InterfaceConf is the language and looks of the interface
+type InterfaceConf struct {
+	Lang string `json:"lang"`
+}
+// store keeps the data of the GUI in JSON files in a directory
+type store struct {
+	sync.Mutex
+	dir string
+}
+// read decodes the file name into v, leaving v as it is if the file doesn't exist yet
+func (s *store) read(
+	name string, v interface{}) error {
+	b, err := ioutil.ReadFile(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return js.Unmarshal(b, v)
+}
+// write replaces the file name with v encoded as JSON
+func (s *store) write(
+	name string, v interface{}) error {
+	b, err := js.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.dir, name+".tmp")
+	if err = ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(s.dir, name))
+}
+// labels returns the address book sorted by label
+func (s *store) labels() (labels []Label, err error) {
+	s.Lock()
+	defer s.Unlock()
+	book := make(map[string]string)
+	if err = s.read(addressBookFile, &book); err != nil {
+		return
+	}
+	labels = []Label{}
+	for label, address := range book {
+		labels = append(labels, Label{Label: label, Address: address})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Label < labels[j].Label })
+	return
+}
+// setLabel stores the address of a label, or deletes the label if the address is empty
+func (s *store) setLabel(
+	l Label) error {
+	s.Lock()
+	defer s.Unlock()
+	book := make(map[string]string)
+	if err := s.read(addressBookFile, &book); err != nil {
+		return err
+	}
+	if l.Address == "" {
+		delete(book, l.Label)
+	} else {
+		book[l.Label] = l.Address
+	}
+	return s.write(addressBookFile, book)
+}
+// requests returns the payments requested, the latest first
+func (s *store) requests() (requests []Request, err error) {
+	s.Lock()
+	defer s.Unlock()
+	requests = []Request{}
+	err = s.read(requestsFile, &requests)
+	return
+}
+// addRequest stores a payment request
+func (s *store) addRequest(
+	r Request) error {
+	s.Lock()
+	defer s.Unlock()
+	requests := []Request{}
+	if err := s.read(requestsFile, &requests); err != nil {
+		return err
+	}
+	return s.write(requestsFile, append([]Request{r}, requests...))
+}
+// conf returns the settings of the interface, English if they were never saved
+func (s *store) conf() (c Conf, err error) {
+	s.Lock()
+	defer s.Unlock()
+	c.Interface.Lang = "en"
+	err = s.read(confFile, &c)
+	return
+}
+// setConf stores the settings of the interface
+func (s *store) setConf(
+	c Conf) error {
+	s.Lock()
+	defer s.Unlock()
+	return s.write(confFile, c)
+}
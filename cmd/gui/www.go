@@ -0,0 +1,93 @@
+package gui
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/gob"
+	js "encoding/json"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+//go:generate go run genwww.go
+// tokenMark is replaced in the index page with the token of the server
+const tokenMark = "__GUI_TOKEN__"
+var (
+	assetsOnce sync.Once
+	assets     map[string][]byte
+	vueData    []byte
+)
+// loadAssets unpacks the files of the www directory from wwwData and makes the script with the templates of the views and the icons. The pages were written for a webview that provided these as the global vuedata.
+func loadAssets() {
+	assets = make(map[string][]byte)
+	var files []struct {
+		Name    string
+		Content []byte
+	}
+	r, err := zlib.NewReader(base64.NewDecoder(base64.StdEncoding, strings.NewReader(wwwData)))
+	if err == nil {
+		err = gob.NewDecoder(r).Decode(&files)
+	}
+	if err != nil {
+		panic("the files of the GUI are damaged: " + err.Error())
+	}
+	for _, f := range files {
+		assets[f.Name] = f.Content
+	}
+	pages, icons := make(map[string]string), make(map[string]string)
+	for name, content := range assets {
+		dir, file := path.Split(name)
+		ext := path.Ext(file)
+		switch {
+		case dir == "views/" && ext == ".html":
+			pages[strings.TrimSuffix(file, ext)] = string(content)
+		case dir == "icons/" && ext == ".svg":
+			icons[strings.TrimSuffix(file, ext)] = string(content)
+		}
+	}
+	data, _ := js.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{"pages": pages, "icons": icons},
+	})
+	vueData = append(append([]byte("var vuedata = "), data...), ";\n"...)
+}
+// handlePage serves the index page, to requests with the token of the server which it puts in the page, and the files of the pages
+func (s *Server) handlePage(
+	w http.ResponseWriter, r *http.Request) {
+	assetsOnce.Do(loadAssets)
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	if name == "" {
+		name = "index.html"
+	}
+	content, ok := assets[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if name == "index.html" {
+		if r.URL.Query().Get(TokenParam) != s.token {
+			http.Error(w, "open the address with the token printed by the gui command", http.StatusForbidden)
+			return
+		}
+		content = bytes.Replace(content, []byte(tokenMark), []byte(s.token), -1)
+	}
+	if t := mime.TypeByExtension(path.Ext(name)); t != "" {
+		w.Header().Set("Content-Type", t)
+	}
+	w.Write(content)
+}
+// handleVueData serves the script with the templates of the views and the icons
+func (s *Server) handleVueData(
+	w http.ResponseWriter, r *http.Request) {
+	assetsOnce.Do(loadAssets)
+	w.Header().Set("Content-Type", "application/javascript")
+	w.Write(vueData)
+}
+// hasLanguage returns whether the pages have a translation to lang
+func hasLanguage(
+	lang string) bool {
+	assetsOnce.Do(loadAssets)
+	_, ok := assets["lang/"+lang+".json"]
+	return ok
+}
@@ -0,0 +1,74 @@
+Vue.use(Buefy);
+Vue.use(VueVirtualScroller);
+
+var app = new Vue({
+  el: '#app',
+  data () {
+    return {
+    vdt: vuedata,
+    bcd: blockchaindata,
+    lng: language,
+    ab: addressbook,
+    rpc:rpcinterface,
+    cnf:conf,
+    timer: '',
+    component: HomeC,
+  }
+},
+created: function() {
+  this.config().then(this.lang, this.lang);
+  this.ref();
+  this.timer = setInterval(this.ref, 5000)
+},
+watch:{
+  'rpc.data.MSG': function(newVal, oldVal) {
+    if (newVal && newVal != oldVal){
+        this.warning(newVal);
+      }
+  },
+  'rpc.data.ERR': function(newVal, oldVal) {
+    if (newVal && newVal != oldVal){
+        this.danger(newVal);
+      }
+  },
+  'rpc.data.BlockCount': function(newVal, oldVal) {
+    if (oldVal && newVal != oldVal){
+        this.warning("New block: "+ newVal);
+      }
+  },
+  'cnf.data.Interface.lang': function() { this.lang(); },
+},
+methods: {
+  warning(val) {
+    this.$toast.open({
+        duration: 5000,
+        message: val,
+        type: 'is-warning',
+        position: 'is-top',
+        queue: false,
+    })
+  },
+  danger(val) {
+    this.$toast.open({
+        duration: 5000,
+        message: val,
+        type: 'is-danger',
+        position: 'is-top',
+        queue: false,
+    })
+  },
+  swapComponent: function(component){this.component = component;},
+  ref: function() {
+    rpcinterface.getInfo();
+    rpcinterface.getBlockCount().catch(function() {});
+    blockchaindata.getInfoData();
+  },
+  config: function() { return conf.confData(); },
+  lang: function() { language.languageData(conf.data.Interface.lang); },
+  saveLang: function(lang) { conf.saveInterfaceConf(lang); },
+  cancelAutoUpdate: function() { clearInterval(this.timer) }
+},
+beforeDestroy() {
+clearInterval(this.timer)
+}
+});
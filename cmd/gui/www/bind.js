@@ -0,0 +1,200 @@
+// bind.js provides the objects the views were written against, that a webview used to bind to
+// the wallet in process, on top of the API of the GUI server. Every object has its state in
+// data, which the methods update in place so the views that hold parts of it see the changes.
+
+var guiToken = document.querySelector('meta[name="gui-token"]').getAttribute('content');
+
+// api sends a request to the API of the GUI server and resolves to the result or rejects with
+// the error message
+function api(path, body) {
+  var req = {headers: {}};
+  req.headers['X-Gui-Token'] = guiToken;
+  if (body !== undefined) {
+    req.method = 'POST';
+    req.headers['Content-Type'] = 'application/json';
+    req.body = JSON.stringify(body);
+  }
+  return fetch('/api/' + path, req).then(function(res) {
+    return res.json().then(function(v) {
+      if (!res.ok) {
+        throw new Error(v.error);
+      }
+      return v;
+    });
+  });
+}
+
+// update puts the values of from into to, keeping the arrays and objects of to so that the
+// views that hold them see the change
+function update(to, from) {
+  Object.keys(from).forEach(function(k) {
+    var v = from[k];
+    if (Array.isArray(v) && Array.isArray(to[k])) {
+      to[k].splice.apply(to[k], [0, to[k].length].concat(v));
+    } else if (v && typeof v === 'object' && !Array.isArray(v) &&
+      to[k] && typeof to[k] === 'object' && !Array.isArray(to[k])) {
+      update(to[k], v);
+    } else {
+      Vue.set(to, k, v);
+    }
+  });
+}
+
+var rpcinterface = {
+  data: {
+    MSG: '',
+    ERR: '',
+    Connected: false,
+    Address: '',
+    BlockCount: 0,
+    Getblockcount: 0,
+    Connections: 0,
+    Getinfo: {balance: 0, blocks: 0, connections: 0, difficulty: 0, version: 0, protocolversion: 0, walletversion: 0, testnet: false},
+    Peers: [],
+  },
+  // call sends a command to the wallet server, which passes the commands of the full node on
+  call: function(method, params) {
+    return api('rpc', {method: method, params: (params || []).map(String)});
+  },
+  // show puts a message into MSG or ERR, emptying it first so that the same message shows again
+  show: function(key, msg) {
+    rpcinterface.data[key] = '';
+    Vue.nextTick(function() {
+      rpcinterface.data[key] = msg;
+    });
+  },
+  // fail shows the error of a command the user gave
+  fail: function(err) {
+    rpcinterface.show('ERR', err.message);
+    throw err;
+  },
+  getBlockCount: function() {
+    return rpcinterface.call('getblockcount').then(function(n) {
+      rpcinterface.data.BlockCount = n;
+      rpcinterface.data.Getblockcount = n;
+    });
+  },
+  getInfo: function() {
+    return rpcinterface.call('getinfo').then(function(info) {
+      update(rpcinterface.data.Getinfo, info);
+      rpcinterface.data.Connections = info.connections;
+      rpcinterface.data.Connected = true;
+    }, function() {
+      rpcinterface.data.Connected = false;
+    });
+  },
+  getPeerInfo: function() {
+    return rpcinterface.call('getpeerinfo').then(function(peers) {
+      update(rpcinterface.data, {Peers: peers || []});
+    });
+  },
+  getNewAddress: function() {
+    return rpcinterface.call('getnewaddress').then(function(address) {
+      rpcinterface.data.Address = address;
+      rpcinterface.show('MSG', 'Created new address: ' + address);
+      return address;
+    }, rpcinterface.fail);
+  },
+  walletPassphrase: function(passphrase, timeout) {
+    return rpcinterface.call('walletpassphrase', [passphrase, timeout]).catch(rpcinterface.fail);
+  },
+  sendToAddress: function(address, label, amount) {
+    return rpcinterface.call('sendtoaddress', [address, amount]).then(function(txid) {
+      rpcinterface.show('MSG', 'Sent transaction ' + txid);
+      if (label) {
+        addressbooklabel.addressBookLabelWrite(label, address);
+      }
+      blockchaindata.getInfoData();
+      return txid;
+    }, rpcinterface.fail);
+  },
+};
+
+var blockchaindata = {
+  data: {
+    balance: 0,
+    unconfirmed: 0,
+    total: 0,
+    listtransactions: [],
+    listalltransactions: [],
+    listallsendtransactions: [],
+  },
+  getInfoData: function() {
+    return api('overview').then(function(o) {
+      update(blockchaindata.data, o);
+    }, function() {});
+  },
+};
+
+var language = {
+  data: {},
+  languageData: function(lang) {
+    if (language.lang === lang) {
+      return Promise.resolve();
+    }
+    return fetch('/lang/' + lang + '.json').then(function(res) {
+      return res.json();
+    }).then(function(l) {
+      language.lang = lang;
+      update(language.data, l);
+    });
+  },
+};
+
+var conf = {
+  data: {Interface: {lang: 'en'}},
+  confData: function() {
+    return api('conf').then(function(c) {
+      update(conf.data, c);
+    });
+  },
+  saveInterfaceConf: function(lang) {
+    return api('conf', {Interface: {lang: lang}}).then(function(c) {
+      update(conf.data, c);
+      rpcinterface.show('MSG', 'Settings saved');
+    }, rpcinterface.fail);
+  },
+};
+
+var addressbook = {
+  data: {labels: []},
+  addressBookData: function() {
+    return api('addressbook').then(function(ab) {
+      update(addressbook.data, ab);
+    });
+  },
+};
+
+var addressbooklabel = {
+  addressBookLabelWrite: function(label, address) {
+    return api('addressbook', {label: label, address: address}).then(function(ab) {
+      update(addressbook.data, ab);
+    }, rpcinterface.fail);
+  },
+  addressBookLabelDelete: function(label) {
+    return api('addressbook', {label: label, address: ''}).then(function(ab) {
+      update(addressbook.data, ab);
+    }, rpcinterface.fail);
+  },
+};
+
+var reqpays = {
+  data: [],
+  requestedPaymentHistory: function() {
+    return api('requests').then(function(r) {
+      reqpays.data.splice.apply(reqpays.data, [0, reqpays.data.length].concat(r));
+    });
+  },
+};
+
+var reqpay = {
+  // requestedPaymentWrite requests a payment to a new address of the wallet
+  requestedPaymentWrite: function(label, amount, desc) {
+    return rpcinterface.getNewAddress().then(function(address) {
+      var r = {label: label, address: address, amount: String(amount), desc: desc};
+      return api('requests', r).catch(rpcinterface.fail);
+    }).then(function(r) {
+      reqpays.data.splice.apply(reqpays.data, [0, reqpays.data.length].concat(r));
+    });
+  },
+};
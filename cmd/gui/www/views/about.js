@@ -0,0 +1,8 @@
+var AboutC = {
+  template: vuedata.data.pages.about,
+  props:{
+    vlng:Object,
+    vicons:Object,
+    vrpc:Object,
+  }
+}
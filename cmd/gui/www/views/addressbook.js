@@ -8,7 +8,6 @@ var AddressBookC = {
     return {
       address:"",
       label:"",
-      desc:"",
       ab: addressbook.data,
       isPaginated: true,
       isPaginationSimple: false,
@@ -17,34 +16,28 @@ var AddressBookC = {
       perPage: 10,
     }
   },
-  components: {
-},
-created: function() {
-  this.abook();
-  this.timer = setInterval(this.abook, 500)
-},
+  created: function() {
+    this.abook();
+    this.timer = setInterval(this.abook, 5000)
+  },
   methods: {
     labelForm: function() {
-      addressbooklabel.addressBookLabelWrite(this.label, this.address, this.desc);
-      },
-    delForm: function() {
-        addressbooklabel.AddressBookLabelDelete();
+      addressbooklabel.addressBookLabelWrite(this.label, this.address).catch(function() {});
     },
-    confirmCustomDelete() {
-        this.$dialog.confirm({
+    confirmCustomDelete(label) {
+      this.$dialog.confirm({
           title: 'Deleting label',
-          message: 'Are you sure you want to <b>delete</b> your label? This action cannot be undone.',
+          message: 'Are you sure you want to <b>delete</b> the label ' + label + '? This action cannot be undone.',
           confirmText: 'Delete Label',
           type: 'is-danger',
           hasIcon: true,
-          // function() { addressbooklabel.AddressBookLabelDelete();
-          onConfirm: () => this.$toast.open('Label deleted!')
+          onConfirm: () => addressbooklabel.addressBookLabelDelete(label).catch(function() {})
       })
     },
-    abook: function() { addressbook.addressBookData(); },
+    abook: function() { addressbook.addressBookData().catch(function() {}); },
     cancelAutoUpdate: function() { clearInterval(this.timer) }
   },
-    beforeDestroy() {
+  beforeDestroy() {
     clearInterval(this.timer)
   }
-}
\ No newline at end of file
+}
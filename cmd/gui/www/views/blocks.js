@@ -0,0 +1,25 @@
+var BlocksC = {
+  template: vuedata.data.pages.blocks,
+  props:{
+    vlng:Object,
+    vicons:Object,
+    vrpc:Object,
+  },
+  data () {
+    return {
+      best: "",
+    }
+  },
+  created: function() {
+    this.bestBlock();
+  },
+  watch: {
+    'vrpc.BlockCount': function() { this.bestBlock(); },
+  },
+  methods: {
+    bestBlock: function() {
+      var c = this;
+      rpcinterface.call('getbestblockhash').then(function(hash) { c.best = hash; }, function() {});
+    },
+  }
+}
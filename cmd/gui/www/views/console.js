@@ -4,97 +4,6 @@ props: {
   vlng:Object,
   vicons:Object,
   vrpc:Object,
-  commands: {
-    type: Object,
-    default: {
-      help: `$ help
-  $addmultisigaddress
-	$createmultisig    
-	$dumpprivkey       
-	$getaccount        
-	$getaccountaddress 
-	$getaddressesbyaccount
-	$getbalance        
-	$getbestblockhash  
-	$getblockcount     
-	$getinfo
-	$getnewaddress     
-	$getrawchangeaddress  
-	$getreceivedbyaccount 
-	$getreceivedbyaddress 
-	$gettransaction    
-	$help              
-	$importprivkey     
-	$keypoolrefill     
-	$listaccounts      
-	$listlockunspent   
-	$listreceivedbyaccount
-	$listreceivedbyaddress
-	$listsinceblock
-	$listtransactions  
-	$listunspent       
-	$lockunspent       
-	$sendfrom
-	$sendmany          
-	$sendtoaddress     
-	$settxfee          
-	$signmessage       
-	$signrawtransaction
-	$validateaddress       
-	$verifymessage          
-	$walletlock            
-	$walletpassphrase
-	$walletpassphrasechange
-  $backupwallet
-	$dumpwallet
-	$getwalletinfo
-	$importwallet
-	$listaddressgroupings
-	$encryptwallet
-	$move
-	$setaccount
-	$createnewaccount
-	$getbestblock
-	$getunconfirmedbalance
-	$listaddresstransactions
-	$listalltransactions
-	$renameaccount
-  $walletislocked
-  `,
-  clear: "exec clear",
-  getbalance:" this.vrpc.Getinfo.balance",
-  ls: "artus.txt skills.txt cat.txt \n",
-      cat: "Specify the file you want to open... \n",
-      "cat artus.txt": `\nMy name is Artus Vranken, and I am an avid software developer.
-I'm always open to new challenges and aim to improve my skills each day.\n`,
-      "cat cat.txt": `
-　 ／l、     Meow :3
-ﾞ（ﾟ､ ｡ ７
-　 l、ﾞ ~ヽ
-　 じしf_, )ノ
-`,
-      "cat skills.txt": `
-Frontend:
-  JS/html/css ★★★★
-  Angular     ★★★
-  Vue         ★★★
-
-Backend:
-  Java        ★★★★
-  Spring      ★★
-  Node.js     ★★★★
-
-A bit of both:
-  TypeScript  ★★★
-
-A bit of everything else:
-  Bash/Sh     ★★★★
-  Docker      ★★★★
-    -compose  ★★★
-  Blockchain  ★★★
-`
-    }
-  },
   user: {
     type: String,
     default: "duo@parallelcoin"
@@ -185,33 +94,45 @@ methods: {
   },
 
   /**
-   * Execute functions entered by the user, based on the "commands" data-object.
+   * Execute the command entered by the user on the wallet server, which passes the commands of the
+   * full node on, the words after the command are its arguments and may be quoted.
    */
   execute: function() {
     let tempInput = this.input.replace("<br>", "");
     tempInput = tempInput.replace("<div>", "");
     tempInput = tempInput.replace("</div>", "");
+    tempInput = tempInput.replace(/&nbsp;/g, " ").trim();
     this.historyIndex = 0;
     this.history.unshift(tempInput);
 
-    let tempOutput = this.commands[tempInput];
-
-    if (typeof tempOutput == "undefined")
-      tempOutput = `Couldn't find command: ${tempInput}`;
+    document.getElementById(this.inputId).innerHTML = "";
+    this.input = "";
 
-    switch (tempOutput) {
-      case "exec clear":
-        this.clear();
-        return;
-        break;
+    if (tempInput == "clear") {
+      this.clear();
+      return;
     }
-
     this.output.push(`${this.prefix} ${tempInput}`);
-    this.output.push(tempOutput);
+    if (tempInput == "") {
+      return;
+    }
 
-    document.getElementById(this.inputId).innerHTML = "";
-    this.input = "";
+    let args = (tempInput.match(/"[^"]*"|\S+/g) || []).map(function(a) {
+      return a.replace(/^"(.*)"$/, "$1");
+    });
+    let term = this;
+    rpcinterface.call(args[0], args.slice(1)).then(function(result) {
+      term.print(typeof result == "string" ? result : JSON.stringify(result, null, 2));
+    }, function(err) {
+      term.print(err.message);
+    });
+  },
 
+  /**
+   * Print the result of a command and scroll to it.
+   */
+  print: function(text) {
+    this.output.push(text);
     Vue.nextTick(function() {
       document.getElementById("vue-terminal").scrollBy(0, 10000);
       document.getElementsByClassName("vue-terminal-input")[0].focus();
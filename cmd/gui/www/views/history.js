@@ -10,10 +10,10 @@ var HistoryC = {
       transactions: blockchaindata.data.listalltransactions,
       isPaginated: true,
       isPaginationSimple: false,
-      defaultSortDirection: 'asc',
+      defaultSortDirection: 'desc',
       currentPage: 1,
       perPage: 10,
-      defaultOpenedDetails: [1]
+      defaultOpenedDetails: []
     }
     }  
 }
@@ -4,21 +4,19 @@ var HomeC = {
     vbcd:Object,
     vicons:Object,
     vlng:Object,
+    vrpc:Object,
   },
   data () {
     return {
-      address:"",
-      label:"",
-      amount:0,
       transactions: blockchaindata.data.listtransactions,
-      defaultSortDirection: 'asc',
+      defaultSortDirection: 'desc',
       currentPage: 1,
       perPage: 10,
     }
   },
   methods: {
       reqForm: function() {
-        rpcinterface.getNewAddress();  
+        rpcinterface.getNewAddress().catch(function() {});
   },
 }
 }
\ No newline at end of file
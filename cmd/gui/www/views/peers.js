@@ -0,0 +1,18 @@
+var PeersC = {
+  template: vuedata.data.pages.peers,
+  props:{
+    vlng:Object,
+    vicons:Object,
+    vrpc:Object,
+  },
+  created: function() {
+    this.peers();
+    this.timer = setInterval(this.peers, 5000)
+  },
+  methods: {
+    peers: function() { rpcinterface.getPeerInfo().catch(function() {}); },
+  },
+  beforeDestroy() {
+    clearInterval(this.timer)
+  }
+}
@@ -0,0 +1,29 @@
+var ReceiveC = {
+  template: vuedata.data.pages.receive,
+  props:{
+    vicons:Object,
+    vlng:Object,
+    vrpc:Object,
+  },
+  data () {
+    return {
+      label:"",
+      desc:"",
+      amount:"",
+      reqpay: reqpays.data,
+      isPaginated: true,
+      isPaginationSimple: false,
+      defaultSortDirection: 'desc',
+      currentPage: 1,
+      perPage: 10,
+    }
+  },
+  created: function() {
+    reqpays.requestedPaymentHistory();
+  },
+  methods: {
+    reqForm: function() {
+      reqpay.requestedPaymentWrite(this.label, this.amount, this.desc).catch(function() {});
+    },
+  }
+}
@@ -11,17 +11,33 @@ var SendC = {
       label:"",
       amount:"",
       timeout:220,
+      book: addressbook.data,
       transactions: blockchaindata.data.listallsendtransactions,
       isPaginated: true,
       isPaginationSimple: false,
-      defaultSortDirection: 'asc',
+      defaultSortDirection: 'desc',
       currentPage: 1,
       perPage: 5,
     }
   },
-  components: {
-},
+  computed: {
+    // labels are the entries of the address book matching what was typed as the address
+    labels: function() {
+      var typed = this.address.toLowerCase();
+      return this.book.labels.filter(function(l) {
+        return l.label.toLowerCase().indexOf(typed) >= 0 || l.address.toLowerCase().indexOf(typed) >= 0;
+      });
+    },
+  },
+  created: function() {
+    addressbook.addressBookData();
+  },
   methods: {
+    pick(option) {
+      if (option) {
+        this.label = option.label;
+      }
+    },
     vrfSend() {
       this.$modal.open({
           parent: this,
@@ -33,9 +49,8 @@ var SendC = {
             amount:this.amount,
             timeout:220,
             },
-            onSubmit: () => this.$toast.open('Transaction sent!')
       })
   }
     },
 
-}
\ No newline at end of file
+}
@@ -0,0 +1,33 @@
+var Ifc = {
+  template: vuedata.data.pages.interface,
+  props:{
+    vlng:Object,
+    vicons:Object,
+  },
+  data() {
+    return {
+      cnf: conf.data,
+    }
+  },
+  methods: {
+    saveInterfaceConfForm() {
+      conf.saveInterfaceConf(this.cnf.Interface.lang).catch(function() {});
+    },
+  },
+}
+
+var SettingsC = {
+  template: vuedata.data.pages.settings,
+  data() {
+      return {
+        component: Ifc,
+      }
+  },
+  props:{
+    vlng:Object,
+    vicons:Object,
+  },
+  methods: {
+    swapComponent: function(component){this.component = component;},
+  }
+}
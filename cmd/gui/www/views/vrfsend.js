@@ -0,0 +1,29 @@
+var VRFSendForm = {
+template: vuedata.data.pages.vrfsend,
+props:{
+  address:String,
+  label:String,
+  amount:String,
+  timeout:Number,
+  },
+  methods: {
+    // sendForm unlocks the wallet for the time given and sends the payment
+    sendForm() {
+      var form = this;
+      form.sending = true;
+      rpcinterface.walletPassphrase(form.passphrase, form.timeout).then(function() {
+        return rpcinterface.sendToAddress(form.address, form.label, form.amount);
+      }).then(function() {
+        form.$parent.close();
+      }, function() {
+        form.sending = false;
+      });
+     },
+    },
+  data() {
+      return {
+            passphrase:"",
+            sending: false,
+      }
+  }
+}
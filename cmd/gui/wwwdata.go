@@ -0,0 +1,4 @@
+package gui
+// Auto-generated file (see genwww.go)
+// DO NOT EDIT
+var wwwData = "eNrs/duT7EhyN4jN7K7tmh1p9aIHyUwvmB41z6npzGxc8lplPcu6JXdk5Ecah6RIm50HJBCZiS4kkA0g69L1lS77oj9H/4L+MO1+sgggAnFxDwBZdXqH+pbF6VOF+LnH1T08Ijw8/tv/9P/8L379X/yn//FXv/5P/49f/eqv/u//5a9//V9tk5TQP3/9X/z6v/oP4YH8+n/7q1//N7d5VpGs+vWnX/3qV//Tp//D//c//Y+/+uHX/014PE6isvz1//y/+c3l5fiJbB6SalxGRZ6mm7BwXp1PjhMn5TENXy6dLM/IlfPp7dPvRs7vLjdkmxeE/hZuK1K8fjrmZVIleXbpFCQNq+SRXH3a5M/jMvk5yXaXziYvYlKMN/nz1adDWOyS7NJxrz69fdpXh3TkbPL4xXn95DhPSVztLx3Pdb+9+uQ4e5Ls9hX7+3FPP2zC6GFX5KcsvnR+G7j0h36O8jQvLn8bbekPZfvp02/D47Gb4zGMY1ZAbzIryOHq0/e/c/JHUmzT/OnS2SdxTLIr53ffy02xTckzZUX/HcdJQaK65lGeng4ZzX2yyZ9fldKO6xI6v71d0x8KcpxJfMopzHGaYoyLunCBf2Q5cBBvZQqN6r68/Ma5++e//6ZGffr0yXG+/51z+/d/+/f/+Eda3E+fJk/7pCKvepvx/B1nm6TppVSeySYNo4dXvJFrAv43IzgRAL+YRVsZX//99mmS0tqNEapQowo51a4gJAMIoq1arPrvt0+TvAiznZnFdruYuRIF//vt0+SFpGn+BFDEGkXcUHz6NClIrBH8lpZALhP/++3T5HgqjqlZJoqQq83/ZtUOXwz80qU/LZ7/TQeK+P/JMUs3u8s9Hcf1mGGSuA9jOqaTrCSV4x2fHZf9t9htwi/uiP5M5v7FFSOoijDjAh2mqRMEh9IhYUnGSdYMOcf5NImO1eun6FSUdGgf8ySrSFE3D/2/yyLPKybV4/FjNd7mWTXehockfbl0vvmXfwr84JuRc8izvDyGEblScGXyM7l0fJfKAU/gesNza+lgH4UAz2RorW4uneD47JR5msTtgBEQUzbboc4Q7Wc+tD5NHk9kXJHikGRhOlL/dH7H6ippNyHX7K9PjoNpRJ0zYwSp1FafRWEafaFKzRk7vvM75zEsvkitdHEhK7seYKnkeqpSEZHYfLloKla3t0itPzSJRku3MC3polXmLYin0FEvjyEBkL4yBtIIUjH02wXQ3HXPbdM8rC6dlGwrEzPOT9XxRAuTVWGSkcKcrrpJ0oRRpUlZjcvqJSXNvPrJcSryXI1jEuVFWHc6T9DmL1tHiNRxlR/VETje5FWVH5pJt0c5jwWBJmR5QPfmUx7DDGL2Mb3pOGymGzMtckkzBIqWZB2d12eCL/InBvzxVFbJ9mXMp+IaWFZhAQ2cY0G2ybPzKrfe+Z3Hi1XuiyR7uHQ8rLIsQy6XjFt+qtIkE2NOm1uYyj+GBcmqDxQ1Ic0K+2a48ynJZT+QvMvDDVBQcuvViVLjNR+0gb/No1NJYql1uNHluWKyMIry9qtf/9fUXP6x/PX//F//f/7lRCankny5OZEtbQb+97+cyL8kRXUK0z8yK7pWgI9h4YTHo/ODk5En519O5AvNm6SXzmdqo34e0aEXVqHz5cKpp+uCVKcia/54jKtL5/FEKGTEvmyi+NLZpHn0EO3DJGsTUtpSaZjtTuGO1J/CzaUTxnFBynKT5w/1x+IYXRbHiE3X2zBqoFG2vYzybFv/VSUHOnA+f24S88Mxz9hg/+/zA7kdMSPgbfQpKkhYkfjS2Z4yJiZNLap9Uk4ou2T35WJS7Un2hX2ixRs54lfWdeyvgmy/tH+x7J0fnJJUf6DFfAzTLxw2cmau617Q3J/CKtpf0vw+F8doQpti8nd//JvPUnEy8vQvYTpy8jT+lzDlTZxsnSbF+au/cprffvMDR9Ug+n8s06ewyJJs11A0NlJtnr+NlMzv//EfPzrzmFqzRY+8b+iQuM1PWdWvCPWXIfX/5j+Qp3rkXTrffOegRYqybV2kP/Ahxjr7szpK2lHw5eKKUr6NPh1Itc/j8rJW0E22j225Gcn/ucrDsprkR5J9aUsan/jUSYfHSHw/kLIMd0xBpe3X6uVILp3PSTlucvncprWWF02v8qOU9tOJnMilsw3TspGbtwte76anvm5x60w+oLTlU3i8bcVa9IwQ9YvXRoibv50fWjVwxVgUZGsIvuPIqmWyowK8zb9cXIFp7Zj9cjGJqDR/kfm9NWSqtuNM78IqrBmz0tTaRhtjjS6laUwdNTQ1BR17Gp6rzwn/hREwcmBEc0Zl+Ej+VmXGkp3XOmeaLihv82z7RaaOwiwi6fWpyv/5GIcV0YoUpSQsVC3I1ONFrYHrvZg7UlZF/sI6ASX49PaJtuivfv3fbJIsZrPZ//F/9/33TvOXcyzyxyQmpVPtiZNvfiRRVf/+mJCn0nkiBXGeiqSqSOaEuzDJyorq8rByQueJbCjKYbNrlTOeTpV/+v57xuEpTFNSOUlGM4lIWY6cPHOq/OjkWwa4/oc/8F//5p//4JSkeCTFxLl/JMVLUxZnH5ZOUpVOWYUVcZKMMmfTHzUBoz0jbjSIc2JNyTJMw4g4ZS7VhJV5n6excwyLqqQZJ5VTEsIw0Z7KWDmp5+7dKfmn/IFkzg9OnEenA8mqyU8nUrz8kaQkqvLiy+cDqcI/ZeGB/PDN7pSMKwr/5s+fL+hAva6qItmcKvLlc2MvfqZWwfffO+ExcUqSxaUTOgX56UTKijYc2hhOmMVOQco8fSQlRxakPKWVkxdOQer+ekqqPW91UhR5wVXKJz6qaM5fjmG1rzfearmlVS3IT84PzuuehDEpqBJ+e7tiYv7TpPn2p8//Ov6bUzJmLfL5z84PonmuPtWzCuXo/OaHH5xTFpNtkpG4NWt+mtS94/zgfP6Hv//jP32+EgmCf7NXOf6nlyNhGXwOj8c0iZiy/P7HMs8kKpbZD87/5Y9//x8mZVUk2S7ZvrAiXPD9s0YBbAnVLZ+/D4/J95+d75y6+gX5qTFOhMQVpNTssIKUE5rvFx36yIF1zX9DgflD+5Hq/yJ/YnbfPe2JL48T1iPKlCnl9HjV6GhW+Hql+v33fCgfT1waw/RE2KDdFvnBSTI6FvKR80DIMcl2DBMWRfhSsiHDBZkOqLyWg7CiIMpbl4dqTw6aILTjpi7IF5oXzbmu6d8z9pMH8lJ+YV8n27y4D2VNLtqEDrJH5wdG/aeHP18JW+SaFneSlOxf2rB/9VeO+q3K//Tw54u2cdnfk5IODTKhQ6SBjJw/uaMmNSXZrtr/mSr+KKy+PF40Df/mkLQkLONHmhOdXfMtLdkPPzif6/b6TBN+A5RLzl8irv/uYKBXQjQoK/ijWjwOosuLklSs2R8kVDtGmOxKMysV4mZVcdmw+bs//k1rzd//4z+2f9zmWUai2oZv7YTretHQwtqZ+tJpzJW/IVU9MStfG35JnpUyMsm2+aXzuglTOtvRlHpWZyAnUomcONluk+iUVi/sz0dSlMzEcUd0/qjyKE/lb/XsIn+pSFllpGrq9FYX4x8IU2t/+vOI2wzff+9EYZoKPRzlh0PIJi551qr1L59ljmFZNnNkAy+5st6e0tTJ8pg4ecYm9jSVJvNa+Y3onBMedC1DdTK15T+PnNcaeOmoBJfOl/oX5z/+R+dPf76YHMLjlz8yrXfx1ppB33/vlPv8qVYXIVf+tZb4uz/+DZ0q7v/xH0cOORyrF6ouksrZJkVZyarBKcMDEbSUX1nP+NTY2edPUq0eyMvIOZQ70PyjQ/BPD+SFKfJGcdPxnJHn6p+S6OGLYT1aGBzKnaIgm9puwyRtitjOeflW7s09oXZJ4ezCR0J3LcJE7hdSFGDhKc8vn+mSbkS5Tprm4HvkTLeTohBlUexZyDBu5hM5DzpEvnzeyZL0WZ9nMkvbSKs+usNwheIUYW2hUlM2VvXQglPBNopMPxpqDiwURY4chscLL+kU5wcGnkgao5OOUIujKk6kqfPI6TPsFGqmRqAmozrlnGY7ElKATUcTyu62GzmvjTpjBLVO4OsltYj/gTwJdT6skBl5anaPjGI2323t12Tq/MC3oMCOqqWM7tqMnM+39XYSM5lCMQU53/E/2jHSaE2Z8dtI5UzFvG2IWpf/Q1iWx30RlvIq6yg+jtjGV36qupun5teSfh45fwIY/Zmvay1Fo7PPP+VmHzW1GzlpuCHpyAkPVHi7i0b5VTnvuZHzJ8Go5vBnvTOr5yRGelLpnj+SrKq3ckNGyLqGEV9JhjArrWwGSzuQLG3SfLjJ84e/pR/+r0VSkS+8llpXcyO5axNAahNapO4x8dbszqqMAeNJMlrY36eM7TUUBxKLb1Vehan4K03KSmonYXTUKWGaWhNZ/wEAWU3fsfJh4sysCXoATO17Q3ZN1ay1ba1g8gtQX77p7ce3StSWEzssfBfF3Bz51I4YadeFGdEyQtTqH4r8kJRk0iyCv0iWsLHQowzYSo+x/M75zNZwny3LPWDBx7WpRiQNbq3k7O8rtW0FpG7VVNfRvBnpmFKbUGwZXTqv9V7VZ5J9fhO7XT3GAIUZlY6M/hcbXCMnMucQY/sK6Uoj4xFUB/rft7ezymTXTVWVZLuSlTb+fNFf/iX1pLY/U0hM+lgzSFqrR8tLXM3Jc2PUVoI3lQ432EjR9WlTalCrKl2l6NeOMo+a+l86Kp04UHp7Z62ss7VelzuSErMy59fh8+evWHzeTwX56Uh3YeRBVWvyZr+PxP8QvhxIVv33SVnlxUvHkGqoTGOskHUYy7O2wJTtETml3iVRsNpmSXGBjb6aqqnU998bdWHDjn8tndA51t+dKndC2bLjq+bakvrkwJyAAcyMmJETkzKyGUOK6ful04BldaPVsg97nv+lU6+9v9R/XtTluWT/fdPNVKX3Rk5hNwqBKeeX6uFf/fq/jcry+w092mbeof/Tf/nz4/e/+43DDrudR3eymHjOf3T+7g//5PxtEpGsJM5/dHZJtT9t6FFRTVn/1/nd984nSrs5pYdwkuQ1uW8j/3FX7Q80//QQOr/7/q8fyMu2CA+kdMpjkl0zZ4VX99tXZh5t8+JwWeQVFVU3JruLtyo3U4LZiqW9TTYFCeOoOB02o8nmVFV5NprETLGMJtskJaNJUo5PWck29sMN/XDI4zAdR2lektHkGO6SjG1Ej0maJscyKZWPaZI9KB8y8lwpH44FeUzyUzmaVOGmfOVet1V+ivZjarznp+qS+WfwJLpvMa5L1CQc8p+hr6X5Uf/wNsnCx01YsIJeZnn1hVY4LIr8KaWiUPuZjiaCpAYcTmmVHFNyIb6kecgcL2qC18bNpPW2k709uJdFGCen8pJ6tip+Fy7/s8qPl+5V6976zRV3yGHG6VXjzDaZ+zNyuJLcPcaTabCg3xoPxDF5JFlV1k0gTkfDTZmnp4pcUZKZ++2VMU7G0xkdJ23COC+SXZJdRoSyvar9her83yasUKxBLtOwrMbRPknjixF1/YW+ipEHJPI6myn7ZLdnXrNAWkoeSQp9T0oIzs+VzZQsr5Jtc8ACJB+LfMcUoJlUnjZVUqUQUyY+rcMVhoC/Q3nBGb2qXj61F/ebEGpJel+Z3ITHIwkLtphTpEz/bvgs7suUucl+O5p+O5r4F82obdDqEF+50+X8+HzVeMZyx1hteIanKhdDPMnSJCPjeqQzB69dkT9duleys5d71bpbuVwemI/sIXwe63/Xw7X+M8nU5CSTk7ljmCowwuv0kRRVEoXpOEyTXXZZ5cerlpi3NVcdzV/NBQG5AzhC/lTDXo3W/u12u21Vga4JUrKtmAj3EW72WxpW5F+/jGfutxeO+PBvzYdG/O3S79T/vNkq2NSGt/TxuWmnmfut3kxGu3AqWvimdaXGZV5ronGZZ7fKowHInxjqtWMgBxdtySLa21rR2LcuJtOLNzoplIcwTYXoiQ+yDDaV9ObqmBV/1zX35uqYFX9Lye2vLO8DiZPTQc68+QLk7k81iZmqEjPVJGaqSsxU5O5Pm9zTsNgROfP6A5B34Dd56X/XHANfzVv8LSW3v741Row0I/PxRWWnyFMghf7ZzvEG4DXMkkM9FbQGlzOZlU6SbZMsqYhDdUVYcAXoty728Yb+YNpQnvWbYQSYCVV+BBJRVcBHCDm0ykAormaM0AlhT4p8TH1b8tEkOdDNmKQce5sXz0kOO/WTb34KtE++SeibqMBEBSb7YPMyNT/NtE9Tk/3URM1M1Mxkv9q8eHO9kvPNy0r7Vv50CgvSfCzHVJ2k4UujIJp/JA/+12YOdmv97ALamVt8zNR7EyY4Nb3HURU2v2XhgUpRdjxVH2ty10Peqf+hhsaOZeKI31p7hVaY6dR6FVCR5yosSDjMiGDz5TipyKHkNmQjNF4PS3nKZEZc3GEcNXOBOaq3NoFHb7BxrTVhZrLuqi55qjMOXH4msyvua80ui0yYRe2M6RWhi/r+SG34Np96mQm8h8XU0vzZTFa820Wy+CAD6GhQEexLA2HdJpLrv3hSOa6/i3HWfmlHnPatGXviYzMK2w/geAST65EJJtVjFExqR2ubzEffSIxKEsuV4p+UWikfRbX417Ze/AtWMSBd1AxIE1UD0pS68fS2ckAJRM9CaU0/awWDSNh3E07LCsHZdxPOiw+RiLSGTFE1bVcCCU07aCmXIJ5zbzWXNEg6dZhMJFq/P1UHVJS4PwWvTTMCWg78AwO8ysuTN7VB/hQnjFX8Zynf9mNn9SRoI0/SFyFO+jcmTdLHWpikD5AswclMlOAkJklwkhAkuap8impWnVlejel+0hOJqRfh737jHJIsKUhJKrqvR7fi3EnQYytOJfvd98zw+umUV2REHS1HcTyK01FcjbYJSeOS0F92p4KM9t5o74/2wWg/He1no/18tC9G7L53wrb0RinZkSwepckoT0fH0bEgI6ENTmmztL90xczkvhksX6XZj97jYn8+1ZPa1HXfTumrdNuOjaCmo+tOa2wB0Xg8zzdazlfpvmZ7XfPtd6PGlhbL1xaWZHtSJNVbeIqTfERNJ2Z0cvOfrfmlhY7rfvtWNwbfQ3Pf2L5I8yc1hdPwWJJL/gs3EspjGNVtUsWjav8q2uiK1qWZg6kh1tQEXGFLjUctdGbc5OVzc4PrkOfVnvKkV5HLKExbS0cDhFmVhGkSliSWVysu21xobtOPny+b6/Tiy8tlHW2gLnJBspjQDfXL/Fglh+Rn8rdkl2ySNKleagQt6TiMqU1TN11ILY6UjMIyiQkfdts8p51TOxSP9rTWx1FZuwq9KquHNzZ+reNBvgV3Q4X178Lojy9lRQ7rPKtG1ORLybhkX0Z/JLucOP/8h9E/5pu8ykd///yyI9nonzenrDqNbsOM8kzT0TopQuePYVaO7oo8ietf/3uSPpIqiULnP5ATaf8cXRdJmI7KMCvHJSmS7VuUx4QKyyvWX2yUYV1F0+RaiQvYrDVem9ExDemPbltq0qWbkG8hJ1+sZot4pm+A6XdcmTiGTlkVebZrSKNTUZCsuqV/vIXNJkbDNZjTH1b/VzGKg+Vc3hebLNlWsF5SLh7MKnYmdAeXNeO+gERjRn/e9mJjW7G9lYVnvXxnOqPee3Tct+Sws8o7HWh/ol7DP0R7Ej1s8uc/j6SP1PzP//yqmdObsCS0ud/YpsqrXuG38hhmzVem6hpFpDRE8+1NafCmVRXgwnXf2ABrxpCQ2FpeaUOyE4srrO2usGHUdI+kFFgT8e7xJj5rxCYah3yxl97rfcqLePxUhMfLLC8OYUoL6bDhYBRE3T3QR5Y8sMlBZN8oX4eq1PqX/aumTqFljsCq45SaGuwqzjZ5bjZX2J+Xm7zagwcdjBGjO57SlMRjmuNrfS+d/vqb5HDMiyrMKhnEVtUNiv2uwaI0OR5J/MobvdHDGoopV08aWEFBDhDGlzD+ZIagAhmFYKby7I1ymikoH4MppgCCWchys9A4/fWBxEnolFFBSMYuTnxpJXcxXx6fL17bhhof8k2Skj7tZUItzQaAsdYzoZZGhMB4WwJorElNqNGyvGmPRZJVI6WBhbGwmK+0BmbCUPVqYANqa2ATjDawAbU1MAC2NLCJRhvYgKINDI9dz10u9Lal2rtf02pIa8vqWLxhNaS1XQ2srVl1MN6qGrJfo7aHEO5S1wgxKR+q/NirWU2srWEBNNq0JtbWuBDa0rwAHG1gEzu0if2lqzXxUxKTGtyrlUG4raFhArStQbituRECS4vDFGijg/Ch7T5d+Fq703tN+7hXmxtQW3ubYLStDaitnQGwpY1NNNq+BtRs28k+LMfMaqz3/Eks25D1N5m5gNd79YmKrz++gATMOtTsUxBYW4gSUjcTe9k+Rr341G+r3pC538ygmfr6ZGBjjc6IWI7jPEtfhmfbKwM275xfIzHxmKy5xn03c6M6/mJlz/PMBjN1vZmDpNLezd+o2HThdWb77rpxfWpm0qiT8weaIZtCiQDCaeqSs6SzzcIUTzyL98mnnqfRJz0z7peFLqJDa2XKaMscENIz2XdLqZHruc1mkdM2D1hQz8yhW1KhjN9fP0NW22xMYR066AxppTM2IKjaRH6WlDLWpoAirN8nnVJeRg/0ybCTtS6Qg2phSiNjCgjiOWy7pVDO7azmsUgf4w0L3jmcu6VOy/Cd9TGkjbE3BW3QIDKkrHY5M8VMt4LPkrOauSloGPP3SZqcm9H2vbLsZq4L27CamNJWswXE7SzG3fKm5HdeI1kkruYOi9xZvLtlTs/yvXUypK7OwBQ7gy87TgiPSRWmyc98odr6WrdJ2uqZuiEUUVgSnUIkaASn4xEmEAkaAcs3Uk7A2BdwPVyHRm8PwiVQqKEaJxXjs3IwSeb0R89KOpOqMwQP4cEC1qHYG5Ab0h+4jAxolrH+rJTRdV1LAesMzQIaebd5sHspr8qxH1xGBjTLWH9Wylg7EFuKWeeJnTqCxYzD4kE9mINLSXFmIdlXpYxeRH8sZWQZmkU08m4zORbJISxe1MNzuJQN1CwoT1DKOgsDP1pYyspzNotrFELuuOyhT1klrzv967BSsgwHFTHJtrnosPki3rpwESnOLCL7qgpPPJ9GNgFnGZpFNPJuMylPUUTKkufgB7E3R0rZQM2C8gRtgIbzqW2A8pzN4hqFkLReHSW1VZpxPFsgerOGApqzSVCKu93GgT+16c4mZ0h7aoWQ5TbbkUL1EsHkniIhyWfftbK6s2BhlX2WLVRUrQSarh5vEjEUPJ/+dClrRgGMOJBYyqhqq+NP6U9nRhVYI5iYZbQryAtTg7rrjiUrmWaQ8hSEqutSn6yAjGBikZFQeyH96cgDUlkgXVsPZU7dzOhPV0WwKRGm1rIaMPUqRGZ2MH1rMMnjDp6tdXMJGXeWqb4mkyRpG9KfzoxgSYKJ64yYm1TT9LLjVOC6NoLaa+lVc06zUZTkkGzyNFZo5nYaA79Q8UlZ3zNVnSHP8IRhdHxdjTEb6PxR82yW0108z1pLa5nUi6lBOdl4ssXzeeWWXQpqbnzN/C5+6IJZz+aMlgDP6mum0gr5XSzR5TGQ07tqIJ1613yb9TDKjiLpfSqBoH+cIUaUTJcijdVAIWIcNRlCOJ4rQlIWaqv3ycfCUZGfQWWWxYfx0qXnHG424ZEzGd4GoOgwloDknMPQJjhaPu8pvSQ2jKsmNToziqtvL76qlxnPEJyaUBcdg91A4Wm4auKDcj1XgJRs1A7ol5eVqyJGA8suC1LDTxel8zjahEnN6Jz2AAWqYQuI1HlMbUJl5PW+WkiC1XDWRMtkKGFVC0/+eL6ggfYewvo8oQOtv44c3imAuC04JN8eOQAiOT7fQFR4w+I5/nBzEcr0/DazCSxqQ74ngx7Ca7coz8vcFGTQvsSYywpAtjalb+eLNGR7wozPE2jIErXzf6c4o3bpgFy7+UOyfLaxKnNGJPmjTVcgy7NbyybGmD37DvY9hNhq3Z6VNTAXA7YuwppS1VeuBDLLM/0AsyzqckpXDlvEVZQmx8uCRFXzVK97ISXyyHiuJ3siX6H3vdr7bvI3PVqLlCbfw8tyegFPTmRNpGXeWw/VZdNVkNY+A3VPw1NTOwjPc/WNkok6xPrkZOWpqJdB5Zb1SsNNVynn8LPpEjWb4S0B6o+GKaA6zmFp0xlGTu+pgaQnGr6aitDZ1RrlMSkTeumf/csuvJt3NPtP7A03LlM2poMndc67ka0+vM+f0NXM6n4ZnGMXbyZr59dDncg5Vy5z7+Zrn8S17M5sIWQC58wlGXw3a/vkbeb47hopEzfn38iklS2lqC/2p/SsnMcF0W9g1xMpgwCTKsPU4bwYRA3wpQPrQF8NUIn7JQM3+TMSzUPlPlfDh7kODdRHI/SqsRu9i1H9/LIHJGnX+NUICNqd/beQFk2co9PfmwiUAwvR+HU0/HgEypZJkpWkarC+wcqHWDXBfeztphxk8o/NMKLBXJVoCVpkCz3MWhPtzRJSbUL/uDIufgCmFi+9GieDx3LgiZMkotHA5L9EIErzcx0c0/xeB5doI3rQGAx1C0zqoMcS/pI9GlbH4UXj8rJQgKz6Y6X+PH5zHRFw4i0XJv80VNlL+an8G3KVJ5CppfxtXsNKbsmFGQFUAmhwr/qTkAh5wNUH9Vda7IiWhwgQJkfP03jweCsdPOp2rEXqQmWoJKkyS38mHgucUuw24RfP90bLxcj3gtHEnyn11QL9CeGVy9ooE7SsVCbs8TwUfmagD56DHm7mlMWkYDFUtNyAVpaTtE7kSTx8mfYVCburBUaBq202oWCLReL9LVnSHzvfNkzYmQ2rzUcyf4tra2dH1d6lBjek0WXHWyC/Ff15T5bAEJBcgF/fzxkUPy2bfnLYznk0pJAhhiJjczDVWaGjaevTn3Nb0TbO7AOia4A1BhwpKhKjbspXbU5WBugYcl3XSmitX1OGvlXsKqwalRnjKsePdZoiOIDXNpBBE1YxHqASmrDs1nJztqZ6NCGoLBtWmZSxRc4443Pbb7vdOrpPviWbs9RqU7lBY4GPv3O7TRUPSwNCGVk6EoSjwjVIRt/fzkIewaY2G8F+BaJTJWoVYtyQiUy+nQHkN6U/Z+cHzGLSJZHXd7JFpzApj6FT2NScwES25gRWZ4ROYK7rntV0H6nawZpYZi+rcjMY4FqTzd1W2g+boHuU9+MVsGD9NXSgwdzUeyakQ9fZSoAJ2nvnsD42gJHZL6hhP2I+w80QaxaWHh00kw2T11/SYrDfj+tUjObqkTFEpjH5Ap+ZJSHkPfkB05h0j/D1/ZzRmUzKZuhMtpqbU5nI15zK6py6VvZnVtWu6jvGg2VCE/WxTGh8k1TOzMoDHUX+iv5Yaa0VbUoyeFGGF/kcnVyXwgGuMQEZnKUQlc0lS+lxVWhCOnaybCWwiN+712gsIwe4B2TJ7Ey9yys6dIy8d35T5cfSmP1mOTsc6+bBQvz+BhfSCja42Q7Wq9bdywCjSpQfMtlJF8EBLcVM73dkCMx27X3013fzRee6NpMB+/+z6aj+f32q47maMx3LB53oaiV/ZvN9pPqHKmNbt3XoPJ0Fbiuxmd5G+pGzeXeBv5Ze5vy/hk7UeZtq0EB0aD5b/rjIvXd+62Mr6Hn9ctr2Q1ZvHcaKLR+8V4et4AZK7i9vS3RF5hi6ydXwQ2Y3NXoIkKc3jWP/HXkCE5wSyuT13azROU7J52OOuaXczbmO54dOd/PVNIjdMxvTOhF0DQ3LdCdVqd9Opepag7AYsFcJUH/4bqW1zB+/XykxP0dPqk4d1rLj6hECoVIODB+tFLgYvnfeq/NxgKg+1uzO08aipsOHydfbu+zIxNq35+5fDq7q19/BtMR6GqozKTN08zJ7+ODpjucGbl1mZx7AaUwtu5bZw0dOcTxfaNcye/joyY3y/FozG6/JudOaTj9gTtNJf4EJjWf58bMZ5/w1pjKdN7SHlT18pUlMZ/01ZzA9r19m+tLH4cfPXbYc8M78uFnLxvFrT1nW2H9DNSFlhkxZUlhCMzd3u1iQ8NzcgCmrDY34+j6m6JTV5jBgyvL9kefPRv7UNaYsnq85ZbGccJcRsvBick7TWfV5V/9bpixek35TVp2RjX7AlKWTfviUhZf246cszvkcXdd0X2epcS1nILBegEaKlj8iYu+dsupMHCBOKZ7XeepU1HHgoPh6U5YtB7wzz52yhtXwa09ZXSFghyrDhh8ycalhaoE8/Wg+27wjT2D6UmLmvr6bNTqJKfkMmMeC2ch3V6PV3JjGpMzNmYxnh5+luZtoNj+zLa3KvmtkWOYzqUr9prQ6rw4WA2Y1gPrDJzZrmT9+bpOYn6MXm97sU3ZcKUIgVMiB4aOVApfC985zdT4OEODamt15aljUdPgw+XqzXUcm1r49d84bXNWvfqGiI5R4l9pkmrsOwDJZXACcsZtrSuBzMPfNNPyQ3KFLbHI89tcPzAS/zybnOGRxN5uN/HpP0rjS1uYOXGpr8sOvtW3jZRC/u4E75gz7ELJdcWsrJ+ZHhWsHeGRPxsZdr77uM1+qjM64Amep4xnqXi2O9idyt6vN8Dy9y/q+T30s16oAkEVjGINNLUWvzn3/hTmaowO8xGDN7lw939R5+CB657yqje8zpKd3zw+Za8FiDW+Is3rDEHmoU/Am6ngoY+j6pWaHum5KL3lAOfqb4SumNkfQd7N9U+T1vYwtzpttLgMn2dkcWnm2WUMenCwzywzrbaeb81qxY1q1DwirCyevT79VZ52VncOARadJ/OFrTluJP37J2fI+b45kHdmj5DZnPwODi7U5bowybO0FOL8ZaTYO8OqPLbdzZ8WmnoMHyNdbbNrzsHXruUvNofX86husLJCSGpHLPz5f1e8TJj+Ty8mCRc2SaOqgTK8thEfWkjAsnpMCkRHD9Esb6kqtUX4Mo6R6uZwoJ6w0aBqLBaWE9ufhoVz3W6WYtdC8Gg2qBBxlsbPG5JFkVWm6bShyZ0QnvWrjM83cb52xQwNUXVxV+dH8aAltqvRZFVZJ1PfCU9N4ysNORlN21JHlQoVOHScrd7qk4dua8GF1KCqPHMSHOvaU13Z8+cqCiI2TihxKHkpM6acti4lbhMdL+h8jYBlLLquwEC1SOs0vPCDWJq+q/HCpDDgBMgKAsQ/KuBExu+rSq3yAAFxNfmMNiEUaa+CeDGZPI4VxbBRUCSXWtH7NgDW2iNDH+7zKj2pCdxZyEdUcWP3BLLQUpb3G3vHZmqvZgjWhtayAyQwyr1Xwz+Mki8nzpd/FUrUfMZRmOWOwkqQkqjoK2J1jY47zOgT96lDXvGdN+oF5fbrRl33L0CwC1F6adtWQPB9Dpn+Y9O+K/OnSa2mSclwrEhK/wvENFSwbbK+gXiFZ/DahX8IkI4WIpemEpypvlXNB0pDWtme8VZkfDye6mrvH5yvpdylb1k3pKVED/M2nx2dVzpovnCVT4/WvtLhdMWZ5eFYlWymCqgT1Zv7xeQBrHipVrVEdylSCBdNpD7ZmzFeoPZUy1n/0DbgKspPLVv/xVvcQySonTb5LE945dB6f+CwmJE9nUUd/OuUVMRTsSIDi1JKY2xKPtrTClicLT2xJP6Wdk5Zcz73Xku596fdA+n0q/T6Tfp+rD25eac8GXrEA9SK+p+fP5Hwlu9Jvw2mKeV8tJB6Tk/adWiNfsVgXM4D5wpuqJB38J97UXyoUgWoWm3nM5/O5StGVRxAEgUIx1axzMxO1TDMF74EEy6VKM5dpDLwHi0Sn1cq03ez47JR5msQON1/lIL7k4HhqL+fpa5qUzYPqY6Gp81NVJjG5khWpNGCM/m/k7k/Vy5H8+UJmSb9cxiRKDmGK4cX78eMwPe5Dg1xK62ZR5IcwQ1iwNBsL9vY8UgoprZsFXAop7U1SHhLuMk7KyBna/KdU5VLnFiVFlBKZaDIzqCDC8qdTWJAWGKszqi8z2Sa7U0FekZLWE64PxUHWWdglFcizS+UCJE5y2IGv8RjAbbKLwiOVhUZWWeckVZgm0Zs8a7yOn8jmIanG/C2OcRkVeZpSkWOR58UjHePny9ocAgRSCQp9LMjVU17E9WKuflG2zbM8bUbSH0dJlyxm376pc9arvHhXUpwqHulf9vxpEs/QIkqw7Cb4tqgJrUET7pruwyRRmDZdXeXHNzMXdQKTRgYdPiaehDFYWvZ9/2oUzddjeRsst3leQSzr7xpLGoHcddwOlps8fnGqQhqPUAYQaq8tHpuKiBzazSZza0mC4HtLEgjbXEqy46kaTapwx35zxG9j1RalaxBak9GE9lpYkHDINhR6K90e8N27uJLfeHC/VbakWDEvL8eH/OfxMQ0jss/TmK6lelcGJG6qZ6a9SgdfbZyM4KItSaMQ6izPLpKFS1s2DNRVyPe0lqWxhrbV+FC+r5kwBm2BysGNo2yY1OVsVudtuVpID0npXRueTVN6pSCiSniAean8fK9EfBAbMXWFlHS+W69Ur0YMqV2by0e2CS9pfwpeG6kVBRPpm2gR0bI6yhaHf9ilS1beduteqk37sb/KHRwppiM0Rb2zbZTSqlP7l9umXW2ZNVIqTQXLbyUpVUh7aNxBBe7Wvb2y71+Fj2npXg19fjt3aetBJS17tq5Ng6NlLkgY03eFFEkTHwdImqB5NZwQhGplprumCNm3/vlIryzop5F6RqB2Nx84UJMuYQpQ8Wu5DJwB9JKcRT58TuhNCkwORrOCicZ0YTaumcInkAFPPIgeYlFmtTqyb8OGVRPzHIjqq+cFjiwz6LiadAlTgCNLy2Xg0NBLchb5WSOrHykwsoxmBRPBkaU2rpnSd2RNjXHFgjpqNWTfho2rJg4xYGboeYHjyowCrCZdwhTguNJyGTgw9JKcRX7WuOpHCowro1nBRHBcqY1rpvQdV20cZNFBNKKaVkP6adiwquN9AkHstIzAQWXE2lRSLkE8OKLUHAaOCK0U51CfNZx6UQKjSW9OKA0cS0qjGgn2kQSFGRX90gQo0urXfB02nkSQPWARZ+YIjiooxp2eeIlRgcPLyG3gGDFLdCaDs8ZZX2JgqAHNjCSDA05vbCitx7CDl+c8xoihpLOHoTNj9tA13vTYS0YCNC1mD5cgHpkUs4d3zInZw3umxOzh3BkxezhvQswe8Pkwe7BMh9nDJUb1vrGUZNtcqx79NGws1YFngDALWkbgWDJCvygplyAeHEtqDgNHg1aKc6jPGku9KIGxpDcnlAaOJaVRjYQeY8kIfCN6prlUq9Ww+TpsRIm4EMCFZjNHcFxBYRn0xEuMChxgRm4DR4lZojMZnDXS+hIDgw1oZiQZHHJ6Y0NpPQaeHqmi3SWqr5XpOy3114HbW/xiNnDhz8wR3uUC7kDriZcYFbzXpec2dLvKKNGZDM7b8epJDO15mc2MJMP7XlpjQ2l9FJ5+GVxamWU748St/jh0PVnfQgQu1BjZIWtK4xaglnaJ0CArSzWnwatDrTTn0Z+5vuxFC64w9eaFU5FVptLIQFLPkSbfiGxnpUOYpro+p98Gzqq9LwOJnPkD7UrW9cdheeOOHyKv5o14JSv2bVhOVu8R5SaIlpf4Piw/80ISc9Yy3T9qKytNMtMIpB+H2tyURnMTk92t2zyb2z1aps3XrlzfcyeoHb/sRpM+gNnH7vw/4qFwtbiuVlj3rXUUUvtQ8+iR3f/db1vPsjlzqC0IG2/cuaxlWjtfFvlT+ecL5oa+F47Q1Mc/yfgHz2d3BdqDPUry2iTWvcrT2FWKbfJMYjbKX5vM61O/aE+ih03+PJrQbstfo1NR5sVlcwvsCvIt1Dyy/RlwIULwdRpPLMa9/kPLo8VyfxGGrf941Z3UGqjse8Dg7YdX9X5bk1mWV+MwpV6z8VtN8V1TY9nTs/YnrW+7gH6VejcbNQcdBmuG4n7Z4ZRWyTElF7y//ObeAIYTX5obhhfQ1V7uyVG0nvLkcCXdrKm5SwLtNBXtKbecgyA711EOGWN1A+ue5EpzN3dhm7GrFGeIY5tOONzrTOVwbs4DvbkUYsWZSmVr86gyeHA7xEgQxovKHMR/nGeRwvZrOQYhmQzx2cFZDHeewXi9vzQDnU0MgeIX7oQuBARPyg4aePwMVeXNpjmu3/588arOsr6sh3k2Ai3PcmJidVG4k9du74pztzdM3bKKgUqXT0yt3NSeEpRFTdYo65EO0bSv7CEDIwGJ1yFwDzRhPnCuqkxDCE0ZGPnaOZzjN9Jyqh0ErA3KIHCDct8QGIy0qQwB29R1XRtLszl0BNCgSqZ2DsPdJVo+9bm4tTkZBBmfijjrYKQ5ZQjYnPw1QZyr2R46AmhRJV87hzMcBVpG7HzY2qIUATeoqUAkLNKeEgJsTv4EMcrTbAsNADSmnKmV/oyT8pYPP/a0tmYDghuUn01icKRNVRDYrPVDMXbOZsuYGKBxtdy7uJx1kicP/OyhSwNkD32bV8Ki8p89DGtYjScku9lDl/BnDzbZzx4+pjHZaZi1MSkCbkx+9glikcaUEPDUxN6GsPA0G0MDAI0pZ2qlP+tcsOXED3ms7dmA4CblJ38YHGlVFQRrVRan3M7ZbBsTAzSvlnsXl3POwCTLrDnQsJuoNQgzUutTLgyOWaoKCLZVWdRbO2fA1jQwkL2q5t7F5azzHnk+ZFv5HUYBxWBNXB/pIGjUMJAwSAPToIdWvtDkrkFA80DOuoPHOUccLav+hxMtDX6o0GKw4wCpEs1SF95/U3YXtOMD4CPveHn3XyqMEkxMujnumsHBmj2/ZnOZgvivRZiV27w4KAt3ib1oziYfWxNKJHVrGjRQm7ZErHkBmppkm6RECQlWVgWpor0aEwwPAgZtQlOe7VqU/TmOqnBoJEttJalwlQSxzWCkYmpBtOe/oj/nF6ERSLwIDCAXocdxCJNIZIHeWaJaA+AF0tPR+KSDG6VeKNsY1xT9Ir8qXDt6m2F69LY7pT9n5m/paukqxfu7eqp2NFwYvJflCx/2xnDdYS1RL9utAwfYGga48qW3wrijixmmRxcTQs7P39LF0q2G93cx38voLBDezfL9C3uDsC2ewW3C9hVsfEXwhY4R1OxbyXw7uppCevQ0U1Lbswtg6ev22sG5XW3utXQVB+9p6XaEvT3Y5tPg9uAbHDbWQ55U1vh29HWD6tHdQx5aBspg6W7F4/9dPa7tVnSVCe9z9YKCtV2GvKLcKo/s4cM7XHtBG1Za2cNX6mrNOx/N/ZfpZPUeAVqYr9S9bFvIxnTIe7My047ubV+dtRseA96f1XO3dG/rHf+u7tU2yaylwbtX8uG3t8WAB2U5b74nZZ0MBrzNqPHt6GTlhUZ7GQa81QiUwdLVikv6u3pb3a3rKhLe36oDvb1ZBjy7KJZuzRaZfSl9zrtkWg5dy2r5dbKu0pzxThlQGtsKW/YQf5/QqxuK/UtnWW0rru1dbXXGk2Otfcg2+OwZ9H8pR2Xbaaq37+V0lKD/yzlmCay2euu6/e4h0G57dhTIZq1LXuYdTdL/GRzOHQvfx9Px3VYV0RQsifLMmWxDicBnseM5GtuZVdItzJaC2T4sx1l4IGYfDQx1D/Ojv54ZnV9hRytFDsfqxSxnQzE9PnfQsLKojlticyZ/FsM4DTckreOpx0lBIraHGuXp6ZDBBLQsIPwKcszyyMEJyAFmJTcXj3dJYyYqnS8T0M59Fa7HLNBovXtee4xhJNbBxcBiVONU3hSk6hzJS5CsY8gGM5MKH7vtmHCmdXTPDlq54fkQdFynYXDV1SWdUfY5sD3skAebfOKBIVkJpSj/WoD7lrArir+CQ5tO1N1xYRKgxZqmNlvMYwlNq12xlMux9yY3QedJh+YkbTn44PF4L/dJHJMMOwipM+65dQnsxgH0cps0FPGM/igU/TcH8VwVHkC20Zb+NCT1LQOuJajfeHOXgz8T5CqO5MB5Wn3Opg/TdoYFa64EFm6HiObR3n0bRomdnOU0aPJbj93u5q9pSH/eehazDgPNQiObQ5j/z71Cr7rMycEYf1r84/pvASJpmhzLpHyTFHrXS0C8K8nhClY4V9o7Pe28QA5SRqBCr+VR3U1GryQU5KA8ULBw3YZDV/TueoJiUJsFJQC4CSUgmFW0J+nxFatCndGV+miFIGsD98lmH0+pY69px248sQ6gpe3u8kQWBkkT75aQBinhf/EAN5q7GU9mASQ07ymexkMBaJ5Aol7NjW3NiaUtIrtZq/lfbBOSxp19K8xfksbSyzWvPc+uTUqHBbkucnxYyU8sdVDLMegNfvx1ndE7uTT3vN7HRHHhfy+zIcG/BfRjsux3Q9K19ZyUU58uUuCdfaGgeze6QvXBrQvy7mjGM1ZsWPZpOKSxZXRnW8vg3k2dhmZrfFBLQ6z7NXT/lSySt/LcXHtv6ELxZhtMza+NWghZJc/NFSTukSlw42l45lYmPQrRe/CcW8CzMzCD0PfI5ZzCf40MzNcFzxr03E3yLGLhhDlcYs7Ol0fDOEfWemSK0vapKyyn5+bao6adAt4j704efWpuVxLvLUWPlniXlulRvnfx79OC52uxr1l6M+LOx6pIMyzOx/IXLf9VVPDXLL358uo79HuPabpTy5/N4/KdZZDfaX2P3n8Ph/5tYJsDzibv2wI954OP4NS/RfrMDe9m07eFPmCe+Nq59G/Z984ZXzULpUe+7vzxtXPReuQrziVfNQvkvWs0B/y5a51E+rXPyRxO3H2UhtNK53dNBZTTO/Z7uS+ou6HYIkhKmnw6knjoTmlL+Xs1OzyL33duqTa7udor9urmLsAP6yilzp7JQPq1b7fBxP26DaZlQU9YfDiGZy908hOnHlS/B7aVRkNJB+2ud3CV+GhsxsP4dA0S/qj4sUiyagQ/Lb6Y10+rixz3eZH8TNVFqgz4t6ZQ9WGn0xxJtWc2SbYnRVLZX1tfzJdtZs0ps34ERes/uOCyh8gmLBO6+YeKtio79YmUfCLIvivVxU/DxNEoO6UKFjNyMCjr12RfeyCxA7U+ucAnbRAlJ2UPo9a/N/+8ewAxnqqiRPpkBqoeuUxGYSTI7xsIyuP30qkY64OwKPKnC2CSMuD4SVYjnW9CnUcpCYvLTV7t9QNYM+ge+ORukadsgqpPful/X9UzcCX63lXjaTEmjySryj6eAQ2hNJkD2bYPUn7HyjACQAPfguSMWjKcZQ9Gr9r9ZaQSQk771KNv1Nk+VRnGC3lUGKlQrRR61qhfMNueVRrCDHsEGakU01Y969Qram7PKg3gBXgt6Cxr9cL/qrXERSPHtT9Pf5r6jK4n+qzztoG8sQM3xTuIhwXVeWtsmzYRAWq74XBzGLgPaQiUa1cTFEpoVJ1tyZpI0a//N2TEc6gceqILO1Aj4+zkJu+J7VfOoX2k8sP7S2+Bd7Pr1+0yv64JSel7MT306/7e8OFzWb9B0KMAyjjojz+/xN2j4SM58tYYxLLHtG4Mi3qK7T8u+uHPMAn6j4yuIhhDoyfBOwrda3B8HMt2dAzh2cdCMsYHs1f6D49e8OHGVf/B0VEAY2z0w59f4l4j48M4tgNjAMsedqYoa0cv/C+xflSGbNcYFW5l7zZqPsA+6GfJym3PepZsK9S4LzvsV00CLM3xkfZt+dF2rTTe2usthnFvRAgzhtpvksMxL6owq9DwYMDIGxIaDKbpig0GU9mDg3XdEmieaAAPDxpoe2mskG+NCfbtNiRHSjgF1WwkculvYb4Cq/cMG1TQooIadQh3RHPSBy7uMJiTHHYaVL5pp7wew/HSMw/I+w4NmAK9zYtHiUbKJ9/8FGiffJPQN1GBiQpM9sHmZWp+mmmfpib7qYmamaiZyX61efHmeiXnm5eV9q386RQWdS/Il4qMdudNaZAq29MaAS2Xkr5Ukml9leTF7Ns3tR2V5Pl8Mp/P51oOKou5WmJaYyV9Np/4Si60m1WEqxVCTQ6CSRAEgVaPmdoMvl4RtRk8gImv18QzCqJlAjQH63UVtVhMFovFQutHtWF9o5/VogRa+vzZm4vhQp9T4TemFMnzp8/+VKiSqYD5UwUW+M+BL3SJL2CBr8Cmy+fpksOmSwGbLhXYfPo8F5nO20znaqar+fNKVGHVVmGlKQ9/+ez5IlvPb/P16ruvWV4l2yQK2TMMXdHapItz4gpxPXU4PlPXDv+TH8KYWlPO0KnfVGq8m5q3HeIiP8b5Uz2baN+cNvVAspPDpmYWqSi8aAzA6FQUJKtu6R/12UNMorxg+V1SnVvQ6UgrR1kVebYDOGi4KI/JSP10LOTHrZpLWTqCEcow6RK/iv79JCYpqcgrGkiUNSwzFOoJUslr0sysWiEn5WlTJVWqF37CPnbWu715Bkfn1G6d6aT11TQ01KN8m00nrS+udd2r5LfWdOr6gX8sLJ12Hc7Mml5907+KN96xeFi22tQvLGOhlmyU4iVdLIqPjVg8h4rFg4GDh5itWT9viYUOkYtwLPJdQQvM3skJj0cSFmEWNZd6+Ts8+vdazci/Gw9fgaYWMweNq9b89RnFGOAFax8D4l/GmxCqXL2etBI+hukJEg1+3Vcipa3RkSFEVY63SZqiYLnhWkIhtQOKrHSezKBHwXFatPgGSR2ZtH95ucqBePQospUcLTVEVcfaHNDQjdaBePRpaxs53twAFQsd2b/cXNUCLHoU20aNlhoi4sEP+xecX1aGufQoewcDtPgQHYvs976yNyzOLDinHlRqFrCuf6n5FXCARY9S26jRUkNEPOxa/4Lzy+kwlx5l72CAFh+i4zHChujw+vI8zKWXIrcysGhzk66JdTWk9PXlfpBJr8Lb6C1lN8mULSe+sSYD1N0msYsmQ5SdJr6nVcFP4sq2NFd6DOpU8Yj/tm+2jFgUEBYcxNHihvDIQCzKzZXytN6E+sGBz6DyfMS83WbYZf0bj+Tp6wGZN5tdVd4dywPoyTjF1pTZszlQZd+xhADfqEQ6gU9Xagb2VQb0opi+9FBrkD2MlC/NJKPXKtOK0bkyAV8AxRqSqlqVv33xAr2dhDJvdKHKv3OJA70lhGbR6CttFHcthKCndJDVkTomqH7RR4V9tQQ9KYPWpvbiVDOov72aAYn4eudbrdHZkQuJtVZvvvZb0EL8nHAEf683VeDsnBD5btmJEQpQlSXDv7TBFWdXr0BKWgwsqYqXdLiWsH/FVCjcACRUuNV/718N9U/3ImFNts3zSmZR/62x8OsQaggL5rBcFXLICokhlLrXQlY0BW04spmApcttpX7VCsji6wAwuFA2DFw0lb+4RKOEyBPJ7PIQ/6OsiuRI4rYduDs273QpwoJO30kE6ZSQ/ry3NJdZta9b5IufXVjWjyKbWgepbcy/7dtXfdnDUXWQrWpAoTqLI9e6Pdp95bYm3xEal1GRpyktSpWfon27VcTO6ATshW8dqS+b0wx2ZWcgNPWOjvWeFOXHDqXBSyBtcpdbfgu3Xa2RUB0XZzyBVC69SQUVZ90QyAzDdGaQGpy5XPZzIw0x7n0CZepAoCWaayrNN1qNS74K4NRdN8AUHNaOSgYA/z7DxX0TICpzF9CIHnACpO5AQqf9xp0hfpaGxwpkxO1qSY3IWK9eVF+N+hsUlVGpq8OPVcyeUm9FjeldId6+Lf3AExCDdsARiEE77AzEIB90CAJkXq8ztM+DjkEM6v7nIAbpoIMQswvPOwkB2rT/UYhBbF52g8ebebHNB0Zm7Y0kawtUDbBBP64vw6mjfuItFzOMdcupSzF5S5P1eBLgrOVSmzMYXl6YKesZU8zlXRAXuKDWWLsWdvx5UCx5Q7Z5AagG5Syaa7pvvtEOsVghZ+63iEciTWnfimS/pWFF/vXLeOZ+e+GID//WfCjyKqzIl+ksJruLlnKcF8kuyRpF60hznK1KXBULt4GZ+y1OVLuINTSz1g3n+IzTtGF0wGTM4uWv0qNFYUEDLOd5BmGHR1bIKOriWNwK2oN29s/rU17E401BwodL9t8x/dDCHHIYtX+UxzAbTdqE9uurHNiWXzmWCE8bmc3pOJpICeKr6idIB7ygobUbTdrfX7VNwkMSxyl5UxwG+BzScvX1GLxz19XmdM+fvfESySvoplJXYEUZ/rvJPtnt69lQCpEr7o4zULuMoMZAfPGdqKJC0+pSmkTxnhzgXU3ypSR/otEFr2r1paQp4C0pEmegZuepc3N64EkL6CFYXkfVKDNyUFp3avSNP2tZqb2jdHXbty3cbHdLo3MiuN3lVKjp5XSz9eVUqAPkdLAPZIDZDXIq1BN0M4ZaGGiUaqpKU1JVpGBNxXyojs9X6kJsdnyu97VaxX86HkkRhSV5k8RA70514Yq7REg8mAOTuiDWl7/Z6bAhxTuWC4b3BrxK4IO0e5kABTJob+b7E2md0Fj5jhHtoGEFHYZQTR1Hxemw0fofXGi0aCeEGkk1jK3BVBoK0U9Oo6blLJo5SDP7JUSadO1VaHAlwG+o3g5wNaz0sk2o6YbmVYSYbMNTWkF3LjRe36UJtzMaTpsZ/WlNpe+/UUjydCT/eVKfapCeXTh3Y0Zibhior0YcewCPGLE6WtlVUGulJJ1SdHNCpag3AUxO9XeATbv/oBJgse9VFB4AX8VhUfAlFNvmoTuG45IcwyKs8kIfGs1g+B98b+V/YxBvTmlKqh7Urg9Qx3kP0puFScgWoiQue2TsL26+eZtEYRGjJ6jSg1N02z04PmuvPXsXo/oZfg9I0vZitFkAcMKlZRnTqQqyreUHpqCnUJTS0uL4UJFUfSNlOK4NA1xRau88tDLMY9qobzy0ip5ffZHyai6O6bn32v7T+YBFVl+CUcoMlqvn3RKGbQr02vpaS4yEoy/7a5vnVUdfvqlIsT8KOBJ0PYCjsGK4frvj3RGKOiZEpSml7NHN4YIvX9Ua1hycCdVjYd8YV9z1/BWyYsxtBciw4CykWVT1aB8pEHGKw1/2UbDqKFKZN5vCKp5NRczCExvCMs3pqBPwYwGmRRqroPkm3QEY1/cm6X8p8zeklMyrt3nsp7XVPF8P1jQ9Sq2p7ISwcoiw3lLphaRYHVSkvet3K1ytNYCQU+qYYaN01Ocewyu8r248FbOst9SRDfPLZhvb8ZBrGD1KMgq14tfXNNSP2tXJQDOy2SNDuIsCW170KEpzWqoVqPkKFQs/KVU2zfl+fY8iSDGZtWJIKVBR2uR+u+WCOE4eE3CCVv29asGC/eLFwrIej/T9tJQ8ak+cNTpWV72sv8YbUj0RkjV0zT0W87HDOlW+C9kox7o8kC5kJMyYzDcJ3TDTPjj1B2ZAo4n14Zo6N1n4fKeQSXsTLkoGTy+9olJ2ccTisw0IPlf3JlD/3/fN7E0CDpnF2TzSMnJ7TeRyZvKlJPmrsm+kB+Szh1fs32Miop0xxpqx0bumMgu5h2l7U2CySYnCGgO9awgY2RsNgJahb+y/Np8+2kNaVQ/oOquMesNjZEpl1mJ6ynn0rQ5dLg/OHNBQb5M0KX85Y6XOrhZwbeEh+waz5/gEko2K8EK1RuR0aWdkJH2WNkAQ/w7pRU7DO6OeS5Qy4C4fkFkvaAfOuqHURrjRoCz23urVQ68tKMNHsR5F/LZm3xVIQ1XnC67g2r5fzr8dTWYX4JKUEUFM8RKNAFyf+MBQHnX5AWsZgH3XoCU9IIO/G9YYsjbRLXav5SoFajRyB8LLenKJmrmk/n3wXNKyULWwVDhVMdYn3FIqXwrhmWrBr43B2Utfq5kJF7rneglIC5OdjMMT+tG2ycjT8e1FjsA2Fmn6mGlX82RLpDn6A8M+6s4E6MlmS17i1nOxwbfqJcqha4OWNE3orm5TDe7moG3jcNNfcZtqPak4O/mN1Tqs35V2TqwfWE08vsoDz6datrjDCJMbcjDBFu/EGl6W4Q5qLtRRzRyFjIXNgZtDoPgEVbgbGmugYWcf+gJiG/0ChAuAgFj81Uycw7+g28KQnxvOhcXDRm8GTWP6o9BjHnLcyVeH9igw4FyHcwEKrNK7K/qjdgLmmWeWOa3N7u5GRlQGxghqZ4XFzKU/CgvEIxAoNUX2KDTsS4jyAcqscvBD+qNwwB0Ot8vtYhtD4B4lB3UszgcoucphNgumIdE6LHvoV2yKfHeZVSbnFBjxztzOtuHWRPYoMODXiTIBCqySe97MW6liiPuEbudbsgXBPYoNOJTa+AAlVzm4JHA9TWla/FG38XYGgXtpaoszq40jqLUlXr8NNoHnLjXZRn1ht7PtAsD2qoHhR2thAxZbZhDF7ipw3/R8e3m0KCE2JLvCmar3o+j0pm6WaeeU1g1WwxNLPvOqV+fQNr5aIeHlju/LKV4J3AqUeXwHtmpzVwu9s2G7b2HpJfXisrlBUFYvKblkVq15u9l1zGsIcliqOgwVOaglaGI4KZ+ORZedJHB6JCfonPWQx2Fq20tlO/asX+KkIBHr0yhPT4cMOwA1PLn4UNgmzyRug0W6Te7SwkLdHGap47b8ZlXU7aPl/IITRWERj/jvzcKvWWK4ju+ys4ZnPoSjMI2+eK77uHfGjjd3j88X2rUrzBGbHQoN2GbrUTjh1QYVbsrKxqOsuUe6P9fwSfNS7uu633gIN/f4rHdDEz6UptRh6doob5SvVFTgqRhjKNgLrI+I8aEcSzfZHpMy2aREzpMdmI/kD1TiB7n1maVGN/ylrTAulL7SZJIS00rUscuHKaG5ZWNxrjb/GHRjRr0SjMeFFGVttLHl1ptURujemloFaINTy0p5GRTfxPe04Vfr4s57kvAaEN9J0gRc6va3SRY+omGjmD8Aj6DIX34y1IN48NXl3IbGpVOJnObDeFNQ1cL/auK+2bC/53+x/eKzc5Gf+e1PxW8O20sYykXsnZFKhu9r+fTn/OZtLopAq20Lm1Oxa/1eGzS0V9n6lrjLerMSY0l6dzvROh1HMpXXkyvDnjWYyBlDiQwcSOScYUTgQTSkvfpkY1L1ymd4fQCyDxILAgrFoCqfI0as4szzFfBF6zVqDQ7cO02ifnfrcN4O0mO9NL7MfkgQUpWo5zwBYEHRHpgFPEi7qExpgIrXQxo6yVBpcF33zFYFx7WdhzI3UOjQiUHlR3r3s21iUJH2iQHADh895IyxQwaOHHLOuOkxK3Q1Vp9s+swKUEMPrs+QWaGvHHTPB52VHSw350wGHRz6TQZ9G+WMacBU6DL7gQGlVbqeMwGABWV5eC7w2OyiMoUAKmEPIegk67iufX7zgkO7k40yKzTooRODypL07nbbxKAi7RMDgD1rMJEzhhIZOJDIOcOox9zQ1V59sukzN0BtPbg+Q+aGYWLRPUN0VvkcMTpnkujg0G+SGNY656wYIKUv5zDsAQGFrOdcYUJhs29oHvBI7SAyJQIoXg+B6KJC5cFf0Z+zGxY2gDq4qEuHGjx0klA4kr7dbZsiFKB9hjCh5wwhMnwAkWHDh5wxeHpMDh0t1SOTPlMD0MhD6zJkYhgkCN3zQld1z5Cbc2YFO4N+k8KgljljTgB1u5zBsBdgdLKe0wKI7rMh0J0NPFK76UyZgAvZQyx6EKKSMV9Ng9g9u5H7rJENLu/eXdI5kgFdb5sldKx9ogDR5wwqctaQIoMHFDlvOPWYNLobrl9WfaYOuNnPqNeQCWSQmHRPID3qfYZgnTOHdPLoN40Map8zphFzLlBXLtlDLyKNpvcGVHbGSURHBtjaN3sYuPWUPZyz85Q9fPx00VX4HkNaZfHuiUJhR/p2sX2vKXvovdWUnXEEYS1yj94kw4YMOWPA9Npiyh7O2GHKHgZuMGUP5+wvZQ8fPwnYOwHYWsree/agsDhvVyl7+KqKX8ngw7X+gOcfFZqeWt+E9hHfjgzgEdlBZA58oGw9Bn4XFX7mRhZeTM5r0j4jWWXxbq2vsCN9u9im9RWgXeub0MHDhgwfNGTYkCFnDJgeWr+jmXpk0kfrAy08tC6DDpx7D/5urd9V16Gyco7WtzPoed7cu03O0PqmApe5D3u6VyfrqftBdB857s4GHp3ddKYcwIXsIQo9CPGdVHcTzeZnN3KfEW5wefeEoHMkA7reNi3oWPvMAKLPGVTkrCFFBg8oct5w6jFRdDdcv6z6TBdws59Rr0EHDkPEpHve6FHvMwTrnNmjk0fPY4ch7XPGHGLOBXIGXe+WANiR+anvhQcIDYm98WD8gAwR3+tOOsDNGyxuH0fvbkLc1XsbL4P46v3tAInBAH7yTKORDb4aofEmA4aI9XqEhu24IAGh3zf4yFlDjwweeOS8YdfnxkRnE/bLqtetCbADzqjXoJsT54lTjysU3S3wLgE86zZFF4+e9ynOa7OzLlbg4QTUo/f+r2LpVL3dpUxwH4u0MxPMf6ODDHIUAUrYy1Wki84iPd52ujm3efsYYjqTdy9wNIakf5fbnaYUaJfblAk+YyiRcwYSGTqMyFmDqJf3VEeT9cqonwcV0N7D6zRsWukvGH2cqLrqPFySzvOisrPoO330b5uz5gxd5XP+v5+0L/V2PTQAXQxXXiaqS0xrX0dIfTWCpdI4IYCbGAvY0NzBHxnfq/z42oSqB0M8uMhldJnrq4hdjADQYo87y03LV4etphf5GaOmY0T6aF8dUjBFibzZtCrCp2kfmFVTTS0UPmeoTDi8hcdVuCm7e10LWWOMAZW500sZKBFa5NJ0RkFQnlN5fLrSgl2a70e/wfNkE6IGeTJECdyOB0GoCxIA7w7Xby8oOddPB9qfpUQDxzOmLPDIzP3WGTt0CoceZoAfmKy/M+w4PjUhEZfzQyknHIv8SIrq5VIv4ig/hlFSvYwEd5msSg50wG1PWR0vhYQlGeeniquG+fEZagjlMSc69qXKzY/PFyCN9Ab5hU7kdRMFGtF3ztQkwoasbGq7M51KminM2pnvhf7bl9nxWXsdtIOjUvemRy7d3kS07lA5xnJBxlpJjCdD3iBjr57UbCFb0Xcx6sfgFjPkXQwps+ZJrzxLX3icayl3I1V7tRZ8W1rVXmxiD1WTUwsxbTXEZEMLM6R6Gkos9KP6Up3SFvp7hngEY7kB6XsQUhQjjwc7xSyXV+mBQq065PkYsudZ0egzBkkVbtBQQvIbV1DwGXVOY+JbjxxN6qW8gP6RUntMS1pgILwzjAzVakJR05qUZvZow6bV3K31DeT6KtGlrb3AxiZ/eIK9O5GSsrzQ3ovx6wBtdhrQrm5KrjwEW78UXbQvzUq8xRgz39Dp+5iPbg0rAqLEV9aChMvvVLZs0OddlEjG6vMucgQy33zdxRq623OXC2kZLtvh2ktS4F6Bon+p5aTWv/PJSXOhBKr5+uGp7mfylsdnh8706NtMolFkzkBcPKXOuo0+ZiYguECok969TGjYdC0Waph9yRCAjz/gRW8evkEBjtxyYBsoiH6WNBTRrlG7WoRAfG3T9Aq6wmnqN3SdY2Wr9Bay5hm0DTaSdh5GUEvXOwyv3Y/x1fBXfJ3UvsvcviVIZcMHMQ5QMCDVLB1/4wclMc5m62TVBAJel2oppOnyXXsovfkYT/ANYIhvn2Foc9dMRvbYLEPhPeyP3ySHY15UYbtAxooK7wcNfHPtnCwG3ObW7KZmKd5zZdF3MlNMYyNFtW0BOcbR7DFGc8Zs11PN8skL2PLJEcurLyzw7WjM/rnol4Eoomot+1jgzTZYpTOvd6rkoJXMRJFfjlQnKjpvL6Fpu30/Euxwo7SGlY2JqvFup2rldGRDkzb5M4kH52dQAhqxZx0tlJ215gt3T3+Rm21egSt0V1uPo5FR39TJwBIDVlkIK91Mshh9nRrf4GrHrO0VrXdHYIVkQDNCl7bXj5kwAK92Gs+gKg8121897bMMUd//hF6tt+pZ4FXPt6+g/D9cyw8RPV3rwQOg0WY9+tz2XKy6eyK20qA38q/EfiHteOc7Z0x3DK/wnbRhO63GxirYdqzbofeCtbWrplHFgpKfozrgKYC08lQB2hYaf+wZ5SpbyxBTpry0LbiFbhgr5n1Mygd6eAIuiprE9y/5OKPORV8D/KhlH8+34+hItAK+wOKcBi+x7Ky19kGWWSKDWqItR18IQCn2rA9X/SAMx+iFngGbvtj+bPsdjgOsEddBv5nivcAYyZh+h2JDl1NnH0DXzw29TY7hLsmY2tIejOO7W2LfvEXantJSUfhrWioOe1BLRbEa0Emk/TzOyHM16oE7FuQxyU+ltlVo7hOSg2Zp+yt3umSnWt251K3dST5Sacqq8+1f5GUK6fVAvv6XOJM0TY5lUo70Io5sLdg2ldQfxht2M6Pl2CeknHyntN7gt5d6QAHB90zUUDKtledP6i1pLSu+6tcyBD7zbLm1pWS+mdEfPVyantc2j06lmZf5WeTFksDdd5M735HQ2QPfBX9xhiEmtiQrSdXYUr4xu/kXRr5/ipOSroHiPxtZI0k89za5651146MoLjPb1AcUuYk3mb3pBRp164TJAhjbixliyWttQRVDc8KP31C2PQnGfIEAGX5Vxxlgu74ZSoUt5GgxL1lZez0tKs8HKrnO3kmTvnoEPa8a8tQMWDuIq+01lPpUx3sDy1kn+sagaRICOeG148V+da6o1Rw2JQGFQolY1REtC5Qf5YPVSyxABhXVpJD4+91osFZiH4IXk/LJSGq8bMu+dj5gKkyKjKRjtmYaNX/sSRizSzv1n8wxqeczOMjjr01qwf151GSlEOpb3kqBoCRaOPn7q/3FGoXfgHdb9ffV+Cs9iiFHDsoza4HrWh5Sk17vlZrZeL+b9rf5kJvYv0FNDJmrE3Z2nzo2xtTlSi6pyg0969d8y/S5n3JonjzWXnKX0xpzwpjbxQiBjEO1W6ymIvAWlNkZLCMnyY6n6k/Vy5H8EO1J9LDJn/+sLuFNot/z18gtCln2GpVI2Q2JIjweuWeJMtkoMLX52ZYiOH2qja+RO83nJMozrb1DRS+wF5GVLtBcgxR4YyoaRB27cm9yaXgPJhmToMbRp5U2+tgeFyv9CSzIor56JEWVRGHafK3yI+8FctDMJbyDadGcyTaUNG6S7UmRVEoJmm9vk34und0eqOpC1P50o/4Um+4gChltjZKAHl+D5FtV6j39fDz0WXPbgq5DL7GXKM2u5TVSVyZqJdTQmlxOGF2a6HuJzWdJdMJXi4OUviHMyE/p129g03EZfEIOV4mirK2hNJI/UU3zCqwFADqmwurlCNK50CvwEhvGHreCUOraF1K2CVSJ1pFpqAPFkr7GKUbjKX3FZlyOre25U4qWvYWycwExmBCfQNQaMfh0HXxYRpvOSx/ttpMt27C1nuzzLLenNGWjmMqe5YCP46t8t0sJ1G6wQeNC3rU6s56Npy48xVmYwS9NvksT9RDBOz4DMHmotnpF6u3mdVmINA1RSkbD/ucClB2d229lLmrvGTmcUs3mrJfkKqr9TeweYs0BHJ7ynUTsCU6RrnlD+opg20qShmhBlHNaqCQwQHfCVMuCbSLzdHz7mCOwjeP6FVfIcXoTlknJPadBa1V6+1niVRco3yQp+X3ziX7JmKdsq/s74FTwpYlCNoytdNW+IGT80yksKlKUBofFrJPBUz6u9kkRm8Tz+WQ+n8+7OOzDdGvQzjpLnmekztigDYJJEARBHwZNxQ0W/qwP9TbZVnuT1u3TZozWbLNpzx5DyOed5Nv8VGDUy+5G325LUumjRtbNi1lfHu3Akel7DpqGCRs7MvmsbxXa4SOT9x06LQ8+gmQu/mwAg3oQKeTugCZsulKmnw7rRoDDvC8HeTTJDLpHkmeOvkkwoO09Nb9+tL6pIuvhtuiZq6/k2pc4OEe/1BkGAwfWFNOGfdt1CslDJ/HMVGTeoIadqSO4J/H8nHmjznA+UG0szKyGDdiFmmFP4iU2q/Zt2CWkXTuJV2dYAk2Gq4HTgeeammDYkPVcVRf0pPZMFbQaNmg9VQn1pfb8cyw1nqemgihhrwM43bRssjAtTG5OGgCgrKYJiVEtZiqRmP0xAt0Q4KYihp+ppRLzO4bXp3rNJMTI/JlBweY/FO8a9WZ4tN5TqHXtJHOVRJqUMYql1liQWcdpEXEGTTmIBupIyXyDSGZg8YwutVlusJkGUfozjEjpWMRCA80xiGZqaXKcag5SAT2MWF9M36CjQFOUspoB+QJ4H1UT+lQjm1EQd5Ag6CuHjbnUo4OnHRoBbI+pZcypBDNUsD28QWbgiIEI5n31X2Pm9BCvBcrSMkAWIGOIYNmh4cEGWVo0iUqw6jnjcPOkh1rzXFRiLEPEcyHWIIWHiuTKMkg8UChBCs/vO3sLswIUSQoe4kujGxkj4ws9+k5JhZsdI+1vg6CXGTJCUzB+qIEyAr9ibDCzZaT9jdFjZswI+ogx6bBtRvBnjBtm8oygjygTzA4agV8xNhbraIR8R3sKtZpG8GeMUR9jatQF4MyHWFsjWyLEsMMUG8GfIU4dFtrIkgax62e9jaypEN8O225kSQPZddh9I1sixLDbKBzZk8Fe7rIZR9ZUiKVuUcocPFQuLHMlUAQPzBgyOWViH50fbPYnkL0PZQ/bpDJ10FdrNgYqkHPQY+ROZbpph96HLVgg56lFFjWrVqaeodrZZuIC+c9AmQDNXpl63nfybGxgIOd5D6W2kOkWaJ42IxnIeQHmDBrOMvWyw+CArWgg/6VlYtAsa5l61dNu4mY2kPOqxxznuYpqcVHdYjXEIe3iguoFts6VMqD6zW6rQ2UAVRxiwCtl8PtawcKch7KHVZxs43fEGDKNeRY4Bd9C1NL77SDCRPgGIoxH9w9hOLp9CMO7dg9hKnTzEIGje4cw3rZ1iDQSvnMIE/TaOKxJB+0bmiRd24YmRdeuoUnRc9PQJOzaMwQourYMTZIeO4ZAs3VuGJo0xn4h0ve27UKAK7hbiGgE62ahyRvZK+wndcLq6urWqV34kY1CdJjp+4SIEFu3CYFRAu8S9tNywhjqEqYFxtC6RQiwhXcIrSoc2SBEVYa+P9hrPmltlC7l5bkNZuDmICAhyN4gzL5ja9Bkj+0M9puUJaPBFD/UZjDjohk2A48DgFsNBqKf3YCR4ZYDRoHaDhgBaj1gBF32A0aHWhAoAWpDYBQ2KwJtMNyOwEh6WRKceJAtARF1WRMQTZc9AdH0tCgg0i6bAqTpsiogoh52BdiEnZYFRGXYFuh4sFkXIGfQvkB1h9XCgPgjNkZfyeRWRo+unnapCcTSsAxA3dZAhd1qbYCjB7Y3+mpGbnH0ELgFztRqdYCsYbujYwJALA+LgtFtj55zkrA+eig8z8VlyG6BgFKE2CBYFh1WCJQFZof0neZbSwQU0x62iL90YVvkKYlJTWMxRyBQP4vEQokbJRYi1C6x0KCmiYWmyzqxkKIGio0GtVEsRDYzxdaEuKVioeplrEj0g+wVhK7LZEHIuqwWhKyn4YJQd9kuGFmX+YLQ9bBgsEbtNGIQQsOOsY0WmymD8QetGZvqsRo0SC6ITTNAnrlZ028gTHtoGcS4sY9T3b6xKQqriYONMNjKGaBnuaHTT0wXVtZWcwfLALZ4umcXxOixaynd7uk/8wnTp5/69Fyr5NkNIEz2EBvIklGHGYRkhFlCAyyM1hjCRLyHPTRd+LA9RO2dfWyxhXRAPzsIocJtIIQAtX8QPGr7IPguuwchQ20eDI/aOwiBzdbBmgq3cxCKXjZOQzvIvgFoumwbgKTLrgFIeto0AGWXPQORdNkyAE0POwZqvE4bBiAy7BdsFNhsF4gvaLdgasJqswDcEXulpxxyW6W7g6cdGgGxUfAxp9snmGBbbRNoxMB2SU/9x22SbvFaoCyttgjEGLZD7BoesUFwTaLbH/1mHGF7dKs1z0Ulxm5zQDKD2BtIBh22BpABZmf0nL1bGwMSSQrm6BIK7n0FBedW3/RSIyAAkWN4oCId2RGMDwitwAPOoNFmJOwuPKakVCvlqtVx5Yq4EDW/tNdwuXRF4AdXDtliEvaNNAhQog3oArcKtbdN5PRTWiVpkhEjbJoEehQtCjwTNPiuQCmehms29y7U4g06ZSwlPhobpQZhkVBHqNfxuP76N+Hx0lXHaftQ6thzfuc8hsUXCX1xoY4KCxDM2eFjRAmpotNqUU70ZJAz/d1VK0YHzYAroiY7Pm4Mrmd0t8m88UlDmdvYdniqobmN6QufPbMcwpydlA+sCTSGVbZ8RJ/H2KiGv1j1ym9II2F77QB3ad16Hm+jQtOF1zfL8+qkrpeBDJp50uSLkXgqtgk/9B4p9UAp1d6we4egeqCg9noj7zxZ9XBZteU6hL8prsPe/EM5gxI7hPdQofUsQtsv3w659VC5HcJ+qOh6dtHtl3WH9Hqg9HLWGJWvwd8tvz4svx8lvj4svl9Nen2L9H6A8Pqg8H6A7PqI7H490fVtovsBkuvjkvv1BNfvENwPkFsfllu72AaQzf8uuQ1guV18lOAGsOAuvprkBhbJXXyA6Aag6C4+QHYDRHYXX094A5vwLj5AegNcehdfT3yDDvFdfID8BrD8LuwCPFXg3nuldwpJr/dBojuFRNf7WnI7ReXWe7/QTgGh9d4vsVNQYr2vJq5TXFy998vqFJNV76sJ6tQqqN77pXQKSalnFdGZiv2Ate0MlNKPW9vOQEH9imvbGS6rH7K2nUHi+iFr2xkssV9zbTuzCO2HrG1nqNx+zbXtzC66H7K2nYHS27W2nWvwd8vvHJbfjxLfOSy+X0165xbp/QDhnYPC+wGyO0dk9+uJ7twmuh8guXNccr+e4M47BPcD5HYOy61dbBca+v1r2wUstx+2tl3Agvv11rYLi+R+xNp2AYruR6xtF4jsfsW17cImvB+xtl3g0vsV17aLDvH9iLXtApbfjrXtUoH775XeJSS9/geJ7hISXf9rye0SlVv//UK7BITWf7/ELkGJ9b+auC5xcfXfL6tLTFb9ryaoS6ug+u+X0iUkpTXfSUUFx/ai3KCnX2iZmkft6K+NC1KdCy1JmEWkrPLife5UOrceflUGSU8HK04nM1f9mziifqzqVX/8hifzR+dqj6M4KUjEnpeqO8TE/V7Nup/PlFSuAZqPZiS8kxruqusUL4rtMQuOsb49wUHISxE82fq6AwdZ32LgIOTlBJ5sfe2Ag6xvE3AQ8pKAaDlr9H+BsobqFygkrv7bZE+K/LX7eUhoBF51vMdMWTuTLHzchPKTZ83tiTqVP1sIPeRFEbTw7DlJ5HW45lc3pD8aiRPWQ3Rzqqo8u6j/iIv8GOdPdW21b06beiDZyZnsw5I9Me6EDbIKdxcjLZeyKvJs1zxuKt7lVDGTKqlS8mot7aQ8bWSY+gj86gLFa/UcoTilpLwU/ewDjWXdqayV4I55e0NIaLOPkDTa1GDlFxdvFpL2nTkb4+bJWA0RyuXCGSkw/BE/n/7YxyR/CFUBtc/nrxA8XHrw0VDOywNZSS85jrB08fRhd02MhyE7mWKtp/a4d9GRnVrp0RBwv4L2zABEoyOk6W71zcPmoyQ8amZ1PdJY5pccwh25TJOMhMV4V4RxQrLqizf1YrIb/ZbM6c+I8nIW3rfsl4t+CyYwZ1TgzylGW7tNGkYPeCtBDcJIvrpir3OxK/Yaoyp2oKi4VqcTsKrXNQpUr+s4Va/TQgxT6g0/u1JvlMAbQqXqdTUN1Ot19RcXbxYiSCGbKF2ea0SnZgdguNy6rmU4ajqd2iWQQlfAcKH7KnQJDSp0LV1X6FgdLNoc4Thcm4PZYcq2B7hfQXtmMEybm88TW0xSltNwVe667qjhVetQb+nNvflAba5kfqY2R0vS1jFlz2mjrxtrD6ErVF9dp9e52HV6jVF1OlxaXa0Xu034ZTYd1f+vaHWNANXqOk7V6k0xhin2hmWHtc465+0NoVIVu5omKXatARYXbxYaSB2bKF2ya0SnXgdgqASTJf2xD0xNu9cgUMEreLj0fRW8hAYVvJauK3hLTSw6HmE6XMeD2WEquAe4X0F7ZjBMxzfdrar55qMqQGp+wzV9vI2X8WrUsDvXblcyP1PToyVp6xiHxQPeWkjDUKKvrudZJnY1zyCa5Q4VFbfdV3PNdFfxqI7XYJrhXpdhmIqvOdo1fKMN3mAiVcErSaDhzuq+uHjDSSCtbIB0mWaATuVuolDJ9Vf0xzoWdcO9NlMg1S7DwZL3VewtGNTrarJht6OVsGh1mOVwpQ5lhqncbmyvUvZjP9BorztZs9utBirNbrg+97beygtHDbdai07nUzcYqtLl7M/U6LaytPU8FskhLF6ARlusZot4Bi6BG6Kvrtd5PnbVzlGWfRkBGbAzY9CgGt5Evnd3RnC0K/m6i97eUDpVz+upPfdoIDJIT0M4XeQ5plPpg0BUwOeraRC71rHac8NGg2Pl76v6FTyo/Q2EdeMG42ct5/vmACRLTE/3gvctbu9MPnATpxEqPa/hk0Gw8GfxdNQwrBXwajNfxEMnA60EZ84HtuLIC5nsYdhkUOvvr76Tkz10buRk1r35On3ABKASWPZwso/cmK/ZDdT7CpG+e5MN3pU3aOA9l+yhY+8me+izdZM9vF/LN53fS8XLWLDM/Tdssgfrfk3Wdz8e5ISX7b07NdlD/42a7GHoPk32MGCbJnv4RZQ4H8T/C2lwOfuvqr6TbJsDbeVRHCw5lOKrq2+WiV19M4hFfdfpA9S3SoCqbw32XvVds7Or77o33t5gIlV9K0k91bdBA+ldA6QLMgN0qm8ThR+okoUXE3wQ9lTfMhYsc1/13YJB9a0mW9U3yAkv2/vUN5QZpl+7sb1K2Y/9B6rvRkKUjM44R/WX89gbNdxqlekv58vtYqD6lrM/9yTVUpa2nuUpikhZQjubQezNYQ3eEH11Jc7zsetxjrKocgEZoM0NGlShm8j36nTB0a7W6y56e0PpVM2up/ZU7hAZpJghnC7sHNOp5UEgvgvvbqLZ3DpWe+p6DY6Vv6/GV/Cg0jcQVr2P8bOW833aH8kS09C94H2L2zuTD5wJGqHS8zpja94Pt/521DBsFHBE/GU4cDLQSnDu7rylOG1tn8IiS7Id2GhxPFtcSY4Y7oj+qH7TNfnXd4pv8ulwi29QE8OJxlJ00Ommwa9seNxD3kAqxdYKNNBVnvPucpannff2htJpHvNaqu6CY2u+Lp95AGf4SjeYbr95CGgR+3gZxD1HsDpfqGjYk14lxOrU25texsP+9DpCmzl61dDmWo/xP8O5Hs4S9X7vA+9b3N6ZDJpDtAGhzCZamiqCev7D55XtNtz601HDUDjOhAt3qPe9WoIz5xVbceTT7WyHCGWwRJYZNc0v4MvDsuny5mEgyxqDIwYsMXQSi0ePBnzvAoMz7Jo1aN+8vWFkulOPkthzdQFQwS45Bsx07mCQHt49Js4yZ3jb6cY2PHsuLFQ0Uvb+/j0tHPHwUQHWRQXCzVbG9zr5QBnifjjd6J5l7ZvFBy4nGhnSsjpH67uzuTdq+HE1O/Nni8GOPnIBzlb6eGnaupaHME0d9ud4k8cv4rUF5XK4eGWB3qVXg131uSXOM6MUp4Mlt5We12poKA6eVRoWO2Kr19Ko1pLlJTiwuAcsHsH4Kan247oTJJYjAAom78N0ayQDL4NcqTfnexeEvqaSVWGSyeIFlglEgsWTkK9ozIY3gMerFMdh5j7uoXrIGM9FQHJlZQL2jgijcsZOUMdpaxTX+DGJSf5KdcA2zZ8u90kck0xOc2oEf87pSi3It1ftgGJ/HvMyYRfdw02Zp6eKXNGRQgmrIszKbV4cLtlvaViRIP4ynrnfjth/XKVITGFRXBPpQcw+QX/F0FSOjxF2Nb7JozZGxJs8ppD24N7wcBrL5tUcizqkZxyModIraqMEHsBeJhpUsDo8iIgRxmi3eV7VsjDekzCWBrurDHb3TZJdTCJk0GhS1lESRFyPoCAHx9IxZtichkOrOwWvlcRLhjG9J1DeUoK9TWhd4ekxpD9XQEGdOcshKcdZXkZFnqavQii2yTOJr7i0jV8aeZPiSlyJJ5b++oG8bIvwQEpnG8bk70/Vq/utZDdVufiDWrMcE2bJIaSZjbPwQC6bzwC3u/wpwzki4urWxi5bBcl84FxpEpDzPx/PyHdsZPzPRyTbfz7qmf5Bqakr5+g1DP8AVuIPmclKb7geZZbzk9CNRpLYgkWAmvEP2T8f+xViSBngJqXfa8SY6RCx+GCfUhI+kubTK+Nbj/UmM2fizUqHhCUZ56dKZqPQK6N58nOeH8ZJpmXHvw7MEWLmTNp6NgoS5g8A5SxFO1ozRYqOVfj9hWM9W0ZhSr5MVrOLhn9+qqAWpZ/PaVKdHV5sPYd3NarIFiu+3qyC4AMKKDWsN3Fpy5ZpEpNxRp71tpUSZNY84ViQR5CCJWD9ITWNPyud6LRJovGG/JyQ4stksZyNJl5A/zMbTZZzoHRAJlX+iphmteYYuReAVSe/8WjUtMrNWiKZ9M0jPFV5lB+OKalIO58WJA1pE6np2l7ZqxpcTTNY5f0k91srIzqn50eSkZgajK/UaKRoPmVb6fkoEpY2o6SZN8a077rHZ5S83pSCEy1bg6MeIJVr+MrCl4xZQKrLLKcPNV5py4Orina1+EjSNDmWSWktPFvyUBISn1EPhXpwlSRqOZiWdnWJRx3oqESclOEmPbsWLflZ1eDk7XpodhWdirKOsTcO0zR/IrFaCbFpwUOFFWGcnMpL//h8tc2zalwmPxMRQk+nbKzoFsgDfRvI2pCWgTVuM472JHrY5M8T/strfqrSJCN1VDUun0lGv43Z6gVY64+fyOYhqeq4muOI1vVU1Rx40qkkxbgkKYl4wiH/Gfpamh/1D2DBv2urIAdVnMyQijpJdjxVf6pejuQH/u3Pr6aqax6Fba05pYF+HidZTJ4vx17/TJqSvja6beLPyOGKL9zrv5R12JU6OKbH5+YLHSdOmadJ7Px2EdKfK2lCauVJn6z7FvSS/UZiXmBZQhsv3VORfvkmDqvwkm3gfV8+7r57PqRX0T4sSlL9cKq24+Xo2+C2fNw5z4c0K3/4vK+q4+X33z89PU2egkle7L73XdelpJ+dx4Q83eTPP3x2HdfxHO/zt8H9t8HtMaz2TvzD57+buNPJ3F/87cSbziczfzINJkt3Ogn8YLLyfj5MvMWC/u9vJ8vZdOLNF5PVfOIvAg747GyTNP3h87d+sN1uP39fc6dZfxvcf3PhZPm4IEcSVs7M/bZpZe1OwrmtBwX9Yzu3/47bsFbMw5qRHQW8ow31+Fp8evjPaSjyCfEdzahHteFz7r/jZqzvAQ8cjc3l83e0pBY1gt+0/vc8HlmjDGtIfr38XUMyexi9h4F51ft/nafOakjtkg13V//PqRW5g/47WtH0defenv85NST3b32P2WQ4iXL3pn+vDam6bA01oGrHrndNWZp3FPcb+M9pYHJPid7tSFd6FSkOSRZW5C9kTaRUv25RbzaZ7ieLx4m/H08WP3/lyQNqlL+MpY6+SvmA1hm2dsGa5i9iBfPRA2fwkgRrnb+IhYm+pviIsTN0pYE10F/CekNfKnxA+wxeQGDt8xeyCviL1cxaAJT/tXHIX9jK56MbZ/BSBmucv5AFzUe3z+AVCmrz/GWsU8AlxodYPwMXHvgM9pew/PjoYWRbTzAH1CJPx2m4Ianw37WfUP2JHyn+WTpTBJG1q7j1sKm3vuzmJZn3vazjfhwbq7ivUdmPaWNM9jXE+jGtDbC+xkvfgiJ7n/3Ihc3zsb1cz4Z9J5N+PMUk0lcH9xyQXPf2VVl9+7pWVX0l/XKbR6eyQxLp0W4YU69cx3Wo+DvS3ZXlt6PJ8mIoc+OoFsiEzQie742Wi5HvBR+QjawJbJWqr2F9SHZcTVjb8KMy4+rDltlq/lG5NXoF6bj2GYIPqhqmcAaxaRXPLzXeGp2EZOf7I8+fjfyp+0HZteoKyTGYjXx3NVrNP0qehCLDqjibjfy6VT9q3DU6zpLhbG6r4TA/JoQB7s6EEGBeTVGepuGxJI74bVwVyY7WUfVn4v5ZxzypL2AAlNw7sKVsburGYUWOSfRACqkIk2VTxza1dSIbgV9F2WRXRxBYexpCSe/1M5R4hlJtgLrKWHbHhBT6bbTJUnmxVvuqvonptQ5M8Yb+oHk11z3kS24aS/qpPz/2vrToVfbXFX/W1glPVe7AfalxUL5EJE1fmQcm85Hj3nH8Kdvm6yGJ47R1rWNsGCng5yVe0aUlcSZDC9R0kJpV/ZHe+DkdB/My68ttzdr/jA2ep9qXbe66A/izu0hqSYv8aXAxKRvlQ5E/mWzfydBoBaqOTlntosgGVtMqmxn9+Rq5VXkccntfGvbGnEsd4T8+d7Om05D+fN286mlNPNFcJ5D4YvR1c2ULBTPXzifB+IuP2iTztcpKYqBEQFjrQfmzSYI8kqwqTdEX2ilotdNwJdWVTZsG3S74Ctk4kxryyqeuKbNDrnrc4eTewrxlXGcSMFr7FYqvWInm344np3+pItgfR/2lSmF/0e+XKoX1talfrinoMvCXyavrJZZfqs7WENK/VCG6AqH+YkqhIwDfLycN1oBNX6UYkzgXZdBWrnR7v7mSMQlmYjVDFbpHDo3yZilfp2SbsBAl4+XwZyLnj542ZCPCiDjxVbIZpB/CcDUlU7kkw3YcVDp8o0HFIfsL1mgHdfAHNB7WsKWksK9qy8qTI1DYo2CcmQ9rBodHWIiTMM13zuSQx2E6jsIifm1zms7d43MzGulyGYLLvzcBKfQ2BxaMdja1UbxNSBorIULmx+detGztlFQpSaVDiCo/srM4Porm4ooVS2KhNqS0zoy2eV696hYiu9NFsrgXtRG6pNmxakfaDGi8AdFJoM4VoMCnN155QaXQSnWhRmYC22oEvrfsgcQq3DG69rexCNRDYWy5R4fsSIKiXBDafgpCMOVhncya8hSjqjwBritPfWdldTZYbSGtJpg0gaTMujUJRtWa73DNmsR3Vkzj0qdeTbWa3czvxG/Axc9287OdVfi6zhWrNWNxxiSeRu7Ij3J6HSSmI/Lccn4hroV6rr787xcrByy2Fi0J3de1XIweeBX6FbrAPDxfHi1vYO5GADy+IX2lBc3swVdsHNc9Szcsrszr9C0rsd3DC3NxTjP34II0vOzw0VZPiiD5KkX6aiFDLqJDNMCxRDNwG5uuHnwKbT0zaRVrt2rKKqySSL9JXgd8ENskKkcx5+pM4bgOOimV6j6UQsopATdiJF41FGRnUvaLhyqzP+SbJCVjplvR1qs1Ts1FBIt7csbO1D0+X1zp9pgUtUIJLef5DE5bwZ99+5vkcMyLKsyqKx48TvrUtBCtlfS1rrH20RY4zp/R2EaakBWHMJWDbJmNbmkV8yisL52pBy49JZhY7ZZl2KLMzKTc2SkEFQ/25VULk4bAvlPN1HpWagwOhIR+Ic/HMItJbAtVWINptcI4ZtHiGgezy21S8FBx4mM74w+lawyCoWT1Is+p/xlOfpZFcW4mHWajpATMAAzGwmFKFwd4SdowfoP6BiTr7BqQqnfPpKGlzT6oY6x59OsXZWk2tSzb7D0zpDsG9MEZDf/VWnt4Ezct5761lHuSHidRfmJho7ZpHlaXrJWvTMu7baSojuYYpUn0wPhrJgWbl/VTMkr1+gvHleGFEIYlLYRTPgK7o1IA1CvqSHwZnQr6xy1NvSqrIn8gzczsNn+qEMCs4nt7dZRiERCmnjDSPGT2K508U3byO2wNY4nQy/JGzrOMzUCtHG3Aai3IKoCj4XDHx3BHXvnyaLVaaYsrO6EjUmnPXIZbOgxp/ZixM3O/dcaOT4ffRd0s5ud274SH9QUat83mHYtG5YbNlv7In3TfSHpMj5aCyQJw9mklaFpHRMu7LI9Jdt3EA5qVTpJtkyypiFPH3AZCCtUeNJpXir9yp0tpf6xWsqZQSHrYTOSD7JtvrtQIcHzQyyemvL5cPMDTVG0QePAg8ORBELSDIGi9iOo0ttf+NjmQsmTDjhn3o0mWV8k2iVh7Nh8VjyQeqonqTBn7+0lMWHi8eogwkZbN6Pyof3ubNJasGe1QnESwTToUp+3b1jT9do/RrKXDh7qCESn1tYpySs4bRRUdLijcFvfJwQhk1+6guO4VuAZt8ncmVR6W1SsYqkzUID4V7JdLGgqLH+YwF0f3yvTYUlwhqXPN9PjsyBdqPP9iRJ0k59p3d3qhy0sbmZ0a//Wigxz0KrE5sH3Hwder157fq84l2gVriKw+c5ffDjBuHkNk9SG5cih9Zd7KhSjZwbbqEWNeV4WzpIfR5nd+MITUgZ8em4TspBeh4se9JhU/mkUI+fks0EHNWSr8ZI9xKQxuO3YMimTNz0IFXZmF0QONKd8zUJ8+y7N1+Thk7HtKywfKBvNwBOTAHC1X6nCSIt0H5AC0hzOhQt0ebDG5Q5BhHdhctmBZKWTiK+XGlmvhIw5utM2EWsu0Gyan45EUUVgSCy8h9IKp7LFjJWNCr5MZwg5RpvXGm5ahLuwQJZV7ndAQdjjL7KFdeFmhjSrQszFUAERbP+arEhpaACIUzz+qtIYiAPuPP/ul96CmAeAGrZ+J0UmZEug1k5uKQomvWxsFV/p6b8g5oplDiwlmfE+y/ntOA+kaSwqgEdrd1xGcWOVHaKIwaERKlR+bR0pKkm7FiwuWnGvLFs/fSNdKgacLYrlE7emwrUxU/ViKpCXrJcKSOalRnrIKi+oNyy8sEW7UHINsGd4RjHecFPVLD5dRnp4OmYKqCwwDxwV5JIWkONluR3QqaRXbh16MNcIgiRFTh376J5nob5Mj3UhpVgPt7yze9ghLpHG3k/xUapeAfXmWKVqHH2sm6qlTR4YyGDrVAQ6JruRjqJYl04nJ4ZgSw8mh3uJXC52U42bro2e29MYPVUb5hP3330tIYqnI3zUlhyIRSzD5hhb78N4AxFbe/G6oskLrYSQ2n9WYxJbgxWhwYtPFrme44j7VutyQbV6QV17qb74B8qtLPZnLpW7+MlcUV/q7Au7FVZ+3EPqVVxh3vODGUq4/H2btQXzaC/R9WTHzDyySuDbflxW1ByFO7WX5/oXKOKfRALLGWITK0F6N78uMWo8Qp/ZCfF9OjTkJMWuvwfceQrV9CQ8ifvm9f49RgxPmxa+8W3mZ19DheARDmHSEmxjKyh5nYig3e4CJodyskSWGF62+4T2UriuWxFB+1iASQ5l1RY8YPLA6wkYM70FrvIgh7LgYGq/bXOic+kSXkfBoXBkJY0bHUG2IHoOjg0V/se7BqLdQ9+DVW6R78Oor0L2KZYhzD6oBwtyDW19R7sFqgCD3GUj9xbhXr/UVYjPQgi4mPWO99OD5jhAvw7mfE9nlvFyGBXQ5L4+BcVzOy2RY+JZzK2Iqgf7UZwRrOS+joTFazstleGiWM+VgeESWc0fQwEAsUjZDQ7BopLbgKxoUC7uieDhp/tZ+bYYoECc/soMXJYDAlXQXiV1FwsguLT7qyraVQcw8LtUADcpFmqnrNi1WvaTCaVUtl8v50gYhh2P1wutt3AigOn5B3/t7Sqpo/6r6Of3F7mbVpf2Ol9rcx6oT4Ee0lIsKNmTfHSlo68mfLKRNHG8yW8zko3Zf29JpOloVDupL0msLqrsOwPaT6tpi5sy30Dy1IvWfwGt4il4Ijs/s9NVVz1hn9OyV7rz5Rpp3MarJAoisx87WiBVY/Xb1lKTpONpTzdXS9WkwJjlpWFZJxNuON8iia4R1P0w2hBwNVz+UCRbYfSgfLAT6UD5IpPDhxaFGxzAaPAj30NyRiMxD2eCxiwcPFTTK7/D+QeLgDmFkbhOAj7gOLZsmmjD3YD6hA2qqZdG9H1ED+4SthVwmF963o8nqogcbi6IwDN5zGIKqQ18dncvYUCfqguhctoZ20dZA5/LVtY227Dm/uK32GUYJ6KAP63RdKxkLm3MZA3pKX8ucPVBNxWUsX87vfF2V6SsWiTNbESmnumrSe8Pnzi968Dt7O2Uo5+FbKefkMGQb5Rz+g7ZQzslgyPbJeRWQ9MjXjXF7TibDtkzOyWHodslZ43zoVsl5I2XQNkmdxbD9kZYG3xhpMeiOCEOAxy2Qs40SSfNtUgerpNfSj6Rw6j9f1aCqroZi982la3UXGt4Swca8q6xwFlGBxs/1pV26MVQXSVo4NvVzlvODtHoe4E244SFq6sybS71lXlR69AOBZJdjeixe4bKpfNjoImUkmbtFXoUV+eIt3ZjsLgC8uK5r0Kx0krq9JA9MI3wncIPGvNvIYxNfluQYFmFF9Cycam/eZTYgzqTas+7t2n6x0Tbtr+4PSdF/+VUPSTiaXUWMI23T7HQgRRLp/n80Hmzj/Cdf2mB59GQJlNdVyyqexrdxqxuGxK+Ir5RxpQRgKPnF1SNcO6MzA+ouXBfhQ+nbWKUgpw7KkT25HSz6RU2drFCjfKkvxNg2bv59P4Veb250vBgzsLXgw/bmgBzgwzaijagp5v07g5zm81jkWR0ODIxXDdKwstZETRQKdiXNimz/PCsjR35Uh7WOpPu5J6beNjGpwiSVLYYkK0nl1BdY6H6ouOwoD8iQ/uD8nOZf2elYii6hEzbLHugk/kqzZWiUQR80L/2ZMaHU/tKUKYkBtTLqhlu1B9Qw9W5kL39qLfN9KEJ1RGERl07F4sKpJwadNCxCWrV/5bctr4zo/J0sNPvRb8aBuljy6ptM9TDRk65km8n9FriwqhwA9CmTU8XvqpNTxZL1p4ZKuHT7cOi0IIGBjfL5U5SGZfm7H5Jy/OcLII7TeYwwBTeAKVcIUgDB8aCaNQwuRIAnpnqbPxlp82DBBe1Sxb5qQyYit/E2pHoiJDMsHPzphNY76eMLr59rhVVVfKEzdL25e6HHINTvMNCzLKkm1OKSrfeUPEo7xOoyhaPYEqu+2W7eggcDKahubTqT//xuxk/JYUhABPAuPGvHko2tUl+GXmkBkTj2nTdOpCzFFXSz8vrNcUXawBtEV8A0rWZT/8HirKlnz+yvTVgmZRO7qibuufBu0DymBWtf5d2XKtzV0apImibHMilf5UkGuCZvxrCrpU3gOKO3/uFkdHFsZVpEWZj47Cr7mE6MF7bYIm0n0LJdsgKCgVHZVTM+BuWIMLQ69sL/nn4bdUP0YQvURNoJWsln8gvaeZ0ZOH0LwlJMIXpX5sYQ7FMQdNzyG2jNfpiNkzOh3RXl9D5YRZi80BBl/Unqxc6rXN+rzp5Sw24YI7bR2UzLSIYe+7vddlqSw/CCCjte4SqH7hjAsd6O6N/VKvnv+UmrObvRdpkEtSdFlRzM16RYnMG2KVwYNoKJwTerut+ZkniFCOc6sh+UZIvqZ7xZJYeWlJm1v2svSl26TdxruFhiXmgaXNsQNja8lOHQh6EWIezVblbVAjH3JYFoI8+ck5/pVYf5sQ3i3gxGdXnXiwNbxuZpniktvVA7aNimvkqHb+yrOGxzv8rztEqOr/iyT/LpE/DmCnTdLiP9K3e24MFK26CbV7ImpPLmfOfMjs/Od3TleiHCeEIROf+VBeS80Itg3j9sn1GbSWuL7RailG8cjnpyrmszs2121/VAEEYD0Kp3FQ6pFnfMMonVC5BwmzTrLZNYuvI46sn7F2sV5TomWC/uHgYVPLPVS79oCXIXW12/bLWVe5tgwbhDmZUWqrZ+kfMXrhmcvVY57uMGiKh2cxQR/9qxDRpO0l3RUW/+v+BwV+6yIrWrve1M6sMprRKqvsUU00xljcWzZBvdHXT1FKMQ+tM+hGzOUegCV6dr5gZjHilkHcPq634LTCTs93ZPwJxSgJnk34CZhHHuq/GN2URQo/OJlb/ancBQaioLY4zaG0MJLB5aOWBOEQz6aH5oXhEMsJnFmsMv1j7A3GLUDphdpMJn9tr1mWGA+hmFUCehj6o+MMcYOQOzDEQPV7+Pqv+K3dtvooPmmlZ8e80G0HwjDTJsxunI5RcUA2DOAepozDqCw+B5B6bsNfPApH3mHj6HGJNPkyDNPsjsYpuQBqxjalpsejC61Zh/Wnp0ArJn8T5zRm8gY2TB5cPrB0xBLQtohgCayJyEWhbYLGTP5BdrJGAaMisIzENy8bOOCsIzUY8qmiVRJ6OPaQJgKjIzBuYikAPSBF9j3TOginD2Zi2B6UgSZnCmgDSGMSHJ4w2bkboy+gVFApiSoGoac1LLY/CkhJD2mpUQ2j7TEmsxc1Jin8EFETI5GTMS+63ngogSYLOF1qXGdMRp0cnIxvx9Vo7ZIMaggkqH1QyYiDg5NEMYDWNOQpwcm4Js7H+xpgEmIL1qwPTTFj2zVu3MRZC1ckgpm6lpQNWBiUdnCkw7ADVY9a+xABpQOTh7vX7AhCMEFpwFTH1gTDbtyMKmGnsWv+DQByYas4LGNMPpB08yIGGvKQak7JpgtImFV9R0uJFceNqYnY9JmWySlP7ReG6AbxpozHlwz+VypZUD98eS/MtEMISgfteXnYNpXktz9ahsaTzKS8NhGDHf/Sb6QBNEwKNBBPz22cvlcgm+rsirwHz62kL/8M033K1Pb2ULsmkj3sae3Mbs15QovV7PmXXraX7nV1rIMImqnuxMqhp6JftkyyOs3ZwF7vFfaaHAJEI2gZh0UBR8LcPsATB9+IxhcmwUPFJ+po5NolqBY0RcSZp0tWLE6LjigrqG6sMr6D0FrdWY7oEYUH0DZQyOLMorTJ/Cl7LPOJTQQ8ei0DzaAXntXsYc/sxXScynFLVGKPdEd/qlQlonODwESuNXFZNteEortQd/OoWFkBH9FTAJWPtOkhgYciJJjhxguR42aptKv5t1OqZ5GEMvH9Up8oXBbZISKHpy7R/UXPSRvLV7hFNuMmn+HcdFuNsx/zjwLVMtsI8SL0i+6KX1hqGN8XyHPO5q43G+L20Prv//7FwrjaV3PCpla0Q2s5FYTFcjFF1PgpYgmrbXxlx6i6RnOdgE2KcceAzOKzSGxZCCpPV7Ct0FwQN4XuFRL4aUhE7SfQqCRv+8wsNkDGuRzFIODdyYAv0aMOtVP1tw0Stb1I0hlUyybd6nMGhs0itbmI4hJWnsmz6FsUU3vbKE9hhSnMZs6qUiLOFRr2zhQIZJBTXC+skFGl/1yhJBhBbmV7/+30dl+f02z6rwiZT5gUyisvz1//z/+n9//7vffHJ+56zzrHKu6yRnXRDizCbuZO5sXpy/lqicsdPcG1VY5QfK42+TiGQljvk+bQBf/hDlWXnp3N46N//mTCfuiOVfXjp//MPfOn+//lvHm3gj5zaPyaXzd3/4J8754pPzu+8/OZNtOJpsww39T0r/U9D/lK8scl9ePo/rhdkhz6s9nVp3RfjCYkCLoH8aIMyqJEyTsJTe4VOMFDOuIfvyGBZJKF7vqE3PgmQxKRhXtg9KmfB58m2yDcfpTvGKDOj/kYMCrEP0adc8xxN3PmeOnNtw/Fy+Ko6azedSdsycLMV371nOs/noyx/95mMgfwyaj1P547T5OJM/8ozm8sd583Ehf+Q1WMofl83HlfxxxQvvKqV3m8/bp1fA1pee12CoU/qaJmXTeWNq8jbO7NJNdh98qowR/z5NIDuadmPyypBjH74+hBTN17pa3L7bhs31Un6TceIuyYEbd4QQzbKbeFrYRqcJ/enxqh/p86+0jM2Lw/TXNqF+SUl6i5gmKWRMxowvqfGlML6Uavbq3YhmUKkFUfMSn1LzU2F+KrVKyX3LsxtTe1o8jNya2aHjm8a1PZm3YUlAfp5EUFbkWH5ZXlx1Ad7+mrN6IC/bIjyQ0glf3W9FDkaUDBYk4wr+/FblOKFXnYrs4gr5/vb21/+Ll4C2b/1pvHJfaeTVbZJWpLj85ljkuyS+vPvXP9AwB//EGUz+LomKvMy31eQmLJOIpX5hLJI8+8G7+OYKLcwKqQaPQtKWxVt+RGF8W2G8JVIab2kUx198RHECW3H8BVIcf9EWZ5smx/E+L5Kf86wK0w8okztyDklR5AXSc2wu/9cvY+/iCvrYlorPoK8Ij3+DePybyqOtmcF2BGT0/vEhV75XMbDaQZVjdbss8rxyANYjLUVUtP0u5NL85i2Bj2yUNuVrGobNv9LvtXquQimgsWJ8NTOlPnP64K4DGBujnXvbvMbe86j9w39+Rd8nR60Mdn1L4veKTewiD9PeSjIWl0d5oZQaV/QMxYiN/D9s/TmpB0XIFkrJJiX1q+UmNJgvBfREhxuIWTWYOC5IWY43ef4AZbvRcFFYxCBuw3H0tiqAcKc+R0CF9hYuT6btEqZgocMGs90maRJWpNqTAwGRvDjpLk+TEIREHEIv49YdDJU7WMi45i4uCFzJQNmJQkHNZZTifK7AeB8ewp/BXvYXrowYH0OoUFOf1/KwOaVhFkGt5W55yVnIpzAbl6wGYbY7hTsyZnucx4JU0mmpXJIw4OQJ1tZxg8iifQ61shcIRFzkSQyOEN6n2Y6k1LaHyuKuBCgl4zg/bdg/T+CYcwMAjHSc57oAGOs/z/UA9OkIQn0VipR1IaPQQk5lFF66mQxDijUXmOIlKghTs2DnEgE8pWEBjkLedsfjuKzyAuaz1UDjJC8h4KLlBopssPAEICXgUFpJAEx0PN5IRbSHq+4teacUNNRamFbjKCkivBeD2RIhQDo0mK0QAqxvg1mIUIDdHMw2MtxefDeEwJimC5cAGFV44QpAg0V2Q6WGWFnngYzCCjl3ZRRaurknw+BizX0JQ086QU4bAzXeQ/0SLAzcI4jjrVyWSUlFdExVI6Hbl+PypWQxJiCdzYtRVqRIygewQrxLypfDgVRF8gAKG+cEapot76tTTG0WkEHQQnIWTrJI6svVQLlXXNmcqvxYkG3yDKqAqcfnvcckzMgzmO+0hVQ5qLZ4rz/BeqhREHQL9gm2i9xpyDFs9h0zYxyq2JRwIDiaZ21yHIUHaAD6sShPEeUxONP7ojgFVCU3WvH0kmzCNKXlhkyxaRAI4AOp7NAph1bQSPejWKRXpHhpovJBTTRVgTQkDIhzVdw+TLcgzldx9DAfNgL9aaBCq31BCCcABWzajJ0NAe1lbgZuCGx1biOevkesNm8zVSDcGQFC8oFBwAZzt0GbPi7TsNyDosyLnEQvETyIXQE55JsfSQROUIslB2V5RC0GqP08wsucFNU+Dl/GUfgAVm7Lh09SbU7RAwHVELcINkkV5Qk4NXObYJOAoy9YcNGhx7bjKgEbYEEk0IYUBcxqw1FJBo6OFa9Tmu92iI0TKZDxBgQJPidS0UMPMLNAA4G8/BUfbnkKarrAF+ngxEcWPP2wATtc0IMrUdeP2/RDWMAY3vz5U8rC22AqiY/TIkxSeCiHfMQUCdlGYQmq0w0HVRFUJW6KbU470HrkgnBK0hheW3khr/UpTfd5AVtlXospyQsoJUInnor8kG9ISp6PpKjgCXnLsaBm4yuRzemlJGkaxjCqKXgUplTIK3jZRyKBIlkcwkvDQMVgltVCx8HvTFEpnWrIQ5LBdV34GvKYIkBPA1bJgcBIUcwDKUJQklwZMS5IVUDmibsUnMB241s3UViQCjWT44WMwszkWOGFmslxKMPqCQld0c5cAIwtbFceAEbXtzMfQMPL3JnCGMS48VJgKvuqw/dkKDZYPN7mUdfuTSwBQSvW2wYtxLqanQtcnGSkKMdRegIV/TSSkGUk+w4rGfsC9mMEM9oIxIGtM5DNQ2/rCeAxfDmCm3/edipAZVUkR9gYmAnQY1KGIIQPO5JVBDOUg6VAFVWyTaIQVJUu3/qK9vXQIHCWREZtQHF1l64MYh6WkFr1ZNQRtkJcwQpWgK4bSYBmsQ2uOpYyDjcw+Son2kuXPpQJdyUBxpuk3OdHEBcquBweMNMgkmEP8Nw5DbYKKkO0xXTqybhjCEr2dBrIqJ9OhMCwmQwrYGNmOl0IVB1H3Lrv4gUhCMeUZQBzR9VlsATxsMIMVioYm1gWGlNsapkFKg6dXGZTFQjr6oWoewLaqV4ohLHIwcMDn5+foHLheZ6MGGc5fZMFwEU8rzQ5YoM58Hl29NALqpKYLtIcVgrTWABKEo+jkG2fILv1bijAJ9CQj3wJwLqX+aXBVlew9GT06WjFypyxnZBgGcioQwKuWoLlVELRIyP4VCZYcnmEFb3ne206XQ9kcE/68xZ2BCXfizYCkhBEM4tS59stgc9iBGIHjoVAJIMbOG11aeBeEBKLch4O9BskRDMFgvSnvwgVGFwg0XCHYwhODt6UtJACnkDcuWCTZSSqYvJIUnAK8V3BLavCHJRvLi75ETzIi2ZtOqoz+ZlZdAwzAp5W8uUdPzthzZSDfeLz7b2oIHFSYaesLt8XiAqw7p4/E+lluQ8TeJOPb/lHZRmAGj5q01FR5jxOG3jLyW/TwU7f8IF8AkdgNBXJVUq2SQkrgaakMT03HIPaddk0Gb1GghTW91yOqcINvNT3Io4hIbiZGE55eppESQ4a/l4446Bjmr/EoIgHS8JB5cMRXPkFy20LqeCh4C45hO6AV2EBjuINb51kB28/zEV6UoXpOI8IuD8d8PUZWzHA89zKlyCnAmzmgG9GxXm0B5cnAd+HivPoAd4aW/FWztM0LNhhNrjyE7DKMtWLUjfzIKiUG10QF+G28pu2AkvGm7NINpsNcjYZqxjc7A5WCw7Nj5scXB5yR4i4OCHrKn7wFr+EKTwgVw2ChEX6skkKcMMnWDWTAYl38NTn8/QE9g9p+pUg+8YuX9STlNDZBtxQmvK9Ex7GHTxc86aehnoEUTzDwwY+ZPIDDjgm8MIo5hllbL4CZ3ziqphxDhsY/mauAfEFmeiy7DEBN5l8gSjCEl7g880HUlBPELDLOaLak4KcDmAbEY4pwUMdvv9ETkWOiypv6Of65XRsVuJHsOQ5SsPG7xaqWWjALIvgOYCuioT5ToDGE+9z9tAdyHEmI+wHxoFHBJiKDonLEjz1CgSuIkUWpuxWEsZ0FkPgZqMMa1w+Tl/gZiIimSmkI7JttGlh2FGTyz08tmFEsPOAVahixltwiBINdSBlSTLkUGW11dCohLlcnW3DsrKfAK8k4DYvMNxM1BlU5GFj6mzJAV43eaJE1GQlxfhH8CjM5R2wTWA2MykdGQreLJIxuH9MNJdx9HwfRC0kFLZOi1YSiDxHoM3tRYGEYk/igaiZhDrGWxDjyZj8iRTssi8InUrQxyQmcC2XEuoJtpG8SPRhegA37lomiLvihg8jeEZy53GbTsU/yXanpNzDwhpMW/A2h/1SRb8UZTWub7AAMKlYZcXsJEj++EnWNg3B1a8/bdPrTUlSgOfrHtea2zSEnVvESEmT6AGs/FywwDdvpnzrZZvCu+58aOcp3DDuYiMjsLnf5ce92xxesQdemz5urt6B/TA1cegkEQFYpGum/qwFJxGyzF26Gmi8TcBxwMUgz+2+JXzrYJsX1mov5yYOqzbf09/mxemwIfCKUTQ3qs/bop0K3FATLVKQWveNMe8eobQKQjYluMLia9Btgewj84XK9lRtclCBkqbyu/BAjiEsWhsOeQSVsCt4kANYyhlPzmJSpPCGj8+tzx2pxscc8evwuSUFrl59brHsdrh553PXvl2CeAjy6oLLFi8ORLLFHo99gYKXte5qIyOwidcLFBRunqzaDB+K8AFUKgFf3e+SKg1hp48WUSFrII5Iw7IcH8KiSrIEnLp4Z6QJOMP74UwCjMEOFQVOc3Anx+VW0i7fIl7G4YIj0i2qVrgttsvzuCCwh0MQLjUQWOaAr693eQ4vGLzQlRF04Q87WoehgqvjcQCwjQaDD6E3gQEDK+DGMxOID7x4qqCfwjSFXbJIox93RRifmjVYCG5l8WXmrgirBD7e9vjG+q4IH8FlJh85RXJETKNADJ7ilMEzAy/IKQV9qfnR0t7SPtxdbR+yR5UyAvqWevHUgFk2gsItR2fxOE1+Ri7pzJYS7BjCKzR/NpdRJAS9D/3ZRkblSYa7mIQLA4r6jM8MKO4xPjWwiL/4XAciFQ8lXAEfxvkzuYRllJRlDjuAzuRal0eM3apFlXvYxdHfiDzLfQWaXz7X9/sYNEa4itmTEPM0i6MWctznGbht73Izb0/gHWXXnUqADQG9wn1une+TI+guwLf69klBxpRXitz04MuKfVJWOehs6XGfpD01Il7GxxPYEVO+xbSHLUiX3wbZ53magBMh7++8PCL7v+6WC2Be/RjCFfI44lSwKRUcNFMNNCawH+ks0IGYL/TM15Elcmbgz9oi/vwzeCzIR1J1SGfgspLrjtOmPOagquXnR8m4idYFHXs3cp3E402I7DrzZXwSo1cX+Zob2y5wAyIBQFPAFSxi0IziDmRJBm/Tu/y6QpLFyJFs0CJOZQUOdZ8f28qhZZV9z1WbbvP6CTmsrMJdER7A1XHMQRUpMlLR/ck0L2DNym3oJH/e58jFPd5EVZgmEdgCAUecYOUUbKYyYpzlsH8rV6c/kuwhyWBOzdD6Md8ReHwuOCA/pKDTF7fWfoQzWPJUy7TOPTh/LLfsOjOUTdSMiwcCnmVzu+iBvGB7GJ7XsojAy7nBpqnLQxI9ML2AaOONARuDd4Y2PMe8eDwRUJs2Fg2/hAq28IZjjtgh6IojCmSxGvAT0DQsq+0BHJm+jMC7y+fXSVP4iNjlN45TEmZH2PfS43mRA3h04fLTT2TZPPV4daiDBLPIsG2ODZGRpyOK4z2RbMm4QIwHfoeHhZLbnFJwiUt4f8G+jQE/YpfCeiv0kdemkxi8xeEuIxUzhmGkZQXvN/tcQNMkO4Eb8guRUxGiB1f8KBq5ruwGYZuO+bX7voQBd23caCNBTjCE55RH9WKLnTqBs8RUIOFLFkGbju1XBqKv8mwnXUXF7sW6KxCO3Yp1QxCO3ol1NyAevhHLnWPT/Ik6ESMXEfnqP32Bi8j3l6nNkIHXCwN+bnAId/CMF7sCkMGHOYs5RyBnQqIU4J4iv/N0CI906wb2p3Cnno7CdEU0a5HHBA5bMG8hVGrgOxILDoLXVr7fpjeX60GYxGZcVkUOL6/8lQED3QZ8f2MCwd0GfsB8CJ/hGdXjN5AOJA6P4CIoiFpIAp6t+0EoI8YHkMtCYB5gx4tty6WAe3XJAaQCRcbnLgwHArpbeCKHIjrBpitXKockKvJon4DZxBsJxNaoYNO6Ogq/0xh4EhaLlcJVJ3ZLyJ0vJYDNsJ7LOIs7vcCBF5cj0QzPmBOvv+RjOvn5BO7HBtxwPOQb5CTY3cgIVOZjjorBA0LukXrIMwJ7swk1l2fkZbxJ0hTLi7vTHHLYsYMf8xzyKi/QW6qeqPmpJLYNIe7LfziVsILmtzGy8FgiNjHf+c/ICcmFh2ihe3zYppxHmjGY0cJmcT4unxLY6XzKryAhls3UW7XpyOqEH2pkR1Cn8M3JrFyCyU2zZaeqYCGV4GHMt2Trm8JjGsQUlPrpQsGdMhzZ1DyPs/yBbmJkyUMCrkcDAGix7OdNhfMjySJkWySIWwwcwIYf8eRH+OKfzz2N8mOVZFQckEHl882AvAS3ori+zU8Vup3QlOUY7sgU7J1FC0gTeMHtcWv7GCZZNd4UJ+TmuIClW/C4PuAbJGz805MNWLUrMHqpArR05xxU0N2LI1ikOOagEr7ZxQXuGFYFgeNs8UuRx/AEXw+eRhLAcgS5FJ0BGuR8kxO/G0d4bUhmm1Y2AhUlqI7lq1jawvDg8fmq5kiKpIxgt8aA77se9+GmSCLkBnDA5/TjPidZ8jxmESifcvB+d8D3qLG5313NJIDteHQp4x7pZQmwZ/i++XEPXlfje/zHhMTjY4IcqIRER2HHu+HSQB7BjY1QZJxVpCDw4jL2NdAY1JqBp8Msp3t8cCAS6vIL08jhpCvGYBq+4BLhTactrKwwR84g3nIYfMOezEU6bLgt2nSb3TaTYHjj8GuexzyOQniXMxKQUxajuwZ8f5+5nY3zLbibxF1QjgXslubyLbRjkcenqBrv4VNNn99TOZ7oQxtQM/PdkuPp55/pCXBC4Igk3Nf3+FLt4Q7jQR5++glUyk13/YTHr2lU7k8nUqLevUsVY+tYwS6JY8yemgl+SVZGIajtpi2nHJ7b+WL/p1NeWeK3xTIKj9/WNHQRZnEOOgryEAds3xNecvHpqyAh6HA/5Y4+BdnA3pbccC8IZmt7fButIPGY+jWAcwB3GCpIDF8O8EJPRtDgibCL/FLF4XMh9zArSAzGVvBIm45df9uKBtwlZYV4Ivrc1bogWQw3dECmAlLAdwuXoiuOoKNhQGYSYAy7tXi+L4FgSZ9zRJmnj/Bmb8AjuRSkeiLIvhRvGuzGDB8WmGeXF8xkRLQP4ZaT+BRgpLOA8KLAFwu5g3hRWlfkfISyOI6o3ualOR2JBdW0cRluwwJeniw4AtnkJzwddBRy+Z5PGe1TeO3FrdsyKpINvIHAEYT6d4OLX19G4LsjnqvgsLnYJTKMZDs4TBM/RKChZ7BZNg5bDHzrhPvRlaR4RJZYEiKJCIvkC5anMUnLPWIVzKcSADP8iKuBLGOReDLWMmZjjiMPJMWGo893ecp9QlL06jYRzOCtOb7kLPdJUZVJtjmloL+LJ1ojPx7rEE2wP4tr4ErEB3TlaVBkhe4uRBmpXQVO0rwzafzbJEMV/1yCWU4o/XAhAfMTepF6O2txsOcIPxgsk8MxfaERo0BGnmBUVgUi+lzOkorAxwIu16rlw0tYpSEcw2YuMHAoFh4KrUxhyfFWSwnAfJvA4m45KolJgdzni4kEwiTR4/NWeUA2PPmsVGbhEZlx/HCjYsY7zKMhjDQkvr/EHQvLLH/apogXGF/8ljk8vOX0cZge9yEeeik2oEh0JCIDD/kJd/Pz5q6JhbnOPQmJBqaSy5idWGhqPG8fACOZBxIUifckan3KYmyL3eMH6OyByXG5ZzfVwYHOx92RhA+gxAU8ihN9rCSDd4DFNHrMkQjkHnerwJeo0VJGYBE0p/zmRx2/nt+6BA3TWEbmj6TYpvCx8rzlCZ4yurM2HXNTc/mxRlmFL/URP9iaghWBnZc2cwlgC5y5UHAvB/jWRLBt+R3tlwGXEtB2GdATuGqfY3ts7lbAEuYmfDjBIVW2Cwn3gjlG+VPRuqA7jSssCvZcJL6dKcGeQnhV7fPwYWVVhI8h6AIk2qogpBo/JuQJnIhEbkXyQKp9kZ92oL0qJncsetjUX8mIMWh786PdsqLXGR+TDLbhg60o/emwScnpCG9WhFMDZtkW41eTytOmjpIMrvIiAXoCN+F8HsKpPCUVFtpS9M8JXpByETsdScH2qUArIY4lmKXIGwE7IiOYF/klA0/ifK9NR62sBlOFsL+AGxEJMK5IliWwe3Is4eANmlBGYEb1VqAi6nZc4Y4VW5EjfC9yI5Jhr+2Ip5cPICAUNX9OQEXIS0pSgniE+tFcxaCbxAHfKK1IFpH65fQN6LDKTxIrUhwS2DL2+NsC7LWW5g0W6MBrKsHqd1wgFM8QTBW9um9ev4cwqxaDOH3xFRd7vSQpYSXKHaCrPSkOzeBAI2NHGxOMRceOFiYW8wqPVibWEiU7Ck14d6RsbpNU+9NhU+Im3kyBIcbdVAIhS3aXz09s0sSFc8tRcCBX140lgG2TmTc4sk/P95aqfEdvTMHb/b47VVHwWp63URHGBAmS7HPjripC2EWLhwNkT0jVtzQhmD81YJjm9dtyISfESwmAceF7EFVBYGttI9LTFNQnfHuYzu9h/JggFwv4aqKi/kPgMdZKMIKvk7jcU6UCBdbjO75s1gfN/UUgIyw2Kt/pr0BPNG51V5i7iEeWLQKJKbBaKRBbVAxe7ZdjHoDmU9NHJySkDt9UPCWwt9qUH0+fDhvazWAAZb7le2IXjGFvY5f7LZ0y+ASA+G06OiJDjknqQwDwpGXKZfeUJfXjVs0rXuBuVqhg4WD03iriKMRZ2uO+j6cM8+OVeFBPXmRnkl9+OWVVeDyCMRC41qmDP4JZ8U4rQa93/ozOCY5E5LpSumXdsYkl2AEsyNaVINjN2GAqgUoSFfC+o7eRYGi472DWouAnN0SRylOWheARpGiAimRlktI9AHgCIDMFCJaIb4M9hiHsRD/ld3EeCRIW3fckgM0Bdy7jUG9enl0SIo8z+Dyi7WMSwuFV/HAlI2x7bSEHYipIcIKzcrmn12NyAAFTN5QAtihdUxkH6u+FyAv2iOIm1yOoBPh2yWP2CJazGcGPeZqSF2ugjdlWQE8H/IEof6HAENcFf66g4E04MSpO5Ef4FKwpErp64EdYTyR5BoeVx53cnvZhVYZH2D3GVzF4f075zYGnPSFpRONvgr0ecNQhAivGDcunBIyO4vEjsKfkITmSOAnH4MYID5n6lGRx/lQHBwbb0VVwh/A5OSQ/Q1A/1qBJhkM9BVoQ7N03P/ZlJOhnyE9unvIMP1ZdcUwRY2FkvVWogeoDFbhdeA2OG7LDtmV9vrn7dLRdgOQ7yk/HbQ6fU/v8OOSpIHAMYpefEjzDl0in/IzoGbmkNV+26RatxCM6vYyj/LBJMsRzz+cupC/hPgfljx+tv4RZTOASBzKiuUvKvL3Atf6Unx6+EDAAg8cNvxdiGSdNh73kyOkxv4L9kp8qJLrtfKFALCqBOdeVxTjP0hf+wLt7xVxXCxJVX9wR+7ngj916x+fmifrLMf1d7KjvkzgmmXj4HXqvtnmd9vgschxv8+hUsu2uMKIxiEdACvvtlRUpPFU5Lwn7vSmK25aD3ojapKTNnrnnRU3mlOjtr1nAqG0Ykdfmt0OSvlyu86xyruvYS/+DM3NuijCLyysGYa/0X2Z5cQjT+stTXYqp616VRXR5KtIvk8n3T2RDU8vv68d7srgcT113QvLqoh/sv/ttQh+Nu3CoHIbVl2/IYUPimMTMb7t6OZJvLkZ2Lk/5duu3DNifvYhUmk6SqpIoquJEepWufNz9ln5vgly1HMrH3TfseetNj17p24frgpDze7Ag7MXQzi7UcOf2ocymfyfqVH16UaYZ0I0yWXc/Fq9aM39gp63wTivzNInHq44uU1DndljLpH93qTR9OqulGNBVLVF3R9Hnxgv6n7KzWzi8fNV64+1Xv/4/RWX5/SGsSJGEaUzo/MZi6E2isvz1//TrP/74/e+cv2uS71jyH+rk/OD87ntkbHzDKZyaxGE034hu/WYy+b6uNJDzuGkR1sePP/gTdzKdf3NxBnE9QP6q5dE5VPrxZgPlvwPYyiOoPyuM0yBGVQXy0cZbP17l407w+i0AbDTKN9qoVGRdFn9ZIbxNDjFff4zo7+OSVPBL/ZS2oXLUf/zp8fl7z8GGWfsufvNsfv3ofsF2k6mdw6wQ4Hn9K9oED0lVB6csD3le7Rk+q5IwTcKSxFfjQ/7zOC+fdcyuCF/Y6yOshs1e3BgJ77qu/Rx14DgjFXxLZe26QUuQnxCmUxUzDlNSwMiZhgSt/7Xrzk0c9TmD9z/X9aaajI/CIh7HpArBfaP17M7XCeDnpdZ1lFoFiezZrevjMgWaZ49YS1xrWDiU/Hp+c6MBweAoa9fVcTw4Agi+1cCIw+vade905CmtkiPSAPcIGOU/uwswElt3rzEieBt0XfvtyhSWIe95Ghbc+VnXbowKDi+xp1cTLaguSwU55I8wT12YMPfmtevNDWhFoyVDRZgHLgIeP4ZFEoI6YB7ojQa7Rq3rB6AUIHKas66fomDQ+MdTCYqRJ8QoKai4xWwdR+Ccb1os3RrMYdnwblsYcuq/dr07DURdZ+FodOvZ3VQHYyPqXgdW4QOBwbO7mQIGlYInBCUNiwOE8F0ZgbyqvF4s7xQYqi19T8GlsAfDerG8V3A2peL7ChRpOj9QUJh4+VMFVmZ5Dm35redShTcnuOVE+2OznT+XEdhc5y8UFD69+EsAaFE6szs1/5hESAS09fzmVoHmURXuYPHwVyYSZrqYq/lb1KMvxDhNx0kWpacSjBu7nt+0/XLchyCvGxmxIVUSQRtwa9dv61wlzMEDbETRPex1U5DRnQKpn3FDIt+uXV8M/cPmlMIPoq9dX0ju4Zgm2wRWaa2qzqJ9DkOERGZxkUOX+df1c9cSZhyTzWk33hQJGIlwXYevkwn2BDosXS+Waw1YuxqCPIVoZszOB3vfUzE/ghPYjWjhLMEeTlnP7oQ4HRHNE8xkxHibwN4jazeYK8AkB3VPsFBQPIodjRQMz8M+gs8PB/AxlvU8CDCSrCogd9/1PJjCJPkRabh5MIMpyj0YdXw915qnDsFygJtc6RT0WtnaDVYSMEEt3bknVa/IH0FNsFi5LQjuOqFTsPdB1m4g1ElRsHd9NnTRhztGr93gDqSo8vG2yOGlVnCvkZQkiy1e2ms3WEMUVc6IIIKp2xKw2GKg8px6CmqTV1V+QK4Cr92pD6GRK8Hr2oushUf07bNjCc4H3gyEosWewnD4DHi9WHkwHqnoYuXDeKyqixVcVfAceb1YqcVHSu1OZwZsvMlTUEP7dzAWMVcW/j2Ot0zxC3+N0OEGz3RhJbEZFNMlQronz9SysdKuINpncKYBKhUXlgsFa3d6bSWxFuzGJK32CSLGcwWMPeC1nnsQEJWfWwiMSs8MQqOyAxYEl5wFBEfkRh0OqJq6M2Co3AQujMXkJvBwvE1uAh+hswyxtZXENsRmWK16yM0MrCEoN7dANh1yM/OtJNaCBSYpLjeqfkPnqNnUxOGDJUDA6GiZWgisw2WGEVqadm6nsbbtAqPtM2KWIDE8ZDwA2zVmVnYaa9muAVp01MzUZqd3yjAlM7sxoPggU9UtqNzc2Z0GwofhHEKiY3CBoa0DcAlSWTppbSGw9dDcBQl7jLu5Z1LCg87XgR0jbm4jsBYp0AnxsSY0VEmvxsMbsnWohhpVkSKBHo5bz29FrjAPMaqrHN7hEUOkove+4CfY1267EUQX//AjmGu3ZXaq8vE2AXfL5ksZhHlpr935SsIVJIPuW67duRDyR+b1DK7u51xgN+EG3GWd30qA8ea0272AW4r3AhY9bE7FDsnuToKx+9ggSmZ2OuI+iWt3vhbQLIZfZFvPF9MWBPbOwhUAJKLVun4tVsKMyygEFygLvwWm8HnWImghBQGNUanMJUEqVj//34DAoBvrOvB0CxlvE9j4XSxU3ClDkUuBrCpSwBVcqZix54Kwaw3mw7AbDRbAsFsNNoVhdxpsBsPuNdgchq012AKELV0NtoRhngZbwTBfg6Hb9MtAQ0Z75im4A8FTBDz2XLgcM4wA7sjlHMPDPbpcYHi4a5dLDA933nKF4ZHuucbwSD/pAxc9il7qYzcju7BCduOW+gi2zL1LfRhjp0dLfSDXjqNwCVb6cD5lDxmyYSO2mTYkhM9CxcbShoRgaPv1vC0dCSuw/GLJvCHw3CPWyRuyx04mVmJAwGeF7upaAmDndasbBYR3zupWBmI9s7qTUQUivKt7HWXNeS2jy5QQcIK5Fv1MKvBA6lp0brKBrcZr0bnJAwwQKgqp2fW0BeQRPRoBm+laKCP4DOZ63qbvw5+RLeVrMYySanOKkFn1WowlFhPpQEq4dVYybEMKeLa8FoMqJdgxzLUYU2mSxXADiNHE7glij7Gt3eu7FrhDLLVrMZzSE6nyvAIl93qto7DXw9fujWtgozzLSAS28I1noBFpu/ENpMUDxL0JDDi7GL2F2+Fm2sJhwEwCsCdzYN+Qm7mMw+qykEFFGCfw8e6NGH/5YQPpy1ZB54cNktv8VtQth7XEjRi/yLLm5loCWByhVgrM4hBxcwMhLc457s2tTAE/c7N2b+50FAuYbmE8u7s2SGzFuJfRiCqf3SnVK0l0Apc1i5XSYKfMglRKaSvgWgLCIQfW7q2rgXBvmFtPhyKvL6zdW1+HWmam20AHY/Pi7QxCWnlPdQrcA+123mIP8BbK7E4ae1VZFSG4/JTKWdSxF0CZvl2osPogEUQuVWSUp7B/xO1KBe7zIvk5zypYqdxeq+gkKxN4LXx7oyKx7cDbWxWXIXrm9k7F5acKzfpehaLbi7drFVh7TAPAO1cFgjG11u6dp8Ko8yvmfXPXDvincZWAh7vtgjt/Ap0XPBmAmEZ3rbCAO0p37YB/HkcnOG7E2r2bSbByH8ZQZLj1XGyaos4zc2/ZQsgWjlm1du/mOgrXM3cLA0uP5LC9sTujAJaNtHZeYsMoo17jHgi8NoE+CLwxgQEIvDWBUxB4ZwJnIPDeBM5B4NoELiDgvWsC6ZYjiBXiUeSw1roXQgE/P7J278VoPm1hc2wu3GY3J1Ag7sWAP6UpqZJsjLymuXbvZxJ0nxeg3XA/l0AleYF9EUUXnYqyGh/gHcRZ20HwfHa/aNPHIdUupzSsSAw/GrxerG4QPOZhs2jXmyIaxDgmEbzmXrTrTvqaQkQfVAZh9zIMVtsLsd6Mwgh8vGDt3i8FBF4o3q8kwDgNX+BI7mv3/loGWmyi+xsBTFnjwdPo/W0LI1kM2/f3dxqILvrg7eb7ex2Kar/7tQGFw8Ss3bWrQ22G9trD0Hhh1r5Og5lm60BH4g9BrOcroywFHMZ0PV8sDShqwa2nOpbeWQKRMwOZx7DX+XreQtPxIYStlPVCQREwBt3aXS8VWFKW8IBer2RcQSKSPCLIaxlZHtMErnA78A9RfdkdQt3qKMzOWN9BSGyVu7430BiyHf4H8C2utee6CqTWweAo81UgybBtQM/1VCimUD3XB4AWjeO5gUqwy4/QW3vrxbVWraSAQlyuPXeq4pivNwuuTuDX8taLaw8hOYRwLPL14tpHSI503oEdT68DhAZ+Sn69uNaqgt0NUccEK8ILNul67kxFF/D2jOfOTZy1IxcqHr3p47lLFYmdxXpuK+VZBJ4KLgIJAr4zvp7d+xLkJYLv+HhuqyeyLNyAY2tx3bYdXOBWixSW00DPvZVwzZ4fqL48905F0kUWHAR2vbieS1hSVmEFwxYSbE+jHCPOwYvrpYQ8JvToGYStJBhm71xfy6BjXlRwC8st+ATGXFx7bqsuaQhm0BvxWmriGPa4X8oIyy7JPFgpSHr/yn6aMA+uFQrLVbpbqdOKHJasVr7BM03PcyUAtrc5v1FAaNk9z5OBiEHjea1UlczNvD4bg4PxrT2v1X1wn3pTCYAcr3reTAZZrDnPm8vIUwkKl9dKQgmXeikB7ILqrWQo3AeLpQxCCt6OHLhIohsjKG7meiHOMiKS0F0S/Fqe8K6JSJoiDxOuPe9WR1kuF3nenYHehPAuqOfdG9gYNuU9b21AE7TEvmuA4ViTa8/3QCjSf57vG3DLyYonrilGpKiSbRLBGtkT9xRZ5DV8ZemJW4j0mL0ahwXYrv5cQ5GQ2r1IYy0U8Aae2PyljqrfTQBlYTFfqejTZoNMzYECjPPsBBtC9ybOolQX12sFvwuzClS+81sFt0/KKkfuN3q+Wim0Pa8NmLWpALilYjeuAj8mcBluFFQZMUvkmOYV9ZfcJOCac36nEFGjDJvd5m13wAtjz7+VEcgGv+ffKSjU0XJ27wJA22XYe08moCsvbO/B8+9BKF4az1/bKGxTa+DClDYSoyb0mAaegwIfxlrqEgRWEmvJpgipjWZm0PBNFrSDgrmdBq/dPLjpRWq15G47WNhqu0BpLZ247CCy1feuH621wvddPGw1XsnEpMD2mr3WQN6TAz01Gj+REHxpZO3JvfhY5JnYqIX9q7zgFsYjh3JecAfjsZM0L7iHCcDlkResDTRc7qmrAbECTz0NiJZ06mtIuIjTVgskaTLew9sRN56COpA4AYMlLG58FZjAFxRupFzBc+LWFt6f4FgnnrjiiQrFYq5CbNcZhAt8lJRRPn4iGyj659qbtrgKXOCL+3g0OCUqBNOFjrIEXvKmSxONeLl605WJtV0i9abXCAE6Bm8MAtQamN4aWPitrfX81mwSi7qZ3hloZL26mLkGFNn89qb3EvQI96+QaeTwwZPyq2PUwz01u71XcPDJljfzFNQWWa/OfAUGGnreLFBA+QlmNVVQZYXsPYi7UEiU5rU3m8sIZMPcmy10lK3jZ0sFjds3sxUAtHK+VgjwyFHe7EZB4lFUvNkthLSW4k6lwKfrW6UlWHwJ5FTJm0lD7QQPs7WMGG+KMCKgNN1MFSAq+XNXxaH9NPcUoMWhwZv7CjTfbm0tOVdLakMGChJ5nX7tzWcmzsp3ruDhd8DW80Bte/hBrPXiRs0ddeXwxAUrdjeHxoMANdl8KcPQHvfmKx33QCoYeS0jyU8n8DTEm9/IsF1BwvrRJXA715vfYuhxXlgyuZPJUuqvgeZwD0Lt7NcyTZZXOHThglDbicrCk0mOYUGyak9KuH8Wvgym7wCCDlLeIpBxyCjzFlMdhcr4fCVzhL1NPXEvK8q3WwKKyWKuQKw6b6VCq3y8y0GerQzAc2F7ep4nGXhKsGpbIk1D+Ay+NdzzNC9wP3Nv0Rb8gFyf9BbXKsZmEi5uYKxNHS1udRrMiFzcQUgr73uNAp0YFmsIaeO9dDWKHrEjvaWnEdmwvobtcJ71loFOUOQ0zioiecspCrfmMtPIuOOIlWiuEdVOIVaShUaCmcfLJQC0cpZG/RF8bWDtLa9lTAkqpeWNirHm2Y7yrMxhm2N5p2KwPd3FTduaWRVG1fgQJqCSX96rQFCj3F5LIDb0c3iRsVzrQNhUX7kaLj7VUbLAWre+RQ36GJYI0teQZfgIAwMAiG36SgpVAttClLZHXnlGn9mDG2GmgbCFxmquA3GrdNXKRP4A77WvWmk4vmD7MLP7NlPQrXJx0+Z0QtxUvFUrR09guhhY1DxCFgHt3FmQOKljJ4PMbkyc9cR1dQsQIB4r91MAi6zc54sZAEbumnvCRTMqQK91b3UvAcbbAjZGVmsZRaO9llF4BKHXrgyljg1FCPqYedeejCxyxEFjvprJOOyFlrXX+iAVeVnSo0NQbV4HBmy8O8LQtl+QfaLWAwZ8YGbttU4op411vrleyMAS2fi4XsqoU7bN0xjeN2+9UOA9ztYB5XREx+VMwjyFiBy2PiqnoiBZ9DLeVOBB7PWtDoz2oF/AzdIAZi8gcKUDCXgPcXFzbQBPcGXudOBuA7ffvQ5MMpjjWgf+eIQrY7TjQ/EEAo12zHbg6LxxdWBx2oBATwfCjy6tFzdG+1SwT9eNbwCf4G1voyER95SbAABi7j0LaWSUeTGOyTY8wb44N1MQahPWm5lKgngYezdzFcdeHICl6GahQhFzcyYuAcRhFW7g+zPezVIDoVcvvZuVDsVcnIQM1QFyy4ocx0kG3vbwbm5MLLKzenMLQB+RRroT2AiPvHyjgaxHHG3uUXIIU/ovdRCBm/VeAyeZBbwW4JTAFuWtq0AsJ5lLX0UiD4iv57c3KpAC4Mac3S9VaPkEX/Kf3a9UoGWPRlz6jEkKBgTwbtualA+oz9JtIKEqGkQqpQGfIlCBrDXsIQSnn9uphqvyJ3iYiQuZ+CMannBUjMkjSfMj82iHjcf5SsLSlkPODsRhD73VfQS3MefC/SxOwkMOGwliDzxOIgLeV/Nur2WID0JuZEgAQm5lyBSE3MmQGQi5lyFzELKWITEYLGgxV/jEYISZ2b1S8XgKYpSax3MQo1Q9XoIYpe6WxcJiLiHZo4ngnDr3WtgRdyhftGolKQjjB47gOxNG1QW4qSmO2GN6EgyP8nnbSWDAtdn9vQTA99nupLx+gqeiO1+B2KZqcQM2TuiLh/Aq8G6qgbDVsrgIG2chqKdFbkibC02Qs03vOCmrUwFHaFiAUMzgEQvvOE/BSG1zYZHF+SGE93/v2jwz+KmdRavh86q0Xxj37pYw1uKdcOvLJJY71N7dCkLaWIu+yU+bEL5o1vLED9zurjWQ5VR0Li66xUUIboPe3UiArva8lbHWxrlrkU/IBuzdvYpBx7yQ6yLZIK6r3r2rgTBuwhUxLpC5X1xQodEjMS5ts56OcBOITZX4BO+5i9VtfDpskHhOntipIvR2CAmRc6SFMC5IWIBLUO9+ISPQoJu3OgoNznKnIGGUJ4w8Al/I94RxR36EI+14YsokKTnuEU9cYTKRlDyy3TZqJGNK+f7GQBcJbEbf3ypQ8AKuJ2Zagm1F39/LCHTymd+qOCRcjHe/NmBW/1sNjk9Xa1dBonFdvLWnAC1xaiSWyMQQCEBeJRE8Ra59DTSOEK/8tc5uHMMPpMxX1ybyEe6/9VSHkucogS+fzFc3OngfIp5L65kOzcipKmCVsp7r4GMOb6uuFzqyhCeT9VIHVnm2O8FdLkQ122HD51qBWEeaaCTsjH59KyMwhS6u9pIiLOH11PpegdjufwkPTUIfdsTkXVz7JdWeFBkYg813XQ00jpCrgr7rgVBEqfriVi+pyhcQIETgccye1YZf4Wll+JFk1aZI4BuKYqlJV/JZXsFVEALyHKUh+vKPL+67kuekGlc5ffYFxIlMn+kmPggRg/wFLtKyTUebcqVibH4Od66MxXFitUheCLUhYO8D3702YJaR6YvLrFs4KvF8GkgAepq9TeDNnOlUAiKR03z3VgMh8ue7dzrwQMqSZDuk1vctvMoLePiuBQYcQ+KCJXW8tDzy43seALR2cUsAVlZcs9ySkIoqyKPFwPEUfXH7Eukh35tKAIvHySK4loHsqhDIb6bCYC8Q35srMMQt0fcWMiwmaXJAZkLfWyrQPDohfja+t4KQ2KDzlHqTZ/g6uu/dGDCU460KxZSOdyfjtkkG1/teRu2TOCbwC1nySBgnB9ijyffWKgwrnO/KOMQX2vc9GWXZF/J9X0XCO6C+r9QCFy/fV8b1MQZVsj/TQFiX+cpwRT3Nldoe6Z4r9kKz7y9gLFoCZXgfC1KSrJ5uUQplmOOB4+cLpSuR81jfV+Sggo/P51OlRR+TmOQgM0VanuBtNt+/1VFoXRVReT6AIupLknIAAdLYP1BHStBcCFwdhc34gTQe4LMwP/AViG1EB4EKRaP9+MEUQlp5z1QKi3EQtKKQxeOCHFPESLhry0tnaMyr2g9aSSjgwi0lwBYeAEE72MEoA347faXg7pQf3EgAfosNnmqCWxlqa9Y7AGht3HuZoKK4Hawvg7WMtLAULqrbFA7A4E89GYGFtfOnvgJDxvw0UFEWA2iqIAsSI9b1fHEtI7GoBv50asDQUs4kKBjgayXX4wE9hXRXUxNnjTau5GxFzltkVYHaY9pCkqPlsUd/utCAWBgnX9w126Y5vJHgiytm9CoXrNbEpTLmtQNDbhSIxfT0p7cq1LJl7U/vVOwuz3fUykOe6fWn9yoeNYymaxWImTwz18Rhm2v+zFPBmEaf+RrOYknNAhiLV202RSgsqm02U2mQGi7mWrPZWM5VKGJm+bOFisMnwtlSRSKP1c9X2gBD79b44lbZNs+qcfhEyhx54baVgBzmJAPQx4n92Y0Mi4o8KUtsY3ohQ+n5Jh324NX62dqXsYhT3DqQQZY5Zta2X15tYPdff3angcbhiQaKThN46T271/F7kh7gDbBZO8qKhxR+n3hx246G4hDSSw3JLsNjSy9mPoRHg8n5cxfC/3gqq2QLKtK5BxEg9279OVicQxLD0c8WswDCY47K/hyEwxGgF7OpBs6yvFmNYM6807lKgjxe58811lEKh6nz5zMNyK7gIE81+fM5hEZcz+arOxW9TfOwsnX9AsKjPbmE0EhEcH++gtB4P15rcHiin99pzUefdicF6EPjz29ArA9ib0FsAGLvQOwUxN6D2BmIXYNYyL3FX7gg1uIc5y88kAQ7XfAXPoi3uNT5iwAkOeYneHW+0KRG8kDoUFtz776DFBnGc2/dQYiN0bmvtXmSxez2iq3NZyCJtQ01kU+qMIV3lRaa+Naxm45hBB+B+4slhEei6s9uIfQTwW6M3K50eFk1Mbzh5ejCRjCuXo5w61wDVGzZC+9tzQB4djpsCHj3wF9oKoM9MbIpSAj6jtzNdTSyWbC41YEFdXw9ggvbhaZejklKrzeABdBGwE+nvCJoZAN/cQ/BMRt4ptWuvgACP5Q2v9Z6s6wd1sBZSitFmfwMl1YTVLpp9UCqfZGfdmDDLV0Lgc0QXGqasTxtyqhIjjDY18FHUljgmkpE5m5/OTVxwu9vnNLVLziHL2c2uoLSgdp9qfVulcABHmdrrWCnLCYFthhaagNSeH11WK1z37MS4vOA71sJYUtw7mu98lSE1FcerdYSgKMXnfzlCoBXmOmzvIbQ+RFvLX8pqakTjJA0Dnb4t2wVzamw1EbIK/ZoiL9cKxBmzsJaf+VCSEtVVx5IAPerL66Abk9w/OV2mqLPQUQFgXc4VoEBY+fxIFYIyCnDlJ4vNtB24YHAruP+aq5ibCpLOJ/u6FQC71QuFQim5+fCT3MXluPoJU2QF+zm04UExL0m/FWbMewKsWpzPMEDSjge7cJH+ORSuBDtCC3veEsOIbwXsbpVkSjuzsTZ2N6rcPYG3Q57/s8XNzR3hLoJw34k1yqGx2mrwGepFsGNBi+fkiMaZm4R3IJwxE5eBHcgHLOOF8E9iIdDcAdaa2BVnLo67invaJWpB5BgWK4udvscvKbti9uyO3h7xheXZHewdhCXXndJtT9tsKPA60CFoV7S/vVURWLeLsILekdv5oy36QkWxuuZgjuAz/L413MFRR92hyX3eqEAq1MKn0deL2VcnIN+ZP71SkaBkWR8cVF2h/iW+uIC7C6D9z19cfN1l2dxnsJXB0RDsc15kM2dAhmHWZi+VEkErk1ubzSw5cH8xe2tCqaXyrHrTP71vQbeF1i91xqSDTuwmW9cEMqiapyypEoQMg8mI88VyeCr0f6ND9PsihxUJ/5NoBHkWVXkaQqr4psphsYO3m7UrsfPZW7mKhBzNvdvFiqQjW8QuFSBD/AdwPmdNpoOIXhlfrbWqpKRsAC9jv2blYqka2G4iNcacJ9XeQkW8lZHvpRNMNUNyFmrFH3JAMTpfJEzmJs7E4dp5BtNiNj8niIWzY0mR08h3ceAkOLu144+Dgv7es1bY4FtE42PJItA1TYXB5+7Agxw7996EmCchvBDRovZQoYhYiDu/qCCKK7w7E5JFdLj0ZweJIDXQheuiiUpiaoCxPq3UxVLH/cAcTMTZzu8E567exofrsjIEzhuhVvWPjxsTsUOeeK8RWVxfZWc3tpB97zFydc+xJxBb5cShD6qCYJWAlTEyN0+X9iWdE+WXeuFed0YMExAbm9NKPKOxO2dCbVE0PEVfIkI0r2Kwd5i8G/XGhAZ3cJ7md4iArO882QE1i53vo6yjcC7QEUX+QO8JL2bKsB9mIK3ju7uDRhbxILYOxNrc31ZK3Ckr2dLFWWp+kxBHk9pCe9ACSElKah17mQA0i2L5bUMwu3ru4WJs7WKP5PxtpuGbQc+I5FX/bulirG5TMzvXQ1sa2uhI5LdfhyTbUJNNrAQi1aykt3+CZxxZ+L+DHuDAvbiFiuQfZ7mKQEv3PrCbtkjJurdrQToMJLXCvRU5eg1i8WdK2PxS6l3now7hMc6qjwYsGG9VLB5TAq4l+9knGVsXSuFtGwHtVIP31aY33sSALuxKC527vMj3FltA5fHBL4E69+7Gmi8OSVpjBxK3Xs6Gm1fXypfBe8KiWun+/z0888gYiojMB0urp7uTwfYz+N+LiNY5HjQ/rpfKDh0P2k+XSnAXUFIBbfZfHqtQKm5USK7PvPpjYkFbbf59FZBouW8M2CWXbL7pYI+FmSXIdcgW+k9Hdj95BPyQt5CeGuivmH3KxkxDtMNvE1+f63iChKCsBsDhp+y3d8qYHxGv78DgDYpv79XCHD/7Ps1AKTvf0QP46c9fIvNX7sQUX3yMt7mEbykWntdVPSlDNAwW/sQaVyEB7AT1gEE3xbhATZl11MIv4/BFlvPIDDm3LKeQ+gqSatxuUc2CtcLiOYxySpkDK+XCoHNeXLdDnfs5ou4eJpkyM325VxG2N9f8Nc3ABjUK4GYQZMsyndZAvppz9oqZMw2AS9CC/WeZPVhGXICsb41cTbraH0H4G2XYIUgZmWFxbny1wrqGCLXHMVdWRZ6LCMVvVyV5gWIDsSl2YS9rVl7hJUgUrR7dYJXe3Pha/IjIccMjCcSiLu0Pybg+72BuEv7Y7nF3PoCcfv1xxL2ChB3lR7IDmQwE+kVdlJ9LSBwTdosaGRDZAc7cBcSDAvLFrhLCYXsNwXuSgKhDr+BKxXcMuwCccP1gbxgm7+Be6uBmHs9dQuC877T4RG8cxi49wYSmQIDd61DYYsz8FwdWJDqBFrNgefp2CrcgEBfB1raVFwwfEiiB/ZqCPzmsrim8ZDHCciIA9JwAxqngTeTERZ9FIh7pml42MTgaYS4iZeGB9Ccu2nLAzdnyyDjT4WCuKWEY9GlcOhKgh5Jhtj8gbicmobZ7kQnN/CFiYVnwMCr8POFbwDLfViAs5F3Y2LLAETe6kgwaP/izsh9Xx3SGcjyTof+GD6GqPNQ4N3r+OMertXaAL5Ue1DTBr4LYzHXpMA3+gGUkLtAh5VPsC00v5/qUOrvh7bD/L6VnCPs+RH4vgJpzryQy/uBH6hoOCJh4E9VGLZunquwpySLc3AnOfDbmpTV9gBCWm6nDAwgF0jC/xhiGmBx15b+BfZ3DPylAsHUtLiUm5Iwtp1GrFsczEhIPkHnBP9GxsBD+FaG4HrUv5Nw2NXYxd1MQjVTBVq4ewgLF3INQfHCihu6abIp4ACDQeCpmDEd4GDPBr6GxC6nB0GgITFrRlzQZZcUN6cUnH2DmY6C22d+vwCAtt2w+6VJYGlOIUbwZaIgWEgArL+DpQyy2BHBCgCiXK8lMInBWH9BcKOBkM2qQDj2pEl2ghFCDvIQmZQX7WwLn6MEwb0EwLzJgmCto2ydNHUVtAUol258ZA8Ew6NqpQDhoTxb38iogsBnTIvWsMgjeE9ycTdXINYoouJoIc13cJ9PfRlhDRp22yLhwM3BNFAgVm7tAEH0ibB/Uzii1lxspaX56Qj3odAMpwM4TMVlq0O4g2NGBdOFAkFU73QpoeBrbMF0pWLwpd70GkJaNdWNRoGp1CkEtHK+FQRJOi5OIFMZg2z2iL3HA+gZF0yldHxHPpjeGzD8LCWYrk001urzmQuAceEKIDyifmceAMVDkN8tTTh2XxBiTR094O4XdzUPYYFoBHEfkjJDduOC2VQCwc4Jclasl5AYR4G4MnMIiyrJwLXurBXUihRJmI5PMK6V1mpveSopmLVNXBUJOGL9FvIcxXAzCJEmMXMr2oBBM+b39xIOPBgIxD3nAznAx5uBuON8IBkoh7NbCTBG++5OR9nk/2YmoxHf3WB2L6MwB5RgtpZh8O7t3FUx1sKJYUHKEt7VDuaeikEjfQZzX0NabpPNrz0EjK3ZrnXuNMguWIxAA2KHTsF8qiEt9sx8pmFRhaJnb320LZhrXcBC3YDDd76AkOgmwHypwW0vYN2vEbBtU33takRoUVYA0NrY1xoBFuEpmLcyXZEcvnDU5l4VOeJQvLgzUOMK9llbCDeJQxIV+Rh8W2VxdyODsGcZgvmtAcMmwfkdALW04b0JtwyBYL428Zbl08JF4VgFFp5MUkY5aHXOZ1MFlsMaU1j6hyQjUREiqKBFwTP6YiojsBXbYqajbGp17Slo3L5azAGgrVMXC4UAd14KxN3hQ/KMxT2c++1IfkZWS2J85lkCx44NFisVY7X6xH3gAxwmLhA3eg95lReb5AFGteU6wUcaizsZgY7JewVlG/BrCInxFVdbWUi0giB3ZuezmYmz6dzWkMsfwfcCg6UnI8bUTx7sWNE8dWclEXY4Gyx9EIrJirhAeziV+yKHg57c3Wsgqz2/bsGwa/JsJSPQkk11lE3WljMFjUvxcq4AkXjCwXJhwMab9ESqPAevHMzW9xYCNJjO2iRiMQDAfenl0kQjjqrBcmVisdF/bUJ/OmFHdcHyxoSXyXNFSIYU+9YkeNrnSPfcKWDMC5YXOQuR237B8l6BjI8kR5ScuF6bhY/JDhUscbc2I2ExPsBRHe4EhiBH9eLGbUZK9uhvhRz3rXwZWO1JccjptVAQGwhstU3BFd5C7A/ZnGjbPJ8wuRQXcqk7P+Z2EYgbudkW3BYXt3GzbYTcHwxWCwljUfbiRmyWZD+G4E5j0CIqksW55fkg4WCZ5TH5ETRDxA1bTHusriWA1cv4ZgEhrcvApUxhUYmrGxmI7ZGtbnWUleedjMaWEOLybpZXyZbPQCxoE/IOcCDu5GRHMIKB8AnLTg+wM6/oYtgdfyFcYbPTgcAXYYJrV8WMXUwKrj0I2acDg2sfJLVRBBqFhxZrCiH7FWsGktoo5hqFjxZrASH7FWsJktooVhpFgBbrGkL2K9YNSGqjuNUopmix7iBkv2Ldg6Q2irVGMcOKdeNCyF7FuvFAUhuFLiRztFgBhOxXrClIaqPQhWSBFmsOIfsVawGS2ih0IVmixVpByH7FugZJbRS6kKzQYt1CyH7FugNJbRT3BoXlqmpws0bhvQp46+L0NrJWZuANvXaUVAV22ygQl0pzOMqpWMDnUYVcmwpuAxVjLfVUxsKuu/P1UgPZ1pNizyePs/yBXuTOkgfwPERcT8232wT2xxQ3UnPQxSYQl0bzJB1X5HAkBbrCELdH8wPZgb674uponhHsUnsgro7mGUHsyYU4us1p/DnQe3smLowyr4AkQ162CW5vNFxGwJ15hR94+zkQy52cthOI4KJGN4egYewKB8i8yELkdY7gdq2BbMsBcfcsfwL7WHgUHsPoATlsuAtUDHq+dDfVgIiX/kyD2co/h7G1QzDYEcL14VhffijAcCtz15VhaYigPBX1kp+q8SaPwTsD6xUA3uY5Ek9sfQ3A6ziWIPwGgJdJTDZhgYagXN9aiNDwk8JH4ximpIKXdXdLFTMO48cwi5A+aVsmA52Mg7trCZHDAnTXtkCWI3d3grtbDcRePIAD5wd3dzrY+upmcHev4y0vbwZ3ax39lMRkjD1ZENy3Q/JIinF0qqzs7z0ZHsHRb4L7VsKLB+Qo776V8LJErvUsJEbIxvX9VEZY9h3vZwDQNpPezxUCy/x8v4CQVt7tQAZ1/307dp+wI17hfYC4qgmPJdShNbi/USCYAXZ/a8CslbtT4fiz8ut7CGk1RmYqBebNd69xRrZexUnukWRY987XOshWwFbFkyJCZtO1hEnyOKEbcBsWqccHhSCQ4NhB4EK4jh33YXEII/BUXJy1oUesa19GWLfcg3WgYPE38ueuyhWP2hispwqSXmOCZ/T1TAEm2bgKU3AsrOcaMsoPiFJaLxQoHV3w5LJeakCY3UpB4c5gSw1XInbG+loB5qdql2NVuVGgTDXBPG9VIOapodbFdji+vlOgjzk8S7TSD64mWrFLEMU0bYUtCbMcXF6JyiU7kIMQCDhE/bQduUmagku4hcQjA1kEbTqiiKbiAuExydCHvKbuTEehLTNvkRUpCGhpTt2FjkL5iRGawOqnnYdZkEZxkQocc4t2Mk7KCnzGdyE1/M8/h2CZxIhMQzoi9wkBgxTMxcYJEmBr6sqAjult6t7IYHRym7q3Js7K907GYwbP1L1XYPX5FYnHHAORrCWSNqQ4uIhd6ND/H3t/0yRJkiWIYdvYvaBJIYAV4YHkxcO24GPWoW7p5m7+ER5p6ZsZmdlV3ZWVOZXZ1dMTFUixcFePsE5zMy8z8/ioCKfMzgoJnigkCBEuIASWS4gA2AulZRcDjszuzohw7pSoP4ADDzzhyBuFK5T39MPUzNTMPbKyK2uInZ7KcFN9+q369On7rENartMtg9ZNrzRtVCBr6uyVIWvNSl2nr8DW+2t1peXiKqRa1csn+T6q6dVAAag7Ic6wBNRIJfRV4Po95IyqcLvwvFxnrCnYBK/OQYOE0nUeq4BxTW1PCkAN8yDXBm5a7eaVdoqr+B2tCWPjSsvDVRz6SazllrjS3HAV19TyXAGoW2dpX7iKw+ul9tnsSpPClT5G+TBHz/FqFmtNPVxp8reK9cotj/N8UEnSwsiFqIlR4fb6KkTtoPN1uqwZ8kCFgIXX6iY/ditgtSqsxRobCA63NyyC1m6m3kgD2KFr7R2lq7Sz1io5PtPWqw9Imr/9Em4TWuPDKb/tlcCi2lGNNYC12FhqeGLwx5rVfFyE6fT1m+dJCaxO29ntHZUgG5ZzlJ+OJIiTILtGV13ac9QvQ4b6UBI5okniBegXx5EfNrgqc6Vt5SqJf0tnemU+t/esDNWp9bruSovJ1fo01EfEHEqRbp27OFfaUa7W336rvzWkGeU338zOfT2PUeytb5JZPNfX0iuAdNKZ1tLelQaW36z9+Sxe1WwpaV4JAXka1tSVFpbfrIPZuyxY6nsnJirx576+wVEOEdRE8nf7YwUo1kIcqBDoYepcT2e6/ccF0KwOV/afqHD+LNNLJtz+kQoHJMVp6GutPd3+0zIoGsLou/lMwqarUwhSrn2AudLWMfGvO/oAxK7bLcIwVzlaSEeBRE8UWqheGaqhxn4Ftq6XrgIJ7E0tIehKDa2kZi9IMVBCfa3S2Ei+qBLq67uiAvinQaiXJrnSxi+hM6r3G+BKG7+EzvTRml33cQEE/mjBnuRg1zW0qLS7S+h8Hug79DQH0U/gMwWgQQbjSlu6hC4SqsWVrjSJS+iZfkGluVpCQx9OGSAx/eikAVlCa8JMutJWJaFLvZzQldr4CY38Ze3rXOoxJzRO5jRplgAMx6MSeAOHfjjON86KapG/OxgWQOp4EoNRCSya6QettFhDcwwOFBA9xGMVokZLzR3k+3SVxPN1XUASd5BvVlQSZxFYaqVP7iDfuekqjtIajDzI928dCht1xwpMTXT3x/nUgjcNbVPPCyCNryg5u+fx8lRPdXYfF2Ea65OzHJye6udX2s0lekH/SEowkhqDfCmeSGqO29BRAJpwhbSkS+JT/VNJ6rAltS8DaQeXxPHyVKvEKhk6PFSYniYeukWoGimpOxxU4RpHOSzC127l4UgD2FhzPjvraI6spCTSy4Pz6y1eZzTpXAYJDanW6NUdHqiwepB8D1zWOAPNT1NNK0/y/Dp0K83GkrU+ZoIrrcWStf4ppuR3Fnq5vSttyNKaO0YajaV6O+GRlMelfkbDUO/J0h11y1BNqyvtyFL/qtaybihRSO0FOeqpEJ1TP/T198HgqVOCzOoMW1ypFw6Pi5o9J5VE09m5np3iShUlHssqiesfy6OBHrZOlOiOhkqBS1Qj0u4haW1W6+jJlWZmWitIV5qGpdTXj/NxDpDMzmviSowkizilGJpWTwWMnpShmJaAvmtHKnAQzYOLYL72w066rtulT9USIT2DPdChV5lWz8IdPdOBR3GyrJmK5zr4hM7XenUQd9xVCyR0BrdffX/Gjg68vj/S7Culs3VSQ9SP+yWgOrfgw/FBGbKBJysttVIMMqEFGRRA6uir8bAIhl449XyI8agIWkNEjscFsJpDOT4oQ9XUN8pPY81bb/xYAWDTpydQ8h2NLh5qVFCGAwWu5uSPnxRA6kUm42JdjWv6VAtaO83PiuB1YM+LYHUSj4NuEa5eCnPgFCGbtv9BfkbqduAox6K0Ibb3aDSugNXHblPwam3879HocQmoqfUnZdj6to8U0BqHyyMFVzZVla9xPSv8oF8CqiOKpBlZeu6vpD5UrfT/aQG6dt8MimBxeH3WEH5+8KwAntBZhldQfYHnhQIsqmcttHykgLtM/e4dqhBNZJS0hasVM7sH4wJIY3CS0eh5EbhBNiet3dLzeLXS08nuweMSUK35ryvt0tLzWB9+ctR9XgCpQ8ZOPsEJnet1SF1p4JaerxcLPXF58LQIAwoEoBKln+dnJeCmZZMDCc60upvu464KAfKDmki8T3K4qDPz13V32WNHBZTBirVBW7oqaI0132isVqinPh73CiCdnvbJ7RQr6vT1UKW6XD1Uvwh1nq60j2bHrcLVHdiRMygC12y7camHDR4AJNsjDcILClpn2v33uF8B6yxqbubHbhU2XcU1m2FQBW7YrlL8kwba95LkG6XBsl7Y9nisQNVQAtJILw0yqveS5uYvr3fBCv1s12jtuY+fqJD16n3u4yMVMNLvePfx0zJUgxLp0KkBbjLiHfbUQquEXgSx/kp7/EwH2dSffkOBxj7JjfWuJqJVTmi+u17pN/JzFaJzuk6DqIY/I80JUwicoYWQMxvqwzK6T3oqRN1ekxaC6TKuUQd3n7hFmNq65IGK/FWNdNOVtn9pFF8uQl9rsTxyVCh9HBxXmgKm8Wymv9+k7V8aL7Q3jNQvSuNQq5kyzN8F+keI++SxAtDxw9W5f0rrRADukycF6HRW66vcfaK23JnTRtinKmy9Fbf75JkK2IDupGVfCpzHOsc7rjTgS+N1MqOd08TXe44e9o6KkLN4udSKqkbOSAdZI0AcOeM6aGAbaVdh5Bxoy9TDP9bCI0KrL/REW6hWOOEc1cNva+ppsWjd/XjkFOGWVB+Uc9grVbjSYz1XmlCm8XUn9dc1AgdZ24r6ei+R7lG/CFOHZI7cElwDn3sk1crSFaXzeElrFCCkdWS6omFYp4HpSgvJdBVneueu7tFIgQnrBAFH4zJU55RqDUJdaSiZrhKtBG8oxRXszaWbiGG/ANIJIi12OHpcAevMfL2I9+hJEbZBaDXsFUGTONZT07KXGQRFvaqNlDLsPlEh4wuaLPTaRa401Uwzv8bl5kgqF6aZH2jd1bvSnDPNwGQtmW+JZCiNXOD4aitUARrUTI+eq3A1Lo3cp10VquboPHUKQPWvWmkFmmY1m/JpvlKU1ogRXWn/mWa0mUZ9OtBBdnpa2KEK20DPPh1pAGvqHOeg2XmtRz9X2i2m4FJRH4rxabcI06HL+LeBdrvn8xPP6ogvaQiZ6sNPuE+fKABNtO+wCtdI8ubTp5eUu0+PVIhOz4XIhtrN/jQ/PTW8SmlJma5P2bs8Tq55cK86Y9buUX2ZWltWKS5M16f6CKPDx08KIE1UkjTpTNd11EzO3l9HGGtdL2+Vxp7pOkFhLyO8tIYAAy1op9fpalHRcz14v+PowJ/rO9IZ1IA7evBRDbhELRda3oVU4U4v/VWz7o37zFFhGxRvXGmrml7qH+/Sjq/W95UrrWjSyxqFrmcDFaIzS2I9VZJLcq711/AzFaCel/BspILVIH2p0qwPWeVKZZDMP61l/gyeKEDriEmC9BxAqTuCdpNaiCcqRGcWh+sl40N3/EUNgfbsqLYMg9YWeqorVC8vefZMB38ZzDP9hnheAKc1infPuwWwujj47nOnAAdI7JzW0Y/PexXg5gl83tcXqJ+9526lRP3UPR+owPouDwsgHT+a11mDPB8VQYOVXg3peb63Z9oAWcN8TrWX6/N88591Fv5Mj5qfP1ag6kK0D8cqVINbO/f5EwWwgQp7fqTA1Z3MnjLC+vUZ9XoK2Ban1e7z/NwkZzWLmR+VK62CsrQQBQryIqB6zv1AWolmFPQEtMTmoOsoQBdBqie5B91eBazB7nn0vF8FP1sHWoX7QTcHln5vOjMapvrwCoOuq4Ff+OcJjc6pFk8MugNNkXc0vAj0o5XHiUZRoO/EKAfRNynPD00Sv6YdeUTQ1eb6VE8s5jVdQSRaf6639RgeqHBZjA9z7X076D7Wgta5i+0+UcBrQo90nxVh0L9FzW0+yvEfvapxczSQFGh2Tv2sZos/zWGWlEWQ6sx9LYNmkPcQfZrWMSwG3edVuA6cZ+1GkLLA7DxeQ/On61P95Tx6PtCCNr3uc8QO4bPrvAENHKcC1lDrwOkVwLWOBgZOvwTUWKOrAqcAXdtXOQtBjVLqwBkWQDr+bBav9cfMGRVBZ3G0COpjCA+ccRG+3l3FSJoIZoF+zPK8BeFcfzPIWQmWNPRXqb6exwqUdkc6T1SIjtPVAh0VgPpamKcFmJrj7jwrQKW+/qnkPK+AdehypY/x/PioClynIzBWh1tH6g7kayaLz0B3ovZ1Meg5Osi60efXeRwDoaGF6Rdh6gjUQc8tAdaFGRn0BiXIhtPWG+phawOj9UalAjUy94E0qaz1Ny7NKPWWZQNpQolmYJ1T6utFFuNuAW4R6C0GpBJAlvizd3padZTTtIm/WASzTh1veCC1GbK6O1mKUrIaikmy77PEj0Dn9VS/Rs8qYLWRlQe95yrwQo8F+l0VKMiagIePC70M/UzvN1wuVkL9FOmuc73PjFG+P/VOOgb9fKVoGGqjwUvTyixh4rbaS6LfL0NGdJ0lWg7EoO+WgfU3Wj8fQlDnGGzQH5aAmg5iP9948epc6x6xPy6AdPwaluqgf1AEbGr2cRG0IQRg/4kWtLH2/ASs9d4y+k9ViM6chqAcrh/+swJojQ3BaDwqgMHp1JssjHLcl54HSdYB5XRtw8+LcBc6IGnGma2Xp6H21LlOAaST0FO916OBtOHM1nqub44S1hFtNGaTBiJZ7aXm9hWQGkrWdYswNTqRA3dQgqvldA/cYQk0odkl1ZNx0uZzfVoTfX0sAdZRttbWIUHCLPHReHqLdEgyLtbL00TPuxi4BwoMDUNfC/S4BNR0aKQl6Tqa65s8UgCaTqw0Jl1Hizicd0D+2sysHbjPNEUa9tdo8LxYYBkndFsbuiJNbUgt1HV0lsR6jDzIYYIaujEfWuavVlrMKc1d16u5/qIbSv2g9arGzHUw6BVAGsNViPOyTk+1VYmzeQER80EsEZ3pT8DzAwVyndWZRY8km+zCj/QuFEaS9XXBHDL4SQIS3o5/qudbDQauvsAp1Yt7B4NBsUA9lhgMdZBNu34wKpWIl6c1R20wLoGuk5oBHhQB58FiQRNaY741eFwH3fH1q/yktoDWkHIwOCoWQI8gaa2a7GDwtAhfh3sGz4pwqziomeTnZUDUU9eBDrtV0Lr2h04RtjYy6sgtrYjUfNcSp6VN1GRJMxj2SsB16hrdbqm3TcTgsF+EXUd1zednKQkWgVZ8M5C6JBcQkl1PkUtJcl0ww8FwpELorXFHz48KQDVP3eG4AFX/gpasReA2d/wzqvcsPBg+LsAleqWawfCJCjbzk3idahXyB8OjAiRKjbRwT1W4uZ+en8Y1dPbwWRFU38PnKtBZopWlDEZdFQrcSdcdkZGjQoKHNy1UT4VaxvO1fluO+ircyk/8MNQbNPRGKuQ36yDUN+yqYCn9Zk2jLNDf671xARZejtp38migwl1Sqn1KjPL9vtTv91E+hJq5zTsUh9pH/bCbL2e69sM0W+s9nQwlN/dC31t5Dt7V0dLSnuriXcMFKS2pLsKZNl/u+1jvaGBw9ESFgJjg2nrkuYCjQ+ucDw1Gz4pwNRTA6HkRrDbS8mDcLUHW2AaOBqUeLtf6UASDUg9rUNrYKYLVSt3lBK+0CEXaWlzqvesOpD7dZY1F3/OnCoD+gTR2CyCds2CRzWrQ1nhQhF3S5SlN0nM9g3I8LEJnia83eRrIl/elr5+IscxPz9G+yp+d10grJG/w0q+5SMYFiA69qjE/HYwfFwCDZS3gkwJg/dU6lIr/l3XipPGRClG7xZ4WoOolCIPxsyLkeqlfrOcq2FIvvho6+Y68oHrdF9l96oPsqoOa5dr77aBbAl3oeRoHTgnuvAbRHPRKgMiCjfSKd4ODfh10B/iy2pfg6GmpTFTH5D1wS5ArP8nC64bZGJQLxOukruvDEmxdhwcHoxIk2FvoIcc6yIapeFaGX0c1fTioQqZ6ZHTwWAtay6I9eKKH17/0D8p7E5z76Lv8VAfZ9HY8yKdD+1CTxpCX9HSmJ1Ye5+fh9DyOtQewp1Sz0psOjXr5JNa5IHTyI1W3gR8XQTrvgjCuU+CQJpCX536W6mPvDKSd3+U5peHs3A8SEKrSNA1qXbENpMHf5XmQSc8nHX+daem0xwMteBDN/IgZ2OjHOtQXS4KmQiNtodpjIC0DL4P5Gc302j5yPweLQFvJgQJQdzlI28HLQF9J3kqgdb8q5U+XwbtgReeB9qH1OIeK5vFlvWTp8VMNoJbAePysCLn0r4KlPiD14PHzEmwQ1cI+6RZhY33cjsETpwhX70Zs8KRXANWKPKXxHeiggINYPZSbQ9XonEvLu8tEay46kFZ3lwnV22cNpDnd5Tqa06Tu/SdN6q78pVYuIB9+VzWvjycHCgD6RofQ3jUDe6yH7Zz6WUaT61rl2JG7rWSdGsDIfbKlZJ1OgHu0paD+xTJyn24pV/uEGbnPthRdR+8i/eU4cp/XlK1BG9J08UrPWJH2ilc1ZMmTpwpA3dv0yTMVqP51Ku0Tr7TbcCANE6+W2vtmJFUYr/2lXqdlJN8V11QvExxIg7prGmoPnjSQuw6izrWvd6Qmn6wQjmt9Suv8Qw+kkdy3wapuAqWBXJ1DWjBpPbwI5LV6HsznNGJlnPHqSrgoIYUUUdEijrIO+G2cQA4r1XPLpXpuXameK0r1h+VS/WFdqf5QlHIrPXRre+jKHhb17cI4mSRnp77ZJfg/e+BaORz+CKKy799Kod6QFyppcgDg31ssFkrmtip7gwER/3XtvlVwEugORAmg6t5JpYo4WU4YiOkO5vTMOuws09q8mvRCSwfdrS0ddOtb4nk16YWWnP72QTn9hlGJzLqMYmvj7QNzxg0jE5l1GYXWer3tY+v1GsYmMusyiq2Nto+tN2oYm8isyyi01ne2j63vNIxNZNZlsNYWYbDqnNe3hN4L/8jsONahNnERhBlNJs/DYPUp9oMnGJhiKI1cbGnkN7pGflNu5KtKI19Jo90gKjfhRwFX/hQQrV7aCiLUGKCtMIionxzuBLX5+6LSd/R6kfhLmrYE+E33369fpJo1ZyvudJuK9gcHNUvIMjabv/9j6sxPH/zs77WYGfsLH90v/erLz70lsLECP5xTcFQTzOIotZdBZM/S1F76q9bPHvytn/y7szR9kM4YVQQ5P/nX/9Z/aV+sqXDF3RGZN6s4Zcoewpv1Rgs3ieLMtDH25jKeU+tGWC53rifwZtWXevsW/MAgbb+iyQ1Qa2nwLRJZzOf0aXx1yMyUYLIOpTk0u9kPd+ycDW7Pr1tNXQAxyY3SkqzZP03jcJ3RQzBS7R6ixWb38DIIww434pYLtLG53+f4lPmlO577md+56Jz2eweUurOTmy3VfgtOLunVpOOoo+bGUvibzcsEfLodgjXxGbNMZDcu9mTlJzTKDlEMDTa6FzTKUlZgHqSM/gKvo5XJFFGGu9vH0YpPIfDETbHCLYNTh9G4ptWO5/Oy+Vs/+Z/inn7gz+fwrjyN43d2enH2k3/9d37yML04a4HvyCCOPMMxWlfLMEo94zzLVpMHDy4vL+3Lvh0nZw963W73QXpxZrTQjsYzoE6jBbvgSXzlGd1Wt+WOW+7YaNEIrcTyyfaMiF62cohHP221Wq2HKz87F7VxvwfhmhqtuWe86I+J637q9L5yz3vDWc/ukS5xiWOPiUvci37vyO0RF5LdLvzjEizwrfFAU3deq9Ml7nnvwu2ed3qzDtbacTuOPe64Hfer8dGQDOwxGWErAKuvD5k4vJtD0nPt3qzTtR3i2uNO33bI0D7oDOw+GdojyBhCZqdnO/zvQcexh5AxJg60TRx7iOUwuWcPSbfTt/udnj3o9G0XslrYQKdrjzpdu9dxbJfX1ZvBXyjqdIb2gAzsEfzlE9a3B5BD+vaIOHb/836X9EZQBghLqAmaJA5vumc7pEt62OIB/nXtYYs1ANXZ/c7Y7nVG8O+sM7QPSLczsg/IyHY7Y3LAZmFAxvaADPHfkd2DvzPHHmFnoPMj0rcPYOxh13YJFukD/dzp4RhcGxYZxssWx2HDdjsH9hg+xkfO0HZID6drBMAu6Q1hEO5RH9bOcQmsCYyFr823rRc9F8oM7AFbJxgmzDtMQM/uwxBheEBuDwkuC36PYehI3ffxX5xzgMVK2AzC3wGMoZTe5+lDnt7j6UOsxwkhd2C7uK49vrYDZY0dNpfdThemOV+BfL5YXQMOMJ7xRqCTLiQggGP3X8McwESMiZwG3NYP4TQ/+ls/+fcYcuA8SYYY/hf/j4+KGM5EVTFqePHk/BxyBOH0Z3BQSLcDR+ygM+ywre3i+g5hM8+6uL179oj0yJD0UoQkCMlmqoOQnd6R6xIHto3r2H3i9Ak20JIYoNp69wO3fiAb72Lj3Ya2hx965APZ+BAbHzaN/OBDtz6WrR9g6wcNrfd6H7j1niNa7/UINtDU+uBDt+7K1gfY+qCp9fGHbn0kWx9j6+OG1vvOB2693xWt9x2CDTS17n7o1vuydSQf+m5T66MP3fpQtj7C1kcNrbsfGtf0JbJxEdm43bz1hw/Oynj4GixkLlU8DAENViltza48oz82WrNrzxgbreTKM4ZGK7n2jF49/kDaa0y6nQFeeMPOAG53e3DUBwTsIJXQg79j/OtsGZu4QPv896B15CKB4Ngu0IfQIqKVXgNOGzT3qc/71Od9ct+3T67sEx53Z9DQp3Fzn4a8T0Pep9H79mkk+4RIwBk3YCCnuU8HvE8HrE+97nv2qdcVfeohaug5DX1yG/vU67E+9Xq8T/337VNf9gkRRs9t6NOouU8D3qcB79Pwffs0lH1CNNIbNeCwbnOfxrxPY96ng/ft04HoUx+RS7+Bkun3G/vUd1if+g7rU7/3nn3q92SfkLTr9xv6NGzuk8v75PI+Dd63TwPZJyS6+sOGPh0092nE+zTifRq/b5/Gsk9IivUPmu6FBvrc6X5gOtEZMjrR6TM6ERuouzOd7gemkZ0ho5FZ60NsfdjQ+oelUp0ho1Kx9R5cjQ1UqtP9wFSqM2RUKmt9gK0Pmloff+jWR7L1MbY+bmj9w1KpzpBRqdh6H4iTBirV6X5gKhVa78vWkSdVT6U63Q9MpTpDRqWy1kfY+qihdbf7oVs/EK27XYINfA8q1ekyKtVxdyFTnW4D+eUK6stlxJf7XrSXMxC0l9NjtBc22nCunMYOHbAOHWCHet337FCvKzrUw91eS3g53QbCyxV0l8vILve9qC5nIKgu1iE8ALVUl9NtoLpcQXS5jOZy34vkcgaC5GIdwjNRS3I53QaSyxUUl8sILve96C1nIOgt7FAfj0ktveV0G+gtV5BbLqO23PcitpyBILZYhxg3q9/QoWFjh1zWIZd1aPC+HRrIDuHVXUtpOd0GSssVhJbL6Cz3vcgsZyDILNYhpGTem8zquR/4umUyAgf+wesWG6hD+j33A5Ma0LorWx9g64OG1j/sZd/vssseW+87BBtoat390K33Zesutu42tT760K0PZesjbH3U0Lrb/dCtH4jWXdgE3++y77nssu/1d7nse27DzeGMxdXhjNnd4Yzf6/LoHYjLozdklwe227DA3eY+jXmfxrxPB+/bpwPRJzx+9fdHz224P5yxuECcMbtB4G/vPfvU78k+9bFP/YY+DZv75PI+ubxPg/ft00D2aYh9Gjb06aC5TyPep5Hgur1vn8ayTwfYp9JFIqR8/y6X8oHaQcqEfD/5795HyPdB5HqrdbIKqdHKJ0/L9Bh96nSPxiBiJiCVPyBjcnAhTh7MTg/+d94bzxwmu8Yp63V6Xx0cuV0sgVgFOWOjJoHWpyDNw0o6Pai50yO9nVq66AyhKedAtCUkWE1ilO/bWt8RrQm5iX7R/yds0Wfx6pov+X//cRU+mP8Gnc4H6rCknuF2iTtojdk//RbcCy2Y377xQF9JsXgf9A4ct9U74P+69sB4UN5+TIFDWR7w69CCN+LQaME14Rgt1LfxDGdktJgaTvn2KJcZ5GX6u5Y5uH87/X5zO7oNcE7DFdsAf/uvfugzjzu/RMi22FHoEWdw0RvjaSjr4jiDT/u9kvqNqCWhc67O45LBRR9EMT3UihkTt+OChs+B0BgSiV8NPnUUbZ6zVj1pLea718X5drr5fI/z+XaNBw1lRrLMeNuy9u9fpNct79JdW+k5929lcP9WBvdv5eD+rRzcu5V+f/ci/fsXEa3cf/W3FCmcaa6vcx6AuRnH63/nv//RKPIpynEg4PzKcc/744te5ZB+enCErLMeGTAdPoDW69vJ8+4CN+Fi+OngojOcdRFnIL6AGs/73SPXsYF2cvtMha+v6tA0YH9mWMRE2X3OJOwarcQz+sYDPZwz0MNpHipniX+t12Dqk36VDBiUyYA0p2oGR/0B6M8BKeuQPoqy6hgZg/tX7qByHkENuz7Kp7+9x7jkPdVnxzPHm+42tOkc3LtIb3DvIn3n/h3jYxnefyzD+49leP+xDO89ln7v3mPp9+49ln7v3mPZUkRH1wTRIuaE7f/sh6ZrBDJQqdAC58FFzNBz5ADkFPXY2vWMEs7UDN/JixcbFEXUJp2hPWCNMqJXzNa/w2aLWZ+x++J//lHvi+JQOCenOHs9NpCu9kLgRBtmICYdgbwVtJFB+3nYAS2djuPag47Tsw9mnSEBrVrUJxpAomO7xHGYOvCAuKgo6xJkxsNvx+63ZgjDFIRJD/Wh+8S1x1/1R+dO74KpAneYFjFofQ/xdnPtMaghI0tlhFw0xKXQPf3Vxseej6QLNXXtXtjpd3oz5cE/xr+IvD/vMTFP2OnZrlAm18CFnT7ptYRmcI9rBgsN4VHa5QrPkDsO+3Bl2iMYYO8r4G8pXAeX/x6GqJnu2k7owESGyOhHLee+qAj/YvutkPcPgImDZYlrO0e9AWOpDZHFCyw1+Du8cBxs2nahMx3XHpW0pwd8GOPXfWioB63KGfvWeLDj0XTd0qVdQ7/0hsQdnHfcyuvkojOATTAAtqXbt1FlpkdcGANxBxqSo54G6BLXCWFHDpV5HOEFzXYgpI/DA5zIPvB8gGayBwRLMpadWxbR8ReyeBQjf8ketRzQ87NH+Kg+wH9HTdg1DCJuJ/Jvff7ROEW4erX63+ed/qwzQEV5EA/DlgQJWOraqKWA/3/enyEEcbodlt5xuq9dtI3gWt7ftqC63sF5p9/Kudo9wCadYYp/ybAzPO/PMA/Yc/aIDMnwNVOdRZZPr47l4/RZT49c1mQXhL7QoV5/h446Y1YK///bFtT2Ph11hqyjTl/taE7WNdri9EnP+RQ0JEv0ZJrzqpwKr+o1Eqk9Bxm4js7kADZYvFhwzsS//3H3WEu7dLBQIBpgNjK9DrJncXV6uPealgAvB9Jn5hiElXHtUQtqA8ueAbKGh50Du9cZ7rY/XJzikQ0NDggW/BSAG1ib7Ixgwc4Ir6kBtEiG0JeZw01LoN89PEC96uZJcdhDGBjbeDgytE3qIp6GUh1W3QCNjgC/DwlrZofj1/DKUPZiE4Y7gHu85QzJsIW2P45rOy2mOdC3+43YsTfGsv0elB3YB7wsUgK7lcXCbo+V7vftA1Ya5A7Gg629xm67PdZvVhr6rZbWvf/B53MQnfHD83/8AQ+Peq1RGrXAONkocvIrQ+07ZNxygY46sIctptN0YLvN0zMibrclpOUtxwW8MraHjYXGxBm1Dmww5HNaDipGuPaB/ngcAEp07N7nA9RWORowlZUB6cEBAOHzrMupwyE5YL96QJyAkd6Rg9QHIIYDEFsCRM9tHR0w4zSUq/XwB2ukXlQ0gCvMZcZuSG92UNt8YB+EqGQCRm+4L1FDGwhLaIkczND0i4AsAIXWSHr1QyB1YRcdoaiRU1o9HA6pRxQ20qrj0GUE1oybonHbsz7+BRLbRUJk0Bnbo44LXe3ZSNhB+8zssIc2fi5BytcGs6ZB6wiFU3A6QJrDzolosq5LLuNz9KWCK5JZfSnnmjHTt46D9oQOSNuADA6FkeGMmcxBhwAD9xExde1+azZgQmq0OkRbL3vUGdgHn8smm8kpFMC0mKJsD47r2GZqeo3EVHzGn6p/+/+FZ3WH8xnMPUM4yy2dVqc7bjm9sXwx9xTxQb9nPPrpv12ygPVn79hlPhrZXdhUg8Gsb4/BLrTbbw3tA7wZxi3Y1XARDN3ZwD6ASRuPIHEwBlNOB06hgyhy5LDyQ9s5aA1sF20jnCH72etCBXBT9XotuC4YRh63YKejjhlTXhg6ZGi7gxaYlA7w4TVodWQTB0yDd0B6dnfY6mAnsT8d0UtUMBaDgOcfUMwDqMOBuuH3BdTnDmZYGt4Sw5bThz522HgP7O4B6YxnA3s4ItC9XgsGSqCK8Uj8PrCHzqxLsIkWliSsn9DqwQGDwHPc45Vg+SHpjFuYB02wDnZxhK7L5ptljnlRVnJMWNsdPO7DsEvGAzZUh8A/ok3WGacP6wR/hqOLzqhno6Ydzkuraw96CNdvwVzgoPpgkNvtcwg2FFwnWHTCppYtLRyKPsxAD5NhtmDdiZxR54DgIrZw6QHeGbZw7dlvVk23BYhp0Gvli/Lti9HY7o/Qgnss9wluOKwKR+UM2AfMmztGJzutjmxpOJYfDKrLhzGwD1ye1ioAQC7ptljNODSlkW9fwASC5ovt9MIuGR7YgGLYGIe40I6DWwXQcF+d9oN+2CWdwcB2h98arSqZG8Uq5+UXH4vzouM7/M3intQa4f8bpslHZprkb8UPxzOpiGl+nEwTHWsXnDwYLenlxDOWfpYEV6Y96o5aHfw3/6dzYB+48OCwjAK7SMcOHvTZo0BgmP8xwzDCn8dP/u73Ri4fgq+7CMLQM/6ee/T4+aC7jSsu5p0XOjp6/vygqyh0IE5x7WGrhxpvvZaDWlg9e9hyengYbLeFXAZ73OqjNv3IdtVJ+rt8ki5oAoPjLK3/6ONOVUmLhWMzSWL2YLMN2T+9fqvnkmEL6Kp+9ZxsexsDvey0+mCqAP8etPoH5EAriOEKJrlcvT9Utp6GZ1QcA4ppRas9l7h2v4UrhYMY4KOawEvO7bFPl+VWBCTOGDsC5K1aNe+U08s75QwrhXuO1KcoICdeWhnRUIvK8E3LcRmwYfrcbUifdBVkCn8HF9yTCsn9h+B/r9GWXtjUX3R6R/ADNEh7aJ43QKYSr/tbjaRmRWnClfT+rd4PrrBTXFWGi/LNBIevD6+fIXEOWj00TBvC33Gr12P/wBXTGjMD7H6Ls1RGLbRg67n2oAWV9A/sMTvBQAS2euhzZmS7rT7SCl3baeGU9UfwxGLq4DnDYFfx26h6XvQcJUW233Nzc3Zl35fB+gcMrOc0w40YmNNvBnMcBuduqa7Pe9c/KMLpeEQJndHggvt0+ck/+nH4dNmGr1Dx3IY3Bem5LXj9NepauTrNmcYSTvf+RYa6Io6jLcPQqNvVolG9PPvvFtZrzhfs8kej1MOQALIPiNv9alxRvum5Gh9dFZUfxzlCUyeXsKcf/Cnpmhd5i408X7QHdeFScQYtVBFvVMjIVd96fd0loNGWTqmfzM45Iv7vPvLx4QTS0IH/6RRvgFTiF6ft7E5+gj3SwG31h/ZgPLR0OhjOqA4Z9bhNZY+pQTnDetK4P7R7XM/jnr0bA909tnv9A8sQs9AfueCzWtfZXs6zLxGkQ/fJ4PnQ0Pa89K7hJZ48efrs+RPxsmEXWQ/kH6MOPFd7nT6IYOwD+Jt2BngkMIH07d6MvxldpB6Y2pUDD5QZT+dpkAcwTgutzFEFC1TXAQF2idNPXXzZINlrD7izMkFwuPz16LJ07hIMqgX7gd4R+HsjzgCOCv4CclqORKE9fio5lSmNBAL6Tz4ujbw7LkDLUPuA3Rbs5mi8M+5/A/Tvf8/0nPtfGtvvjBJfOaUR5yz9ZP0/5OsCXghNPHsU3KKgrt9voea5/rJw739Z/F2xElkWRGecbv87/6OP/L7ULkcfmM3MBaSU78CMu/i3B0iMCXwGHZ4QuiirhgIu9z045D4H+1Dwc7TMt8ctwU4bcHbaCFgvgAHDDjDwURkVdQME/w34z9hChzlDHMwUP4gDhISvg/POmDHrUMuD+U8EhIjlgCPZmvERoBieQLN9lJv1ws6g02NOEwc5h4/Ls9wQBFhkZLszxWGjoyJlGLzA5A7h3jc5tu5BA62UlRhwQVPvc3Rq5grUL1w+HvArwP0c7LRwNvscgQPyHhHRJdZfJrlCH5zIzBLDkQNm3REPzgOCE3I+Rl4YytqxfZy+UMwu6zYK9XEGxPSz1enyMeDoiVg6kPDBm2yWsxJhdzBDNBiO3FDoZRNM66Q6Toep43Sc7qyLiVI9B5KETgCRGg5HfZy7AXB/4WmMUr5BnUdXKc8C1N8HRj0YkjooQoE/DqCTIcqBXYLfxOmlTq/DvjtO76iPDmVcxgzFO4Np2EC73ZY034NJ6gw6A0RiuA6dARl0BilMHMhVB0e9A/ayr9pQlnBEdhWtIQweJym/+JvxInOYkaLttBzYVshxG8J+dxiHp28PeM4BvNW7yADYItpHYQKv0OnxCsdYH4iaeMaQ1TfapToQxbNi/TGvr89YUEDashwmr0U76/69lU7k9e5K/V1xR3S3kgSszMH9i/QHzWUKVxF39LyOZnG0CJKleEr+7X/2I9L3zZk1juCNjBgR7jYrO+uKVNWruZ2cIhvPZ03Ha+TPEmeoe5Qo75GO8q87tMd9F4TH/YOD/M3Us5WHyNAeV4ZTHUBvK0erPGK9TZwq0HNAJcTthSCm7fRcdFo8Rpyf436XdD930fUoXEZjjvF7BND6iPQ/BWnoEfOHi4J5h7jo+YpVvR0rDxmL74BJxAi392em0CnjofaFgCrt8sa5L+WU+1rmtIaTskuNq6O0UlaVeAH1U/a7z/8bpKyoS3gVqXTejF+KaIyBKy6VWX1KX3mL0hkz648i3GMd5h99/t/gtXDL5xA5E3CzgEZcf2iPz+Ga/7w3REbwef9zmVG4NH6KLtzt82wZ/uRf/3uPHu49fXn05jevnrUg5dFPH8KfVuhHZ55BI6MFKdSf84VZ0sxvzc79JKWZZ/zqzfPO2FCzIn9JPeNsHXSy+B0U51GBPOPt25//6rO3b17+8tkXb9+KMlmQhfTRK66NchQHUevX8DN7+IBlMTBQ6WwlNPSMNLsOaXpOaWa0zhO68AyIoHC6potre5amxo4FdLEZ7lEc4v/4lzSNl/Q+xdRAD7uU8VcrDvrwAVuDh6fx/JqXnAcXqMvjr1YSqUKtDyP/QnxH/sWpn7RO46tWkHZW/hyU+0KapvDJAtTDF3YGKyyU65wmfiQeQA/TlR+V8iFig9H6+7MwmL3zjPTSXx3Fy1Uc0SgzP42X9MgyWhcd2FCecTHPbAhfYLPZBs0lA56dKz9SBlRqf52c0aTF/hgtKN7J/OQM9l7kX7yg0foIAi4giujQK38J9v/i1sMeF5rYNe3hg3lw8ein8sdPf6rMd33Dpd4vabQ2aseWZn6S5X31t0yjZuKV211dG36x6qddiCuN0hyIOkSxMDpjxeoKPHzgP/rpts6/ptH8A3YeeEg7d1wHvFOnv2Ts8g/Yb86A37nrNfA79f4xi8bxJI7ffcARKDE+dh5FQ5mdRvIpM0X+gKPgxs07j6AGnvV+a/+P4iiNwx330SMOrWlJQUh1uIRGOZtKk11uUIFYBDScw2UAlPaqwO0CMqxUEVxWZZ1s/dTN1glEv+HhH+QMFhdrvo7ztTqdzVnRLM78sLpGMAkrfe94YMlSz1otFfi0g0Mtdf60k9IQaPZV6M/oeRzOaeIZn/vR2do/o9A5CKIUesYsWrDefQYxcRb+jNpAIhmtvx9Eq3XmGal/QaGcoXE+E68gGk/rwg/XFImqR8+iszBIzx8+YFlbyySp8ejun3z3J3d/9d0/uPuLuz/fueAiMR49T/zor/8rP0j1pR4+ELPw6KdqWmW+CvMvr8jSR/nqfPgg8i+U4yJX5afFrv/04dIPotYkwPXkZ4gpK3MS0mhNLvAcl881ZJzO5vkWgoQwOsu3IyQkq5lnJKsZS3j08AG09yjvxcNFHGc0wRbZT5Wq0ux5/al/BUoWcOaRfvUM/DZ2Qk+onyE3fvFgiY7bR3EU0RmsYqo7I82de4J+mpTesYTdusecPG3r389phoCzeB1l9+/h49N4nSkdxO/d+gdm2/dv8DXnryttiiRjR6KEQzc0fdEJFtU1pHPZpExpZXErO6etS3wF7dYDhpWbWqdhSkVTv/YD6G9rESfv1VK8WOSNKeiAnRmepCKEdJYEq6yVJjNggZ0+uFhT+7dsujCnBoy96XYAlK+qLbAXa4pD2QJ2GkTzrVUF9DJ9cA7Pv50gUfi5E+RFstgdWCji7ASshmnbbXjcFcxOwEy3bCdQhkd27DQc/91AZ4yA2nVBuFRtCzS8wIsgDx+wJ/jDB8gu+Vs/+R8DHfCARvZv0zj6yb/+21/f/PTfNrJkTRntODHeJGvaeo0f5Kf/tsHP28RgjA5MCwRRYUwMSWBgTkQziMluTIwv+C9ITelsnQQZ1P5a/IR0CEAfnRkT4wX7AWnyATdpGS/Fb1ZJNIdEeKRhgnh2TFoGfwNhskrHT1oGf2C04IWB+YJKnrQMTrLz6jlexCb4b8jgNGFqTIwn4iekl0jGiXHEElocCoEYcTgx3uBfSFG40sbE+JXyhZ2/8IMQ2MnGxHgsf0NO6Gc0zZA36/P7dGJ8jomtN2oqNnu9ghrewB9lTqBS/gtS534GUE/hD0It8RKcGI/ZD0hb+ddZbEyMV/51K4t5T05piI3DXwSiCUTwNCbwq4U/WdmzIPIzHOgr+ZuvZWEock1gdYvjaamLlNBvYKOu/OslBHnMi33JMlqveE6h1JKmKeveC/6LbdXLfFq+oJctdWqAPIbe+HxTMVpnwskj3BaMvJgImgQnEG//CacCfrr5qThui0Qct/9D+bhhR4OEti4SP6DFI3f5wx+5xyua/PV/tS6euOgivqbJhzx0QUIrpw7Yqsu//q+zhFYO3mt46dQcO37cWv4sW9Mw3O3cfQGqvez7r/9p5eQ9DdJVHAWNR+8pTaLgr//rhKat7D7HD37Q5uO3BHbtBz5/+Puv/+mW08dOTfDNmrbmpYG1KOyCv/6nNG0+iaUqVn5AEQK/5nTpR2IldzmW8foC1rTlK/PGz+azKKFnQZolNCkeUT/QHdHiCf3rP2mtkngVp+opTVJ+Sv/O/7V8Su/+0Xf/4Ls/vfvzu3919+ff/end71p3f/Xdn9z9+Xf/4d3vikf27j+7+8u7fwbpAHr3F5XDe/dfYNHf3f2z7/6Tu9999x+WzvDd/+m7P7n7s7v/9u535WN890/u/vzun3/3D7/7k7t/dfeX0J3igb77T+/+8u6v7n733X9692eVY333j7Haf373L+7+7O6/KZzuu39y9y+UUsoBx0J/fvcvldzSOb/7R3f/8u7PlJEq55xP2V/iPP2ju99VzvvdP777y7v/5u7PvvvfwGxgI78rH/y7f/LdnyrNVw7/3f/57nff/YO7v7r7y7t/cfeXd//t3Z/f/bO7v2x99w8KxQQ2uPsv7v7iu39491cwgRqkcPef3f0ZVPXdn979s+/+5Lv/6O7PJKCKHe7+Yzb9hZq0OAJG+N0/YHMOnWl996e49P/qu39w97u7v/juf40z82cqzrj7v9z9+d1flbDG3f/u7r+B9YNiKuq4+4/vfof9+JdFBIJb4V/g9oQd+7sCJrn7x7jef3r35y2ZJ1DK3X9+93+DxLu/uPtdCbPc/RWfVzaCuz+voJi7f3z3u7t/zlrkQ/vdFnxT3iOtu7/iM4Yd/O5/pZ+x3zWjIE2tbCN/95+wzdpcb46XcP3+5Lt/KKejgJzYUYfqf1daHo6h7v4JHPDv/iFu7n9UQFJ3/xXs0+/+BGv48yK2uvvf417+CyVPoK27/5yN5S/v/jl2/19+9w8FAlOeoD/5//7k//O/ffCzvdYTSGhddO2R7bRuWy8+e9P6PJjRKKWt29ZZkJ2vT+1ZvGQF2b+tnz346Z65WEe4VCYlmXVjsKjdhufBJo0XLXq1ipMsbbcrOct4vg7plP2xOZyXmbBWQUJNeNUaljUxRAt50TldBBFtt9lf21/Op+yneYylTkhmTeq6MuV/bRyypkEqc6j91ZpaG8s01hFrYG7siepSGi6m8M8kOw9SokyEdZPQbJ1ErUKa+N3KzMC6CRZmdBycWBwSfos5OLzwk5bvQZJ3E0wCEk72HMIzJzebzSEvRKHQzA9D0xdliU/y35lFfDv09rp52gbqjrwbWUdmLz1KMnvmRSSz556ynBEJrJvMjuGndXv7EqfTZvPwKolXNMmuEewGMePZOgGsh32N1kvKv7rkjGaTYGNtSGZHnjojrC+03ab227c0fcE2hISQ00ihUX8dZpuJJlMZytyMiOEbJLJIBM3FXnF3ckA+klUSZzEspn3upy8vIzEmNqVQAOpYecYDg2RmZqfecAyb4bi05/PNq6bD9BGfDTIlsUc9ent7syGJ3I5iVIdip+55XtJu5/sdvm9vzdSjUF6AW7g/Qk9zLuJpbDM+eDqJD7N22wzthEbA+c/4DxLaaeZnwexL/HwepV5WTrFIgEXfprN4RT+bewFrc30YLEx/aq4Lq4gDgyNgf3IRxXPabisfdpomR3GU0auMA618uJbbbeWjWLCQppQn9PZWOYdy0G/ffvWrZ29fv/7y7dHLL948+6M3b9/e3prU06RbJGq3I7a+eGipRdj2Y/QhTehc8k/T2gyQ/5m+tSEwRWnyJQfx1tYkarfNtRdZZM1WfuaFtpgsPyRzbzYVSzIJ7VO6iBN6lFA/o4d5jn7Xrnm/LTJnm7NUgTefHp/Yszia+Zk5J2trcrw+2bDCN+J0TVKJSGIitkq42WwsYhZ3r3VjrFPaSrMkmGXGYaYcUW+vi/sh8CLT6XYt4ns67Fc+2HRyw7fwhG42lhlYh5nY1F65cXGmW0HUolMTUBgHtRDkBqVAk6iEa4qYqEsukyDjvzfWhB5nJ15E6PbhRvbczIhxaihwslP+xrL4BIgRwR71g4gmz0J8w0yidRgSnvnZLI5e+bN3E2M5DwyR+jTww/jsiBGVb+hVsQzPBdo1rGS+jvzZu1M/ebpOfOjapD/odkXmm9hPM5nTo/08Iw6zYAVPzYkBOjNJsPSB71HMfxwFoEM0B0QuBgCCwMfrLAbxVUgzOjHiKB+In9HncbL0s4wmxUH4GX3lJ6kmGfdsXE6//sJf0rSQ+CKOsvNq8vMgSaHAy8WvKX1XyPpVxGR+sO5P/etUA/ImWNZ0GXI0XX4Rz/3wNbLjK0NZBbN3NHkRnwYh/cLPggvceUp9jQBfxFkwo3+4pms1FWf8Uz89AjKdJrB9DzPb9wJOHKjHLfD0G1oc0a5lwjl1LQJ/BhbBAch/rMP8AgvEr6b6+q5lGpfvUgMOfmT2BhZJvcgcW/br6+VpHJJYdzelh6buopSHKjimJ7e3Jvzx4nY7ZZ/xNJ34lmmwmm1jn1rWxrKBfKdeoOtl3ojENpE5Hlkkx0Qwm+WikhrJi3Pl3wl4B7ZHxubQYLro6tVD223z7VvqRdZWlCIyW0EBrWd2ugqDzDRsw7ITOl/PqGlqLwDAXhuLUGsjq/JFS8HC3KOcmOw4cE/vRbe3KinBuxxZojYblSVfLszMOlzEicmWt3sYPKR2SKOz7Pww2N+3gFA1gdIkmSXKBoeipbwrKVJ0cTBvdfc8j9oJXcYXdCp+mNZEyWR3/Bd46atfHPjoPAjnpjrQWL1XsFJUazAfHH/d+fr465OvH3x98/Xma/Nr6+uffb3/9fRr++uvv/4Pvv7k69uTB2fE+PrrT9qGNaGbzD71AiR3fcJx/FyL45ONZZHMpl5K4NzFeO4S7+ZxNE/iYF4lRHVPhMsgmseX7Tb7a0f+RXAGWM9epzR5fEajzF762ezcfMCrfRBYG/IENO+e0CS5/tCt5DVjQ8HL1x+6heDVeRzR2+CVP78NXsVsQC9XNPE/dEtYaQtY09jGr7FA+qFb+ewZQ9vYhh9pVqSV2HzxTOv2NrHzOWbfwcvX7Ad2mP3knTWtzQ54aIcBvPCzc8+Df6csZVL3YG234V+1AHxPnoseGAIrnQepYZmWDuedIc47q8F5kjAcsOvBHbLroXdgkdjTPiIPM3uBpKQ11eZPym0ECzMwqUUyLzUzste1SGBGFvGtLLkWyxJz4M0MV5JaN5tgYRpnNDOCqAXIMeU/rew8iS9bQBc9S5I4MY3HsxlN0zhJW1GctdL1ClaCzvcMiyM+A6lPLN1um4ystDHNqqEu8/Xci0znwDI1OGe0p58f82aDz9obeElrim02lg00ad1eutmUXriH+ierrDFSnsBb7zWx4D0LKZQbYJank5s0+JZOXmdJEJ3BkwN4+fPJkzgOqR8R7otVfqMtjJIfzOJIFA4E9cy/fZUO5WlL/4pdWZPjL3C/EpZxsiGgGFGdtJsg/coPgzmQXEH6PJ6tU0bxRvRSkuv4HBXN394GtnyDCJDNhkBX1kAu37Br7HlAw8L1IO5W6rF3MQMjmdc9zB72D7P9fYu223vU/gS6ar8NUqwC9pVHBbgleT4bAq/1dYpkvNJMsDCV5zOvopxiR/QSCiK4keIc5Ue7FlpBCrp8ST7QVlAPZWl6KPKOqeSE0Y0YIRf1bBlkU+d4DRvcQ6+Dbwt1pZcBIAYsB5vVupn5KYWHUbr0w9CYqBUDwKHIX9J5sF4KAJkcgvq+SMWnnuexNVc21VStwDA2mw1Z0uw8nqeTmwVsQ7WL+bY5lMQT20Rsq1D22PySLgTTJaJX2Ztg9k6HXqj9CQ3tb9Y0uX6ND6Q4MWm1KsvGfpgWYBQSR0/CdTJRCXZ2LMSh8fYcwtqmSyBjT8N1YhBqscTZOZ29+zRbhgM8bkF2bWKlz4tD1dTaLdSKXYJqN6RaZWmHlKYqoYv0WD9rJ+oUa2ZHXwruHfZ6Yn8C4IfIOcbu5YO9vTUzD9Z87kdg6UHgar+AbHyf8x0KdTh8yjhm8gL2WTjP1QMER/f2tprOD5aXWZVqbC61qCnGu+RFVrFDm81ms/ODa3iw7cGl0goH/CXZVd+ijCCo4QsF9gJTfNMhkWVtJjVwjRwfSaqMrUP92xTeUYFJrQqJQPeNVsDIAz9qMf5tTh60mttz+Xj7Tl27YpSmD403VjZmdXEqyx0AlRWZjmuRBP46Fgk9Xdk1mZE5WXi0HdrPyTn+/TlZ4d/XZIl/X5EL/PuEnOLfX5Nr73zqT/zjDB7n8Me72VjkzLvOuenk0jufBpPVNDjOTiZmgLA3GyuHODxvt83I4+/OdQuJMHPm7S3a7ct2W57fy+P1idVuJ+Y1WcNBAu4ppE2i4/UJuT5en3jnRX65II+P1ydTAJpctNuzaWrOSWBNTtttyPC8+dQsiyEUBmTGBB/8qmkFUZr50QyqpfLS8JOzNUoS+UuZ3R2trrgzwKiX3Rctp5AGj21M7pWSgVItp5BAplHbX63Ca+wSka1biuxDzq5H898k21jm3Jos1WnKxWjT1BSUP1J8ZG5N5mTZbpvmtX0RJNnaD29v89+w2BZM4ZzAdviy3T5rt/fOjtcn7XZsnpE1mVuWtTkM7eeeQ0L7516PhPZrzyWh/cobk9B+4jlDEtq/9vqQ8ytvCFlfek5vTPKTEFYJ2aZjUhX0TQEvA6NBJzykm12rz18Te3vUVF4SPLG72bGqnLS+UdnVptOmVollbfYgLWdbmy58M2Z31tzeTTEXT3omc2kjRuJYpD9QMRJ/jLyj1+ntrRY9UeJbjX3a61Zz2aOkSwJ8gNpgiBkvzWZUyBmApiEEHAxJeJ5Hp4YxocSw3hrE3N+P9gPLzmJG/pv9oaXrH7w3bzb2ij+HPkufyVXZgZHaZ4zU4fdmpLL6ekOsrzf63vXV3WMkU24ygVDw3RYRH3iFmQ49mECpiJm02u29wPQ98TK0ZEVYQU1p3LYvF1sK732/5iuv9yM/+oMMVPYuaJLxuxmMD0DeEQD3nVkQGdam4cEsWm6+oGV/7DQMZtQEJ/fWzvhF0qqeR626Yfhh2GIvhFYctVrAAa+nMsQA8GDNaBCKQ7YI4zhpHkuQfuF/YVJvn1rT7sSkj7rTYBJZJrU2W6UBgCJyccDhzoz+7eTNkNU6BvLGPzbevp3FCe38Nn2bnvsJnb99azBKRJsD95Ve7KAwO7ikAf54+dNhmk1uNqj6wcUAqUGOTyx7tU7PTSkaCGz+i4Ax3SQye641hWDtoNNzFsanoFEK0cQTcHExMf7v/7TV6zrj1lMaBWnr1To9f+cn9KJlfhvGQRLP3tnJ2jI2VtMOAqOxNEvW8EIhRb4OCdJX4up/uSA6/Eay+PN45oeUc07EPif8rBpCHEEMS484+a1wRjOlZYaem8VG26hdVrEZ1O4JLdtpdGCZe13rMDIHfcvkgzHYX6OolYOvmbeZxzJN8UZ9G3jFW4r1mZLM4yVIxH8JwUcreuRlnPabclE02ztkHkf43pmY1AvwEhat7HtCsCKk15RDOxtLw74rvpDsBfE5QY+EvmUaYu3e+GdGHeaHSoC95JvUi6Z0ohKHqdVuwx2eljV4upLg0C8E49e61u7vkp7L3iVul71LYDw1u4GR4z4XJsLh5j+9dHqzmQQyAw638dYAeRK47XicmV3r9pbxoG5vEzMjVEx0DM9Fa2PtICd1xngdOwcf6nrvsuvd+VD19Vh931+OO3KaNo3gsUtCK8tJB/4Kiqyb4hNH1qE8wLnWCrxvSi+fHBreWxV4EogSfV0J0K3SlEGCtAxu3ZSfUeobagd+fRcIkPvz7SPTHVmmMQ8uDOu9OPgl6o5fhfY8nmHnSeoFpo+n2LdnqAXE9U+2oNp0WoI3qQWahY3tO6x9hx/pUd8y9xx2rPt9yzQ+e/b21Zcv37w06qlQRnMmeO+T0OuStXd8glyACI5tYkV7IPwPzIREVru9ZtdtxBgFhwLjPgoPLQTxsuNwfx94BOb/MjXXqKYoy0gyad1Mv3SrTx7T+NawtM8DU8qoJrpHimCow8mZUnGVGtaE17uNlurBFCPBtgyi5iUE+sxnR/Sg2x05Bwe9gTtyuwcHjjXpbkd0I/bs6L7/s6NWu2FHDTCdShlssNHI4hsb99YAbozAjGHDaJ7ziZDJtNtGijdDnpeKvKlmAiU7YKLXXWtui9oKGeZ5npq1p3zm1+1UdG8iW1b033YdmReaclSWbljqwzgXAk9C2Hs/+EibOrO7YNHliGfEEM/ALfI4e8jjjMxx1yJrOEUHFpnBd98ic04qBRlNQMJvWGTh7ZnHJ8jVaLcNEJgYQcQTTMsi514VQQMFt6m9LMmKLMkFObVuQjMiGVkx7cBrckYuydsKQ3nRbiORciUu1KtjeiLuVCGGwhfVBH8iCZMak9pbDRiGkVBo3Wx2hSPvvGzfaH0mpoa89kRbnrckRyDZuSpwE596V8fzk9vbq2Pj7/99OaUnt7fLdvvqeHlC3nhPb2/fmkuLfOItp6+nb02DRlkS0NSwJm8EifzSMx4n4GIX3llXNoe4vX06eQr8gJfttnnpzcyX7FqHXlPLsvY8r6y43W5f2rCAoHRrXpJ3TBXg9lazoS+x47F5Sebk3LLI63b7abstxrvneU/tyF+COtcRyJ7eaDbB01xxGC7qoN3eO729heXcO4LxswauyJy8sUgCvPE3JDl+d+Kdk6UVLMxrj1Gj6eT19M3krSkatwgs9uSCJfKnNJ+UyScbcmrB7XcGW+bawj9Xt7epeUXOyPXx2Yl1iD4QfNO3X+379vOfmYvb2yOLZORaXoHXW2gcPEy7aHOM+fOjP9Be/CRR522jih7yRxWnioJF4i+pYZHAE2x0vOYzG93F2fMgXYX+tWdEcUQNEpnjngXkGzjeQv2wzCKZjbbsv/UvfGbFPjEI9TKb+1VhejY5uUTteEUj0yLUBg4rNQ1uEv9IgNjP+SZ7+LWwi4fFsGdhnFIT8Ay1nx8Gnc6hNachzWgrzLfjcXocnJyIOQ9Na1NlpjKq6/ZWQxeJgoJ5PTWTvG4kKUjkwWlIiJrBLu7j+MSj1iTyQlOl2afRxAecpEe3kTl2VeU/+WpzXLbMPU7fVV6bJPGMo9evv1yH9PMgzcjR69evYdWe0lnoM9VjSPsKdjgDCAOUns4y/Hz68oX6m9Usvt6Af0X24Wc+WngvaPJZRpeY+DzgjX765sXnj8PwKA5D5jcGU0qfoObLiVz8ZsJdkfKCzgMfK3sRLClw3xAzEVA4noNG4gt/ReAvwrzyAxgD2pKxhHB9FkT8Dyv5+quff46bGQFef/VzppAivl752flreiY/4yDKxIcyC6+/+vkb4VuVJcTrZEafrBcLURdM9+tzSllx0BF/k/izd0drWkxgX/F6hj1S+TtAgR+GDxNx+sL9fS4b9JLj8ITMvAAEbXNv1m7PFPHdvN3emx/HJ+22b85JTNYWSUEqlNo4BZsdJQ2ClW+w5g1iyCYM67CW1QRLcy95RK2Q+mB0LyE1l9qWyAl+TAqC66EFfRYX6wvk3uo5v3ue1K6hx/7J7S0tX63pMZz8k100vBnnovuhOBf9EdbXH3+YF8JWkwqhkOtrIdLc6EJnBcT0HonvBeJ9xlJsOPPitKeVXMAlOxOi7AFsR2bAL0FEjnZkplz1TXr3Sie+aYHaXmz7SFfAD4tw1Th/hjYAN9B1qfImtuVed0PQn5HQbYOrB9lyDfDXKwl+7qegayThVD28siqdVItSVOuO4Kara8vZkLmwLGEgXN1OQAxof9Ogc/eYjRwZomwWNhtyCWJVOSsanSBWyqMbImtQoegU4VKaPRb9Ny3WRhYsadJuz0LqJ2CJEa8zM8+wCup7qHNYox+Woc6cUPRCd1xCzws+wJAEqE2pCpauUYNUAqFSUIc5rM6hLv2EGeRzKD+kSZZnc42hQq6sQ0y4QjMUdMmQYFGHU5zLktIWQhtWIW29mvsZnbBlMcieY22IOsc1impsbQVQu63Mt5fSTKyCesKp7Be+NtkCbnhvxH4DmQBBq3U6r4yruPabHZAl6OAAWdv9MMgtV4LFO2JU1IXFk3KjnlKxfGydk3cGLB1TdxQQ4pzxE/YNWgjpzyW7SzZkFaeBcjY1bWXxyiBc/SxONPyAYw7VQYmVQWQhkR7SBU8+jbMsXqqALKWQi+An0taEWo86Dh47bi/HO7kdZ+w5hCmqvYlXzASLfT7BdlhKRC+lIR5DALKdgvpu2VqvgAdmcZLQWfYKa69DCGKmc6SQz9lESSp8sMmYVBVW38QreeoLszopppa/aytkk7LZEPSlwPbE720kNxRN1IyFP6efRU/jy8ggIfUvKEt6uc6MzfcfXrGVX62qbRTwX3oer8M5M6ur7Kq9vZKSKB6uaf5zomwWxUDPKmpgvolX9gyeonwbobHeo+7tbWUldGCgytqAR+vuLFJB5LVY9ZM5TbMkvjYtwvnPvk0tE/WAAb8C9tuQ9Dy+ZGOs6YlQulam1JT6FziPNT2QQpe8CcTrvUHXOuTHUzlrdhClNMkez3/rz2gknmim4S8ympzSsyAyxAUVVqaBqwsHjDIMMqnd2njzyB6WLp2IXj7N7x2Y4fUqxyy1Culv4pUnOQlFVWLDjnACUpudIMMilV2yU1l+VCyyV2z49navUqF1oyQhTG1vi9IgLrIq7/XQT9MvMAwC71CrfjDFtsoD3NacODbaFsUUHLJNWjNrYiHlulm3txIUPB0e0iI7qTBYi9Tm8rkllQY02AH6D89udBlgnPtpZ7ZOAbXJi6l+3NvKAZ1DmAeAF4BSdFSRsm1Nq4F+Ug/oDtQTFx6NRh/oqTkeYH3j4e9RGBXRS9PcwZjuqzWd5j8nL23fsulVRqO5+cL2Lcu8oeGkeSMj2Qe8qwndWBu9bDgjRs57MISiAuhLckHYzQafzD4xVMt77dv4XLyefWIcwVPjNL7SAp6pgHEY+qtUX+NrBTC3cdeCvlFB0WuBFuyJCpbEqznSCRrAZwogGiVoob5RoOC9qwX6lQoUrdaZFuqPFKjPmWGaFi6gCqD0Q6cBTAqA4DlAC7ZQweD0LYIZe0fqoC9UaO4isw72UoX90p8HsRbsqQrG8KYW7nEBjvue0EI+L0AilamF+0yFe8Mcl2rAflMCS7VQf1iEqlm8rAgU1G6HpAAp3TdoYecF2NhP9VVeFMHQzYZ+4VTAX63ATFILd5QJbhjKbhRGFEk8EO4jPh04FkpG7chMQDSqvvZ2Q4Tqb3udUhMNsLyyaqLke5kZ43Zl1obMq3poiqgEzHI2ZOHdoH1FGBaeojOTktAGVu7h2lywgZ57C7IC3mrXIksc08oiF6iX0rXIKaZcWOS6vsYlsODgx6mo+ppVfeZdk0usqW+Rt1jTpUXe1df0VlTwjlXw2ntHjrCCoUWusIIjizytr+BKVPCUVfDGe0o+AfZBzyIvsfwnFnmMSzuwyAtMeWyRr7wb5AJNqvpy7D1zWLHpRA6MR1kzkXcz8yPmVAYe1ILlkEk7ksCMTcuMCAWylzs81LR2UyqQsQKrJF6udL27Aa4knDjpo4e7vDGegnBPW9uGvKqfQLiJ0Q+S8cmc3zlfsRl9xYb6xHtFngO3vmeRL3D+nlvkt5DQt8iXmPBbi3xW38IXYrd8KdbqM1bzM+8z8i1U5Frkc6zoW4t8Wl/R56L8p6z8N96n5DeMe/xrLP4bi/yyvvivRfFfsuK/8n5Jfg5bZWSRX2D5n1vkD+vL/0KU/0NW/o+8PyR/jCK+rkUoxRr+2CIZ9W5AOKpbvFUSnyX+EpicM+Q3R55YpsMPR1xRem/qKgKNThLR+tFTmm+UUNztGWUzEvFjEVAvosSnOCt9i6RsVnxqkbih7pSKqY15RQn1YkpCqGjoWGTN6gmpRWb108uVIqLdjnBQWQ7Chd1wiql0r4bmE+yDcEm1+BYEJqxh8GHXcM3XkDU0icg9FtPHxZw3TPhaWcwlI6tmfCnnfHoW1JtTcs6WcmiRFVuCc2qRZUPNK7mUS17RBfWWlJziUroWuWb1nFKLnDXUcy3rOeP1XFLvjJK32KGBA6oeWNFbapHXLNG1yBFLfE0tctVQ+zsqcNKRbOeKt/OUeleUvIEqe2OLfMJqfEMt8rKhxk9kPS95PY+p95KSF6xrI4t8xSp6QS3yatse3n77ZML3mBCQ5CzrKkc5v51I4Ilr6QNu1q/uj3AC3KNPamaU7cxUEsiv+OZ8wifgOfWeUPIFzi2QMb9lc/sFtciXDYv0W7lIX/KKPqPel5Q8YxX1LfItq+gZtcjnuGOHFvmUpX1OLfJNQ+Xfyj31qWzmG97Mb6j3DSW/xmZGBxb5Javy19Qiv8LEcc8iP2eJv6IW+UVDO7+U7fxctvML3s4fUu8XlPwR9nxkkT9mNf4RtQjNsJmRRbIME2lmkSirb+aPZTNZJpoRGC/IvCgjPlZ50LVIyqr0M4vEDVWmsqKYV5RkXpyRkFXUt8iaVRRmFpk1VLSWFc14RfPMm2VkwSoaWuScVbTILLLKvvdh+32fn/PsPc/PMms6Pxl7Rq0yjpD5XF1k3jIjp2yuDixyzebqNLPIWcOkX8tJP+MVXWbeWUbeZuhwoGeRd6yit5lFXjdU9E5W9JpXdASWROQqY2K/pzVl2ZqVDcYfOYqdu8w8dk6m6sfkZnPIOfdXmX1qIXV8ldk+yaxcbwtV532LPdD84+jEOmQziV6aDXKT0uwld/Wp9kxfMzO+Yo+TTPXQ+TTTq5CNgI4sKr+wmm0/TYOzqFGtcGQdBmZgv94P7OfEYOUMcsNKTiJz1LOareFKWjUaZwC00XmDVJPd6r1BL+/tc3UmYec05HqRI6abDGLm0CvMh8LR3Atvb6tWJYyrfbMh8M4ikcdNny0SeIZ/OpvTxdl58Nt34TKKV98kaWbk/qCjE29EgtzuwF7EyTN/dm4W7eDA1BGZJqM9LwQ1FWodRyfSxTNqQmN6Zln2b+Mggrr2vGBjFR1y5M6N4EWAJh3ljU7WnkNmnm8vyNxL7cVh+Gh9aIliC3LuJbk3heM1WHOQlTebBua5VBCbmeeWNYEUsvRWouILr3u4fHRxaM2ZXu45WXir4wtuDxIdL0688+PFSY7xNpOwcS9yU9NBly3lyN1iRFPwGBGzihISeoGZAbfFN0PeVYvMvNSMydoCWRptt6M9L2Jzd7h+NDu0goVpJl54PIPO73mJJR0MoGqvBJwxf4wmvb2dwaEPrXY7PJ6deJ6X+3W8vZ3d3gqHNHu03QaB+65WJ/4VSZsNUFSHlEwplT4ESxS6n5GuNUn1XsOsm61H6r3Y5ND/Qa+klzUYF/hhqLilsMFAPQMNBJweMxCAp/QC8+cqwrsBVtbEeFJkvys6XiHT8VoIHa8F0B7L4CqI0snxzPZPSBCd0yTIHmdZkqIGA9MHYYMouSpjGhCMRGaqpLwjVfn18clmQzCIZY2mBzZgbMg7SlfoKVeqjKBY+WXEuL0yFSiNl9wpE0+r1chgznXpnKlcnEPoEPGhKmtE9BK1f5keBnaIBCjT+iz6KqCXsK++okkWwOG9hnfsuc+8P0Hpt0GqTjtkKx6YJgZj3xb0Ni7PgwxVX6ti7OMTPHjMMiz3CmVjLRq/T7x6yyLVMnMuu7BIUYWglMvvfU1Wsa3HYWgaPzMsEoEMG9xAEV9QeOB/RGDKlCSM6WId7pmRZ6ZegqYPpgXmgMDJgApYs6HHJ/yQdz+0NrnzEnBXRXyPbhZBBFOPbk72onY7sdkK579MSwIFCzPgF6m/kTYmdAOr9pR7pQ7jTKdrweYAfcnPAUbaL2HhZ8tVdr2lKBaiAIhFPqX+nCa7lDlHyFzHUKs/mK/UIdcBmfnhTMioqrvVtCbmdm9rmZ3S7FN2OkwUZcKFW6OHkG1tEtQ1ul1gssqDVdGU5Ip7XLTBHwJ8D57RDEuxnoszbEGQgAyMDbgDuZRmr3kedhrU/rimgTieXL1FQRngvVCI+3ONjD2QFVzoeyrG4FGyp/oYa7eVA8NOp96DXBE3iWolupOjgaFgCiOA0e5MURKSK1TcEOJgS/VFjuU8ypRBxBTVbCM4y+bOFL91e6t+HlZbF6vl0YKCZsoldsUVJdx8pJAqapLzjl+FuwDc6DTtFIyd0LDtM1UnaWMxtZnS9Ncdu4ZqhcuEqZmpW+721gCjrUyOqN3OxCrB1B13gQosnEFMm2iP5YagctkrDBxW0I5QJ5NXrzkpara1IZl/qqkqWGB7XrE2VY+qfIIc63BbUxj4l85frrM0mGsOmrwUcw1MOwM/lZn1sNtuV1vcELH+3+cV224XtnSwEHVEXu6tyzNrzEN90zKpJQytzTW8T/nWR6LHmlB4C2ndl4r9kBf2reLetyaFT6AY6WXrS3r27GplGqaxH+wblkGMsyB/EWbTSLocT4nx8PTRJ87DB6ePDGsSARHSiLybFKX1m151YklrKItyOpzbJ/E6AhFHbmyFbrXqyS4vs7N49cjrwllh3NeHnsn5PUEU0eRTCsxYRXNK/OA8HnuGbTEwoPstoDqvHydJwRu33EIG9NXAJXcmzFN9YQ+KbYKJLD4z37iFnb+fHUZe9CiHYhuy40zLSZOIRF70sDvtTiKBKiUCkMDINWEPiq0km2nIjI4I4A4vkEBD2eWQQUaXkyjOTFDimwdo0jG3DHh4oxMsn2MCLtUPbBb8+U28IrH6tR8UprzjFz4PfTteLIDKiFcP06lSzlNyJsrvR+BwwawB7BQb2/dLy82ib5c0MTdkwf0pV9BRzd2i+MkVyFzvkFUQ5ryoyXXnCrSIqdcM3U4byM0gHJ8KiqfBGW2de9y8qN47LiANZJUWrogmfqwpT6A/nz+7oMxskEY0AfONYPaOEwLFG8Ei/DBXCyUUTINEqUYMZllC0fAp0yi+f6dZYIf791tb7n5d390gcDTexSAwMtEkzhwMi4xPt2vZC9U2cDvTZQevWEV/jjyaGEmEEyvfzFDJBx3grz1py8lLhw+7t7fhI289RT+J7FaZ4OsRfCYdxXP6ODNDi6QPB4PewfD2Nn00GPadg9vbcN/xPG8NQVhK0PuOZT0cDPu97u1t/Ggw6rv9KZ0mwgtTaE3SCXwzn3ghCfd71iTuYIl9M+1gSw8fOl1rfzgY9IfWZneG62AgfQUzGzwMKwC6kmC0bMakYqBp1rhzsNTVa1BV8gIzJjdwT0+Yt+GNRZDLpfpOsHbxb+zwSDm9ftXPsY77FdB0UmSi+SZV+P4k9lIzAxZXLJiiYNGboH8cdJAceTF6yCEZXnA7eSdW/AspvQza7aB89+/iLajPnXaUHQXEFWcOVVv5M5op7uy0Jr+UuRJCL2bWlB6nJ1qXr6oLE+Z9I3fqq+ZNC1953yaFIqx703jCrO523bpj4UNhWDSud4bF7QB+7BgDkLvJqHjh437s4oILO/7rnZfpnNlJV3bCqd273Knd/r7kFt/eRo885c3FW+I6Kr7pWNaEuagA5wK+2SWRNcm9hrAkkENYE/h1HOHHCZwZAWURsBPnrwNhM04C6fkiUBxjBIr/kJ2kQNbN/V3ycl933BffHt3U+UpArH+AcbQOUMHyYFgRfTFhze57wip49XO6fEtw8dHA5a5tDiz7l89+g8xrh7u36buMew0YcMHjcJ2jfTrqSbpMT9J0HaYmecC0JM0DB5QkGTI6427FLrn44y2P0/KOI9jXHOGCbuNBD1UbTRB4P2U47Q0XgH3iXdkL8tJ7ai/IY+/IXpAXnnDTR77yAvsXr19+QV55X7XbX9ns1RYsrskT79w03p4H8zmNDIs8h88sfiW8tBqguFfjpZf81puZ3MtQh8f2vjZAsU8mw/b5DD7jVSdPeVZBO+Rbne+lF+RzL7D/kAGTT729z29v9z7PSxW/7EXA7UTIN17abq9rvMS9Nl9uieXyEo8cg2D7coSu4TaWZftl8VuObz8xn5HMOgwgJC7qej07zk7ISw5EAuaq6Vm7/RIASWBtJi/JbzRuF78E0eBr80U+tvwNDLiFkmxDfu19q3G29cIWl67GLVUFusbtlopmX2zIL+viQlIPh/NL8zM2wmsWDugtDwd0jeGAzC9JZk3NyM6dbk9N36TkidVu0+MnJ8fZCYbvwV8YgSHyXpsRUb10vzO7aPtsWRNe9vYWJvYJeWc65AbJCFFB1yLf8I5aEzH7G/KrEt65Lt7igXdhZt4lEnK+1yXw+ONUXPrIP7R+iRd5cOxrLnLyc71oTvEA89qk1uRX5mucIWtDfqFZ9i/UmKjeW5PCLIpm9jCdTTjMKZhB7vnmZ4RaIGndM7Pb2z2fF7bw95f4g6fBbMMPmCUK7E7oxR9W/VNT77K4irhneX0Z1vcZySzBIfgEC4o+RgW4whLf3ha2AC4TcG3+qDARYj0yEnmPTeiJRQLv+ISkXvcwEgyv9NDCVrzoOAVh7e1t5nlP8N8QTIyZtEguULAhf1zbCu5hEniPzWj62YS1mEKLMUThEy3GhxYbmBccx6zJCOf/GY41ZU1+eZzl2yLdHH57e2u+8KqGioXzVfU5zXB2rhyhEEN70t5uUeVtdxW1le4Jf2bAUqoeQfNdlLHt9hnBUyo3Cft5/OSESEtbtmfwaH7DACmeu8jKYx+k7fan7fY35jNCq45sUwqKsRb5DZouJCpmI9KlkVH3SLDfvoMDfmUvvD8kT+2F90t4940sewHXnPdHBDU+7IX3C4I6IPbC+2OSoq/QnouhK54RQ3eBGeQXuMVX9kL36PuNeY5ekC0Sm7H98/3Y/vV+bD//2d635Iat0eTFJschlHoGatiztSVBeoSaE69XCfXneGEK3EwwsB3hzEySUj+ZnZN0RWcBTQkqjhDlEiaK1yeyjkCIB9WlBTdCGfW6h1RQjY8yemidm5QeZxT2a47pqPfGPGexOy0SQKFIFgroobU0I3ocsEIw7tdizHxfGgTOj+7e8M3fErrvGYY1/e0xPZnAP94LXPJ39Pp5qRCw2369LapLi91Ye0Y+AAxG/FsrWJi/BXTv5b57sg1Zp/Q1xXCyylb6FNlhkBWAILuU5Wz4AucDlRpQjDs0+TkphcL7Jak8EX9Fig6SnlLmuSxOJn9INL6TJn9EtM67J3+8schX7XbeI3Pv29vbtUY56YUpI+EdwyPoxNjzXpkMuxs3G/y6QevH/Fu6QIW71CIGEIWgnCbowUkNngQsTE+I7zmHFbzjw0MXEWCOf3zcP8HCjDzAmc4JMc9MQJU5Sx0usF8rwRBOMd/MqneShjSMUM2yEEUcbiWy92szj5AKKAfa9jLyivsc/grIro1FFAx0/PwEFcBcq4CXnqswMogCmZsv5EnALz87Jwb8ayAumZuc0uZTu9dt5ED1BpZpLGnmc8f9zpi/QfizFF2OJ143118L0mdXGY3S4DRUPdgp8VDIuiG8YWhK2pteABeBVRZHqXmDvo+tgmmZdROblASCDr4JJsZLY7+1v5+QS+aruGxjxk62ny9sleqc0kk17h2dGq+NifHKsPYp8uJTaNhi1YVKdc8NzBaesI1nxuEMkIzUvwtO7ACs2qq9qqlyr1uocc+pVni5UV2S5jhv3W6v7C+ePXvabkOF7TZvo92eoVbWhqzUaMa/fPabSUAAHvRrFn6a/ZJeT+aACn5N/XeTBURlSyj9lk7OG/lDGpXHRk/u3HGhby/wUErdvxhfuKg0mNoLsgbOlTjX60MrFDEoYy9heoFAN+BZj/M3yT1cOyOfAeQC8FfHTJJOSYNtfpqlqiBQAiRV44WQWJFwalWq2+16t3bT+iwulbMmxyeql81CmKIW7P1ymKJWzBmxoNQrp0NPd8TttnHMut9izjNPDM9L+VJY0wTeEn5DiAa5SYZFNq3D9WKBu5DHJWPRYdd6P3/5NabEh11PNM+GlD0bYv5sCNUwsGuEKwaBTTDNknO2F9iLPOIpAePUXTUYkb9iGn56Hc0+a2T8c8j4NKXJBZKANWAD9K0AIgaVtXQw3sk/6+CgyROh5nQiR1mvOJ010UdIT56GVFGbDsxMbBRr27mUnhSLXkVT8AzML3I8iNVbRF70G9OCPVVcI2XlYRmVdd80ISkSkeRQ40D7V7lSAtfdoFPjizVEBy3fIiaoQGeepHKIb1nTaJJOAzOzJoKw8zwzQQVhS+t2m00gpVNlGibJ7gIsp+vsJsFyur1cgVZq7DeEgxBrXNbPZdmbnZX8f8bCKSiUbonAZYTHxvr96Qs3uHFk/sQZanJ6OmVg7kZim6dHoQUc3lsLuMFXXEavMmNDVn6aXsbJ/Et6Qf1Q6vCe+yl6lqJJjZc4XejyPKIxjOtTWcWm3oujTrGXRxudSGeJkFJQ4JVuAUXK7W25dRUeIhnxYX4VIMGJWsGqCjDMhp9QX0bZZa7nZbJWSTiJ4+wo9NOUakLFH+cBn7lVIpHBf8kNGCiKQNZcVU58osM7YV7MsvgX5qCe3SK4MiZSk1JEJj7ZEOzllj7lIZ/LPeKRs3mr/Auq5X5Av8TAUzXcjeJOur1Ve357W2p5Q9SZqasSYLiGhdqDKfowgswUvbq18k/uCm5v1+IcXoKXqzakO8S87581+PbMoZQI0Dr/nTt65tzdS6je/+dmQ/INUqvgXAiRneMEfljK4y2sUPlkTQ16TTvxYmFM4JfBtXaZF+tqLVv07gpfQgfLiBDJ7VZGRm+0RPFursG9VaH4PgrSWuVjrcKxojScxWdnIS3MYRCWolCX1fwqc+7t6dOlUzEWvLlmvfAimBhi0YVbvBoFWlWbuhjam7nkuL9SbqbMN1cnZRfBBtVtfn9Xt/5KRvdPqqvVY35zsnZOyKrk81j8Vn0jM/9qryspiJlF0mmcnecGMvm1wgO81x05KDYtR4THaPDqx77RMfYlWgPHluL7jGbPvlkHF35IowwaEuqQAGhNvkfN8L0hHKz+mpi9K/hPFcHsN/Li17hEZD6TVQMcPeZAOJpf4YKz3DF4ZIsC+zUvorYDL7Yb6mVq2dOE+u/gubdnCjz00HMkLwcuDThHHWOfHjsn6Efk8ijfBDVzkW+T29tSwpNrNifllCpi0C4YJHZ67urKwAUKwVFJA2R/KCB7VwaJGiDdsYDsXxmHhasv+Fa99JY+PGy4GqpIXdJ5sF6K5FYmM0I49zI9KnukpgV3rJodXMA7peg37L7V3r7KRapersXrt+Bvu8EJN7txBQC9moU+q7uTJYEfnRUByxWq8MVqQfu5s15JSJkgu3eRxJEgZQQQtMfTinCqH1wOhklFKNSsLkJhEoOCm11kwm+Zym7+PKeThn56zvOXNFoXK575Cc3UihFkvSoBwFg1O6I5gHVCozlNqucFueyo8l7wL0Aij9pvUxou7Lez29tMWvqaRrryIxQu+FkwYyjcCPCeQNeak2MqLllC8RicbMhxZBoBiF4UCDhFBH/BpmW/ciRBqK1cEicb68RiVGcw+xKH8hzegCc/1KDB20JpzLM4yhKQnLFBUVt5AG3Icf5aQksDfEFFOYFkvz01b5Lc3pIU55OliZrVdwzx8a3LbuN8qtX3H0suvPmW/hUn+Kgtf2/IPF6+Ul/MVFIfQMNMbrDhCbU5PUNAxxo/QTWb6aLjJypkbzYkH94n2E1Q77AmkZlPhjryPLEw+Dy5afw/1IjUniuDgnFcmEYLQxjhWykyjdMOOwilw8EfT6LnADNhhRj5xH7jeUCyiX3DKdhsrAm131Kz2J58R+7R0kMT7AWVZ9eUFZ409Y27BGJzfcMe1cHsHfJRJ+X6N6VBFHOneYL6WlJ79NkOoyZ8b5cqh65BoHE/uTYKdTLa0TA2JPKz4IK+jCBIQ6CSB4gX6p4XZsbUpNUpllsK4ibk/J92W7y3Cqea3e+luT2n4ao1Y+UK8xtEF2x2jMketQNmzkDnmw05Zl34Omrx/zP2ASOZ1Fbejda+0XqQ58ieWvu8oGGdiG1zQpwfG95UMVWrhERLnCAq2UAbdoecdmrxJ9+XfCvBH8KPyUQeGMKZOIis8RdRDyNeYx3YnIa6O7WYpohwDbjoGYIRKGTBFrWIYNQd+o5er1eFHYoEtXG6zrI4MoAgtzDI5zsT/YaAiQAxaDozSG9EMEkKWoCDfiilv9xoHJGGajkKChtAXUyO79tq5p8a5EDXKLVzk0w4R+TeA2LHw+nvPCTVoPS9mlyvDNIf79yetLmDwC3Ge7TH/DG73fdpEctawHWEMPhh9VojINY49SvILrdH3hB6tYLpQl9wIh2YYep50t9tcHXLOBcGueGnjLEHFv6cGvxksoM+DxKK2y2dHHMg8H9ukMS//AI/LzosQQwjD09jUtW2EGI9UFt1YsC/cw8FVmFcoqJWu90ylYpaj1rd1u1tS62KfytVGZsTgthkLv1nF5CWSO4ASV7AVvGKRnTOwnjsNRmEFiZKX7kwecSLoNDB6ZaSQUaXrNjbzDSYbwbDOiE9Lf3wNuSTrdwBmRIpPDIN/3utpnZlYI7f0etJQBrGoE4tt0g1JhlIB4WBKkOzpfsdpXYFY2504c+mUXGiMWUTxJ9PBvOGfxMzE/qMoEEsuFKb5A+dnKJkVrtvXnw+4VdvbnOJSr2bDc74pnCCUNyp7mxOUMjNuMvSthTD1nyZ0W1HYZXh54l1svu1/wFdBeWiPw0jMXedX5TQceYfF9QJsdpz3kPOZSTMjsUHgR+7P796z9KwvhlNlkHkZ7kUryAR5LMsMxP6zTpIlAQckYbLmSVr3q8ip3T33qlx5xZ+mH6o6px7SRxLEZvYL94TvWVFQUyAXjwa3ZJo5QWb+8ghfjBeR+ifUqDr8WrgH4VTetqZ8Z3dmsktLrkcuSxRHt4JtcXvzYkgWuWmyzNJ5p8ybLSXJ7bbXYb9BCH3fYmtKkS68mfUIP3ezsQKk4Hg5DBLY5Oh3pzZobtIkJop3CQ8RVwlhZ1XuE8KOUY+i3hMjHwZdLMqTzO1xU92oCnTMDDgFHewD7BU8kwTAw9knpOfT5XnUMQv1C58y6EpOIxgd+l8UlChM0vDt+DaCszKpKg1QfQ3uAW+0YDJYVj88jz3I1UAKsIke+WigZdxIRQ6YeCdnSo1FqYCJBHFgUTcpiT1isOOPRxRRFLrMK83RscppU6APjBzVnicnljWJH7UcbRQTBWvS2Lp3VCkxWBWzV0qlMv5pad/DYcTu5hzpGCv7VSMvW87DHfIm1vQH/zC3vmqLob2AU0/+NP7MKF9fs9X/xOGmT4uAVC53mtv88a4koIN9TfiYpU4ptpA8bAW6rNKqgMFXzEFvON1i25/pKQsB/pRXu4NPH12fb3P7d/iF3BOBChIE3YTHtL/gZMGJSRYRKM/PqJBIRC0Aobf43VegPg+l/fenrxm/8be0t/vsnTZZTn4OJcl0VyMMkocvxLRKX19KHI/CpZ+QwxiwY6rDff7EuqXXp1ynFxxhs+VowDeo6quAZOgaGJfI+ie8qGLdw3tGEQBKkTFVtKnBoJORPDsj3wn8GWq5d8JCNBsOAPtRYVDJaROOdqTQIL3RFEAgQNne3xH/iu15Y743kxYXLIi0w6SAFHqh1pgVWpI2ntxoEondYQnFZxwfDyyltntoRa7HZk+9x3SY2rsI6bG3mMuryEO1gzB1sxtiNvPnV6j3xDwgr3ChHPuOcRFzyF2ZC6Z6xAILHONCafMd0gfXYfYkXnGfIc4APEOU97qqGw1kqSiQW8G4Og+xXBE74Qa/Tvbt3jaSqSt8rQLkXaRp12LtOs87VKkXeZpM5E2y9OKTryDXIM/3qrBD6Mic58b1+7kwLtE04a5at1TXo811SROjo3Xa4MYLwxivIEfv4Yf5wYxnhvEeG2cYIj8KDv/IF15IWsqdCZPnhwbv/CjNbwxiPGcnib85wuwKTeI8XiVBCF+Q+ov1hHFPyF8PV6frdMMek1XGUVhNTFezrKY/foivhCJT+mM/WROz5MUpuPl4teUvuMj5C+r2iFWtI+V0TwvVDitzUEd5CAKgyi/95ZBBGvPNsDSv8o/uFw1T0Br+/M4nMuI94TOgwy9FMhrtPzsW0fMSSGkQi0pX8ti+nVamIxty62M8FfaejYboibnzcL1+ByV73IbE/mKrTRI6+ynC/taqdGqdrCQb0qH1ijTeL4Ow99QPzGtfeOBsc8EHbg3TWvfYYmYBpWYatybFqZklp3Fn8cznw1SqJwz0oS+8pN0t0HyLbZ1nKzGqTbVpNZEdgz+sVeQzgzpsD9HCfWzeHuHdu8Pr3GqTzbzDiFOOQ1C+gUS3TsbF5UqZnj/hVLTZpNH/xJn4gIvBLbfgmgezKAzaQ0tOY+zVEdLKg7PEENzTV3lUPKUuTpgvkNuIFE6+M4rUc80xjK6QUw7UfcduaZ+Miltz40ucgEEOMhnBe30FL5LGKTZywUUL1gESXcfbGwc40zVj2LTExHUw6wOtwhJyjMEQ7RhONZ+X/iM4/huqn6UGnQOuhDK8PgEHnaHwUOPHgb7+1bEDLeD/Bja8BBPMOY+CVLEtTiJtQYvvL12Oz/BdV3WDwaXy3roFctrh0HKWXx9sa+f+1u76l99iK4+KndVt8SknKV2lZ22Op6deqoh5sPc9iNm68KfXIWTULUS0Z8i3YA8flayylnJymdFb6ej8cksHP0WU0u+zGsYeerA3jsEgXrHqy1It/01k7AdYVgbYmi3hFFr0sT4LR0GRaiuBmhqawUIRIpREtYrdb4q49XMKPCAjkocoIJxkT1X7z4IQrEXpF/4X5iZNa3Wl03MaiI+uCpLVQxwoBbAFQNSomobxg8Flb2gSi9U+oM5/CRzOksQoVfQACvF6TgREkO7lI+60/rMjudMGsp6jlOPrztg4wKk6vfv40PHaejk/pZOduv7uM/6yNbjN7/5zW9evHj6VLNVJP6jInKW3CbST3wJHQZeViAEic8SSlQgWkSZwUOnOzW6xsQwrP2Apflqms89sRhGvqUZwnzFLu5qn4sGcYea3TzN0boMKvCg8+CMGA8Mi++x36cR3WBcjpeVG8DrHu1S2azw6m1U+CjItGLbl9KuXOGDH6Xymwf1pwrPotKjS5KMBZrwAhAzUsjVg30MAowsXgklcvHJ9N2VuLOYcJJHygD2tqR+X8RzP6xnfG4Pl1WIg5XHyQJCkM9wmQzcwVhdzEZZSlM45ETqqTF9+TySAnxjLptknsU+MN3Hfop03u3b2zIYm6IOC8osgF/k84Z27mpCM02CIO32ntKM+JLd3iqtS5XrSBv3Sb3+2Dg77BYsX4Csps8yuqxvRY3XU7hSc/ol75DVROYUlKGD9LPo19XAZpR7vtHGHntBo3XBvVQJjvN2CyBNscywvvp4ZpC9NaZZqotpBh6gfCWmWVwT04wPIWanR/a7HNMs/R4xzdLNZlMzDWK6eGi1ak7N4NfQpxkMfq4bPIS+hMGHMPi1Zy6889Lg1+rgF/WDn0FD8/Lg1+32uRz8uXbwMz74uQzoBltuW0ghdh4FDlD3Zx5bqBoNDKjxqmCmbCefkyXFw16ub1qqvfA5abSGzwvlvzFc1b0jcrxfQI73jKTx3oE0PqZUKtdT15sfUr6ZFAswVguzvBMyKL3Ksczm4qYkDulE6A9sdpJu3UeQlRsS0OLdNv0AhgW1quhFTQ5/9u4MbYIMaXJ38gMaQhQ0OUyq0AM0P6rW7a1Y1sKozD1RFo0fRFm0cpBlLfhkhSvGDi/QqKHB4OGepgs6eeCPTyddRrZBu4P3pMD9Bgo8pSsfncPVyyGYjbP8XPlzsFMLaZqqzqc+D6J3Ot8YfjQ7j5NaOlZHuH6y8hOMNVa8Dgr0rNILXkpJKdCuagy/CyW81IWqByaaFEQaSHsyumzodtEkJHftBPNAtlHjv+feE3RvEQbRO2Oi9gtGlR4Jy1kNA9Nsmn+5VUrrUfCEsZVyluoYsh/tdrHZvJSZD7VIM7MLr+7WRpeqao2foGmL8tTilA8DmrOr2LQIvMy5K/TfxOtWeh6vw3nrMvFXrVP1JLbiqOXnScYPdMvSfAng1jlPajHdPLgI5jRh9wRbGG6aBtsgv7Kk8Xq+1ws3Z74UGz3SnHAzrO3WUtQuYIL7NfMD4+Syi0Cn12/kkTwPaDjf7hWwyUcRKiAKdgZ+gNty/r3k3sBEIYbWRVGgCVYKvsbvF+swC/ScE16JMFtWeC9J8G0cZYpTQX8+j6O0Qd+r6iSpSdu46iRQ+DnDRD5MFtr8c5gDdMWDrN63ICWi4fw9mCTgx6PAJ2ly48cns7MU88eBipPKWSpiviRPRSQAw0NpdlIT3LPAwykILkWi6tGo6MvIsQqPct7vqToGY4L3AFvEjnFYcOrEG5hm6P9IOM3bCLZsRucaH3S1XpzypbRKeuE8+VD1BKVX6RYVWKUEexEnz/zZuWmW9IWrXbGoDMFR8B0Voe8oC6KntNscJgIjyDoXU0p3St/HWV5FHumD2osgzGii9JEzacR8wCvT/m0cRKbReniaPGoZiqgtu15pntrCDxzdSAygg+EdK2pB4hmq883FN4glHCjJLWPwZeoW9gqPps7PPdD+nibdTuh8PaOmqY1Ik9mZfzal+86EbizStSxCIXww1sM2aM7iE6doqmxf6dYRGLLrqHrjl8paN/oYoiwsK/LcSItfPKRls3cjadnSlYoljbylQK+AljyYtgjOSmjUeb0r6gz2KsqCH1e3F/Hpk3h+LR8WYpepvPVN1cxksyFliqboO1BseUFm8IcoIwQMnMfOKTS8ERLf4k5a+itlG+XuhyPcRJm4KKYUvG0s2OUrGhFjkFCs/xkzh4JHIjge0RTUgEWMM/TxOCi8i9JOQ7ntCLXlGTetE2ZHnh+dhvq4oVdeq3KJE1ra5qxiLDFVzE0KtfJEPo8Qo4WXeB4nOocxBacxCCjdwpRdw1iTXVsXVKY6mN9HjwpOCooTftpRtnZlQ1IFV0+pXblmDYMU/WXVkdzVRLVLeSPoZ6nYw5XO/08+d7xh0uBOoNxtK3f/9BEI9UGZUC/58u6jErQdmQlTg9Y79WZ3Q4F+jxn9Hgr6PVS9eq+36gR/GN6MRmsUydKVIh5lpjBIJxebO7mXSLKgo8YAjPe0BRTV19gC5mIw8r2d8qYrP9rmOht9dZWcaOMrYLEOw8tgDmo19/HmLVaAZ4lPUuuNG3OYGwwRRaA4Tyf3kGXeY3Z+PG4Haz1mkYrvbubSirOpdcbKqTirHGUpW4AX4gDM+9bv2UAvUY6LdPonjijNNwf3+JUfViagOC0Ej8cFKgSKZzaQDfpn+sKYZWC0x1pDPPYEU0OYhhlNeFQrocPCfM6kxNSpS+Ub2LJKFJuEMt7ipBpB1EIpj5hi4S36sGxDJ5sWUzeNJtFx9wQepsrS5o6g4IpU8OT0mB2xUr1w97HB5PeyRIOGYlFpGIRFpEVXfWU7SqaboycfKmSE0qsCMaEjKrQOkKoCih/aoeP3MJDqHTADqf5HtvuvsPL63WZ1J6mV/YYFatyRqaezBWI3vGKRwxJKRiycOqjTfRcX/zZjkwknKyriG9V+QFoO3NO2hGVqi6hEAncZKe2VtMrrxydCobw4EYeStcWV5lglPPFQavypmcfZv68DPjmUPB6S7e9v8ni54NSKTXQN9cKWod1WPmzht5V9fha9OQ/Sil6lFDowKM5bOT45LI28e5g9rFZ+mO3vF0bIco+zk8MI2PZKYCzTgBU1QMki8lBJG96ppAoGeJ2B4aPWU51CAPy8qDIu6BK+n5gaZ7udw+VqlmXQa+onKl9Nzvc91YgrfiHIJaXvnqyDsMhsYAhCTGyQK4pGJIPzjmF71URCLaYFClKe2Esf6TbgNO1oUicjXep+ShLPIaHXPQwfxofh/r7l2+soPQ8WmSlbDsraqcp0E9pJLIsk+/uHPp+4YofzwKNriH0pDsfo0NLC768ti6xlLP+WzyZPv12RGhTVc3sNWR1U5pCulevNsuibD71gf3gorPBxUpTlMX0MhElib885TPU826y43UDyF3sYp9IiMWw1HFdqEX/fG4ldFBUPHq5JNcCicmiUEfNJyw+jMl5gReejUEuZ1XNAKvs9Xx7f6x76D4Pj7EQcZn9/X8Y5hPjR6cPG7h2m4vDHnh7wOD2Rx/BNsARV5tjzPGjz2D/JU+Uc1lZj5dO6C8+yz9z39Hs/Go8EpQv6S9BP4XdwqiIX+AdPQMEwUvrWAuEVM8goGJEW82vv3O9rq9lwn24jBHTXeUXkLurUMGiZBKZoU8UDa9JHBUOvonFRDvWwYH9mEZGuuUtwhgv6r3K0Vo5+uofRQx2IOB6ROB6BpwM7jnBIirUnHI38C26m8vVVwsztdqn3KqbONWX5SEGbUczhOqobF0MLeiAVUaj4tAJ47J8cqvPLRrQHQTnlF0j2C6MR2XkKB+EDEvlifDWDEbtVjggC08cPGyDFsGIxrMRrgD6Oy2O7xr4lEkdlUhl/z7EedjcE6IOj8zilUY0dUlljRZ1MjJKr0hnyPWmJKwbAXiArphwwvEqy5TF7xfzA4SLKdi5SeLCLVdIuylE6jhx9sIoPedqKJYS8cW8vk55asw1jhjDcWui4+N3KVFncnrlHb2/3gLlQOjRZ86HJthyarLCpFP0ugZiNCXZEZWkxFMJsMub+tQJRsCtkIPl6chabZoH3qtpW6vYTmlqVkiWlps1HZaDN5SXXSeJLrrAYmhRJFb1D5GNaHhMssKKzOeWqOjr3xnlzMxoWREP5zjIzi9wgww63I/LxiocGHZ1TO7+zTrTKueQc2WZ/T+vIqs5zcqT1sl5AB9h81X18VPUtFgnPYZHOt1hU9Ry2a+v3bTR3V/b+jZ5sEWgphxr5UCUgrLSyjjXyQwZobIR/7mpBohf/y+pg92WkWmnZbSVIXnMhYKH4bpu3vHfvN0kqk+5kY+0u2/o94YiUzjj3sm7sDLsxHjj3rV4PzAHkOpYYN/Uu13dehS3TXZlkWO+iKnszWmQyViFfkFgpBbQkmTybDVHwZuGJR0vvO1p83NXPwGmnPOmIosWkcIRniPsNoQ3B8mYPkowIU/BiH4xlEEl4ToQTY+lf5YmM5tZ6ADTUaw5L4GRUSMoK4HXaiRcd6FkVXtBqxMhT87rLNfOnC7XLD3acRPUxo94SBNe1M2N3PdSrXP3sPmANQe8qXKQNwxU/utBb+S4pB5ApON3WBpIBpYu93M4kN6lg/P7cruamFA+C7z6p6klzC1HdlmGVTkTtG71Nzg4BbcI4Uwx2qmFnpGxNscFn4R3kWloFcXdBfELUgDk7BMOpRtCpRtnRO97253EUXoOli3DPpAbM2SZs+x7ivEJYqKrMjtrC/DwXzl0wZ9kbUpz9HSOmnHZKWto3Dc5cuQWIIeyHdr9fjqWuKxVKXiyv3S4l8PfMtBweZKI/Xiv/LIjQr2ALlW4g+sQMaTg6V6TDKGo9xgBW0sfM7W0+NGWb+/VNdIAQC2JY5w9D0CIvVEPdFV1L1BC0v7egRZrW79vo/f3vVhs9yQNpzVSBLXxNikExK6JMHmWNaVIpAo9WIWQc+ml1SifCfz+TOMUjUOG5VVAc2FOgwP5tLw+UckLqNh1YsP6+N1wQlWf/h9xwmtZ//xsuiN5vw3H3ER9wxzWitU4YpJkhOsYVQdmH4Fs1oWzlyqyE5dI6bKmL0fVJSjOzUoYY3NcQPvmVna6t28gJ8VwaXk9iS00N9lgsa2EE21QwalQyMq0ahk4to/oY+fCTju6/7jnnzD3TtilHqHzGFW9ypCCN23nCsx9kwuF84H8as+zqoytfCGPuX3cAV6fs6cCfsGyD5hnKxjNQlgsvH/XhUxTw3uMxxue/usZ/Q55p3+Ndhr6vy5u31iPbpnIo1AdA3WEoek8qbn01zyjGSKkSntJ+pZwiSM8IqPk4a6RsOUCNanauNgX/7fZ4YhcHbqrtLyfhLUtEW/2Ar6bqG2npX+kaloK/ZRBp84X4sPmN5fzYnlaqZy8em6/mKfU99OL6QyZQP/iR6cX1S5rzQ6ekOs8ciJsjrdJc4Ifx2XZdOXqV0WieTkLbl8awQRbSsp0r/8RNq/yGbSq+z/0U4zYX4uNtj4VTiJ8zi6NFkCzf0KtMX7SqI7ZWvNrioI/yOqZNmRPj5S9Ro96PZjT8fk3KKqYNeROD/YZGYbJgGytO1VZrbrrAeiGUB+t6cQOnIY74gHbwQ7zhGsov6yJB8MlvCAaxSuLlKpMODHAE3POP7L7w8GsYQIOrjtW4S7ggjnIDm4JFA+yoJ9dlE8n0MkBBhjBStm5mfkqZj7RFbEyEyWTE0B6QTYcCIl3PZujRgQMhOurMgmQW0hzq0k8iNC3gUH5IkyzPngMqSoq5sg4xe8ojBxhA5/ElW+w6rUa2614ydW4pVBdPKwiPpKrq8aWp8dRU4ycL10SIXyvuU3N8XLAPrixSpcYqCLvZG5w8SQV1tRpGA5lo84tl5WZma802myV8KMXMNXGJvql6rFKoG5IyLax4nZV8TikeJrg4amZTy4QrLLQ2FnEGXWsjHEO9iNdR1tSkftDSQRQIRmx/taLR/Og8COeyGUtrOVs/oC4noYQuj3LohJaUUKdIaWaWYIghgAyIO0sanXLJ011cM0QgpjUx2PY1UEOCYxW47vN9LwsySBbGTZaXmWzBi7kbnYXnh7tnR3jPmlq7M/QfJbXXmMeX3OuOuEsnxxw552Zi3JGRwKmrJD5L/CWgo5ksz2rlRTnFz7/Q6klro5ZboB0Mu3hvYB+P/CRXddsW4ufbOF524jXwLmZ+xPESb4wptfGaTuqvm2ODpjN/RQ1iXBnEiJkzM4MIjHXCbiO17i2XUTpL4jDc9c711XgXcz98jaWn+uSJMQuDlbHZ4oUUgYjxjtJV0cuo193UX4JFr3Y+d7gF2Ma/oHPWgTfxijnJiOjlr3FtKyEvsDSu+zT/uW+sroxJ/l00+FOvjGrHjFO2iKUm5IpPS59bV3RyfDIplmEX2x/d8067EtcZN6nzazx/SkSn9Q/Kgc79aB5SNstmwbWRmlP2kFHrx49l40aQVoBYg7gUAeOWXB1KxC5+cGkkU28AH4C2P5+bBmM3rlZ0bliTHQoxR4LFcpZUDS3uLk+TNtWk1bebChBSMzq8tqpDimJWUh1TCVQZSA5dbKYwvcV60uw6pHYWrzxw/6wZEx4S63D7yHSTROoay92Xl2aak3SzEjkndq1+01PrYVf4yBRoEWiA8NrEhvzkDHuRFumbXQgc6XALg5zpIqaxA2agMZ4gpeR1JHxnvCedFFToJCageJXQNK0/0+12b4TEAhcTCDtZnBhTYKIP6/PzHb1er7gPTtFDq5ao00xUPQXHJz3UU3CVqsreUbuT0uToUFu9W9ItGK3ZM6l2Vsh7oqfDfHN+GGR0eD+csgMOoI0n/ofTKd01IF/uxbNG4w99erdyh4S1XjYRsKP4KK0RQ1J5DAUNYFWjW2vql92Wbjyl9hdrWiSDUFYlXIFRdx3Syc3Sv2LUETq6+DWneI6pLUntaWQqX9ClM5PxbTP/bGLIHENhflOGTYFZqORTG+lvRIqCz44KjbyX+Ww3+NrgsMLFRpnbXLAGRlIJahUiYt3qYE9hMUNQISlxoDWuc/XrdsVWLNfOFD8+pgbWB9vwc+Sjtcr7vqxS8nEOAV9GP5m3qucBR4f81Gmtjk5eAero8OO8agDDCqU6J9+ZmGhZJ/nqF7teo66qVFvU3wxS1g5ziLrHG0Dl/UVIr7hS52ezUhTRYuV0Hvisoxx22gDI9Eca5P7cwJ7ayhdjF1ZUALjbHvjD1AH2WCZjNhvyvHElAO2M1fZTWV2+Ug0YY5k75Sn6KRIMliY3Uw2xY9WI4wV51vv412CstoIcjyVJjxq8iUIneJqyYwSnlFZ5hLoQ2KwNGYB8vZp8UAUXKthLqMaCvS2p33C9Pbwl0iA6u701Ra+8ilbfhuQTrXDVUPpU2jpap06tfIJKZ7cyWYVzfGKdVBrQC0WVwwwArBmFJafeRbiqKm+uHKSeJ27DlhzO2mzTSJBeOHJ5yzYnHCWNg2LXVc5hTd9V+4WCd1teePcu51Kj2j6fWCf3vnmrwkiXWfe6vY8sjORM0qGWS/o5d8VUwyfVMj8BY/lBRJMK85T49ukJhBxch+Gr3Nfh+8cyrzA5K1U59+RV3psXeC9mlyrnqciMigy4drtQmH/uLhzZhRXxN4W/0MRQ+JExEtiMyCMwFTMktjzMURFEz20os9z0QLvxJKrr1P39hELRz97fuFcIV8HpQGiM0L+uPkSkC7vOyj9DdbR8getpZFGt/oUibstdXiKiJqScNx/iLnLZXTT4yHfRsK8V1TFSyZA+IAPwAVnv6fwz7kuLs974lzBLx5/qu+Cj789ieJt8a/pJFsxwqkpPEz4d0qIJqR72Oj7Z6SHKalAsRRglq39mFh4zcFf4p6xyPbdjTkOa7cDfkLybCjNjt+csHwNzZbzDuxT2QbvNnmPv8z4tkJg7OHYTuunC/WC+L7/vU7Qufs73RwMjhgbGP0o08EWcBYtgxvR+Crjgb9oJjgojKdhek+Pf6xnbbbN9mANz3zPy4Xg1NQfk/sfj967uqdnmr6QVyu5BTeLMDyfH3I0SeyqdkBVNlEdWOZc3O+lhIJEkoVG2BdIpKnCmwVL1hSzsPsV3nCiek0sKpPeMIYJVkdyjMHNrgs0LVyT4Ue8GGIx6gVQ7KlPw/GxjrP4ZDUKuewjz+QB/8kkE46ggSSvhlBShGJ/En6nFOurHviNQCX3kdad0wpR8XiX0olbNgtX5yEFItKLcBur1c9hnYRis0iDdWsbFMmAltwWUu5+Sc9npyZI7NvawXEG7XcguN8CG8wUo1d6rYrbg6WfRl0Wt87I65Z6ygXK3fJmHW2LpX5kOUVvpANeGZwaRqWbtc0jZBQsd3RHfy8B/X4QOp/aKcVSYn6EbpjE0yUiQHvGzSEW1oKxCqj5ZZD5ogqIzoVLo28wiMo2/5nkJkdekwBkJnmiuqWehtb/py7grwSZnfmhOl1Sb4LtMPNxDP82K+jyr0hEQWsdwNGoC+5bXRMO7yEdbhBVnudJiqYkttYIVQuhvr6a8J7b2NIfdkKi078XMwHnYYWb27zEz+471cR2Xr9SLTxuz9EMbiu9RscvuZbcLSdxG5XuaStfaQn8Qs+Q9KrbKvYYHbew8vGbD3Or4KEe1iu3/OtzBEJfa4lLDWCSBsXU3YATG5qm617QgzhDzUidLcL6OqtICnYPx8hWtjkrnel8ZGOVFcjc//88/+S/rW0I7VPUurDNEheaZEarN7qPNjnOsMMUEZplktrzKNu+xCBTQTmURMhZg2aTNiyDdSrFhVOQ4bK42VmVFPvd/iAXhDX2sfRz627dx7kdf3kX6SVSiz+gOOczd0g8rBxzNeTbk2AG2PiePp82BgST5be0brQd5OtLqZY9Wk93r6vBUSdJJwupnsmtENLNL26ot6vsyYgY9ZMQMPrYD/+b4yF/68yAuBUauCbsjhW7l+Dsn3ED0q/csXRAOlh0DS4fCIgE7Lt+v8i3bFDaTdSyP1vOegXlETTWBeUR2XWCezdYwNXkNPxwRJ0KCIRriH0XxfCeBPdJK2E7RuODKAzUrTlSk60qdEXPmn6LqdTEkelfqlqD/lO+rXfIhfJ0wuyecFn5vSZqKr+vvO0JOgT3I10BvF87PCZXWZYRLzuCPTqFHObUEjQyx9NtvzEoHVVCL31Q6S/BCMa9QrKqep7uRsRtlV5Y7FGMKVjwwXx338H0R+YAh8uGPH5EL/ZqPj853sCSvoPp/g9l/qLhiDIPdD/W3inpaxzQflFc86lN2cJlJHRdp/v/1bVDCNkV89SO7J35sd8L3Q81jhpo/tjOQHte/G2ijY0b+7N2pn+TyTkSTijZeHNX7s0C3FySOHs8UTbottroBU/4JMlqvUteEVZ+uE6a3xzy686/b2yA33xWDEqAqK5gNqcLyFGMwS04CPqryBsMTXDeog6gNyNgc1EYAYoTUv6BNcAhQ0By6rzPEXO5cOOoiseLqMJUbq6xFIpzWntSYJ2TAgiypNS9zZeYCGyDfoDXSY39WlYEX5NcMQLAitcJwlsp0rpN35a7lXaioWr8n4hgyxd1h70dN071GXyofmZyrkGkqlqoh2UiWrHmDjZF8m5pVFIkXfph+qOqaMV+ZniTgQnqd0qdAgmDZf0Ne7so4SPnu/TccA9DwTWmli9RWdpfHAmqtU7peNUE5HCpeZ1vANC7fSiAfkZOBtOVpfLWVSCUG4JIOtsduXI5ZiIFoIc/JscSuhC2L4Ruk+LdM4Vog3gq20L2POk4deSw7atVLR9IsXkE//TMkosymgMPlFgJp80R8L7D5mKZKw4U5AT2J4ngjS4TOKs5O7OHAI5Jah3m98cMuaigUyfnInsXRzM9MiIxnTeJHHUcLlYbBjJpdEluigEiLQWZtbWiY0la5nP8eTKNjRDA09NMsmBmTwp4v+JfeEEaqnHx0BtOQaW6PPqrKJj5gnOEA1dvsyPRRwQ303hL8jtGdoTlizgyHXfRmaEfm2iJzpg+3wO+5Rc4BwLXIChPOLbKEikZ9i1xgytIipwAytMg1JpzqiJ9q+GAz8G42JDEtMyAzoUM3s32Lpy1E2iJPW4m0VZ52IdIu8rRrkXYNaYFUy0NSQQ2AWO9y6QSpg3C9jNJ7lDhFzTiFvIJlXCnfkZ8k8aWScA42DTA5MkV4Hs2feZkfqCQbnpBCERm8m5NKqCJUgGAxCz5LjziylMRVkH4ZXx7JGre/SEX4P5gfVtmX8eV95miJUTzAF0HaYG7Gf7+Ok0wSiFj7iZr1lF1ytUZpfjozNoTLbtUpZAJFRRuTE5+KauXu2pq5bPh1UQVTzcjJaTA3odEc+q+ucxJfMvy06xqgB8d4RSM6fyr2yO7LcO6notRXLN7R/Rafbctf0uuaqTc2YqC5/qwcK9OPbZ7Z7qaqpVpD659RFkTlyfUrPzufhPYpOP864sf3+ERcU6lpCXMwzLIsImI94WhwJyNAcVqhtqfQtAjF54uUNzgQTK6MdpqrruYFuXdl7J9yfHR9zLMtC+GVTavqiUGC2NGwqdi4JzfoySudTfa6TL0OzADxPO11ydsgRZQ8YQdZPoPQL3at9i/exgLHcYVe8clCEjJ0x7P4F+YwxMczBBYkGKqKIQS0nU45gIIjsLTEksaETY5M4EZEEuOJoJZiXfMp32yIkqgNVi4RhVV68EEJ1d9OcTVEBHeOMaTbMF5QxKr2stpqI8+kHcf6WUYCb+UnKf0sysyIOF1rX35m8HmoqYHTXhEJLFzyMBRYvu5hWJmhRRBmNDF1ekjULl4RoKliyX6g21MZfHMvs9N4SbX1cBvT0J5ZJkoblO1PMkLt0hUFEU4tMaBfRfmlp1ccFv3xvA8wOrFjAEnCiYEcBaHUMwY4RHkWqNJeyuvDwTE8dFTv5FStlEdOFTXtezmaQOMUZ9IlIlUQDZCYMxyK6FMGQcZdEZXbOyx9Y8B+ZdtJBodWazmr9hx8sqn1RTDVRMWd/ErkZxpmqqDPnSO33IlqBesKz28qgvYo74K14VdPhelSqafd1tVjFakeHe9G2ddeEa3TfMlrisqZrhTTbT8WjJfK45dH5heonoXYDaIA507w6gsgEKAe32vVqirTbqNrFCvXye8eZg9FocNsf9+CSiD0OwKKs1hXz40+14MaDk8T6r+rEjiVaatc4ODCUqXvtBOd5xedAMCee3I9KT7J1Hgs7bYhMnNnn9G0smJs99aF/4RqA9j+kx0K8iem70kUemphfSQtJEUksw7r/JH67XY1MZ0GU7+TTtKOP/FvbwF5+tOU/UinPv7bnZi+Z6RIgCnVTX07i3+1WtHkyE+paU18klbB0mlaAktJMPUfpVNn0nEm8KPjTBwLPhzEiDB6DYqSjhL4ZnvktNvS77bMPHZO2m3165C22znKhSMt0AOSRh6t3aLTvRxsYsxpOjOEh2/d+8PO4s/jSzFK3Tm7vVXZwdAlA3WYaTgneVNTfLLwBmuQYwEveRJVPrkuZMjKxc0K5ZWWRCc1xw9vXXErls6c5jbXID5CReXFOx180RLmqSCH1iz3e1Z/mD3yuu22poSdrpBIyogjUPjjoqvaKoGkklKHVaIiTp75s3PTLNoAlQdnRhbJMGRlkc5g8Y9LnUTbI+ValIYkyBLTDEsD10Ht3q2wwvAkz9cX4pNVXiY+QfkmManF3juVCaDWRLcgOFa660AJfd/uM95Isf+AiwvtAjfXkG0IdgqEyynZ9Mgg+UVIbedEJlRscXNH5AfPt99Jj8Ac0SnUCuU7BZuiYqEmw6JXfl0hIIjOzsT9qfUi+1XphoWlNnOhv5pe6AKjQVPmZxEngT/e4ErfWgqAsJBuWEWiwCCF0ylqhtUvNFVD+nLnr08FQ8Ok1qG+QrZpM+Ht5z0qFmR2tWrhvTirbZyjsAhRWHVZ7jG6QtCJhp4gui6Vr2tG8oNk7SKQFJBzE8oRyauEcl82YvWKhDSStBoWjLj3uyKohmyP51jZeRJftiJ62XqWJHFi/sFni9Z1vG6lNGv5rZVst8Uq7ognEkGo5TrNWqskvgjmtOW31lHwzZq23tHr1joNorNWdk4he9XiG7Tzjl4bf2DhE/EIr6Hn6BfudRhnutHwGFvMeZwkYizBUFOZCwXQ4+6Jnflngq7LzjHuw+2tkc3xF3bgSZxl8fJzusjKzSuuvpUQJdjAsXGKxZhBHNhjJzRN6fwx8GbKj4wankphB6jZcg8VsOQ+Pcy87GF32p1kj2qq7DjT2pxJpqJdQB5l0OPsxBLBdio6S5JJJCJ10ND+Zk2TaxY0LU5Mg0XTs6SRLRFvp3p75TJNKObEMMRh1xGMh0XxXaWSqZlVCh93T0glDUhes9rQsQMivGodpMxVqNIxAZhBywccSgAZtSzJ4BK5SxiJbQbwLOchRGr9YDVgAXOX2COZnUu+PH5w0KcDSKB8T8t0QVUAWWpKJzeCyUw3G6uorlPa+MVlopZqkd4lkcfTxXkov4ej4+yEvWd5X6IN+2F2iVS/s0zmo7QycEXVQ3QYBjqySD4a5OBqykZmn+lTDscWySuKzKFls0Etkni5dbrF9LpMucl0RtxlxoiLFIcHLESaM+oyoeKgy6SKzshhQdIGB9ahb/r2633ffv6zPcjpWUXuWN4hmArLIgamGOQG0qpXDomIT+Zk4aVwp557mjc5bDbEJFwasqq8IcnSWz1ypup7ccIQJbnwJMZcklOvS669mbnAgBBg/770AnNJVo96SuGeLNyzLBks6Pr29txjm6TdTsxrC5knmReaC7FlSOTBpXWON/+j08PT/X1rbUbklFxMl+bi+PSEnFoT+GsxRg3UMPeu7ZkfhuZClj/cM31vzoyFLcuexxEt1BWbc7Ikxz7XhTo9gdM64Z+SJoh4t7xTEqF7gXp5tzNQRdpFKOJbN1lyLU6gP83MwIwswGARoKZJBu+cmY/RxXLNBQZ+yK5yuQRpux2YKRsvEoSbRjl8j7l3MV3LNIKMJhivE3Yt22WrJM5i2CTazlevTHgCBOzIsKs3PfZP4Je12fn0HPC4R936GbNuslYQteg0sBeY4ptdElnWBLCHFzWOuDRSH3h6MPtsWo9HJ8fBiWkdpnx+PQUn+6iqpJw/NSyudcNWorexxGJR62ajHwKXo7Tbe74gaxwu3Ch3h8ReyroU4371qpIm2L4T1KPaEICtgrTiDaFmqnZM4tjtahojrqYx+PhqGiUljd64pKXRR3xqR2ZYq1nxAuVlr5GpVNGxSFEnIhE6EQnoRLC0tUhbF/UkqsJENj4uUMz9yjKGNO6eWuHsUvZtomc45fIJBbTOaUlejB1NDVPN2mikoZX68raqHOA6DyKsgVLxj+ujA+m0FgtxzAWp2MtaB3ysAMhd/fk8jlLpJKwc0pterXx0IAWi/FKk4nz0dXGKlektRSnOc5Sw3Hwr1URBzyQLd1qOzx3cLz639ESAul9aG3quj16Ner7VBGubNrywlivo08PIyo497m9rUOXighmXskzqClSAW57ntdR1OSl4lgeqH734w+nfFFwtGijJ74CTWekyjjsYKHocOeH//sCe3ppV8hF3simQevm4MWRMXHzZA9ejHBkQj5EAW9LMfw81dhZ5sKz/Eq2XNFG9d9MoK+qU8bMgEy4K+js6bapcAqBofkG1kR+KAk2a9DADCsf9l/SaizNwtjY7u4+7QYWPjF5l3E8Nq5UPWbB2xIhJDi2SeAHxuSlYKs3nX9LFm/hNg5qAVPX4ZOXDGbA/gUHbQh2GM5EYRO4fO2cqGb8BjtJ5vA7nrcvEX7VOlT3UiqOWz1MMK29LzLR1U2y7XmEgU1Afm348z+znxhJKLpqKGIfyPEA3z41usgvTlce8+hXjsO4IrfFWrXKyqv1b+qsaxQg5uCLziCVbhzQX7ejmj/FGKfBGf5DbmAquE9xI2VxxR6scAIEqDdhkXN14QuWhyT3Z6DWQxU30EQMJae68007GlXnzK4LrrBp5uHx2CSt6XNxxaVWdBp2qdqoUTG7VJzzFQXKHUQqG7s5DVTGUn/K7SlKoVNkrTIG/SOZlJQUd5o5nZ3+nrPOAD1bSezOfJA2gYlynaNuJvZLbxuxRlWNZso45vq95DFzR/fHOli8qM9jsgMThvg1CNw3idt+vScfanLA9VNEjwhOH8ZrYRCd4fqTGE+bmu4ddsobbXV0Z2iWligqVKFu1SDiNrzozGkpSr5PbvIiWFLtPKTdWDWLKmmwbbsv3stbYmoup+W7Ue+DlBHQ+S7U0NEdYt7d5JOsCEjuXJHXVfxg7kjWEZsZ0P/nJNiY5uS6jzbFVyJRIu1o7GuBbVu1oiHoo69412TkewAJa4nSFwRyowU/sqUJMKJQEbKJP0lm8ovPXKIBhztinDDWzD4PxCW4YXplkhB3VYGNNFBtX8bqwTkpRdD4sfb8LUc+x5XsR9Ft9QzNCX5r95w+nwndhr1alOrj1WPyzY76XFSD9ZhbHvrBdj6ktVOgRlnslFkL4SYYklPhmG4EdUnZfKLoMmbU5qdmeVBU+WRsyPw11QEKYzXMNIv27FfDUXINr0IujQDV4Z5b09M3MmnIXdTondPc7WAXpv87JpLJvBEeAzVdFLQCm4/1dUjbEHChj6Pn7YmjVEWdF71ex2y9uhQY0HUk0zSegAU0XcAun9KZFuo9jF5C/qqilCcUHqsPI4oOWxaTDI8JVwcTqiJcfTD4plQowtNBxYDMi8tNsGU4lndoUba9ohAFHkLWKboonjcG+ar0d1te5v0NV3PcfR0iFxRCnsN2u2cpTgWJKbv4BQlB483xnzeIQZmhCbUWlu/6y4joEMuSQaoSILeh3QtlvPh/ZREGh2m7D2V2usuv7dJx1hxWTDStzmMtHigoLOHHws64zjFhmwBLFlVUoTIsfDQ5nTQSFslu/RTHsuHobnVTipwgUwB8oRV0K0wLdPWkXUhefiF7QsJ7litkyIgProKp7Ud6eTbUwXIrV7NSrTpDRpUSJqkdn+bwChNwB5Gyo2JnpydOC/jsxVjSRIZaEL04ed4DaqrkbJIlIK0UzOBncgJZ00Qpuh7inU/yYl6+IfDVRF+q97HTHXRQAjZ0fnQCoX5D/SPchYZwd5SGVhaRGpHDmH+tG7j8QmH9Ygd5Ij/8wNgRCOtfABBfGhuBrrQbiPI7fTZhm3txQOXIJXSQ0Pa+KUxZxMuOcJpMps62pHFxtWAEB0G4Xv4F99xVzF1eNOyeUGJUGTKtSwycxDx2A42QiHt77Jl7XrpUvFg21l7k1isZVueqCvpXSAFPowlRYbaltQlk1mX9GbjaMBtWzwdN7yQ1DlhKqMkO2tTkvW8qOdG5mVNcywreR+GZx4aQhq4aXXcumZt6c3viniseX29suOBsBz8KAFggPSfMpBSw6EWaQadkKMvIvtkRAgd4pAVDwlxgLkdHo8LXLWdZiSvDhwahuERqlJVOMSTXXEDYK0ObmJJeW1riPYQj0jX9qonavGH1514oMafJjFpKPlaiab/zTk2KsQuWMy+aqPZGlVUXq2gbmFD8AJRSzpF62LEpP7G2wMqXkh0coUKNKduafHlUceWi99pDq1NaruH2/qf3InN20ElOR72IqtzCnayNfxwhNc/ZmfoxYgXVoCHaVmIsaZpVwfn9fRolkaBVYI7wxkQfckAqfiy2HMaH5wnieF5Cig6MsdziS++KvC9MsdpcZsFe1wNOCscTF7mGcdSTuVQlcnjTJ2A0uWVBwVbP3GNBFk+OMxWaviwyRKZG+spy+47RZysLf6QP9SdlEhbHFXlbos9862S0+YOafbg0J9r60XJ/Rcu7HoeWI9kqFLaeXKuOy6ANxVR20bRXubg+QDBFupWtDPDYwN2UnjxcFsZ9iI8OlbeJq5m4NChV62UM6NdIwmFMWEWXCP4DNb0x0JXCNylFwN2ROfyR9cfTkZKMAOX0/+THslFx2nBrWYaEFeZ0ost17iV9lBapgVStRlZA/uDy1OVxwcb0+kF9OePDrrguR32q3W8qVUUFm4mX9/TDZh8c8SnD4LPNn56rfGx7IcitdXg4eWEFLIsRKXpN/CvzlJjyV04zlWOnMSoG3UbSU5bHQNz+UWF/MGewONe6nVux7llY16XQexzJYFF10YPZWkCESqRofUalMECrQmJh5gBa/N3XbcEsgq8w/QzetPHqpxrmjrmv62DcFwTXfDe12V+cSsC48Ds3D6WPk8nv7gBQDOR4Td3iys+Q5d14MrKUf1xrWBnv29TFoW7kGnnaZmv1xFpbuR7xM3zeez5jZxowPfkQU4xmGVqvD3R8W/3F8ZxaQoFWL5Qyi7l6O7iY5sqzZu++5OAfMn/NB76OzZge9Em+2zoPiqORBceCUXCg6Pb0C6hlnMdR4RtR5QSx6Swws6Yn+HJRKg+icJkH2GM/+nlNkz+3uHu6erhJVOkLQDrXe6onc2/UgS/8KNl6Nj7icv+1suCqunjV9wfzVEn+dxTDFIc3oPYgaSS81qNbG0SJIlr9kuG1XR4hOnzjjMTmAuWMeE15Gv6TXuxYfQzk/DOPLL+il7Ba8JNOMvqYrH02Bdq3NIMaJVBZ9PEfvk/fyBYg9ebqGN4Of0fQ94hTgWqt82+MTeDW9AVaHQd5ShrW+pIuJ4Mnhi4udnjL7dosKckGTocCbxVq4SLa5AlTMk+WD9Dn7JjKPa0Lx6LCfQTfBAR0M7nPkCjZ4EXvjn9lZEizN3BGZsOAN4wwfNTWFUVlEQk4lNp4Y8zjK2B0BwhyQ6u5Q1zMBN+WC4FI9xeY0m4M/lTWaDxui1t9QFAth6xsiZlLTZbgeOJ+cow7+jICfbMYfqrkbkmfUzQBAyBVI5al6nH5Jz55drWpe3ZVTmL8thMYTvWyxKkxarxA9pXZCV6E/o+aD487x1yc3G9P62f7UJl9//R98cvv3vk5PHpwR4+uvP2kbFuPoWJb92ziITOPWsIhxJpK3SQxgnB7diANXxwzPrleg40so35yWfkEKe54vQhw9CddJMca0P5+XW2PCLeHTsXAS0NJeYb+oCF041caM6jpB0ajdzuwlzIIZSUoTVRgy5HVkZmTVLwXvA8xvxZdgDsaUIfh+sVh1Qnf+jX9mbUy+qUvo8va240gZD2y53AGHkCWqqNkEitrMoblPEl20AQlUzPXnc4NklrVRptkDK68zmn0RJ0s/DL6l0OU3hWDXcqRGjBQVCKZMqUtKp8Y64kb9xoQ7M5uWXJYxr1g0nFvoEASVPl7i3tBIj5QVvr1VJlK4seObirlkZy4capyTHErpE69B+pyp913I5wTcmfMbWr9b84VQeGbH3ZOCk5WdloW1AitDMtEmxKUttKsc2c+lRxTF8RKMrgSB2tuVqArUusm3nUqCyO1HxYPNKh5I4c1RKcm7KVZGXbp2W9n0X9BLXq5ENmkaRe6kqQs6rG6Fj2t9mXEKpCUMAevjpDeUVvTAFBZM5mfr9I3CZqB2mThpCrJR/3gXuBne5czPSR58mMnmzhrkciAkPJOqt2oYCM4UUQRL8ikgeSI56Z8/HiXrcM/RcickVZ7zSnLCPOeJiAEXWItZ7pMNtktQH2VZ1TmsIEFAxaWowrpw1TitTBCnHgGhionBVYoZYv2AKqbMszR7zgjvfSgrYmpZpEZTa+lfsSM5obb8zWznZiihTgyYWnVddNMs/M5LEx5ivKN01UEvhgZaAcME446ZUDuOkOZlgUmoreJy7nMNgRheVnVodfFdqM1TcSsShV4ERs/aPMbtRu0SGUwWkcaG51irVMushieZzX5wpcqMIZ6NdbLZnFhlM2eG8OpMnFlu2byZpaJibXUXfILrzTRsI9PI6HIV+hmaCQEVTO0CZa7VwlSCWZe5g5LwVfbBVBcx7JyGq5bYG9iKhEehSP5KedTdEgVcgS3F4pZVlk/ORPZ0S935FVatGvI0FYt5+b78wQPmHuLgx+cewukxfzsjxoDqMQaU2ysxoNxyDI/eqBTDw3VLITx64zyEBzljioiX+H1mkbee8fiFQd55xqsXBnmt8Zhi0odOd2p0AVVY+3RDjkruQUTkmzOafRqvk9S0SMA+XwTROqOpyV2XsPoMp4eqT/Z5vE6eA0YGX1S+Fz10eiR65PSmUcdzehMgPiP0geWA553XZmTtGxNj/7UZWPumppap0TL2TX/6dvLOgr5uyFXVkYl8VFASQJ+Cha4q4ZmX8kcEHMTDyPPBz03g+ccOOIp5u2GOTyIBNDFgKaVj9xSgnS4sq5LmYBq0G6Rf+F+YsXV7Gz/s3t7Gj3r921v97MQPHQBwetbtLSuWWLe3CRRLHg0OCix26FSIou/cY9/czyjD2CAA22NVFJOtaYj+hp6i8lUxa2LmeSS0U1jZMAxSOoujeWp2LZb4Wn5bAoqtf2IR/biCdtvpeZ4XT2OvOwlub9nX7a0Z77Nlx3rYtgLW7EYZqVblMVjSVTB7RxMN6zXVBJtJNcFmUk2wGZZ2KdIu87Qi2zbVsHcVVm68lZWLcxxEYRDlnLZlEMHAWN7Sv8o/kI9wHodACnMeKZ0HRTcCFXZovgQ13NWei1L1YA7vbd1DsecKd4VsWSnGM50lSGrzVa8NwJIFS8qaz2iylSGpONjWeAML82Cnb9Rqp3UZJrUmR1jphvXkFRzND9wNVudUmwoduBIdYHbRXyANVcNdrfKwStWy7f5CqWmzqejfriNGu8FGgELbnPuox1+NtAhbJ5UZeHsu2XKXEmkSzAMaFVPr+L1yFGWOLzandk846aMYtUD4c/5UbuieO+25E7hIvO5h9DA7jPb3JQ1AfC841BS5vTUDL9p3IJ97ASp1H7D9FHFTAFjL61qTOrh3gNL2OCBHYpQxc4S2GFz57LAHG0tgNLoRM9kwXvDLP+weZjwaRPnIWeWGpPVbtrEOlYZ4t3Vq0G/Ju5MNRtSEDVXHQlU3brud2HPbj66Zyr86s9XiHHXwYC8CMmdl5mkFvAPbZ08plua3malfiSroI8/pTd9N3lobUtjeW5WEOX+/CshsIz7jZnevM7g6BQtKbcKjRLid/wrQqtDOwiiU2AwzVwKjvAFCBNl1kasaRy/4CI9KtopbZgdc4b+bdj1d9rSaBJRXNRX28QRqettum3gOdqusq6mrw86EMoFPlakyqwX4kSyiGcLYg0gZaGakrnZJiFGkzLQ1a/cTtsaP2T3a04xG24Vqa9XKJhVXoLjstN1mP4Cg2tOgN5HvRbe3mmzJddYTieUca1pJUujGCvTErAevHhQdaakFUklNLQAjGalV1wYjTDNLznThFBfWlk41y1h87+j2UfkJpEdTajUSQU10DebKrOWGlJxy9W8lSn4qqMDaOEHsNSRagEtZ3JxqmtLfw8ijD4ONLMQI03Z7T0Y2UdNLBR/5smCFOMEqQLqj4LXSoIVvUX0FVXFOlPM6lY54aMASFRYKXyml1iAyVuTJICVdjISKPYh37oHq+6nYA1Z7zGv3igPmqbkPTLibMWvXFa29HVR7NHWLaHafZYHPTvRTe3O/TUL8So6c6sNI0ytGZNGHfv3GSus2FokrOVsbAw9Gj+LNlt14E33/rZaVL+fKxqN5kLJH3Y2y5rHu2lcFVcr7gjA18FoKJbNIluN3HT7PtFhbYhmVbpFBzSsFwLUQXjFfVYin3H8374UIMpIVn2psJEzYTfTKxawv8yRezTF2sKlJVbX/Za8+/fTFi9evNfMpLzOKXBqqTJUUSBd5XVlxn/HRFRlWxqTbNTYywKdcUEZCv2Kvn2pnKI8ezZ5fXFj+N/m2PhQzmPPMDhsu8DxoImembbnQFXjGaONBq/XbucHWT3tw5Dv4o5rzZfK5XBZPFjS8Va8u4jeqLu+xcNvwgMMARshrYtItcWIMwoRa+TcXaEm+As3NYnVCJ1bpRNTOhHO8JcbCn2CD4o2Vy9F4Cm8PxSdGlgRnZzQxiup+RrxY5NYfCq4xaXFXF7hk1Fa+CsKzHURyVVmabvAJ9edxFF6DOxrBjauKTFWxW1EAxd+fmIUehpgwTgsEOQCDZg36N+QWb2QCE5lZAddAyeLso+RLLlgu8rLK3rDErmGWNFUvPcpUMfEic1jD7CZRPJoXOkvi9QqdFec8rYKvr02dn2Ndc+pGMAAhb58c5YWpmaGSYLH40quRLxaAymLGQqbiSBnTiY6aLHtO5r3TqBCUqTwxjKqrZZbT5HB5R8fLJcH67kH8wdxhFofo94p3YmJUDF9+b6teeOlvX/cyC6Fm5Utg5bUvZSurz3O+9/oXHw4fewfQMutWvRl2WNhd1rHANNthIUsP6NqVrLAbS0tZylfXkmftuJr384NeXpStywGedhq9P3FJQ7tdTlH8/HGvTLV0SsErVMWaRPH5A//tRhigrASb2IEqYLS+0Gr4vdIDS/+q2ix/joIYMNLk8nf0FlrC+bERDOrThaua1RAI38Od08GIKWyMP6bCBtPHAK2N4UBr7hP7aWZIIS86ga93uv50naDTLMYbn/Ov29tAketBhQJu81EttQ0ktLgfjQ6+M4yC5baNAMQIqX9Bm+AQ4APZeGsNu6vW3GxdysadgpA8KfSl3gPikqYpxtTcoI+y99vJvS46Jut1nY++k7U7OA6zYJVbKc4aRNJgHqXqDRScYEgqXa9agG6QVs3qBccSCn4wj3rsN7rVw1/MX95Jrl9sPeo4aLJ06V/nhl7S75RISL9Z+4kyJD9V7eiX6zALwiBSC8CNUDuWJZ0H66VR9DJFL0G9t9b65HpFS4cd5x7KoK3GY9HnmgrEmHSViLKbH8astGACzXx6vymeMWlLnbEOSkc8uIpsNQBPsF+YKMZnME1NMSSWh8sLOewXJspVg3T5wQqwBTZQCRd+bU62Oe//YPauvW6fnXj3I594R1xe2qP/qxXQLvntFexobXrM+pMHQvFtn0VBOdmwg7RqUkLyZzO6yuSZSvyzp0m8KjqwqD12IuKOoFXewzJRMK5V7RbRC9SAhmFrFFa2Wl2Jij1KzL1Cyu2tJjioyLTabSmkF2kilGCRrY39YD1GLqZVVi5gn2Iwwm3hVgWD50FINVIGaZolllA0wEle60bXHBdfqxNq7jlsTyps7UUQUlRshlV6A7TCgiYsFZndYN7FJ0FKg/jWElFXIq6EUprq4ux6xyfWoVCm4bpBvGJVRSg7jk4O8+kCXGYGVqlupmMDJgcFcVg+AQ5I20S3CyHzfA+CvZZb8OUCy+763IhLY2AkNwy5zzpr1qO6dUueWvgCi7ksnA6PihDyxbtO3TDsiBdjIWeekiWEAIRF1el61XnrKqu255DA6x4GculAMncYiOWDyQ1OFLtCH/aMYYO+kW+n69MUEYnZJY6Vh6YELGiHfpp9xikJwzaswxStlHhuXjQFIZ2PSsng0w9FC4ys5EaIviUz86CFPxT5zu6y0it8jei9pUQ0o3Ila/zfsCIdgDpTA5sICromNEzJp57yiIWM8/iCxWagxY3EPfJDWnyhzgMIunTeQrSYpQs8rMQ/wzfGe9bh8DrwPfO9+hGvthdX8a1iqFVhjlQTCxzQ3fgkgFCN/FKmEomKa5jyE9ng1Uf4b1Y7Dk9+/YO/lmhCttPh3/rJvxMGpw/SWRKHIU3s36Y/+def/b9h43+1pl8FSbb2w9c8s6TEX4wa7eEVce6nLy8jSRPlszWV9M4k4/TQTZDRZfp5sAyyiUP7Gy6RZJyvQ9FWKwFSwQ6iILu9NdkPbw8iQ3cAvVdO62UQzeNLcI0ZnMGzxl6nNHkMewnPsHimGC9ef/asxdBd9qgrFPdznTAF2WT7A6KUtNG2VxgPKBlvkmBOo+yBYT3qiotMyU4uQMba1FCw3y81FLCGNsK0Q+Y9m5/RB3l10aPuVFtlVOl7xKqcdJyNabGa42bkV/MGwawcAVK9d5uEwrOjE5+mNLlA0WEpDJPRccDPpm6XkrdoqPbZfMLeCBed037vgFJ3ZnCXnZXacxdo8XLlJ/RxNP8izoJF1av020tB5UGY+nixSGn262AOlxnLP6/mM7/G4h5+e6ktT0RxbeGCRXKEXTNA+Q3jv8FgPvWjeUiTqkPht2yw/PXBPX0+jWcYH1ucrq8Cegm2u88AzX0epBmNaGLyeeKES3lqLH2yKQ2z79OxdruaZscRXE2gFhm129sH027fc8Csm/cbM/fmVdvbGgfESqzDxKxYuSuAFPYHrWwOCjuDVrbFRlDkczG+wpkzhUsA67DaYS8jmZ1SfCQGp+uMmoafBH7nPJjPaWQQI0vW1LAqQOIIGqTjWESM28vDEaqrTjKkOTwjo1fZg/NsGRokkuGBQxtCs0Xzo/MgnKNiEz4kPMM/jdfZ5DT0o3cGiW5va+C3uYDX7UMgpw8Z9XhzQRPGguzarj0wSBClmR+GRfXR3KG7WUUcsUUKAF/yCc7zNxvCzbkURwx7wvKE3TvTUFxAX63pRAd3Fsanfthum6HHfgKkRcJ2O4Srykwt/kDRWLe8vl6exmG7baT4o5xhixDlU42OF4ekmxoFsIbmYF6iNEvWsyxOPM+T6Xvidx7zfSr6NpENkpmnngvxs0Xlc4c9oelGZmUiZn1AIpUSCDCMu4x0Ds8MMwI7TV7HYdjClY9m2PT0VRIvg5TaCU3j8IKaIQOz7OycRkUXI6aBvmxRnb2UgT5nMceaxGaKkf+nBlchmxgRGrMbRKokyXjpsVo2H13M6Kf0MuBwMz+lsr5A9lbIGQk0yDhJpwn13x0iPKsZwdEFSCZyBZ1VW5GzsTZm4AUYGt6aJmYA1uEksP3kzJpETCOLoZkguojfUU9nfdUCfTQ+wflUxiRlKxd6zKSc+MnZJCO8K5OYsO5OUgKtM5XCw2gaeRF2xwvBRjDyQoJqh5YFlEG+N+UxynioffFgZ1+ceLQ2DbuZ/bX99DqafcYPDNSS7+FjHYguQD60jL4GZdFStH1aABXTqW41tSyu6PbC+ZYqlOYzsLU432e4JU2LVAxz90yqHqLM4j6XYbmBxcD9LB/5URRnLRDLt/wWvktbftryW3LmrQ2YTtecfGhMMBSKvIScjxDhAT+MbIrBBeEN5Kkft7d7DomYp5OzNcvf6xLuky6IWrA7IvsyYZpfwAwget4tJREegciSur6qew6SaxKDRrS6V0hgwTVIzYxEFslwSs+9EhemyG2kVj70zOuSwOPpguNymD0Uvw+z/X0rOM5OPHqcSZc3gegkq3iRxEuTWuwynNfMOB/GDI+LDLhAQ49TpOImVNSLF0n8LUVuD34ygkTciIiLRT/WJiXMhYRRhDIqFlskx+wkITFJSUh8MmMUlVnoCqcuuJtv2bCl9s4SxDhT1kg116YZeNSa3kgFkmAz4daFIslTa5CpRZh2uwAEZyLLQun3SFZlRl4hgSSetiCJObIiqfgRih+mX9hB+WbxkzMkDoUxgtw72SNnmnWcSRcEDM6h/zA79HHn+B3nJC927J8chl5A4nabgsb97a2ZepTMQuonoPoQrzOw7o69lGbiW9lOEVBt4TX3SUUgTMgsjmZ+Zp6bIagUxV53QxJA2vZbrFTdjKVWNsQXSsRxCMQmyEJ4zaUdSS9bqADMAx/InaBR0waubrAwZ3L+JUfZDtK8kugMuBWBUumXfhbEj7wZLBBNQQsF6gk8z5vl/eNsgkMlyQtI3pgZkMwiQbs9k+sdRzPYJDN5mrpkVt3SaAStbhO1axbhr5+rTP/UmMm5Ej/MmU1DYPBsNkx9ySg1WTmYnBTLz17xLAITahZHEVAalgZjWNrTIj75bigfFp5slgqzCmXP5YIY5IzWBq/STVy7XZuVL/PtbRdd09CNaeW05gIlf0FuaCD1/hWiXtIWut1pAdEch9S+9JPINI4v1vK50UGv90EYZNcn2rKtx68+awVpC25Y/8IPQri/WkHUuo7XSes0iS9TmtitVyH1U35bhyHOQ2sVh9eLIAwnrfMsW6WTBw/Ogux8fQpvmweX/dkDXXsPsoTSB0s/zWjyQNRgWIfSuoodwjlclCQAqenbizV9mw8D9fK9ZIPspAvvBh6WkwUXdhTtJXVzule8qJg9lOd5QS59CBbyDVxwi5cV3OL5ZmYp12vUQgfZ0AI1QaZEguPoxMplQaL6jUjZmBG8hMM5E+1YfPT6AR8m06R8LcL8TGD3cKI72pAA5T4RmxONZYm26gxp0urdx9kW+lLiRbwqvYj7dS9iqfgDk1vemwa5QCbI1kfvcudH77Lw6F2220t89K7Yo/faa2iE/Wmofsr+aCGASzmFfyY3bIbOyKlXvT9IcJh51ySospUfmP46i28Zn9x6QFQinwQlL6ketVm8jy/iOZ0Gk8xUE0ggbs1jegKsv0D/vjqj2RHXZXmdXYdANCNiBCMnQbkyMwuMoetpg+1nNM10vjQDkxID5DyLML40rP3Cd+e6knJlWBu00j0s08eMxC28GUBnizOubm8LOa+/+jnPUMn/0vwcn1gk8bqHycNAkMHJvufAMY7M4DiRB7gFH6JDknHG1iiIzmQXZJb4wXM2qAzMBYBT+csLTGuS2UzswfoFSRvzzLvhIJObzYaciQKW+vOt8GZUu5WtG4QRfIxLT/8oudkQY+WnKSjUkZvS1fcWgvlsrEN+8KocX1h47prtUmFJMAz9idclr+8v8Qz0Es+gQY1Qh1UUpUJdtrThQD7fVzKHSbqKRhpakIr6IVy9CZ1dz0LaEZIu6XE/of4cXN/hX5jxM9oBZXSDh0h9Ec+560OjzQobE+1RY51hu6YoSGS8TcULparpLWcPhYYQs2gFvds6gLdvQX7WUQpcgx/1cxZ6kdoYX5Yxl/eN1ZWi+L6K45BohiB7wqwXosQO5jv34yKgl3JOUcYMSwh/f0mvUZ8gSuCNveEd5RM+vUHFVNDEnjC119DP6G9MYz+zs3gFPbeMDfNaA2uwjNcpLQmGA+tGaSpvCGGrgmgFltkeVgS+3KMhjGuS2fBHejOESYGfhCtmYso6Bct0FgqbdIuWFVCvvwDN3drlL7OaMeAdEw3JY8BgNptaiS4XiH3JVue13OVCm+xG8cJ1U2xxEm+IcnRveHp+rCYXG6F4hjLbgtv7QihcyObBP7Vep3DCQfv+szJgOQJBsQTb1c8bAhEwCIMpxDYBQqKB/nqboIK5sSGn68VCuqQqjaTX7W6IwA8NcRZWCeVoVltNF5zFBxkL1dukPaf4YsKRpqWgaZzyYApocnJVx0U3IGkFjeLZerkOUeUXnDxleZmUcO8BynSTSLoNyNcMrmeVgQG8C8m/Sx+Gh+n+vpXse7GXHacnx8HJ7W1E6HF64hWaT/i6TuJN7hRJCXqxi0xI3HggDsqlv2dUXoVvUMGtesmdmkIWlXtk2vMEJVtHMrTbOiCIDX97awrtA3hMwluVncI6oToNiWB4SH8fYkPl6gNnNHsCdqpBdHYUBqgCiU/wyOsEgCFJInrD1OVxQg+jh+DbOdn3InDAZZFoP3kU8GWFDE98dCKLZN4NeAeOCNPwnkT7yYZba7McymmqN/FKwChJ+9SeYc9Y23Ipsw1BdtErcUjKCygGOxUSR2UtJ3VLrIJVagwLa87mubIVBLuhCKwhofhtz6DV253s7b1tt284ccYDDu1UZUEmrWJ2KWvfdXCCl1JqUisJrx2IRXauo77nujo4C4eITQKoQ/P25bs/8/TYa0ofdacKQkqPacc5sVUU0p3Qn5VKsf6Ill9x/Xd8JJUTdS7Hwnxne3RDciUlFHzUqAIwEYmeayr4QGF8ZhqfZa2U0mXays5pS5BQLa7d3ArS6A+ylnzAkFYat4KslSUBTVtZ3GI3SYsxe2gL+9bys1YczahtEENc+xNUA6OhRQqNv/Df0Va6Tmix9XMmtwmu6LzFJrrlR/PWH+TPwT+AoUEH/gBewX/AuzXzI15JaxYnCZ1l4TWWBDs50de8nzzUI7JkZPMtoBvh5WSjvEgJ5MmIGzrnpUNYglZC0aM5hiusNe0C+hYoIoUCnnQJo/P3HCKov1LsjkAbxZtxsL5iXYddzHULV3VIrYDyTIvU1gJajuIqv2EHKtmpeSg4p3TFfTTWROT/5G2a+QlTpvU4R/2TtzSal1Jg/lPk7r3wVyJxHQFJ+5Uuiy3b0yDJrqVk6JMgfa1KbOp63axOUz99Nfo1+rUh/GUBD2SrGA4FBlRiRpKIJBZ3IMUI/oDAldfdkNS7CeaTT/b3BfFPYFIme10Mbs0tIxJ53ekf8TExIiDpVSElbEL2yAXpMVdjB7ELcAnXKS11UyDLsvDnkdNuSzNhmXnsnLTb6peg6QqrisrLUcLUiIEagHsxsg4T0LEEF5eBnUIKCHPI/4+9f2luI1kXRbGz7euBFXHOPX7EnRqsw4AqG0mQVK+1z+4Ck9gS1Q91t6Rekrp7rYawuItAgswmWIXOSpBiE9hhjz3wyGOHIxzhmcMR9uCGJx74D5w79I/wwD/ANxzf9+WrCgVK3avXOWvbWxEiqrLy/fjye3+aegiQHpAcR/HA8gOZuRB7n3zyySe1DdUnNiVlxuCDa057nC6Nze3qQnTA18SuO67je/ZUfJ1ti06yuXWd1LCxnw+QmpGVIVsnVRaf6fxK1mOXbJ6BLZ1DoY0qluUSBG018RfUouUMRBBv1FW5RI2pRlIshDN1/OPwABzqhEltckDa5K3RVNh5TeVqdYAmEmcbGGYfg9Lf851A1zCNV88F82Xvn0a1bUEZy+IqrQ5bcA8YF9iy3jEuYKkXTxQ6cawj/3gZilS88s7f+JzSLYR2ztPs9uaTtjO1pERkr1w44mjqHmYRrl95IxoPOtmFgMxUg6MZ+Yx4714Oc+1xWstnAp/zmES0KlR+DWdyTyz4dZ+Q9Z5YcIdg0TxdiQN+K6q9Q34u/vmf02r/EXiot/2blndn4pzPR+c1VOsI6x1eifPs/KjaO+x256PzXh0dO8Y83W56K84Z1Z1e9W7Z/iO2vrlQc5me7whxRhZA50ijnAN5ciHO+UzMR1UDveNTcT6YHlXQ1rTZGxrcYNrrsQFG2pkOp3799g6zXm96XHW76VRU1kLkAjqEXdw34MX/eW4u+hOp5qmrDdIvsF8X0K9QAZ+J6iPjqpmKmTgYTPcujlU/IIgWUjUwRneJRdiImKEM4tRtjRtxcSQaN3S3Oz0WGzc5321SjLunAcoAZYrMgBt2l/edbHXinrwFz2txwN+JpSPYXx+9G7zu9dipWI5ejy3G4i6i9JStNxoSNzQT2JSr9WdxwL8Ltf589N3g516PpVDtz2Pmrg2AO91ueupZaaLsz5QddZuoQg/lSKOff5zakR5n0r9BkJgUVz9UuFqF56OL+O1YTJ0RWTxANrhZrdJdh+qEiXrmVuipe7h0Dy/dwytxMXh1NB28ArWlZ6IcvRrj6r4RevgMOvtscCpyvGDf4A06H70ae/B5OkxP3czA/UOjEs9Ylj4Vz0BieTNML8UEyz+FeGWXzt9Hmp6Ky/6iXKSM2VK8UZef4lf0cilvxRt6RGXepyw79fq+OBlL/oo/42/4U5ZFzfKXYtc+rlYH/LLbfXlU78fo5fhXd4LvIrLxlL/sHTKWpVu7tLE1+Q44onvZ6zGeYx1v+Clj/BSxEgfyhvPRqybZ+Oojw7LTlq2wrkUVi3Hoiw0c2nooDvy7bje+uui+SvgFnzJ+Fw5PdgOWaPzdbyl/2WD7yv7peRoZIwWxh7W/IHJHErT6XpkLe2GC8yP0F7ZnOarkVSnw/kgWQogaRSCyVRNmxy0SUPtkL+2tgYcC570RcMjr7gAPvhA2mhBc4tZ6fXBPSKKIi69iLn7hmPcaqfsweHADj3GK1rxtRqPgQtLzOyrGR2oz0tCmzOceaVAj0FBbdU0Rgq+t+cEHMbpHSPD0tsiv1CSMrS4bqA08e71uM0K/VtMWxwp31xY1BsKcVLtDArfPT5YUiXb9oUKFDxEX1Ap8AG9/qzl6PALnfWPnIFDqUe01RHJd49E3TtV7IyfEzHdXo8Ndox55dJTE4yaIx3uOiDUjPQY8FjRnXRQCPAIlV9Oschz3YlSNQfMpDnswb2M9WtnB2t+Jhhg4AOfCGXCEG1AL3a4nBOg1Bd1V0SgyMuMoEkKDC5PMSj2RBFST+3gcBGMrMRq/h7tgTfYdGOSBOfxa/YwuZq05m82AxjrCkkFrAhitfYhXJ7fObQ+AQfPLihzWPADUQeoWX7uuq/bWiaYsbca9JKIaw3jWIfI2Otx2MXN32B3WjqYDvJXiW/Oo/XtHbLcwxAH6kBaRiWOXqdV0BVm2W6Qk72VAt0znAA1Z43IpupnlP4u7VvD5DJ1rqgLNGkZJNNaEJwHmJeMY3FnQZF131KAXnoSnITx8a4D3NrdAtVpgyZ9KsLGSxUT5KEAjgq/UblPKCitht9z2pk1+vgWugvJAHQpudZ2DiPzG1nCQb+w4o9uKt+wnqk1NEap5cBLm0qJj37uEhKvNk3lh7TvxpSwgnztRfs7jTeQxvlOYaFWcf0ebooYOUsMIZlg7wzZignqfDYEx4GAdHogX8p2pNeKZsI3xpc6gsOF8Dmck3ZxX2W/umJEZr9E+PkwDC9eA8tdAsxwzqWKDeJ2eLKv+bllsHnI7z99V0YAsgLm/6F4kjGqUf43StO0OpTehb9s6vUf2XOvdbPbrRzab/eKhhYsi9Hijg7WRbQ55WN+2UAU1ERijG5+Amvuwrdh+HPzn9/TNfrBgBNfDnm3PG9zagYOWu6IOOu69LRpn6D5BhYcsbip9gr1U8D1Fw/WE1+QWTcBy56Q5fni+Lu9wKCT5yz1q0UpbG9ulVdkYZ26wU1+I0Ez7wh0wnt4786uVWa12IhjKWg9VPPItsi6XNVr++9n6bbIhYI71FQSVCFExPK7HBkETwvGxIU6YTwTufCVNalpRb6iYu8zAtg+3JtRXx7kwN2Nr036gwBITBP91NkCE3PhQ43bNa45fnbb3d5G292H/oH+wdyZN3v9dm9J3iBNq5VV5VanzApRN72zuk0AMgrGKf/tGy5l6h3GKTWCRabgHCuYFUcVIjyHiGrDs4JkNiv5Gxd1u0wuPbyY1vWRTV/N13Trb9Dbp880sU8LTomrebeTZIIXfvbca60395/fV9cxmW6N5YXMeYeGfxdFB71F9f/bB+vXPavr1z7rdZ6hf/x30tTFj4jWX/UaXxbvNNBiG+Jn72NDiOy77z6Zk8bEBINMNKeVBq5TyYDyMX7K7NeurKYhNuRLeuEMOWzF+WIC+mq4zyQugI8g6xaR3W2j6ypQaLDHWPvjetpyKxuVUA+7H1SxEVLWQLKfn0jybtlnvq+HmlaTQ6CmVYI3bcmPJkRo7Monuk7gRvqk28KEA0lC/JRBX6gqcZ8KogyKTo6ztOgeEaAvsbsnvsRRoCVOfFcpspcG8kRblRQ36zTmMWII4ccQpxTA7dso0we6Co2luWCLJ1ToYMlsdkxFqx3Zsr8ed56qqVHHe+Sfbi3/qBKP9ouOPcAcPIDnTBdK3MfptHAhaNQiAhJxp2e3WTcRelB017cwAM+nMSu261blR5qJDhyPrPEx6qpc87IMSsQsmBQMEU+eRHDsPG/Gc++hrNk1ARoZMaLkGyA/AYvBv/u4BeIK6XkpwAvX/+btv/1/7H+086HwEjqD6P1ad60f93/cfPYKUdMI6jw4Of7f36ODwk86n13nR+VO5hC+vJJqkTTtLr/zz/NmbztdqIotK9h90Ptp/sNNwI+WtuuwaW0uKbrcN0l2he80h/XiDDZOyrGW3UOlul377+dV0SI+pYZkEGAkl17SLokWrebaiFbS35UxL+bNM79aRqaCJYJNd2eDroWh83Ik/6vBxB8Fd+KTCp4RcOUUTtFolBbI/62lNryCQdkYUfJRY80PR6Bvsyc31WJNDEjsFkb+CkjgAYSqqqNcjqsfqxYzJ+R9BOxb6MAlwAP1XfTYvc5NSvalk/lgb9AOIAtvZvCx1ajCQV7erqs/gKMparcvNJRkmSbY5tOGXr1++6NMEg8sjMrECd2++C6HWeWtfpe+jD+QlM7OuW5JGJoluHuluScl4VocI5xwkAOgRQcceEYqRHqnxGIK/uSlpuxxBEmLKr8sbqU/yCrz5tzp8AQhApkALMUsTQCsDppeAWI1P4QM4RdByhgFo8M8eSnC4qpLoBFyHqO62yyEYvXM9ZtBbWnG8d8gCx2ExVxOZFuCVHjtzsbnJ6h7lQptXNVu5C3ePmmi9bsN6tcz5hiFbcPIAlqJACI+KsZBpwWzvzsX+Xvr2hu2f81Nxm7aiJlpiFIP0nLfa9Jmh6Zvy28XCLQ+g06D4eLatwslFrh+b9IDVy/Vkv8LJA5UZviv23z5JR4/3fhhD727e17tdnuztHiasvlWsD6oT4TwJR8sAxqvD1iHRN1CvbZAZEQwsnB1tEzv0t/ZQHx8OpfUvYLjPB3CabnpehOfg1LN/ShUJt/N4sQ6bhOJ73xlhVqvYganLvGcs7kheFAo2KPb2Bkzjwo+KXnC5ocO2umwcaLIxllAG3dZ6oYYv8bjuxeFubaNiy9jzLVTQ7V6mhsNTAHyhmpfOG/WalDiaTqq9DTN/0+YGRkYT8yKcWNQKqrmGLQTcDFyL0p7anWK12tEuU9Ht7uhu1wNI8AhFz4Z5o0Ylmk5PeN5IorpVt5sHiBAib3rPsrIvr6WON3QYK40DpmuNldVsTZ8SC7aZFBo7J/06GxLSv2GvVqu87jG4cjDkUt5iPNtJLcH4BavCICZ+EFVzELUhgJqHHUWw1PSrGTbAj82rZGMTqVnq6vNGsoXt2d5hqOnTABp3DjcgIbtDH4fA8PNHElCkcCotRHwmyDEj2ZHtaauynfCvxSiJ7pPEm5clPKE4ncmYPxcjKww/QbiccOs9Zppw++F5ucTylpnr061okFuufvhgGbbQJD3hNy+Lw/T4TWpd6pN8YZbQ7zF/Ku6IEnku9bl8bXRu5DmIT9pu7UrNZWGsRHy6xMl7oxaQMJXXpiznJC6XGiPNFChB49ikdWJHho2A/tcS1HlRajm1eh8oub+UtyfldEtHVPVK4hpM3+Tn2evoHWT2mPBtcVmUN4WtMnsNfNI3+TkY4laLfCKzlxQQ+5t5bqC39lv2hl8tK/NthSrN2WuOTrGAMXQ6VzPiKHxRlpdV9jwCL98QSALV6m0Ol4x3kMCDR6dsZ0dz56+JuE+x2jTIAmHbfSb2R39+e9PfHe/j2XzFvxDJ6SneVqeniSru1vzJPb4E+LetH7//46fFtdJlQYZeOzu1hP7Czgz/Tnzb7bZ/q1+o/GfxpNvd7pG2kfsr8XO3u39VKbky1pUs2e//zPiX8O3n4MgVcnU+6R+Ar1n+U+OjJOewxwf8e5HWP+XFVJdqmjAObanFRVnIlVrk05ValNOVKivf5mqVqLICvP07xj/HivYnF7q8km/33057oW93a+KGM/6DtX1/wtw98EdQHWjfA3+8x7z9BzJv5/eat+/54nho/hibuUPbf2px2eZZS6+63fSV2HnS7e58205qeqYaPQAyNJEV0KXWUFcIUf/Ul8V1/7tvPz399MV3jPFXa/6HaAOcnsKnp59+9+bly69fn37+9csnj78+/eLly69OT8PRkTKioTZJWtnt7pOj/c6knEq7CIEWcy59jeSFbN3l3nujTOmRtQ//lZzN0Z+rlKl97pc3xVfyFmIab6lbUv7X0rDh6/qShhE6plElWxFzr5FSpwJaUJodH6ihkgYoGh6XyadTsWHfRRlRUBTn3XBWdW8HyVcQYrRSvORKgjWs3CiupkLJXs/aoy3PUE1lUDb6+Hp51tLN5VnlrC7WvFaErPOapa5TX45vFJmidDbuX+kCP3S7/hE681QusKJmDWSNLlrVmEKHiSJhHLzaBSR7YI4K9GMHakBWypNSAyYYC+J05qAUFbET8Czk0s1EVCTCrqFJzASasFGWXI5yj+gfjvFYLGUDa+aaK17yHNxWOlmLCCGWc+cebwKOarUsREHvBvw8anqW8ythPcoVVc2FmfXaJUp6nRUnNiHONCteWt919dTX5Pu6lgq6syi2AsLci0kRy3KV5I30Zxb9rdVjvZrESTq/8RJaVb1G3cEo4VVZQlVSGy8HVtVJeQXXX5TtZF4WcholvISm3SsiD5/lE1PqW1FFac+lyWu9UdVjSP8mhAREHam5FHe4FNldEzVYD+aSlglQ+S3eOjenZd3qElLJKl1GB4DPJR34mawfOy+pADU3kSRO6wKIyqX0RBztF8lNPGkH3EQ400LG/Bks7hzt1X8Ccyycgamsx03H2MsgNqSALlz6Ddzthmd/YCX2j0vYyly6bcvlxu4CeiBaw0Clws6X/QLFo27zSP/IccMKSds2mgMZnrmJzocMz5juNrcMz9xEZ0SGZ26iLSXDMzfR9oSpR3saaYnSsNIXsgHzryUbjBIAQQlPFhgNrbpQGP9sWbgnYmXBQ6nhXQO9V8lkDAL3T/NY5SZYP8uRHA++SS8kl7wFtBagIdrGMwEeBchWwyfwg3SNXjVLYWKSrWDOdOn0tDw7PR00vA7jsDJ8dIPJlChiR8N2aJBsN8wjf0cD9e4cARJVr0AVfyoX9soAiLx2nKUrP7Xn0kRcPSRC0gvJ+C0oG4QzcY7b+lYK4kGfSrExi4PYg7SF23KBh6C079dXJ0A+igMOdElCE0EaNow32RTD9IthagSsSd+TFMKwrMltcauk0YVr4RfnSMXquLBMA2i25GZUgtQlhS3Gr2Tdi6Jr3qqA3OTzS3Tp6qfiTEbCe8CxgUfT7Ta89S6lJ/uvorFikFF6jnOfymEh3IfsVna7O39KIXBOc1JWK2B5sG7XLp+qPn1nZIG6n9QN2T9V1XdLiVF3YPJP4QPjptstut3CrUGvx4sAtXZluIT9hNHC5e075amsJlotTEneoZE5lYMf4B2ULkRXggsslMMOPZeGT+ixkmZQdbs7k9XqUSyRtuu3WqUF+tqlHbsUO6rbPZPAGryHko0p2A2ilbe4HzGiGlZOGpI5Dk0nQsvS0qJtKePLbjddwmtIarLSgkZFR6Y1HpG9NWibmuY2TQvUGGewRrQPwtN9LRawpdKCnMIxbta8ikdpHMO3PkwjhNCrldkBJEbvwLNdi3QynAQeflYIw/3cG8ZLD04g+GfEEruR7jy2+Fnudidp4IN57iJKka7yd15awQ1cgVYaYfghQE08Y6jrDseCnprCiVA1KLgXvBh4b5EEbH3LdDhWK93tancWhkWmh+muTLWNegfD4LoOPAuWpa726L4/kZ6Bu2XQ8XgiR57hvA9Cr+ITulqRZAXsHq2ryZEZc8wS94yt16cxhQAQa4vX4gbjtCXqGwEC5IDyWq0xdNxSeyupAdASDjEpSL2T4mm/la0X4OtlmNEd49c1yAyIUGjwfHkuDgb5kTM6RZ/LGiQHohzlYzS9B17sFfKshxq0zLrdKtUM/kI8u0uZAuzLcBuja9UW6YGsM/g7xbABTXSbugmImvBAwc7JDFdtmeRQRplkEMe4jql1ZoayRXMFXMnf02hQzMgMv6/hKCPowJgsGvnL+M4T0A/pXGcaljVB4NBkIzMOgyiG7RtmNLZbsMY5RxtSE8LYwmYEQotIz7pEJi1YFl1jr2Vgd5Lso45AwgmLJY5mSI6iM7V+R/j5FlFOpxj6xc9MHKMjxEEYyozysDV/3opsvgM0U7yUa8a/3pqhl1TJWLyGTO8ksfM2KGUvL/ociR0ba4Ebm2J8SjhAWyYCDpkXxPh4YEG5vARoC5MEWJILKqhGJVg2ARo1yLvdnfr6Y8C/XIzyMeOQU+TD3G2WqrlZKjasslE19mjsGkaN5hninexb1SV4JLMO8Y5IoaWR0/Zp2RzOPSJnN7JulzYC9+2DWZ14LMmAeEvcjcj1MSgaREK9+C5sO5y4SMb5w27GfnDXA04DLJGT2iLgu1sPNi6bgqHxtxZFjTRpKqykijRBAakZnaaKjQUZkKCWHRs4U/LKVYjrTXqlUDQfcyiXs7EAqDlU1v5ErQdu0co1ICJbBkRrGI/IJ943rBCaoogVMfSoQEWMOwi7kMHzun0EJY3A7t4Ctq2GjVmlORteplS+BDf4GT3n63VzGEFvOzhohA4b1hA/23GhALpl6dH1OkioyTO3dp659ZpRkzumf3qWVxjPoC8BuZ9WeMppU7kkjB5n+uRUMgzVIZeU3sQxfSX0GQwUC0KvS567Y4+zJdkkLVl4NwyuzpKtVpQetIY8qH0HzgFWqzdykANFrFJ452gFCWfTnfA8kqLK2sndUK/y4aMx5IaapVcY6yL4HC6I1i7FKZAFNkMZZSjHNobTGfTa02OK52yoRvk4U6hgAhnhbz6OENpPNy4TYFjzUuxcpQVHMToo7/BKPJeps89SaEmPfalAwwbIw253B5oMIRhZDtIZt1UTVMharXIhxE3qPJ1PoFZr1+UqTSdHB6tVdTQhCAvur8DLiINCObvbvL8AIl6lJm7dhk6w+9RpE0OXJVCnXgEUDzTZPvg22j6juoTXLJb9U/wCF3XAul3SYKs8RXe7yWfhVhXPZEqBzthQE4IiWabXgAxx6WjCWzk4l+hr6kymOePnMl227bRnEVcOBxmkNDZM7f6f31Yf+fzp25se24/wBDM6HIOGkM/xtaxdBdiAoF5H1MHzCJdtYkludr4GykCy4UG2dxgHZEbbeocXHWmnVfC19Z8v71UreBrfQCYCD2aghe7vEtt54Ha2Dstak8PDplC+dCkOBmVwBV72eihdhMEBzganiFZKc2rc9jBIA7+BbumGsL9zUZaX4EjuG9fpMI5v4nE87ccSe2zbTkH9C3UCRZG2Pt+Bz5zzdp4Qc6BWMGHrzzb78FncB5JTrlZtwSesErEN0yQH9p2aACYSisgl/0LyJ2h0/i0w5AMw/Q7kJ5RGAp0nTrfsgA2eeKo56E8ZcdAMUQSgMqWWfpb3OECvpHnm1M1RUhgnMPZFTYgWf0u/A26YB14t0/BcVlV+jvFwCzlfrXakTOtpIOh0Wqn1Lychvt0YoED9ayxc3eih89D1neQHtoe4u7+S5E6mVhP/UoqvQFKlzaOBfTjsgw4B5hLfSV5v4EvIUxlbCyj7rbfMrI3EhpNqny1M/0mKZhw8NnhVa+YnSRHxvpOMf9/tRsN6aaOrd15J8YUMm+b7JjvyiZXS1a2wJB6XoLbnT4SFFIkzTkisiybgM0BGxr8FW8Rv0erwZzn8AnQlX8mUMb4j2wXWbtT3haeT7K5Aqwfo9+e0RCYa1g8IsevhSAIabG/1NjW2HaBIytSw1crzSD/DiD+YZhpMWnsZop0asWMcv9LEzDeFoLAA8Xda+Su0AEltWuE1nFsM3NQwcJkSAmRxa8iimqwLLdRGGbDagmLApP5cMv65dL6jcML+KPmfZEO3lABC0k3wmvbaqrwQyT9DWiqBTvHKqplkUSYtkh2bqdiWyVnsoO29FLqWj5fFRGYFnxBQzzR3TnnNOtbR/wOuq3813jLD83/Ru8ysIAvsDXrAq5uSaAVBuS8aGcOJIFNr0eEGDe64BhncDUHTQvJI21MaYgk56TTUP+FLPuczbG9CCPNSyNFkzOeigJ+Z+JNMJ4ybdImR0dI5G6YmXUL1gL5RbvEHQFgY1+kM41NFX/J0hrH2+ZLP+nZWGeOqJZnP+nay8UnnVxVj2XJHCAxviiNacqx1zljoc8FMCqms2y3T1PaYUfU0CN9wmA0DswFYNpFTg4bUA0kV4qX04V5HP5X+TdxBqHM6X2BSFRMS7K5sV3Hk12kOY+ATtgYWwjCHaYN+Z0Va2QnVadW/ApbilA3TXFQM0gkQTlhGJSo+GYNQjPKhUuVIjUWEJxYGgaGmAcKJB0TtDikLw3XEZtZjcvBSrlYufjG8UnykK4yd1Mis6pkVZA7RjnwXtKkHh2HDEQqkx9mGgCwCjiXPHdwajQcbO1QcDCaBOToBxC1dihLmsM00ZblapXNReZUN8Lk3mo8bkoclGy4j28JUmRQ2b7rkab5aJQnrJadJb8LY6AC2mDLpDPY31CQWMp2hqLu3HB2M8YnxZR/lnnC10NLZ/VDxJWOZggaxkmGzClDe3xFi2e1SuXSBxypTcPq63fZC1GiW6rRE/juotIH0JV2CvB5YjukSPaZCGjK18BX0G6/nqjJJL6cB9hIQ7NkuQ7tBA3kNRAuRRmGFVbzCBQoKipQE/yDAOxQilsaHcqWJSQ7wKXYqq+dof7VagXVVQi94AbjQqQ5jepOfj5k7nNZqmYPkcuhYCtDViOWbm7qlp+tPt2tF+VbzIJSozLYom/fgq44NZCn8Alny8LOh8cBWq9ykmvlzpSNifWLn5o8SnVTUzU+W8cfZrPF1bmIm1R9lUwm7o1N2Z62yTPu1AyIZW7OOZWIz4yiHP0ohuTSAxKxWd2s+MXxpOKzBHx3/NhRb1HpEjLHA1iwGdWaP3CLwRvZLLkqEvlAFSl3Rc5p/6Ffz0nS7FijFibx0yifkOqoMeiCQsFqBtJcsunIswNLCbazVKjyL0Zgx51g5IOWVLcUnghxgpfADmQfB5ZoQooSzOJzE0GDCS683s1qNxiybuPrXazc1S7rfitFybBX+p4b5gUKyW+ZIfDDduuV36nt+tUo6hGbBfIQKrk1k5hJx0VukHI0zAtySIRQni4HMjOAJ4A3av4z7syJIPaDKC6us59u+MgEJvPAeCS+MqGm0XBhhog16a5xEZuBubssaYAOGVmSnqiDnFMEyZfPOOjeezUF/Zf+U2KTPCu9Xi2NjthrvtXQjZ4SRR627vSbiHt1FL0hIN6Z716tXRRMPfQ1fUJx0Ct2PTBPiI3xqh1ZJq2haRDwwB7TYe1RQgPQCxru3uiTqq0Dqq+CSm17iuCCyf3qRV6DJ/+m1BbfOA3NZXmZJzzAOaC3ugjMUou3i3xu0ZzpBHcB3+PcS4G2QXnpcmxucqneo+XZm+lWp49B0NZMyNd0zYOHPqLZLc3TmKZxLAOCpFGdmdGnGrE+GH9BjekIFVKiC3xiUnCOq3dfLws/lrvFouhZn/mVwaYRvR+z6p4MwShriFkl0G1ck3isHHLYBiNOBiZoW7Zx+WwEeDwOUmRNkQEGgMK6vBrpP3l+kFkIUoFRwqqrnZCQD5lnw+tQZwHS7pybVwVYGWP6a8T90u0/7zk6l2/1Dn9Z7NgeVMFrolyBef21adGadH6QrIbmCMxw6ZFCvKiRYDIWStfUYPpVyIXZ2ND5YB1YVKHnukH0EJc3zn28xCR4oCeAhJsEDJdGyC20frAoVthAqDvVF1bjI1mdOrVdNRa/30sR+iry66xT9vft6bKJcAO5LL4W8eRq/T+Xi2bSyTIY4SzM1xLQTScLb5erWXaGBsWRp/NoIxbjzmTUKYGFPWdvifsLa4jfWIVhNM6Mm2xxI2ISjYjwOXiNJfBR1x3mQsp176YI8k4qen74hoR+Zy5uy9eB1bPjbUOJFEDKoyCOO07K1exCtDqWImnU2ozHLybtWg/3guaWWEfWQyqHfBbtvO8nDXmN1eg+Th2w9U0U+n9/e+W3W7SLfiE+850XgmhTLBeyGNHaxWRsh6duLNhigpoP6dkEWkPHRzkM6sILcAlBqFcI1hE3oyzubA5pH1uhS1O97lP1h07tNIj2EMuEj6LW29b+vpsAMCzYM1Is1bb6ou4PoWdRr2jhHjQTv9zxUWEVnNZ6o+qmtVVR52N+YIQKiG7YeuKUjMLFzkHk4Q+l4/2RbFtsHcMMri3y4wxMAn3c1dT5/P+0dDorjS9PtnsGJ7KvpMdbEwIL5zDjtr6J3yA+A0YSYz5nTZ2GDE8CXT/Au/l6mjw1DQTCuRn3AelmIhmutABxrDk7wFMNQvGMSPPOrVYkqpP641LaLjwG9qcdL51TbgzY5CwpD/esrLvkmP9l+eugiiX/QccaZ2d7EujEfEvrYtgUiABdFGbPb4bBRy6ZJzvvOWHy4vFZmo1Yjcz0tb+5dMDs4wBCeSFWcezRhtbpO/Ud3d1vnOr+oc43TPahdpofWadkbIz5AbfYlqpW+jJVcPHH7xtxj6DEy41ExXvM3pl/FmewE0GexxfoD/YDwNzG5/iOSLBFS4xheJkLMB4bE0033Zk0EPgixkSrXXnoNSKaKM2IymNxBc55K6nZB/HxodSjC4IB56IleklV8KtMScTbJBqBqyoFlx0nZYrXCyUyo7YSXkXvPnNQvyjRnVtYNMgE7PlQBIR2pTU9u9UEO2twktOmxjYrx8GV24qTM1JptBNoDdsKwDXr69iDHoEoh7RSe25Ui691F+iqS6Mo2xMFJe63IaopOGBOwe/WoAJJGa5SoZ0iE400NfOdBzelLEL40J4orkUZpduTcEXV0BAfK37ZgWqDGA93tXsGqQntW0/vjv98RIs1FWvaShAQpYDmOwhTW7X7ye1B773bt2pN/ytKajeYD0Lcm8kSyDNRo7WTerSGVG68Ht22Tn7oM37uT0u7w5k8paziTDS7M1Zi3Ll85LLMSTj0GqoIJQDT6NQwlX61e8pf8U8MYV3Z/P3NSgzXtJ9c1GAce5G7XPuygDmN9RPeoWG2wG3VTZ6wm+HlKshyQ/1iRnEtxPcNO0Bp8asQdoBNg1Bbg3jMP96gbYKnRpulVDFMLGPXwa9STfW6A0rSA8CXL3PcC/g418H7Bb2N/kk8uZFQGPrPspStZwN/V6iXj2w0haiDz65ivtWH/QDZJzb3S7banA0KJbB4vL8N7FZbP3cex7Seke8MFQzdzxF15vqVnDSDgNZOjok9NUM+y2Ss0hkg1xlotbKAuzfimYhkaxyDTjXtPzFRXpHoSmFry/V6jCjls2GankvXJw0baEuL7Q4xq+uFaXrOsobzfsrnvgqKOppMrQSUHVBp5JcygGuBo6DIDBdcpwKzoleeM3RWoGhkSR/mYjNDWlajcxQcS7x2wwHdqZXDMwazKKq9hw/bbAGtsOSHe0sSwbLL2noSiRf4s4oRrrnjOKz4ZtIgYVquNRZZOJdZ7FfI+sWDyYHb85B3lXiwsgDGkxlwFddhNH2+bVbsFkXFFqncY11M6WciGC5u4qqrRy6rZy4mooIO+s5Mxn0QGC0WqmQ0eyLj2oi2MqRdm9pVpaDe6+Gu7UWQYOOzlMC0ECS00HpvL9BJcFWqGKqhKlGkBNAXLXHjDyhaFwDhW5RYEJJDs+uh8Ndei6mwGW8nXZPnge/1FDC9+tE553VXtHNpUCYcN8SYUe1KTnHU2BKl7VubmPaRlSDiFCr41m/ZxT/vOE8zIQFjtQbDEBFBe+zx8YlLFNctKeCoBOdHDm1QzaGZDQPhdrTVkMOPuKZgTum+agKWFeAwZrHC9honO0mQyzyv0IFLiSbklKSHIo9MS9FFlLJqRTkpkH1ANc5CDkdjTfuSEJjW84iUbyv60vIKEiqT99gWQrsxWgR/wCVLXBCROATtGDDhfrSb2N80BWgDIQHZmKvtlgaVLEIUxNrLc0yzpTcY1cgLKgEpRhDxbNYcqnRB12WLU83NN2GZjj6Ct9BstZeX4PHEanSzU/nUVwoqboc7SL01KXxreVBshgIA6CxTuKflr+kaX7265d2HLwGqUyp2eJj2J8Y7iG+or07BS+dJYU9NiIqGI6aXFEGTURZYkDNHGaORfmnss9mKRxkAHbjqqkaNnaQdwPeVAyT8Zq/iNwnHtVZ9+MhuKlT7pTkaODsDVFXpX4NJ5LYhkdN/HoiZU9DcsoLwl/RkihIInlt2t6x6yveNaEMhwCtMzKFBjfTgaO0MVQFWzcr1t03zuiNBSfAX9PC0ESDZOUc+mfzoXn2GqEa/w9yfxAn6U+BF+rsTPmDoTX+DvpfgWf8/Ed/h7LRZo8yzFDH+X4hrTz8X3EUj6AYXJpJVEQ6r4ROTOpmRwlZY8OV2Ch6Fh2kT9S8b6p6VW50A1iTJLK1HyUpQh0WlY63RCSKCaA7Y+FzvLwYZPDiKZi4aDDlXzcFHWo5NXwuDBtrwiMgKBjotvTDqx77y0vBu8UloYDAtaqTUa5tYOnJjEjtpFqMV77Y/uOWH60dtqJRmfQAQidGMwtIhwi7kRbaS8SCtu03iwLVIbllFgZ5iqyFlCaISryOdCyThY+21t1l2i9XYjtOmPLdcVOqZwfYuk+aLgZeTXQXNjlQLSlFQHViv7gOAXPwrKw3gZ2vyT2eKNEWwzyCHj+nOT/hBb7eIe+4MRd6rukNr7MN1wEoKi+I3UhpDNKpxdSrl4PPe8US3k4A/Qulwgvq8BluKt19aQ2KSptbg7RZ0Ayomu12hzf1dAAHLJ6S0zxDvCTqhirgr5xiI2g4I2ge4TxBfKPnDdvCGEaqYEDE/edDY9gvRPgG7QyLC5MIz1d9FXXwpKn3J+lTmnLgzDvdEkhDmPCNpio+rBTpzPqccRbrezk5YgSQicMhrRiVfRUDQTtRNmaucPzEYY6eCGaqKpBVDS3732j6f4iBZS9OiAjGI8rqLeEwBBNnSgsH2yuImEDwEyaUgput2oKuK23Vlun9vjqCSMn/hEmBZmIein8KU4GCyPnAvKwdKp6MzFZLQETdFmOwNUVftUpnM+4wWsmDVyMS2MS1GsNfZ4QE6EN6fQ+WqrBvd8E5rP4EbRfMF4bkNvIGxcAMKqnDYQdqIW4Qzk5y3nx2ymcR26zXW4CmBL+WuDrblC70abEhq0L7f9QMbdRguDWPwORFD0ijFQUfzufFgyxpugAhDpqMwwTY3QrKZmwnet7MYwlp1DhRD7dM2nzQhFEWt0s6OmBrOClqzvybCp+g4Ud1rQpm8q1xyAco1hKNLzfWXsztSUHgZ1nM5sKsggdpdGHzBuyKkhA7KahgwxJzPT37UDR08EXNY5rH8wsX/2IrUkPF/SeEyqmDsMEbKMBpDkWoXuTDF3SoqKsTaxvL3mZlDIpKo/URBf2JutpSqG5wowJjVLdWptc6wGJj4DfI88Vfg00k70lhvTKI9LGtg652UO8WVsrfatUW+USo59U7+1K8Y8IeaSwJyaI3K8vMf7QtX0vgC0Z+O4oqJXEKqyNZ+LT9N6bDI3IFEa1GTmk2EokS1RCMH4rFGuPoXdrnvFXqd4SBhfCARrARNJMWvLki7QEmZo4jnvdikVq8iKdBFOFut2WyvxGbBgt5s2k7AuDnW5vRCPA6bAfuGKUb6wvvXlpbw2AaYNtt6iP5Xz/Hboc4JEOrLriYWX9aGaaHumcXGYzUMAObbu1erRwYHtm6FqWbf7QW3MnCPFUJIxjgo5vsVhbZBZKL8O+5MgYs7W6UwoPudVUNNtU1my4jLYjh4jrbmkk7yMnIdRkBjDbWtgZmLhU6YxDKJa83KdzjxwGeSCJHoTQLh4keb9q3KKhmdteJ2kr92ufcBrarVKkF2dIDLXyCCvJXhoSVSxWJpkkFp53GrlnohXAYr/tgTWZeWERPqkxvI0eI5RYnnlMzux+aAAE/W06WCBGFa5Z1hVLMt3hKjIsF+NxajylGzOWIZp1TpVjjrkObPoQjQd0crofh0hIVhdOv4TyKA8m4hPaq4KUlDULsCQpKaLWzowf5Mu2aAwac7JQmHnAPLDe0Xvh5EB7zrNgbOJcNV3vu/6nM83N5lxfiSDGZcb8wS6vRRV3Nll6OYcurlkE497LTmgVZZ9UaSKhsu63T+ZdMLdK4cvWGP4Qq84wzPk7P5gUsUnPOclNwADK4v2R5aqEEoc4KKapYtWGzWg6hZckYmPLAyv2CYnfMHCnTAV2qQLhjjodcRgnnoGM9iSXhy598FFr8euRxdj8UeTTkcX40ZjthvX63Wq+ILOGg1yCnwHVNmFX5H3yTHuy4LHy5afVUbnE8evubYq4APcTtdg0o7v4pqtd9rvOBlMipw1kfMgU9Pxdcca1HvR9Q9yev4AvwNFfE/V7ar+KVkCYYQJZAAVBeC6LEM5pT0jFyIMAUyjVqvloO4cMrleyj1/qewlPcQ/eunFMNlLehfIe8t5qwvJit8B2ZYp7jHjbBHFf54ieFsGeDdZ8xmowgSTpZrU188bMgok/nCDP+tBCNUQLKAK5MXqQhzyshCPArKWQ70WnvK5tzppum9ZrRQYRKLd2oRPRMUr731Gp3P6UNYUbJ0cxxpY5WRg46wv/YmGyyF8VRUhgviE8EjVcjb71YoNQIAzYGzY24HFNKm4c+FzIdeaRyjSYAJ7phhWcKAqlsGrBlSiEh+of7zJYAXlMlaTR0S6fwi5rbnDCJQwAgsSJptYc3w+aHOa4THhOWg2WELZPfWLCnj4DY/zcEUuxdN+zXk9+Hex+/tpv80hPZSCdaxvaMkytDNZaLla7RTpTICXj0hG489JlQBC5dpQ7XWZAm91ySuurILYUlg6QkZAadMyLQPgPkyLdF53yWcvB9UvKlEOIL65VOfFSxePSCiwOIF71G5jjo426LZQgUplgQdwwCcifHHLXh1NBpWDRcsow6gaD4KRGdqEonGTxr2eVNfnaMtmv8t0iR1er1M4hpxORLztylT2UaLDSPvVvgwgHcU+Lp1eAK7xJcvg1KzDESeZTFXEFgOTGg3rGMvImKuWC6kd0JkUPgW+FugFBN+d6dZd/V047yPtJL7bKxiLSeZzObXl6moqYN6jxjsChd0wk07ThxvUR0Edj+AqDE4R+HmSlpR86VphPI3GBy5yCt7IZK1ioZEofuTIYOpYQJjjzSgxy+BF/FRR9LfwcV6QBGGipuLA6q8dDlyztYmRQqI1UZBQofHBRE0RMz2FCwTvRHxCVSc1w7ifwRGOdp5ypL3DitqdxvNNz+e+z3YO0zyAqKYSRPjCWH8SfDiInOc4RPA3mtem2L2gJx3aHaIIeTb19zZ2YrteG2hThe4EnbqCdn5ooE19arMNl6vZzLN6M4V1mkhN2EUs7APPyeGSKOiX5/1lJUUBf7d4gstBgoixwRgvETWKO2S3H/hW85Pnj1bA8et7WEie14+TQDGZr5pxha6MeR6bMNYCFyEhCrusgRGhi+tody+K+wXusaz9eO8w21TeGMZh2Bq500JI7h2IvJLnn75bxGHt0CUqmV4Ye1vGVn4NNTnUtkJYAxwrOlJ4WQ7u8R+GDh8shTGDo7FhrYq+bQ1iItdFWvAS6asYc7vekCShFeyOQu+keA8BogG/wETflHoEzhuX3r7qOkWO8U7MmYk1k/FYi6Z/WIIrg6IPEkNRFeAe2HolRbMbYIbHwo/h/Xq1DfggY5gQtptG9nlg8w8Kx8c39gE6EWUQeuD8FW2IKIqIJa7CMy82Wd0qZj1vSAnCTQ0ffTtv8nPCDbixxBvyiulRuDRebMhxTDMFGOboWjdM1wuZToq0iKeJcVRfRQ+4NQUBgf2q5HwmCn6fHi460qCx47UM+zEQYWT1Gfrgv6Axsy3GChFeiohf7CJieE1oAXfmri5LI4phQU+Z5JGpJRhjQRY5Q+3qyE7N2gUGVrVL2LQhlTWGvn33bHSfUtenB133po0fyNevAYq26vM1jDCdRyZT1whvSle6XTL4blgU4gEkkEIjM3VtEh90o4iEN3g4rMirfgi4Eui82DLDBrGgZmM3c5KIxXSO5KYhVrZMuSBWNtwmIjcGKqgpan1o2QPr9bekDqNa+K4EWQJJ4RK42soghMPJATmXzeRPadJyiuMCON+nJi14PYxZm1nnNybW7rZ6BpLB6qUk3uN1FfHN+7lgd7syDZc++p1D/jb040ezzZ40Ft6BaiW2KYOm5b3Og4EYigfqArQBePAVA4uycILfWjoSEK39akZ8ioN0oMb5es2L+3MhtF1vCy9VQ8d2Sc3dMP4hmT3uVosRBHGlxY2sp5FpvzhpJLc50CUt2MgF31NDOsb0deAUHpm1hD2w1IrVcI+zolMoH7ebbKQipWXrULzNPKphG+XriK2k9IaJVIuqtLc1AsOHbYu8/2e0Ht8f1CanLEQ9mCONE1GB+3TCQDG1jNVnS9J6Jd8bwMq2Wl+pdmAW9UFrr5FniAKAlDWVRe2IBvQ9CEHF69Gidst2VQ2LztRdeAQXHdz78YiceLi51f1ZIQyeqoJyF81GZ7PtbaILskYsg+C16r5Lp2id87v3ePtww0KdOxa8WjivrkU05dQ535tBzcV6nJHuqSJSdMhduxUYvqhZmpYiH1VjDNm5WoEKETyxu9wZPFbg7p4Ux32n6vMor5QRW8LQ1ztNPjWcs6HD4TvQ0TWRw4d3qZ9zDlqS7TEW8HiCH4ZaXBZ7OA0eTmMPJzbfsSYEeEKThz1Jh3AdAb+WExeP8bRppdrYLkjJ78o5eo0gHIGX4spgBFybICTHPMCfhkgo0NvTU/RcncUJVJHkBhVFy5Shwrbun55eL+XpqbC7DHIh6ror5+EbfrDcwiKYt7lEIQIiGNRvQhJ0D2tkjTWOZM8bdpoOAex2a69RZLIGhEd8TrQG9ce93IL7sTvy7lEPSsra0cSDgOVZnHfHkErFhoWm3ESc0Wwz4Ltcxr4Xut3wHEHsWijk4KfByTLgrEXpYF4clbUWYT5cSP3dxZHY29vAkg8opI7dN47e5bRBaL5C0FY0kQEQgw+4d2R971iU2W2f1D06ag4zbNxNht19btJYHZCbeLmdQ8q2CMrfu1uUrWuFLPbb3CKGx6fNYdhARlJ+DhLPGiVa0u6O8eayRY1NhmMjSjRfNELR/V80tLobOHQTJSh4QtkTxo2HBR7S1CWCyOicoQ9OE+nX0gyT0KoQI+s9mvgj5LttzK8KcfeV0zHKyJdjAkpHe/kcI3i63QzqjYh+ZXeqmMyXU5ldFFy+849X+bvMtfECDWTGa26x0qx51JHJ0nrruWh6GJOR+22XtRlkI+vNV8euizS8cRmqYmtudbyydmCBOa3dV2KHl9S2JnKJWnbeAikchqHia7XIdx9ey05Uy5rTurf31NJ2zpSKG4FIEuxnYMlscEFi1/ozoFS4tcqxg3RWPra3Nu53uqNXK+iU4hqUyEo0YVkUaRn5PHTGPNGUV8JPOZ8I8puAdNOwIC4hikEL9OGVZFnSw0eQh2aYbZCPJuNh2qIqKODLZjK/TkFwyL1nR5alkFOYkETLcpW/63YdID1G+dWzwqTuG3Hlcg6yPm7dT9CRY87GOajiCe+osWNWKxCngYRwvR7sfDA19XQ7deQ8UScU/Ghp1FzcQSDqTEtOHNzskqPE1q5y9kJyquUV6eTJbFfCZenpopgY8nD0e3hrZ9DRUWxhRaM6mPd2RbFJWoquWaiatPeE5JdpSAtca361gS4BM3wbGohwbz6X02/my3NVBHuc5gf0HYcqxD5yDDCLWYSrDdpwxSAVt2H3rH+ktlA5fdvo0D9ZTFLygmXtQYJl7DIQCZ3YKQwYHTeng2QFLbAHBx6xDON3XqtwXjSr3bK2wDZujedfDNMobrxAH4DWvrZApr8oHO/f+Xeprb/T1yxAXzMEnaeKWmaKTHIpKEbhgmIUDsjWdyASKDDj7d8gLhnwJhHdaD90y6LGZlDVawokTeGv/7T+wHJVpa0VxWbg7HjNHGYUvfRD2fU9zXEfEyGfEwM5NEhR238wa8aXRf9aanJb9aj/+/6jRwlu9ttCoIrA7VxyMgFk/BzTUHGNQ025ljmnSeSVnMuJgXv/XEvMfVpsCYlklePI69k5uTFNzpbGUPgGUI6j2uTUZkqoEXiTq1UyuZCTy/ARO+S+XS2jYsAbK/HLmp9h55H1Cdc7xafnU52fn+NTtZDzOdacML6LmfP5vLyZLefzaqKlLChuPs+XppyVk2WFT4t5fstthzhs+3xC2oa6nFd8KifzXEvuLmH76/LbV+yze3FDh3ep+VRV0L0plwX9gh5FUV7ncwUbnV+o6VQWHCCXkfpKFZCoCqkNV9VVvuDKyCvEPPm8LBf8ajk3ajGXnBotygstZ7wotazUz5IXZXWRA0IfWijKG50veLmQBV/ky0qWhXynDNcyn5bF/JZr+dNSaTnlNmDqlBOmyyuZX81lVXE/pqrUNO9G50U1h+qNXspqIeWUw9EAG1EMq8GvFYamTBi/KURyYcwi29+/ubnp33zcL/X5/uEnn3yy/26uisuEn7QBvSSr+TP/Pewyyo/JNjwC/z1b83etQPOkIIEj5fx77o3AIZwHv2wtQ5gMKLCgYXDk/OHxhkYaRvXVQnIl0LxnE2lhA5YqodrMlgjjAGHfFOOspEa8LFJ648b66h4UqRa67/1yema6y284vbMWn3LWlKpAG/ki4nm+ho9vMMWWSpJ16oRVJwAsuHE6IyEQXE2wexdlzl6jFkitdPTGCP5khVdEGY7sk2tmnNmHSEb6uuGPcmiGspd0kp7JJPh3iUOyvImXcKuz7Nr6cQ1u/rawM4vUiDfEzwQFN3QxDRx75Kr0RNJJGNc9YSLmJHDpy2Zr1jF/UlchkOiBB5YQ/tvqTE8UdZ2VVtE4bF2o6EUh7qrr86zlYD06ODjYB10ifpWbi6z96P3DPkTgxD/Pv07W/EcEmBfmas7Pyukth4ucX8h8yuHE8Stpck6XiVFmLnk+ncI1wXNt1ATeKzWVfFaWRmosBz+H/OIRv/iYX/yOX/yeX/w9vzjX5XLBi/yaV2T0yafqmk+nfDrnU8Nn6nyS032EbqgkX6gJ+rm/0FxdnfO54le5Kng55wu+0JIv5zznZzw/O9P8bKr42bTkZ5pPlJF8ApwNPE/TWcHlFVf88mzKr3J9yX/iesG14dpMuF6e3fKKV/nVgldX+XzOq0VecCBHi3NeLc94tVxwo9D1/nWu+c2Z5nh35supKjlAaSCcLzneV1xenckpJx0Fjk75eVUu9UTySV5c5xUnVx8Aq+lhKudcFRV3Y5+Uc/hPk2XhLa6FwbUxU24uuNGc7lwcIgjM+EzJ+bSSBu8YTvf8PD+Tcz6X57KYwjJKDTc+VW2v/nJpFstw9TtUwGMIU2lyBZehyuflOb+SxRL/wM3Eq+XVVa5vub2YuSQmB4drqLzhzq8DP5uXk8uflqWRXM00KB4Z2C0J458SpnJ9zvNCXUHeidITRFvUYpGbCz5Z6qrUcKHCjVxNuJzP1aKSnLw+8FlZmL1ZPpG8ptPHz/n5/HZxwdVVfi5hI0tcfKn5VV5d8itVVao436NM2NIiN0bqgi/K+e15Sb9YTkN9FLebV+hfnVNgdPnOYEmDe2ZZSX6t5E2CwsxnrVfMjwSRPy3qwaW/jvPgxyFqBGYJHGO884b06LxGABx43uqVBjHApziv2EvyYoJxOW5KjRe7nlxweZUrGMecL/U8icy1vnF+TDdBkANq03KChFT/p6XUt69xy2Coo0Ggl32ehrOPqbpOIo+cUOFnfhwzLeXPMr2rlcnauOhbqvddsFgoAG85LLLU2KvTRBagUfiyOLnv0Cx0XyLNY2O0OlsamSbuS8LDIwNZAq9148XrrNWvcnunX7xOX2BIOePreSPfmRdgXNyyhRqVuKyp9KWt//IPKGxzptKbXz5BhvlGsHXZjz+jZeKak59B1DnIml7oom8pjCtfLGQxbc8bfcO8hPJsG7/sh+9rDmyO1+psrorz9sxRhjU3pMPcntN+xEjaMKsnBNU2umvCN0Fxt1/D5YjW/RuZa/vH8ARsKBl/VQi7xxsFvsDgS44QblaGe1TLWdiwWs663fQLyAFAB4tvMUp1mdYR3PmiYQ/l6nQxGbyGmre/LdvMWkEuIudXPBeKNH0GZtgwXgK11OE1/oKPDfgF7XrQcYRHazuQhS48Kz4rdWst8Ndze0p2dNDtYpJ1vEh1j8oxPZRrhDFPCuHMNhJ+t+bgy+XbQoysQkUSHMEnzlF3whPaxYmXiyRR6JrvYO5UtH/Ae4kQCn5RGkKKfFaxPIorgIkqRBkorBEwyFQdFbBhsaYc8Y4QDeoMnvmtmywBBIN0xYl6cLZLGp358BLzmPvyOBBuDWaekuuCpwXEWcABg8o6jOYxENTfzPOJvCjnU6lZM0QIjjNOANvGeoo1dIwojZ8J5AT3VCFCqRJmoI6EHvR6ihVpiaGEbKgWch8UvGHluOhfhWP2pecufVm0Ho8voeEnRe14fGkn3y5QFAMWxIONpFYjQxe2HCSpTwqeC0MPlfi+2KyVy2BvP4EcG41wE3IsQbVuDo5JYXaQupgwLSqyfprAjx6mql/Op9+ha1wX5/6HIlVhkxsuGVfAg0KCVM7C+SYB7rTbndPhUsB4p9LAsWsrS9cElFn6MgBNXMgga6bS5niXTLqWsUXXD0W6hHEkrlpqcj0ohwat0yk9AbPgWcrWc1u426XPi7IyKO9M+NYG540G59hgcw5sw2SDVDI/4xWbYCzZH4oUJz5ZFjQ1kkuw3yDFQAwD2I6tBRdHzW3Tmr0WE0bRym+GGUk1uTkEu1I1U6g3l+rwJn4qQO37c4DxY6GBkSDRZCfSRAzs2yrhGrm+/nhFvtk+r1+iOr95YXXDoUQv6Se9mte7uEuga/VjqQp0jB/5OGoxGpa0yfDHxt8oUbGjTAu8e5xOohWwahSwal74myuMp5P0XO+ApRCCcFCQvUKMXhX8qyKC9X8ilaC7rWrI5KUBQSUFRa2ry6viQmqFmACADnfyHeQ1qY4T7LWLceeExtFNhKy5LkGTVt1ICnGbi3TpDOvQEi/OSer/S8b4kuViCR7XJqNyTE5p/1CkFRoe+brSr1arnxgcZwQeO0JM6MlmdtbS9jvjE2ZSqBVaPoFrY1hZbNAjQi9epzcFfwcfWXYGP6vVRib4GLGE/hDhohZT80hAspeA0Gcotc2T7QIiNLws0gKYgM2aIf5XIZosYiel+PT5k0+f2jg/1NAwMXopk8zwDYSuoBEYNtz4hK2vVsksn1fEMR/a54zqY9nJfd30swQeR+q10yccqR9zmCqfBFgDVs7a5oB8/6lZ+lW3u/MleGN48+kf3zx+9enj2uBXq+TZi2++fVNLBMbYItz9dupAE+dUycWFl0XXlKErUy58QFmQduTnOX5l3PXv0+tI2ddhPGjFinETtn3mrmGQ2basEZ1soz068CePDvypiC78QtfPORy90p4e9BzqgmrtpCYta4xOOMWlt7xLTZqDW5Y038iU20zM+/t4DGAYrQlPkbWuoC9YQFaDIsW435V4DaftDbwyxsF3kgINXHmNGdHDWJ1WxVYSXjEeZxSVnQytudK81DzXvNJ8ovkyTE/hA9QXes3nWuyP3t6wfu/t3unu2/F4P8zYTEdGfM4IHxTyK/iDHiWW8GcuDvhMHPCFOOBTG8u4xccg0B3CcGNZ/9ZvtmY8Zx9/Qpvsk0c7KCCCUOiHwctqxT7+XSNDVcswYZ/8fSPDpJZhyX73HxsZlrUMh49+RyKuw0e/E6LRxd4ha/+wBx/mq9VstVqwO2IjwXmY5JXsfPy7rAIFNFSYHFDSJ1neSPrk77NJI+l3B9mi16ulHGaLvb045ZPDbFbP88nH2aye5/DRx9m8nunw0e+z+d4e+PqlCQkY07XQe4f8wpJrg+tjcdDtUlC09CKIa67B/Gpvjw0uut25JhXfC/BOu8To9WTQ6730qGE6FRp85kaSHc36RqurlLHsKo2wpKuU3YHnsbSMFIldsWlUjGOdtUj5atjaQgYMIPSJzHjJwsYsaxtTLHSqeImekTaxoIWuqXiGmylNSFv86MAibQ9PZylEwug9TFgKuqUJS3xQftc3UuCxr0XPqyvYwtoXThMGBKEaJjzpqUxFt8AUT2Y9GHky+m4pO9avox5ngAlFRa51Q+wi+1f5Im3jlozMeL3p6DrKsGbZaByqvvB3Uiq90xT7FK0kaaYZTkLuAhVMFvNcFSIOPHcVV9Z0NPtLK7vVAbHAGp7nCzSr4/Yd7pstFYZaznXAWXlJJGNELdZeW7po0eassHUDWD7PFPfIclZu6f6pTiPIa6+UgRIKVDSSyRxie5NEPVV9rc4vDHh2TixWDKz8hNv4iPY7y1T/Sk2nc9T1FeA3rZLLRcLgJrGRgbvd1BeySbyAYNI92LoY1DjOAu8UkZq+27jFcRabBLm6Nhe5ExmGPJSADnPoEfEBcr8TJ6AXngyyyZBB+k8D8kNsNSm0BQPrgQLWClwLEaGknEdUoO4GG/4GyuHSKxBNWLb0oYchu1gOy+FowpfjbLTkk3E24aZlBc90PbLALrwnGPJvtaKX6z3UkIEkF6poR2jvCUOnZIJPgQQc+rJLpzkU8K4zvnz98kWfuPtqBg5KIzx7t96bUBqZS9EBie1CopPCS3Rf7mObl0f5oKSbXYF3eFQiwktFOZOBctNkwMfsrDUZezK/acwa+SoHpM0KPEqR7O5eJwNaX3TtkKRWkrG7e90RQnQe0iQ8HEKKuw7gmSUM/VCXIjkt0qRXAph2MV1O4MiVoIiNbpvcRkpSJCZZwoP5TtaYasO4s/8JimOdFFrs3GFo4XUSLcaJbvXyQvIZKaTtMtfay7F5RBaNEnZ0AKT4PK/MM5c6TtiR1l5HLs21aOToJwzpqTv5Dhx/uVsp14xfytvsIdhE2NQcEB+wj1hnlBtzwI5ZD5QWkudaVFpMtMX5BjuXOmUD9linpRbvdMrY8DU8s+wTIJxL3e2+xHcXd77eh8r2waVUgDhMKIwHG9QUOUSBOqmylwhQHEjAfiwFZVT5btFLeAceL+UtPeLChYmHjrmrTOkYn+v18tgZ92WUMdfHQkdb9LGOLkTETuVqhWhs5FP6ZUCgBcUyrbTItZsntA1KJc0Uew2ZPT6KEwb6Zb0e/+Rj+7y3h/7nAMHEeuhcRaoVuqHKQi11u64RQHEHbIBEwhvNX2gIfa0T/iM+TJKAi33aOIJv9EYQZfUBQZS/1ugcqKgHUX6mG2byAyNSJQzrn94oc/Emr8AjlApvMUvxZ/R7GcUVUu2N+/hBP1NkLMbf6E06E/rxw/DO3nNZwe1dlel1FtPdX0ddTvVq9UazVrIWTXHDIOrE+3NLgpLvNcv1KYGRsOPZRGXBAo1qUxD8lULG74M32hKxDdAB6i0v9NgHqPxqmEwu8uIcWBPWtx3qiAbP6Jgf/Q1g8GWHMmDyGqr7UVOg8T5VVCv6IxS1HxrFodg6VYxL9OnNn2n+teafaq4Do/uNdrGycVM+DXTqc0+nPtcRGf9N2xy6+AS1mfSJsbyBpqwSjYI4wxOhN1NJPEGMt0nEeNvITLy3CRDwzKQTUDFC2YUaiyRhoRpyklUKyMGTSOCIrorQAWEh9Rdvnn+NCZg7+NLtdqOX4M+Kl0JgDBEGM6uKpRyQP0HMCuLUyrPP85oAN84CUf2BqgqKoAqs/07xVZQWWzLA90uSjAxFIODDZzrN+RKHayP1La1nJxx+GbvI+KxGhzjX36CvAVyql9+8efbyRYNH1aYjYEFApCxAavNW7g7YXjDHiVAP6Vmdhvj3bdVrYbNZ1yHPHcpofUYxXBSFgSmdqO5wgEmEoNjEzpzigcxTQtUU3unuo0NOEX7So+uoDv0jAcOrcC6+8efiG73mX2hxm7bYLKx5IfaztN9j+wPPwCf3K/uDdLgz+nM6/ugtY/vnrNVbjZq5yrQPcVuwgfYGm8AHG+nRwdh2fSz06NC9oCDcrCPa/kl0FX6rg0ur0DdkpaG0fXiZ1t4xenoUuUXfpxT4eIuG3RdQKiOllO80/1mL/T/v7e3zr7TYf1t9tKOuFqU2eWF29/mXus28/GftHdDY7vcrabxmt/FxMYDtavMWW/P2tUQma/qVBs0BnvgOJFFQfS2+13bzNAPENEy3i6auo213pMlvFnUtTlyv+U9ajJLv5dmlMglPnpc/g/JLlYz5981tpWbpd1p8p9+j/EPVu7A9wL5IpThNJWhboq3Xd/5sBGc8JnCXDljflN8uFlKf5JVMmcdFD51byJ90i1vIn/SoGPfQJ4qut6LX8S78vMEDRt5v2eD9qnjvMRJsV/653Pha2q/OlSqv+MTeM0tRy87nouwXpb7K5+pnOcW01cqWxytoJpar1Zwv4IzY+4Uqh68Dm9KoQRTpwl1MFCZlwbKF9eLZKrYGqTuaYEdU3qBFm/kXaDkX4ol2+g0MfLJp63YBDn4zOQQ1k4NSlM6jDStrtZXNYn5JyUE7VlPBcs8YeDet4ML9UqcTXvEkiT5PWZoL/L4jxGxUjX02IiZyuM1yyzv/IUDazz2k/Vyv+R8RUPQi/vifdAhcg4rbDowzRqEugCkPmCGLmIYdEmcZC1P/qFmrGU8Il+cqsdGoMQBTMzEAjAK0jnsphvjeEBiAxCphIBkdFHGPUE6aoLaNbJc0pEXPsADcg+TuP+8UEOKyOQs+ncepBCeARm4KyNx80LwFnYEPmjuuhZ+zaB41OxYH5F7JgXbNYaxwCgo3LcMtEwzSvq3djCZcqnA7A6ZWOi+XTT3OO+fkDxlHsj8BQdJlarhRqfMYmFwnjPFLNFzlZu20KzfuTijCshhPN6pxPziCvjBSk/GA7CV7+Jpw/HlTNtL3TGk/PUbUrfmZELqEz2Uef8RXmxrXiQlYJz4166TPtk5ATwolnoBwlGslkiCbS7hSIiGNZXwtG5/z2rsEfZCqXmISv0KGQQH8Ji+ruFHFtLzpl0Wtmkhj1We4wau5kS2FHtGl/abRr5tG6qcFGPtvNhz3bnu79Vxp5Zt9XB/rTT0RG7WsVSWeDG2tYI0kq5DtMw3S9/s+9oExmlIOFjnYb1XxTGOd6zmekqWK3eQvIS1m689UI7juhoCWXHBuJKNdahF5MATAmRYuhAi390LU1EI5Nc/N2rrd69ZWuGHcgtdQ0VTV2TIXVDHyRq0WoHZu/5HaBSEM+oyoO1guUhdWUAkhtBrmKpsoPhG1aBRB2bfO5KjIbS0GmqirAVCIWGJX9XqTY9A7WcLStIcumBzZ77zsHQLw3uDPQFMIca6V2H97luI0gRnEKp/PWcpXuyy6kS9UHc+xu+tcmhPr9BMxJortmepRqXrJU4i3kIwRvHsHlWC5U/ocS027vSVTLq4UBizjFWSv7qtw4nPcU+ESKkSLeCtW93r3dqGOcbMJrfhc5HwmSm/9BjmUGi5tDgU5lnwmJj7HTKSFSOcCzIPAZh7JdnZ8MMyPl0OtMqWQvcuGBTXm6s5cFdkBv4N9lhXc7rJszv0ey2b8Iq/euCXKqJZu91oRNUTT6aigZBxfbFcqBBsbOIb3kff3w6SQnunkUUE3jpgDaOpyzTiY4q1KDevdqlSSk7foYN2qCJgcyo8/Iv8XaeBO7x0yf7EnPOHISQ8VnKuYtECdabQdPKXr64wCxbm3/gTw6PmcXLeE9JQ5QQRc9ITuB8jAbNQG0O/dwcrpvoXKSTMN0PI34HjlLvbzNKkokCBCiKXI++Ga5jP3au9SvnAJ0Q3KIRwAqPLnttS1f3fFLnxKXO5K5H3y0/MpVMlvXeX8HPLPjNT04dT3yk0MP/NlH2O9fNc3wW9cafvpJPTPl38n8v7UnjR+KS4MfywunAfGweNu97EnPC5F+li4V+b9MML8vRQ7l7UIVDsSvKqXJVBDUhNcfbla7cJRFkLs0hZ4LV52u9PhNFvyN/B4MbzIFvwFPF4Pr7MZ/xEez1arK/4Mnlrs53eHu9kt/xq+3qxW5/w5PJ2sVqf8qZinZfqODd/RnGXvGP9GIKJXIUrzmdhV6TPGX4mwR+LQPuzuGwibA5DrBeP4+4Yx/ipsy2H6TbeLH14z/rzbfY4Kwl93u1+nivGoVhQHsYHdq9VFebNaGfQ35PV3m+HJVWTl4dyenIKZCDIBw/MI9e7HA4w1TBr3kjTuUS3UH5rme8r4s273War4K7Zm/Mdu90foNAx5Zkc0oyHzeQ1FcOON5mG1ojIvGP9stUrPVPqUDaPL7BV/yrKpwlggrxgDoMKjuSDbUh33iPFvVqvPVqtXac118Ok2+BGOePS2AT9s+gfAD9JeO9ypgQtHWqfMhq6KABe72wpHAmrOZ+41hiNNTJxP/Zn+Gj7xa5eJX7gTTR+ufO3+PAPswOBIlOM8Pt6n8e4/g91/zfguHpNzNjynqrJzxm9EGFn9RMS7stuN36LNmbam241q3WaduqM1s0drwRi/iY/WqT1aS8avQDcJde9hk1x0u6hVH90I7oDdDm/TE5adpNGdc5Kyu5vaXt2p7b36kNL2zq9W7ekU7siOTDI+7XanqaLhzWzvZzS8tmO0rI3ZHaMZ42d0jHZrx+iG7/pjdGOP0TVgxorfMH66Wp2tVjd11sNZdGNvhnPvdndU9SJ/UfPQv+uIZ5NKFhj3jss9K6o44vpwVzWYrpoNgeOdaZYByh74C/TEjiPNjxuLz+xEJoi0KucqtWYDJ2rDSQ4phqMwClUQlnNZ8aWQeFRfWt/4pTgYlEffepZvr0e6bdXoW3BlDrI9nouDQR5CVPZ6OSvSySgf2zwQTsw+WsWW6FtESQW2/TLaJYCDFalB3fGaOSCP53uBxAoK3vgEIs7YgHtyfoUWShMSTWN8JqyT8frlKnZyvlNjxUdhSgPXtkhzC6UmomgL/cq63cj5ky0icgw5hD3JMfSWggHlKYXlbq/IQcop0V2wQSWpEjOucUC17qKVg2d38lzIQd7GZ1Uz7MI2Tisv0lLkxA+F7gLTNALr7C7siqrvjN3i3RFSR+U4BWslNjAuwpvVI7hOtR2LYms/2XznYB3enIkPzT1fCOmFkfyKbudBkV6xIa0y7NtquNywiYVkfsWyxpf0ikvGz4E8u0glX8AMF+mMdbu3m9PNMrJVs7Z2vsll0/61T5LmetlG5mBp25Y7gjq07nfewMsCS9quDKOJwKw6d6S8NVtrqne6SPPWEhtAzvkViLhSOxswUSxDC9HICVWs8+ns7ciXD5p9DmvHWDOi1Zd1G2Aqli1r5rsNnsbF1lDzphlq3oStqNkCI3Pxwk6vdfiNDVrxmVuEbrfRASywsWRWDm0LxV28cuowA9l26KSQW09cuAiagTBu60GxaYSV7VQ8UJc20njiEGJ6JQ4LedIiLW021u26R8oOh94uC8iZWtb33ANnAmkGLzEbipyxZb9mv2znz0oLOmEAcqAH6Bol6GUAkDG1wMM2qjkuSnu1PLi0gbtBXGC3iflM1bpXH6n81zQTocx1tVxcaH0klN1mBdojW++5pIIUkAc/c1C6dpUo2r3QMRUuCOilNS2lOJ6MU1CwyiWHpTfMJ47MOJUsrE492JQzkmgGmoL+n6UhHRTTIyymfrOEIVtzOoyJV6Sl216wfYfpTVoyfoZWWfO0xCs4hms3kV8hg36FnNTuzpkgOysu28seabRB9qEJ4Td6QmWmIXb0rQB2eCDE3l4RCnS7gGN4FY3wQRherFN3I3GygG672gs0dfYyycJKTlm3e5Nq2ph0Rmv9t2eU0uCMQlcHwc46rL0W2mZjQ2LIZia1Ci5z6mANKd+8+IUelEcKdWUt8mJsyBkK/oX25rlHLspoYd6lsoFBgfKKApiuPCKV20h64DdzKtEul+5pZW8ToCZtKOMW625WNG23fTzZ4Uu3AmDar1pKgyqOU3vQKeR4jRJvsDpPZfyqrBm9tGb0lPtkXhZyiqbn8PoSsSzWInVuW/0g+JzyaxsCflCk1zgrU3EdFnEqpn0XI58BKSO5ctEgIyTmNgoWQ+tzzSyJhssJ9k3To8o6tA5bacpc2mg6prpbOkA5fPNrkyq6tYZFeoG5btnwYkeI2xoe6Q0APFMPNgPaXE3FAb8WTicCDXiAROFXQo+uxzgc/+lcKPh0JtTodsxvxE4+mB2J6253eiRuBwzseIZQvNebjTMDWBzUsrd3Pc6+K9ILDmDkHfzykis+Zdxlxpp7vemYQcYrfoYZr/gZZrxl3NWDbe/t3VLGC5vxImS8aUFFLuy1H3n7SK8IgIUe1Cq+sl29Cl1tqxgroeqjLoaxpBTrKa3Ez0Wq+YxfM8ZNCjTGOWxhNqxG+DDOTtJzbnMMF+k5XjvU751D7AB0ay70aDK2fZuHvkGqC03Y1s951E+WtVYfej2YHV8P8WZM1ei2dzhmQ0CxMnqxR3nKb+E4T49vu93d9MB2HcItX8AXPmcZbkWk1jwuVvedks5Qb+k0nREOd8sP+G3YbHDRwKYeQv0X/IBf+G8se2+1GX1HJhUhCi356BPjdEbbzrtzERBOXARZL4mqsXHjFZZyDmgYc09tmLqpY1BEeJmY3gLwHm4Ru6AppBLl/xjdWFKsZWSMxV72Yo2hS3kbO3B62SCC0V4GIlhNLI+BL4WpAbASPH1Mut0JQD9u6D7gOjUR6QQjN7ULwF9Fph3i850Dgo4TwjJyMdlCTBt0XgOJpo2cDvQ0LIWvtmIWF1laRZKLvDrxeqopJmKrvkGv7Rt6YNVoqaocMc+Q6Lg/65oKyBzGBuTtTOnKYItg1jpYBN8VC7jEgUk6W612XqYzvhwtxpY4nkNYKaKmZ2JW808EJcBStN5w5yI1fGkDYsNsukDKO4deS+qKtIahgsfpFeSATt7ihNnGdq5hgSdkLfyDTO2zM8ckhIb0TPEwWeTOvjtxWvCJXYvsizcPCZ6U7d+Ew0j5DByUOHYaTt0CeXzRrYz8GM9mhsw7U4vvKMYIubEABP/kwXR9iq2iHYSvARSULvIqqMg8I8X0pubMMxDf7mDAYsCDX1I7frddQjdxY8rBREgunQOhpdM6Tiesb8qvyxuniUiOhVwc24kVShNqxS/qzLFrHCjOBb/2nFyCwxeNm+ya2XjjFu64Vb/1YhJ+LgD/GNwOAgJwKg4Gp220x2lEe5yO01vsyS2eesQF+Xmo5EYcDG5aSNebQLreIOl6S4jSibjdgGlQ/Umfgk/7vj8Wh4PHRyfAUHW1Pu71GCaMHo8d7vwF3DGDW3FrB7r29wV4TDvgB/aiwKi5QLGt17X1mzOOY1oTe4NypHeWU5p9VnDLQM1GRvOl5k81f6X5D5o/GTodvxvFHWcMnmkfNZxzIQs3YqwPT1WgAtbZ3dqbQ/yxADcyX3a7QSt9w7EBOa9TZWGtMvhGlIB2lfYBbv5rNEvrdn9UJGIDew5mzR7fKXHnnOk0vLwBE8i2jBaj6L8/RSXVbndHk/js2no8Af87xTYHO+/Uhg8h56SCZZdWPSXYd8hQLZiNXOULG/vMezl/jdKPxHml9N1D/1SyTxFoKapaUMQXJthx8ugZVfLJYHhj4q2hAbZqcm0S/kYx/p6cqN/1Yls+u4Lw/UvsIi0PQB4GLvsaE5W1aJU3V4XdbUyiF1D4qeQqfts+sWR0UJVXMm1zWrjzAogLsPdcM5ZK7xxxaMj8oVnSleu8VIRNscwEewrvjKrbfalS+wGgvNutdroACQsYzaXXaHrsnrjzS9OuNOTzrflBRHc/bqhGmciEww2MlKBWq6ZYp8aj5xT/209kM/w38ur911E15oqV4sdU89cqzdG6EsPykmvtHesJzyeIMpgJvEixSBSSA+6XVPrMaLZJgs1mqqgYG6jVaiN97zBm7LxUNbt/tJXWty2KtjsvXACTYE8Yi9jICihR5DuA3jJrJxOKvMEi0iqCBeMecN7iM73Yksm61muWPOQ/Kv/BA72onz+q+9yHXqN5AiB9+FglpLynDH0BBJVQgf5UVQjw6ENsufdpNBM7W7wkku76htB9KDMovV2LHjGJZ0rcIezI3ikOl0xGgROaEKO5t9NCfKrSgjHbfNFsHs0bTk+vX2p1rop8/lRV4BpfJEVZSOtxHe1TpvQBdOEbSRBkXQ3TItyAgOqdq7TgNVXBWiFRrsm1US1RD8uMWm51hLkxwsGO3hE7MWhJ3zvijZ7q4fv62jZDa5advqeYHUnrONuqtEMHJ6BFy+oS09Ae6ff2D8Ao/1oJcjNhozaRDlT2pCznMi/4pKr8M+wulw1V+OxzpLJtUyKF6zjPm7KWVlPAjvPFmtdx3pb0SKWsnrQ1b6NBpwCSjUhfj9t0cks3ju6Z5yqK29rtRufR+XlzEBJkLTUPby5+1vC5SiuTmoiLn0Wg76lq2N+FgHKelkPrpCiuNQNmvZAumL8Sm1Fx6zHLFTOj07RkYwEeF3yfQy++UZ6Tv/92uhfigO3uW/M1xDK8FVZaCxV2hz3LNsNQhS6vCWB9psQ2T7urVU5uh1+15UngWBLgKdAl7xduD9fU7KkjX6ta9LJmXK1aZCFetMXXsnGy0QbDurT5TDFWc1lJ8VHR4wMvRTE6QKq2GV5gIIX0GoPIlGiqV3kyOo3CwgSuvtXMeq7AZrYWt7OEVyyDxKIqnAdYWs7SKWsnp5Ge+F7SoyJLNe0le8kgR/66tWmC52EemDzDqpdM6DHJql6OYbtUmhMjE6wDrByVUrx2e8WGmIJl4Bf/WjcrKekkrFb2AXWGohkRT5WNvbQUUUAuPodJWOIk5E03qN3uRhLhoa8UWRjHsJ3xOXgwpZtgp9Uxt6lLHUysSbhOcz4HZdrcpPCb7szb7va2VDuUMMFeN2Le3BfOMnyABjtLs6fQH6FmtYjLduHhwjLIBQ3acDUCzERZD7mpxeNMQd/RbRmFboX3SorBQybzuUmDmGmJq7jg01jjfwHa+sakE54E9dwEWMyUWFfSdR9mPAmqeUnNJcFCyHWg3MEWnD9RMCWwAS24RgWiCK7DxRZxPb+1+CJSqZj3rNutvaZWccIrQna79fdYbe47Wx0uUyFvvikr4uOAocCTcoms3pO5koV5JScmLvpzfItQBYuy4oV7odow0vtczgzIOeXMoMMvUy72CvgLC6NXK+W7AEPwoUpL2xVEAAZWzQe06UXZj4x+MCXx0XrSpKd7yeIdeAqDX5bwWEPIGRyI5KBK1mvroeGJQpCHzX6lxF2wG8q+UDy8fQ5BJTJ7NTxRnBQ4n5PGf1uIQ+5CvRGKN4hfwl4L7j/EFSr1t0YwhWO7QAk+4eiULGyyShl30dStl5G2SyJYGmNX8I6KI3fRgYXUpFrkRcJb/flye0+gw0WLAlCKFx+qtisI3GLwsp6TVPQ8eKzEwaA68o6VKicongg1qsaDiVV7Rm8rEwJkwJSyIHtSB9nJ6en1XFUmAYhW+iCKxWhCypwTnk4c3J60w+0c/UDoMHPeITWapMyOvBX2zHV1IfRoNh4sNqBfzhf+pIjFfeeMF6MFdnFonW0tWDZ3T2sbmHJhBDr+BG7x0gaTI7bdVMzXHq2xOUrvcX9L4NDaarqN6+GRi00Y7CV7yR58TbyNio0Bd5FXz8EGFQJJWk0VJGedZeu3yENyb9/V3n5WdhynWs7m5U0gYSEuTL+czSppvpDgwC0q1XTXEAGTYNQGPdGisOBkBgSNYVxHYEVvgBXdCjkSXmyywHLFCweDRTBShZ2DMa2IZgfPZauV7nZ39n27Dh0lEzWpzS36nl2t0qLV5ixXqBLlG8PVXdB4gGkB1jz8SpqLclpld3Y1sg1H+zuFqnnsoGm32esXsk0cuKmczMtCIn8fQVWLIV9bauty/UGnBfJawFYQV6RoJS1tPFo5r+nBFY4jeKHSKLaUyxrr4xaM1wYjdD+20FoDK26JnMaZOu9DkLdvK3TfK04LHj6o6pWspL6W0zf5uXjW/gmkL+I2/nYuzRsSp1SLfCLF1/WC3xaXRXlTWP520wXazpMQBUHN0mdFTWfb+UiriWgIPj6HgCtuKfFl0AhtsyWuTGfT6zQWR8llURm9nJhSBztaYCnVx7Ba3ZPV5smozv3N0oFAKy1ghz19mS4LT4pG0QKeKVb/FgVk/UoxHge2DBereDI8UdnL+tddDK0sWtHnVvZMmDCIEm94FDqdbl/kYTTSxEy6UOQRBpHAtVrjsVhEIfWxv1MKwwMiutcG1WFe8ruzRjAbLOnNtMhfdRQhvduNWyZsGTjRJJ0GTNpGCIxjnof6ACunCmwU6oQxLonO4xIYCk+G3xTBSh7j/TzZxsF+2p/Ka1OW86rb/UO3+4e+vFIGkHVlEr4kxjbu2S/B6PXu7V2aDrP+6q0evi1Yb8jert+u98/5T0rsj/b6H/WGf969W6dsNXo7frv/9u14/5x/r1r9AuHt5KwYf1I8eft2t5vADMjRYdsXt9Iw+RR2PDW9xPaHepP0Cp6ceynU50rYIIJfydsqGyWRakMy5v4CaMQA4mg/DEGSLcAl75jOUUNBDPBQlWh4XyyYg4pnUUHQ5Af2pXNF8USRbYtma34uC+BnZK2B/Rp+iZwjbojqF6VkEG0geu8lPGG83pYtNfH5449UwKzX2PcfFP9j++yhMsgvnz28Uxqzh1U1Z+8LDRNYn0EqHM0gJvzaGcRmazOIKdEM4rubwbgtXwryp0mv/rWXMDuH/E9t/Kb0ByV+UO/xW8SCZoiQ/AcVB4Ja8z8oVNfRMqf4iRCIsJzbeIAU9M7GMaTYfKrCewSUd85lEUVarAUNpNCCN2caBlxSEFwbGxACJxo+V9zJ8hYYHHBWlgZDBFLQQG1rgtFj+fbgjdTlEKYPYw22hiScTpsxATGKow3h6GIQbsRytNEhYVu2xYaEvrrIkdTOheYYkdJGL5yrEHYQp6kZyJCmjQI72snDHeCDFIb4iY0pwpiWRtNcA5wrxf6f31YfpaM/v62Sh0fHb/fFuMfSYQaJgsHfYZakoz8n449Y0ls9TEd/fjj+iD3srWwZcXT8T+MeY2y4z3UpklG+9/PjvR9Ox6O3b2/evt17+7Y//ijhqhQIJpOeLnvJ27cZG9IjkOaliOBp8uejpKdKxnPXubf7Q3a8z6tmtrdv9yFnLxn9+Xj80XHC+ASKHO08fXny5k/ffNqB9N7xvuJLSn8LHs7m9nm0z2e4TWx0SppCL3fHm3BRAuv6uhR3SXduBkmWHCU86Z7j4zE8wh4aJOAnlifd/GoBH7rw4T8cHsDz2wJfPsFnk6z5RSn2u+kwm5vVuVlB8VV+tWCDfWD7tH1a/YfDg9V/+ARz3GKHF7rZz/OyHVuR3e5tSYGr31LQD4jrHzhJp2XNl/vwqswuygCq3P1X8Bbe9nUJkciJ+31W8t2S35T8pOTvSn5Z8sclf1ny1zDX/7j68/VeWWT7/A28Xu+tICnb5y9KsZ+O3lZvX48/GrK3VS8dZqpYlTN8th/YPv+xFPs8Hf2Zv12/HY8/gu0Zv7Lh7j7/FDdLunrLdvfP+bMSvf19xHb3+dfwJYM+oIxpnz8vxf7b/ujP/XFv/5w/LcVt+qeYvfZNWY8DTh4eDiGiXia59/6cGe78NW9hrqDU44AHh8WD4kijnzYzApcLSEaPMXyRFe+FuLiG2diAWcEdkwS8zEeeI+3anZWCrrrVaqr5Jbyp6hst3wD/5jV/DAkRJQNpLyGtQY1A+k0prnVqvKFlUrtfE8ZPmhkWWr5p5Hm3kaesTDPTbokGJHN1pQwIdUIkKOQNofWyAV4E0lLfXyhDvQwBN8J6TYh9uUBX7fCd8cvSK0rZ0Bj1QFjvythr3rtyVJC9w7o1urQvGzpowLOynBi4IXmOU/5tketbO+kV0Eh58UR+LWfm5UIWNn0iDgZyQLqcQnLd7c5KH3twKQ74XOgGETcTi3I0H69WKf7WQGA6evu2ekvHJz3aT3pzBwtZwhMFSPlChGM8i44x6UA5zrfXmOezMp2Dby8XtBeAxhy4EJEfr32EpOHk7u0d75/zZPcwYbU8o5Onj988fjsKOcdjnxOAVjrnBbPCKOfXEBmYFzkYwtgHoNqTZM0Gk54/SXsL12EpFvxxOueTvSWoIUaqlhH5ekShKUCsQ2qUy5KIS+k1TGNid+/tu48lFYG4HxjQ56JczqdfSbnwwQxRKmhtKqvlGWGP6e9QLf4kve59zAbO++wa2IfzRpsXcZvjY2rvAts7SS96j+LikB8MVzDmfDohccYVZLwCEobmIipAKpMuf0X5b6ndczEZnKS3UTn+OL0dHY75OZ806jgV78gHwim7u0xPcdFOnVIoh4vlJD1sdvTMae/vuocb+wA1TXGEcKZ2fYiSKRvspJWdn122WpXR8zJ6nkfP6Y3YjZeYHzJ2dMAGbNoTN7xW+ZmIl+gApDQn6ZStp+iq6gxVXpMk2npnYfedIe9XCgw3sLE3JdtwO36CIW96wnBZaza21XuXBrEJrVFJa1SLinfngrgaWB28bbLRmKO6XjYhX8wnqYl3wE5a+CrzEjUFtU8oSsYG7CTV8dorGwoY+coUXKHwUff6S4Bqr+d5dSEK6MRJWtQLy2IqJlzVDQkCw9VtlYmQUV0D0AFLFiiI63ZNmQIYeJxqxit81BTG4zGSsYHnnmOUsZ0d0Le20RI83ELASApsc2aV1Oekne7AARYA7fRrMR19PF6tpqPf4d/fo+MpfiGS3AYQSS60nMHzdHQ4Hrrj/1ROyql8IW/mqpDVZ6X+QstZ1v51MBstxlaSD5XYaCenZXrNL9h6vSRHExgZBTCLgs8d1AeuYlY07gFa/dmacS0K2Km4C7pd+wA4Gl8ipZhrwyWsS6wNiBA/mEm4WBc42LQQE2YZPiVGgpgwLsmFwgZTMY9MqAY5RkVSo3zcr/UeVPQGOYRFIrfXAk9+7k8+LWdU0fJY5IPl3h4zffSuhz+pGi3HaM5RoADXZhc51+BITY3yvUP8jlA/OcPYbNVwY2okaKrvHGAtGe06ZF605zvEfLzWEZo8tn6coiuAO0C3srOSByQgq2EEARvIYtSAN3GCbBNN4G2bacse4798W9qvfOM+y/x1VlkAEyELJV/S1pkLlGMU1Wr1EgiLwVfdLoZvBzQBt047Kjwa85bYnc6hNsSb+a50ApDCCj7oSdCPRyx+LjlBaguvPM5m1mnpDD35QiAWX3LNBtCvRb+oxJw5Lg44Yp6JBSM5oiMBIXlGcs+Zj8WCGtfdLipk7/+YX+dx3lomtlr9KYWOL0DIcaamUwmO2QMAI2PJE497Tns9thAnJZhILtD3OgrDQQ65E08j8fFdeJyFlgnJ0hYaYj+wdbpgfBHw3wOGCPAiQoAPGM+HLezPKOqT7VPND3LhckTA1dsPGwwWBsbYFspFtUGMakizQK/BXWtkxDxsTaACR0Xxsih6EI0vg/GVE1lV6DznixLGvDkgN0dqltjbVPbVTBj+BHYDxlcxxPvJ5Nqa1tRnF5Ls9MIjrl/MQqQc1EDhs6mZgMiLzU7Vqy6LiasaHv3SvSpx9Rkvhso76S36aob7lqpfreiJdbtPyrSgobiPdkSLNcsKsQCqYifaggxsb5rV7LQFEWjbIE6sDQpfZm9vEIyQRmZM2z6KkzaQ/UUJKjPrWC2QPJapWdx3We876MSki+DzArpczUuDvg7YnfaxpAaErdPXNyhOXa0eJla1IHk4SHUfnSZMX89LQ7GAo3fy4nQ9FguyuYmCR1i5OpwkJLyFHiyHE9h7qRYLrlwGtuayaJGeq1G4z8Y89m4w2nBpsHc4HphuFwLZGAdgMLSgs0jbQfFp6BtMKvf1i0OuG+1NUMMQcdKsITkEbvXOV6tVsCnZEUITnNMBhEUxTsG+h4VQlxgGOLKhlKICBW9y4TxMAqGYGqGZUxxBQEtDys+HMnsK90VWdruu28Okk2RJwnZyHL2NSFa0+WgXZvi9Sg3LvlTQgSIQUl6T1IbjBPQY/kxEEQI9AbwSEAxJwjQN2F0KQ0LSgR1PAETS4paeZphwxRjPKbkBvkonGViKmU7jMA8DWyA5rdKkt8RAWtzWfZf84xlx6JNsucYYqL0ID3e32OQoKFK0dOveTuHJctG4chtuuofs8EtZVFm1RtdFuyVjQ496Am/rURzGq+iHF1ey6NMDsh3hsGa0XWVYTbeDa9sSd/NqVWvsY1/Jes0tylHbss0zWS/GvYZhtnOwxsgaRcBzX5U1j7eDFKU3hZA8QdNh5DagBqSOYgxaXcidzfuw5Y4haRCQB2xgrLXlDIW+Ws6eFZ+Vug2QWghK8NN7mDYApoNc3whjgc/am8Wii5tUsmZcowQAoI8MwyQCRCC1bAfh+o1DZ5hBYuTVYp4b6UsNU3dlIogkSZODuhD91X6cl2bP5mBZKBMnk0QslHW35pnPGuRuHnKLh0nyEKNFRxA8K/hmT1crKoe1r1ZXoVZeMLbemCAacepWS1Wui14rALoY38+qmKtC7vmWKTulvrGJdGXLgNEpIGyObjxGh4FGxE05UmMbTUcOdjb6FWJYYmyMaNdhxUYc8MJ7KR6Yo2JgyM5KCyUmcO8iblXSM5m+vHHoczAQtwJBQP94motvkdPY7Vr/LYRNP0dsmvGvQ/H489f4mZcA6NCn884hz1HrGLSULG7JbYgmczXHS0CLU99SFL0JQdckvwJzTZcHDMNui0m3e4prYE1gkh585Cc6LXmyi8EsE4wEjd5m/Qp2u48tm5fLOibONRtiFFYNFN+Ve/K4xev20b6m0Z7a/DznLiSy5c/Wpu4NTZ1lrTwrGbiX7naXo8PxYG6n2TtLTufeTRCM5Fx7NyZznlv7X9vPTcDO66NOrpZGTi3v5J4p6HbtHNgQ7Oua88cvypq3rIA7z0qdsPtQQhrwC6u0byz4suf9bj0oAKgJM3rk7kUX8zfclH4SP6Uph+uYqv0RqgWTpXyu8iqa7h8xpy3Pi74yUuem1IdChXq5Gj0aI4h3nx8JFTrCWOYr9pocIGsByAT+L4rYVPBJ6bzUq9lJWUzJVBZpkzgB3N/zepJzfR8FaSo3Z/B5neMXQlR0TKuWHOCRjks+BgNCuPkogBOIuN5dzYsqe/H67bS3z3+GFHzOIn/wX5WR/O6bxs7BW4/qx6uRDPCx/i9LMfoclDH4XVPgs6lSaA0S/c3kvc+HHYobR4+S6z208Uucehqkp3qUZLCDwRs85kGxHaWQsIBgOqYwVN68hTuhWK12fH4onBYC4pRGaT3YQFiM10PHEsmIotQSIkwDTwA0xxkgqDwXLTQiZq42qEJMngic6cEXZTph/FaDoQC2C0a/cnJ5Vr5LkO6bcMP4JJC2AE0nQLFCx4tewoQQD12Rh0mvBEJ2QhTUpO+pp8naIaTU7q5Ol+4oY4duMcF2QedTVVL7S2g/VBm3irmwSWpjaduY2zbsLtrV6bzZ1JzbFeQFtjKvt6JsjfM1cCUmjs7OKoxHbMnpivEJqGOSsuJPJf++5J+X4i7iuO0ceIcGX5Y8qAJm1nL0fbaisc18SZuV56IBRwcUt8dBX0fs3lgAzjgpX0Zm6yXbaW/5Ifzs7jrr5O/yeUfYgG1B95BsskiFn64+ZwHsdF985aU/yqU3eV6zurt/y/G9xraczTIYzpWdYae0ZsudrFPWBdAPeynS7ORHeAiBga/zOZyG63wOSt9rNkgGWmgI+tPDIMFJvbfOxLwzbAw5q7+PDsbuyvX+A7TzgsnWdpb9zR2AS9nthsMkhMi3TXs0EK4s7KB5QDnncj5PeGnT4aLcCx/hFY4/fZzl8yr+iu/J4ML2XU4uwQAoqRvTU7hdcJGBT2SQArq0SS+l+mEswySzOZMsyU5/8pkpGHNzghLaSbmgbLugdVqbfr67OxGQ3Lf9GtrAztBM3ktaIujt7jrnhru71yLppbTsDgwqhg0pcaogK4cwzlBJ3Ai7291VKFHze2I3d75ARru712MGe8cKaXd31fHeYSOzQ5h2dxVzJUP67q4C/IkqoVpC4Qnuy+RD9w5Bwb9w42wsfrR0qRKbU8g2Njt2X7EP6nbMw7kP0jRxQSVczFwK0olCHDuySlAYTj4ROzQv0C3gEEG4vihYLn0ArHP4QrvYuXwuGgefZmgAwLz9m8XFEoaUxNxGHZ+HqOMzijo+ZwNgzcxEAtusVo93huDwhqQ3Y/wiWiHuI5Tb+V46t0pwR6XVagU+ImklzuZLnfCkbjiYbC7EztO64j+g5m3XgTd7JY5FUyv1mgz3qbdxvFtObCObA/u+RsXA+6sIFFZbBWvulIFawkQlIEvAGN2xxOoPikf6QtlpsSm2kiWvTUX2rOANfaLs64JHmrutxtFaTpeTdl8sHR/exvRDNRhRGRxPMcvd4rBOX4JV0w9lawi2zixFvIfj7e4j97vzwZEDZFWtvKIVfYbYd0M4yRK4lCwOnPlHRxp0u+lPpfihbPQSaJrvSRMrmiXQAIqMgnApqVTT2OMRYc+Bu05YxscbyS4c0g6EOiAJUI32B74JsP3RTGq1Wli1qNVq5/vSP29adnu7bkToPU+E4pe6r8jprfVP1thZNR4WOIcjE8JLeVulklnHKz+VDPhbFNP10PPDsWHoo7FSLGL27Fh2Mtn+wYtbyNEGA8cTFZHqF1qiNt0LO6U8SxCEDCCWlBTfhZYJzN/sIyiVgV6IqRF+LPhAPCS1sOija688yoPr26qRCRwlIno8kGkFzOP2luscL7LvY3ebU+hLgSlOSf6BfRoxK50ygVGTbjcMPnChSRevkb5afVxLxsjH0GrNc4+rGEIYoChNzRpphzzUEbtJ56plpfSRQqGjjKRL6DwdlEKIlzr4y9ZEpluWA1kDFAqBaOI/AYWdjt7e7J6Oe6u36ejPbPzR8C0DnWlxvPqzW5u31Udv033+B8g+erz3Q7738+nuGMqNQbf6bb+ZuHo7eohK1sOH49XbUYK618MEnt9Oe/DTLDFmH+3uc5mLO1lNskf/kZv8LPuEvI9khx9zgscfP+LLRfbxP/C5nJns4//INRhQZh9/wqflTZH97sBGqs9G/8B/9/fjNTe2wlHyaTVJOPzNF2h1kZ9lyZv8zIarzBJrFE/tjCAmaPIans9ynYyh1VHy7YJQ4/Lm20Uypj6MErhTXDo+j22vRskr+HXf6GVMPR0lT8ubwn3C57Hve/Ikn1xiRxIOoeeksQ/JeM2LvM0BB4C4HlyXjiO0nM8HyZrrHGxPykXmsBh4gSsxP0cb0JQNEg7msiCLcHns+1PiZmOWSs5nWZGndWSosyNEx6ZMllrLwjLFE8YnRs+xxI7LYPT8K5Bf8OpCzUztG6bQx3xe/5TP7YcrafLaF0igT7gS8Onh2dKYsngI1CHl6nS7rof0Dft8APWp6XQuf1Gxw4TZtf0lpR4lcRxLlddlgkmRA8X/ssjukiwp4afuWkayoiceJg97uvcwyR72yjzVHNzLADXjbXKKOKgd0BCBb1fmwVTWEf9JJPpdJ4O2eA824wiQsXr4vUCzU83rCJPpJePEslH/UDrjR9KO4Fr8qZFEkM6zMNz1lQA1i0yr0dhPBsZ9jjODMCGHENBlT+ADlzlGgrbSxSqiP+S7nDgblTPCj2oalD1R5OkogS2a8AR3Y8KTfA5/YZslY+doZvPs7UzQhqA+R/6rOzB4OmG3+slarQAhwx6GDtuJzcPNpXpbzvtOurkBAcnoSexKnruGul0kNuuQIc0Z4yXVXzIe9oOt6A4ovrQYJrZYhJK7LEmm/ecayh4y2DTakK4Dq5V23giztnZ9RfEmzvPAfF7kupLPCpNKfmjvY1af7Ut5e1JOwYVh0jN2O8ocXJBqYeDXTnRyepnWi/Ck19T0wXPWTC0gNRTdzKCRUsZrtsrFXVk0KCDZv9H5wruEalvk03MC6rxGD63XfMPRmKsOTPdCTUWo6Yy4ovwhbA+Tn/eSh3GtQOpHB6LbjV5QTDYkPk9mGUbsnuwgDBsm3OZPmO3zZF7ml9nLNZ/ULzC0Zbd8QSHJtB1UE4UMBiGY6O04KrDNkC0GHidkl4G5p7nJP5fFZ0UztzVw9Pk8y1VcpujUp8rJb6BLZwMn8mgSQuQE5Sq/PZMnjrnaso47qfGWHLEUjK2pC4DSPgOn//hmEUu0kv4MJTPc+bQQO4fRRbKsXSSg3DbJU+/4+c66TElulLkgjyR3/iincjjPUVSUPTydWHvJh8R6arSfFc0eRdKlebhZHEHllfqsEpxLhzcyAnV4M04GvX7jZAcOSM2oYqLHYH4wMzxsZF3EWWelxpyzUm/WueE918VlnJUamecoVUP2uRfMEe0c3kGUUkUZHjUyPMpiM9m4GyjB1qtVcorM59Sy1AIrHJibea/qJfFCFavVPGc4QmBYJ+tosKCMBpFLZxtDnYY5qRG+NTUEp3hGS9OijtFqR+RUKp2mRk1xjWtxlbvo1cmpQaADTFie9HRGUnjL1+t2k7ukZ1+23J0QDYg0aXsJGhWTY9IatrFOavKOICcbACdwJ1+twHGB6gliqiZsYC896FPJBjm9pSU4yXR5ECDm/jZG1qedeRx/U6BS1/eK5TR13QtyF36VA6ULTDx/C008oL/Gj6w2a7b50CCXLuQTNTRAzglwgVYry0IxDbiUSqvNcE0LxAZu92/pIsZYOmCDQkD3onsjqfctVfiifEebIRQC3PaEKujcivgLRLADcBQE2vbBbifw04P6RhFGYGHE3QYQcW63CrK+C8eReEsmgKfANfDQzSq/tUFNC+1gzzFOtRQ8Ob1K8fJs1uMjY/RSGfMqNq5GP6BFDE1rsA5gxwee91pbkdkfoNM6ODvUxBbTyG+4K4RGD4BkBRRifnmUbZiclmk0A4g70L3V6+FrgeIBm2FdB+NhiNM8QF+/LGpWG2idIWQdqALp4gx23KkEWApcbutjzVKRqd9EJSgBDoGJjk8gwkp6eVoSM8TCFN9GlsQfwzWbR/BIY6yoAnzDwuCHC3uJ2ssUmCt1zoxTRTCNWGXXtbs7uUtihyk1OBvwEIriG2uqeAUskYRs2SjhExdrgphQG7ynO/CIqcFbmT0qEGEkVDEqyap3sCRl/J0lmiGQhS4jmccElqrqiYfWz+fDHhXqPUy4zm9e+ET70gMKNrUi2mFilepxbRxmGytwbqDTNhsjmFP2c30+fMhzfU6t5PocWnBfPTY6TLh/bqvUf6SKkzUw49UsnbhdVtXp6rG7B0AhueiB8JicW1D4q6InQEcTL6tLees+ajmzH8GoBT5qOYs+4mENOfA1AyhBOQhuwceFllF6pE8FXx+CCVby0ALqhwl/2FTyMxFaHKv7FT0Rf0LNPwtN7GWNreNzdpf0TnP3xU4ZdrJcuIwucIvPix9DXiSbbGbgh9gEYEy6WSGuyKfNjHEyXE8ue0BoCK10apa2Q/CO8x7y+ZJBsZ4yt0lykihXdrpMR0mvIQmoYTCe8Opc5OAVbFSMN9kkdKdHFVvICp2yESGw7/ic3Vl6uWc/esptks/nZ/nkMvrkknpJ/Ui57yHRr0gdCXBhB9tBUsQudwKPwnLrCe1ZwqC9sofHcuptZHdNp45A+2vrWAo61qRERvC5kbiN61KrlFgJ9dlfJ2t7kkua5xLn3t94sak4393niUU2A50NxUR4BTNP3iDqoyw+DfNF2t4Xed1TAynOdbskC/A343AbhgnEC8gZiHhBeV2NeDF14iUXpkG8mFbixWwQL8npPCWfpDWiRSHZEhMtfkSBYoFLMrmzkLGX8BlsxzSJqrHe0UxkMVOnhGr61GSPAWIOvN/hga73lJBrQBiXxVTOVCGnsHJZeMvasmRzSiTsrs56uspjX+7OoWstIFfN9XQuyuholJ6Vl9PC1iiynCSBXjKYB3XDShTDDSw+Z8OEHwKlcpCE1UoAJydKMQcoUq1t4OzhFicQ4sAawHnjKB2Eh+i63PZeBTHcbZ4qBnYQMYbT7dbft4YXuQXoTbjVmgHG+chim6n5S6o1ca143A6DY+JinZa8OYUsO+BLoVar83wQWNzlNhJ02crh7qUTPDsTwBoihshtXnMuYy0krOC6zSKgTnKHis7z+PrpHHqh+dCi2FmQo4OVVfAJDqbyHBDjTY4kRQZDnYosOb0GugWE9ACjSKd0GNvNZGd500CncPGYoQ7n7yQK3pvXDWaT5H0Gs8ZKNhzqmD3sneWp9lxj7rnGJsbDQpNneUMZw4Lst8tHB4/+Afx0vKXH2K0HpnwSPn6SsHXskuTt27Okl0xLrmbgAYvPpQFPhdzoW36daz7JK8mB+uZAJvL8JleG427mk9xMLmz8P/QayavlQgIHU5c3/OZCzSW/VXI+tcI+uKBLbbi6wh8aCK9uFFRj+SpcvjOymFZ8pop8Pr/lzlMFn8qz5fk5+Omy8wEIMXpEq5J+tZgrgzvW7t23b89WMDTWwxFGNM6u3W1G395FzgE/C8dhPSHnC9EtRXZNUmvw7gNa1HLN+EtS5r7J+UnO3+UivclbsYjPytQpUcEdbH3lAMZwpX6W3e4fS/ShOogRCs/azCvwKGTRB4cxbKALepNxWTOvcc8dk0YXat1HtGTOWQ5FxdPEmq6PKDXDMlPMBfNe86AEkFNsCMt8Bv6LexaBJ40KQU5TyGcGLKHmQj/V0au4TBs9jT6uVuTbOi4P1bHE1o03TbcbUY02JdWjfCyKUT524Qlu4F7Ubuo7VV9qXepKKF71jVpUouSVPaN3QI+oucwMt09vSreFqhaHgi3uuAdNnqO9dFNNDvc1Y7gC9vR0dPCPWAodOWMaWnwiTmO9IsPrGmN2un0MITfo1gYfYeC9A3xeTWDB/Zits9PdPM3tM58EvZbAq88/ED2lAzcBZ8HQvqjA1hjMANPPS8Yvc5G+y/t2Dnl4jKYzOryPIxCYnuTiJP8ljhGHD4/yDjgKEeBdbf/4YfbwaKquO7l75ydR3M3gqoZ8w7HjAzzvL4FL8KTbfZyn4LrqdfR6wPibvNVxKTpXrYVo8e2sGX+RixaHti77+33dguIQenHFZsAcoOaBe7WScZJ7sDMVe412vET0xeyCvyAvxu4Fr2vbdxf8AB2tI0cdt2EiBHS0nHU0S/4D6p+i653HJj0gruybHAy5fJzMHR0CbMZd0UJHkyTR2QCW33AcXi5NLSpqJ0q633cybZJAB8RuqmOP2eijIV4zmGYauUUkL0FT4a7VF8jL/F6/H69zHs5tVkSH2FnbQqp7JDkamr244wnKwY2zOHDrJUpebB5djzW+yMlygfwAo9ffZeGOoLjM+bJYs8G/+bt/f63kTbWfn5VL0wcV17/77/8HT/Hs4PUvEqPmsqOqvbyYyMqUOjl+0Om0ZSBmK35uz4C0RuesfAcvOp+qZTWXVWVLdDpHi1DCzGXSud6DDonkel6c97GHyfHR/sLnhzZ8HjVBJbLyvIRMU3Xts5n8bC591fDim4Sv+vjIXBx/JzWgi0f75uL4yEyP7+4613ox6X8ujSpmZf+avnfW66N9Mz0+2jd6s5Jv4DBPynnn+j21LWzGD6r1+3w+l+a9dd5gtg+q8Y2sTKeQ5qbUl1vrM7IyhTRtNR3t4zTapXaTbR/sz7/5u38Xb60fq7/7GSOwwttJR3TuHnQ6DtJkneulpNA68GeRn8uKFpw/6HQovMcdNgZbIaMrl1MCrns9SS8mUcL6wfrBv/m7/8Z2hhzRnkGcWNrt/+P/06/a7b+DP9dSGzXJ5/85tn/oeO0QdI5A9NRRU5FU6rxYLsD+6yrp/GO1PLtSXgtOJPP8TM4/w2+2mdn8XWemFHSAKnNVbjlbZ/kcpsUdr6OL39W7iC3A14vfHR+d7aFZQgduDIHK9UkHnQhRR2B0FJHUvUdOLwSpMXbyDn7rzErdMReyY+egk8r+eb/zdXmpWNLZP/4Lu73Ib035/m7bxqOO+xQtf1oqLaftY/gm13Ay5yelKhpD+CP92xiFVbujTtBC+lWz31S1B0gjXMv14VT5NY6V8h0/sGcUdoV/qR/Y/zLgvH0/H1UUgjiCWWd76OG5g46Xpf3dQ6s2uG3jicPcZNIWlmkh9Tf5uUw62VRVALamItlR1Tc5xCw0cprUa8BaCEHqIAEvkt8nx7/vAHjE7SI1wCeAipTrvcUPD5Ljw4O/pILfJ8eHf0kPHh0kx48+uAdH+24SG+nRJgDiXZcI+GZz+a5tDs/2iAEQVqI26f7xaN/lbHYjusSpV7gRjh+EtLM9vIhq5TKMG5TkZwSQqqT+deHarfenkUnqPZiisHvq362aMOZBVTGR2KSWzLZFCIxXqavFXEYtq7J4jWmNMpZpsleV2uxZAhdi3dj016U2T11qvWhc0gHX4we1LEfu3u0ExyEglSsXVftC4iTvTcr58qro4CK4mjsZ/opwAXRu1NRciOTwHw6SDnQiYAobNVeLvPBgAdvv6/LGXiQBYzuHbQY4CfxeyalaXgHMgNIt3d2v9/eDB+TBeTwkm3jvQLYNw1dYH8gcVK/hoSj1VT7fOpLNgTQ7vlnEXg4bVwVNGjxNwXZQJ51/nMzV5BIP8kzpq5NlZcor0tJPGyvBkmP6EC6VXzTnR/tuvx0/aC3l8EgP++/HK//nm6jcj9Xf/ff/1f8F8UtKfVKWlx+GZYZaNnDNFtSyjn6u4Q9U1klZhwpZ8uvOjtRWDzIrm4JTGr3nZ1knHgpU575FACrroMS8+cHDj6yDOq38QQ0K1OBE1nmYV5OHLksEsLLOoUu18C7rHB5Q0tqNk+jraRbYWm7MyFTIofcpG4QUo66k7ohOJc0zwIWu83kasvLO7w8ODpir3QXIslV6bLWluU48X5ixn4dV/xoSvtfKSGoMM3DbR8rG+sQHjmte256vadRtx4IOg+8EsVIoHEXf5k/voh2OaE/WeYjFVXFOg3rIoyxXsqpwth8+1rJzWy471dI+3OSF6Ziyc3R2PHWn7xjRYKym87DTs0+9zsNh582Fqjq5dcOeF0VpOmeysyymZSH7tTZtV9+ARaztnOx8vdE1dPXVeehBRu3jRV49m5RFfVPCv7I4oeozOBLi+P1LVZvc1oWx1a9ZvEC4h+qbo3aMomZQrr1lzf16Y9zQx0tTktlxo+bJXOa6volxd7NwPCh+kw3r5Pfq1oKeMP2fEDRD8V/lODD/i79FDgx1sc6C+SDeyhMsuJXRQPXez7F4qmYzNVnOze3WaqY+y/1VnZRFQQBxe5cmIc/9lT2RlengAHxddXTgTFamiQFMc33pr/1fyVz5r2vbBu6/v/t/wv1Hc/1BVx+V/S04LO+9BmEWso679D70SoFSOB66VrDIDZxid0s8xEXDLCfAPH9Yr2mzFqqj7cLxuVovHJjZSUcQ+9qm6cVEwcGe5RNJvNaH59JAPTixF3l18ZD1zYUsAtCBRIQn2KuOAEB6Ab3qbL2LCEr8T2m5YSXKuXRg4v/w1wQTTRCBOYHPdL2Ue0bqK5Dj+q1dT3TY5aycLGOa4mg5bysAoaAXS7MHVGWuCqkbZMjRXHXQsZFIZGH0LbrNwSJt9MrC5QX2AGTFMlaS/PBt8ZAldEiP7+4gCxxwexQXTQx1rmIKdDmP3qKJq41FFfcOZVuxhZYz9S45vrujp/XanvVNUiODVcBmnk2TTmZru+vgbMtpJ+uo6jP7vE46/3gpb5cLVOn8dpFs73PSmZD/CzlVCIIEmUttEChRx9oe66DqgZcxuM0LwOq//j/DkTqhlA+CVrY0f0DACgs0YNUGpGrAqWUltTvvhN7YKN8PIpQ56yTTZfmPC8u1m5QKCez1gzV/8GD/o48edD7qAEbRMRe5eVh1ziSgdhjGS047Z7cIIzpeqbX/oPPR/gMYxwZgqcFIovFLfZt1kv1/toCyWs5m6l3WSXZtwoWqKE/wAs5qX9DJbtaxuDsua4C7dGRaCtu9lHWe5+aiP5uXpU7xUefFtLxKWeejzqHD1gfxTIBC0tLIKXGaKhrtxCbSXNN2bgGrdvz/tEvWc7A6a/vsZ2PdsSk0Fet/GjTX4jnBcWq5BtQxQwc6CU6BOmqG2HNjj3fwRCk0y8Xz08cy+w864RBt77sXPuaogGCFj3017Qi6LPp2au39FffqtTTYIWy1Y8qtvQs9wqwtvUGNnE66vTc79d6Ei80XOZdOavzk9tk0reXuY7uOtltvDuUbqVEKUS3kBBTRp5YSqTo3F7Lo5B0EPR2yLldVZwbcchoW/ungcbvq3GGOx/q8WndkZ6/z5kJ2UEu6U5KWhZ8JrDCaCemGZHmOqXSmsGGsk7ySncOPM/vaIVuauo8A/w0nANxUL42M01FBavAgrvLjf/gFVcJnVS6rL+i8tlQd1fy7g19QcyHfmftrXT8IuBU5eH0GK/wdHF2PYsXrSrQQbkzrZQrRPMuQP8vhgimLeONWnZKOmbtaCLYQjy0sX7P1bRggFRYfuE29EP/+obR17f7BbA6+OZTPIHHbUH5h7zvxYW0Zyqe0K21Pr67yYtpB9x/u/pF407nBkFy4gya/mgOsmFx0FhgCPK7CDZbamC3n805RTmWnLDhVU+pp1clnRupay7mWHWWqjtfZ60DqVX7bOZMdjOsZTZY9UC1zBF2E+/+ZXfEwA17jMTk608cJ7ySJ4zLF+d1zlB3wj1+Sf/+XFdjvFmfVAoJBdpKOd4gbMcDiO7kjOgebn/rLgsy9fO1s8OBX7pkkGWweHEjEVDXrpNFwRCdB1kTS4GdhYgAf1kzLw32bixAJUhV017fFWuG+ds2s/4kNtjQetVtvw2+GXJ9XHRGVs75698kNT7J6+7q3f846q1UHFA9rKmp5s/ZOHlbtzwmEo0x293kHw9/ZltnANw0AokbsbZJ60LvRwZhjN0PEvAa5p2W1nJtoiqW+6i+0KkzqNKkwB06JVbLqDF1i1mmqPmM6R+cXvPOIeTIxuge1bm1Oat23zMbagJsXOWTG4237UM46eTjqxbRTTXQ5n3dM2VHRdYyNRKcaFaw7d+07Bj9SJ75bSry33qjJZdpCd287BXVyk/WpV09u0wOOiOoBG2yvoXpyizGxwaAvbaODGLjQquM8bZP1dZlPO+4+95M00+WVw8bjCapd+y0AEM/IBtTodQ47x3WQYY00auemdubj3KONKnu98aCJBoTbK906UFim9wwywkA+eIB7ncPOUefgVw9nb+8XD+cEYBxucmyCd85Kc9FRdFsCqdrJK/f63RJyVSYvJjIM1HJ1kZj6DW77rZD7nq7fXJTzCJWpJlrKotHDbVgVHcaOiAnBqAdhdHb6HqwfrAeeB+WWnXhQ/+6/fQ8P6n4mVKdzvXeW678Wy9r2NeJZ/w2ok1h9kIXUHWBt/FItjl9c7vcHyfHvf0W5wwPs6PtK/m2qi/y2PQhqGtuXnTJ0grbHL1dsadVpsfos6GbCktVB4yMrF7KQ0z2Kjg+dsmysl5j+FJOj/C5fI2EP4vAk5p2a/v+1tgyI5urfY2Wje7VmHjz4ABUTrJ+ktiJ5mhuZdIrlldRq4hVMOhNLyh0/OApc17s7hNVP85p2BlTnOHMYG3SSzyXksWYjrLNe/9Lib9RVrXibcsqmuseD9+nU2DE/du9uuMe/QHkmEpm9TzPGNY8mFaF1++rnuqVZytLSxIes7yQ38hzuG9fiiU/wA76764TmXAEUMm7ToYn1Z7bsRpHQOU7eq9F1lGujJkFmC5pBuZPuxGl7lgnilMeawDQSIdmVRJHotJb2oKZ1/u7Z0yAlhdGeWxXXNmXWaJ8CWLIrct6Ul7rKH5/lqOsw3SKHjZbY5dwmgvVVTiawGd5fIeV7b3W0o7fOAAiek06lfqZXq+d2z9F435Q8cWLIrU2iILqlBS/AfG8bVtsjr4vTt83UJM79vvn6XBZS043/nmrPXc73VflGL6sPqdBQvrbqHrRYZsCw5mpi3j8BxIvz+bc3YHUAKB3/b54nB1way7t99m3+BhzdurZwFbTW/TdyJ212v9PpdB7Utn/H/JpB4P7/C0eyvY4PHs6DeCU6Wk6kuo537y9YElf4L1yardV8+Jha9jeku5Sa7mcbTr9vL7D2+7GhV3q/Ds2/rxO0qETzv77OdccyMT5ILm1Lb6jRnE2m96mOtkir369GE9MAGakdTS5yVYQ+zVVl8vk8zvhXUyadyl+uTdrptNEnWWc0jnjM607nwfrBg6DldFFeOZ2XB6N/EeZa1ugo1pYLBS5kjtFnG5AaC5rSRDrhsapdo71m4bqxk8sLqTCAea7PgybHUWV0WTRvCtiytn1XfLoso764UtCpowsAtxt9u29oy8Le/3L66wcYV/KrB9lWyf1DffCLRppf52pOypC/dpzmHUUF+vWDbG6F7QMM0BUfaWnDe3jeUPn5zc+NvWDqSqbbrBqq5WQCVXt1My1/IgvHWp2FvIlR5poJA65tmxkHahY+brfgaDNB+ZswqoNLqjI1TlFtIq2BBWrxAUw9rWVtsQvtfIilVTtn6rdnzBx/uBFTnLHzoQZAgXqPLYCAWHI2TR8/+lCTJjWzfamT+UKIzsNKFtOHSfPAVzIiIX913fb8bFZvP0z/8ibU1VVulrqljTYA/9vZZ+EeqC0NanS49XCctOOj6iqfz49/PXnyF5AmR/vU+F/ZCO23YZp9aJcs16zWI0zbnPtfZObnKm65n37hrmmzMNtuXbadJvg3f/dvI4QT6YH/HdID5dWHKalCufdTAu+1K/sVGvYfRhq00QW/IXa/3qJc3+nYm7lVub6hX3EuzQt5Y6/edLut1pqDXNKbA4YKiFD4H34ePCP4b1t8I1T5tXzm8gBLC7GI4wfvuW59tfaWrTFR8fTALX5RavVzWSBa30ALMM8enquaQSil2EzWhPfrvDhfohAOE45b8QyqEDwDJccb6clxk4ery3lSs7S3ws6aHwPXcPDfQGxaKyObFLO+n7s+fnyvZFEWyfGnxflcAW/yA6WRukqO/9P//r/7X/6n//t/97/6T/+3//TffnDBmU6OP9N58f/4P+aq+hARJs3s5t8HD9pm+4OW+FeuSFiZD3IIgaRBDTG+1zPEPSPtdB54pxGeT7KQUjsLtf/R//Zv0UINe/hedxJbEVnE+b/BOrZKQdEiYpv489HBfUgs3M5/FVN8rPg3NVv/tTbrvx67q5Zn11J7wZl1x/Q+dLsmTKMqHBPzN+nVQhXnNaHtNyij+jC8p9Y5V9Nv2r2zWyMrJBxc/17Lwvyq/vmqfvsOajm59h185eiQX91JqO437aQqzsplMfVdfObef8Hes3X88n791s4R/l0MqxGDvQIMFqHaB6GwWPK3Mgp9j4EntvVhPgOoW/f6DMAs9aY2MEuYh2fFrLzfHvwvMui2anKW4Lb35b/9b/5FsK3b2G/1n9jhmM29Bal2zLjjv3W3Yov89koW5jd2KxY0SS5+F9V1VFP2ilJpXHZgjvfrtQGI69upjFyI5KB/QP8O3eBtY2H0LuFKFSI5SD7EM1mk+YJ92aqh9uvnxGqgb0xKmJV7FhgI0miE9NoyrqeymmiFWD4sY2vnQ4O/IX4dHR4qc7xNxc96Y6sxoI8Wlu9W4zy3Shf8N0LCfj3nmqjWJl//vyQHG1zpycrYI1lt6u/+q4e4TudfPcS5bvxFHuK0/GmR3/6rwutfgyT9UGb9Fobxf3kO/m9HXmx1lPevzvF+S+d4/78jzbin94T0xH23KNUv6ytW8xvKWv4SgvXf18klIFn/B8+AZLWcgg8iWm3pX+7B71dIWpoO/GAyo1faB1ECXTOZ/a3+ug7+/iKpzXZq3fXdomdy+g0haDV/A200+X1yH6p0o85tvvtwXu0LDPO9XvyIHLcqZCB0dyZr/5t/EbQ49LiNEI89fstiuoX6vtaz11jBg86HSC1+rVTqL3a4/deUZuVLU4Ivmrk0ssVtt0UDrZfgjZvuHwnDBSbw5PJXOfkuI16DLq9qPszJ8fWDD0S7PK+RsHGLQKzXEapU++5aiTUS2kXk8Ry9RyT0N7WJalyhv9omauHQ/OdxaP8vZRlqXK6/5jo8aPz+tTlp/rhvYalFBf8i3lr9Hviri4DtndLmxxlumk47z2+7RDxaIPy3bbU2whD87bCcKlnU9GL+lef0rzynvxrP6R5d2X/lPP0iztOvZDw58+pA5P8LMrP+G2LW/DV4TNtZM20xJf5lKZ3ezwT5txGRirEM/t/Xue4AAfdB7A8o1+R9fGj4gt8ggkGT4wE7vlya7NEjb/VF3tq3xTn4YLs2GOjfnG3b75tslJr7005nf5+mrOrkmrz2ycJoJb17wZgm7KCXM1Wcd24uctO5yStE+aadvIrzhiAJ1VaH1VRO1EIfIAC6kfokr2TTzRtlxMWhmvszNTdSBw7LvKa0S4XmlLlesY+wiX1gnWPROQBPbfP2frRlH3i3/zFL53421b0BALaxqIC8TwnjCMMDZ1XNNKuigsPtiE5MivvOhq52OpYFkzZDRlyV03zeLxeyqIWLIDQ3w0z1eA3WlXDW+e7VZ1AjcNQa0RieQ50nuZ5uhmSoAYVO/VDHW4PXctAhj7hw9fJ05GPGXO17CwTodKKpsdEc/HQhp+5/5oCggWgZPhbCP/8VuHWOMChyX3CRF3Le2UJFHC1q2fac8V+TkqCee9LhKK8XQ8iG3UcHvcFwrbrJFyE8/rPZhDlyB6+xWiUA1gNpo9zHWV51Zvke8pU6uVb53oWaTmURnHgrfykFq7Vo5oCgPPaa3NFVlYNm+36RE7331yXVWu/y8O/oKldFJ1OI69vpSiwrlOYexCN08zkeBiTMi3NaI5gHqON4gzJtd17+IETo8PsSRRR7AGGfzSYfcj17DbRfrlbnxRHbrudJMcvQAUV0oW4zw2g1c0gjB8lQzUYmYsVvqvm/n/8OBhoPCJGhqftAZIYyb4x8Y+w12PhsNuERFF7/yrluTll8MKMbxzfMyPupf++I0KnBZgCHaz2LJBH/Vem4+ZsmKRbOJ+0RB/AO2Zvkepp0KnMLpBviyFkHGLpNU0kAV1JvlkU4lmxjRkX5rKXy13mFcWjVTE0QpdppCZlAjTVSLR7cUjVxBBtd8HbNVo8p8wbLdcTeXl3HWwM4hJow2NC2ehzf8r21kAuprd3Br1vrqTGOnEJ5XlWLC51XMtlCyFiW4VbISBzBRV5V4KI52ZrP5diDLZbPt+YLLKrQte2VxrxP6w7nmw8o59irW8bcziftbNNF3DDv6wRRWWladz58uIfNWmOsJnaO3Zu7tXfpuoNo4oDFJscn8LA1St6H8nAXWl3l+rbGGgRwgN4GAP/bxr+lwW6J01G35XFwCO+y/yvA6Ai3RCh9L4y2xV0wjrsHAaUMITUIhQzvFmkMCQ5LfIE8+m0geH+/44BhZ1lQrKjIr7mTpUBlnXN1LQvyUyyLaRWr9xI4txU1KKVZ2XD43MGkvp11+KSXsjXwD/UibPkUC4ajw6kmO9Smg+gWeqpWO3TgTelsIbEmh61TvVZCTl9wflkgnO5pDAs09q8vyDvby4QpQTK6SaU5BH/9Ibe3g0o0UxEDgf7ZphoE+9pdqf/fAQCkmRBo"
@@ -20,6 +20,11 @@ type Config struct {
 	LogDir                   *string
 	LogLevel                 *string
 	Subsystems               *Mapstringstring
+	LogNoWrite               *bool
+	LogFormat                *string
+	LogFiles                 *[]string
+	LogMaxSize               *int
+	LogMaxAge                *time.Duration
 	Network                  *string
 	AddPeers                 *[]string
 	ConnectPeers             *[]string
@@ -76,6 +81,12 @@ type Config struct {
 	MiningAddrs              *[]string
 	MinerListener            *string
 	MinerPass                *string
+	MinerController          *string
+	MinerSwitch              *time.Duration
+	StratumListeners         *[]string
+	StratumDifficulty        *[]string
+	StratumShareTime         *time.Duration
+	StratumPass              *string
 	BlockMinSize             *int
 	BlockMaxSize             *int
 	BlockMinWeight           *int
@@ -85,6 +96,7 @@ type Config struct {
 	NoPeerBloomFilters       *bool
 	NoCFilters               *bool
 	SigCacheMaxSize          *int
+	PowWorkers               *int
 	BlocksOnly               *bool
 	TxIndex                  *bool
 	AddrIndex                *bool
@@ -98,10 +110,15 @@ type Config struct {
 	CAFile                   *string
 	OneTimeTLSKey            *bool
 	ServerTLS                *bool
+	ClientAuth               *bool
+	ClientCert               *string
+	ClientKey                *string
 	LegacyRPCListeners       *[]string
 	LegacyRPCMaxClients      *int
 	LegacyRPCMaxWebsockets   *int
 	ExperimentalRPCListeners *[]string
+	GUIListen                *string
+	GUINoBrowser             *bool
 	State                    *StateConfig
 	ActiveNetParams          *Params
 }
@@ -93,6 +93,7 @@ type Config struct {
 	NoCFilters           *bool            `long:"nocfilters" description:"Disable committed filtering (CF) support"`
 	DropCfIndex          *bool            `long:"dropcfindex" description:"Deletes the index used for committed filtering (CF) support from the database on start up and then exits."`
 	SigCacheMaxSize      *int             `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
+	PowWorkers           *int             `long:"powworkers" description:"Number of block proof of work hashes computed at once while syncing -1 = all cores"`
 	BlocksOnly           *bool            `long:"blocksonly" description:"Do not accept transactions from remote peers."`
 	TxIndex              *bool            `long:"txindex" description:"Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC"`
 	DropTxIndex          *bool            `long:"droptxindex" description:"Deletes the hash-based transaction index from the database on start up and then exits."`
@@ -139,9 +140,11 @@ const (
 	DefaultGenerate              = false
 	DefaultGenThreads            = 1
 	DefaultMinerListener         = "127.0.0.1:11011"
+	DefaultStratumPort           = "11044"
 	DefaultMaxOrphanTransactions = 100
 	DefaultMaxOrphanTxSize       = 100000
 	DefaultSigCacheMaxSize       = 1000000
+	DefaultPowWorkers            = -1
 	// These are set to default on because more often one wants them than not
 	DefaultTxIndex   = true
 	DefaultAddrIndex = true
@@ -483,19 +486,16 @@ func loadConfig() (
 	if cfg.TestNet3 {
 		numNets++
 		ActiveNetParams = &TestNet3Params
-		fork.IsTestnet = true
 	}
 	if cfg.RegressionTest {
 		numNets++
 		ActiveNetParams = &RegressionNetParams
-		fork.IsTestnet = true
 	}
 	if cfg.SimNet {
 		numNets++
 		// Also disable dns seeding on the simulation test network.
 		ActiveNetParams = &SimNetParams
 		cfg.DisableDNSSeed = true
-		fork.IsTestnet = true
 	}
 	if numNets > 1 {
 		str := "%s: The testnet, regtest, segnet, and simnet params " +
@@ -79,6 +79,7 @@ Application Options:
       --nocfilters            Disable committed filtering (CF) support
       --dropcfindex           Deletes the index used for committed filtering (CF) support from the database on start up and then exits.
       --sigcachemaxsize=      The maximum number of entries in the signature verification cache (default: 100000)
+      --powworkers=           Number of block proof of work hashes computed at once while syncing -1 = all cores (default: -1)
       --blocksonly            Do not accept transactions from remote peers.
       --txindex               Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC
       --droptxindex           Deletes the hash-based transaction index from the database on start up and then exits.
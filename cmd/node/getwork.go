@@ -52,7 +52,7 @@ func handleGetWork(
 	error,
 ) {
 	c := cmd.(*json.GetWorkCmd)
-	if len(StateCfg.ActiveMiningAddrs) == 0 {
+	if len(activeMiningAddrs()) == 0 {
 		return nil, &json.RPCError{
 			Code: json.ErrRPCInternal.Code,
 			Message: "No payment addresses specified " +
@@ -74,7 +74,7 @@ func handleGetWork(
 			Message: "Pod is not yet synchronised...",
 		}
 	}
-	state := s.gbtWorkState
+	state := s.gbtWorkStates[s.Cfg.Algo]
 	state.Lock()
 	defer state.Unlock()
 	if c.Data != nil {
@@ -82,8 +82,8 @@ func handleGetWork(
 	}
 	// Choose a payment address at random.
 	rand.Seed(time.Now().UnixNano())
-	payToAddr := StateCfg.ActiveMiningAddrs[rand.Intn(len(StateCfg.ActiveMiningAddrs))]
-	lastTxUpdate := s.gbtWorkState.lastTxUpdate
+	payToAddr := randomMiningAddr()
+	lastTxUpdate := state.lastTxUpdate
 	latestHash := &s.Cfg.Chain.BestSnapshot().Hash
 	generator := s.Cfg.Generator
 	if state.template == nil {
@@ -238,7 +238,7 @@ func handleGetWorkSubmission(
 		}
 	}
 	// Look up the full block for the provided data based on the merkle root.  Return false to indicate the solve failed if it's not available.
-	state := s.gbtWorkState
+	state := s.gbtWorkStates[s.Cfg.Algo]
 	if state.template.Block.Header.MerkleRoot.String() == "" {
 		log <- cl.Debug{
 			"Block submitted via getwork has no matching template for merkle root",
@@ -255,9 +255,10 @@ func handleGetWorkSubmission(
 	merkles := blockchain.BuildMerkleTreeStore(block.Transactions(), false)
 	msgBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
 	// Ensure the submitted block hash is less than the target difficulty.
-	pl := fork.GetMinDiff(s.Cfg.Algo, s.Cfg.Chain.BestSnapshot().Height)
+	pl := fork.GetMinDiff(s.Cfg.ChainParams, s.Cfg.Algo, s.Cfg.Chain.BestSnapshot().Height)
 	log <- cl.Info{"powlimit", pl}
-	err = blockchain.CheckProofOfWork(block, pl, s.Cfg.Chain.BestSnapshot().Height)
+	err = blockchain.CheckProofOfWork(block, pl, s.Cfg.Chain.BestSnapshot().Height,
+		s.Cfg.ChainParams)
 	if err != nil {
 		// Anything other than a rule violation is an unexpected error, so return that error as an internal error.
 		if _, ok := err.(blockchain.RuleError); !ok {
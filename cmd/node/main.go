@@ -35,12 +35,19 @@ func Main(serverChan chan<- *server, started chan struct{}) (err error) {
 	// 	}
 	// }
 	shutdownChan := make(chan struct{})
+	stopped := make(chan struct{})
+	defer close(stopped)
 	interrupt.AddHandler(
 		func() {
 			log <- cl.Inf("closing shutdown channel")
 			close(shutdownChan)
+			<-stopped
 		},
 	)
+	return Run(serverChan, started, shutdownChan)
+}
+// Run runs the node until quit is closed, closing started once the server is started. The server is stopped and the database closed before it returns.
+func Run(serverChan chan<- *server, started chan struct{}, quit <-chan struct{}) (err error) {
 	// Show version at startup.
 	log <- cl.Info{"version", Version()}
 	// Enable http profiling server if requested.
@@ -134,25 +141,23 @@ func Main(serverChan chan<- *server, started chan struct{}) (err error) {
 			"unable to start server on %v: %v", *Cfg.Listeners, err}
 		return err
 	}
-	interrupt.AddHandler(
-		func() {
-			log <- cl.Inf("gracefully shutting down the server...")
-			e := server.Stop()
-			if e != nil {
-				log <- cl.Warn{"failed to stop server", e}
-			}
-			server.WaitForShutdown()
-			log <- cl.Inf("server shutdown complete")
-		},
-	)
 	server.Start()
+	go server.reloadOnSignal()
+	setRunning(server)
+	defer setRunning(nil)
 	if serverChan != nil {
 		serverChan <- server
 	}
 	close(started)
 	log <- cl.Info{"blockchain node is now started"}
 	// Wait until the interrupt signal is received from an OS signal or shutdown is requested through one of the subsystems such as the RPC server.
-	<-interrupt.HandlersDone
+	<-quit
+	log <- cl.Inf("gracefully shutting down the server...")
+	if e := server.Stop(); e != nil {
+		log <- cl.Warn{"failed to stop server", e}
+	}
+	server.WaitForShutdown()
+	log <- cl.Inf("server shutdown complete")
 	return nil
 }
 // dbPath returns the path to the block database given a database type.
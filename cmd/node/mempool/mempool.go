@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -121,6 +122,22 @@ const (
 // Ensure the TxPool type implements the mining.TxSource interface.
 var _ mining.TxSource = (*TxPool)(nil)
 
+// Ancestors returns the descriptors of the transactions in the main pool that the transaction with the passed hash depends on, directly or through other transactions in the pool. This function is safe for concurrent access.
+func (
+	mp *TxPool,
+) Ancestors(
+	txHash *chainhash.Hash) ([]*TxDesc, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	txDesc, exists := mp.pool[*txHash]
+
+	if !exists {
+
+		return nil, fmt.Errorf("transaction is not in the pool")
+	}
+	return descList(mp.ancestors(txDesc.Tx)), nil
+}
+
 // CheckSpend checks whether the passed outpoint is already spent by a transaction in the mempool. If that's the case the spending transaction will be returned, if not nil will be returned.
 func (
 	mp *TxPool,
@@ -142,6 +159,22 @@ func (
 	return count
 }
 
+// Descendants returns the descriptors of the transactions in the main pool that depend on the transaction with the passed hash, directly or through other transactions in the pool. This function is safe for concurrent access.
+func (
+	mp *TxPool,
+) Descendants(
+	txHash *chainhash.Hash) ([]*TxDesc, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	txDesc, exists := mp.pool[*txHash]
+
+	if !exists {
+
+		return nil, fmt.Errorf("transaction is not in the pool")
+	}
+	return descList(mp.descendants(txDesc.Tx)), nil
+}
+
 // FetchTransaction returns the requested transaction from the transaction pool. This only fetches from the main transaction pool and does not include orphans. This function is safe for concurrent access.
 func (
 	mp *TxPool,
@@ -216,6 +249,72 @@ func (
 	return hashes, txD, err
 }
 
+// MempoolEntry returns the entry of the transaction with the passed hash in the main pool as a fully populated json result, including the counts, sizes and fees of its ancestors and descendants in the pool. Both include the transaction itself, as they do in bitcoind. This function is safe for concurrent access.
+func (
+	mp *TxPool,
+) MempoolEntry(
+	txHash *chainhash.Hash) (*json.GetMempoolEntryResult, error) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	txDesc, exists := mp.pool[*txHash]
+
+	if !exists {
+
+		return nil, fmt.Errorf("transaction is not in the pool")
+	}
+	tx := txDesc.Tx
+
+	// Calculate the current priority based on the inputs to the transaction.  Use zero if one or more of the input transactions can't be found for some reason.
+	var currentPriority float64
+	utxos, err := mp.fetchInputUtxos(tx)
+
+	if err == nil {
+
+		currentPriority = mining.CalcPriority(tx.MsgTx(), utxos,
+			mp.cfg.BestHeight()+1)
+	}
+	vsize := int64(GetTxVirtualSize(tx))
+	entry := &json.GetMempoolEntryResult{
+		Size:             int32(tx.MsgTx().SerializeSize()),
+		Vsize:            int32(vsize),
+		Fee:              util.Amount(txDesc.Fee).ToDUO(),
+		ModifiedFee:      util.Amount(txDesc.Fee).ToDUO(),
+		Time:             txDesc.Added.Unix(),
+		Height:           int64(txDesc.Height),
+		StartingPriority: txDesc.StartingPriority,
+		CurrentPriority:  currentPriority,
+		Depends:          make([]string, 0),
+	}
+
+	for _, txIn := range tx.MsgTx().TxIn {
+
+		hash := &txIn.PreviousOutPoint.Hash
+
+		if mp.isTransactionInPool(hash) {
+
+			entry.Depends = append(entry.Depends, hash.String())
+		}
+	}
+	sum := func(descs map[chainhash.Hash]*TxDesc) (count, size int64, fees util.Amount) {
+		count, size, fees = 1, vsize, util.Amount(txDesc.Fee)
+
+		for _, desc := range descs {
+
+			count++
+			size += GetTxVirtualSize(desc.Tx)
+			fees += util.Amount(desc.Fee)
+		}
+		return
+	}
+	count, size, fees := sum(mp.ancestors(tx))
+	entry.AncestorCount, entry.AncestorSize = count, size
+	entry.AncestorFees = fees.ToDUO()
+	count, size, fees = sum(mp.descendants(tx))
+	entry.DescendantCount, entry.DescendantSize = count, size
+	entry.DescendantFees = fees.ToDUO()
+	return entry, nil
+}
+
 // MiningDescs returns a slice of mining descriptors for all the transactions in the pool. This is part of the mining.TxSource interface implementation and is safe for concurrent access as required by the interface contract.
 func (
 	mp *TxPool,
@@ -404,6 +503,16 @@ func (
 	mp.mtx.Unlock()
 }
 
+// SetMinRelayTxFee changes the minimum fee of transactions that are accepted and relayed while the pool is running. This function is safe for concurrent access.
+func (
+	mp *TxPool,
+) SetMinRelayTxFee(
+	fee util.Amount) {
+	mp.mtx.Lock()
+	mp.cfg.Policy.MinRelayTxFee = fee
+	mp.mtx.Unlock()
+}
+
 // TxDescs returns a slice of descriptors for all the transactions in the pool. The descriptors are to be treated as read only. This function is safe for concurrent access.
 func (
 	mp *TxPool,
@@ -519,6 +628,38 @@ func (
 	return txD
 }
 
+// ancestors returns the transactions in the main pool that the passed transaction spends outputs of, directly or through other transactions in the pool. This function MUST be called with the mempool lock held (for reads).
+func (
+	mp *TxPool,
+) ancestors(
+	tx *util.Tx) map[chainhash.Hash]*TxDesc {
+	found := make(map[chainhash.Hash]*TxDesc)
+	stack := []*util.Tx{tx}
+
+	for len(stack) > 0 {
+
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, txIn := range next.MsgTx().TxIn {
+
+			hash := txIn.PreviousOutPoint.Hash
+
+			if _, ok := found[hash]; ok {
+
+				continue
+			}
+
+			if desc, ok := mp.pool[hash]; ok {
+
+				found[hash] = desc
+				stack = append(stack, desc.Tx)
+			}
+		}
+	}
+	return found
+}
+
 // checkPoolDoubleSpend checks whether or not the passed transaction is attempting to spend coins already spent by other transactions in the pool. Note it does not check for double spends against transactions already in the main chain. This function MUST be called with the mempool lock held (for reads).
 func (
 	mp *TxPool,
@@ -538,6 +679,45 @@ func (
 	return nil
 }
 
+// descendants returns the transactions in the main pool that spend outputs of the passed transaction, directly or through other transactions in the pool. This function MUST be called with the mempool lock held (for reads).
+func (
+	mp *TxPool,
+) descendants(
+	tx *util.Tx) map[chainhash.Hash]*TxDesc {
+	found := make(map[chainhash.Hash]*TxDesc)
+	stack := []*util.Tx{tx}
+
+	for len(stack) > 0 {
+
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		prevOut := wire.OutPoint{Hash: *next.Hash()}
+
+		for i := range next.MsgTx().TxOut {
+
+			prevOut.Index = uint32(i)
+			redeemer, ok := mp.outpoints[prevOut]
+
+			if !ok {
+
+				continue
+			}
+
+			if _, ok := found[*redeemer.Hash()]; ok {
+
+				continue
+			}
+
+			if desc, ok := mp.pool[*redeemer.Hash()]; ok {
+
+				found[*redeemer.Hash()] = desc
+				stack = append(stack, redeemer)
+			}
+		}
+	}
+	return found
+}
+
 // fetchInputUtxos loads utxo details about the input transactions referenced by the passed transaction.  First, it loads the details form the viewpoint of the main chain, then it adjusts them based upon the contents of the transaction pool. This function MUST be called with the mempool lock held (for reads).
 func (
 	mp *TxPool,
@@ -1131,6 +1311,21 @@ func (
 	}
 }
 
+// descList returns the descriptors of a set of transactions of the pool ordered by the time they were added, oldest first.
+func descList(
+	descs map[chainhash.Hash]*TxDesc) []*TxDesc {
+	sorted := make([]*TxDesc, 0, len(descs))
+
+	for _, desc := range descs {
+
+		sorted = append(sorted, desc)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Added.Before(sorted[j].Added)
+	})
+	return sorted
+}
+
 // New returns a new memory pool for validating and storing standalone transactions until they are mined into a block.
 func New(
 	cfg *Config) *TxPool {
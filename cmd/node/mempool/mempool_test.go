@@ -826,3 +826,94 @@ func TestCheckSpend(
 		t.Fatalf("Unexpeced spend found in pool: %v", spend)
 	}
 }
+
+// TestAncestorsDescendants tests that the ancestors and descendants of a transaction in a chain of transactions in the mempool, and the counts and sizes of its entry, include the transactions before and after it.
+func TestAncestorsDescendants(
+	t *testing.T) {
+
+	t.Parallel()
+	harness, outputs, err := newPoolHarness(&chaincfg.MainNetParams)
+
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	const txChainLength = 5
+	chainedTxns, err := harness.CreateTxChain(outputs[0], txChainLength)
+
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+
+	for _, tx := range chainedTxns {
+		_, err := harness.txPool.ProcessTransaction(tx, true,
+			false, 0)
+
+		if err != nil {
+
+			t.Fatalf("ProcessTransaction: failed to accept "+
+				"tx: %v", err)
+		}
+	}
+
+	for i, tx := range chainedTxns {
+		ancestors, err := harness.txPool.Ancestors(tx.Hash())
+
+		if err != nil {
+			t.Fatalf("Ancestors: %v", err)
+		}
+		descendants, err := harness.txPool.Descendants(tx.Hash())
+
+		if err != nil {
+			t.Fatalf("Descendants: %v", err)
+		}
+
+		if len(ancestors) != i || len(descendants) != txChainLength-i-1 {
+
+			t.Fatalf("transaction %d has %d ancestors and %d descendants, "+
+				"expected %d and %d", i, len(ancestors), len(descendants),
+				i, txChainLength-i-1)
+		}
+
+		for _, desc := range ancestors {
+
+			if desc.Tx == tx {
+				t.Fatalf("transaction %d is its own ancestor", i)
+			}
+		}
+		entry, err := harness.txPool.MempoolEntry(tx.Hash())
+
+		if err != nil {
+			t.Fatalf("MempoolEntry: %v", err)
+		}
+		var ancestorSize, descendantSize int64
+
+		for j, chainedTx := range chainedTxns {
+
+			if j <= i {
+				ancestorSize += GetTxVirtualSize(chainedTx)
+			}
+
+			if j >= i {
+				descendantSize += GetTxVirtualSize(chainedTx)
+			}
+		}
+
+		if entry.AncestorCount != int64(i+1) ||
+			entry.DescendantCount != int64(txChainLength-i) ||
+			entry.AncestorSize != ancestorSize ||
+			entry.DescendantSize != descendantSize {
+
+			t.Fatalf("transaction %d has entry %+v", i, entry)
+		}
+
+		if (i == 0) != (len(entry.Depends) == 0) {
+			t.Fatalf("transaction %d depends on %v", i, entry.Depends)
+		}
+	}
+	// Transactions that are not in the pool have no entry.
+	_, err = harness.txPool.Ancestors(&outputs[0].outPoint.Hash)
+
+	if err == nil {
+		t.Fatalf("Ancestors: got no error for a transaction not in the pool")
+	}
+}
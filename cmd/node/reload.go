@@ -0,0 +1,128 @@
+package node
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"git.parallelcoin.io/dev/9/cmd/nine"
+	"git.parallelcoin.io/dev/9/pkg/util"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// LoadConfig is set by the launcher of the node to read and validate its configuration file again. It returns the new configuration, the configuration rows that changed and those of them that only take effect after a restart.
+var LoadConfig func() (cfg *nine.Config, changed, restart []string, err error)
+var (
+	// reloadMtx makes reloads of the configuration happen one at a time
+	reloadMtx sync.Mutex
+	// liveMtx guards the settings of Cfg and StateCfg that are replaced when the configuration is reloaded. The peer handler, which replaces the peer limits and ban settings, reads those without it.
+	liveMtx sync.RWMutex
+)
+// activeMiningAddrs returns the addresses the node mines to. This function is safe for concurrent access.
+func activeMiningAddrs() []util.Address {
+	liveMtx.RLock()
+	defer liveMtx.RUnlock()
+	return StateCfg.ActiveMiningAddrs
+}
+// randomMiningAddr returns one of the addresses the node mines to chosen at random, or nil if there are none. This function is safe for concurrent access.
+func randomMiningAddr() util.Address {
+	addrs := activeMiningAddrs()
+	if len(addrs) == 0 {
+		return nil
+	}
+	return addrs[rand.Intn(len(addrs))]
+}
+// activeMinRelayTxFee returns the minimum fee of relayed transactions. This function is safe for concurrent access.
+func activeMinRelayTxFee() util.Amount {
+	liveMtx.RLock()
+	defer liveMtx.RUnlock()
+	return StateCfg.ActiveMinRelayTxFee
+}
+// banning returns whether banning misbehaving peers is disabled and the ban score that bans a peer. This function is safe for concurrent access.
+func banning() (disabled bool, threshold int) {
+	liveMtx.RLock()
+	defer liveMtx.RUnlock()
+	return *Cfg.DisableBanning, *Cfg.BanThreshold
+}
+// setLiveConfigMsg carries the settings that can change while running to the peer handler, where the peer limits are read
+type setLiveConfigMsg struct {
+	cfg   *nine.Config
+	reply chan struct{}
+}
+// ReloadConfig reads the configuration file again with LoadConfig and puts the settings that can change while running into the server, its connection manager, mempool and miners. It returns the configuration rows that changed and those of them that need a restart to take effect. This function is safe for concurrent access.
+func (s *server) ReloadConfig() (changed, restart []string, err error) {
+	if LoadConfig == nil {
+		return nil, nil, errors.New("configuration reloading is not available")
+	}
+	reloadMtx.Lock()
+	defer reloadMtx.Unlock()
+	cfg, changed, restart, err := LoadConfig()
+	if err != nil {
+		return
+	}
+	if cfg.LogLevel != nil {
+		liveMtx.Lock()
+		Cfg.LogLevel = cfg.LogLevel
+		liveMtx.Unlock()
+		cl.Register.SetAllLevels(*cfg.LogLevel)
+	}
+	reply := make(chan struct{})
+	select {
+	case s.query <- setLiveConfigMsg{cfg: cfg, reply: reply}:
+		<-reply
+	case <-s.quit:
+		return nil, nil, errors.New("server is shutting down")
+	}
+	targetOutbound := defaultTargetOutbound
+	if *cfg.MaxPeers < targetOutbound {
+		targetOutbound = *cfg.MaxPeers
+	}
+	s.connManager.SetTargetOutbound(uint32(targetOutbound))
+	liveMtx.Lock()
+	StateCfg.ActiveMinRelayTxFee = cfg.State.ActiveMinRelayTxFee
+	Cfg.MiningAddrs = cfg.MiningAddrs
+	StateCfg.ActiveMiningAddrs = cfg.State.ActiveMiningAddrs
+	threads := cfg.GenThreads != nil && (Cfg.GenThreads == nil || *Cfg.GenThreads != *cfg.GenThreads)
+	if threads {
+		Cfg.GenThreads = cfg.GenThreads
+	}
+	liveMtx.Unlock()
+	s.txMemPool.SetMinRelayTxFee(cfg.State.ActiveMinRelayTxFee)
+	s.cpuMiner.SetMiningAddrs(cfg.State.ActiveMiningAddrs)
+	s.minerController.SetMiningAddrs(cfg.State.ActiveMiningAddrs)
+	s.stratum.SetMiningAddrs(cfg.State.ActiveMiningAddrs)
+	if threads {
+		s.cpuMiner.SetNumWorkers(int32(*cfg.GenThreads))
+	}
+	log <- cl.Info{"reloaded configuration, changed:", changed}
+	if len(restart) > 0 {
+		log <- cl.Warn{"configuration changes that need a restart to take effect:", restart}
+	}
+	return
+}
+// setLiveConfig puts the peer limits and banning settings of cfg into the configuration of the running server. It is called from the peer handler.
+func (s *server) setLiveConfig(cfg *nine.Config) {
+	liveMtx.Lock()
+	defer liveMtx.Unlock()
+	Cfg.MaxPeers = cfg.MaxPeers
+	Cfg.DisableBanning = cfg.DisableBanning
+	Cfg.BanDuration = cfg.BanDuration
+	Cfg.BanThreshold = cfg.BanThreshold
+}
+// reloadOnSignal reloads the configuration every time the process gets a hangup signal, until the server shuts down
+func (s *server) reloadOnSignal() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	for {
+		select {
+		case <-hup:
+			log <- cl.Inf("received hangup signal, reloading configuration")
+			if _, _, err := s.ReloadConfig(); err != nil {
+				log <- cl.Error{"failed to reload configuration:", err}
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
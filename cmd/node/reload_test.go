@@ -0,0 +1,60 @@
+package node
+import (
+	js "encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+)
+// TestReloadConfigCmd ensures the reloadconfig command marshals and unmarshals, and that its handler returns the rows the reload reports and its errors.
+func TestReloadConfigCmd(
+	t *testing.T) {
+	const marshalled = `{"jsonrpc":"1.0","method":"reloadconfig","params":[],"id":1}`
+	cmd, err := json.NewCmd("reloadconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range []interface{}{cmd, json.NewReloadConfigCmd()} {
+		b, err := json.MarshalCmd(1, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != marshalled {
+			t.Fatalf("got %s, expected %s", b, marshalled)
+		}
+	}
+	var request json.Request
+	if err = js.Unmarshal([]byte(marshalled), &request); err != nil {
+		t.Fatal(err)
+	}
+	if cmd, err = json.UnmarshalCmd(&request); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cmd, &json.ReloadConfigCmd{}) {
+		t.Fatalf("unmarshalled %#v", cmd)
+	}
+	s := &rpcServer{}
+	if _, err = handleReloadConfig(s, cmd, nil); err != ErrRPCUnimplemented {
+		t.Fatalf("got %v without reloading, expected %v", err, ErrRPCUnimplemented)
+	}
+	s.Cfg.ReloadConfig = func() (changed, restart []string, err error) {
+		return []string{"p2p.listen", "p2p.maxpeers"}, []string{"p2p.listen"}, nil
+	}
+	result, err := handleReloadConfig(s, cmd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &json.ReloadConfigResult{
+		Changed: []string{"p2p.listen", "p2p.maxpeers"},
+		Restart: []string{"p2p.listen"},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Fatalf("got %+v, expected %+v", result, want)
+	}
+	s.Cfg.ReloadConfig = func() (changed, restart []string, err error) {
+		return nil, nil, errors.New("invalid configuration")
+	}
+	if _, err = handleReloadConfig(s, cmd, nil); err == nil {
+		t.Fatal("reload error was not returned")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	netsync "git.parallelcoin.io/dev/9/pkg/chain/sync"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 	"git.parallelcoin.io/dev/9/pkg/peer"
+	"git.parallelcoin.io/dev/9/pkg/peer/addrmgr"
 	"git.parallelcoin.io/dev/9/pkg/util"
 )
 // rpcPeer provides a peer for use with the RPC server and implements the rpcserverPeer interface.
@@ -88,6 +89,14 @@ func (cm *rpcConnManager) DisconnectByAddr(addr string) error {
 func (cm *rpcConnManager) ConnectedCount() int32 {
 	return cm.server.ConnectedCount()
 }
+// Services returns the services the server advertises to its peers. This function is safe for concurrent access and is part of the rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) Services() wire.ServiceFlag {
+	return cm.server.services
+}
+// LocalAddresses returns the addresses the server advertises to its peers, with the score of each. This function is safe for concurrent access and is part of the rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) LocalAddresses() []addrmgr.LocalAddress {
+	return cm.server.addrManager.LocalAddresses()
+}
 // NetTotals returns the sum of all bytes received and sent across the network for all peers. This function is safe for concurrent access and is part of the rpcserverConnManager interface implementation.
 func (cm *rpcConnManager) NetTotals() (uint64, uint64) {
 	return cm.server.NetTotals()
@@ -28,10 +28,12 @@ import (
 	indexers "git.parallelcoin.io/dev/9/pkg/chain/index"
 	"git.parallelcoin.io/dev/9/pkg/chain/mining"
 	cpuminer "git.parallelcoin.io/dev/9/pkg/chain/mining/cpu"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining/stratum"
 	txscript "git.parallelcoin.io/dev/9/pkg/chain/tx/script"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 	database "git.parallelcoin.io/dev/9/pkg/db"
 	p "git.parallelcoin.io/dev/9/pkg/peer"
+	"git.parallelcoin.io/dev/9/pkg/peer/addrmgr"
 	"git.parallelcoin.io/dev/9/pkg/rpc/json"
 	"git.parallelcoin.io/dev/9/pkg/util"
 	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
@@ -76,7 +78,7 @@ type rpcServer struct {
 	statusLines            map[int]string
 	statusLock             sync.RWMutex
 	wg                     sync.WaitGroup
-	gbtWorkState           *gbtWorkState
+	gbtWorkStates          map[string]*gbtWorkState
 	helpCacher             *helpCacher
 	requestProcessShutdown chan struct{}
 	quit                   chan int
@@ -101,12 +103,16 @@ type rpcserverConfig struct {
 	// These fields allow the RPC server to interface with mining. Generator produces block templates and the CPUMiner solves them using the CPU.  CPU mining is typically only useful for test purposes when doing regression or simulation testing.
 	Generator *mining.BlkTmplGenerator
 	CPUMiner  *cpuminer.CPUMiner
+	// Stratum is the server of the Stratum miners, reported by getstratuminfo.
+	Stratum *stratum.Server
 	// These fields define any optional indexes the RPC server can make use of to provide additional data when queried.
 	TxIndex   *indexers.TxIndex
 	AddrIndex *indexers.AddrIndex
 	CfIndex   *indexers.CfIndex
 	// The fee estimator keeps track of how long transactions are left in the mempool before they are mined into blocks.
 	FeeEstimator *mempool.FeeEstimator
+	// ReloadConfig reads the configuration file again and applies the settings that can change while running, returning the rows that changed and those that need a restart.
+	ReloadConfig func() (changed, restart []string, err error)
 	// Algo sets the algorithm expected from the RPC endpoint. This allows multiple ports to serve multiple types of miners with one main node per algorithm. Currently 514 for scrypt and anything else passes for sha256d. After hard fork 1 there is 9, and may be expanded in the future (equihash, cuckoo and cryptonight all require substantial block header/tx formatting changes)
 	Algo string
 }
@@ -136,6 +142,10 @@ type rpcserverConnManager interface {
 	AddRebroadcastInventory(iv *wire.InvVect, data interface{})
 	// RelayTransactions generates and relays inventory vectors for all of the passed transactions to all connected peers.
 	RelayTransactions(txns []*mempool.TxDesc)
+	// Services returns the services the server advertises to its peers.
+	Services() wire.ServiceFlag
+	// LocalAddresses returns the addresses the server advertises to its peers, with the score of each.
+	LocalAddresses() []addrmgr.LocalAddress
 }
 // rpcserverPeer represents a peer for use with the RPC server. The interface contract requires that all of these methods are safe for concurrent access.
 type rpcserverPeer interface {
@@ -179,6 +189,10 @@ const (
 	gbtRegenerateSeconds = 60
 	// maxProtocolVersion is the max protocol version the server supports.
 	maxProtocolVersion = 70002
+	// algoStatsBlocks is the number of recent blocks getalgostats and the algostats notification count the share and hashes per second of each algorithm over when none is given.
+	algoStatsBlocks = 120
+	// algoStatsTimes is the number of times between the latest blocks of each algorithm getalgostats and the algostats notification return when none is given.
+	algoStatsTimes = 10
 )
 // Errors
 var (
@@ -270,6 +284,7 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"estimatefee":           handleEstimateFee,
 	"generate":              handleGenerate,
 	"getaddednodeinfo":      handleGetAddedNodeInfo,
+	"getalgostats":          handleGetAlgoStats,
 	"getbestblock":          handleGetBestBlock,
 	"getbestblockhash":      handleGetBestBlockHash,
 	"getblock":              handleGetBlock,
@@ -280,6 +295,7 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"getblocktemplate":      handleGetBlockTemplate,
 	"getcfilter":            handleGetCFilter,
 	"getcfilterheader":      handleGetCFilterHeader,
+	"getchaintips":          handleGetChainTips,
 	"getconnectioncount":    handleGetConnectionCount,
 	"getcurrentnet":         handleGetCurrentNet,
 	"getdifficulty":         handleGetDifficulty,
@@ -287,18 +303,28 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"gethashespersec":       handleGetHashesPerSec,
 	"getheaders":            handleGetHeaders,
 	"getinfo":               handleGetInfo,
+	"getmempoolancestors":   handleGetMempoolAncestors,
+	"getmempooldescendants": handleGetMempoolDescendants,
+	"getmempoolentry":       handleGetMempoolEntry,
 	"getmempoolinfo":        handleGetMempoolInfo,
 	"getmininginfo":         handleGetMiningInfo,
 	"getnettotals":          handleGetNetTotals,
 	"getnetworkhashps":      handleGetNetworkHashPS,
+	"getnetworkinfo":        handleGetNetworkInfo,
 	"getpeerinfo":           handleGetPeerInfo,
 	"getrawmempool":         handleGetRawMempool,
 	"getrawtransaction":     handleGetRawTransaction,
+	"getservicestatus":      handleGetServiceStatus,
+	"getstratuminfo":        handleGetStratumInfo,
 	"gettxout":              handleGetTxOut,
 	"getwork":               handleGetWork,
 	"help":                  handleHelp,
+	"invalidateblock":       handleInvalidateBlock,
 	"node":                  handleNode,
 	"ping":                  handlePing,
+	"preciousblock":         handlePreciousBlock,
+	"reconsiderblock":       handleReconsiderBlock,
+	"reloadconfig":          handleReloadConfig,
 	"searchrawtransactions": handleSearchRawTransactions,
 	"sendrawtransaction":    handleSendRawTransaction,
 	"setgenerate":           handleSetGenerate,
@@ -314,6 +340,7 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 var rpcLimited = map[string]struct{}{
 	// Websockets commands
 	"loadtxfilter":          {},
+	"notifyalgostats":       {},
 	"notifyblocks":          {},
 	"notifynewtransactions": {},
 	"notifyreceived":        {},
@@ -328,6 +355,7 @@ var rpcLimited = map[string]struct{}{
 	"decoderawtransaction":  {},
 	"decodescript":          {},
 	"estimatefee":           {},
+	"getalgostats":          {},
 	"getbestblock":          {},
 	"getbestblockhash":      {},
 	"getblock":              {},
@@ -336,12 +364,18 @@ var rpcLimited = map[string]struct{}{
 	"getblockheader":        {},
 	"getcfilter":            {},
 	"getcfilterheader":      {},
+	"getchaintips":          {},
 	"getcurrentnet":         {},
 	"getdifficulty":         {},
 	"getheaders":            {},
 	"getinfo":               {},
+	"getmempoolancestors":   {},
+	"getmempooldescendants": {},
+	"getmempoolentry":       {},
+	"getmempoolinfo":        {},
 	"getnettotals":          {},
 	"getnetworkhashps":      {},
+	"getnetworkinfo":        {},
 	"getrawmempool":         {},
 	"getrawtransaction":     {},
 	"gettxout":              {},
@@ -356,13 +390,7 @@ var rpcLimited = map[string]struct{}{
 // Commands that are currently unimplemented, but should ultimately be.
 var rpcUnimplemented = map[string]struct{}{
 	"estimatepriority": {},
-	"getchaintips":     {},
-	"getmempoolentry":  {},
-	"getnetworkinfo":   {},
 	"getwork":          {},
-	"invalidateblock":  {},
-	"preciousblock":    {},
-	"reconsiderblock":  {},
 }
 // NotifyBlockConnected uses the newly-connected block to notify any long poll clients with a new block template when their existing block template is stale due to the newly connected block.
 func (
@@ -604,7 +632,7 @@ func (
 		// Choose a payment address at random if the caller requests a full coinbase as opposed to only the pertinent details needed to create their own coinbase.
 		var payAddr util.Address
 		if !useCoinbaseValue {
-			payAddr = StateCfg.ActiveMiningAddrs[rand.Intn(len(StateCfg.ActiveMiningAddrs))]
+			payAddr = randomMiningAddr()
 		}
 		// Create a new block template that has a coinbase which anyone can redeem.  This is only acceptable because the returned block template doesn't include the coinbase, so the caller will ultimately create their own coinbase which pays to the appropriate address(es).
 		blkTemplate, err := generator.NewBlockTemplate(payAddr, state.algo)
@@ -637,7 +665,7 @@ func (
 		// At this point, there is a saved block template and another request for a template was made, but either the available transactions haven't change or it hasn't been long enough to trigger a new block template to be generated.  So, update the existing block template. When the caller requires a full coinbase as opposed to only the pertinent details needed to create their own coinbase, add a payment address to the output of the coinbase of the template if it doesn't already have one.  Since this requires mining addresses to be specified via the config, an error is returned if none have been specified.
 		if !useCoinbaseValue && !template.ValidPayAddress {
 			// Choose a payment address at random.
-			payToAddr := StateCfg.ActiveMiningAddrs[rand.Intn(len(StateCfg.ActiveMiningAddrs))]
+			payToAddr := randomMiningAddr()
 			// Update the block coinbase output of the template to pay to the randomly selected payment address.
 			pkScript, err := txscript.PayToAddrScript(payToAddr)
 			if err != nil {
@@ -676,7 +704,9 @@ func (
 		// Notify websocket clients about mempool transactions.
 		s.ntfnMgr.NotifyMempoolTx(txD.Tx, true)
 		// Potentially notify any getblocktemplate long poll clients about stale block templates due to the new transaction.
-		s.gbtWorkState.NotifyMempoolTx(s.Cfg.TxMemPool.LastUpdated())
+		for _, state := range s.gbtWorkStates {
+			state.NotifyMempoolTx(s.Cfg.TxMemPool.LastUpdated())
+		}
 	}
 }
 // RequestedProcessShutdown returns a channel that is sent to when an authorized RPC client requests the process to shutdown.  If the request can not be read immediately, it is dropped.
@@ -823,7 +853,9 @@ func (
 			break
 		}
 		// Allow any clients performing long polling via the getblocktemplate RPC to be notified when the new block causes their old block template to become stale.
-		s.gbtWorkState.NotifyBlockConnected(block.Hash())
+		for _, state := range s.gbtWorkStates {
+			state.NotifyBlockConnected(block.Hash())
+		}
 	case blockchain.NTBlockConnected:
 		block, ok := notification.Data.(*util.Block)
 		if !ok {
@@ -1526,6 +1558,88 @@ func genCertPair(
 	log <- cl.Inf("Done generating TLS certificates")
 	return nil
 }
+// algoParam returns the algorithm named by the optional algo parameter of a mining command, the algorithm of the RPC endpoint when it is not given. An error is returned when it is not an algorithm of the hard fork in force at height.
+func (s *rpcServer) algoParam(
+	algo *string, height int32) (string, error) {
+	name := s.Cfg.Algo
+	if algo != nil && *algo != "" {
+		name = *algo
+	}
+	if _, ok := fork.Get(s.Cfg.ChainParams, height).Algos[name]; !ok {
+		return "", &json.RPCError{
+			Code:    json.ErrRPCInvalidParameter,
+			Message: fmt.Sprintf("%s is not a mining algorithm at height %d", name, height),
+		}
+	}
+	return name, nil
+}
+// algoStats returns the statistics of the algorithms for the block after the given block of the main chain, over the given number of blocks up to it
+func (s *rpcServer) algoStats(
+	hash *chainhash.Hash, height int32, blocks int32, times int) (*json.GetAlgoStatsResult, error) {
+	stats, err := s.Cfg.Chain.CalcAlgoStats(hash, blocks, times)
+	if err != nil {
+		return nil, err
+	}
+	result := &json.GetAlgoStatsResult{
+		Hash:   hash.String(),
+		Height: height,
+		Blocks: blocks,
+	}
+	if height < blocks {
+		result.Blocks = height
+	}
+	for _, x := range stats {
+		algo := json.AlgoStatsResult{
+			Algo:          x.Algo,
+			Version:       x.Version,
+			Bits:          strconv.FormatInt(int64(x.Bits), 16),
+			Difficulty:    getDifficultyRatio(x.Bits, s.Cfg.ChainParams, x.Version),
+			BlockTimes:    x.Times,
+			Blocks:        x.Blocks,
+			Share:         x.Share,
+			NetworkHashPS: x.HashesPerSec,
+		}
+		if x.HasDivergence {
+			algo.Averagers = &json.AlgoAveragersResult{
+				AllTime:          x.Divergence.AllTime,
+				Trailing:         x.Divergence.Trail,
+				TrailingWeighted: x.Divergence.Trailing,
+				AlgoWeighted:     x.Divergence.Algo,
+				Adjustment:       x.Divergence.Adjustment,
+			}
+		}
+		result.Algos = append(result.Algos, algo)
+	}
+	return result, nil
+}
+// changeBlockStatus applies one of the invalidateblock, reconsiderblock and preciousblock commands to the block with the given hash and converts its error for the RPC client.
+func changeBlockStatus(
+	s *rpcServer, blockHash string, change func(*chainhash.Hash) error) error {
+	hash, err := chainhash.NewHashFromStr(blockHash)
+	if err != nil {
+		return rpcDecodeHexError(blockHash)
+	}
+	if _, err := s.Cfg.Chain.HeaderByHash(hash); err != nil {
+		return &json.RPCError{
+			Code:    json.ErrRPCBlockNotFound,
+			Message: "Block not found",
+		}
+	}
+	err = change(hash)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(blockchain.RuleError); ok {
+		return &json.RPCError{
+			Code:    json.ErrRPCVerify,
+			Message: err.Error(),
+		}
+	}
+	return &json.RPCError{
+		Code:    json.ErrRPCDatabase,
+		Message: err.Error(),
+	}
+}
 // getDifficultyRatio returns the proof-of-work difficulty as a multiple of the minimum difficulty using the passed bits field from the header of a block.
 func getDifficultyRatio(
 	bits uint32,
@@ -1773,7 +1887,7 @@ func handleEstimateFee(
 func handleGenerate(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	// Respond with an error if there are no addresses to pay the created blocks to.
-	if len(StateCfg.ActiveMiningAddrs) == 0 {
+	if len(activeMiningAddrs()) == 0 {
 		return nil, &json.RPCError{
 			Code:    json.ErrRPCInternal.Code,
 			Message: "No payment addresses specified via --miningaddr",
@@ -1786,9 +1900,11 @@ func handleGenerate(
 			Message: fmt.Sprintf("No support for `generate` on the current network, %s, as it's unlikely to be possible to mine a block with the CPU.", s.Cfg.ChainParams.Net),
 		}
 	}
-	// Set the algorithm according to the port we were called on
-	s.Cfg.CPUMiner.SetAlgo(s.Cfg.Algo)
 	c := cmd.(*json.GenerateCmd)
+	algo, err := s.algoParam(c.Algo, s.Cfg.Chain.BestSnapshot().Height+1)
+	if err != nil {
+		return nil, err
+	}
 	// Respond with an error if the client is requesting 0 blocks to be generated.
 	if c.NumBlocks == 0 {
 		return nil, &json.RPCError{
@@ -1798,8 +1914,7 @@ func handleGenerate(
 	}
 	// Create a reply
 	reply := make([]string, c.NumBlocks)
-	fmt.Println(s.Cfg.Algo)
-	blockHashes, err := s.Cfg.CPUMiner.GenerateNBlocks(c.NumBlocks, s.Cfg.Algo)
+	blockHashes, err := s.Cfg.CPUMiner.GenerateNBlocks(c.NumBlocks, algo)
 	if err != nil {
 		return nil, &json.RPCError{
 			Code:    json.ErrRPCInternal.Code,
@@ -1889,6 +2004,25 @@ func handleGetAddedNodeInfo(
 	}
 	return results, nil
 }
+// handleGetAlgoStats implements the getalgostats command.
+func handleGetAlgoStats(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.GetAlgoStatsCmd)
+	blocks, times := int32(algoStatsBlocks), algoStatsTimes
+	if c.Blocks != nil && *c.Blocks > 0 {
+		blocks = int32(*c.Blocks)
+	}
+	if c.Times != nil && *c.Times >= 0 {
+		times = *c.Times
+	}
+	best := s.Cfg.Chain.BestSnapshot()
+	result, err := s.algoStats(&best.Hash, best.Height, blocks, times)
+	if err != nil {
+		context := "Failed to calculate algorithm statistics"
+		return nil, internalRPCError(err.Error(), context)
+	}
+	return result, nil
+}
 // handleGetBestBlock implements the getbestblock command.
 func handleGetBestBlock(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -1957,16 +2091,16 @@ func handleGetBlock(
 	}
 	params := s.Cfg.ChainParams
 	blockHeader := &blk.MsgBlock().Header
-	algoname := fork.GetAlgoName(blockHeader.Version, blockHeight)
-	a := fork.GetAlgoVer(algoname, blockHeight)
-	algoid := fork.GetAlgoID(algoname, blockHeight)
+	algoname := fork.GetAlgoName(s.Cfg.ChainParams, blockHeader.Version, blockHeight)
+	a := fork.GetAlgoVer(s.Cfg.ChainParams, algoname, blockHeight)
+	algoid := fork.GetAlgoID(s.Cfg.ChainParams, algoname, blockHeight)
 	blockReply := json.GetBlockVerboseResult{
 		Hash:          c.Hash,
 		Version:       blockHeader.Version,
 		VersionHex:    fmt.Sprintf("%08x", blockHeader.Version),
 		PowAlgoID:     algoid,
 		PowAlgo:       algoname,
-		PowHash:       blk.MsgBlock().BlockHashWithAlgos(blockHeight).String(),
+		PowHash:       s.Cfg.Chain.PowHash(blockHeader, blockHeight).String(),
 		MerkleRoot:    blockHeader.MerkleRoot.String(),
 		PreviousHash:  blockHeader.PrevBlock.String(),
 		Nonce:         blockHeader.Nonce,
@@ -2020,6 +2154,12 @@ func handleGetBlockChainInfo(
 		Pruned:        false,
 		Bip9SoftForks: make(map[string]*json.Bip9SoftForkDescription),
 	}
+	if checkpoint := chain.AutoCheckpoint(); checkpoint != nil {
+		chainInfo.AutoCheckpoint = &json.AutoCheckpointResult{
+			Height: checkpoint.Height,
+			Hash:   checkpoint.Hash.String(),
+		}
+	}
 	// Next, populate the response with information describing the current status of soft-forks deployed via the super-majority block signalling mechanism.
 	height := chainSnapshot.Height
 	chainInfo.SoftForks = []*json.SoftForkDescription{
@@ -2189,7 +2329,11 @@ func handleGetBlockTemplate(
 	}
 	switch mode {
 	case "template":
-		return handleGetBlockTemplateRequest(s, request, closeChan)
+		algo, err := s.algoParam(c.Algo, s.Cfg.Chain.BestSnapshot().Height+1)
+		if err != nil {
+			return nil, err
+		}
+		return handleGetBlockTemplateRequest(s, request, algo, closeChan)
 	case "proposal":
 		return handleGetBlockTemplateProposal(s, request)
 	}
@@ -2200,8 +2344,8 @@ func handleGetBlockTemplate(
 }
 // handleGetBlockTemplateLongPoll is a helper for handleGetBlockTemplateRequest which deals with handling long polling for block templates.  When a caller sends a request with a long poll ID that was previously returned, a response is not sent until the caller should stop working on the previous block template in favor of the new one.  In particular, this is the case when the old block template is no longer valid due to a solution already being found and added to the block chain, or new transactions have shown up and some time has passed without finding a solution. See https://en.bitcoin.it/wiki/BIP_0022 for more details.
 func handleGetBlockTemplateLongPoll(
-	s *rpcServer, longPollID string, useCoinbaseValue bool, closeChan <-chan struct{}) (interface{}, error) {
-	state := s.gbtWorkState
+	s *rpcServer, longPollID string, algo string, useCoinbaseValue bool, closeChan <-chan struct{}) (interface{}, error) {
+	state := s.gbtWorkStates[algo]
 	state.Lock()
 	// The state unlock is intentionally not deferred here since it needs to be manually unlocked before waiting for a notification about block template changes.
 	if err := state.updateBlockTemplate(s, useCoinbaseValue); err != nil {
@@ -2314,7 +2458,7 @@ func handleGetBlockTemplateProposal(
 }
 // handleGetBlockTemplateRequest is a helper for handleGetBlockTemplate which deals with generating and returning block templates to the caller.  It handles both long poll requests as specified by BIP 0022 as well as regular requests.  In addition, it detects the capabilities reported by the caller in regards to whether or not it supports creating its own coinbase (the coinbasetxn and coinbasevalue capabilities) and modifies the returned block template accordingly.
 func handleGetBlockTemplateRequest(
-	s *rpcServer, request *json.TemplateRequest, closeChan <-chan struct{}) (interface{}, error) {
+	s *rpcServer, request *json.TemplateRequest, algo string, closeChan <-chan struct{}) (interface{}, error) {
 	// Extract the relevant passed capabilities and restrict the result to either a coinbase value or a coinbase transaction object depending on the request.  Default to only providing a coinbase value.
 	useCoinbaseValue := true
 	if request != nil {
@@ -2332,7 +2476,7 @@ func handleGetBlockTemplateRequest(
 		}
 	}
 	// When a coinbase transaction has been requested, respond with an error if there are no addresses to pay the created block template to.
-	if !useCoinbaseValue && len(StateCfg.ActiveMiningAddrs) == 0 {
+	if !useCoinbaseValue && len(activeMiningAddrs()) == 0 {
 		return nil, &json.RPCError{
 			Code: json.ErrRPCInternal.Code,
 			Message: "A coinbase transaction has been requested, " +
@@ -2358,11 +2502,11 @@ func handleGetBlockTemplateRequest(
 	}
 	// When a long poll ID was provided, this is a long poll request by the client to be notified when block template referenced by the ID should be replaced with a new one.
 	if request != nil && request.LongPollID != "" {
-		return handleGetBlockTemplateLongPoll(s, request.LongPollID,
+		return handleGetBlockTemplateLongPoll(s, request.LongPollID, algo,
 			useCoinbaseValue, closeChan)
 	}
 	// Protect concurrent access when updating block templates.
-	state := s.gbtWorkState
+	state := s.gbtWorkStates[algo]
 	state.Lock()
 	defer state.Unlock()
 	// Get and return a block template.  A new block template will be generated when the current best block has changed or the transactions in the memory pool have been updated and it has been at least five seconds since the last template was generated.  Otherwise, the timestamp for the existing block template is updated (and possibly the difficulty on testnet per the consesus rules).
@@ -2431,6 +2575,21 @@ func handleGetCFilterHeader(
 	hash.SetBytes(headerBytes)
 	return hash.String(), nil
 }
+// handleGetChainTips implements the getchaintips command.
+func handleGetChainTips(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	tips := s.Cfg.Chain.ChainTips()
+	results := make([]json.GetChainTipsResult, 0, len(tips))
+	for _, tip := range tips {
+		results = append(results, json.GetChainTipsResult{
+			Height:    tip.Height,
+			Hash:      tip.Hash.String(),
+			BranchLen: tip.BranchLen,
+			Status:    tip.Status.String(),
+		})
+	}
+	return results, nil
+}
 // handleGetConnectionCount implements the getconnectioncount command.
 func handleGetConnectionCount(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -2441,51 +2600,17 @@ func handleGetCurrentNet(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	return s.Cfg.ChainParams.Net, nil
 }
-// handleGetDifficulty implements the getdifficulty command. TODO: This command should default to the configured algo for cpu mining and take an optional parameter to query by algo
+// handleGetDifficulty implements the getdifficulty command, for the algorithm of the optional algo parameter or of the RPC endpoint.
 func handleGetDifficulty(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	c := cmd.(*json.GetDifficultyCmd)
 	best := s.Cfg.Chain.BestSnapshot()
-	prev, err := s.Cfg.Chain.BlockByHash(&best.Hash)
-	if err != nil {
-		fmt.Println("ERROR", err)
-	}
-	var algo = prev.MsgBlock().Header.Version
-	if algo != 514 {
-		algo = 2
-	}
-	bestbits := best.Bits
-	if c.Algo == "scrypt" && algo != 514 {
-		algo = 514
-		for {
-			if prev.MsgBlock().Header.Version != 514 {
-				ph := prev.MsgBlock().Header.PrevBlock
-				prev, err = s.Cfg.Chain.BlockByHash(&ph)
-				if err != nil {
-					fmt.Println("ERROR", err)
-				}
-				continue
-			}
-			bestbits = uint32(prev.MsgBlock().Header.Bits)
-			break
-		}
-	}
-	if c.Algo == "sha256d" && algo != 2 {
-		algo = 2
-		for {
-			if prev.MsgBlock().Header.Version == 514 {
-				ph := prev.MsgBlock().Header.PrevBlock
-				prev, err = s.Cfg.Chain.BlockByHash(&ph)
-				if err != nil {
-					fmt.Println("ERROR", err)
-				}
-				continue
-			}
-			bestbits = uint32(prev.MsgBlock().Header.Bits)
-			break
-		}
+	algo, err := s.algoParam(c.Algo, best.Height)
+	if err != nil {
+		return nil, err
 	}
-	return getDifficultyRatio(bestbits, s.Cfg.ChainParams, algo), nil
+	bits, version := s.Cfg.Chain.LastBits(algo, best.Height)
+	return getDifficultyRatio(bits, s.Cfg.ChainParams, version), nil
 }
 // handleGetGenerate implements the getgenerate command.
 func handleGetGenerate(
@@ -2539,10 +2664,10 @@ func handleGetInfo(
 	best := s.Cfg.Chain.BestSnapshot()
 	v := s.Cfg.Chain.Index.LookupNode(&best.Hash)
 	foundcount, height := 0, best.Height
-	switch fork.GetCurrent(height) {
+	switch fork.GetCurrent(s.Cfg.ChainParams, height) {
 	case 0:
 		for foundcount < 9 && height > 0 {
-			switch fork.GetAlgoName(v.Header().Version, height) {
+			switch fork.GetAlgoName(s.Cfg.ChainParams, v.Header().Version, height) {
 			case "sha256d":
 				if lastbitsSHA256D == 0 {
 					foundcount++
@@ -2578,19 +2703,19 @@ func handleGetInfo(
 			TimeOffset:        int64(s.Cfg.TimeSource.Offset().Seconds()),
 			Connections:       s.Cfg.ConnMgr.ConnectedCount(),
 			Proxy:             proxy,
-			PowAlgoID:         fork.GetAlgoID(s.Cfg.Algo, height),
+			PowAlgoID:         fork.GetAlgoID(s.Cfg.ChainParams, s.Cfg.Algo, height),
 			PowAlgo:           s.Cfg.Algo,
 			Difficulty:        Difficulty,
 			DifficultySHA256D: dSHA256D,
 			DifficultyScrypt:  dScrypt,
 			TestNet:           *Cfg.TestNet3,
-			RelayFee:          StateCfg.ActiveMinRelayTxFee.ToDUO(),
+			RelayFee:          activeMinRelayTxFee().ToDUO(),
 		}
 	case 1:
 		foundcount, height := 0, best.Height
 		for foundcount < 9 &&
-			height > fork.List[fork.GetCurrent(height)].ActivationHeight-512 {
-			switch fork.GetAlgoName(v.Header().Version, height) {
+			height > fork.Get(s.Cfg.ChainParams, height).ActivationHeight-512 {
+			switch fork.GetAlgoName(s.Cfg.ChainParams, v.Header().Version, height) {
 			case "blake2b":
 				if lastbitsBlake2b == 0 {
 					foundcount++
@@ -2678,7 +2803,7 @@ func handleGetInfo(
 			TimeOffset:          int64(s.Cfg.TimeSource.Offset().Seconds()),
 			Connections:         s.Cfg.ConnMgr.ConnectedCount(),
 			Proxy:               *Cfg.Proxy,
-			PowAlgoID:           fork.GetAlgoID(s.Cfg.Algo, height),
+			PowAlgoID:           fork.GetAlgoID(s.Cfg.ChainParams, s.Cfg.Algo, height),
 			PowAlgo:             s.Cfg.Algo,
 			Difficulty:          Difficulty,
 			DifficultyBlake2b:   dBlake2b,
@@ -2691,11 +2816,37 @@ func handleGetInfo(
 			DifficultyStribog:   dStribog,
 			DifficultyX11:       dX11,
 			TestNet:             *Cfg.TestNet3,
-			RelayFee:            StateCfg.ActiveMinRelayTxFee.ToDUO(),
+			RelayFee:            activeMinRelayTxFee().ToDUO(),
 		}
 	}
 	return ret, nil
 }
+// handleGetMempoolAncestors implements the getmempoolancestors command.
+func handleGetMempoolAncestors(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.GetMempoolAncestorsCmd)
+	return mempoolRelatives(s, c.TxID, c.Verbose, s.Cfg.TxMemPool.Ancestors)
+}
+// handleGetMempoolDescendants implements the getmempooldescendants command.
+func handleGetMempoolDescendants(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.GetMempoolDescendantsCmd)
+	return mempoolRelatives(s, c.TxID, c.Verbose, s.Cfg.TxMemPool.Descendants)
+}
+// handleGetMempoolEntry implements the getmempoolentry command.
+func handleGetMempoolEntry(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.GetMempoolEntryCmd)
+	txHash, err := chainhash.NewHashFromStr(c.TxID)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.TxID)
+	}
+	entry, err := s.Cfg.TxMemPool.MempoolEntry(txHash)
+	if err != nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	return entry, nil
+}
 // handleGetMempoolInfo implements the getmempoolinfo command.
 func handleGetMempoolInfo(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -2710,11 +2861,17 @@ func handleGetMempoolInfo(
 	}
 	return ret, nil
 }
-// handleGetMiningInfo implements the getmininginfo command. We only return the fields that are not related to wallet functionality. This function returns more information than parallelcoind.
+// handleGetMiningInfo implements the getmininginfo command. We only return the fields that are not related to wallet functionality. This function returns more information than parallelcoind. The difficulty, algorithm and network hashes per second are those of the optional algo parameter or of the RPC endpoint.
 func handleGetMiningInfo(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (ret interface{}, err error) {
+	c := cmd.(*json.GetMiningInfoCmd)
+	best := s.Cfg.Chain.BestSnapshot()
+	algo, err := s.algoParam(c.Algo, best.Height)
+	if err != nil {
+		return nil, err
+	}
 	// Create a default getnetworkhashps command to use defaults and make use of the existing getnetworkhashps handler.
-	gnhpsCmd := json.NewGetNetworkHashPSCmd(nil, nil)
+	gnhpsCmd := json.NewGetNetworkHashPSCmd(nil, nil, c.Algo)
 	networkHashesPerSecIface, err := handleGetNetworkHashPS(s, gnhpsCmd, closeChan)
 	if err != nil {
 		return nil, err
@@ -2728,13 +2885,12 @@ func handleGetMiningInfo(
 	}
 	var Difficulty, dBlake2b, dBlake14lr, dBlake2s, dKeccak, dScrypt, dSHA256D, dSkein, dStribog, dX11 float64
 	var lastbitsBlake2b, lastbitsBlake14lr, lastbitsBlake2s, lastbitsKeccak, lastbitsScrypt, lastbitsSHA256D, lastbitsSkein, lastbitsStribog, lastbitsX11 uint32
-	best := s.Cfg.Chain.BestSnapshot()
 	v := s.Cfg.Chain.Index.LookupNode(&best.Hash)
 	foundcount, height := 0, best.Height
-	switch fork.GetCurrent(height) {
+	switch fork.GetCurrent(s.Cfg.ChainParams, height) {
 	case 0:
 		for foundcount < 2 && height > 0 {
-			switch fork.GetAlgoName(v.Header().Version, height) {
+			switch fork.GetAlgoName(s.Cfg.ChainParams, v.Header().Version, height) {
 			case "sha256d":
 				if lastbitsSHA256D == 0 {
 					foundcount++
@@ -2752,7 +2908,7 @@ func handleGetMiningInfo(
 			v = v.RelativeAncestor(1)
 			height--
 		}
-		switch s.Cfg.Algo {
+		switch algo {
 		case "sha256d":
 			Difficulty = dSHA256D
 		case "scrypt":
@@ -2764,8 +2920,8 @@ func handleGetMiningInfo(
 			CurrentBlockSize:   best.BlockSize,
 			CurrentBlockWeight: best.BlockWeight,
 			CurrentBlockTx:     best.NumTxns,
-			PowAlgoID:          fork.GetAlgoID(s.Cfg.Algo, height),
-			PowAlgo:            s.Cfg.Algo,
+			PowAlgoID:          fork.GetAlgoID(s.Cfg.ChainParams, algo, best.Height),
+			PowAlgo:            algo,
 			Difficulty:         Difficulty,
 			DifficultySHA256D:  dSHA256D,
 			DifficultyScrypt:   dScrypt,
@@ -2778,8 +2934,8 @@ func handleGetMiningInfo(
 		}
 	case 1:
 		foundcount, height := 0, best.Height
-		for foundcount < 9 && height > fork.List[fork.GetCurrent(height)].ActivationHeight-512 {
-			switch fork.GetAlgoName(v.Header().Version, height) {
+		for foundcount < 9 && height > fork.Get(s.Cfg.ChainParams, height).ActivationHeight-512 {
+			switch fork.GetAlgoName(s.Cfg.ChainParams, v.Header().Version, height) {
 			case "blake2b":
 				if lastbitsBlake2b == 0 {
 					foundcount++
@@ -2839,7 +2995,7 @@ func handleGetMiningInfo(
 			v = v.RelativeAncestor(1)
 			height--
 		}
-		switch s.Cfg.Algo {
+		switch algo {
 		case "blake2b":
 			Difficulty = dBlake2b
 		case "blake14lr":
@@ -2865,8 +3021,8 @@ func handleGetMiningInfo(
 			CurrentBlockSize:    best.BlockSize,
 			CurrentBlockWeight:  best.BlockWeight,
 			CurrentBlockTx:      best.NumTxns,
-			PowAlgoID:           fork.GetAlgoID(s.Cfg.Algo, height),
-			PowAlgo:             s.Cfg.Algo,
+			PowAlgoID:           fork.GetAlgoID(s.Cfg.ChainParams, algo, best.Height),
+			PowAlgo:             algo,
 			Difficulty:          Difficulty,
 			DifficultyBlake2b:   dBlake2b,
 			DifficultyBlake14lr: dBlake14lr,
@@ -2899,7 +3055,7 @@ func handleGetNetTotals(
 	}
 	return reply, nil
 }
-// handleGetNetworkHashPS implements the getnetworkhashps command. This command does not default to the same end block as the parallelcoind. TODO: Really this needs to be expanded to show per-algorithm hashrates
+// handleGetNetworkHashPS implements the getnetworkhashps command. This command does not default to the same end block as the parallelcoind. Without the optional algo parameter the work of all the blocks is summed, weighted by the speed of their algorithms, and with it only the blocks of that algorithm are counted, by the number of hashes of the algorithm their targets take on average.
 func handleGetNetworkHashPS(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
 	// Note: All valid error return paths should return an int64. Literal zeros are inferred as int, and won't coerce to int64 because the return value is an interface{}.
@@ -2916,6 +3072,13 @@ func handleGetNetworkHashPS(
 	if endHeight < 0 {
 		endHeight = best.Height
 	}
+	var algo string
+	if c.Algo != nil && *c.Algo != "" {
+		var err error
+		if algo, err = s.algoParam(c.Algo, endHeight); err != nil {
+			return nil, err
+		}
+	}
 	// Calculate the number of blocks per retarget interval based on the chain parameters.
 	blocksPerRetarget := int32(s.Cfg.ChainParams.TargetTimespan / s.Cfg.ChainParams.TargetTimePerBlock)
 	// Calculate the starting block height based on the passed number of blocks.  When the passed value is negative, use the last block the difficulty changed as the starting height.  Also make sure the starting height is not before the beginning of the chain.
@@ -2956,7 +3119,13 @@ func handleGetNetworkHashPS(
 			minTimestamp = header.Timestamp
 			maxTimestamp = minTimestamp
 		} else {
-			totalWork.Add(totalWork, blockchain.CalcWork(header.Bits, best.Height+1, header.Version))
+			switch {
+			case algo == "":
+				totalWork.Add(totalWork, blockchain.CalcWork(header.Bits, best.Height+1, header.Version,
+					s.Cfg.ChainParams))
+			case fork.GetAlgoName(s.Cfg.ChainParams, header.Version, curHeight) == algo:
+				totalWork.Add(totalWork, blockchain.CalcHashes(header.Bits))
+			}
 			if minTimestamp.After(header.Timestamp) {
 				minTimestamp = header.Timestamp
 			}
@@ -2973,6 +3142,81 @@ func handleGetNetworkHashPS(
 	hashesPerSec := new(big.Int).Div(totalWork, big.NewInt(timeDiff))
 	return hashesPerSec.Int64(), nil
 }
+// handleGetNetworkInfo implements the getnetworkinfo command.
+func handleGetNetworkInfo(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	// The sub version is the user agent sent to peers in version messages.
+	var comments []string
+	if Cfg.UserAgentComments != nil {
+		comments = *Cfg.UserAgentComments
+	}
+	msg := wire.MsgVersion{UserAgent: wire.DefaultUserAgent}
+	if err := msg.AddUserAgent(userAgentName, userAgentVersion, comments...); err != nil {
+		return nil, internalRPCError(err.Error(), "Could not create user agent")
+	}
+	var proxy, onionProxy string
+	if Cfg.Proxy != nil {
+		proxy = *Cfg.Proxy
+	}
+	onionProxy = proxy
+	if Cfg.OnionProxy != nil && *Cfg.OnionProxy != "" {
+		onionProxy = *Cfg.OnionProxy
+	}
+	torIsolation := Cfg.TorIsolation != nil && *Cfg.TorIsolation
+	onion := Cfg.Onion != nil && *Cfg.Onion
+	networks := []json.NetworksResult{
+		{
+			Name:                      "ipv4",
+			Reachable:                 true,
+			Proxy:                     proxy,
+			ProxyRandomizeCredentials: torIsolation && proxy != "",
+		},
+		{
+			Name:                      "ipv6",
+			Reachable:                 true,
+			Proxy:                     proxy,
+			ProxyRandomizeCredentials: torIsolation && proxy != "",
+		},
+		{
+			// Onion addresses can only be reached through a proxy.
+			Name:                      "onion",
+			Limited:                   !onion,
+			Reachable:                 onion && onionProxy != "",
+			Proxy:                     onionProxy,
+			ProxyRandomizeCredentials: torIsolation && onionProxy != "",
+		},
+	}
+	localAddrs := s.Cfg.ConnMgr.LocalAddresses()
+	addresses := make([]json.LocalAddressesResult, 0, len(localAddrs))
+	for _, local := range localAddrs {
+		host, _, err := net.SplitHostPort(addrmgr.NetAddressKey(local.NA))
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, json.LocalAddressesResult{
+			Address: host,
+			Port:    local.NA.Port,
+			Score:   int32(local.Score),
+		})
+	}
+	// There is no separate incremental relay fee, fee increases of replacement transactions are held to the minimum relay fee.
+	relayFee := activeMinRelayTxFee().ToDUO()
+	reply := &json.GetNetworkInfoResult{
+		Version:         int32(1000000*appMajor + 10000*appMinor + 100*appPatch),
+		SubVersion:      msg.UserAgent,
+		ProtocolVersion: int32(maxProtocolVersion),
+		LocalServices:   fmt.Sprintf("%016x", uint64(s.Cfg.ConnMgr.Services())),
+		LocalRelay:      Cfg.BlocksOnly == nil || !*Cfg.BlocksOnly,
+		TimeOffset:      int64(s.Cfg.TimeSource.Offset().Seconds()),
+		Connections:     s.Cfg.ConnMgr.ConnectedCount(),
+		NetworkActive:   true,
+		Networks:        networks,
+		RelayFee:        relayFee,
+		IncrementalFee:  relayFee,
+		LocalAddresses:  addresses,
+	}
+	return reply, nil
+}
 // handleGetPeerInfo implements the getpeerinfo command.
 func handleGetPeerInfo(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -3127,6 +3371,52 @@ func handleGetRawTransaction(
 	}
 	return *rawTxn, nil
 }
+// handleGetServiceStatus implements the getservicestatus command.
+func handleGetServiceStatus(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	if ServiceStatus == nil {
+		return nil, &json.RPCError{
+			Code:    json.ErrRPCMisc,
+			Message: "The node is not running under a supervisor of its services",
+		}
+	}
+	return ServiceStatus(), nil
+}
+// handleGetStratumInfo implements the getstratuminfo command.
+func handleGetStratumInfo(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	st := s.Cfg.Stratum
+	result := &json.GetStratumInfoResult{
+		Running:   st.IsRunning(),
+		Listeners: []json.StratumListenerResult{},
+		Miners:    st.Miners(),
+		Workers:   []json.StratumWorkerResult{},
+	}
+	for _, x := range st.Listeners() {
+		result.Listeners = append(result.Listeners, json.StratumListenerResult{
+			Address: x.Addr,
+			Algo:    x.Algo,
+		})
+	}
+	for _, x := range st.Stats() {
+		var lastShare int64
+		if !x.LastShare.IsZero() {
+			lastShare = x.LastShare.Unix()
+		}
+		result.Workers = append(result.Workers, json.StratumWorkerResult{
+			Name:       x.Name,
+			Algo:       x.Algo,
+			Difficulty: x.Difficulty,
+			Accepted:   x.Accepted,
+			Rejected:   x.Rejected,
+			Stale:      x.Stale,
+			Blocks:     x.Blocks,
+			Work:       x.Work,
+			LastShare:  lastShare,
+		})
+	}
+	return result, nil
+}
 // handleGetTxOut handles gettxout commands.
 func handleGetTxOut(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -3244,6 +3534,12 @@ func handleHelp(
 	}
 	return help, nil
 }
+// handleInvalidateBlock implements the invalidateblock command.
+func handleInvalidateBlock(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.InvalidateBlockCmd)
+	return nil, changeBlockStatus(s, c.BlockHash, s.Cfg.Chain.InvalidateBlock)
+}
 // handleNode handles node commands.
 func handleNode(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -3337,6 +3633,33 @@ func handlePing(
 	s.Cfg.ConnMgr.BroadcastMessage(wire.NewMsgPing(nonce))
 	return nil, nil
 }
+// handlePreciousBlock implements the preciousblock command.
+func handlePreciousBlock(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.PreciousBlockCmd)
+	return nil, changeBlockStatus(s, c.BlockHash, s.Cfg.Chain.PreciousBlock)
+}
+// handleReconsiderBlock implements the reconsiderblock command.
+func handleReconsiderBlock(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	c := cmd.(*json.ReconsiderBlockCmd)
+	return nil, changeBlockStatus(s, c.BlockHash, s.Cfg.Chain.ReconsiderBlock)
+}
+// handleReloadConfig implements the reloadconfig command.
+func handleReloadConfig(
+	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
+	if s.Cfg.ReloadConfig == nil {
+		return nil, ErrRPCUnimplemented
+	}
+	changed, restart, err := s.Cfg.ReloadConfig()
+	if err != nil {
+		return nil, &json.RPCError{
+			Code:    json.ErrRPCMisc,
+			Message: "Failed to reload configuration: " + err.Error(),
+		}
+	}
+	return &json.ReloadConfigResult{Changed: changed, Restart: restart}, nil
+}
 // handleSearchRawTransactions implements the searchrawtransactions command.
 func handleSearchRawTransactions(
 	s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, error) {
@@ -3616,7 +3939,7 @@ func handleSetGenerate(
 		s.Cfg.CPUMiner.Stop()
 	} else {
 		// Respond with an error if there are no addresses to pay the created blocks to.
-		if len(StateCfg.ActiveMiningAddrs) == 0 {
+		if len(activeMiningAddrs()) == 0 {
 			return nil, &json.RPCError{
 				Code:    json.ErrRPCInternal.Code,
 				Message: "no payment addresses specified via --miningaddr",
@@ -3660,6 +3983,20 @@ func handleSubmitBlock(
 			Message: "Block decode failed: " + err.Error(),
 		}
 	}
+	// When an algorithm is given the block must be of that algorithm at its height, which is after the main chain tip when the previous block is not known.
+	if c.Algo != nil && *c.Algo != "" {
+		height := s.Cfg.Chain.BestSnapshot().Height + 1
+		if h, err := s.Cfg.Chain.BlockHeightByHash(&block.MsgBlock().Header.PrevBlock); err == nil {
+			height = h + 1
+		}
+		algo, err := s.algoParam(c.Algo, height)
+		if err != nil {
+			return nil, err
+		}
+		if name := fork.GetAlgoName(s.Cfg.ChainParams, block.MsgBlock().Header.Version, height); name != algo {
+			return fmt.Sprintf("rejected: block version %d is not %s", block.MsgBlock().Header.Version, algo), nil
+		}
+	}
 	// Process this block using the same rules as blocks coming from other nodes.  This will in turn relay it to the network like normal.
 	_, err = s.Cfg.SyncMgr.SubmitBlock(block, blockchain.BFNone)
 	if err != nil {
@@ -3837,7 +4174,7 @@ func newRPCServer(
 	rpc := rpcServer{
 		Cfg:                    *config,
 		statusLines:            make(map[int]string),
-		gbtWorkState:           newGbtWorkState(config.TimeSource, config.Algo),
+		gbtWorkStates:          make(map[string]*gbtWorkState),
 		helpCacher:             newHelpCacher(),
 		requestProcessShutdown: make(chan struct{}),
 		quit:                   make(chan int),
@@ -3852,6 +4189,14 @@ func newRPCServer(
 		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 		rpc.limitauthsha = sha256.Sum256([]byte(auth))
 	}
+	// Each algorithm of every hard fork has its own block templates, so miners of all of them can share one endpoint.
+	for _, x := range config.ChainParams.Forks() {
+		for name := range x.Algos {
+			if rpc.gbtWorkStates[name] == nil {
+				rpc.gbtWorkStates[name] = newGbtWorkState(config.TimeSource, name)
+			}
+		}
+	}
 	rpc.ntfnMgr = newWsNotificationManager(&rpc)
 	rpc.Cfg.Chain.Subscribe(rpc.handleBlockchainNotification)
 	return &rpc, nil
@@ -3900,6 +4245,37 @@ func rpcDecodeHexError(
 		fmt.Sprintf("Argument must be hexadecimal string (not %q)",
 			gotHex))
 }
+// mempoolRelatives returns the transactions in the mempool that the relatives function finds for the transaction with the passed hash, as a list of hashes or, when verbose, as a map of the hashes to their mempool entries.
+func mempoolRelatives(
+	s *rpcServer, txID string, verbose *bool,
+	relatives func(*chainhash.Hash) ([]*mempool.TxDesc, error),
+) (interface{}, error) {
+	txHash, err := chainhash.NewHashFromStr(txID)
+	if err != nil {
+		return nil, rpcDecodeHexError(txID)
+	}
+	descs, err := relatives(txHash)
+	if err != nil {
+		return nil, rpcNoTxInfoError(txHash)
+	}
+	if verbose == nil || !*verbose {
+		hashStrings := make([]string, len(descs))
+		for i := range descs {
+			hashStrings[i] = descs[i].Tx.Hash().String()
+		}
+		return hashStrings, nil
+	}
+	entries := make(map[string]*json.GetMempoolEntryResult, len(descs))
+	for _, desc := range descs {
+		// Transactions mined or removed since the relatives were found are left out.
+		entry, err := s.Cfg.TxMemPool.MempoolEntry(desc.Tx.Hash())
+		if err != nil {
+			continue
+		}
+		entries[desc.Tx.Hash().String()] = entry
+	}
+	return entries, nil
+}
 // rpcNoTxInfoError is a convenience function for returning a nicely formatted RPC error which indicates there is no information available for the provided transaction hash.
 func rpcNoTxInfoError(
 	txHash *chainhash.Hash,
@@ -3956,10 +4332,10 @@ func verifyChain(
 			}
 			return err
 		}
-		powLimit := fork.GetMinDiff(fork.GetAlgoName(block.MsgBlock().Header.Version, height), height)
+		powLimit := fork.GetMinDiff(s.Cfg.ChainParams, fork.GetAlgoName(s.Cfg.ChainParams, block.MsgBlock().Header.Version, height), height)
 		// Level 1 does basic chain sanity checks.
 		if level > 0 {
-			err := blockchain.CheckBlockSanity(block, powLimit, s.Cfg.TimeSource, true, block.Height(), s.Cfg.ChainParams.Name == "testnet")
+			err := blockchain.CheckBlockSanity(block, powLimit, s.Cfg.TimeSource, true, block.Height(), s.Cfg.ChainParams)
 			if err != nil {
 				log <- cl.Errorf{
 					"verify is unable to validate block at hash %v height %d: %v",
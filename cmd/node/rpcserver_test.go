@@ -0,0 +1,145 @@
+package node
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	txscript "git.parallelcoin.io/dev/9/pkg/chain/tx/script"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	database "git.parallelcoin.io/dev/9/pkg/db"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+	"git.parallelcoin.io/dev/9/pkg/util"
+)
+// testChainAlgos are the algorithms of the blocks of the chain made by newTestChain, two before the Plan 9 hard fork and four after it
+var testChainAlgos = []string{"sha256d", "scrypt", "blake2b", "scrypt", "blake2b", "sha256d"}
+// newTestChain returns a chain on a regression test network where Plan 9 activates after block 2, with a block of each of testChainAlgos that is not checked for proof of work, and a function that removes it
+func newTestChain(
+	t *testing.T) (chain *blockchain.BlockChain, params *chaincfg.Params, teardown func()) {
+	dir, err := ioutil.TempDir("", "rpcserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := chaincfg.RegressionNetParams
+	p.HardForks = []fork.HardForks{fork.Halcyon(0), fork.Plan9(2)}
+	params = &p
+	db, err := database.Create("ffldb", dir, params.Net)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	teardown = func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+	chain, err = blockchain.New(&blockchain.Config{
+		DB:          db,
+		ChainParams: params,
+		TimeSource:  blockchain.NewMedianTime(),
+	})
+	if err != nil {
+		teardown()
+		t.Fatal(err)
+	}
+	timestamp := params.GenesisBlock.Header.Timestamp
+	for _, algo := range testChainAlgos {
+		best := chain.BestSnapshot()
+		height := best.Height + 1
+		timestamp = timestamp.Add(time.Minute)
+		bits, err := chain.CalcNextRequiredDifficulty(timestamp, algo)
+		if err != nil {
+			teardown()
+			t.Fatal(err)
+		}
+		script, _ := txscript.NewScriptBuilder().AddInt64(int64(height)).AddInt64(0).Script()
+		coinbase := wire.NewMsgTx(wire.TxVersion)
+		coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), script, nil))
+		coinbase.AddTxOut(wire.NewTxOut(blockchain.CalcBlockSubsidy(height, params),
+			[]byte{txscript.OpTrue}))
+		merkles := blockchain.BuildMerkleTreeStore([]*util.Tx{util.NewTx(coinbase)}, false)
+		block := wire.NewMsgBlock(&wire.BlockHeader{
+			Version:    fork.GetAlgoVer(params, algo, height),
+			PrevBlock:  best.Hash,
+			MerkleRoot: *merkles[len(merkles)-1],
+			Timestamp:  timestamp,
+			Bits:       bits,
+		})
+		block.AddTransaction(coinbase)
+		if _, _, err = chain.ProcessBlock(util.NewBlock(block), blockchain.BFNoPoWCheck, height); err != nil {
+			teardown()
+			t.Fatalf("block %d %s: %v", height, algo, err)
+		}
+	}
+	return
+}
+// TestDifficultyAlgoParam ensures the algorithm parameter of the mining commands is checked against the hard fork in force and that getdifficulty gives the difficulty of the newest block of the algorithm since the hard fork, or its minimum difficulty when there is none.
+func TestDifficultyAlgoParam(
+	t *testing.T) {
+	chain, params, teardown := newTestChain(t)
+	defer teardown()
+	s := &rpcServer{}
+	s.Cfg.Chain, s.Cfg.ChainParams, s.Cfg.Algo = chain, params, "sha256d"
+	height := chain.BestSnapshot().Height
+	for _, x := range []struct {
+		algo string
+		ok   bool
+	}{
+		{"", true},
+		{"blake2b", true},
+		{"keccak", true},
+		{"nothing", false},
+	} {
+		algo := x.algo
+		name, err := s.algoParam(&algo, height)
+		if (err == nil) != x.ok {
+			t.Fatalf("algo %q: got error %v", x.algo, err)
+		}
+		if err != nil {
+			if e, ok := err.(*json.RPCError); !ok || e.Code != json.ErrRPCInvalidParameter {
+				t.Errorf("algo %q: got error %v, expected an invalid parameter error", x.algo, err)
+			}
+			continue
+		}
+		if want := x.algo; want == "" && name != s.Cfg.Algo || want != "" && name != want {
+			t.Errorf("algo %q: got %s", x.algo, name)
+		}
+	}
+	// the newest blake2b and sha256d blocks are after the hard fork, the scrypt block after it is not the newest of its algorithm
+	header := func(h int32) *wire.BlockHeader {
+		hash, err := chain.BlockHashByHeight(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header, err := chain.HeaderByHash(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &header
+	}
+	hf := fork.Get(params, height)
+	for algo, want := range map[string]*wire.BlockHeader{
+		"blake2b": header(5),
+		"sha256d": header(6),
+		"scrypt":  header(4),
+		"keccak": {Bits: hf.Algos["keccak"].MinBits, Version: hf.Algos["keccak"].Version},
+	} {
+		algo := algo
+		got, err := handleGetDifficulty(s, &json.GetDifficultyCmd{Algo: &algo}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := getDifficultyRatio(want.Bits, params, want.Version); got != expected {
+			t.Errorf("%s: got difficulty %v, expected %v", algo, got, expected)
+		}
+	}
+	if _, err := handleGetDifficulty(s, &json.GetDifficultyCmd{Algo: new(string)}, nil); err != nil {
+		t.Fatal(err)
+	}
+	// before the hard fork the blocks of the algorithm are found back to the genesis block
+	if bits, version := chain.LastBits("sha256d", 2); bits != header(1).Bits || version != header(1).Version {
+		t.Errorf("got bits %08x version %d before the hard fork, expected those of block 1", bits, version)
+	}
+}
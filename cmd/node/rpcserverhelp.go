@@ -104,6 +104,7 @@ var helpDescsEnUS = map[string]string{
 	"generate--synopsis": "Generates a set number of blocks (simnet or regtest only) and returns a JSON\n" +
 		" array of their hashes.",
 	"generate-numblocks": "Number of blocks to generate",
+	"generate-algo":      "The algorithm to mine the blocks with, defaults to the algorithm of the RPC endpoint",
 	"generate--result0":  "The hashes, in order, of blocks generated by the call",
 	// GetAddedNodeInfoResultAddr help.
 	"getaddednodeinforesultaddr-address":   "The ip address for this DNS entry",
@@ -119,6 +120,31 @@ var helpDescsEnUS = map[string]string{
 	"getaddednodeinfo--condition0": "dns=false",
 	"getaddednodeinfo--condition1": "dns=true",
 	"getaddednodeinfo--result0":    "List of added peers",
+	// GetAlgoStatsCmd help.
+	"getalgostats--synopsis": "Returns the statistics of each algorithm for the next block: its target, the times between its latest blocks, its share of the recent blocks, its network hashes per second and the terms of the Plan 9 difficulty averagers.",
+	"getalgostats-blocks":    "The number of recent blocks to count the share and hashes per second of each algorithm over",
+	"getalgostats-times":     "The number of times between the latest blocks of each algorithm to return",
+	// GetAlgoStatsResult help.
+	"getalgostatsresult-hash":   "The hash of the best block",
+	"getalgostatsresult-height": "The height of the best block",
+	"getalgostatsresult-blocks": "The number of recent blocks the statistics are counted over",
+	"getalgostatsresult-algos":  "The statistics of each algorithm in the order of their versions",
+	// AlgoStatsResult help.
+	"algostatsresult-algo":          "The name of the algorithm",
+	"algostatsresult-version":       "The block version of the algorithm",
+	"algostatsresult-bits":          "The hex-encoded target bits of the next block of the algorithm",
+	"algostatsresult-difficulty":    "The difficulty of the next block of the algorithm as a multiple of the minimum difficulty",
+	"algostatsresult-blocktimes":    "The seconds between the latest blocks of the algorithm, newest first",
+	"algostatsresult-blocks":        "The number of recent blocks of the algorithm",
+	"algostatsresult-share":         "The part of the recent blocks that are of the algorithm",
+	"algostatsresult-networkhashps": "The estimated hashes per second of the algorithm over the recent blocks",
+	"algostatsresult-averagers":     "The terms of the Plan 9 difficulty averagers, when Plan 9 is active",
+	// AlgoAveragersResult help.
+	"algoaveragersresult-alltime":          "The divergence of the average block time since the hard fork from the target",
+	"algoaveragersresult-trailing":         "The divergence of the average time of the trailing blocks from the target",
+	"algoaveragersresult-trailingweighted": "The weighted divergence of the trailing blocks",
+	"algoaveragersresult-algoweighted":     "The weighted divergence of the trailing blocks of the algorithm",
+	"algoaveragersresult-adjustment":       "The combined adjustment applied to the previous target of the algorithm",
 	// GetBestBlockResult help.
 	"getbestblockresult-hash":   "Hex-encoded bytes of the best block hash",
 	"getbestblockresult-height": "Height of the best block",
@@ -154,6 +180,10 @@ var helpDescsEnUS = map[string]string{
 	"getblockchaininforesult-bip9_softforks--key":   "bip9_softforks",
 	"getblockchaininforesult-bip9_softforks--value": "An object describing a particular BIP009 deployment",
 	"getblockchaininforesult-bip9_softforks--desc":  "The status of any defined BIP0009 soft-fork deployments",
+	"getblockchaininforesult-autocheckpoint":        "The latest block that became a checkpoint by being deep and old enough, omitted if there is none",
+	// AutoCheckpointResult help.
+	"autocheckpointresult-height": "The height of the automatic checkpoint",
+	"autocheckpointresult-hash":   "The hash of the automatic checkpoint",
 	// SoftForkDescription help.
 	"softforkdescription-reject":  "The current activation status of the softfork",
 	"softforkdescription-version": "The block version that signals enforcement of this softfork",
@@ -281,6 +311,7 @@ var helpDescsEnUS = map[string]string{
 	"getblocktemplate--synopsis": "Returns a JSON object with information necessary to construct a block to mine or accepts a proposal to validate.\n" +
 		"See BIP0022 and BIP0023 for the full specification.",
 	"getblocktemplate-request":     "Request object which controls the mode and several parameters",
+	"getblocktemplate-algo":        "The algorithm of the block template, defaults to the algorithm of the RPC endpoint",
 	"getblocktemplate--condition0": "mode=template",
 	"getblocktemplate--condition1": "mode=proposal, rejected",
 	"getblocktemplate--condition2": "mode=proposal, accepted",
@@ -295,6 +326,13 @@ var helpDescsEnUS = map[string]string{
 	"getcfilterheader-filtertype": "The type of filter header to return (0=regular)",
 	"getcfilterheader-hash":       "The hash of the block",
 	"getcfilterheader--result0":   "The block's gcs filter header",
+	// GetChainTipsCmd help.
+	"getchaintips--synopsis": "Returns the ends of the main chain and of every side chain known to the node, highest first.",
+	// GetChainTipsResult help.
+	"getchaintipsresult-height":    "The height of the tip",
+	"getchaintipsresult-hash":      "The hash of the tip",
+	"getchaintipsresult-branchlen": "The number of blocks of the branch after the block where it forks from the main chain, zero for the main chain",
+	"getchaintipsresult-status":    "The status of the branch (active, invalid, valid-fork, valid-headers or headers-only)",
 	// GetConnectionCountCmd help.
 	"getconnectioncount--synopsis": "Returns the number of active connections to other peers.",
 	"getconnectioncount--result0":  "The number of connections",
@@ -302,9 +340,9 @@ var helpDescsEnUS = map[string]string{
 	"getcurrentnet--synopsis": "Get bitcoin network the server is running on.",
 	"getcurrentnet--result0":  "The network identifer",
 	// GetDifficultyCmd help.
-	"getdifficulty--synopsis":   "Returns the proof-of-work difficulty as a multiple of the minimum difficulty, according to the currently configured cpu mining algorithm.",
-	"getdifficulty-algo":        "Defaults to the configured --algo for the CPU miner, can be set to sha256 or scrypt",
-	"getdifficulty--condition0": "algo=sha256d or scrypt",
+	"getdifficulty--synopsis":   "Returns the proof-of-work difficulty of the newest block of an algorithm as a multiple of the minimum difficulty.",
+	"getdifficulty-algo":        "The algorithm, any of those of the current hard fork, defaults to the algorithm of the RPC endpoint",
+	"getdifficulty--condition0": "algo of the current hard fork",
 	"getdifficulty--result0":    "The difficulty of the requested algorithm",
 	// GetGenerateCmd help.
 	"getgenerate--synopsis": "Returns if the server is set to generate coins (mine) or not.",
@@ -347,6 +385,39 @@ var helpDescsEnUS = map[string]string{
 	"getheaders--result0":      "Serialized block headers of all located blocks, limited to some arbitrary maximum number of hashes (currently 2000, which matches the wire protocol headers message, but this is not guaranteed)",
 	// GetInfoCmd help.
 	"getinfo--synopsis": "Returns a JSON object containing various state info.",
+	// GetMempoolEntryResult help.
+	"getmempoolentryresult-size":             "Transaction size in bytes",
+	"getmempoolentryresult-vsize":            "The virtual size of the transaction",
+	"getmempoolentryresult-fee":              "Transaction fee in DUO",
+	"getmempoolentryresult-modifiedfee":      "Transaction fee in DUO used for mining priority, which is the same as the fee",
+	"getmempoolentryresult-time":             "Local time transaction entered pool in seconds since 1 Jan 1970 GMT",
+	"getmempoolentryresult-height":           "Block height when transaction entered the pool",
+	"getmempoolentryresult-startingpriority": "Priority when transaction entered the pool",
+	"getmempoolentryresult-currentpriority":  "Current priority",
+	"getmempoolentryresult-descendantcount":  "Number of transactions in the pool that depend on this one, including this one",
+	"getmempoolentryresult-descendantsize":   "Virtual size in bytes of the transactions in the pool that depend on this one, including this one",
+	"getmempoolentryresult-descendantfees":   "Fees in DUO of the transactions in the pool that depend on this one, including this one",
+	"getmempoolentryresult-ancestorcount":    "Number of transactions in the pool this one depends on, including this one",
+	"getmempoolentryresult-ancestorsize":     "Virtual size in bytes of the transactions in the pool this one depends on, including this one",
+	"getmempoolentryresult-ancestorfees":     "Fees in DUO of the transactions in the pool this one depends on, including this one",
+	"getmempoolentryresult-depends":          "Unconfirmed transactions used as inputs for this transaction",
+	// GetMempoolAncestorsCmd help.
+	"getmempoolancestors--synopsis":   "Returns the transactions in the memory pool that the given transaction depends on, directly or through other transactions in the pool.",
+	"getmempoolancestors-txid":        "The hash of the transaction",
+	"getmempoolancestors-verbose":     "Returns JSON object when true or an array of transaction hashes when false",
+	"getmempoolancestors--condition0": "verbose=false",
+	"getmempoolancestors--condition1": "verbose=true",
+	"getmempoolancestors--result0":    "Array of transaction hashes",
+	// GetMempoolDescendantsCmd help.
+	"getmempooldescendants--synopsis":   "Returns the transactions in the memory pool that depend on the given transaction, directly or through other transactions in the pool.",
+	"getmempooldescendants-txid":        "The hash of the transaction",
+	"getmempooldescendants-verbose":     "Returns JSON object when true or an array of transaction hashes when false",
+	"getmempooldescendants--condition0": "verbose=false",
+	"getmempooldescendants--condition1": "verbose=true",
+	"getmempooldescendants--result0":    "Array of transaction hashes",
+	// GetMempoolEntryCmd help.
+	"getmempoolentry--synopsis": "Returns information about a transaction in the memory pool.",
+	"getmempoolentry-txid":      "The hash of the transaction",
 	// GetMempoolInfoCmd help.
 	"getmempoolinfo--synopsis": "Returns memory pool information",
 	// GetMempoolInfoResult help.
@@ -367,10 +438,12 @@ var helpDescsEnUS = map[string]string{
 	"getmininginforesult-testnet":            "Whether or not server is using testnet",
 	// GetMiningInfoCmd help.
 	"getmininginfo--synopsis": "Returns a JSON object containing mining-related information.",
+	"getmininginfo-algo":      "The algorithm of the difficulty and network hashes per second, defaults to the algorithm of the RPC endpoint",
 	// GetNetworkHashPSCmd help.
 	"getnetworkhashps--synopsis": "Returns the estimated network hashes per second for the block heights provided by the parameters.",
 	"getnetworkhashps-blocks":    "The number of blocks, or -1 for blocks since last difficulty change",
 	"getnetworkhashps-height":    "Perform estimate ending with this height or -1 for current best chain block height",
+	"getnetworkhashps-algo":      "Only count the hashes of the blocks of this algorithm, all blocks weighted by the speed of their algorithm when it is not given",
 	"getnetworkhashps--result0":  "Estimated hashes per second",
 	// GetNetTotalsCmd help.
 	"getnettotals--synopsis": "Returns a JSON object containing network traffic statistics.",
@@ -378,6 +451,32 @@ var helpDescsEnUS = map[string]string{
 	"getnettotalsresult-totalbytesrecv": "Total bytes received",
 	"getnettotalsresult-totalbytessent": "Total bytes sent",
 	"getnettotalsresult-timemillis":     "Number of milliseconds since 1 Jan 1970 GMT",
+	// GetNetworkInfoCmd help.
+	"getnetworkinfo--synopsis": "Returns a JSON object containing information about the peer-to-peer network.",
+	// GetNetworkInfoResult help.
+	"getnetworkinforesult-version":         "The version of the server",
+	"getnetworkinforesult-subversion":      "The user agent the server sends to its peers",
+	"getnetworkinforesult-protocolversion": "The latest supported protocol version",
+	"getnetworkinforesult-localservices":   "Services bitmask which represents the services the server supports",
+	"getnetworkinforesult-localrelay":      "Whether or not transactions are relayed from peers",
+	"getnetworkinforesult-timeoffset":      "The time offset in seconds from the median time of the peers",
+	"getnetworkinforesult-connections":     "The number of connected peers",
+	"getnetworkinforesult-networkactive":   "Whether or not networking is enabled",
+	"getnetworkinforesult-networks":        "Information about each network",
+	"getnetworkinforesult-relayfee":        "Minimum fee rate in DUO/kB for transactions to be accepted",
+	"getnetworkinforesult-incrementalfee":  "Minimum fee rate increase in DUO/kB for replacement transactions, which is the same as the relay fee",
+	"getnetworkinforesult-localaddresses":  "The addresses the server advertises to its peers",
+	"getnetworkinforesult-warnings":        "Any network warnings",
+	// NetworksResult help.
+	"networksresult-name":                        "The network (ipv4, ipv6 or onion)",
+	"networksresult-limited":                     "Whether or not connections to the network are disabled",
+	"networksresult-reachable":                   "Whether or not addresses of the network can be connected to",
+	"networksresult-proxy":                       "The proxy used to connect to the network, if any",
+	"networksresult-proxy_randomize_credentials": "Whether or not the proxy credentials are randomized for each connection to isolate Tor streams",
+	// LocalAddressesResult help.
+	"localaddressesresult-address": "The advertised address",
+	"localaddressesresult-port":    "The advertised port",
+	"localaddressesresult-score":   "The priority of the address, higher is preferred",
 	// GetPeerInfoResult help.
 	"getpeerinforesult-id":             "A unique node ID",
 	"getpeerinforesult-addr":           "The ip address and port of the peer",
@@ -424,6 +523,35 @@ var helpDescsEnUS = map[string]string{
 	"getrawtransaction--condition0": "verbose=false",
 	"getrawtransaction--condition1": "verbose=true",
 	"getrawtransaction--result0":    "Hex-encoded bytes of the serialized transaction",
+	// GetServiceStatusCmd help.
+	"getservicestatus--synopsis": "Returns the state of the services the supervisor of 9 shell runs in the process of the node: the node, wallet server, CPU miner, miner controller and Stratum server.",
+	// ServiceStatusResult help.
+	"servicestatusresult-name":     "The name of the service",
+	"servicestatusresult-state":    "The state of the service (starting, running, restarting, stopped or failed)",
+	"servicestatusresult-restart":  "When the service is started again after it stops (never, on-failure or always)",
+	"servicestatusresult-restarts": "The number of times the service has been started again",
+	"servicestatusresult-since":    "The time the service came into its state in seconds since 1 Jan 1970 GMT",
+	"servicestatusresult-error":    "The error the service last stopped with or failed its health check with",
+	// GetStratumInfoCmd help.
+	"getstratuminfo--synopsis": "Returns the listeners of the Stratum server, the number of connected miners and the shares of each worker since the node started.",
+	// GetStratumInfoResult help.
+	"getstratuminforesult-running":   "Whether the Stratum server is accepting miners",
+	"getstratuminforesult-listeners": "The addresses the Stratum server listens on",
+	"getstratuminforesult-miners":    "The number of connected miners",
+	"getstratuminforesult-workers":   "The share accounting of each worker that has authorized",
+	// StratumListenerResult help.
+	"stratumlistenerresult-address": "The address the listener accepts miners on",
+	"stratumlistenerresult-algo":    "The algorithm of the miners of the listener, none when they choose it in mining.subscribe",
+	// StratumWorkerResult help.
+	"stratumworkerresult-name":       "The name the worker authorized with",
+	"stratumworkerresult-algo":       "The algorithm of the last share of the worker",
+	"stratumworkerresult-difficulty": "The share difficulty of the last connection of the worker",
+	"stratumworkerresult-accepted":   "The number of accepted shares",
+	"stratumworkerresult-rejected":   "The number of rejected shares",
+	"stratumworkerresult-stale":      "The number of shares rejected for a job that is no longer current",
+	"stratumworkerresult-blocks":     "The number of blocks found and accepted by the chain",
+	"stratumworkerresult-work":       "The sum of the difficulties of the accepted shares",
+	"stratumworkerresult-lastshare":  "The time of the last accepted share in seconds since 1 Jan 1970 GMT",
 	// GetTxOutResult help.
 	"gettxoutresult-bestblock":     "The block hash that contains the transaction output",
 	"gettxoutresult-confirmations": "The number of confirmations",
@@ -443,9 +571,18 @@ var helpDescsEnUS = map[string]string{
 	"help--condition1": "command specified",
 	"help--result0":    "List of commands",
 	"help--result1":    "Help for specified command",
+	// InvalidateBlockCmd help.
+	"invalidateblock--synopsis": "Permanently marks a block and the blocks that build on it as invalid, reorganizing the chain when the block is in the main chain.",
+	"invalidateblock-blockhash": "The hash of the block to mark invalid",
 	// PingCmd help.
 	"ping--synopsis": "Queues a ping to be sent to each connected peer.\n" +
 		"Ping times are provided by getpeerinfo via the pingtime and pingwait fields.",
+	// PreciousBlockCmd help.
+	"preciousblock--synopsis": "Treats a block as if it was received before the others with the same work, reorganizing the chain to it when its branch has at least as much work as the main chain.",
+	"preciousblock-blockhash": "The hash of the block to make precious",
+	// ReconsiderBlockCmd help.
+	"reconsiderblock--synopsis": "Removes the invalid marks of invalidateblock or of a failed validation from a block, its ancestors and the blocks that build on it, reorganizing the chain to the branch with the most work.",
+	"reconsiderblock-blockhash": "The hash of the block to reconsider",
 	// SearchRawTransactionsCmd help.
 	"searchrawtransactions--synopsis": "Returns raw data for transactions involving the passed address.\n" +
 		"Returned transactions are pulled from both the database, and transactions currently in the mempool.\n" +
@@ -480,6 +617,7 @@ var helpDescsEnUS = map[string]string{
 	"submitblock--synopsis":   "Attempts to submit a new serialized, hex-encoded block to the network.",
 	"submitblock-hexblock":    "Serialized, hex-encoded block",
 	"submitblock-options":     "This parameter is currently ignored",
+	"submitblock-algo":        "Reject the block when it is not of this algorithm",
 	"submitblock--condition0": "Block successfully submitted",
 	"submitblock--condition1": "Block rejected",
 	"submitblock--result1":    "The reason the block was rejected",
@@ -508,6 +646,10 @@ var helpDescsEnUS = map[string]string{
 	// Session help.
 	"session--synopsis":       "Return details regarding a websocket client's current connection session.",
 	"sessionresult-sessionid": "The unique session ID for a client's websocket connection.",
+	// NotifyAlgoStatsCmd help.
+	"notifyalgostats--synopsis": "Request an algostats notification of the statistics of each algorithm for the next block whenever a block is connected to the main (best) chain.",
+	// StopNotifyAlgoStatsCmd help.
+	"stopnotifyalgostats--synopsis": "Cancel registered notifications of the statistics of each algorithm whenever a block is connected to the main (best) chain.",
 	// NotifyBlocksCmd help.
 	"notifyblocks--synopsis": "Request notifications for whenever a block is connected or disconnected from the main (best) chain.",
 	// StopNotifyBlocksCmd help.
@@ -554,6 +696,11 @@ var helpDescsEnUS = map[string]string{
 	// RescannedBlock help.
 	"rescannedblock-hash":         "Hash of the matching block.",
 	"rescannedblock-transactions": "List of matching transactions, serialized and hex-encoded.",
+	// ReloadConfigCmd help.
+	"reloadconfig--synopsis": "Reads the configuration file again and applies the settings that can be changed while the node is running: log level, peer and ban limits, minimum relay fee, mining addresses and threads.",
+	// ReloadConfigResult help.
+	"reloadconfigresult-changed": "The configuration rows whose values changed",
+	"reloadconfigresult-restart": "The changed rows that only take effect after the node is restarted",
 	// Uptime help.
 	"uptime--synopsis": "Returns the total uptime of the server.",
 	"uptime--result0":  "The number of seconds that the server has been running",
@@ -580,6 +727,7 @@ var rpcResultTypes = map[string][]interface{}{
 	"estimatefee":           {(*float64)(nil)},
 	"generate":              {(*[]string)(nil)},
 	"getaddednodeinfo":      {(*[]string)(nil), (*[]json.GetAddedNodeInfoResult)(nil)},
+	"getalgostats":          {(*json.GetAlgoStatsResult)(nil)},
 	"getbestblock":          {(*json.GetBestBlockResult)(nil)},
 	"getbestblockhash":      {(*string)(nil)},
 	"getblock":              {(*string)(nil), (*json.GetBlockVerboseResult)(nil)},
@@ -590,6 +738,7 @@ var rpcResultTypes = map[string][]interface{}{
 	"getblockchaininfo":     {(*json.GetBlockChainInfoResult)(nil)},
 	"getcfilter":            {(*string)(nil)},
 	"getcfilterheader":      {(*string)(nil)},
+	"getchaintips":          {(*[]json.GetChainTipsResult)(nil)},
 	"getconnectioncount":    {(*int32)(nil)},
 	"getcurrentnet":         {(*uint32)(nil)},
 	"getdifficulty":         {(*float64)(nil)},
@@ -597,17 +746,27 @@ var rpcResultTypes = map[string][]interface{}{
 	"gethashespersec":       {(*float64)(nil)},
 	"getheaders":            {(*[]string)(nil)},
 	"getinfo":               {(*json.InfoChainResult)(nil)},
+	"getmempoolancestors":   {(*[]string)(nil), (*json.GetMempoolEntryResult)(nil)},
+	"getmempooldescendants": {(*[]string)(nil), (*json.GetMempoolEntryResult)(nil)},
+	"getmempoolentry":       {(*json.GetMempoolEntryResult)(nil)},
 	"getmempoolinfo":        {(*json.GetMempoolInfoResult)(nil)},
 	"getmininginfo":         {(*json.GetMiningInfoResult)(nil)},
 	"getnettotals":          {(*json.GetNetTotalsResult)(nil)},
 	"getnetworkhashps":      {(*int64)(nil)},
+	"getnetworkinfo":        {(*json.GetNetworkInfoResult)(nil)},
 	"getpeerinfo":           {(*[]json.GetPeerInfoResult)(nil)},
 	"getrawmempool":         {(*[]string)(nil), (*json.GetRawMempoolVerboseResult)(nil)},
 	"getrawtransaction":     {(*string)(nil), (*json.TxRawResult)(nil)},
+	"getservicestatus":      {(*[]json.ServiceStatusResult)(nil)},
+	"getstratuminfo":        {(*json.GetStratumInfoResult)(nil)},
 	"gettxout":              {(*json.GetTxOutResult)(nil)},
 	"node":                  nil,
 	"help":                  {(*string)(nil), (*string)(nil)},
+	"invalidateblock":       nil,
 	"ping":                  nil,
+	"preciousblock":         nil,
+	"reconsiderblock":       nil,
+	"reloadconfig":          {(*json.ReloadConfigResult)(nil)},
 	"searchrawtransactions": {(*string)(nil), (*[]json.SearchRawTransactionsResult)(nil)},
 	"sendrawtransaction":    {(*string)(nil)},
 	"setgenerate":           nil,
@@ -621,6 +780,8 @@ var rpcResultTypes = map[string][]interface{}{
 	// Websocket commands.
 	"loadtxfilter":              nil,
 	"session":                   {(*json.SessionResult)(nil)},
+	"notifyalgostats":           nil,
+	"stopnotifyalgostats":       nil,
 	"notifyblocks":              nil,
 	"stopnotifyblocks":          nil,
 	"notifynewtransactions":     nil,
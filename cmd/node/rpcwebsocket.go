@@ -28,6 +28,7 @@ import (
 // Notification types
 type notificationBlockConnected util.Block
 type notificationBlockDisconnected util.Block
+type notificationRegisterAlgoStats wsClient
 type notificationRegisterAddr struct {
 	wsc   *wsClient
 	addrs []string
@@ -48,6 +49,7 @@ type notificationUnregisterAddr struct {
 	wsc  *wsClient
 	addr string
 }
+type notificationUnregisterAlgoStats wsClient
 type notificationUnregisterBlocks wsClient
 type notificationUnregisterClient wsClient
 type notificationUnregisterNewMempoolTxs wsClient
@@ -149,11 +151,13 @@ var wsHandlers map[string]wsCommandHandler
 var wsHandlersBeforeInit = map[string]wsCommandHandler{
 	"loadtxfilter":              handleLoadTxFilter,
 	"help":                      handleWebsocketHelp,
+	"notifyalgostats":           handleNotifyAlgoStats,
 	"notifyblocks":              handleNotifyBlocks,
 	"notifynewtransactions":     handleNotifyNewTransactions,
 	"notifyreceived":            handleNotifyReceived,
 	"notifyspent":               handleNotifySpent,
 	"session":                   handleSession,
+	"stopnotifyalgostats":       handleStopNotifyAlgoStats,
 	"stopnotifyblocks":          handleStopNotifyBlocks,
 	"stopnotifynewtransactions": handleStopNotifyNewTransactions,
 	"stopnotifyspent":           handleStopNotifySpent,
@@ -751,6 +755,14 @@ func (
 	}
 	return
 }
+// RegisterAlgoStatsUpdates requests notifications of the statistics of the algorithms to the passed websocket client when a block is connected to the main chain.
+func (
+	m *wsNotificationManager,
+) RegisterAlgoStatsUpdates(
+	wsc *wsClient,
+) {
+	m.queueNotification <- (*notificationRegisterAlgoStats)(wsc)
+}
 // RegisterBlockUpdates requests block update notifications to the passed websocket client.
 func (
 	m *wsNotificationManager,
@@ -815,6 +827,14 @@ func (m *wsNotificationManager) Start() {
 	go m.queueHandler()
 	go m.notificationHandler()
 }
+// UnregisterAlgoStatsUpdates removes notifications of the statistics of the algorithms for the passed websocket client.
+func (
+	m *wsNotificationManager,
+) UnregisterAlgoStatsUpdates(
+	wsc *wsClient,
+) {
+	m.queueNotification <- (*notificationUnregisterAlgoStats)(wsc)
+}
 // UnregisterBlockUpdates removes block update notifications for the passed websocket client.
 func (
 	m *wsNotificationManager,
@@ -919,6 +939,7 @@ func (
 	clients := make(map[chan struct{}]*wsClient)
 	// Maps used to hold lists of websocket clients to be notified on certain events.  Each websocket client also keeps maps for the events which have multiple triggers to make removal from these lists on connection close less horrendously. Where possible, the quit channel is used as the unique id for a client since it is quite a bit more efficient than using the entire struct.
 	blockNotifications := make(map[chan struct{}]*wsClient)
+	algoStatsNotifications := make(map[chan struct{}]*wsClient)
 	txNotifications := make(map[chan struct{}]*wsClient)
 	watchedOutPoints := make(map[wire.OutPoint]map[chan struct{}]*wsClient)
 	watchedAddrs := make(map[string]map[chan struct{}]*wsClient)
@@ -947,6 +968,9 @@ out:
 					m.notifyFilteredBlockConnected(blockNotifications,
 						block)
 				}
+				if len(algoStatsNotifications) != 0 {
+					m.notifyAlgoStats(algoStatsNotifications, block)
+				}
 			case *notificationBlockDisconnected:
 				block := (*util.Block)(n)
 				if len(blockNotifications) != 0 {
@@ -967,6 +991,12 @@ out:
 			case *notificationUnregisterBlocks:
 				wsc := (*wsClient)(n)
 				delete(blockNotifications, wsc.quit)
+			case *notificationRegisterAlgoStats:
+				wsc := (*wsClient)(n)
+				algoStatsNotifications[wsc.quit] = wsc
+			case *notificationUnregisterAlgoStats:
+				wsc := (*wsClient)(n)
+				delete(algoStatsNotifications, wsc.quit)
 			case *notificationRegisterClient:
 				wsc := (*wsClient)(n)
 				clients[wsc.quit] = wsc
@@ -974,6 +1004,7 @@ out:
 				wsc := (*wsClient)(n)
 				// Remove any requests made by the client as well as the client itself.
 				delete(blockNotifications, wsc.quit)
+				delete(algoStatsNotifications, wsc.quit)
 				delete(txNotifications, wsc.quit)
 				for k := range wsc.spentRequests {
 					op := k
@@ -1013,6 +1044,29 @@ out:
 	}
 	m.wg.Done()
 }
+// notifyAlgoStats notifies websocket clients that have registered for algorithm statistics of the statistics of the algorithms after a block is connected to the main chain. The statistics are calculated once for all the clients, over as many blocks as getalgostats uses by default.
+func (
+	m *wsNotificationManager,
+) notifyAlgoStats(
+	clients map[chan struct{}]*wsClient,
+	block *util.Block,
+) {
+	stats, err := m.server.algoStats(block.Hash(), block.Height(), algoStatsBlocks, algoStatsTimes)
+	if err != nil {
+		// The block may already have been disconnected by a reorganize.
+		log <- cl.Debug{"failed to calculate algorithm statistics for notification:", err}
+		return
+	}
+	ntfn := json.NewAlgoStatsNtfn(*stats)
+	marshalledJSON, err := json.MarshalCmd(nil, ntfn)
+	if err != nil {
+		log <- cl.Error{"failed to marshal algorithm statistics notification:", err}
+		return
+	}
+	for _, wsc := range clients {
+		wsc.QueueNotification(marshalledJSON)
+	}
+}
 // notifyBlockConnected notifies websocket clients that have registered for block updates when a block is connected to the main chain.
 func (
 	_ *wsNotificationManager,
@@ -1506,6 +1560,12 @@ func handleLoadTxFilter(
 	}
 	return nil, nil
 }
+// handleNotifyAlgoStats implements the notifyalgostats command extension for websocket connections.
+func handleNotifyAlgoStats(
+	wsc *wsClient, icmd interface{}) (interface{}, error) {
+	wsc.server.ntfnMgr.RegisterAlgoStatsUpdates(wsc)
+	return nil, nil
+}
 // handleNotifyBlocks implements the notifyblocks command extension for websocket connections.
 func handleNotifyBlocks(
 	wsc *wsClient, icmd interface{}) (interface{}, error) {
@@ -1865,6 +1925,12 @@ func handleSession(
 	wsc *wsClient, icmd interface{}) (interface{}, error) {
 	return &json.SessionResult{SessionID: wsc.sessionID}, nil
 }
+// handleStopNotifyAlgoStats implements the stopnotifyalgostats command extension for websocket connections.
+func handleStopNotifyAlgoStats(
+	wsc *wsClient, icmd interface{}) (interface{}, error) {
+	wsc.server.ntfnMgr.UnregisterAlgoStatsUpdates(wsc)
+	return nil, nil
+}
 // handleStopNotifyBlocks implements the stopnotifyblocks command extension for websocket connections.
 func handleStopNotifyBlocks(
 	wsc *wsClient, icmd interface{}) (interface{}, error) {
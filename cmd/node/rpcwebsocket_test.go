@@ -0,0 +1,190 @@
+package node
+import (
+	js "encoding/json"
+	"reflect"
+	"testing"
+	"time"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+)
+// TestAlgoStatsCmds ensures the getalgostats, notifyalgostats and stopnotifyalgostats commands and the algostats notification marshal and unmarshal, and that getalgostats defaults to the number of blocks and times of the notification.
+func TestAlgoStatsCmds(
+	t *testing.T) {
+	stats := json.GetAlgoStatsResult{
+		Hash:   "123",
+		Height: 100000,
+		Blocks: 120,
+		Algos: []json.AlgoStatsResult{{
+			Algo:          "x11",
+			Version:       8,
+			Bits:          "1d00ffff",
+			Difficulty:    1,
+			BlockTimes:    []int64{72, 90},
+			Blocks:        13,
+			Share:         0.1083,
+			NetworkHashPS: 1000,
+		}},
+	}
+	tests := []struct {
+		name         string
+		id           interface{}
+		params       []interface{}
+		staticCmd    interface{}
+		marshalled   string
+		unmarshalled interface{}
+	}{
+		{
+			name:       "getalgostats",
+			id:         1,
+			staticCmd:  json.NewGetAlgoStatsCmd(nil, nil),
+			marshalled: `{"jsonrpc":"1.0","method":"getalgostats","params":[],"id":1}`,
+			unmarshalled: &json.GetAlgoStatsCmd{
+				Blocks: json.Int(algoStatsBlocks),
+				Times:  json.Int(algoStatsTimes),
+			},
+		},
+		{
+			name:       "getalgostats",
+			id:         1,
+			params:     []interface{}{9600, 5},
+			staticCmd:  json.NewGetAlgoStatsCmd(json.Int(9600), json.Int(5)),
+			marshalled: `{"jsonrpc":"1.0","method":"getalgostats","params":[9600,5],"id":1}`,
+			unmarshalled: &json.GetAlgoStatsCmd{
+				Blocks: json.Int(9600),
+				Times:  json.Int(5),
+			},
+		},
+		{
+			name:         "notifyalgostats",
+			id:           1,
+			staticCmd:    json.NewNotifyAlgoStatsCmd(),
+			marshalled:   `{"jsonrpc":"1.0","method":"notifyalgostats","params":[],"id":1}`,
+			unmarshalled: &json.NotifyAlgoStatsCmd{},
+		},
+		{
+			name:         "stopnotifyalgostats",
+			id:           1,
+			staticCmd:    json.NewStopNotifyAlgoStatsCmd(),
+			marshalled:   `{"jsonrpc":"1.0","method":"stopnotifyalgostats","params":[],"id":1}`,
+			unmarshalled: &json.StopNotifyAlgoStatsCmd{},
+		},
+		{
+			name:         "algostats",
+			params:       []interface{}{`{"hash":"123","height":100000,"blocks":120,"algos":[{"algo":"x11","version":8,"bits":"1d00ffff","difficulty":1,"blocktimes":[72,90],"blocks":13,"share":0.1083,"networkhashps":1000}]}`},
+			staticCmd:    json.NewAlgoStatsNtfn(stats),
+			marshalled:   `{"jsonrpc":"1.0","method":"algostats","params":[{"hash":"123","height":100000,"blocks":120,"algos":[{"algo":"x11","version":8,"bits":"1d00ffff","difficulty":1,"blocktimes":[72,90],"blocks":13,"share":0.1083,"networkhashps":1000}]}],"id":null}`,
+			unmarshalled: &json.AlgoStatsNtfn{Stats: stats},
+		},
+	}
+	for i, test := range tests {
+		cmd, err := json.NewCmd(test.name, test.params...)
+		if err != nil {
+			t.Fatalf("test #%d (%s): %v", i, test.name, err)
+		}
+		for _, x := range []interface{}{cmd, test.staticCmd} {
+			b, err := json.MarshalCmd(test.id, x)
+			if err != nil {
+				t.Fatalf("test #%d (%s): %v", i, test.name, err)
+			}
+			if string(b) != test.marshalled {
+				t.Fatalf("test #%d (%s): got %s, expected %s", i, test.name, b, test.marshalled)
+			}
+		}
+		var request json.Request
+		if err = js.Unmarshal([]byte(test.marshalled), &request); err != nil {
+			t.Fatalf("test #%d (%s): %v", i, test.name, err)
+		}
+		if cmd, err = json.UnmarshalCmd(&request); err != nil {
+			t.Fatalf("test #%d (%s): %v", i, test.name, err)
+		}
+		if !reflect.DeepEqual(cmd, test.unmarshalled) {
+			t.Fatalf("test #%d (%s): unmarshalled %#v, expected %#v", i, test.name, cmd, test.unmarshalled)
+		}
+	}
+}
+// newTestWsClient returns a websocket client of the server without a connection, with room for the given number of queued notifications
+func newTestWsClient(
+	s *rpcServer, queued int) *wsClient {
+	return &wsClient{
+		server:        s,
+		addrRequests:  make(map[string]struct{}),
+		spentRequests: make(map[wire.OutPoint]struct{}),
+		ntfnChan:      make(chan []byte, queued),
+		quit:          make(chan struct{}),
+	}
+}
+// receiveNotification returns the method and the unmarshalled notification of the next notification queued for the client
+func receiveNotification(
+	t *testing.T, wsc *wsClient) (method string, ntfn interface{}) {
+	select {
+	case b := <-wsc.ntfnChan:
+		var request json.Request
+		if err := js.Unmarshal(b, &request); err != nil {
+			t.Fatal(err)
+		}
+		ntfn, err := json.UnmarshalCmd(&request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return request.Method, ntfn
+	case <-time.After(5 * time.Second):
+		t.Fatal("no notification was sent")
+	}
+	return
+}
+// TestAlgoStatsNotification ensures the algostats notification is only sent to clients that register for it, and that it has the statistics getalgostats returns by default.
+func TestAlgoStatsNotification(
+	t *testing.T) {
+	chain, _, teardown := newTestChain(t)
+	defer teardown()
+	s := &rpcServer{}
+	s.Cfg.Chain = chain
+	s.ntfnMgr = newWsNotificationManager(s)
+	s.ntfnMgr.wg.Add(2)
+	s.ntfnMgr.Start()
+	defer func() {
+		s.ntfnMgr.Shutdown()
+		s.ntfnMgr.WaitForShutdown()
+	}()
+	stats := newTestWsClient(s, 1)
+	blocks := newTestWsClient(s, 6)
+	if _, err := handleNotifyAlgoStats(stats, &json.NotifyAlgoStatsCmd{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handleNotifyBlocks(blocks, &json.NotifyBlocksCmd{}); err != nil {
+		t.Fatal(err)
+	}
+	best := chain.BestSnapshot()
+	block, err := chain.BlockByHash(&best.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := handleGetAlgoStats(s, json.NewGetAlgoStatsCmd(nil, nil), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ntfnMgr.NotifyBlockConnected(block)
+	method, ntfn := receiveNotification(t, stats)
+	if method != json.AlgoStatsNtfnMethod {
+		t.Fatalf("got a %s notification, expected %s", method, json.AlgoStatsNtfnMethod)
+	}
+	if got := ntfn.(*json.AlgoStatsNtfn).Stats; !reflect.DeepEqual(&got, want) {
+		t.Fatalf("got %+v, expected %+v", got, want)
+	}
+	if _, err = handleStopNotifyAlgoStats(stats, &json.StopNotifyAlgoStatsCmd{}); err != nil {
+		t.Fatal(err)
+	}
+	// The notifications of the second block are only sent once the first has been handled.
+	s.ntfnMgr.NotifyBlockConnected(block)
+	s.ntfnMgr.NotifyBlockConnected(block)
+	for i := 0; i < 6; i++ {
+		if method, _ = receiveNotification(t, blocks); method == json.AlgoStatsNtfnMethod {
+			t.Fatal("algostats notification was sent to a client that only registered for blocks")
+		}
+	}
+	select {
+	case <-stats.ntfnChan:
+		t.Fatal("algostats notification was sent after the client stopped it")
+	default:
+	}
+}
@@ -23,6 +23,8 @@ import (
 	indexers "git.parallelcoin.io/dev/9/pkg/chain/index"
 	"git.parallelcoin.io/dev/9/pkg/chain/mining"
 	cpuminer "git.parallelcoin.io/dev/9/pkg/chain/mining/cpu"
+	controller "git.parallelcoin.io/dev/9/pkg/chain/mining/dispatch"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining/stratum"
 	netsync "git.parallelcoin.io/dev/9/pkg/chain/sync"
 	txscript "git.parallelcoin.io/dev/9/pkg/chain/tx/script"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
@@ -113,7 +115,8 @@ type server struct {
 	chain         *blockchain.BlockChain
 	txMemPool     *mempool.TxPool
 	cpuMiner      *cpuminer.CPUMiner
-	// minerController      *controller.Controller
+	minerController      *controller.Controller
+	stratum              *stratum.Server
 	modifyRebroadcastInv chan interface{}
 	newPeers             chan *serverPeer
 	donePeers            chan *serverPeer
@@ -403,13 +406,22 @@ func (s *server) Start() {
 	} else {
 		panic("cannot run without RPC")
 	}
-	// Start the CPU miner if generation is enabled.
-	if *Cfg.Generate {
+	// Start the CPU miner if generation is enabled, unless the miners are run as services of their own.
+	if *Cfg.Generate && !Supervised {
 		s.cpuMiner.Start()
 	}
-	// if *Cfg.MinerListener != "" {
-	// 	s.minerController.Start()
-	// }
+	// Start the miner controller if a listener for external miners is configured.
+	if Cfg.MinerListener != nil && *Cfg.MinerListener != "" && !Supervised {
+		if err := s.minerController.Start(); err != nil {
+			log <- cl.Error{"unable to start miner controller:", err}
+		}
+	}
+	// Start the Stratum server if listeners for Stratum miners are configured.
+	if len(s.stratum.Listeners()) > 0 && !Supervised {
+		if err := s.stratum.Start(); err != nil {
+			log <- cl.Error{"unable to start Stratum server:", err}
+		}
+	}
 }
 // Stop gracefully shuts down the server by stopping and disconnecting all peers and the main listener.
 func (
@@ -424,7 +436,9 @@ func (
 	// Stop the CPU miner if needed
 	s.cpuMiner.Stop()
 	// Stop miner controller if needed
-	// s.minerController.Stop()
+	s.minerController.Stop()
+	// Stop the Stratum server if needed
+	s.stratum.Stop()
 	// Shutdown the RPC server if it's not disabled.
 	if !*Cfg.DisableRPC {
 		for i := range s.rpcServers {
@@ -605,6 +619,9 @@ func (
 // handleQuery is the central handler for all queries and commands from other goroutines related to peer state.
 func (s *server) handleQuery(state *peerState, querymsg interface{}) {
 	switch msg := querymsg.(type) {
+	case setLiveConfigMsg:
+		s.setLiveConfig(msg.cfg)
+		close(msg.reply)
 	case getConnCountMsg:
 		nconnected := int32(0)
 		state.forAllPeers(func(sp *serverPeer) {
@@ -1865,7 +1882,8 @@ func (
 ) addBanScore(
 	persistent, transient uint32, reason string) {
 	// No warning is logged and no score is calculated if banning is disabled.
-	if *Cfg.DisableBanning {
+	disabled, threshold := banning()
+	if disabled {
 		return
 	}
 	if sp.isWhitelisted {
@@ -1874,7 +1892,7 @@ func (
 		}
 		return
 	}
-	warnThreshold := *Cfg.BanThreshold >> 1
+	warnThreshold := threshold >> 1
 	if transient == 0 && persistent == 0 {
 		// The score is not being increased, but a warning message is still logged if the score is above the warn threshold.
 		score := sp.banScore.Int()
@@ -1892,7 +1910,7 @@ func (
 			"misbehaving peer %s: %s -- ban score increased to %d",
 			sp, reason, score,
 		}
-		if int(score) > *Cfg.BanThreshold {
+		if int(score) > threshold {
 			log <- cl.Warnf{
 				"misbehaving peer %s -- banning and disconnecting", sp,
 			}
@@ -1925,8 +1943,8 @@ func (
 	cmd string) bool {
 	if sp.server.services&wire.SFNodeBloom != wire.SFNodeBloom {
 		// Ban the peer if the protocol version is high enough that the peer is knowingly violating the protocol and banning is enabled. NOTE: Even though the addBanScore function already examines whether or not banning is enabled, it is checked here as well to ensure the violation is logged and the peer is disconnected regardless.
-		if sp.ProtocolVersion() >= wire.BIP0111Version &&
-			!*Cfg.DisableBanning {
+		if disabled, _ := banning(); sp.ProtocolVersion() >= wire.BIP0111Version &&
+			!disabled {
 			// Disconnect the peer regardless of whether it was banned.
 			sp.addBanScore(100, 0, cmd)
 			sp.Disconnect()
@@ -2424,12 +2442,12 @@ func newServer(
 			SigCache:     s.sigCache,
 			IndexManager: indexManager,
 			HashCache:    s.hashCache,
+			PowWorkers:   *Cfg.PowWorkers,
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	s.chain.DifficultyAdjustments = make(map[string]float64)
 	// Search for a FeeEstimator state in the database. If none can be found or if it cannot be loaded, create a new one.
 	e := db.Update(func(tx database.Tx) error {
 		metadata := tx.Metadata()
@@ -2527,17 +2545,56 @@ func newServer(
 		NumThreads:             s.numthreads,
 		Algo:                   s.algo,
 	})
-	// s.minerController = controller.New(&controller.Config{
-	// 	Blockchain:             s.chain,
-	// 	ChainParams:            chainParams,
-	// 	BlockTemplateGenerator: blockTemplateGenerator,
-	// 	MiningAddrs:            StateCfg.ActiveMiningAddrs,
-	// 	ProcessBlock:           s.syncManager.ProcessBlock,
-	// 	MinerListener:          *Cfg.MinerListener,
-	// 	MinerKey:               StateCfg.ActiveMinerKey,
-	// 	ConnectedCount:         s.ConnectedCount,
-	// 	IsCurrent:              s.syncManager.IsCurrent,
-	// })
+	var minerListener string
+	if Cfg.MinerListener != nil {
+		minerListener = *Cfg.MinerListener
+	}
+	s.minerController = controller.New(&controller.Config{
+		Blockchain:             s.chain,
+		ChainParams:            chainParams,
+		BlockTemplateGenerator: blockTemplateGenerator,
+		MiningAddrs:            StateCfg.ActiveMiningAddrs,
+		ProcessBlock:           s.syncManager.ProcessBlock,
+		MinerListener:          minerListener,
+		MinerKey:               StateCfg.ActiveMinerKey,
+		ConnectedCount:         s.ConnectedCount,
+		IsCurrent:              s.syncManager.IsCurrent,
+	})
+	var stratumListeners []stratum.Listener
+	if Cfg.StratumListeners != nil {
+		if stratumListeners, err = stratum.ParseListeners(chainParams,
+			*Cfg.StratumListeners, DefaultStratumPort); err != nil {
+			return nil, err
+		}
+	}
+	var stratumDifficulty map[string]float64
+	if Cfg.StratumDifficulty != nil {
+		if stratumDifficulty, err = stratum.ParseDifficulty(chainParams,
+			*Cfg.StratumDifficulty); err != nil {
+			return nil, err
+		}
+	}
+	var stratumShareTime time.Duration
+	if Cfg.StratumShareTime != nil {
+		stratumShareTime = *Cfg.StratumShareTime
+	}
+	var stratumPass string
+	if Cfg.StratumPass != nil {
+		stratumPass = *Cfg.StratumPass
+	}
+	s.stratum = stratum.New(&stratum.Config{
+		Blockchain:             s.chain,
+		ChainParams:            chainParams,
+		BlockTemplateGenerator: blockTemplateGenerator,
+		MiningAddrs:            StateCfg.ActiveMiningAddrs,
+		ProcessBlock:           s.syncManager.ProcessBlock,
+		Listeners:              stratumListeners,
+		Difficulty:             stratumDifficulty,
+		ShareTime:              stratumShareTime,
+		Password:               stratumPass,
+		ConnectedCount:         s.ConnectedCount,
+		IsCurrent:              s.syncManager.IsCurrent,
+	})
 	/*	Only setup a function to return new addresses to connect to when
 		not running in connect-only mode.  The simulation network is always
 		in connect-only mode since it is only intended to connect to
@@ -2545,7 +2602,7 @@ func newServer(
 		discovered peers in order to prevent it from becoming a public test
 		network. */
 	var newAddressFunc func() (net.Addr, error)
-	if !*Cfg.SimNet && Cfg.ConnectPeers == nil {
+	if !*Cfg.SimNet && (Cfg.ConnectPeers == nil || len(*Cfg.ConnectPeers) == 0) {
 		newAddressFunc = func() (net.Addr, error) {
 			for tries := 0; tries < 100; tries++ {
 				addr := s.addrManager.GetAddress()
@@ -2601,7 +2658,7 @@ func newServer(
 	// Start up persistent peers.
 	if Cfg.ConnectPeers != nil {
 		permanentPeers := *Cfg.ConnectPeers
-		if len(permanentPeers) == 0 {
+		if len(permanentPeers) == 0 && Cfg.AddPeers != nil {
 			permanentPeers = *Cfg.AddPeers
 		}
 		for _, addr := range permanentPeers {
@@ -2619,7 +2676,8 @@ func newServer(
 	}
 	if !*Cfg.DisableRPC {
 		/*	Setup listeners for the configured RPC listen addresses and
-			TLS settings. */
+			TLS settings. The algorithm of a server is only the default of the
+			mining commands, which all take an algo parameter. */
 		listeners := map[string][]string{
 			"sha256d": *Cfg.RPCListeners,
 		}
@@ -2643,11 +2701,13 @@ func newServer(
 				TxMemPool:    s.txMemPool,
 				Generator:    blockTemplateGenerator,
 				CPUMiner:     s.cpuMiner,
+				Stratum:      s.stratum,
 				TxIndex:      s.txIndex,
 				AddrIndex:    s.addrIndex,
 				CfIndex:      s.cfIndex,
 				FeeEstimator: s.feeEstimator,
 				Algo:         l,
+				ReloadConfig: s.ReloadConfig,
 			})
 			if err != nil {
 				return nil, err
@@ -2749,6 +2809,14 @@ func setupRPCListeners(
 			Certificates: []tls.Certificate{keypair},
 			MinVersion:   tls.VersionTLS12,
 		}
+		if Cfg.ClientAuth != nil && *Cfg.ClientAuth {
+			// Only clients with a certificate issued by the certificate authority may connect.
+			tlsConfig.ClientCAs, err = util.LoadCertPool(*Cfg.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
 		// Change the standard net.Listen function to the tls one.
 		listenFunc = func(net string, laddr string) (net.Listener, error) {
 			return tls.Listen(net, laddr, &tlsConfig)
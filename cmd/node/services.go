@@ -0,0 +1,50 @@
+package node
+import (
+	"sync"
+	cpuminer "git.parallelcoin.io/dev/9/pkg/chain/mining/cpu"
+	controller "git.parallelcoin.io/dev/9/pkg/chain/mining/dispatch"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining/stratum"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+)
+// Supervised is set by the launcher of the node when the CPU miner, the miner controller and the Stratum server are run as services of their own, so the server does not start them itself
+var Supervised bool
+// ServiceStatus is set by the launcher of the node when it runs the node under a supervisor, returning the state of the services of the process for the getservicestatus command
+var ServiceStatus func() []json.ServiceStatusResult
+// running is the server of the node while it is running
+var running struct {
+	sync.Mutex
+	s *server
+}
+// setRunning sets the running server, nil once it has stopped
+func setRunning(s *server) {
+	running.Lock()
+	running.s = s
+	running.Unlock()
+}
+// CPUMiner returns the CPU miner of the running node, nil if the node is not running. This function is safe for concurrent access.
+func CPUMiner() *cpuminer.CPUMiner {
+	running.Lock()
+	defer running.Unlock()
+	if running.s == nil {
+		return nil
+	}
+	return running.s.cpuMiner
+}
+// MinerController returns the controller of the external miners of the running node, nil if the node is not running. This function is safe for concurrent access.
+func MinerController() *controller.Controller {
+	running.Lock()
+	defer running.Unlock()
+	if running.s == nil {
+		return nil
+	}
+	return running.s.minerController
+}
+// Stratum returns the Stratum server of the running node, nil if the node is not running. This function is safe for concurrent access.
+func Stratum() *stratum.Server {
+	running.Lock()
+	defer running.Unlock()
+	if running.s == nil {
+		return nil
+	}
+	return running.s.stratum
+}
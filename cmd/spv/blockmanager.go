@@ -1955,7 +1955,8 @@ func (b *blockManager) handleHeadersMsg(hmsg *headersMsg) {
 					return
 				}
 				totalWork.Add(totalWork,
-					blockchain.CalcWork(reorgHeader.Bits, prevNode.Height+1, reorgHeader.Version))
+					blockchain.CalcWork(reorgHeader.Bits, prevNode.Height+1, reorgHeader.Version,
+						&b.server.chainParams))
 				b.reorgList.PushBack(headerlist.Node{
 					Header: *reorgHeader,
 					Height: int32(backHeight+1) + int32(j),
@@ -1988,7 +1989,8 @@ func (b *blockManager) handleHeadersMsg(hmsg *headersMsg) {
 				}
 				knownWork.Add(
 					knownWork,
-					blockchain.CalcWork(knownHead.Bits, knownEl.Height, knownHead.Version),
+					blockchain.CalcWork(knownHead.Bits, knownEl.Height, knownHead.Version,
+						&b.server.chainParams),
 				)
 			}
 			log <- cl.Trace{"total work from known chain:", knownWork}
@@ -2134,7 +2136,8 @@ func (b *blockManager) checkHeaderSanity(blockHeader *wire.BlockHeader,
 	stubBlock := util.NewBlock(&wire.MsgBlock{
 		Header: *blockHeader,
 	})
-	err = blockchain.CheckProofOfWork(stubBlock, blockchain.CompactToBig(diff), height)
+	err = blockchain.CheckProofOfWork(stubBlock, blockchain.CompactToBig(diff), height,
+		&b.server.chainParams)
 	if err != nil {
 		return err
 	}
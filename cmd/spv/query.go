@@ -853,7 +853,7 @@ func (s *ChainService) GetBlock(blockHash chainhash.Hash,
 					s.timeSource,
 					false,
 					block.Height(),
-					true,
+					&s.chainParams,
 				); err != nil {
 					log <- cl.Warnf{
 						"Invalid block for %s received from %s -- disconnecting peer",
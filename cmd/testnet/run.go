@@ -0,0 +1,92 @@
+package testnet
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"git.parallelcoin.io/dev/9/pkg/util/interrupt"
+)
+// Run starts every node of the test network in base as a shell in a process of its own, printing the output of all of them prefixed with the number of the node, and waits until they have all stopped. An interrupt is passed on to all the nodes.
+func Run(
+	base string) (err error) {
+	if base, err = filepath.Abs(base); err != nil {
+		return
+	}
+	nodes, err := Nodes(base)
+	if err != nil {
+		return
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return
+	}
+	var (
+		mtx     sync.Mutex
+		running []*exec.Cmd
+		wg      sync.WaitGroup
+		out     = bufio.NewWriter(os.Stdout)
+	)
+	// print writes whole lines of the nodes one at a time so they don't get mixed up
+	print := func(prefix, line string) {
+		mtx.Lock()
+		fmt.Fprintln(out, prefix, line)
+		out.Flush()
+		mtx.Unlock()
+	}
+	width := len(fmt.Sprint(nodes[len(nodes)-1]))
+	for _, n := range nodes {
+		prefix := fmt.Sprintf("[%*d]", width, n)
+		cmd := exec.Command(exe, "shell", NodeDir(base, n))
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return err
+		}
+		stderr, err := cmd.StderrPipe()
+		if err != nil {
+			return err
+		}
+		if err = cmd.Start(); err != nil {
+			stop(running)
+			return err
+		}
+		running = append(running, cmd)
+		print(prefix, fmt.Sprintf("started %s shell %s", exe, NodeDir(base, n)))
+		var lines sync.WaitGroup
+		for _, r := range []io.Reader{stdout, stderr} {
+			lines.Add(1)
+			go func(r io.Reader) {
+				defer lines.Done()
+				scanner := bufio.NewScanner(r)
+				for scanner.Scan() {
+					print(prefix, scanner.Text())
+				}
+			}(r)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// All output has to be read before waiting for the process.
+			lines.Wait()
+			if err := cmd.Wait(); err != nil {
+				print(prefix, fmt.Sprint("stopped: ", err))
+			} else {
+				print(prefix, "stopped")
+			}
+		}()
+	}
+	interrupt.AddHandler(func() {
+		stop(running)
+	})
+	wg.Wait()
+	return nil
+}
+// stop asks the processes of the nodes to shut down
+func stop(
+	running []*exec.Cmd) {
+	for _, cmd := range running {
+		cmd.Process.Signal(os.Interrupt)
+	}
+}
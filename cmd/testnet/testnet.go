@@ -0,0 +1,162 @@
+// Package testnet creates and runs a local network of nodes on the test network that only connect to each other, each with its own data directory, ports, wallet and mining address.
+package testnet
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"git.parallelcoin.io/dev/9/cmd/def"
+	"git.parallelcoin.io/dev/9/cmd/nine"
+	"git.parallelcoin.io/dev/9/cmd/walletmain"
+	"git.parallelcoin.io/dev/9/pkg/util"
+)
+const (
+	// BasePort is the first of the ports of the nodes of a test network, each node has the next ten ports from it in the order of its number
+	BasePort = 25040
+	// MaxNodes is the most nodes a test network can have
+	MaxNodes = 100
+	// PrivPass is the private passphrase of the wallets of a test network
+	PrivPass = "password"
+	// Network is the network the nodes of a test network run on
+	Network = "testnet"
+)
+// the offsets of the ports of a node from its first port
+const (
+	minerPort = iota + 5
+	walletPort
+	p2pPort
+	rpcPort
+	guiPort
+)
+// Addr returns the loopback address of a port of the node with the given number
+func Addr(
+	node, port int) string {
+	return fmt.Sprintf("127.0.0.1:%d", BasePort+10*(node-1)+port)
+}
+// NodeDir returns the data directory of the node with the given number in the test network in base
+func NodeDir(
+	base string, node int) string {
+	return filepath.Join(base, strconv.Itoa(node))
+}
+// Nodes returns the numbers of the nodes of the test network in base, in order
+func Nodes(
+	base string) (nodes []int, err error) {
+	entries, err := ioutil.ReadDir(base)
+	if err != nil {
+		return
+	}
+	for _, x := range entries {
+		n, err := strconv.Atoi(x.Name())
+		if err != nil || !x.IsDir() ||
+			!util.FileExists(filepath.Join(base, x.Name(), "config")) {
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	sort.Ints(nodes)
+	if len(nodes) == 0 {
+		err = fmt.Errorf("no test network nodes found in %s", base)
+	}
+	return
+}
+// Create writes the configurations of n nodes into numbered data directories in base, each one a copy of the configuration of ap with the ports of the node, connecting only to the other nodes and mining to the address of a new wallet of its own.
+func Create(
+	ap *def.App, base string, n int) (err error) {
+	if n < 1 || n > MaxNodes {
+		return fmt.Errorf("a test network has from 1 to %d nodes", MaxNodes)
+	}
+	if base, err = filepath.Abs(base); err != nil {
+		return
+	}
+	for i := 1; i <= n; i++ {
+		if util.FileExists(NodeDir(base, i)) {
+			return fmt.Errorf("%s already exists, refusing to overwrite",
+				NodeDir(base, i))
+		}
+	}
+	peers := make([]string, n)
+	for i := range peers {
+		peers[i] = Addr(i+1, p2pPort)
+	}
+	for i := 1; i <= n; i++ {
+		dir := NodeDir(base, i)
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return
+		}
+		var connect []string
+		for j, x := range peers {
+			if j+1 != i {
+				connect = append(connect, x)
+			}
+		}
+		// The configuration is written to the data directory in the app group after every change, so it has to be set first.
+		err = set(ap,
+			row{"app", "datadir", dir},
+			row{"p2p", "network", Network},
+			row{"p2p", "nolisten", false},
+			row{"p2p", "listen", []string{Addr(i, p2pPort)}},
+			row{"p2p", "connect", connect},
+			row{"p2p", "addpeer", []string{}},
+			row{"p2p", "nodns", true},
+			row{"rpc", "listen", []string{Addr(i, rpcPort)}},
+			row{"rpc", "connect", Addr(i, rpcPort)},
+			row{"wallet", "server", Addr(i, walletPort)},
+			row{"gui", "listen", Addr(i, guiPort)},
+			row{"mining", "listener", Addr(i, minerPort)},
+			row{"mining", "controller", Addr(i, minerPort)},
+			row{"mining", "generate", true},
+			row{"mining", "genthreads", 1},
+		)
+		if err != nil {
+			return
+		}
+		for _, x := range []string{"appdatadir", "logdir"} {
+			ap.Cats["app"][x].Value.Put(nil)
+		}
+		var pubPass []byte
+		if p := ap.Cats.Str("wallet", "pass"); p != nil {
+			pubPass = []byte(*p)
+		}
+		wdb := walletmain.NetworkDir(filepath.Join(dir, "wallet"),
+			nine.ActiveNetParams.Params)
+		addr, err := walletmain.CreateTestWallet(nine.ActiveNetParams, wdb,
+			pubPass, []byte(PrivPass))
+		if err != nil {
+			return err
+		}
+		if err = set(ap, row{"mining", "addresses", []string{addr.EncodeAddress()}}); err != nil {
+			return err
+		}
+		fmt.Printf("node %d in %s: p2p %s rpc %s wallet %s mining %s address %s\n",
+			i, dir, Addr(i, p2pPort), Addr(i, rpcPort), Addr(i, walletPort),
+			Addr(i, minerPort), addr.EncodeAddress())
+	}
+	fmt.Printf("wallet private passphrase is '%s', start the network with: testnet run %s\n",
+		PrivPass, base)
+	return nil
+}
+// row is a value to set in the configuration
+type row struct {
+	group, name string
+	value       interface{}
+}
+// set puts the values of rows through their validators into the configuration of ap
+func set(
+	ap *def.App, rows ...row) error {
+	for _, x := range rows {
+		r, ok := ap.Cats[x.group][x.name]
+		if !ok {
+			return fmt.Errorf("no configuration row %s.%s", x.group, x.name)
+		}
+		// Lists are added to by their validators, the old value is replaced.
+		if _, ok := x.value.([]string); ok {
+			r.Value.Put([]string{})
+		}
+		if !r.Put(x.value) {
+			return fmt.Errorf("invalid value %v for %s.%s", x.value, x.group, x.name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package testnet
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+// TestAddr ensures no two ports of the nodes of the largest test network are the same
+func TestAddr(
+	t *testing.T) {
+	seen := make(map[string]int)
+	for node := 1; node <= MaxNodes; node++ {
+		for _, port := range []int{minerPort, walletPort, p2pPort, rpcPort} {
+			addr := Addr(node, port)
+			if other, ok := seen[addr]; ok {
+				t.Fatalf("nodes %d and %d both use %s", other, node, addr)
+			}
+			seen[addr] = node
+		}
+	}
+}
+// TestNodes ensures only numbered data directories with a configuration are found, in numeric order
+func TestNodes(
+	t *testing.T) {
+	base, err := ioutil.TempDir("", "testnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(base)
+	if _, err = Nodes(base); err == nil {
+		t.Fatal("empty test network was found")
+	}
+	for _, x := range []string{"10", "2", "1", "3", "x"} {
+		if err = os.MkdirAll(filepath.Join(base, x), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if x == "3" {
+			continue
+		}
+		err = ioutil.WriteFile(filepath.Join(base, x, "config"), []byte("{}"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	nodes, err := Nodes(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 10}; !reflect.DeepEqual(nodes, want) {
+		t.Fatalf("found nodes %v, expected %v", nodes, want)
+	}
+}
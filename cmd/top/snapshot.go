@@ -0,0 +1,178 @@
+package top
+import (
+	"sort"
+	"time"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+)
+// recentBlocks is how many blocks of the best chain the dashboard shows
+const recentBlocks = 12
+// Chain is the part of the RPC client of the full node the dashboard reads from
+type Chain interface {
+	GetMiningInfo() (*json.GetMiningInfoResult, error)
+	GetPeerInfo() ([]json.GetPeerInfoResult, error)
+	GetRawMempoolVerbose() (map[string]json.GetRawMempoolVerboseResult, error)
+	GetBestBlock() (*chainhash.Hash, int32, error)
+	GetBlockVerbose(blockHash *chainhash.Hash) (*json.GetBlockVerboseResult, error)
+}
+// Block is a block of the best chain in the recent blocks pane
+type Block struct {
+	Height int64
+	Hash   string
+	Prev   string
+	Algo   string
+	Time   int64
+	Txs    int
+	// Interval is the seconds since the block before it, zero for the oldest block shown
+	Interval int64
+}
+// Bucket is the transactions of the mempool paying at least Min satoshi per byte and less than the Min of the next bucket
+type Bucket struct {
+	Min   float64
+	Count int
+	Bytes int64
+}
+// feeRates are the lowest fee rates of the buckets of the fee histogram in satoshi per byte
+var feeRates = []float64{0, 1, 2, 5, 10, 20, 50, 100, 500}
+// Snapshot is the state of the node and wallet shown on the dashboard
+type Snapshot struct {
+	Mining       *json.GetMiningInfoResult
+	Height       int32
+	Blocks       []Block
+	Peers        []json.GetPeerInfoResult
+	MempoolTxs   int
+	MempoolBytes int64
+	Fees         []Bucket
+	Balance      float64
+	WalletErr    error
+	Err          error
+	Time         time.Time
+}
+// collect reads the state of the node from c and the balance of the wallet from balance, reusing the blocks of the last snapshot that are still in the best chain
+func collect(
+	c Chain, balance func() (float64, error), last *Snapshot) (s *Snapshot) {
+	s = &Snapshot{Time: time.Now()}
+	defer func() {
+		s.Balance, s.WalletErr = balance()
+	}()
+	if s.Mining, s.Err = c.GetMiningInfo(); s.Err != nil {
+		return
+	}
+	var best *chainhash.Hash
+	if best, s.Height, s.Err = c.GetBestBlock(); s.Err != nil {
+		return
+	}
+	var known []Block
+	if last != nil {
+		known = last.Blocks
+	}
+	if s.Blocks, s.Err = chainBlocks(c, best, known); s.Err != nil {
+		return
+	}
+	if s.Peers, s.Err = c.GetPeerInfo(); s.Err != nil {
+		return
+	}
+	sort.Slice(s.Peers, func(i, j int) bool { return s.Peers[i].ID < s.Peers[j].ID })
+	var pool map[string]json.GetRawMempoolVerboseResult
+	if pool, s.Err = c.GetRawMempoolVerbose(); s.Err != nil {
+		return
+	}
+	s.MempoolTxs = len(pool)
+	for _, tx := range pool {
+		s.MempoolBytes += int64(tx.Size)
+	}
+	s.Fees = feeHistogram(pool)
+	return
+}
+// chainBlocks returns the recent blocks of the best chain ending at best, latest first, only fetching the blocks that are not in known
+func chainBlocks(
+	c Chain, best *chainhash.Hash, known []Block) (blocks []Block, err error) {
+	index := make(map[string]int, len(known))
+	for i, b := range known {
+		index[b.Hash] = i
+	}
+	hash := best.String()
+	for len(blocks) <= recentBlocks {
+		if i, ok := index[hash]; ok {
+			blocks = append(blocks, known[i:]...)
+			break
+		}
+		var h *chainhash.Hash
+		if h, err = chainhash.NewHashFromStr(hash); err != nil {
+			return
+		}
+		var b *json.GetBlockVerboseResult
+		if b, err = c.GetBlockVerbose(h); err != nil {
+			return
+		}
+		blocks = append(blocks, Block{
+			Height: b.Height,
+			Hash:   b.Hash,
+			Prev:   b.PreviousHash,
+			Algo:   b.PowAlgo,
+			Time:   b.Time,
+			Txs:    len(b.Tx),
+		})
+		if b.Height == 0 {
+			break
+		}
+		hash = b.PreviousHash
+	}
+	// one more block than is shown is kept so the oldest shown has its interval
+	if len(blocks) > recentBlocks+1 {
+		blocks = blocks[:recentBlocks+1]
+	}
+	for i := range blocks {
+		blocks[i].Interval = 0
+		if i+1 < len(blocks) {
+			blocks[i].Interval = blocks[i].Time - blocks[i+1].Time
+		}
+	}
+	return
+}
+// feeHistogram counts the transactions of the mempool in the buckets of feeRates
+func feeHistogram(
+	pool map[string]json.GetRawMempoolVerboseResult) []Bucket {
+	buckets := make([]Bucket, len(feeRates))
+	for i, r := range feeRates {
+		buckets[i].Min = r
+	}
+	for _, tx := range pool {
+		if tx.Size <= 0 {
+			continue
+		}
+		rate := tx.Fee * 1e8 / float64(tx.Size)
+		i := sort.Search(len(feeRates), func(i int) bool { return feeRates[i] > rate }) - 1
+		if i < 0 {
+			i = 0
+		}
+		buckets[i].Count++
+		buckets[i].Bytes += int64(tx.Size)
+	}
+	return buckets
+}
+// Difficulty is the difficulty of the blocks of one algorithm
+type Difficulty struct {
+	Algo  string
+	Value float64
+}
+// difficulties returns the difficulty of every algorithm with blocks on the chain, the algorithms that have none yet have no difficulty
+func difficulties(
+	m *json.GetMiningInfoResult) (d []Difficulty) {
+	for _, x := range []Difficulty{
+		{"blake14lr", m.DifficultyBlake14lr},
+		{"blake2b", m.DifficultyBlake2b},
+		{"blake2s", m.DifficultyBlake2s},
+		{"keccak", m.DifficultyKeccak},
+		{"scrypt", m.DifficultyScrypt},
+		{"sha256d", m.DifficultySHA256D},
+		{"skein", m.DifficultySkein},
+		{"stribog", m.DifficultyStribog},
+		{"x11", m.DifficultyX11},
+	} {
+		if x.Value != 0 {
+			d = append(d, x)
+		}
+	}
+	return
+}
@@ -0,0 +1,100 @@
+package top
+import (
+	"errors"
+	"reflect"
+	"testing"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/rpc/json"
+)
+// fakeChain is a chain of blocks one minute apart with the hash of each block being its height, counting the blocks fetched
+type fakeChain struct {
+	height  int64
+	fetched int
+}
+func hashOf(
+	height int64) string {
+	var h chainhash.Hash
+	h[0], h[1] = byte(height), byte(height>>8)
+	return h.String()
+}
+func (c *fakeChain) GetMiningInfo() (*json.GetMiningInfoResult, error) {
+	return &json.GetMiningInfoResult{DifficultySHA256D: 2, DifficultyScrypt: 3}, nil
+}
+func (c *fakeChain) GetPeerInfo() ([]json.GetPeerInfoResult, error) {
+	return []json.GetPeerInfoResult{{ID: 2}, {ID: 1}}, nil
+}
+func (c *fakeChain) GetRawMempoolVerbose() (map[string]json.GetRawMempoolVerboseResult, error) {
+	return map[string]json.GetRawMempoolVerboseResult{
+		"a": {Size: 200, Fee: 0.000001},
+		"b": {Size: 100, Fee: 0.00001},
+		"c": {Size: 100, Fee: 0.0001},
+	}, nil
+}
+func (c *fakeChain) GetBestBlock() (*chainhash.Hash, int32, error) {
+	h, err := chainhash.NewHashFromStr(hashOf(c.height))
+	return h, int32(c.height), err
+}
+func (c *fakeChain) GetBlockVerbose(
+	hash *chainhash.Hash) (*json.GetBlockVerboseResult, error) {
+	height := int64(hash[0]) | int64(hash[1])<<8
+	if height > c.height {
+		return nil, errors.New("no such block")
+	}
+	c.fetched++
+	// the genesis block has the zero hash before it
+	prev := chainhash.Hash{}.String()
+	if height > 0 {
+		prev = hashOf(height - 1)
+	}
+	return &json.GetBlockVerboseResult{Hash: hash.String(), PreviousHash: prev, Height: height, Time: 1000 + 60*height, PowAlgo: "sha256d"}, nil
+}
+// TestCollect ensures a snapshot has the recent blocks with their intervals, only fetching new blocks after the first, and the mempool in the fee histogram
+func TestCollect(
+	t *testing.T) {
+	c := &fakeChain{height: 5}
+	noWallet := errors.New("no wallet")
+	balance := func() (float64, error) { return 0, noWallet }
+	s := collect(c, balance, nil)
+	if s.Err != nil {
+		t.Fatal(s.Err)
+	}
+	if len(s.Blocks) != 6 || s.Blocks[0].Height != 5 || s.Blocks[5].Height != 0 {
+		t.Fatalf("got %d blocks, expected the 6 blocks of the chain latest first", len(s.Blocks))
+	}
+	if s.Blocks[0].Interval != 60 || s.Blocks[5].Interval != 0 {
+		t.Errorf("got intervals %d and %d, expected 60 and 0 for the genesis block", s.Blocks[0].Interval, s.Blocks[5].Interval)
+	}
+	if s.WalletErr != noWallet {
+		t.Errorf("got wallet error %v", s.WalletErr)
+	}
+	if s.Peers[0].ID != 1 {
+		t.Error("peers are not sorted by id")
+	}
+	c.height, c.fetched = 30, 0
+	s = collect(c, balance, s)
+	if len(s.Blocks) != recentBlocks+1 || s.Blocks[0].Height != 30 {
+		t.Fatalf("got %d blocks from %d, expected %d from 30", len(s.Blocks), s.Blocks[0].Height, recentBlocks+1)
+	}
+	if c.fetched != recentBlocks+1 {
+		t.Errorf("fetched %d blocks, expected %d", c.fetched, recentBlocks+1)
+	}
+	c.height, c.fetched = 31, 0
+	s = collect(c, balance, s)
+	if c.fetched != 1 || s.Blocks[1].Height != 30 || len(s.Blocks) != recentBlocks+1 {
+		t.Errorf("fetched %d blocks for one new block, expected only the new one", c.fetched)
+	}
+	if s.MempoolTxs != 3 || s.MempoolBytes != 400 {
+		t.Errorf("got mempool of %d transactions and %d bytes", s.MempoolTxs, s.MempoolBytes)
+	}
+	var counts []int
+	for _, b := range s.Fees {
+		counts = append(counts, b.Count)
+	}
+	if want := []int{1, 0, 0, 0, 1, 0, 0, 1, 0}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("got fee histogram %v, expected %v", counts, want)
+	}
+	want := []Difficulty{{"scrypt", 3}, {"sha256d", 2}}
+	if got := difficulties(s.Mining); !reflect.DeepEqual(got, want) {
+		t.Errorf("got difficulties %v, expected %v", got, want)
+	}
+}
@@ -0,0 +1,306 @@
+// Package top is a full screen terminal dashboard of a running node and wallet for operators without a desktop. It reads the node over its RPC websocket, refreshing when it is notified of new blocks and transactions and every few seconds for the peers.
+package top
+import (
+	js "encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+	"git.parallelcoin.io/dev/9/cmd/ctl"
+	"git.parallelcoin.io/dev/9/cmd/nine"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	rpcclient "git.parallelcoin.io/dev/9/pkg/rpc/client"
+	"git.parallelcoin.io/dev/9/pkg/util"
+	"git.parallelcoin.io/dev/9/pkg/util/tcell"
+	"git.parallelcoin.io/dev/9/pkg/util/tview"
+)
+// refreshInterval is how often the dashboard is refreshed without notifications
+const refreshInterval = 5 * time.Second
+// dashboard is the panes of the dashboard
+type dashboard struct {
+	header  *tview.TextView
+	mining  *tview.TextView
+	blocks  *tview.Table
+	peers   *tview.Table
+	mempool *tview.TextView
+	wallet  *tview.TextView
+	root    *tview.Flex
+}
+// Run shows the dashboard of the node and wallet configured in cfg until q or escape is pressed
+func Run(
+	cfg *nine.Config) int {
+	refresh := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case refresh <- struct{}{}:
+		default:
+		}
+	}
+	client, err := rpcclient.New(connConfig(cfg), &rpcclient.NotificationHandlers{
+		OnClientConnected: notify,
+		OnBlockConnected: func(*chainhash.Hash, int32, time.Time) {
+			notify()
+		},
+		OnBlockDisconnected: func(*chainhash.Hash, int32, time.Time) {
+			notify()
+		},
+		OnTxAccepted: func(*chainhash.Hash, util.Amount) {
+			notify()
+		},
+	})
+	if err != nil {
+		fmt.Println("cannot connect to the node at", *cfg.RPCConnect+":", err)
+		return 1
+	}
+	defer func() {
+		client.Shutdown()
+		client.WaitForShutdown()
+	}()
+	if err = client.NotifyBlocks(); err == nil {
+		err = client.NotifyNewTransactions(false)
+	}
+	if err != nil {
+		fmt.Println("cannot register for notifications from the node:", err)
+		return 1
+	}
+	balance := func() (b float64, err error) {
+		var result js.RawMessage
+		if result, err = ctl.Call(cfg, true, "getbalance"); err == nil {
+			err = js.Unmarshal(result, &b)
+		}
+		return
+	}
+	app := tview.NewApplication()
+	d := newDashboard()
+	app.SetRoot(d.root, true)
+	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
+			app.Stop()
+			return nil
+		}
+		return event
+	})
+	quit := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		var last *Snapshot
+		for {
+			s := collect(client, balance, last)
+			if s.Err == nil {
+				last = s
+			}
+			app.QueueUpdateDraw(func() {
+				d.show(s, *cfg.RPCConnect)
+			})
+			select {
+			case <-quit:
+				return
+			case <-refresh:
+			case <-ticker.C:
+			}
+		}
+	}()
+	err = app.Run()
+	close(quit)
+	if err != nil {
+		fmt.Println("cannot run the dashboard:", err)
+		return 1
+	}
+	return 0
+}
+// connConfig returns the websocket connection to the full node configured in cfg
+func connConfig(
+	cfg *nine.Config) *rpcclient.ConnConfig {
+	c := &rpcclient.ConnConfig{
+		Host:     *cfg.RPCConnect,
+		Endpoint: "ws",
+		User:     *cfg.Username,
+		Pass:     *cfg.Password,
+		TLS:      !*cfg.NoTLS,
+	}
+	if c.TLS {
+		for _, f := range []*string{cfg.RPCCert, cfg.CAFile} {
+			if f != nil && util.FileExists(*f) {
+				if pem, err := ioutil.ReadFile(*f); err == nil {
+					c.Certificates = append(c.Certificates, pem...)
+				}
+			}
+		}
+		if cfg.ClientCert != nil && cfg.ClientKey != nil &&
+			util.FileExists(*cfg.ClientCert) && util.FileExists(*cfg.ClientKey) {
+			c.ClientCert, _ = ioutil.ReadFile(*cfg.ClientCert)
+			c.ClientKey, _ = ioutil.ReadFile(*cfg.ClientKey)
+		}
+	}
+	return c
+}
+// newDashboard lays out the panes of the dashboard
+func newDashboard() (d *dashboard) {
+	d = &dashboard{
+		header:  tview.NewTextView().SetDynamicColors(true),
+		mining:  tview.NewTextView().SetDynamicColors(true),
+		blocks:  tview.NewTable().SetFixed(1, 0),
+		peers:   tview.NewTable().SetFixed(1, 0),
+		mempool: tview.NewTextView().SetDynamicColors(true),
+		wallet:  tview.NewTextView().SetDynamicColors(true),
+	}
+	d.mining.SetBorder(true).SetTitle(" mining ")
+	d.blocks.SetBorder(true).SetTitle(" recent blocks ")
+	d.peers.SetBorder(true).SetTitle(" peers ")
+	d.mempool.SetBorder(true).SetTitle(" mempool ")
+	d.wallet.SetBorder(true).SetTitle(" wallet ")
+	d.root = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(d.header, 1, 0, false).
+		AddItem(tview.NewFlex().
+			AddItem(d.mining, 36, 0, false).
+			AddItem(d.blocks, 0, 1, false), recentBlocks+3, 0, false).
+		AddItem(d.peers, 0, 1, false).
+		AddItem(tview.NewFlex().
+			AddItem(d.mempool, 0, 2, false).
+			AddItem(d.wallet, 0, 1, false), len(feeRates)+4, 0, false)
+	return
+}
+// show puts a snapshot into the panes
+func (d *dashboard) show(
+	s *Snapshot, node string) {
+	status := "[green]connected[-]"
+	if s.Err != nil {
+		status = "[red]" + tview.Escape(s.Err.Error()) + "[-]"
+	}
+	d.header.SetText(fmt.Sprintf(" [::b]9 top[::-] %s  height %d  %s  updated %s  (q to quit)",
+		node, s.Height, status, s.Time.Format("15:04:05")))
+	if s.Err != nil {
+		return
+	}
+	d.mining.SetText(miningText(s))
+	fillTable(d.blocks, []string{"height", "algo", "interval", "txs", "time", "hash"}, blockRows(s.Blocks))
+	fillTable(d.peers, []string{"id", "address", "dir", "version", "height", "ping ms", "ban score", "sent", "received"}, peerRows(s))
+	d.mempool.SetText(mempoolText(s))
+	if s.WalletErr != nil {
+		d.wallet.SetText("\n [yellow]no wallet:[-] " + tview.Escape(s.WalletErr.Error()))
+	} else {
+		d.wallet.SetText(fmt.Sprintf("\n balance [::b]%.8f[::-] DUO", s.Balance))
+	}
+}
+// fillTable replaces the rows of a table with a header and rows
+func fillTable(
+	t *tview.Table, header []string, rows [][]string) {
+	t.Clear()
+	for i, h := range header {
+		t.SetCell(0, i, tview.NewTableCell(h).SetAttributes(tcell.AttrBold).SetSelectable(false))
+	}
+	for r, row := range rows {
+		for i, x := range row {
+			cell := tview.NewTableCell(x)
+			if i > 0 {
+				cell.SetExpansion(1)
+			}
+			t.SetCell(r+1, i, cell)
+		}
+	}
+}
+// miningText is the difficulties of the algorithms and the hashrates of the network and the miner of the node
+func miningText(
+	s *Snapshot) string {
+	m := s.Mining
+	var b strings.Builder
+	fmt.Fprintf(&b, " network %s\n", hashrate(m.NetworkHashPS))
+	if m.Generate {
+		fmt.Fprintf(&b, " miner   %s %s\n", hashrate(m.HashesPerSec), m.GenAlgo)
+	} else {
+		b.WriteString(" miner   [gray]not generating[-]\n")
+	}
+	b.WriteString("\n [::b]difficulty[::-]\n")
+	for _, x := range difficulties(m) {
+		fmt.Fprintf(&b, " %-10s %.8g\n", x.Algo, x.Value)
+	}
+	return b.String()
+}
+// blockRows is the rows of the recent blocks pane
+func blockRows(
+	blocks []Block) (rows [][]string) {
+	for i, b := range blocks {
+		if i == recentBlocks {
+			break
+		}
+		interval := "-"
+		if b.Interval != 0 || i+1 < len(blocks) {
+			interval = (time.Duration(b.Interval) * time.Second).String()
+		}
+		rows = append(rows, []string{
+			fmt.Sprint(b.Height), b.Algo, interval, fmt.Sprint(b.Txs),
+			time.Unix(b.Time, 0).Format("15:04:05"), b.Hash,
+		})
+	}
+	return
+}
+// peerRows is the rows of the peers pane
+func peerRows(
+	s *Snapshot) (rows [][]string) {
+	for _, p := range s.Peers {
+		dir := "out"
+		if p.Inbound {
+			dir = "in"
+		}
+		height := p.CurrentHeight
+		if height == 0 {
+			height = p.StartingHeight
+		}
+		rows = append(rows, []string{
+			fmt.Sprint(p.ID), p.Addr, dir, p.SubVer, fmt.Sprint(height),
+			fmt.Sprintf("%.0f", p.PingTime/1000), fmt.Sprint(p.BanScore),
+			bytes(p.BytesSent), bytes(p.BytesRecv),
+		})
+	}
+	return
+}
+// mempoolText is the size of the mempool and a histogram of the fee rates of its transactions
+func mempoolText(
+	s *Snapshot) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, " %d transactions, %s\n", s.MempoolTxs, bytes(uint64(s.MempoolBytes)))
+	most := 0
+	for _, x := range s.Fees {
+		if x.Count > most {
+			most = x.Count
+		}
+	}
+	for i, x := range s.Fees {
+		label := fmt.Sprintf("%g+", x.Min)
+		if i+1 < len(s.Fees) {
+			label = fmt.Sprintf("%g-%g", x.Min, s.Fees[i+1].Min)
+		}
+		bar := 0
+		if most > 0 {
+			bar = x.Count * 40 / most
+		}
+		fmt.Fprintf(&b, " %8s sat/B %s %d\n", label, strings.Repeat("█", bar), x.Count)
+	}
+	return b.String()
+}
+// hashrate formats hashes per second with a unit
+func hashrate(
+	h int64) string {
+	units := []string{"H/s", "kH/s", "MH/s", "GH/s", "TH/s", "PH/s"}
+	f := float64(h)
+	i := 0
+	for ; f >= 1000 && i < len(units)-1; i++ {
+		f /= 1000
+	}
+	return fmt.Sprintf("%.2f %s", f, units[i])
+}
+// bytes formats a number of bytes with a unit
+func bytes(
+	n uint64) string {
+	units := []string{"B", "kB", "MB", "GB", "TB"}
+	f := float64(n)
+	i := 0
+	for ; f >= 1000 && i < len(units)-1; i++ {
+		f /= 1000
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d B", n)
+	}
+	return fmt.Sprintf("%.1f %s", f, units[i])
+}
@@ -7,8 +7,8 @@ import (
 	_ "net/http/pprof"
 	"sync"
 	"git.parallelcoin.io/dev/9/cmd/nine"
-	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	legacyrpc "git.parallelcoin.io/dev/9/pkg/rpc/legacy"
+	"git.parallelcoin.io/dev/9/pkg/util"
 	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
 	"git.parallelcoin.io/dev/9/pkg/util/interrupt"
 	"git.parallelcoin.io/dev/9/pkg/wallet"
@@ -21,15 +21,10 @@ var (
 // Instead, main runs this function and checks for a non-nil error, at point any defers have already run, and if the error is non-nil, the program can be exited with an error exit status.
 func Main(c *nine.Config, activeNet *nine.Params, path string) error {
 	// fmt.Println("wallet Main")
-	cfg = c
-	ActiveNet = activeNet
-	if ActiveNet.Name == "testnet" {
-		fork.IsTestnet = true
-	}
-	if cfg.Profile != nil {
+	if c.Profile != nil {
 		go func() {
 			listenAddr :=
-				net.JoinHostPort("127.0.0.1", fmt.Sprint(*cfg.Profile))
+				net.JoinHostPort("127.0.0.1", fmt.Sprint(*c.Profile))
 			log <- cl.Info{"profile server listening on", listenAddr}
 			profileRedirect := http.RedirectHandler("/debug/pprof",
 				http.StatusSeeOther)
@@ -37,6 +32,24 @@ func Main(c *nine.Config, activeNet *nine.Params, path string) error {
 			log <- cl.Error{http.ListenAndServe(listenAddr, nil)}
 		}()
 	}
+	quit := make(chan struct{})
+	stopped := make(chan struct{})
+	defer close(stopped)
+	// The wallet server is stopped when an interrupt is signaled or requested, and the interrupt handlers added before it wait for it to stop.
+	interrupt.AddHandler(func() {
+		close(quit)
+		<-stopped
+	})
+	if err := Run(c, activeNet, path, make(chan struct{}), quit); err != nil {
+		return err
+	}
+	log <- cl.Inf("shutdown complete")
+	return nil
+}
+// Run runs the wallet server until quit is closed, closing started once its RPC servers are listening and the wallet is opened. The RPC servers are stopped and the wallet is unloaded before it returns, so it can be run again.
+func Run(c *nine.Config, activeNet *nine.Params, path string, started chan struct{}, quit <-chan struct{}) error {
+	cfg = c
+	ActiveNet = activeNet
 	// dbDir := NetworkDir(path, activeNet.Params)
 	log <- cl.Debug{"dbDir", path, *cfg.DataDir, *cfg.DataDir, activeNet.Params.Name}
 	loader := wallet.NewLoader(activeNet.Params, path, 250)
@@ -51,11 +64,34 @@ func Main(c *nine.Config, activeNet *nine.Params, path string) error {
 		}
 		return err
 	}
+	// Shutdown the various process components before returning, in the
+	// reverse order they are started in so the wallet is closed last.
+	defer func() {
+		if legacyRPCServer != nil {
+			log <- cl.Wrn("stopping legacy RPC server...")
+			legacyRPCServer.Stop()
+			log <- cl.Inf("legacy RPC server shutdown")
+		}
+		if rpcs != nil {
+			// TODO: Does this need to wait for the grpc server to
+			// finish up any requests?
+			log <- cl.Wrn("stopping RPC server...")
+			rpcs.Stop()
+			log <- cl.Inf("RPC server shutdown")
+		}
+		log <- cl.Trc("unloading wallet")
+		err := loader.UnloadWallet()
+		if err != nil && err != wallet.ErrNotLoaded {
+			log <- cl.Error{
+				"failed to close wallet:", err,
+			}
+		}
+	}()
 	// Create and start chain RPC client so it's ready to connect to
 	// the wallet when loaded later.
 	if !*cfg.NoInitialLoad {
 		log <- cl.Trc("starting rpcClientConnectLoop")
-		go rpcClientConnectLoop(legacyRPCServer, loader)
+		go rpcClientConnectLoop(legacyRPCServer, loader, quit)
 	}
 	loader.RunAfterLoad(func(w *wallet.Wallet) {
 		log <- cl.Trc("starting startWalletRPCServices")
@@ -76,41 +112,17 @@ func Main(c *nine.Config, activeNet *nine.Params, path string) error {
 			return err
 		}
 	}
-	log <- cl.Trc("adding interrupt handler to unload wallet")
-	// Add interrupt handlers to shutdown the various process components
-	// before exiting.  Interrupt handlers run in LIFO order, so the wallet
-	// (which should be closed last) is added first.
-	interrupt.AddHandler(func() {
-		err := loader.UnloadWallet()
-		if err != nil && err != wallet.ErrNotLoaded {
-			log <- cl.Error{
-				"failed to close wallet:", err,
-			}
-		}
-	})
-	if rpcs != nil {
-		log <- cl.Trc("starting rpc server")
-		interrupt.AddHandler(func() {
-			// TODO: Does this need to wait for the grpc server to
-			// finish up any requests?
-			log <- cl.Wrn("stopping RPC server...")
-			rpcs.Stop()
-			log <- cl.Inf("RPC server shutdown")
-		})
-	}
 	if legacyRPCServer != nil {
-		interrupt.AddHandler(func() {
-			log <- cl.Wrn("stopping legacy RPC server...")
-			legacyRPCServer.Stop()
-			log <- cl.Inf("legacy RPC server shutdown")
-		})
 		go func() {
-			<-legacyRPCServer.RequestProcessShutdown()
-			interrupt.Request()
+			select {
+			case <-legacyRPCServer.RequestProcessShutdown():
+				interrupt.Request()
+			case <-quit:
+			}
 		}()
 	}
-	<-interrupt.HandlersDone
-	log <- cl.Inf("shutdown complete")
+	close(started)
+	<-quit
 	return nil
 }
 func readCAFile() []byte {
@@ -132,6 +144,24 @@ func readCAFile() []byte {
 	}
 	return certs
 }
+// readClientCert reads the certificate and key presented to a consensus RPC server that requires clients to have a certificate, which are nil if they are not found
+func readClientCert() (cert, key []byte) {
+	if *cfg.NoTLS || cfg.ClientCert == nil || cfg.ClientKey == nil {
+		return
+	}
+	if !util.FileExists(*cfg.ClientCert) || !util.FileExists(*cfg.ClientKey) {
+		return
+	}
+	var err error
+	if cert, err = ioutil.ReadFile(*cfg.ClientCert); err == nil {
+		key, err = ioutil.ReadFile(*cfg.ClientKey)
+	}
+	if err != nil {
+		log <- cl.Warn{"cannot open client certificate:", err}
+		return nil, nil
+	}
+	return
+}
 // rpcClientConnectLoop continuously attempts a connection to the consensus RPC server.
 // When a connection is established, the client is used to sync the loaded wallet, either
 // immediately or when loaded at a later time.
@@ -139,12 +169,18 @@ func readCAFile() []byte {
 // The legacy RPC is optional.  If set, the connected RPC client will be
 // associated with the server for RPC passthrough and to enable additional
 // methods.
-func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Loader) {
+func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Loader, quit <-chan struct{}) {
 	var certs []byte
 	// if !cfg.UseSPV {
 	certs = readCAFile()
 	// }
 	for {
+		// Do not attempt a connection once the wallet server is stopped.
+		select {
+		case <-quit:
+			return
+		default:
+		}
 		var (
 			chainClient chain.Interface
 			err         error
@@ -208,10 +244,25 @@ func rpcClientConnectLoop(legacyRPCServer *legacyrpc.Server, loader *wallet.Load
 				associate(w)
 			}
 		})
+		// The client is stopped with the wallet server whether or not it was associated with a wallet.
+		disconnected := make(chan struct{})
+		go func() {
+			select {
+			case <-quit:
+				chainClient.Stop()
+			case <-disconnected:
+			}
+		}()
 		chainClient.WaitForShutdown()
+		close(disconnected)
 		mu.Lock()
 		associateRPCClient = nil
 		mu.Unlock()
+		select {
+		case <-quit:
+			return
+		default:
+		}
 		loadedWallet, ok := loader.LoadedWallet()
 		if ok {
 			// Do not attempt a reconnect when the wallet was explicitly stopped.
@@ -236,12 +287,15 @@ func startChainRPC(certs []byte) (*chain.RPCClient, error) {
 	// 	*cfg.RPCConnect, fmt.Sprint(!*cfg.NoTLS),
 	// }
 	// spew.Dump(cfg)
+	clientCert, clientKey := readClientCert()
 	rpcc, err := chain.NewRPCClient(
 		ActiveNet.Params,
 		*cfg.RPCConnect,
 		*cfg.Username,
 		*cfg.Password,
 		certs,
+		clientCert,
+		clientKey,
 		!*cfg.NoTLS, 0)
 	if err != nil {
 		return nil, err
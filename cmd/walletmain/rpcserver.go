@@ -179,6 +179,14 @@ func startRPCServers(
 			MinVersion:   tls.VersionTLS12,
 			NextProtos:   []string{"h2"}, // HTTP/2 over TLS
 		}
+		if cfg.ClientAuth != nil && *cfg.ClientAuth {
+			// Only clients with a certificate issued by the certificate authority may connect.
+			tlsConfig.ClientCAs, err = util.LoadCertPool(*cfg.CAFile)
+			if err != nil {
+				return nil, nil, err
+			}
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
 		legacyListen = func(net string, laddr string) (net.Listener, error) {
 			return tls.Listen(net, laddr, tlsConfig)
 		}
@@ -188,7 +196,7 @@ func startRPCServers(
 				err := errors.New("failed to create listeners for RPC server")
 				return nil, nil, err
 			}
-			creds := credentials.NewServerTLSFromCert(&keyPair)
+			creds := credentials.NewTLS(tlsConfig)
 			server = grpc.NewServer(grpc.Creds(creds))
 			rpcserver.StartVersionService(server)
 			rpcserver.StartWalletLoaderService(server, walletLoader, ActiveNet)
@@ -8,10 +8,13 @@ import (
 	"git.parallelcoin.io/dev/9/cmd/nine"
 	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	"git.parallelcoin.io/dev/9/pkg/util"
 	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+	"git.parallelcoin.io/dev/9/pkg/util/hdkeychain"
 	"git.parallelcoin.io/dev/9/pkg/util/legacy/keystore"
 	"git.parallelcoin.io/dev/9/pkg/util/prompt"
 	"git.parallelcoin.io/dev/9/pkg/wallet"
+	waddrmgr "git.parallelcoin.io/dev/9/pkg/wallet/addrmgr"
 	walletdb "git.parallelcoin.io/dev/9/pkg/wallet/db"
 	_ "git.parallelcoin.io/dev/9/pkg/wallet/db/bdb"
 )
@@ -147,6 +150,21 @@ func CreateWallet(cfg *nine.Config, activeNet *nine.Params, path string) error {
 	log <- cl.Dbg("The wallet has been created successfully.")
 	return nil
 }
+// CreateTestWallet generates a wallet with a random seed without prompting at the provided path, for the nodes of a local test network, and returns its first receiving address.
+func CreateTestWallet(
+	activeNet *nine.Params, path string, pubPass, privPass []byte) (util.Address, error) {
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.RecommendedSeedLen)
+	if err != nil {
+		return nil, err
+	}
+	loader := wallet.NewLoader(activeNet.Params, path, 250)
+	w, err := loader.CreateNewWallet(pubPass, privPass, seed, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer loader.UnloadWallet()
+	return w.NextExternalAddress(0, waddrmgr.KeyScopeBIP0044)
+}
 // NetworkDir returns the directory name of a network directory to hold wallet files.
 func NetworkDir(
 	dataDir string, chainParams *chaincfg.Params) string {
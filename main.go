@@ -60,25 +60,6 @@ var nineApp = func() *def.App {
 			Precs("help"),
 			Handler(Conf),
 		),
-		// Cmd("new",
-		// 	Pattern("^(N|new)$"),
-		// 	Short("create new configuration with optional basename and count for testnets"),
-		// 	Detail(`	<word> is the basename for the data directories
-		// <integer> is the number of numbered data directories to create`),
-		// 	Opts("word", "integer"),
-		// 	Precs("help"),
-		// 	Handler(app.New),
-		// ),
-		// Cmd("copy",
-		// 	Pattern("^(cp|copy)$"),
-		// 	Short("create a set of testnet configurations based on a datadir"),
-		// 	Detail(`	<datadir> is the base to work from
-		// <word> is a basename
-		// <integer> is a number for how many to create`),
-		// 	Opts("datadir", "word", "integer"),
-		// 	Precs("help"),
-		// 	Handler(Copy),
-		// ),
 		Cmd("list",
 			Pattern("^(l|list|listcommands)$"),
 			Short("lists commands available at the RPC endpoint"),
@@ -97,7 +78,9 @@ var nineApp = func() *def.App {
 		<node> indicates we are connecting to a full node RPC (overrides wallet and is default)
 		<wallet> indicates we are connecting to a wallet RPC
 		<word>, <float> and <integer> just cover the items that follow in RPC
-		commands the RPC command is expected to be everything after the ctl keyword`),
+		commands the RPC command is expected to be everything after the ctl keyword
+		without a command an interactive console is opened with completion of commands
+		and parameter names, a history and switching between node and wallet servers`),
 			Opts("datadir", "node", "wallet", "word", "integer", "float"),
 			Precs("help", "list"),
 			Handler(Ctl),
@@ -105,7 +88,8 @@ var nineApp = func() *def.App {
 		Cmd("node",
 			Pattern("^(n|node)$"),
 			Short("runs a full node"),
-			Detail(`	<datadir> sets the data directory to read configuration and store data`),
+			Detail(`	<datadir> sets the data directory to read configuration and store data
+	Stratum miners are served on the addresses of stratum.listen, getstratuminfo shows them`),
 			Opts("datadir"),
 			Precs("help", "ctl"),
 			Handler(Node),
@@ -123,7 +107,9 @@ var nineApp = func() *def.App {
 			Pattern("^(s|shell)$"),
 			Short("runs a combined node/wallet server"),
 			Detail(`	<datadir> sets the data directory to read configuration and store data
-		<create> runs the wallet create prompt`),
+		<create> runs the wallet create prompt
+		the node, wallet server, CPU miner, miner controller and Stratum server run as
+		services that are restarted when they fail and stopped in order, getservicestatus shows them`),
 			Opts("datadir", "create"),
 			Precs("help"),
 			Handler(Shell),
@@ -131,7 +117,9 @@ var nineApp = func() *def.App {
 		Cmd("mine",
 			Pattern("^(m|mine)$"),
 			Short("run the standalone miner"),
-			Detail(``),
+			Detail(`	connects to the mining dispatcher at mining.controller
+	using mining.pass, and mines with mining.genthreads threads
+	using mining.algo, switching algorithm every mining.switch`),
 			Opts("datadir"),
 			Precs("help"),
 			Handler(Mine),
@@ -139,51 +127,80 @@ var nineApp = func() *def.App {
 		Cmd("gui",
 			Pattern("(^g|gui)$"),
 			Short("run the GUI wallet"),
-			Detail(``),
+			Detail(`	<datadir> sets the data directory to read configuration and store data
+	runs the shell and serves the GUI wallet on gui.listen, opening it in the web browser`),
 			Opts("datadir"),
 			Precs("help"),
 			Handler(GUI),
 		),
-		// Cmd("test",
-		// 	Pattern("^(t|test)$"),
-		// 	Short("run multiple full nodes from given <datadir> logging optionally to <datadir>"),
-		// 	Detail(`	<datadir> indicates the basename to search for as the path to the test configurations
-		// 		<log> indicates to write logs to the individual data directories instead of print to stdout`),
-		// 	Opts("word", "log"),
-		// 	Precs("help"),
-		// 	Handler(TestHandler),
-		// ),
+		Cmd("top",
+			Pattern("^(t|top)$"),
+			Short("full screen dashboard of a running node and wallet"),
+			Detail(`	<datadir> sets the data directory to read the configuration from
+	connects to the node at rpc.connect over its websocket and the wallet at wallet.server
+	and shows the best block, the difficulty of each algorithm, the recent blocks, the
+	peers, the mempool and the wallet balance until q or escape is pressed`),
+			Opts("datadir"),
+			Precs("help"),
+			Handler(Top),
+		),
+		Cmd("testnet",
+			Pattern("^(testnet)$"),
+			Short("create or run a local test network of nodes connected to each other"),
+			Detail(`	create <basename> <integer> writes the configurations of <integer> nodes
+		into the data directories <basename>/1 to <basename>/<integer>, each with its
+		own ports, a new wallet to mine to and connecting only to the others
+	run <basename> runs all the nodes in <basename> as shells in one supervisor
+		with their output prefixed with the number of the node`),
+			Opts("create", "datadir", "integer"),
+			Precs("help"),
+			Handler(Testnet),
+		),
+		Cmd("config",
+			Pattern("^(config)$"),
+			Short("read and change the configuration file non-interactively"),
+			Detail(`	get <group.name> prints the value of a row, lists separated by commas
+	set <group.name> <value> validates and saves the value of a row
+	unset <group.name> puts the default value of a row back
+	diff prints the rows whose values are not their defaults, with the value and
+		default separated by tabs
+	validate lists rows in the file that don't exist and values that are not valid
+	<datadir> before config sets the data directory of the configuration file`),
+			Opts("datadir"),
+			Precs("help"),
+			Rest(),
+			Handler(Config),
+		),
 		Cmd("create",
 			Pattern("^(cr|create)$"),
 			Short("runs the create new wallet prompt"),
 			Detail(`	<datadir> sets the data directory where the wallet will be stored`),
 			Opts("datadir"),
-			Precs("wallet", "shell", "help"),
+			Precs("wallet", "shell", "help", "testnet"),
 			Handler(Create),
 		),
 		Cmd("gencerts",
 			Pattern("^(gencerts)$"),
-			Short("generate a number of TLS key pairs for nodes"),
-			Detail(`	<word> sets the name of the CA signing key file to use
-		<integer> sets the number of keys to generate (append a number to the filename)`),
-			Opts("word", "integer"),
+			Short("generate node, wallet and rpc client TLS key pairs signed by the certification authority"),
+			Detail(`	<datadir> sets the data directory holding the certification authority made by genca, where the key pairs are written`),
+			Opts("datadir"),
 			Precs("help"),
-			Handler(func(args []string, tokens def.Tokens, app *def.App) int { return 0 }),
+			Handler(GenCerts),
 		),
 		Cmd("genca",
 			Pattern("^genca$"),
 			Short("generate TLS certification authority key pair"),
-			Detail(`	<word> sets the name of the CA signing key file to output`),
-			Opts("word"),
+			Detail(`	<datadir> sets the data directory where the signing key is written, the certificate is written to tls.cafile`),
+			Opts("datadir"),
 			Precs("help"),
-			Handler(func(args []string, tokens def.Tokens, app *def.App) int { return 0 }),
+			Handler(GenCA),
 		),
 		Cmd("log",
 			Pattern("^(L|log)$"),
 			Short("write to log in <datadir> file instead of printing to stderr"),
 			Detail(`	<datadir> sets the data directory where the wallet will be stored`),
 			Opts(),
-			Precs("help", "node", "wallet", "shell", "test"),
+			Precs("help", "node", "wallet", "shell", "testnet"),
 			Handler(func(args []string, tokens def.Tokens, app *def.App) int { return 0 }),
 		),
 		Cmd("datadir",
@@ -191,7 +208,7 @@ var nineApp = func() *def.App {
 			Short("directory to look for configuration or write logs etc"),
 			Detail(`	<datadir> sets the data directory where the wallet will be stored`),
 			Opts(),
-			Precs("help", "node", "ctl", "wallet", "conf", "test", "new", "copy", "shell", "create"),
+			Precs("help", "node", "ctl", "wallet", "conf", "shell", "gui", "top", "create", "gencerts", "genca", "testnet", "config"),
 			Handler(func(args []string, tokens def.Tokens, app *def.App) int { return 0 }),
 		),
 		Cmd("integer",
@@ -199,7 +216,7 @@ var nineApp = func() *def.App {
 			Short("number of items to create"),
 			Detail(""),
 			Opts(),
-			Precs("help"),
+			Precs("help", "testnet"),
 			Handler(func(args []string, tokens def.Tokens, app *def.App) int { return 0 }),
 		),
 		Cmd("float",
@@ -302,6 +319,12 @@ var nineApp = func() *def.App {
 				Max(10000000),
 				Usage("max number of signatures to keep in memory"),
 			),
+			Int("powworkers",
+				Default(node.DefaultPowWorkers),
+				Min(-1),
+				Max(4096),
+				Usage("number of block proof of work hashes computed at once while syncing, -1 = all cores"),
+			),
 		), Group("limit",
 			Tag("pass",
 				RandomString(32),
@@ -322,6 +345,22 @@ var nineApp = func() *def.App {
 			Enable("nowrite",
 				Usage("disable writing to log file"),
 			),
+			Tag("format",
+				Default("text"),
+				Usage("format of the log lines, text or json for a JSON object on each line"),
+			),
+			Tags("file",
+				Usage("[subsystem:]path ... files the log of a subsystem, or of all without one, is also written to, relative to the data directory"),
+			),
+			Int("maxsize",
+				Default(10),
+				Min(0),
+				Usage("size in megabytes a log file is rotated at, 0 = never rotated"),
+			),
+			Duration("maxage",
+				Default(7*24*time.Hour),
+				Usage("age rotated log files are deleted at, 0 = never deleted"),
+			),
 		), Group("mining",
 			Tags("addresses",
 				Usage("set mining addresses, space separated"),
@@ -343,9 +382,13 @@ var nineApp = func() *def.App {
 				Max(4096),
 				Usage("set number of threads, -1 = all"),
 			),
-			Addrs("listener", 11045,
+			Addr("listener", 11045,
 				Usage("set listener address for mining dispatcher"),
 			),
+			Addr("controller", 11045,
+				Default("127.0.0.1:11045"),
+				Usage("address of mining dispatcher the standalone miner gets work from"),
+			),
 			Tag("pass",
 				RandomString(32),
 				Usage("password to secure mining dispatch connections"),
@@ -495,6 +538,21 @@ var nineApp = func() *def.App {
 				Usage("username for rpc services"),
 			),
 		),
+		Group("stratum",
+			Tags("listen",
+				Usage("[algorithm:]address ... addresses to serve stratum miners on, port 11044 if none is given, with the algorithm of the miners on it or chosen in mining.subscribe without one"),
+			),
+			Tags("difficulty",
+				Usage("[algorithm:]difficulty ... starting share difficulty of the miners of an algorithm, or of all without one"),
+			),
+			Duration("sharetime",
+				Default(time.Second*10),
+				Usage("time between shares the share difficulty of each miner is adjusted towards, 0 = fixed difficulty"),
+			),
+			Tag("pass",
+				Usage("password stratum workers authorize with, none = any"),
+			),
+		),
 		Group("tls",
 			File("key",
 				Default("tls.key"),
@@ -520,6 +578,17 @@ var nineApp = func() *def.App {
 			Enable("skipverify",
 				Usage("skip verifying tls certificates with CAFile"),
 			),
+			Enable("clientauth",
+				Usage("require rpc clients to present a certificate issued by the certificate authority in CAFile"),
+			),
+			File("clientcert",
+				Default("client.cert"),
+				Usage("file containing the tls certificate presented to rpc servers that require one"),
+			),
+			File("clientkey",
+				Default("client.key"),
+				Usage("file containing the tls key of the client certificate"),
+			),
 		),
 		Group("wallet",
 			Addr("server", 11046,
@@ -537,5 +606,14 @@ var nineApp = func() *def.App {
 				Usage("use configured wallet rpc instead of full node"),
 			),
 		),
+		Group("gui",
+			Addr("listen", 11049,
+				Default("127.0.0.1:11049"),
+				Usage("loopback address the GUI wallet serves its pages on"),
+			),
+			Enable("nobrowser",
+				Usage("don't open the GUI wallet in the web browser, only print its address"),
+			),
+		),
 	)
 }
@@ -34,7 +34,7 @@ func (b *BlockChain) maybeAcceptBlock(block *util.Block, flags BehaviorFlags) (b
 		var i int64
 		pn = prevNode
 		for ; i < b.chainParams.AveragingInterval-1; i++ {
-			pn = pn.GetLastWithAlgo(a)
+			pn = pn.GetLastWithAlgo(a, b.chainParams)
 			if pn == nil {
 				break
 			}
@@ -50,14 +50,19 @@ func (b *BlockChain) maybeAcceptBlock(block *util.Block, flags BehaviorFlags) (b
 	}
 	// Insert the block into the database if it's not already there.  Even though it is possible the block will ultimately fail to connect, it has already passed all proof-of-work and validity tests which means it would be prohibitively expensive for an attacker to fill up the disk with a bunch of blocks that fail to connect.  This is necessary since it allows block download to be decoupled from the much more expensive connection logic.  It also has some other nice properties such as making blocks that never become part of the main chain or blocks that fail to connect available for further analysis.
 	err = b.db.Update(func(dbTx database.Tx) error {
-		return dbStoreBlock(dbTx, block)
+		err := dbStoreBlock(dbTx, block)
+		if err != nil {
+			return err
+		}
+		// Keep the proof of work hash computed while checking the block so it is not computed again.
+		return b.powHashCache.store(dbTx, block.Hash(), blockHeight)
 	})
 	if err != nil {
 		return false, err
 	}
 	// Create a new block node for the block and add it to the node index. Even if the block ultimately gets connected to the main chain, it starts out on a side chain.
 	blockHeader := &block.MsgBlock().Header
-	newNode := newBlockNode(blockHeader, prevNode)
+	newNode := newBlockNode(blockHeader, prevNode, b.chainParams)
 	newNode.status = statusDataStored
 	b.Index.AddNode(newNode)
 	err = b.Index.flushToDB()
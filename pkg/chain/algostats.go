@@ -0,0 +1,96 @@
+package chain
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+)
+// AlgoStats are the statistics of the blocks of an algorithm up to a block of the main chain. They are for explorers and monitoring and play no part in consensus.
+type AlgoStats struct {
+	Algo    string
+	Version int32
+	// Bits is the target of the next block of the algorithm
+	Bits uint32
+	// Times are the seconds between the newest blocks of the algorithm, newest first
+	Times []int64
+	// Blocks is the number of the recent blocks of the main chain that are of the algorithm, and Share is their part of all the recent blocks
+	Blocks int32
+	Share  float64
+	// HashesPerSec is the number of hashes of the algorithm per second it takes on average to find its recent blocks in the time of all the recent blocks
+	HashesPerSec float64
+	// Divergence is the state of the Plan 9 averagers for the next block, which is only set when HasDivergence is true
+	Divergence    Plan9Divergence
+	HasDivergence bool
+}
+// CalcAlgoStats returns the statistics of every algorithm of the hard fork of the block after the given block of the main chain, in the order of their versions. The share of blocks and the hashes per second are of the window blocks ending with the given block, and each algorithm has the times between its newest times+1 blocks. This function is safe for concurrent access.
+func (b *BlockChain) CalcAlgoStats(
+	hash *chainhash.Hash, window int32, times int) (stats []AlgoStats, err error) {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+	node := b.Index.LookupNode(hash)
+	if node == nil || !b.bestChain.Contains(node) {
+		str := fmt.Sprintf("CalcAlgoStats: block %s is not in the main chain", hash)
+		return nil, errNotInMainChain(str)
+	}
+	return b.algoStats(node, window, times), nil
+}
+// algoStats returns the statistics of every algorithm for the block after tip
+func (b *BlockChain) algoStats(
+	tip *blockNode, window int32, times int) (stats []AlgoStats) {
+	nH := tip.height + 1
+	hf := fork.Get(b.chainParams, nH)
+	var versions []int
+	for v := range hf.AlgoVers {
+		versions = append(versions, int(v))
+	}
+	sort.Ints(versions)
+	first := tip.Ancestor(0)
+	if tip.height > window {
+		first = tip.Ancestor(tip.height - window)
+	}
+	// the first block only marks the start of the time of the window
+	blocks := make(map[string]int32)
+	hashes := make(map[string]*big.Int)
+	for node := tip; node != nil && node != first; node = node.parent {
+		algo := fork.GetAlgoName(b.chainParams, node.version, node.height)
+		if hashes[algo] == nil {
+			hashes[algo] = new(big.Int)
+		}
+		blocks[algo]++
+		hashes[algo].Add(hashes[algo], CalcHashes(node.bits))
+	}
+	span := tip.timestamp - first.timestamp
+	for _, v := range versions {
+		algo := hf.AlgoVers[int32(v)]
+		s := AlgoStats{
+			Algo:    algo,
+			Version: int32(v),
+			Blocks:  blocks[algo],
+			Times:   []int64{},
+		}
+		s.Bits, _, _ = b.nextRequiredDifficulty(tip, time.Now(), algo, false)
+		if tip.height > first.height {
+			s.Share = float64(s.Blocks) / float64(tip.height-first.height)
+		}
+		if hashes[algo] != nil && span > 0 {
+			s.HashesPerSec, _ = new(big.Float).Quo(new(big.Float).SetInt(hashes[algo]),
+				big.NewFloat(float64(span))).Float64()
+		}
+		// GetLastWithAlgo finds no blocks, so the chain is walked back by version, and the genesis block is skipped as its time is not a normal timestamp
+		var last *blockNode
+		for node := tip; len(s.Times) < times && node != nil && node.height > 0; node = node.parent {
+			if node.version != int32(v) {
+				continue
+			}
+			if last != nil {
+				s.Times = append(s.Times, last.timestamp-node.timestamp)
+			}
+			last = node
+		}
+		s.Divergence, s.HasDivergence = b.plan9Divergence(tip, algo)
+		stats = append(stats, s)
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package chain
+import (
+	"testing"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+)
+// TestAlgoStats ensures the statistics of the algorithms at the end of a recorded header sequence account for every recent block and agree with the difficulty and averagers of the next block
+func TestAlgoStats(
+	t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.HardForks = []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(plan9ActivationHeight),
+	}
+	b := &BlockChain{chainParams: &params}
+	headers, _, err := loadDifficultyCorpus("plan9-steady.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := newBlockNode(&headers[0], nil, b.chainParams)
+	for i := 1; i < len(headers); i++ {
+		headers[i].PrevBlock = node.hash
+		node = newBlockNode(&headers[i], node, b.chainParams)
+	}
+	stats := b.algoStats(node, 100, 5)
+	if len(stats) != 9 {
+		t.Fatalf("got %d algorithms, expected 9", len(stats))
+	}
+	var blocks int32
+	var share float64
+	for i, s := range stats {
+		if s.Version != int32(i) {
+			t.Errorf("algorithm %d has version %d", i, s.Version)
+		}
+		blocks += s.Blocks
+		share += s.Share
+		bits, err := b.calcNextRequiredDifficulty(node, node.Header().Timestamp, s.Algo, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Bits != bits {
+			t.Errorf("%s: got bits %08x, expected %08x", s.Algo, s.Bits, bits)
+		}
+		if len(s.Times) != 5 {
+			t.Errorf("%s: got %d block times, expected 5", s.Algo, len(s.Times))
+		}
+		if s.Blocks > 0 && s.HashesPerSec <= 0 {
+			t.Errorf("%s: got %g hashes per second for %d blocks", s.Algo, s.HashesPerSec, s.Blocks)
+		}
+		if d, ok := b.plan9Divergence(node, s.Algo); s.HasDivergence != ok || d != s.Divergence {
+			t.Errorf("%s: got divergence %+v, expected %+v", s.Algo, s.Divergence, d)
+		}
+	}
+	if blocks != 100 || share < 0.999 || share > 1.001 {
+		t.Errorf("the algorithms have %d blocks and %g of the share of the last 100 blocks", blocks, share)
+	}
+}
@@ -62,7 +62,7 @@ type blockNode struct {
 }
 // initBlockNode initializes a block node from the given header and parent node, calculating the height and workSum from the respective fields on the parent. This function is NOT safe for concurrent access.  It must only be called when initially creating a node.
 func initBlockNode(
-	node *blockNode, blockHeader *wire.BlockHeader, parent *blockNode) {
+	node *blockNode, blockHeader *wire.BlockHeader, parent *blockNode, chainParams *chaincfg.Params) {
 	*node = blockNode{
 		hash:       blockHeader.BlockHash(),
 		version:    blockHeader.Version,
@@ -74,16 +74,16 @@ func initBlockNode(
 	if parent != nil {
 		node.parent = parent
 		node.height = parent.height + 1
-		node.workSum = CalcWork(blockHeader.Bits, node.height, node.version)
-		parent.workSum = CalcWork(parent.bits, parent.height, parent.version)
+		node.workSum = CalcWork(blockHeader.Bits, node.height, node.version, chainParams)
+		parent.workSum = CalcWork(parent.bits, parent.height, parent.version, chainParams)
 		node.workSum = node.workSum.Add(parent.workSum, node.workSum)
 	}
 }
 // newBlockNode returns a new block node for the given block header and parent node, calculating the height and workSum from the respective fields on the parent. This function is NOT safe for concurrent access.
 func newBlockNode(
-	blockHeader *wire.BlockHeader, parent *blockNode) *blockNode {
+	blockHeader *wire.BlockHeader, parent *blockNode, chainParams *chaincfg.Params) *blockNode {
 	var node blockNode
-	initBlockNode(&node, blockHeader, parent)
+	initBlockNode(&node, blockHeader, parent, chainParams)
 	return &node
 }
 // Header constructs a block header from the node and returns it. This function is safe for concurrent access.
@@ -145,6 +145,8 @@ type blockIndex struct {
 	sync.RWMutex
 	index map[chainhash.Hash]*blockNode
 	dirty map[*blockNode]struct{}
+	// tips are the nodes that no other node of the index builds on, the ends of the main chain and of every side chain.
+	tips map[*blockNode]struct{}
 }
 // newBlockIndex returns a new empty instance of a block index.  The index will be dynamically populated as block nodes are loaded from the database and manually added.
 func newBlockIndex(
@@ -154,6 +156,7 @@ func newBlockIndex(
 		chainParams: chainParams,
 		index:       make(map[chainhash.Hash]*blockNode),
 		dirty:       make(map[*blockNode]struct{}),
+		tips:        make(map[*blockNode]struct{}),
 	}
 }
 // HaveBlock returns whether or not the block index contains the provided hash. This function is safe for concurrent access.
@@ -180,6 +183,41 @@ func (bi *blockIndex) AddNode(node *blockNode) {
 // addNode adds the provided node to the block index, but does not mark it as dirty. This can be used while initializing the block index. This function is NOT safe for concurrent access.
 func (bi *blockIndex) addNode(node *blockNode) {
 	bi.index[node.hash] = node
+	if node.parent != nil {
+		delete(bi.tips, node.parent)
+	}
+	bi.tips[node] = struct{}{}
+}
+// Tips returns the nodes that no other node of the index builds on, in no particular order. This function is safe for concurrent access.
+func (bi *blockIndex) Tips() []*blockNode {
+	bi.RLock()
+	tips := make([]*blockNode, 0, len(bi.tips))
+	for node := range bi.tips {
+		tips = append(tips, node)
+	}
+	bi.RUnlock()
+	return tips
+}
+// Descendants returns every node of the index that builds on the given node, in no particular order. This function is safe for concurrent access.
+func (bi *blockIndex) Descendants(node *blockNode) []*blockNode {
+	bi.RLock()
+	defer bi.RUnlock()
+	var descendants []*blockNode
+	seen := make(map[*blockNode]struct{})
+	for tip := range bi.tips {
+		if tip.height <= node.height || tip.Ancestor(node.height) != node {
+			continue
+		}
+		// Branches share the nodes below where they split, so stop at the first one already collected.
+		for n := tip; n != node; n = n.parent {
+			if _, ok := seen[n]; ok {
+				break
+			}
+			seen[n] = struct{}{}
+			descendants = append(descendants, n)
+		}
+	}
+	return descendants
 }
 // NodeStatus provides concurrent-safe access to the status field of a node. This function is safe for concurrent access.
 func (bi *blockIndex) NodeStatus(node *blockNode) blockStatus {
@@ -232,12 +270,12 @@ func (bi *blockIndex) flushToDB() error {
 func (node *blockNode) GetAlgo() int32 {
 	return node.version
 }
-// GetLastWithAlgo returns the newest block from node with specified algo
-func (node *blockNode) GetLastWithAlgo(algo int32) (prev *blockNode) {
+// GetLastWithAlgo returns the newest block from node with specified algo, by the hard fork schedule of the given chain parameters
+func (node *blockNode) GetLastWithAlgo(algo int32, chainParams *chaincfg.Params) (prev *blockNode) {
 	if prev == nil {
 		return nil
 	}
-	if fork.GetCurrent(prev.height) == 0 {
+	if fork.GetCurrent(chainParams, prev.height) == 0 {
 		if algo != 514 &&
 			algo != 2 {
 			log <- cl.Debug{"irregular version block, assuming 2 (sha256d)"}
@@ -251,7 +289,7 @@ func (node *blockNode) GetLastWithAlgo(algo int32) (prev *blockNode) {
 		}
 		// log <- cl.Debugf{"node %d %d %8x",prev.height, prev.version, prev.bits}
 		prevversion := prev.version
-		if fork.GetCurrent(prev.height) == 0 {
+		if fork.GetCurrent(chainParams, prev.height) == 0 {
 			if prev.version != 514 &&
 				prev.version != 2 {
 				log <- cl.Debug{"irregular version block, assuming 2 (sha256d)"}
@@ -66,6 +66,8 @@ type BlockChain struct {
 	sigCache            *txscript.SigCache
 	indexManager        IndexManager
 	hashCache           *txscript.HashCache
+	powHashCache        *PowHashCache
+	powWorkers          chan struct{}
 	// The following fields are calculated based upon the provided chain parameters.  They are also set when the instance is created and can't be changed afterwards, so there is no need to protect them with
 	// a separate mutex.
 	minRetargetTimespan int64 // target timespan / adjustment factor
@@ -86,6 +88,8 @@ type BlockChain struct {
 	// These fields are related to checkpoint handling.  They are protected by the chain lock.
 	nextCheckpoint *chaincfg.Checkpoint
 	checkpointNode *blockNode
+	// autoCheckpoint is the latest block of the main chain that has become a checkpoint by being deep and old enough, nil if there is none yet.
+	autoCheckpoint *blockNode
 	// The state is used as a fairly efficient way to cache information about the current best chain state that is returned to callers when requested.  It operates on the principle of MVCC such that any time a new block becomes the best block, the state pointer is replaced with a new struct and the old state is left untouched.  In this way, multiple callers can be pointing to different best chain states. This is acceptable for most callers because the state is only being queried at a specific point in time. In addition, some of the fields are stored in the database so the chain state can be quickly reconstructed on load.
 	stateLock     sync.RWMutex
 	stateSnapshot *BestState
@@ -103,8 +107,9 @@ type BlockChain struct {
 	// The notifications field stores a slice of callbacks to be executed on certain blockchain events.
 	notificationsLock sync.RWMutex
 	notifications     []NotificationCallback
-	// DifficultyAdjustments keeps track of the latest difficulty adjustment for each algorithm
-	DifficultyAdjustments map[string]float64
+	// difficultyAdjustments keeps track of the latest difficulty adjustment for each algorithm, which miners use to scale their work
+	adjustmentsLock       sync.RWMutex
+	difficultyAdjustments map[string]float64
 }
 // HaveBlock returns whether or not the chain instance has the block represented by the passed hash.  This includes checking the various places a block can be like part of the main chain, on a side chain, or in the orphan pool. This function is safe for concurrent access.
 func (b *BlockChain) HaveBlock(hash *chainhash.Hash) (bool, error) {
@@ -368,6 +373,7 @@ func (b *BlockChain) connectBlock(node *blockNode, block *util.Block,
 	blockWeight := uint64(GetBlockWeight(block))
 	state := newBestState(node, blockSize, blockWeight, numTxns,
 		curTotalTxns+numTxns, node.CalcPastMedianTime())
+	autoCheckpoint := b.nextAutoCheckpoint(node)
 	// Atomically insert info into the database.
 	err = b.db.Update(func(dbTx database.Tx) error {
 		// update best block state.
@@ -376,6 +382,14 @@ func (b *BlockChain) connectBlock(node *blockNode, block *util.Block,
 			log <- cl.Trace{"dbPutBestState", err}
 			return err
 		}
+		// Store the new automatic checkpoint if the block made one.
+		if autoCheckpoint != nil {
+			err = dbPutAutoCheckpoint(dbTx, &autoCheckpoint.hash)
+			if err != nil {
+				log <- cl.Trace{"dbPutAutoCheckpoint", err}
+				return err
+			}
+		}
 		// Add the block hash and height to the block index which tracks the main chain.
 		err = dbPutBlockIndex(dbTx, block.Hash(), node.height)
 		if err != nil {
@@ -412,6 +426,14 @@ func (b *BlockChain) connectBlock(node *blockNode, block *util.Block,
 	view.commit()
 	// This node is now the end of the best chain.
 	b.bestChain.SetTip(node)
+	if autoCheckpoint != nil {
+		b.autoCheckpoint = autoCheckpoint
+		log <- cl.Infof{
+			"block %v at height %d is now an automatic checkpoint",
+			autoCheckpoint.hash,
+			autoCheckpoint.height,
+		}
+	}
 	// Update the state for the best block.  Notice how this replaces the entire struct instead of updating the existing one.  This effectively allows the old version to act as a snapshot which callers can use freely without needing to hold a lock for the duration.  See the comments on the state variable for more details.
 	b.stateLock.Lock()
 	b.stateSnapshot = state
@@ -785,7 +807,7 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *util.Block, flags
 			"fastAdd set in the side chain case? %v\n", block.Hash(),
 		}
 	}
-	node.workSum = CalcWork(node.bits, node.height, node.version)
+	node.workSum = CalcWork(node.bits, node.height, node.version, b.chainParams)
 	// We're extending (or creating) a side chain, but the cumulative work for this new side chain is not enough to make it the new chain.
 	if node.workSum.Cmp(b.bestChain.Tip().workSum) <= 0 {
 		// Log information about how the block is forking the chain.
@@ -811,6 +833,13 @@ func (b *BlockChain) connectBestChain(node *blockNode, block *util.Block, flags
 		return false, nil
 	}
 	// We're extending (or creating) a side chain and the cumulative work for this new side chain is more than the old best chain, so this side chain needs to become the main chain.  In order to accomplish that, find the common ancestor of both sides of the fork, disconnect the blocks that form the (now) old fork from the main chain, and attach the blocks that form the new chain to the main chain starting at the common ancenstor (the point where the chain forked).
+	// Blocks at or below the automatic checkpoint are never disconnected.
+	if b.forksBeforeAutoCheckpoint(node) {
+		str := fmt.Sprintf("block %v would reorganize the main chain "+
+			"before the automatic checkpoint at height %d",
+			node.hash, b.autoCheckpoint.height)
+		return false, ruleError(ErrForkTooOld, str)
+	}
 	detachNodes, attachNodes := b.getReorganizeNodes(node)
 	// Reorganize the chain.
 	log <- cl.Infof{
@@ -1115,6 +1144,10 @@ type Config struct {
 	IndexManager IndexManager
 	// HashCache defines a transaction hash mid-state cache to use when validating transactions. This cache has the potential to greatly speed up transaction validation as re-using the pre-calculated mid-state eliminates the O(N^2) validation complexity due to the SigHashAll flag. This field can be nil if the caller is not interested in using a signature cache.
 	HashCache *txscript.HashCache
+	// PowHashCacheSize is the number of block proof of work hashes kept in memory, in front of those stored in the database. DefaultPowHashCacheSize is used if it is zero.
+	PowHashCacheSize int
+	// PowWorkers is the number of proof of work hashes of headers and blocks that are computed at once ahead of block processing. All cores are used if it is zero or less.
+	PowWorkers int
 }
 // New returns a BlockChain instance using the provided configuration details.
 func New(
@@ -1148,6 +1181,10 @@ func New(
 	targetTimespan := int64(params.TargetTimespan)
 	targetTimePerBlock := int64(params.TargetTimePerBlock)
 	adjustmentFactor := params.RetargetAdjustmentFactor
+	powHashCacheSize := config.PowHashCacheSize
+	if powHashCacheSize == 0 {
+		powHashCacheSize = DefaultPowHashCacheSize
+	}
 	b := BlockChain{
 		checkpoints:           config.Checkpoints,
 		checkpointsByHeight:   checkpointsByHeight,
@@ -1161,12 +1198,14 @@ func New(
 		blocksPerRetarget:     int32(targetTimespan / targetTimePerBlock),
 		Index:                 newBlockIndex(config.DB, params),
 		hashCache:             config.HashCache,
+		powHashCache:          NewPowHashCache(powHashCacheSize, config.DB),
+		powWorkers:            newPowWorkers(config.PowWorkers),
 		bestChain:             newChainView(nil),
 		orphans:               make(map[chainhash.Hash]*orphanBlock),
 		prevOrphans:           make(map[chainhash.Hash][]*orphanBlock),
 		warningCaches:         newThresholdCaches(vbNumBits),
 		deploymentCaches:      newThresholdCaches(chaincfg.DefinedDeployments),
-		DifficultyAdjustments: make(map[string]float64),
+		difficultyAdjustments: make(map[string]float64),
 	}
 	// Initialize the chain state from the passed database.  When the db does not yet contain any chain state, both it and the chain state will be initialized to contain only the genesis block.
 	if err := b.initChainState(); err != nil {
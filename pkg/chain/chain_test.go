@@ -797,3 +797,71 @@ func TestIntervalBlockHashes(
 		}
 	}
 }
+// TestAutoCheckpoint ensures blocks become automatic checkpoints once they are deep and old enough, and that chains forking before the automatic checkpoint are refused.
+func TestAutoCheckpoint(
+	t *testing.T) {
+	// Construct a synthetic block chain with a block index consisting of the following structure.
+	// 	genesis -> 1 -> 2 -> ... -> 29 -> 30 -> 31 -> ... -> 42 (recent)
+	// 	                   \-> 4a    \-> 30a
+	tip := tstTip
+	params := chaincfg.RegressionNetParams
+	params.AutoCheckpointDepth = 10
+	params.AutoCheckpointAge = time.Hour
+	chain := newFakeChain(&params)
+	branch0Nodes := chainedNodes(chain.bestChain.Genesis(), 30)
+	for _, node := range branch0Nodes {
+		chain.Index.AddNode(node)
+		chain.bestChain.SetTip(node)
+		if checkpoint := chain.nextAutoCheckpoint(node); checkpoint != nil {
+			chain.autoCheckpoint = checkpoint
+		}
+	}
+	if chain.autoCheckpoint == nil || chain.autoCheckpoint.height != 20 {
+		t.Fatalf("automatic checkpoint is %v, expected height 20",
+			chain.autoCheckpoint)
+	}
+	checkpoint := chain.AutoCheckpoint()
+	if checkpoint.Height != 20 || *checkpoint.Hash != branch0Nodes[19].hash {
+		t.Fatalf("AutoCheckpoint returned %v at height %d, expected %v at height 20",
+			checkpoint.Hash, checkpoint.Height, branch0Nodes[19].hash)
+	}
+	// Blocks that are deep enough but too recent do not advance the checkpoint.
+	recent := tip(branch0Nodes)
+	for i := 0; i < 2; i++ {
+		recent = newFakeNode(recent, 1, 0, time.Now())
+		chain.Index.AddNode(recent)
+		chain.bestChain.SetTip(recent)
+	}
+	if checkpoint := chain.nextAutoCheckpoint(recent); checkpoint == nil ||
+		checkpoint.height != 22 {
+		t.Fatalf("expected block 22 to become the automatic checkpoint, got %v",
+			checkpoint)
+	}
+	chain.autoCheckpoint = branch0Nodes[21]
+	for i := 0; i < 10; i++ {
+		recent = newFakeNode(recent, 1, 0, time.Now())
+		chain.Index.AddNode(recent)
+		chain.bestChain.SetTip(recent)
+	}
+	if checkpoint := chain.nextAutoCheckpoint(recent); checkpoint != nil {
+		t.Fatalf("recent block %v became an automatic checkpoint", checkpoint)
+	}
+	// Only chains that contain the checkpoint are accepted.
+	tests := []struct {
+		name  string
+		node  *blockNode
+		forks bool
+	}{
+		{"main chain", recent, false},
+		{"checkpoint", branch0Nodes[21], false},
+		{"below checkpoint", branch0Nodes[20], true},
+		{"side chain before checkpoint", tip(chainedNodes(branch0Nodes[2], 1)), true},
+		{"side chain after checkpoint", tip(chainedNodes(branch0Nodes[28], 1)), false},
+	}
+	for _, test := range tests {
+		if forks := chain.forksBeforeAutoCheckpoint(test.node); forks != test.forks {
+			t.Errorf("%s: forksBeforeAutoCheckpoint returned %v, expected %v",
+				test.name, forks, test.forks)
+		}
+	}
+}
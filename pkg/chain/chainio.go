@@ -30,6 +30,8 @@ var (
 	heightIndexBucketName = []byte("heightidx")
 	// chainStateKeyName is the name of the db key used to store the best chain state.
 	chainStateKeyName = []byte("chainstate")
+	// autoCheckpointKeyName is the name of the db key used to store the hash of the latest automatic checkpoint.
+	autoCheckpointKeyName = []byte("autocheckpoint")
 	// spendJournalVersionKeyName is the name of the db key used to store the version of the spend journal currently in the database.
 	spendJournalVersionKeyName = []byte("spendjournalversion")
 	// spendJournalBucketName is the name of the db bucket used to house transactions outputs that are spent in each block.
@@ -737,6 +739,22 @@ func dbPutBestState(
 	// Store the current best chain state into the database.
 	return dbTx.Metadata().Put(chainStateKeyName, serializedData)
 }
+// dbPutAutoCheckpoint uses an existing database transaction to store the hash of the latest automatic checkpoint.
+func dbPutAutoCheckpoint(
+	dbTx database.Tx, hash *chainhash.Hash) error {
+	return dbTx.Metadata().Put(autoCheckpointKeyName, hash[:])
+}
+// dbFetchAutoCheckpoint uses an existing database transaction to fetch the hash of the latest automatic checkpoint.  It returns nil if none has been stored.
+func dbFetchAutoCheckpoint(
+	dbTx database.Tx) *chainhash.Hash {
+	serialized := dbTx.Metadata().Get(autoCheckpointKeyName)
+	if len(serialized) != chainhash.HashSize {
+		return nil
+	}
+	var hash chainhash.Hash
+	copy(hash[:], serialized)
+	return &hash
+}
 // createChainState initializes both the database and the chain state to the genesis block.  This includes creating the necessary buckets and inserting the genesis block, so it must only be called on an uninitialized database.
 func (b *BlockChain) createChainState() error {
 	// Create a new node from the genesis block and set it as the best node.
@@ -747,7 +765,7 @@ func (b *BlockChain) createChainState() error {
 	})
 	genesisBlock.SetHeight(0)
 	header := &genesisBlock.MsgBlock().Header
-	node := newBlockNode(header, nil)
+	node := newBlockNode(header, nil, b.chainParams)
 	node.status = statusDataStored | statusValid
 	b.bestChain.SetTip(node)
 	// Add the new node to the index which is used for faster lookups.
@@ -807,7 +825,7 @@ func (b *BlockChain) createChainState() error {
 			return err
 		}
 		// Store the current best chain state into the database.
-		node.workSum = CalcWork(node.bits, node.height, node.version)
+		node.workSum = CalcWork(node.bits, node.height, node.version, b.chainParams)
 		err = dbPutBestState(dbTx, b.stateSnapshot, node.workSum)
 		if err != nil {
 			return err
@@ -890,7 +908,7 @@ func (b *BlockChain) initChainState() error {
 			}
 			// Initialize the block node for the block, connect it, and add it to the block index.
 			node := &blockNodes[i]
-			initBlockNode(node, header, parent)
+			initBlockNode(node, header, parent, b.chainParams)
 			node.status = status
 			b.Index.addNode(node)
 			lastNode = node
@@ -905,6 +923,18 @@ func (b *BlockChain) initChainState() error {
 			))
 		}
 		b.bestChain.SetTip(tip)
+		// Restore the automatic checkpoint, unless the main chain no longer contains it.
+		if hash := dbFetchAutoCheckpoint(dbTx); hash != nil {
+			node := b.Index.LookupNode(hash)
+			if node != nil && b.bestChain.Contains(node) {
+				b.autoCheckpoint = node
+			} else {
+				log <- cl.Warnf{
+					"automatic checkpoint %v is not in the main chain, ignoring it",
+					hash,
+				}
+			}
+		}
 		// Load the raw block bytes for the best block.
 		blockBytes, err := dbTx.FetchBlock(&state.hash)
 		if err != nil {
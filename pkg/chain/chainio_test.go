@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 	database "git.parallelcoin.io/dev/9/pkg/db"
 )
@@ -574,7 +575,7 @@ func TestBestChainStateSerialization(
 				height:    0,
 				totalTxns: 1,
 				workSum: func() *big.Int {
-					workSum.Add(workSum, CalcWork(486604799, 0, 2))
+					workSum.Add(workSum, CalcWork(486604799, 0, 2, &chaincfg.MainNetParams))
 					return new(big.Int).Set(workSum)
 				}(),
 				// 0x0100010001
@@ -588,7 +589,7 @@ func TestBestChainStateSerialization(
 				height:    1,
 				totalTxns: 2,
 				workSum: func() *big.Int {
-					workSum.Add(workSum, CalcWork(486604799, 1, 2))
+					workSum.Add(workSum, CalcWork(486604799, 1, 2, &chaincfg.MainNetParams))
 					return new(big.Int).Set(workSum)
 				}(),
 				// 0x0200020002
@@ -0,0 +1,251 @@
+package chain
+import (
+	"container/list"
+	"fmt"
+	"math/big"
+	"sort"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// TipStatus is the state of the branch of the block chain that ends at a chain tip.
+type TipStatus byte
+const (
+	// StatusActive indicates the tip is the end of the main chain.
+	StatusActive TipStatus = iota
+	// StatusInvalid indicates the tip or one of its ancestors is known to be invalid, either because it failed validation or because it was invalidated by hand.
+	StatusInvalid
+	// StatusValidFork indicates every block of the branch has been fully validated, but the branch is not part of the main chain.
+	StatusValidFork
+	// StatusValidHeaders indicates the blocks of the branch are stored but have not all been fully validated.
+	StatusValidHeaders
+	// StatusHeadersOnly indicates only the headers of the branch are known.
+	StatusHeadersOnly
+)
+// tipStatusStrings are the names of the tip statuses, which are the same as those of bitcoind.
+var tipStatusStrings = map[TipStatus]string{
+	StatusActive:       "active",
+	StatusInvalid:      "invalid",
+	StatusValidFork:    "valid-fork",
+	StatusValidHeaders: "valid-headers",
+	StatusHeadersOnly:  "headers-only",
+}
+// String returns the TipStatus as the name used by the getchaintips RPC.
+func (s TipStatus) String() string {
+	if str, ok := tipStatusStrings[s]; ok {
+		return str
+	}
+	return fmt.Sprintf("Unknown TipStatus (%d)", int(s))
+}
+// ChainTip is the end of a branch of the block chain known to the block index.
+type ChainTip struct {
+	Height int32
+	Hash   chainhash.Hash
+	// BranchLen is the number of blocks of the branch after the block where it forks from the main chain, which is zero for the main chain.
+	BranchLen int32
+	Status    TipStatus
+}
+// ChainTips returns the ends of the main chain and of every side chain of the block index, highest first. This function is safe for concurrent access.
+func (b *BlockChain) ChainTips() []ChainTip {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+	// The main chain does not end in a tip of the index when the blocks that built on its end were invalidated.
+	best := b.bestChain.Tip()
+	nodes := b.Index.Tips()
+	hasBest := false
+	for _, node := range nodes {
+		hasBest = hasBest || node == best
+	}
+	if !hasBest {
+		nodes = append(nodes, best)
+	}
+	tips := make([]ChainTip, 0, len(nodes))
+	for _, node := range nodes {
+		tip := ChainTip{
+			Height:    node.height,
+			Hash:      node.hash,
+			BranchLen: node.height - b.bestChain.FindFork(node).height,
+		}
+		status := b.Index.NodeStatus(node)
+		switch {
+		case b.bestChain.Contains(node):
+			tip.Status = StatusActive
+		case status.KnownInvalid():
+			tip.Status = StatusInvalid
+		case status.KnownValid():
+			tip.Status = StatusValidFork
+		case status.HaveData():
+			tip.Status = StatusValidHeaders
+		default:
+			tip.Status = StatusHeadersOnly
+		}
+		tips = append(tips, tip)
+	}
+	sort.Slice(tips, func(i, j int) bool {
+		if tips[i].Height != tips[j].Height {
+			return tips[i].Height > tips[j].Height
+		}
+		return tips[i].Status < tips[j].Status
+	})
+	return tips
+}
+// InvalidateBlock marks a block and every block that builds on it as invalid, and when the block is in the main chain, disconnects it and reorganizes the chain to the branch with the most work that remains valid. The marks are stored in the block index, so they last across restarts until ReconsiderBlock removes them. This function is safe for concurrent access.
+func (b *BlockChain) InvalidateBlock(hash *chainhash.Hash) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+	node := b.Index.LookupNode(hash)
+	if node == nil {
+		return fmt.Errorf("block %s is not known", hash)
+	}
+	if node.parent == nil {
+		return fmt.Errorf("the genesis block %s cannot be invalidated", hash)
+	}
+	inMainChain := b.bestChain.Contains(node)
+	// Blocks at or below the automatic checkpoint are never disconnected.
+	if inMainChain && b.forksBeforeAutoCheckpoint(node.parent) {
+		str := fmt.Sprintf("block %v is not after the automatic checkpoint "+
+			"at height %d", hash, b.autoCheckpoint.height)
+		return ruleError(ErrForkTooOld, str)
+	}
+	b.invalidateNode(node)
+	log <- cl.Infof{"INVALIDATE: block %v (height %d) marked invalid", hash, node.height}
+	var err error
+	if inMainChain {
+		// Disconnect the block and those after it, and then connect the best branch that is left.
+		detachNodes := list.New()
+		for n := b.bestChain.Tip(); n != node.parent; n = n.parent {
+			detachNodes.PushBack(n)
+		}
+		err = b.reorganizeChain(detachNodes, list.New())
+		if err == nil {
+			err = b.reorganizeToBestTip()
+		}
+	}
+	if writeErr := b.Index.flushToDB(); err == nil {
+		err = writeErr
+	}
+	return err
+}
+// ReconsiderBlock removes the invalid marks from a block, from every block that builds on it and from its ancestors, and reorganizes the chain when one of the branches that are valid again has more work than the main chain. Blocks that were marked invalid because they failed validation are validated again when they are connected. This function is safe for concurrent access.
+func (b *BlockChain) ReconsiderBlock(hash *chainhash.Hash) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+	node := b.Index.LookupNode(hash)
+	if node == nil {
+		return fmt.Errorf("block %s is not known", hash)
+	}
+	b.reconsiderNode(node)
+	log <- cl.Infof{"RECONSIDER: block %v (height %d) is no longer marked invalid", hash, node.height}
+	err := b.reorganizeToBestTip()
+	if writeErr := b.Index.flushToDB(); err == nil {
+		err = writeErr
+	}
+	return err
+}
+// PreciousBlock makes the main chain end with the given block when its branch has at least as much work as the main chain, so that of branches with equal work the one chosen by the operator wins. It does nothing when the block is already in the main chain or its branch has less work. This function is safe for concurrent access.
+func (b *BlockChain) PreciousBlock(hash *chainhash.Hash) error {
+	b.chainLock.Lock()
+	defer b.chainLock.Unlock()
+	node := b.Index.LookupNode(hash)
+	if node == nil {
+		return fmt.Errorf("block %s is not known", hash)
+	}
+	if b.bestChain.Contains(node) {
+		return nil
+	}
+	if b.Index.NodeStatus(node).KnownInvalid() {
+		return fmt.Errorf("block %s is known to be invalid", hash)
+	}
+	if b.forksBeforeAutoCheckpoint(node) {
+		str := fmt.Sprintf("block %v would reorganize the main chain "+
+			"before the automatic checkpoint at height %d",
+			hash, b.autoCheckpoint.height)
+		return ruleError(ErrForkTooOld, str)
+	}
+	if b.excessWork(node).Sign() < 0 {
+		return nil
+	}
+	log <- cl.Infof{"REORGANIZE: block %v was made precious", hash}
+	err := b.reorganizeChain(b.getReorganizeNodes(node))
+	if writeErr := b.Index.flushToDB(); err == nil {
+		err = writeErr
+	}
+	return err
+}
+// invalidateNode marks a node as having failed validation and all the nodes that build on it as having an invalid ancestor. This function may modify node statuses in the block index without flushing.
+func (b *BlockChain) invalidateNode(node *blockNode) {
+	b.Index.SetStatusFlags(node, statusValidateFailed)
+	for _, n := range b.Index.Descendants(node) {
+		b.Index.SetStatusFlags(n, statusInvalidAncestor)
+	}
+}
+// reconsiderNode removes the invalid marks from a node, from the nodes that build on it and from its ancestors. This function may modify node statuses in the block index without flushing.
+func (b *BlockChain) reconsiderNode(node *blockNode) {
+	invalid := statusValidateFailed | statusInvalidAncestor
+	for _, n := range b.Index.Descendants(node) {
+		if b.Index.NodeStatus(n).KnownInvalid() {
+			b.Index.UnsetStatusFlags(n, invalid)
+		}
+	}
+	for n := node; n != nil; n = n.parent {
+		if b.Index.NodeStatus(n).KnownInvalid() {
+			b.Index.UnsetStatusFlags(n, invalid)
+		}
+	}
+}
+// reorganizeToBestTip reorganizes the chain to the branch with more work than the main chain that is not known to be invalid, if there is one. When a block of that branch fails validation, the branch is marked invalid and the next best one is tried. This function may modify node statuses in the block index without flushing. This function MUST be called with the chain state lock held (for writes).
+func (b *BlockChain) reorganizeToBestTip() error {
+	// Every failed attempt marks a block invalid, so there can not be more attempts than branches.
+	for range b.Index.Tips() {
+		node := b.bestCandidate()
+		if node == nil {
+			return nil
+		}
+		log <- cl.Infof{
+			"REORGANIZE: block %v is causing a reorganize", node.hash,
+		}
+		err := b.reorganizeChain(b.getReorganizeNodes(node))
+		if _, ok := err.(RuleError); !ok {
+			return err
+		}
+		log <- cl.Warnf{"REORGANIZE: branch of block %v is invalid: %v", node.hash, err}
+	}
+	return nil
+}
+// bestCandidate returns the last usable node of the branch that has the most more work than the main chain, or nil when no branch has more. A node is usable when its block is stored and neither it nor an ancestor is known to be invalid. This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) bestCandidate() *blockNode {
+	var best *blockNode
+	var bestExcess *big.Int
+	for _, tip := range b.Index.Tips() {
+		candidate := tip
+		for n := tip; n != nil && !b.bestChain.Contains(n); n = n.parent {
+			status := b.Index.NodeStatus(n)
+			if status.KnownInvalid() || !status.HaveData() {
+				candidate = n.parent
+			}
+		}
+		if candidate == nil || b.bestChain.Contains(candidate) ||
+			b.forksBeforeAutoCheckpoint(candidate) {
+			continue
+		}
+		excess := b.excessWork(candidate)
+		if excess.Sign() <= 0 {
+			continue
+		}
+		if best == nil || excess.Cmp(bestExcess) > 0 {
+			best, bestExcess = candidate, excess
+		}
+	}
+	return best
+}
+// excessWork returns how much more work the chain ending at the given node has than the main chain, counted from the block where they fork. This function MUST be called with the chain state lock held (for reads).
+func (b *BlockChain) excessWork(node *blockNode) *big.Int {
+	fork := b.bestChain.FindFork(node)
+	excess := new(big.Int)
+	for n := node; n != nil && n != fork; n = n.parent {
+		excess.Add(excess, CalcWork(n.bits, n.height, n.version, b.chainParams))
+	}
+	for n := b.bestChain.Tip(); n != nil && n != fork; n = n.parent {
+		excess.Sub(excess, CalcWork(n.bits, n.height, n.version, b.chainParams))
+	}
+	return excess
+}
@@ -0,0 +1,111 @@
+package chain
+import (
+	"testing"
+	"time"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+)
+// TestChainTips ensures the tips of the block index are reported with the right status and branch length, and that marking blocks invalid and reconsidering them changes the branch the chain would reorganize to.
+func TestChainTips(
+	t *testing.T) {
+	// Construct a synthetic block chain with a block index consisting of the following structure.
+	// 	genesis -> 1 -> 2 -> 3 -> 4 -> 5
+	// 	                \-> 3a -> 4a -> 5a -> 6a
+	// 	                          \-> 5b
+	tip := tstTip
+	params := chaincfg.RegressionNetParams
+	chain := newFakeChain(&params)
+	// Every block has the same work, and a different time so that the branches do not share hashes.
+	timestamp := time.Unix(1500000000, 0)
+	branch := func(parent *blockNode, numNodes int) []*blockNode {
+		nodes := make([]*blockNode, numNodes)
+		for i := range nodes {
+			timestamp = timestamp.Add(time.Second)
+			nodes[i] = newFakeNode(parent, 2, params.PowLimitBits, timestamp)
+			parent = nodes[i]
+		}
+		return nodes
+	}
+	branch0Nodes := branch(chain.bestChain.Genesis(), 5)
+	branch1Nodes := branch(branch0Nodes[1], 4)
+	branch2Nodes := branch(branch1Nodes[1], 1)
+	for _, nodes := range [][]*blockNode{branch0Nodes, branch1Nodes} {
+		for _, node := range nodes {
+			node.status = statusDataStored
+			chain.Index.AddNode(node)
+		}
+	}
+	// Only the header of 5b is known.
+	chain.Index.AddNode(tip(branch2Nodes))
+	for _, node := range branch0Nodes {
+		node.status |= statusValid
+	}
+	chain.bestChain.SetTip(tip(branch0Nodes))
+	type tipTest struct {
+		node      *blockNode
+		branchLen int32
+		status    TipStatus
+	}
+	checkTips := func(name string, expected []tipTest) {
+		tips := chain.ChainTips()
+		if len(tips) != len(expected) {
+			t.Fatalf("%s: got %d tips, expected %d", name, len(tips), len(expected))
+		}
+		for i, test := range expected {
+			got := tips[i]
+			if got.Hash != test.node.hash || got.Height != test.node.height ||
+				got.BranchLen != test.branchLen || got.Status != test.status {
+				t.Errorf("%s: tip %d is %v at height %d with branch length %d "+
+					"and status %v, expected %v at height %d with branch "+
+					"length %d and status %v", name, i, got.Hash, got.Height,
+					got.BranchLen, got.Status, test.node.hash,
+					test.node.height, test.branchLen, test.status)
+			}
+		}
+	}
+	checkTips("initial", []tipTest{
+		{tip(branch1Nodes), 4, StatusValidHeaders},
+		{tip(branch0Nodes), 0, StatusActive},
+		{tip(branch2Nodes), 3, StatusHeadersOnly},
+	})
+	if descendants := chain.Index.Descendants(branch1Nodes[1]); len(descendants) != 3 {
+		t.Errorf("got %d descendants of 4a, expected 3", len(descendants))
+	}
+	// The longer side chain has more work than the main chain.
+	if node := chain.bestCandidate(); node != tip(branch1Nodes) {
+		t.Fatalf("best candidate is %v, expected 6a", node)
+	}
+	// Invalidating 4a leaves only 3a, which has less work than the main chain.
+	chain.invalidateNode(branch1Nodes[1])
+	for _, node := range append(branch1Nodes[2:], branch2Nodes...) {
+		if status := chain.Index.NodeStatus(node); status&statusInvalidAncestor == 0 {
+			t.Errorf("block at height %d is not marked with an invalid ancestor", node.height)
+		}
+	}
+	if node := chain.bestCandidate(); node != nil {
+		t.Fatalf("best candidate is %v at height %d, expected none", node.hash, node.height)
+	}
+	checkTips("invalidated", []tipTest{
+		{tip(branch1Nodes), 4, StatusInvalid},
+		{tip(branch0Nodes), 0, StatusActive},
+		{tip(branch2Nodes), 3, StatusInvalid},
+	})
+	// Reconsidering 6a also clears its ancestors but not 5b on another branch.
+	chain.reconsiderNode(tip(branch1Nodes))
+	if !chain.Index.NodeStatus(tip(branch2Nodes)).KnownInvalid() {
+		t.Errorf("5b is no longer marked invalid")
+	}
+	if node := chain.bestCandidate(); node != tip(branch1Nodes) {
+		t.Fatalf("best candidate is %v, expected 6a", node)
+	}
+	checkTips("reconsidered", []tipTest{
+		{tip(branch1Nodes), 4, StatusValidHeaders},
+		{tip(branch0Nodes), 0, StatusActive},
+		{tip(branch2Nodes), 3, StatusInvalid},
+	})
+	// The end of the main chain is reported when blocks build on it.
+	chain.bestChain.SetTip(branch0Nodes[3])
+	tips := chain.ChainTips()
+	if len(tips) != 4 || tips[3].Hash != branch0Nodes[3].hash || tips[3].Status != StatusActive {
+		t.Errorf("the end of the main chain at height 4 is not an active tip: %+v", tips)
+	}
+}
@@ -175,3 +175,35 @@ func (b *BlockChain) IsCheckpointCandidate(block *util.Block) (bool, error) {
 	// All of the checks passed, so the block is a candidate.
 	return true, nil
 }
+// AutoCheckpoint returns the latest block of the main chain that has become a checkpoint by being deep and old enough.  It returns nil if automatic checkpoints are disabled for the active chain or no block has qualified yet. This function is safe for concurrent access.
+func (b *BlockChain) AutoCheckpoint() *chaincfg.Checkpoint {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+	node := b.autoCheckpoint
+	if node == nil {
+		return nil
+	}
+	hash := node.hash
+	return &chaincfg.Checkpoint{Height: node.height, Hash: &hash}
+}
+// nextAutoCheckpoint returns the block that becomes the automatic checkpoint once the passed node is the end of the main chain, which is the block AutoCheckpointDepth blocks below it if its timestamp is at least AutoCheckpointAge old.  It returns nil when that does not advance the current automatic checkpoint. This function MUST be called with the chain lock held (for reads).
+func (b *BlockChain) nextAutoCheckpoint(tip *blockNode) *blockNode {
+	depth := b.chainParams.AutoCheckpointDepth
+	if depth <= 0 || tip.height <= depth {
+		return nil
+	}
+	node := tip.Ancestor(tip.height - depth)
+	if b.autoCheckpoint != nil && node.height <= b.autoCheckpoint.height {
+		return nil
+	}
+	age := b.timeSource.AdjustedTime().Sub(time.Unix(node.timestamp, 0))
+	if age < b.chainParams.AutoCheckpointAge {
+		return nil
+	}
+	return node
+}
+// forksBeforeAutoCheckpoint returns whether the chain ending at the passed node does not contain the automatic checkpoint, which means it forks the main chain at or before it. This function MUST be called with the chain lock held (for reads).
+func (b *BlockChain) forksBeforeAutoCheckpoint(node *blockNode) bool {
+	checkpoint := b.autoCheckpoint
+	return checkpoint != nil && node.Ancestor(checkpoint.height) != checkpoint
+}
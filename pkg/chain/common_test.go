@@ -286,7 +286,7 @@ func (b *BlockChain) TstSetCoinbaseMaturity(maturity uint16) {
 func newFakeChain(
 	params *chaincfg.Params) *BlockChain {
 	// Create a genesis block node and block index index populated with it for use when creating the fake chain below.
-	node := newBlockNode(&params.GenesisBlock.Header, nil)
+	node := newBlockNode(&params.GenesisBlock.Header, nil, params)
 	index := newBlockIndex(nil, params)
 	index.AddNode(node)
 	targetTimespan := int64(params.TargetTimespan)
@@ -314,5 +314,5 @@ func newFakeNode(
 		Bits:      bits,
 		Timestamp: timestamp,
 	}
-	return newBlockNode(header, parent)
+	return newBlockNode(header, parent, &chaincfg.MainNetParams)
 }
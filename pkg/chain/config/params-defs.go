@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math/big"
 	"time"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 )
@@ -152,6 +153,9 @@ type Params struct {
 	GenerateSupported bool
 	// Checkpoints ordered from oldest to newest.
 	Checkpoints []Checkpoint
+	// AutoCheckpointDepth and AutoCheckpointAge make the block of the main chain that is this many blocks below the tip a checkpoint once its timestamp is this old, and the chain is not reorganized below it. A depth of zero disables automatic checkpoints.
+	AutoCheckpointDepth int32
+	AutoCheckpointAge   time.Duration
 	// These fields are related to voting on consensus rule changes as defined by BIP0009.
 	//
 	// RuleChangeActivationThreshold is the number of blocks in a threshold state retarget window for which a positive vote for a rule change must be cast in order to lock in a rule change. It should typically be 95% for the main network and 75% for test networks.
@@ -187,4 +191,6 @@ type Params struct {
 	// PowLimit defines the highest allowed proof of work value for a scrypt block as a uint256.
 	ScryptPowLimit     *big.Int
 	ScryptPowLimitBits uint32
+	// HardForks is the schedule of consensus rule changes of the network ordered by activation height, starting with the rules in force from the genesis block
+	HardForks []fork.HardForks
 }
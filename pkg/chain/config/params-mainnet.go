@@ -1,5 +1,9 @@
 package chaincfg
-import "git.parallelcoin.io/dev/9/pkg/chain/wire"
+import (
+	"time"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+)
 // MainNetParams defines the network parameters for the main Bitcoin network.
 var MainNetParams = Params{
 	Name:        "mainnet",
@@ -32,6 +36,8 @@ var MainNetParams = Params{
 	Checkpoints: []Checkpoint{
 		// {11111, newHashFromStr("0000000069e244f73d78e8fd29ba2fd2ed618bd6fa2ee92559f542fdb26e7c1d")},
 	},
+	AutoCheckpointDepth: 2000,
+	AutoCheckpointAge:   12 * time.Hour,
 	// Consensus rule change deployments.
 	//
 	// The miner confirmation window is defined as:
@@ -83,4 +89,8 @@ var MainNetParams = Params{
 	MaxActualTimespan:  3300,
 	ScryptPowLimit:     &scryptPowLimit,
 	ScryptPowLimitBits: ScryptPowLimitBits,
+	HardForks: []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(250000),
+	},
 }
@@ -1,6 +1,7 @@
 package chaincfg
 import (
 	"math"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 )
 // RegressionNetParams defines the network parameters for the regression test Bitcoin network.  Not to be confused with the test Bitcoin network (version 3), this network is sometimes simply called "testnet".
@@ -75,4 +76,8 @@ var RegressionNetParams = Params{
 	MaxActualTimespan:       AveragingTargetTimespan * (Interval + MaxAdjustDown) / Interval,
 	ScryptPowLimit:          &scryptPowLimit,
 	ScryptPowLimitBits:      ScryptPowLimitBits,
+	HardForks: []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(250000),
+	},
 }
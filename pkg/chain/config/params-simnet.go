@@ -2,6 +2,7 @@ package chaincfg
 import (
 	"math"
 	"time"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 )
 // SimNetParams defines the network parameters for the simulation test Bitcoin network.  This network is similar to the normal test network except it is intended for private use within a group of individuals doing simulation testing.  The functionality is intended to differ in that the only nodes which are specifically specified are used to create the network rather than following normal discovery rules.  This is important as otherwise it would just turn into another public testnet.
@@ -78,4 +79,8 @@ var SimNetParams = Params{
 	MaxActualTimespan:       10 * 300 * (100 + 10) / 100,
 	ScryptPowLimit:          &scryptPowLimit,
 	ScryptPowLimitBits:      ScryptPowLimitBits,
+	HardForks: []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(250000),
+	},
 }
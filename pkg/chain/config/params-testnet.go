@@ -1,5 +1,6 @@
 package chaincfg
 import (
+	"time"
 	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 )
@@ -31,6 +32,8 @@ var TestNet3Params = Params{
 	Checkpoints: []Checkpoint{
 		// {546, newHashFromStr("000000002a936ca763904c3c35fce2f3556c559c0214345d31b1bcebf76acb70")},
 	},
+	AutoCheckpointDepth: 500,
+	AutoCheckpointAge:   time.Hour,
 	// Consensus rule change deployments.
 	//
 	// The miner confirmation window is defined as:
@@ -80,4 +83,8 @@ var TestNet3Params = Params{
 	MaxActualTimespan:       TestnetAveragingTargetTimespan * (TestnetInterval + TestnetMaxAdjustDown) / TestnetInterval,
 	ScryptPowLimit:          &scryptPowLimit,
 	ScryptPowLimitBits:      ScryptPowLimitBits,
+	HardForks: []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(100),
+	},
 }
@@ -2,12 +2,17 @@ package chaincfg
 import (
 	"math/big"
 	"strings"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
 )
 // String returns the hostname of the DNS seed in human-readable form.
 func (d DNSSeed) String() string {
 	return d.Host
 }
+// Forks returns the hard fork schedule of the network
+func (p *Params) Forks() []fork.HardForks {
+	return p.HardForks
+}
 // Register registers the network parameters for a Bitcoin network.  This may error with ErrDuplicateNet if the network is already registered (either due to a previous Register call, or the network being one of the default networks). Network parameters should be registered into this package by a main package as early as possible.  Then, library packages may lookup networks or network parameters based on inputs and work regardless of the network being standard or not.
 func Register(
 	params *Params) error {
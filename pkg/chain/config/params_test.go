@@ -1,5 +1,8 @@
 package chaincfg
-import "testing"
+import (
+	"testing"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+)
 // TestInvalidHashStr ensures the newShaHashFromStr function panics when used to with an invalid hash string.
 func TestInvalidHashStr(
 	t *testing.T) {
@@ -25,3 +28,49 @@ func TestMustRegisterPanic(
 	// Intentionally try to register duplicate params to force a panic.
 	mustRegister(&MainNetParams)
 }
+// TestHardForks ensures every network has a hard fork schedule starting from the genesis block in order of activation, and that a network with its own schedule does not change the rules of the others.
+func TestHardForks(
+	t *testing.T) {
+	for _, params := range []*Params{&MainNetParams, &TestNet3Params,
+		&RegressionNetParams, &SimNetParams} {
+		forks := params.Forks()
+		if len(forks) == 0 || forks[0].ActivationHeight != 0 {
+			t.Fatalf("%s has no rules from the genesis block", params.Name)
+		}
+		for i := 1; i < len(forks); i++ {
+			if forks[i].ActivationHeight <= forks[i-1].ActivationHeight {
+				t.Fatalf("%s hard fork %d activates before the one before it",
+					params.Name, i)
+			}
+		}
+	}
+	private := RegressionNetParams
+	private.HardForks = []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(10),
+	}
+	tests := []struct {
+		params *Params
+		height int32
+		want   int
+	}{
+		{&private, 10, 0},
+		{&private, 11, 1},
+		{&RegressionNetParams, 11, 0},
+		{&MainNetParams, 11, 0},
+		{&MainNetParams, 250001, 1},
+		{&TestNet3Params, 101, 1},
+	}
+	for _, test := range tests {
+		if got := fork.GetCurrent(test.params, test.height); got != test.want {
+			t.Errorf("%s at height %d: got hard fork %d, expected %d",
+				test.params.Name, test.height, got, test.want)
+		}
+	}
+	if got := fork.GetAlgoName(&private, 0, 11); got != "blake2b" {
+		t.Errorf("version 0 after Plan 9 is %q, expected blake2b", got)
+	}
+	if got := fork.GetAlgoName(&MainNetParams, 514, 11); got != "scrypt" {
+		t.Errorf("version 514 before Plan 9 is %q, expected scrypt", got)
+	}
+}
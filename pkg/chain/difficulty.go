@@ -2,9 +2,9 @@ package chain
 import (
 	"encoding/hex"
 	"fmt"
-	"math"
 	"math/big"
 	"time"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
 	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
 	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
@@ -30,8 +30,37 @@ func (
 	err error,
 ) {
 	b.chainLock.Lock()
-	difficulty, err = b.calcNextRequiredDifficulty(b.bestChain.Tip(), timestamp, algo, true)
+	var adjustment *big.Rat
+	difficulty, adjustment, err = b.nextRequiredDifficulty(b.bestChain.Tip(), timestamp, algo, true)
 	b.chainLock.Unlock()
+	if adjustment != nil {
+		b.adjustmentsLock.Lock()
+		b.difficultyAdjustments[algo], _ = adjustment.Float64()
+		b.adjustmentsLock.Unlock()
+	}
+	return
+}
+// DifficultyAdjustment returns the latest adjustment to the target of an algorithm found by CalcNextRequiredDifficulty. It is only a hint for miners and plays no part in consensus. This function is safe for concurrent access.
+func (b *BlockChain) DifficultyAdjustment(algo string) (adjustment float64) {
+	b.adjustmentsLock.RLock()
+	adjustment = b.difficultyAdjustments[algo]
+	b.adjustmentsLock.RUnlock()
+	return
+}
+// LastBits returns the target bits and version of the newest block of an algorithm in the main chain up to the block at height, going back no further than the activation of the hard fork in force at height. The minimum difficulty of the algorithm is returned when there is no such block. This function is safe for concurrent access.
+func (b *BlockChain) LastBits(
+	algo string, height int32) (bits uint32, version int32) {
+	hf := fork.Get(b.chainParams, height)
+	bits, version = hf.Algos[algo].MinBits, hf.Algos[algo].Version
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+	// GetLastWithAlgo finds no blocks, so the main chain is walked back by version
+	for node := b.bestChain.NodeByHeight(height); node != nil &&
+		node.height > hf.ActivationHeight; node = node.parent {
+		if node.version == version {
+			return node.bits, node.version
+		}
+	}
 	return
 }
 // calcEasiestDifficulty calculates the easiest possible difficulty that a block can have given starting difficulty bits and a duration.  It is mainly used to verify that claimed proof of work by a block is sane as compared to a known good checkpoint.
@@ -67,38 +96,52 @@ func (
 ) (
 	newTargetBits uint32,
 	err error,
+) {
+	newTargetBits, _, err = b.nextRequiredDifficulty(lastNode, newBlockTime, algoname, l)
+	return
+}
+// nextRequiredDifficulty calculates the required difficulty for the block after the passed previous block node, and also returns the adjustment that was applied to the previous target of the algorithm, which is nil if the target was not scaled.
+func (
+	b *BlockChain,
+) nextRequiredDifficulty(
+	lastNode *blockNode,
+	newBlockTime time.Time,
+	algoname string,
+	l bool,
+) (
+	newTargetBits uint32,
+	adjustment *big.Rat,
+	err error,
 ) {
 	nH := lastNode.height + 1
-	switch fork.GetCurrent(nH) {
+	switch fork.GetCurrent(b.chainParams, nH) {
 	// Legacy difficulty adjustment
 	case 0:
 		log <- cl.Debug{"on pre-hardfork"}
 		if lastNode == nil {
-			return newTargetBits, nil
+			return newTargetBits, nil, nil
 		}
-		algo := fork.GetAlgoVer(algoname, nH)
-		algoName := fork.GetAlgoName(algo, nH)
-		newTargetBits = fork.GetMinBits(algoName, nH)
+		algo := fork.GetAlgoVer(b.chainParams, algoname, nH)
+		algoName := fork.GetAlgoName(b.chainParams, algo, nH)
+		newTargetBits = fork.GetMinBits(b.chainParams, algoName, nH)
 		log <- cl.Debugc(func() string {
 			return fmt.Sprintf("last %d %d %8x",
 				lastNode.height, lastNode.version, lastNode.bits)
 		})
-		prevNode := lastNode.GetLastWithAlgo(algo)
+		prevNode := lastNode.GetLastWithAlgo(algo, b.chainParams)
 		if prevNode == nil {
-			return newTargetBits, nil
+			return newTargetBits, nil, nil
 		}
 		firstNode := prevNode
 		for i := int64(0); firstNode != nil &&
-			i < fork.GetAveragingInterval(nH)-1; i++ {
-			log <- cl.Debugc(func() string {
-				return fmt.Sprintf("%d: prev %d %d %8x",
-					i, firstNode.height, firstNode.version, firstNode.bits)
-			})
+			i < fork.GetAveragingInterval(b.chainParams, nH)-1; i++ {
+			log <- cl.Debugf{"%d: prev %d %d %8x",
+				i, firstNode.height, firstNode.version, firstNode.bits}
 			firstNode = firstNode.RelativeAncestor(1)
-			firstNode = firstNode.GetLastWithAlgo(algo)
+			firstNode = firstNode.GetLastWithAlgo(algo, b.chainParams)
 		}
 		if firstNode == nil {
-			return newTargetBits, nil
+			return newTargetBits, nil, nil
 		}
 		log <- cl.Debugc(func() string {
 			return fmt.Sprintf("9: first %d %d %8x",
@@ -138,205 +181,260 @@ func (
 				CompactToBig(newTargetBits),
 			)
 		})
-		return newTargetBits, nil
+		return newTargetBits, nil, nil
 	case 1: // Plan 9 from Crypto Space
 		log <- cl.Debug{"on plan 9 hardfork"}
 		if lastNode.height == 0 {
-			return fork.FirstPowLimitBits, nil
-		}
-		nH := lastNode.height + 1
-		algo := fork.GetAlgoVer(algoname, nH)
-		newTargetBits = fork.GetMinBits(algoname, nH)
-		last := lastNode
-		// find the most recent block of the same algo
-		if last.version != algo {
-			ln := last.RelativeAncestor(1)
-			ln = ln.GetLastWithAlgo(algo)
-			// ignore the first block as its time is not a normal timestamp
-			if ln.height < 1 {
-				break
-			}
-			last = ln
+			return fork.FirstPowLimitBits, nil, nil
 		}
-		counter := 1
-		var timestamps []float64
-		timestamps = append(timestamps, float64(last.timestamp))
-		pb := last
-		// collect the timestamps of all the blocks of the same algo until we pass genesis block or get AveragingInterval blocks
-		for ; counter < int(fork.GetAveragingInterval(nH)) && pb.height > 2; counter++ {
-			p := pb.RelativeAncestor(1)
-			if p != nil {
-				if p.height == 0 {
-					return fork.SecondPowLimitBits, nil
-				}
-				pb = p.GetLastWithAlgo(algo)
-			} else {
-				break
-			}
-			if pb != nil && pb.height > 0 {
-				// only add the timestamp if is not the same as the previous
-				timestamps = append(timestamps, float64(pb.timestamp))
-			} else {
-				break
-			}
-		}
-		allTimeAverage, trailTimeAverage := float64(fork.GetTargetTimePerBlock(nH)), float64(fork.GetTargetTimePerBlock(nH))
-		startHeight := fork.List[1].ActivationHeight
-		if b.chainParams.Name == "testnet" {
-			startHeight = 1
-		}
-		trailHeight := int32(int64(lastNode.height) -
-			fork.GetAveragingInterval(nH)*int64(len(fork.List[1].Algos)))
-		if trailHeight < 0 {
-			trailHeight = 1
-		}
-		firstBlock, _ := b.BlockByHeight(startHeight)
-		trailBlock, _ := b.BlockByHeight(trailHeight)
-		lastTime := lastNode.timestamp
-		if firstBlock != nil {
-			firstTime := firstBlock.MsgBlock().Header.Timestamp.Unix()
-			allTimeAverage = (float64(lastTime) - float64(firstTime)) / (float64(lastNode.height) - float64(firstBlock.Height()))
-		}
-		if trailBlock != nil {
-			trailTime := trailBlock.MsgBlock().Header.Timestamp.Unix()
-			trailTimeAverage = (float64(lastTime) - float64(trailTime)) / (float64(lastNode.height) - float64(trailBlock.Height()))
-		}
-		if len(timestamps) < 2 {
-			return fork.SecondPowLimitBits, nil
-		}
-		var adjusted, targetAdjusted, adjustment float64
-		if len(timestamps) > 1 {
-			numalgos := int64(len(fork.List[1].Algos))
-			target := fork.GetTargetTimePerBlock(nH) * numalgos
-			counter = 0
-			for i := 0; i < len(timestamps)-1; i++ {
-				factor := 0.75
-				if i == 0 {
-					f := factor
-					for j := 0; j < i; j++ {
-						f *= factor
-					}
-					factor = f
-				} else {
-					factor = 1.0
-				}
-				adjustment = timestamps[i] - timestamps[i+1]
-				adjustment *= factor
-				switch {
-				case math.IsNaN(adjustment):
-					break
-				case adjustment == 0.0:
-					break
-				}
-				adjusted += adjustment
-				targetAdjusted += float64(target) * factor
-				counter++
+		newTargetBits, adjustment = b.calcPlan9Difficulty(lastNode, algoname, b.chainPlan9Lookup(), l)
+		return newTargetBits, adjustment, nil
+	}
+	// nH := lastNode.height + 1
+	// algo := fork.GetAlgoVer(b.chainParams, algoname, nH)
+	return fork.GetMinBits(b.chainParams, algoname, nH), nil, nil
+}
+// plan9AlgoFactor and plan9TrailingFactor weight the newest interval of the same algorithm and trailing averages. They are the exact values of the float64 factors the averagers were written with.
+var (
+	plan9AlgoFactor     = new(big.Rat).SetFloat64(0.75)
+	plan9TrailingFactor = new(big.Rat).SetFloat64(0.81)
+)
+// plan9Lookup finds the blocks the Plan 9 averagers measure: the newest block of an algorithm from a block, and the block at a height of the main chain, which is nil when there is none
+type plan9Lookup struct {
+	lastWithAlgo func(node *blockNode, algo int32) *blockNode
+	mainChain    func(height int32) *blockNode
+}
+// chainPlan9Lookup returns the lookup of the Plan 9 averagers in the chain, which finds the newest block of an algorithm with GetLastWithAlgo and reads the main chain from the database
+func (b *BlockChain) chainPlan9Lookup() plan9Lookup {
+	return plan9Lookup{
+		lastWithAlgo: func(node *blockNode, algo int32) *blockNode {
+			return node.GetLastWithAlgo(algo, b.chainParams)
+		},
+		mainChain: func(height int32) *blockNode {
+			block, err := b.BlockByHeight(height)
+			if err != nil {
+				return nil
 			}
-		} else {
-			targetAdjusted = 100
-			adjusted = 100
-		}
-		var trailingTimestamps []float64
-		pb = lastNode
-		trailingTimestamps = append(
-			trailingTimestamps, float64(pb.timestamp))
-		counter = 1
-		for ; counter < int(fork.GetAveragingInterval(nH)) &&
-			pb.height > 2; counter++ {
-			pb = pb.RelativeAncestor(1)
-			trailingTimestamps = append(
-				trailingTimestamps, float64(pb.timestamp))
-			counter++
-		}
-		var trailingAdjusted,
-			trailingTargetAdjusted,
-			trailingAdjustment float64
-		if len(trailingTimestamps) > 1 {
-			target := fork.GetTargetTimePerBlock(nH)
-			counter = 0
-			for i := 0; i < len(trailingTimestamps)-1; i++ {
-				factor := 0.81
-				if i == 0 {
-					f := factor
-					for j := 0; j < i; j++ {
-						f *= factor
-					}
-					factor = f
-				} else {
-					factor = 1.0
-				}
-				trailingAdjustment = trailingTimestamps[i] - trailingTimestamps[i+1]
-				trailingAdjustment *= factor
-				switch {
-				case math.IsNaN(trailingAdjustment):
-					break
-				case trailingAdjustment == 0.0:
-					break
-				}
-				trailingAdjusted += trailingAdjustment
-				trailingTargetAdjusted += float64(target) * factor
-				counter++
+			return &blockNode{
+				height:    block.Height(),
+				timestamp: block.MsgBlock().Header.Timestamp.Unix(),
 			}
-		} else {
-			trailingTargetAdjusted = 100
-			trailingAdjusted = 100
+		},
+	}
+}
+// plan9Averages are the results of the four Plan 9 averagers for the next block of an algorithm, each the ratio of the actual to the target time between blocks
+type plan9Averages struct {
+	// last is the newest block of the algorithm, whose target is adjusted
+	last *blockNode
+	// blocks is the number of intervals in the trailing average
+	blocks                             int
+	weighted, trailing, trail, allTime *big.Rat
+}
+// Plan9Divergence is the state of the Plan 9 averagers for the next block of an algorithm. Each term is the ratio of the actual to the target time between blocks, and Adjustment is what the target of the newest block of the algorithm is scaled by. It is for monitoring and simulation and plays no part in consensus.
+type Plan9Divergence struct {
+	Algo       float64
+	Trailing   float64
+	Trail      float64
+	AllTime    float64
+	Adjustment float64
+}
+// CalcPlan9Divergence returns the state of the Plan 9 averagers for the block of an algorithm after the end of the current best chain. The result is false if the averagers do not decide the difficulty of the next block. This function is safe for concurrent access.
+func (b *BlockChain) CalcPlan9Divergence(algo string) (d Plan9Divergence, ok bool) {
+	b.chainLock.RLock()
+	defer b.chainLock.RUnlock()
+	return b.plan9Divergence(b.bestChain.Tip(), algo)
+}
+// plan9Divergence returns the state of the Plan 9 averagers for the block of an algorithm after lastNode
+func (b *BlockChain) plan9Divergence(
+	lastNode *blockNode, algo string) (d Plan9Divergence, ok bool) {
+	if fork.GetCurrent(b.chainParams, lastNode.height+1) != 1 || lastNode.height == 0 {
+		return
+	}
+	a, _ := b.calcPlan9Averages(lastNode, algo, b.chainPlan9Lookup())
+	if a == nil {
+		return
+	}
+	return Plan9Divergence{
+		Algo:       ratFloat(a.weighted),
+		Trailing:   ratFloat(a.trailing),
+		Trail:      ratFloat(a.trail),
+		AllTime:    ratFloat(a.allTime),
+		Adjustment: ratFloat(a.adjustment()),
+	}, true
+}
+// calcPlan9Difficulty calculates the Plan 9 difficulty for the next block of an algorithm after lastNode. The mean of the cubes of the four averagers scales the target of the newest block of the algorithm. All of the arithmetic is done with exact fractions and integers so that every platform arrives at the same bits.
+//
+// The adjustment is returned if the target was scaled by it, otherwise it is nil.
+func (
+	b *BlockChain,
+) calcPlan9Difficulty(
+	lastNode *blockNode,
+	algoname string,
+	lookup plan9Lookup,
+	l bool,
+) (
+	newTargetBits uint32,
+	adjustment *big.Rat,
+) {
+	a, newTargetBits := b.calcPlan9Averages(lastNode, algoname, lookup)
+	if a == nil {
+		return
+	}
+	adjustment = a.adjustment()
+	// the new target is truncated towards zero
+	newTarget := new(big.Int).Mul(CompactToBig(a.last.bits), adjustment.Num())
+	newTarget.Quo(newTarget, adjustment.Denom())
+	if newTarget.Cmp(CompactToBig(newTargetBits)) >= 0 {
+		return newTargetBits, nil
+	}
+	newTargetBits = BigToCompact(newTarget)
+	if l {
+		ttpb := float64(fork.GetTargetTimePerBlock(b.chainParams, lastNode.height+1))
+		log <- cl.Infof{
+			"%d: old %08x, new %08x, av %3.2f, tr %3.2f, tr wgtd %3.2f, alg wgtd %3.2f, blks %d, adj %0.1f%%, alg %s",
+			lastNode.height + 1, a.last.bits,
+			newTargetBits,
+			ratFloat(a.allTime) * ttpb,
+			ratFloat(a.trail) * ttpb,
+			ratFloat(a.trailing) * ttpb,
+			ratFloat(a.weighted) * ttpb,
+			a.blocks,
+			(1 - ratFloat(adjustment)) * 100,
+			algoname,
 		}
-		ttpb := float64(fork.GetTargetTimePerBlock(nH))
-		allTimeDivergence := allTimeAverage / ttpb
-		trailTimeDivergence := trailTimeAverage / ttpb
-		trailingTimeDivergence := trailingAdjusted / trailingTargetAdjusted
-		log <- cl.Trace{
-			"trailingtimedivergence",
-			trailingTimeDivergence,
-			trailingAdjusted,
-			trailingTargetAdjusted}
-		weighted := adjusted / targetAdjusted
-		adjustment = (weighted*weighted*weighted +
-			trailingTimeDivergence*trailingTimeDivergence*trailingTimeDivergence +
-			trailTimeDivergence*trailTimeDivergence*trailTimeDivergence +
-			allTimeDivergence*allTimeDivergence*allTimeDivergence) / 4.0
-		if adjustment < 0 {
-			fmt.Println("negative weight adjustment")
-			adjustment = allTimeDivergence
+	}
+	return
+}
+// calcPlan9Averages runs the four Plan 9 averagers for the next block of an algorithm after lastNode: the blocks of the same algorithm, the trailing blocks of all algorithms, and the blocks of the main chain since the start of the averaging window and since the hard fork.
+//
+// When the averagers don't apply the result is nil and bits is the difficulty of the next block, otherwise bits is the minimum difficulty of the algorithm.
+func (
+	b *BlockChain,
+) calcPlan9Averages(
+	lastNode *blockNode,
+	algoname string,
+	lookup plan9Lookup,
+) (
+	a *plan9Averages,
+	bits uint32,
+) {
+	nH := lastNode.height + 1
+	algo := fork.GetAlgoVer(b.chainParams, algoname, nH)
+	bits = fork.GetMinBits(b.chainParams, algoname, nH)
+	last := lastNode
+	// find the most recent block of the same algo
+	if last.version != algo {
+		last = lookup.lastWithAlgo(last.RelativeAncestor(1), algo)
+		// ignore the first block as its time is not a normal timestamp
+		if last == nil || last.height < 1 {
+			return
 		}
-		if math.IsNaN(adjustment) {
-			return lastNode.bits, nil
+	}
+	averagingInterval := fork.GetAveragingInterval(b.chainParams, nH)
+	// collect the timestamps of all the blocks of the same algo until we pass genesis block or get AveragingInterval blocks
+	timestamps := []int64{last.timestamp}
+	for pb := last; int64(len(timestamps)) < averagingInterval && pb.height > 2; {
+		p := pb.RelativeAncestor(1)
+		if p.height == 0 {
+			return nil, fork.SecondPowLimitBits
 		}
-		// Bias adjustment for difficulty reductions to reduce incidence of sub 1 second blocks
-		if adjustment < 0 {
-			adjustment = (1 - adjustment) * adjustment
+		if pb = lookup.lastWithAlgo(p, algo); pb == nil || pb.height < 1 {
+			break
 		}
-		bigadjustment := big.NewFloat(adjustment)
-		bigoldtarget := big.NewFloat(1.0).SetInt(CompactToBig(last.bits))
-		bigfnewtarget := big.NewFloat(1.0).Mul(bigadjustment, bigoldtarget)
-		newtarget, _ := bigfnewtarget.Int(nil)
-		if newtarget == nil {
-			return newTargetBits, nil
+		timestamps = append(timestamps, pb.timestamp)
+	}
+	if len(timestamps) < 2 {
+		return nil, fork.SecondPowLimitBits
+	}
+	hf := fork.Get(b.chainParams, nH)
+	ttpb := fork.GetTargetTimePerBlock(b.chainParams, nH)
+	numAlgos := int64(len(hf.Algos))
+	// the trailing window counts two for every block, so it covers half of the averaging interval
+	trailingTimestamps := []int64{lastNode.timestamp}
+	for pb, counter := lastNode, int64(1); counter < averagingInterval && pb.height > 2; counter += 2 {
+		pb = pb.RelativeAncestor(1)
+		trailingTimestamps = append(trailingTimestamps, pb.timestamp)
+	}
+	startHeight := hf.ActivationHeight
+	if b.chainParams.Name == "testnet" {
+		startHeight = 1
+	}
+	trailHeight := int32(int64(lastNode.height) - averagingInterval*numAlgos)
+	if trailHeight < 0 {
+		trailHeight = 1
+	}
+	a = &plan9Averages{
+		last:     last,
+		blocks:   len(trailingTimestamps) - 1,
+		weighted: weightedDivergence(timestamps, plan9AlgoFactor, ttpb*numAlgos),
+		trailing: weightedDivergence(trailingTimestamps, plan9TrailingFactor, ttpb),
+	}
+	for _, s := range []struct {
+		d     **big.Rat
+		first *blockNode
+	}{
+		{&a.trail, lookup.mainChain(trailHeight)},
+		{&a.allTime, lookup.mainChain(startHeight)},
+	} {
+		if *s.d = spanDivergence(lastNode, s.first, ttpb); *s.d != nil {
+			continue
 		}
-		mintarget := CompactToBig(newTargetBits)
-		if newtarget.Cmp(mintarget) < 0 {
-			newTargetBits = BigToCompact(newtarget)
-			b.DifficultyAdjustments[algoname] = adjustment
-			if l {
-				log <- cl.Infof{
-					"%d: old %08x, new %08x, av %3.2f, tr %3.2f, tr wgtd %3.2f, alg wgtd %3.2f, blks %d, adj %0.1f%%, alg %s",
-					lastNode.height + 1, last.bits,
-					newTargetBits,
-					allTimeAverage,
-					trailTimeAverage,
-					trailingTimeDivergence * ttpb,
-					weighted * ttpb,
-					counter,
-					(1 - adjustment) * 100,
-					fork.List[1].AlgoVers[algo],
-				}
-			}
+		// A span over no blocks divides by zero. With no time either it is not a number and the difficulty stays where it is, otherwise it is infinite and the target is the easiest there is.
+		if s.first.timestamp == lastNode.timestamp {
+			return nil, lastNode.bits
 		}
-		return newTargetBits, nil
+		return nil, bits
 	}
-	// nH := lastNode.height + 1
-	// algo := fork.GetAlgoVer(algoname, nH)
-	return fork.GetMinBits(algoname, nH), nil
+	return
+}
+// adjustment returns the mean of the cubes of the averages, which the previous target of the algorithm is multiplied by
+func (a *plan9Averages) adjustment() (adjustment *big.Rat) {
+	adjustment = new(big.Rat)
+	for _, d := range []*big.Rat{a.weighted, a.trailing, a.trail, a.allTime} {
+		adjustment.Add(adjustment, new(big.Rat).Mul(d, new(big.Rat).Mul(d, d)))
+	}
+	adjustment.Quo(adjustment, big.NewRat(4, 1))
+	if adjustment.Sign() < 0 {
+		log <- cl.Debug{"negative weight adjustment"}
+		adjustment.Set(a.allTime)
+	}
+	// Bias adjustment for difficulty reductions to reduce incidence of sub 1 second blocks
+	if adjustment.Sign() < 0 {
+		adjustment.Mul(new(big.Rat).Sub(big.NewRat(1, 1), adjustment), adjustment)
+	}
+	return
+}
+// weightedDivergence returns the ratio of the time between the timestamps of a sequence of blocks, newest first, to the target time for that many blocks, with the newest interval weighted by factor. With fewer than two timestamps the ratio is one.
+func weightedDivergence(
+	timestamps []int64, factor *big.Rat, target int64) *big.Rat {
+	n := len(timestamps)
+	if n < 2 {
+		return big.NewRat(1, 1)
+	}
+	actual := new(big.Rat).Mul(factor, big.NewRat(timestamps[0]-timestamps[1], 1))
+	actual.Add(actual, big.NewRat(timestamps[1]-timestamps[n-1], 1))
+	expected := new(big.Rat).Mul(factor, big.NewRat(target, 1))
+	expected.Add(expected, new(big.Rat).Mul(big.NewRat(target, 1), big.NewRat(int64(n-2), 1)))
+	return actual.Quo(actual, expected)
+}
+// spanDivergence returns the ratio of the average time per block from first to last to the target time per block. The ratio is one when there is no first block, and nil when first is at the height of last.
+func spanDivergence(
+	last, first *blockNode, target int64) *big.Rat {
+	if first == nil {
+		return big.NewRat(1, 1)
+	}
+	blocks := int64(last.height - first.height)
+	if blocks == 0 {
+		return nil
+	}
+	d := big.NewRat(last.timestamp-first.timestamp, blocks)
+	return d.Quo(d, big.NewRat(target, 1))
+}
+// ratFloat returns the nearest float64 to a fraction, for display
+func ratFloat(
+	r *big.Rat) (f float64) {
+	f, _ = r.Float64()
+	return
 }
 // BigToCompact converts a whole number N to a compact representation using an unsigned 32-bit number.  The compact representation only provides 23 bits of precision, so values larger than (2^23 - 1) only encode the most significant digits of the number.  See CompactToBig for details.
 func BigToCompact(n *big.Int) uint32 {
@@ -368,14 +466,14 @@ func BigToCompact(n *big.Int) uint32 {
 	return compact
 }
 // CalcWork calculates a work value from difficulty bits.  Bitcoin increases the difficulty for generating a block by decreasing the value which the generated hash must be less than.  This difficulty target is stored in each block header using a compact representation as described in the documentation for CompactToBig. The main chain is selected by choosing the chain that has the most proof of work (highest difficulty). Since a lower target difficulty value equates to higher actual difficulty, the work value which will be accumulated must be the inverse of the difficulty.  Also, in order to avoid potential division by zero and really small floating point numbers, the result adds 1 to the denominator and multiplies the numerator by 2^256.
-func CalcWork(bits uint32, height int32, algover int32) *big.Int {
+func CalcWork(bits uint32, height int32, algover int32, chainParams *chaincfg.Params) *big.Int {
 	// Return a work value of zero if the passed difficulty bits represent a negative number. Note this should not happen in practice with valid blocks, but an invalid block could trigger it.
 	difficultyNum := CompactToBig(bits)
 	// To make the difficulty values correlate to number of hash operations, multiply this difficulty base by the nanoseconds/hash figures in the fork algorithms list
-	current := fork.GetCurrent(height)
-	algoname := fork.List[current].AlgoVers[algover]
-	difficultyNum = new(big.Int).Mul(difficultyNum, big.NewInt(fork.List[current].Algos[algoname].NSperOp))
-	difficultyNum = new(big.Int).Quo(difficultyNum, big.NewInt(fork.List[current].WorkBase))
+	current := fork.Get(chainParams, height)
+	algoname := current.AlgoVers[algover]
+	difficultyNum = new(big.Int).Mul(difficultyNum, big.NewInt(current.Algos[algoname].NSperOp))
+	difficultyNum = new(big.Int).Quo(difficultyNum, big.NewInt(current.WorkBase))
 	if difficultyNum.Sign() <= 0 {
 		return big.NewInt(0)
 	}
@@ -383,6 +481,14 @@ func CalcWork(bits uint32, height int32, algover int32) *big.Int {
 	r := new(big.Int).Div(oneLsh256, denominator)
 	return r
 }
+// CalcHashes returns the number of hashes it takes on average to find a block with the given target bits, without the weighting by the speed of the algorithm that CalcWork applies. It is zero when the bits are not a valid target.
+func CalcHashes(bits uint32) *big.Int {
+	target := CompactToBig(bits)
+	if target.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Div(oneLsh256, target.Add(target, bigOne))
+}
 // CompactToBig converts a compact representation of a whole number N to an unsigned 32-bit number.  The representation is similar to IEEE754 floating point numbers.
 /*
 Like IEEE754 floating point, there are three basic components: the sign, the exponent, and the mantissa.  They are broken out as follows:
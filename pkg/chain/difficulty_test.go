@@ -1,7 +1,18 @@
 package chain
 import (
+	"bufio"
+	"fmt"
+	"math"
 	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 )
 // TestBigToCompact ensures BigToCompact converts big integers to the expected compact representation.
 func TestBigToCompact(
@@ -53,7 +64,7 @@ func TestCalcWork(
 	}
 	for x, test := range tests {
 		bits := uint32(test.in)
-		r := CalcWork(bits, 0, 2)
+		r := CalcWork(bits, 0, 2, &chaincfg.MainNetParams)
 		if r.Int64() != test.out {
 			t.Errorf("TestCalcWork test #%d failed: got %v want %d\n",
 				x, r.Int64(), test.out)
@@ -61,3 +72,191 @@ func TestCalcWork(
 		}
 	}
 }
+// plan9ActivationHeight is where the Plan 9 hard fork activates in the difficulty corpus
+const plan9ActivationHeight = 10
+// loadDifficultyCorpus reads a recorded sequence of block headers from the testdata directory. Each line has the version, timestamp and bits of a block, starting with the genesis block, and the bits the Plan 9 averagers give the block.
+func loadDifficultyCorpus(
+	filename string) (headers []wire.BlockHeader, averaged []uint32, err error) {
+	f, err := os.Open(filepath.Join("testdata", filename))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var version int32
+		var timestamp int64
+		var bits, averagedBits uint32
+		if _, err = fmt.Sscanf(line, "%d %d %x %x", &version, &timestamp, &bits, &averagedBits); err != nil {
+			return nil, nil, fmt.Errorf("%s line %d: %v", filename, len(headers)+1, err)
+		}
+		headers = append(headers, wire.BlockHeader{
+			Version:   version,
+			Timestamp: time.Unix(timestamp, 0),
+			Bits:      bits,
+		})
+		averaged = append(averaged, averagedBits)
+	}
+	err = scanner.Err()
+	return
+}
+// plan9FloatDivergence is the float64 averager the Plan 9 difficulty was calculated with before it was done with integers: the time between the timestamps, newest first, over the target time for as many blocks, the newest interval weighted by factor.
+func plan9FloatDivergence(
+	timestamps []int64, factor float64, target int64) float64 {
+	var adjusted, targetAdjusted float64
+	for i := 0; i < len(timestamps)-1; i++ {
+		f := 1.0
+		if i == 0 {
+			f = factor
+		}
+		adjusted += float64(timestamps[i]-timestamps[i+1]) * f
+		targetAdjusted += float64(target) * f
+	}
+	return adjusted / targetAdjusted
+}
+// TestPlan9Averagers ensures the integer averagers and target scaling give the same results as the float64 calculation they replace, down to the bits of the new target.
+func TestPlan9Averagers(
+	t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	oldBits := []uint32{0x1e0fffff, 0x1d7fffff, 0x1c3a5f11, 0x1b0404cb}
+	for n := 0; n < 1000; n++ {
+		target := int64(1 + rng.Intn(200))
+		timestamps := make([]int64, 2+rng.Intn(50))
+		timestamps[0] = 1500000000
+		for i := 1; i < len(timestamps); i++ {
+			// blocks come out of order now and then, as their times are set by the miners
+			timestamps[i] = timestamps[i-1] - int64(rng.Intn(int(3*target))) + target/4
+		}
+		weighted := weightedDivergence(timestamps, plan9AlgoFactor, target)
+		trailing := weightedDivergence(timestamps, plan9TrailingFactor, target)
+		want := []float64{
+			plan9FloatDivergence(timestamps, 0.75, target),
+			plan9FloatDivergence(timestamps, 0.81, target),
+		}
+		for i, got := range []*big.Rat{weighted, trailing} {
+			if diff := math.Abs(ratFloat(got) - want[i]); diff > 1e-12*math.Abs(want[i]) {
+				t.Fatalf("case %d divergence %d: got %v, expected %v", n, i, ratFloat(got), want[i])
+			}
+		}
+		blocks := int64(1 + rng.Intn(100))
+		span := timestamps[0] - timestamps[len(timestamps)-1]
+		spanRat := big.NewRat(span, blocks*target)
+		spanFloat := float64(span) / float64(blocks) / float64(target)
+		if diff := math.Abs(ratFloat(spanRat) - spanFloat); diff > 1e-12*math.Abs(spanFloat) {
+			t.Fatalf("case %d span divergence: got %v, expected %v", n, ratFloat(spanRat), spanFloat)
+		}
+		a := &plan9Averages{weighted: weighted, trailing: trailing, trail: spanRat, allTime: spanRat}
+		adjustment := a.adjustment()
+		floatAdjustment := (want[0]*want[0]*want[0] + want[1]*want[1]*want[1] +
+			2*spanFloat*spanFloat*spanFloat) / 4.0
+		if floatAdjustment < 0 {
+			floatAdjustment = spanFloat
+		}
+		if floatAdjustment < 0 {
+			floatAdjustment = (1 - floatAdjustment) * floatAdjustment
+		}
+		old := CompactToBig(oldBits[n%len(oldBits)])
+		newTarget := new(big.Int).Mul(old, adjustment.Num())
+		newTarget.Quo(newTarget, adjustment.Denom())
+		floatTarget, _ := new(big.Float).Mul(big.NewFloat(floatAdjustment), new(big.Float).SetInt(old)).Int(nil)
+		if got, want := BigToCompact(newTarget), BigToCompact(floatTarget); got != want {
+			t.Fatalf("case %d: got bits %08x, float64 calculation gives %08x", n, got, want)
+		}
+	}
+}
+// corpusPlan9Lookup returns the lookup of the Plan 9 averagers for the block after lastNode in a corpus. The newest block of an algorithm is found by the search of GetLastWithAlgo without the check in front of it that returns nothing, so that the averagers are run, and the main chain is the corpus up to lastNode.
+func corpusPlan9Lookup(
+	params *chaincfg.Params, nodes []*blockNode, lastNode *blockNode) plan9Lookup {
+	return plan9Lookup{
+		lastWithAlgo: func(node *blockNode, algo int32) *blockNode {
+			for ; node != nil; node = node.parent {
+				version := node.version
+				if fork.GetCurrent(params, node.height) == 0 && version != 514 && version != 2 {
+					version = 2
+				}
+				if version == algo {
+					return node
+				}
+			}
+			return nil
+		},
+		mainChain: func(height int32) *blockNode {
+			if height < 0 || height > lastNode.height {
+				return nil
+			}
+			return nodes[height]
+		},
+	}
+}
+// TestPlan9DifficultyCorpus ensures the difficulty of every block in the recorded header sequences is the one the chain requires after the blocks before it, and that the Plan 9 averagers give the bits testdata/plan9ref calculates with the float64 Plan 9 difficulty they replace.
+func TestPlan9DifficultyCorpus(
+	t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.HardForks = []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(plan9ActivationHeight),
+	}
+	b := &BlockChain{chainParams: &params}
+	for _, filename := range []string{
+		"plan9-steady.txt",
+		"plan9-shock.txt",
+		"plan9-timewarp.txt",
+	} {
+		headers, averaged, err := loadDifficultyCorpus(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes := []*blockNode{newBlockNode(&headers[0], nil, b.chainParams)}
+		for i := 1; i < len(headers); i++ {
+			header, node := &headers[i], nodes[i-1]
+			algo := fork.GetAlgoName(b.chainParams, header.Version, node.height+1)
+			bits, err := b.calcNextRequiredDifficulty(node, header.Timestamp, algo, false)
+			if err != nil {
+				t.Fatalf("%s block %d: %v", filename, i, err)
+			}
+			if bits != header.Bits {
+				t.Fatalf("%s block %d %s: got bits %08x, recorded %08x",
+					filename, i, algo, bits, header.Bits)
+			}
+			if fork.GetCurrent(b.chainParams, node.height+1) == 1 {
+				bits, _ = b.calcPlan9Difficulty(node, algo,
+					corpusPlan9Lookup(b.chainParams, nodes, node), false)
+			}
+			if bits != averaged[i] {
+				t.Fatalf("%s block %d %s: got averaged bits %08x, expected %08x",
+					filename, i, algo, bits, averaged[i])
+			}
+			header.PrevBlock = node.hash
+			nodes = append(nodes, newBlockNode(header, node, b.chainParams))
+		}
+	}
+}
+// TestHalcyonDifficulty ensures blocks before the Plan 9 hard fork of the main network require the minimum difficulty of their algorithm whatever the blocks before them, which is the rule the chain before the hard fork has been accepted with.
+func TestHalcyonDifficulty(
+	t *testing.T) {
+	b := &BlockChain{chainParams: &chaincfg.MainNetParams}
+	header := wire.BlockHeader{Version: 2, Timestamp: time.Unix(1500000000, 0), Bits: 0x1c0fffff}
+	node := newBlockNode(&header, nil, b.chainParams)
+	for i := 1; i < 30; i++ {
+		header.PrevBlock = node.hash
+		header.Version = []int32{2, 514}[i%2]
+		header.Timestamp = header.Timestamp.Add(time.Duration(i%7) * time.Minute)
+		algo := fork.GetAlgoName(b.chainParams, header.Version, node.height+1)
+		bits, err := b.calcNextRequiredDifficulty(node, header.Timestamp, algo, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := fork.GetMinBits(b.chainParams, algo, node.height+1); bits != want {
+			t.Fatalf("block %d %s: got bits %08x, expected the minimum %08x", i, algo, bits, want)
+		}
+		if prev := node.GetLastWithAlgo(header.Version, b.chainParams); prev != nil {
+			t.Fatalf("block %d %s: found block %d of the algorithm before the hard fork", i, algo, prev.height)
+		}
+		header.Bits = 0x1c0fffff
+		node = newBlockNode(&header, node, b.chainParams)
+	}
+}
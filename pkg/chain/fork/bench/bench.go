@@ -4,18 +4,21 @@ import (
 	"fmt"
 	"time"
 
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
 	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 )
 
 func main() {
-	h := fork.Hash([]byte{}, "blake14lr", fork.List[1].ActivationHeight)
-	for i := range fork.P9Algos {
+	params := &chaincfg.MainNetParams
+	plan9 := params.HardForks[1]
+	h := fork.Hash(params, []byte{}, "blake14lr", plan9.ActivationHeight)
+	for i := range plan9.Algos {
 		fmt.Print(`"`, i, `": {, FirstPowLimitBits, , `)
 		now := time.Now().UnixNano()
 		var samples int64 = 50
 		for j := int64(0); j < samples; j++ {
 
-			h = fork.Hash(h.CloneBytes(), i, fork.List[1].ActivationHeight+1)
+			h = fork.Hash(params, h.CloneBytes(), i, plan9.ActivationHeight+1)
 		}
 		fmt.Println((time.Now().UnixNano()-now)/samples, "},")
 	}
@@ -1,10 +1,11 @@
 // Package fork handles tracking the hard fork status and is used to determine which consensus rules apply on a block
-// TODO: add trailing auto-checkpoint system and hard fork block time change
+// TODO: hard fork block time change
 package fork
 import (
 	"crypto/rand"
 	"encoding/hex"
 	"math/big"
+	"sort"
 	"time"
 )
 // AlgoParams are the identifying block version number and their minimum target bits
@@ -24,17 +25,10 @@ type HardForks struct {
 	WorkBase           int64
 	TargetTimePerBlock time.Duration
 	AveragingInterval  int64
-	TestnetStart       int32
 }
-// AlgoVers is the lookup for pre hardfork
-var AlgoVers = map[int32]string{
-	2:   "sha256d",
-	514: "scrypt",
-}
-// Algos are the specifications identifying the algorithm used in the block proof
-var Algos = map[string]AlgoParams{
-	AlgoVers[2]:   {2, mainPowLimitBits, 0, 824},      //824 ns/op
-	AlgoVers[514]: {514, mainPowLimitBits, 1, 740839}, //740839 ns/op
+// Params is the source of the hard fork schedule of a network, which the chain parameters of every network provide. The schedule is ordered by activation height and starts with the rules in force from the genesis block
+type Params interface {
+	Forks() []HardForks
 }
 // FirstPowLimit is
 var FirstPowLimit = func() big.Int {
@@ -43,69 +37,6 @@ var FirstPowLimit = func() big.Int {
 }()
 // FirstPowLimitBits is
 var FirstPowLimitBits = BigToCompact(&FirstPowLimit)
-// IsTestnet is set at startup here to be accessible to all other libraries
-var IsTestnet bool
-// List is the list of existing hard forks and when they activate
-var List = []HardForks{
-	{
-		Number:           0,
-		Name:             "Halcyon days",
-		ActivationHeight: 0,
-		Algos:            Algos,
-		AlgoVers:         AlgoVers,
-		WorkBase: func() (out int64) {
-			for i := range Algos {
-				out += Algos[i].NSperOp
-			}
-			out /= int64(len(Algos))
-			return
-		}(),
-		TargetTimePerBlock: 3 * time.Minute,
-		AveragingInterval:  10, // 50 minutes
-		TestnetStart:       0,
-	},
-	{
-		Number:           1,
-		Name:             "Plan 9 from Crypto Space",
-		ActivationHeight: 250000,
-		Algos:            P9Algos,
-		AlgoVers:         P9AlgoVers,
-		WorkBase: func() (out int64) {
-			for i := range P9Algos {
-				out += P9Algos[i].NSperOp
-			}
-			out /= int64(len(P9Algos))
-			return
-		}(),
-		TargetTimePerBlock: 9 * time.Second,
-		AveragingInterval:  9600, // 24 hours
-		TestnetStart:       100,
-	},
-}
-// P9AlgoVers is the lookup for after 1st hardfork
-var P9AlgoVers = map[int32]string{
-	0: "blake2b",
-	1: "blake14lr",
-	2: "blake2s",
-	3: "keccak",
-	4: "scrypt",
-	5: "sha256d",
-	6: "skein",
-	7: "stribog",
-	8: "x11",
-}
-// P9Algos is the algorithm specifications after the hard fork
-var P9Algos = map[string]AlgoParams{
-	P9AlgoVers[0]: {0, FirstPowLimitBits, 0, 69495444},
-	P9AlgoVers[1]: {1, FirstPowLimitBits, 1, 79734306},
-	P9AlgoVers[2]: {2, FirstPowLimitBits, 2, 69968425},
-	P9AlgoVers[3]: {3, FirstPowLimitBits, 3, 71988313},
-	P9AlgoVers[4]: {4, FirstPowLimitBits, 4, 68395274},
-	P9AlgoVers[5]: {5, FirstPowLimitBits, 5, 67460443},
-	P9AlgoVers[6]: {6, FirstPowLimitBits, 7, 64433603},
-	P9AlgoVers[7]: {7, FirstPowLimitBits, 6, 69987634},
-	P9AlgoVers[8]: {8, FirstPowLimitBits, 8, 64936544},
-}
 // SecondPowLimit is
 var SecondPowLimit = func() big.Int {
 	mplb, _ := hex.DecodeString("07fffffff0000000000000000000000000000000000000000000000000000000")
@@ -118,80 +49,135 @@ var mainPowLimit = func() big.Int {
 	return *big.NewInt(0).SetBytes(mplb)
 }()
 var mainPowLimitBits = BigToCompact(&mainPowLimit)
-// GetAlgoID returns the 'algo_id' which in pre-hardfork is not the same as the block version number, but is afterwards
-func GetAlgoID(algoname string, height int32) uint32 {
-	if GetCurrent(height) > 1 {
-		return P9Algos[algoname].AlgoID
+// Halcyon returns the original rules of the chain, sha256d and scrypt blocks every 3 minutes, in force after the given height
+func Halcyon(
+	activation int32) HardForks {
+	algoVers := map[int32]string{
+		2:   "sha256d",
+		514: "scrypt",
+	}
+	algos := map[string]AlgoParams{
+		algoVers[2]:   {2, mainPowLimitBits, 0, 824},      //824 ns/op
+		algoVers[514]: {514, mainPowLimitBits, 1, 740839}, //740839 ns/op
+	}
+	return HardForks{
+		Number:             0,
+		Name:               "Halcyon days",
+		ActivationHeight:   activation,
+		Algos:              algos,
+		AlgoVers:           algoVers,
+		WorkBase:           workBase(algos),
+		TargetTimePerBlock: 3 * time.Minute,
+		AveragingInterval:  10, // 50 minutes
 	}
-	return Algos[algoname].AlgoID
+}
+// Plan9 returns the rules of the Plan 9 hard fork, nine algorithms sharing 9 second blocks, in force after the given height
+func Plan9(
+	activation int32) HardForks {
+	algoVers := map[int32]string{
+		0: "blake2b",
+		1: "blake14lr",
+		2: "blake2s",
+		3: "keccak",
+		4: "scrypt",
+		5: "sha256d",
+		6: "skein",
+		7: "stribog",
+		8: "x11",
+	}
+	algos := map[string]AlgoParams{
+		algoVers[0]: {0, FirstPowLimitBits, 0, 69495444},
+		algoVers[1]: {1, FirstPowLimitBits, 1, 79734306},
+		algoVers[2]: {2, FirstPowLimitBits, 2, 69968425},
+		algoVers[3]: {3, FirstPowLimitBits, 3, 71988313},
+		algoVers[4]: {4, FirstPowLimitBits, 4, 68395274},
+		algoVers[5]: {5, FirstPowLimitBits, 5, 67460443},
+		algoVers[6]: {6, FirstPowLimitBits, 7, 64433603},
+		algoVers[7]: {7, FirstPowLimitBits, 6, 69987634},
+		algoVers[8]: {8, FirstPowLimitBits, 8, 64936544},
+	}
+	return HardForks{
+		Number:             1,
+		Name:               "Plan 9 from Crypto Space",
+		ActivationHeight:   activation,
+		Algos:              algos,
+		AlgoVers:           algoVers,
+		WorkBase:           workBase(algos),
+		TargetTimePerBlock: 9 * time.Second,
+		AveragingInterval:  9600, // 24 hours
+	}
+}
+// workBase is the average time of a hash of the algorithms of a hard fork
+func workBase(
+	algos map[string]AlgoParams) (out int64) {
+	for i := range algos {
+		out += algos[i].NSperOp
+	}
+	out /= int64(len(algos))
+	return
+}
+// Get returns the hard fork in force at a height
+func Get(
+	p Params, height int32) HardForks {
+	return p.Forks()[GetCurrent(p, height)]
+}
+// GetAlgoID returns the 'algo_id' which in pre-hardfork is not the same as the block version number, but is afterwards
+func GetAlgoID(
+	p Params, algoname string, height int32) uint32 {
+	return Get(p, height).Algos[algoname].AlgoID
 }
 // GetAlgoName returns the string identifier of an algorithm depending on hard fork activation status
-func GetAlgoName(algoVer int32, height int32) (name string) {
-	hf := GetCurrent(height)
-	name = List[hf].AlgoVers[algoVer]
+func GetAlgoName(
+	p Params, algoVer int32, height int32) (name string) {
+	name = Get(p, height).AlgoVers[algoVer]
 	return
 }
 // GetAlgoVer returns the version number for a given algorithm (by string name) at a given height. If "random" is given, a random number is taken from the system secure random source (for randomised cpu mining)
-func GetAlgoVer(name string, height int32) (version int32) {
-	n := "sha256d"
-	hf := GetCurrent(height)
+func GetAlgoVer(
+	p Params, name string, height int32) (version int32) {
+	hf := Get(p, height)
 	if name == "random" {
-		rn, _ := rand.Int(rand.Reader,
-			big.NewInt(int64(len(P9AlgoVers)-1)))
-		randomalgover := int32(rn.Uint64())
-		switch hf {
-		case 0:
-			switch randomalgover & 1 {
-			case 0:
-				version = 2
-			case 1:
-				version = 514
-			}
-			return
-		case 1:
-			rndalgo := List[1].AlgoVers[randomalgover]
-			algo := List[1].Algos[rndalgo].Version
-			return algo
+		var versions []int
+		for i := range hf.AlgoVers {
+			versions = append(versions, int(i))
 		}
-	} else {
-		n = name
+		sort.Ints(versions)
+		rn, _ := rand.Int(rand.Reader, big.NewInt(int64(len(versions))))
+		return int32(versions[rn.Uint64()])
 	}
-	version = List[hf].Algos[n].Version
+	version = hf.Algos[name].Version
 	return
 }
 // GetAveragingInterval returns the active block interval target based on hard fork status
-func GetAveragingInterval(height int32) (r int64) {
-	r = List[GetCurrent(height)].AveragingInterval
+func GetAveragingInterval(
+	p Params, height int32) (r int64) {
+	r = Get(p, height).AveragingInterval
 	return
 }
 // GetCurrent returns the hardfork number code
-func GetCurrent(height int32) (curr int) {
-	if IsTestnet {
-		for i := range List {
-			if height > List[i].TestnetStart {
-				curr = i
-			}
-		}
-	}
-	for i := range List {
-		if height > List[i].ActivationHeight {
+func GetCurrent(
+	p Params, height int32) (curr int) {
+	for i, x := range p.Forks() {
+		if height > x.ActivationHeight {
 			curr = i
 		}
 	}
 	return
 }
-// GetMinBits returns the minimum diff bits based on height and testnet
-func GetMinBits(algoname string, height int32) (mb uint32) {
-	curr := GetCurrent(height)
-	mb = List[curr].Algos[algoname].MinBits
+// GetMinBits returns the minimum diff bits based on height
+func GetMinBits(
+	p Params, algoname string, height int32) (mb uint32) {
+	mb = Get(p, height).Algos[algoname].MinBits
 	return
 }
 // GetMinDiff returns the minimum difficulty in uint256 form
-func GetMinDiff(algoname string, height int32) (md *big.Int) {
-	return CompactToBig(GetMinBits(algoname, height))
+func GetMinDiff(
+	p Params, algoname string, height int32) (md *big.Int) {
+	return CompactToBig(GetMinBits(p, algoname, height))
 }
 // GetTargetTimePerBlock returns the active block interval target based on hard fork status
-func GetTargetTimePerBlock(height int32) (r int64) {
-	r = int64(List[GetCurrent(height)].TargetTimePerBlock)
+func GetTargetTimePerBlock(
+	p Params, height int32) (r int64) {
+	r = int64(Get(p, height).TargetTimePerBlock)
 	return
 }
@@ -44,9 +44,9 @@ func Cryptonight7v2(
 	bytes []byte) []byte {
 	return cryptonight.Sum(bytes, 2)
 }
-// Hash computes the hash of bytes using the named hash
+// Hash computes the hash of bytes using the named hash, with the rules of the hard fork in force at the height on the network
 func Hash(
-	bytes []byte, name string, height int32) (out chainhash.Hash) {
+	p Params, bytes []byte, name string, height int32) (out chainhash.Hash) {
 	switch name {
 	case "blake2b":
 		b := Argon2i(Cryptonight7v2(Blake2b(bytes)))
@@ -61,14 +61,14 @@ func Hash(
 		b := Argon2i(Cryptonight7v2(Lyra2REv2(bytes)))
 		_ = out.SetBytes(rightShift(Lyra2REv2(b)))
 	case "scrypt":
-		if GetCurrent(height) > 0 {
+		if GetCurrent(p, height) > 0 {
 			b := Argon2i(Cryptonight7v2(Scrypt(bytes)))
 			_ = out.SetBytes(rightShift(Scrypt(b)))
 		} else {
 			_ = out.SetBytes(Scrypt(bytes))
 		}
 	case "sha256d": // sha256d
-		if GetCurrent(height) > 0 {
+		if GetCurrent(p, height) > 0 {
 			b := Argon2i(Cryptonight7v2(chainhash.DoubleHashB(bytes)))
 			_ = out.SetBytes(rightShift(chainhash.DoubleHashB(b)))
 		} else {
@@ -74,7 +74,7 @@ func (
 ) GenerateNBlocks(
 	n uint32, algo string) ([]*chainhash.Hash, error) {
 	m.Lock()
-	log <- cl.Infof{"generating %s blocks...", m.cfg.Algo}
+	log <- cl.Infof{"generating %s blocks...", algo}
 	// Respond with an error if server is already mining.
 	if m.started || m.discreteMining {
 		m.Unlock()
@@ -173,6 +173,15 @@ func (
 	name string) {
 	m.cfg.Algo = name
 }
+// SetMiningAddrs replaces the payment addresses the generated blocks randomly choose from. This function is safe for concurrent access.
+func (
+	m *CPUMiner,
+) SetMiningAddrs(
+	addrs []util.Address) {
+	m.submitBlockLock.Lock()
+	m.cfg.MiningAddrs = addrs
+	m.submitBlockLock.Unlock()
+}
 // SetNumWorkers sets the number of workers to create which solve blocks.  Any negative values will cause a default number of workers to be used which is based on the number of processor cores in the system.  A value of 0 will cause all CPU mining to be stopped. This function is safe for concurrent access.
 func (
 	m *CPUMiner,
@@ -265,8 +274,8 @@ out:
 		rand.Seed(time.Now().UnixNano())
 		payToAddr := m.cfg.MiningAddrs[rand.Intn(len(m.cfg.MiningAddrs))]
 		// Create a new block template using the available transactions in the memory pool as a source of transactions to potentially include in the block.
-		algo := fork.GetAlgoVer(m.cfg.Algo, m.b.BestSnapshot().Height)
-		algoname := fork.GetAlgoName(algo, m.b.BestSnapshot().Height)
+		algo := fork.GetAlgoVer(m.cfg.ChainParams, m.cfg.Algo, m.b.BestSnapshot().Height)
+		algoname := fork.GetAlgoName(m.cfg.ChainParams, algo, m.b.BestSnapshot().Height)
 		template, err := m.g.NewBlockTemplate(payToAddr, algoname)
 		m.submitBlockLock.Unlock()
 		if err != nil {
@@ -279,7 +288,7 @@ out:
 			// if m.cfg.ChainParams.Name == "testnet" {
 			// 	rand.Seed(time.Now().UnixNano())
 			// 	delay := uint16(rand.Int()) >> 6
-			// fmt.Printf("%s testnet delay %dms algo %s\n", time.Now().Format("2006-01-02 15:04:05.000000"), delay, fork.List[fork.GetCurrent(curHeight+1)].AlgoVers[block.MsgBlock().Header.Version])
+			// fmt.Printf("%s testnet delay %dms algo %s\n", time.Now().Format("2006-01-02 15:04:05.000000"), delay, fork.GetAlgoName(m.cfg.ChainParams, block.MsgBlock().Header.Version, curHeight+1))
 			// time.Sleep(time.Millisecond * time.Duration(delay))
 			// }
 			m.submitBlock(block)
@@ -345,7 +354,7 @@ func (
 	m *CPUMiner,
 ) solveBlock(
 	msgBlock *wire.MsgBlock, blockHeight int32, testnet bool, ticker *time.Ticker, quit chan struct{}) bool {
-	algoName := fork.GetAlgoName(
+	algoName := fork.GetAlgoName(m.cfg.ChainParams, 
 		msgBlock.Header.Version, m.b.BestSnapshot().Height)
 	// Choose a random extra nonce offset for this block template and worker.
 	enOffset, err := wire.RandomUint64()
@@ -369,12 +378,12 @@ func (
 		rn, _ := wire.RandomUint64()
 		rnonce := uint32(rn)
 		// Do more rounds the more the difficulty will adjust down
-		mn := uint32(
-			float64(maxNonce)*m.b.DifficultyAdjustments[algoName]) + 27
+		adjustment := m.b.DifficultyAdjustment(algoName)
+		mn := uint32(float64(maxNonce)*adjustment) + 27
 		if blockHeight < 20 {
 			mn = 27
 		}
-		log <- cl.Info{mn, "rounds of", algoName, m.b.DifficultyAdjustments[algoName]}
+		log <- cl.Info{mn, "rounds of", algoName, adjustment}
 		for i := uint32(rnonce); i <= rnonce+mn; i++ {
 			select {
 			case <-quit:
@@ -400,7 +409,7 @@ func (
 			var incr uint64
 			incr = 1
 			header.Nonce = i
-			hash := header.BlockHashWithAlgos(blockHeight)
+			hash := header.BlockHashWithAlgos(m.cfg.ChainParams, blockHeight)
 			hashesCompleted += incr
 			// The block is solved when the new block hash is less than the target difficulty.  Yay!
 			if blockchain.HashToBig(&hash).Cmp(targetDifficulty) <= 0 {
@@ -498,11 +507,11 @@ func (
 			"%s new block height %d %s %10d %08x %v %s %ds since prev",
 			time.Now().Format("2006-01-02 15:04:05.000000"),
 			block.Height(),
-			block.MsgBlock().BlockHashWithAlgos(block.Height()),
+			block.MsgBlock().BlockHashWithAlgos(m.cfg.ChainParams, block.Height()),
 			block.MsgBlock().Header.Timestamp.Unix(),
 			block.MsgBlock().Header.Bits,
 			util.Amount(coinbaseTx.Value),
-			fork.GetAlgoName(block.MsgBlock().Header.Version, block.Height()),
+			fork.GetAlgoName(m.cfg.ChainParams, block.MsgBlock().Header.Version, block.Height()),
 			since,
 		)
 	},
@@ -510,9 +519,9 @@ func (
 	Log.Wrnc(func() string {
 		return fmt.Sprintf(
 			"Block submitted via CPU miner accepted (algo %s, hash %s, amount %v)",
-			fork.GetAlgoName(block.MsgBlock().Header.Version,
+			fork.GetAlgoName(m.cfg.ChainParams, block.MsgBlock().Header.Version,
 				block.Height()),
-			block.MsgBlock().BlockHashWithAlgos(block.Height()),
+			block.MsgBlock().BlockHashWithAlgos(m.cfg.ChainParams, block.Height()),
 			util.Amount(coinbaseTx.Value),
 		)
 	})
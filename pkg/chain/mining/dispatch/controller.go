@@ -1,26 +1,32 @@
-// +build test
-
-
 package controller
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
+	"net"
+	"sort"
 	"sync"
 	"time"
-	"git.parallelcoin.io/dev/9/pkg/util/cl"
 	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
 	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
 	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	"git.parallelcoin.io/dev/9/pkg/chain/mining"
 	"git.parallelcoin.io/dev/9/pkg/chain/wire"
 	"git.parallelcoin.io/dev/9/pkg/util"
-	"github.com/xtaci/kcp-go"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
 )
 const (
-	// maxNonce is the maximum value a nonce can be in a block header.
-	maxNonce = ^uint32(0) // 2^32 - 1
-	// maxExtraNonce is the maximum value an extra nonce used in a coinbase transaction can be.
-	maxExtraNonce = ^uint64(0) // 2^64 - 1
+	// updateInterval is how often the controller checks for a new best block or mempool change
+	updateInterval = time.Second / 2
+	// heartbeatInterval is how often the current work is resent to subscribers when nothing has changed, so workers know the controller is alive and lost datagrams are replaced
+	heartbeatInterval = time.Second * 3
+	// minMempoolInterval is the minimum time between new templates caused only by mempool changes
+	minMempoolInterval = time.Second * 3
+	// SubscriptionTimeout is how long a subscriber is kept after it last renewed its subscription
+	SubscriptionTimeout = time.Second * 30
+	// maxJobs is the number of recent jobs on the current best block that solutions are accepted for
+	maxJobs = 16
 )
 // Config is a descriptor containing the controller configuration.
 type Config struct {
@@ -30,11 +36,11 @@ type Config struct {
 	ChainParams *chaincfg.Params
 	// BlockTemplateGenerator identifies the instance to use in order to generate block templates that the miner will attempt to solve.
 	BlockTemplateGenerator *mining.BlkTmplGenerator
-	// MiningAddrs is a list of payment addresses to use for the generated blocks.  Each generated block will randomly choose one of thec.
+	// MiningAddrs is a list of payment addresses to use for the generated blocks.  Each generated block will randomly choose one of them.
 	MiningAddrs []util.Address
 	// ProcessBlock defines the function to call with any solved blocks. It typically must run the provided block through the same set of rules and handling as any other block coming from the network.
 	ProcessBlock func(*util.Block, blockchain.BehaviorFlags) (bool, error)
-	// MinerListener is the listener that will accept miner subscriptions and such
+	// MinerListener is the UDP address that will accept miner subscriptions and solutions
 	MinerListener string
 	// MinerKey is generated from the password specified in the main configuration for miner port using Stribog hash to derive the nonce, Argon2i to expand the password, and a final pass of Keccak
 	MinerKey []byte
@@ -43,20 +49,40 @@ type Config struct {
 	// IsCurrent defines the function to use to obtain whether or not the block chain is current.  This is used by the automatic persistent mining routine to determine whether or it should attempt mining. This is useful because there is no point in mining if the chain is not current since any solved blocks would be on a side chain and and up orphaned anyways.
 	IsCurrent func() bool
 }
+// job is a block template sent out to workers, kept so that solutions can be turned back into blocks. The merkle root and coinbase of its work are those of the extra nonce of each subscriber.
+type job struct {
+	work  *Work
+	block *wire.MsgBlock
+}
+// subscriber is a worker that is sent new work
+type subscriber struct {
+	addr     *net.UDPAddr
+	lastSeen time.Time
+	// extraNonce is given to the subscriber when it subscribes so its coinbase, and so its headers, differ from those of every other subscriber
+	extraNonce uint32
+	// job and payload are the last work made for the subscriber, kept so heartbeats don't rebuild the merkle root
+	job     uint64
+	payload []byte
+}
 // Controller delivers new work to miner clients
 type Controller struct {
 	sync.Mutex
-	b                *blockchain.BlockChain
-	g                *mining.BlkTmplGenerator
-	cfg              Config
-	started          bool
-	submitBlockLock  sync.Mutex
-	wg               sync.WaitGroup
-	workerWg         sync.WaitGroup
-	updateNumWorkers chan struct{}
-	quit             chan struct{}
-	listener         *kcp.Listener
-	workers          []*kcp.UDPSession
+	b               *blockchain.BlockChain
+	g               *mining.BlkTmplGenerator
+	cfg             Config
+	started         bool
+	submitBlockLock sync.Mutex
+	wg              sync.WaitGroup
+	quit            chan struct{}
+	conn            *net.UDPConn
+	subsMtx         sync.Mutex
+	subscribers     map[string]*subscriber
+	replays         replayCache
+	nextExtraNonce  uint32
+	jobsMtx         sync.Mutex
+	jobs            map[uint64]*job
+	current         *job
+	nextJob         uint64
 }
 // submitBlock submits the passed block to network after ensuring it passes all of the consensus validation rules.
 func (c *Controller) submitBlock(block *util.Block) bool {
@@ -71,6 +97,11 @@ func (c *Controller) submitBlock(block *util.Block) bool {
 		}
 		return false
 	}
+	prevBlock, err := c.b.BlockByHeight(block.Height() - 1)
+	if err != nil {
+		log <- cl.Debug{"previous block of block submitted via miner not found:", err}
+		return false
+	}
 	// Process this block using the same rules as blocks coming from other nodes.  This will in turn relay it to the network like normal.
 	isOrphan, err := c.cfg.ProcessBlock(block, blockchain.BFNone)
 	if err != nil {
@@ -91,150 +122,318 @@ func (c *Controller) submitBlock(block *util.Block) bool {
 	}
 	// The block was accepted.
 	coinbaseTx := block.MsgBlock().Transactions[0].TxOut[0]
-	prevHeight := block.Height() - 1
-	prevBlock, _ := c.b.BlockByHeight(prevHeight)
 	prevTime := prevBlock.MsgBlock().Header.Timestamp.Unix()
 	since := block.MsgBlock().Header.Timestamp.Unix() - prevTime
 	Log.Infc(func() string {
 		return fmt.Sprintf(
 			"new block height %d %s %10d %08x %v %s %ds since prev",
 			block.Height(),
-			block.MsgBlock().BlockHashWithAlgos(block.Height()),
+			block.MsgBlock().BlockHashWithAlgos(c.cfg.ChainParams, block.Height()),
 			block.MsgBlock().Header.Timestamp.Unix(),
 			block.MsgBlock().Header.Bits,
 			util.Amount(coinbaseTx.Value),
-			fork.GetAlgoName(block.MsgBlock().Header.Version,
+			fork.GetAlgoName(c.cfg.ChainParams, block.MsgBlock().Header.Version,
 				block.Height()),
 			since,
 		)
 	})
 	return true
 }
-// solveBlock attempts to find some combination of a nonce, extra nonce, and current timestamp which makes the passed block hash to a value less than the target difficulty.  The timestamp is updated periodically and the passed block is modified with all tweaks during this process.  This means that when the function returns true, the block is ready for submission. This function will return early with false when conditions that trigger a stale block such as a new block showing up or periodically when there are new transactions and enough time has elapsed without finding a solution.
-func (c *Controller) solveBlock(msgBlock *wire.MsgBlock, blockHeight int32, testnet bool, ticker *time.Ticker, submissionReceived chan *wire.MsgBlock, quit chan struct{}) bool {
-	// Choose a random extra nonce offset for this block template and worker.
-	enOffset, err := wire.RandomUint64()
+// newWork creates a block template on the current best block and computes the target bits of every algorithm that is active at the next height. The merkle root and coinbase of the work are filled in for each subscriber by workFor.
+func (c *Controller) newWork() (j *job, err error) {
+	// Grab the same lock as used for block submission, since the current block will be changing and this would otherwise end up building a new block template on a block that is in the process of becoming stale.
+	c.submitBlockLock.Lock()
+	defer c.submitBlockLock.Unlock()
+	height := c.g.BestSnapshot().Height + 1
+	hf := fork.Get(c.cfg.ChainParams, height)
+	var algos []string
+	for i := range hf.Algos {
+		algos = append(algos, i)
+	}
+	sort.Slice(algos, func(i, j int) bool {
+		return hf.Algos[algos[i]].Version < hf.Algos[algos[j]].Version
+	})
+	// Choose a payment address at random
+	payToAddr := c.cfg.MiningAddrs[rand.Intn(len(c.cfg.MiningAddrs))]
+	template, err := c.g.NewBlockTemplate(payToAddr, algos[0])
 	if err != nil {
-		log <- cl.Error{"unexpected error while generating random extra nonce offset:", err}
-		enOffset = 0
+		return
 	}
-	header := &msgBlock.Header
-	targetDifficulty := blockchain.CompactToBig(header.Bits)
-	lastGenerated := time.Now()
-	lastTxUpdate := c.g.TxSource().LastUpdated()
-	// Note that the entire extra nonce range is iterated and the offset is added relying on the fact that overflow will wrap around 0 as provided by the Go spec.
-	for extraNonce := uint64(0); extraNonce < maxExtraNonce; extraNonce++ {
-		// Update the extra nonce in the block template with the new value by regenerating the coinbase script and setting the merkle root to the new value.
-		c.g.UpdateExtraNonce(msgBlock, blockHeight, extraNonce+enOffset)
-		// Search through the entire nonce range for a solution while periodically checking for early quit and stale block conditions along with updates to the speed monitor.
-		for i := uint32(0); i <= maxNonce; i++ {
-			select {
-			case <-quit:
-				// fmt.Println("chan:<-quit")
-				return false
-			case <-ticker.C:
-				// fmt.Println("chan:<-ticker.C")
-				// The current block is stale if the best block has changed.
-				best := c.g.BestSnapshot()
-				if !header.PrevBlock.IsEqual(&best.Hash) {
-					return false
-				}
-				// The current block is stale if the memory pool has been updated since the block template was generated and it has been at least one minute.
-				if lastTxUpdate != c.g.TxSource().LastUpdated() &&
-					time.Now().After(lastGenerated.Add(time.Minute)) {
-					return false
-				}
-				c.g.UpdateBlockTime(msgBlock)
-			case <-submissionReceived:
-				// fmt.Println("chan:<-submissionReceived")
-				// Here we will send out the updated block to subscribed client workers
-			default:
-			}
-			// Instead of directly attempting to solve blocks, here we send out the new block data to subscribed miner clients and process submissions they return when they find a solution
-			header.Nonce = i
-			hash := header.BlockHashWithAlgos(int32(fork.GetCurrent(blockHeight)))
-			// The block is solved when the new block hash is less than the target difficulty.  Yay!
-			if blockchain.HashToBig(&hash).Cmp(targetDifficulty) <= 0 {
-				return true
-			}
+	msgBlock := template.Block
+	c.nextJob++
+	w := &Work{
+		JobID:     c.nextJob,
+		Height:    height,
+		PrevBlock: msgBlock.Header.PrevBlock,
+		Timestamp: msgBlock.Header.Timestamp,
+	}
+	for _, x := range algos {
+		var bits uint32
+		bits, err = c.b.CalcNextRequiredDifficulty(msgBlock.Header.Timestamp, x)
+		if err != nil {
+			return
 		}
+		w.Algos = append(w.Algos, AlgoTarget{
+			Version: hf.Algos[x].Version,
+			Bits:    bits,
+		})
 	}
-	return false
+	return &job{work: w, block: msgBlock}, nil
 }
-// generateBlocks is a worker that is controlled by the miningWorkerController. It is self contained in that it creates block templates and attempts to solve them while detecting when it is performing stale work and reacting accordingly by generating a new block template.  When a block is solved, it is submitted. It must be run as a goroutine.
-func (c *Controller) generateBlocks(quit chan struct{}) {
-	// Start a ticker which is used to signal checks for stale work and updates to the speed monitor.
-	ticker := time.NewTicker(time.Second / 2)
+// block returns a copy of the block of a job with the given extra nonce in its coinbase and the merkle root that goes with it, leaving the block of the job as it is
+func (c *Controller) block(j *job, extraNonce uint64) (msgBlock *wire.MsgBlock, err error) {
+	b := *j.block
+	b.Transactions = append([]*wire.MsgTx{j.block.Transactions[0].Copy()},
+		j.block.Transactions[1:]...)
+	if err = c.g.UpdateExtraNonce(&b, j.work.Height, extraNonce); err != nil {
+		return
+	}
+	return &b, nil
+}
+// workFor returns a freshly stamped message with the work of a job for a subscriber, made with the extra nonce of the subscriber. The subscriber must be locked by subsMtx.
+func (c *Controller) workFor(j *job, s *subscriber) (msg []byte, err error) {
+	if s.payload == nil || s.job != j.work.JobID {
+		w := *j.work
+		// the job is in the upper half so the coinbase of a subscriber differs from one job to the next
+		w.ExtraNonce = j.work.JobID<<32 | uint64(s.extraNonce)
+		var b *wire.MsgBlock
+		if b, err = c.block(j, w.ExtraNonce); err != nil {
+			return
+		}
+		var coinbase bytes.Buffer
+		if err = b.Transactions[0].Serialize(&coinbase); err != nil {
+			return
+		}
+		w.MerkleRoot, w.Coinbase = b.Header.MerkleRoot, coinbase.Bytes()
+		if s.payload, err = w.MarshalBinary(); err != nil {
+			return
+		}
+		s.job = j.work.JobID
+	}
+	return Encode(c.cfg.MinerKey, MsgWork, s.payload), nil
+}
+// setWork makes a job the current work and forgets jobs that are built on another block or have fallen out of the window of recent jobs
+func (c *Controller) setWork(j *job) {
+	c.jobsMtx.Lock()
+	defer c.jobsMtx.Unlock()
+	for i, x := range c.jobs {
+		if x.work.PrevBlock != j.work.PrevBlock ||
+			i+maxJobs <= j.work.JobID {
+			delete(c.jobs, i)
+		}
+	}
+	c.jobs[j.work.JobID] = j
+	c.current = j
+}
+// currentWork returns the current job, or nil if there is none yet
+func (c *Controller) currentWork() *job {
+	c.jobsMtx.Lock()
+	defer c.jobsMtx.Unlock()
+	return c.current
+}
+// send sends a subscriber its work of a job. The subscriber must be locked by subsMtx.
+func (c *Controller) send(j *job, s *subscriber) {
+	msg, err := c.workFor(j, s)
+	if err != nil {
+		log <- cl.Error{"failed to make work for", s.addr, err}
+		return
+	}
+	if _, err = c.conn.WriteToUDP(msg, s.addr); err != nil {
+		log <- cl.Debug{"error sending work to", s.addr, err}
+	}
+}
+// broadcast sends the work of a job to every live subscriber and removes the ones that have not renewed their subscription in time
+func (c *Controller) broadcast(j *job) {
+	c.subsMtx.Lock()
+	defer c.subsMtx.Unlock()
+	for i, x := range c.subscribers {
+		if time.Since(x.lastSeen) > SubscriptionTimeout {
+			log <- cl.Debug{"subscription expired", i}
+			delete(c.subscribers, i)
+			continue
+		}
+		c.send(j, x)
+	}
+}
+// subscriberCount returns the number of current subscribers
+func (c *Controller) subscriberCount() int {
+	c.subsMtx.Lock()
+	defer c.subsMtx.Unlock()
+	return len(c.subscribers)
+}
+// workUpdater generates a new template when the best block changes, or when the mempool changes and no new template has been made for minMempoolInterval, and pushes it to the subscribers. It must be run as a goroutine.
+func (c *Controller) workUpdater() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(updateInterval)
 	defer ticker.Stop()
-	// Create a channel to receive block submissions
-	var submission chan *wire.MsgBlock
+	var lastBest = c.g.BestSnapshot().Hash
+	var lastTxUpdate time.Time
+	var lastGenerated, lastSent time.Time
+	var haveWork bool
 out:
 	for {
 		select {
-		case <-quit: // Quit when the miner is stopped.
-			// fmt.Println("chan:<-quit")
+		case <-c.quit:
 			break out
-		default: // Non-blocking select to fall through
+		case <-ticker.C:
 		}
-		// Wait until there is a connection to at least one other peer since there is no way to relay a found block or receive transactions to work on when there are no connected peers.
-		if c.cfg.ConnectedCount() == 0 {
-			time.Sleep(time.Second)
+		// There is no point making templates when nobody is mining, no peers will receive solved blocks, or the chain is not yet synced
+		if c.subscriberCount() == 0 || c.cfg.ConnectedCount() == 0 {
 			continue
 		}
-		// No point in searching for a solution before the chain is synced.  Also, grab the same lock as used for block submission, since the current block will be changing and this would otherwise end up building a new block template on a block that is in the process of becoming stale.
-		c.submitBlockLock.Lock()
-		curHeight := c.g.BestSnapshot().Height
-		if curHeight != 0 && !c.cfg.IsCurrent() {
-			c.submitBlockLock.Unlock()
-			time.Sleep(time.Second)
+		best := c.g.BestSnapshot()
+		if best.Height != 0 && !c.cfg.IsCurrent() {
 			continue
 		}
-		// Choose a payment address at random
-		rand.Seed(time.Now().UnixNano())
-		payToAddr := c.cfg.MiningAddrs[rand.Intn(len(c.cfg.MiningAddrs))]
-		// Create a new block template using the available transactions in the memory pool as a source of transactions to potentially include in the block.
-		template, err := c.g.NewBlockTemplate(payToAddr, "")
-		c.submitBlockLock.Unlock()
-		if err != nil {
-			log <- cl.Error{"failed to create new block template: %v", err}
+		txUpdate := c.g.TxSource().LastUpdated()
+		stale := !haveWork || !best.Hash.IsEqual(&lastBest) ||
+			(txUpdate != lastTxUpdate &&
+				time.Since(lastGenerated) > minMempoolInterval)
+		if stale {
+			j, err := c.newWork()
+			if err != nil {
+				log <- cl.Error{"failed to create new block template:", err}
+				continue
+			}
+			c.setWork(j)
+			haveWork = true
+			lastBest, lastTxUpdate, lastGenerated = best.Hash, txUpdate, time.Now()
+			log <- cl.Tracef{"sending job %d for height %d to %d subscribers",
+				j.work.JobID, j.work.Height, c.subscriberCount()}
+		} else if time.Since(lastSent) < heartbeatInterval {
 			continue
 		}
-		// Attempt to solve the block.  The function will exit early with false when conditions that trigger a stale block, so a new block template can be generated.  When the return is true a solution was found, so submit the solved block.
-		if c.solveBlock(template.Block, curHeight+1, c.cfg.ChainParams.Name == "testnet", ticker, submission, quit) {
-			block := util.NewBlock(template.Block)
-			c.submitBlock(block)
+		if j := c.currentWork(); j != nil {
+			c.broadcast(j)
+			lastSent = time.Now()
 		}
 	}
-	c.workerWg.Done()
 }
-func (c *Controller) minerController() {
-	c.workerWg.Add(1)
-	quit := make(chan struct{})
-	go c.generateBlocks(quit)
-out:
+// handleSubscribe adds or renews a subscription, giving a new subscriber an extra nonce of its own and sending it the current work
+func (c *Controller) handleSubscribe(addr *net.UDPAddr) {
+	c.subsMtx.Lock()
+	defer c.subsMtx.Unlock()
+	s, ok := c.subscribers[addr.String()]
+	if !ok {
+		log <- cl.Info{"new miner subscribed from", addr}
+		c.nextExtraNonce++
+		s = &subscriber{addr: addr, extraNonce: c.nextExtraNonce}
+		c.subscribers[addr.String()] = s
+	}
+	s.lastSeen = time.Now()
+	if j := c.currentWork(); j != nil && !ok {
+		c.send(j, s)
+	}
+}
+// handleUnsubscribe removes a subscription
+func (c *Controller) handleUnsubscribe(addr *net.UDPAddr) {
+	c.subsMtx.Lock()
+	defer c.subsMtx.Unlock()
+	if _, ok := c.subscribers[addr.String()]; ok {
+		log <- cl.Info{"miner unsubscribed from", addr}
+		delete(c.subscribers, addr.String())
+	}
+}
+// handleSolution rebuilds the coinbase and merkle root of the job a solution refers to with the extra nonce of the solution, applies the solution to the header and submits the resulting block
+func (c *Controller) handleSolution(addr *net.UDPAddr, payload []byte) {
+	var s Solution
+	if err := s.UnmarshalBinary(payload); err != nil {
+		log <- cl.Debug{"malformed solution from", addr, err}
+		return
+	}
+	c.jobsMtx.Lock()
+	j, ok := c.jobs[s.JobID]
+	c.jobsMtx.Unlock()
+	if !ok {
+		log <- cl.Debug{"solution from", addr, "for unknown or stale job", s.JobID}
+		return
+	}
+	bits, ok := j.work.Target(s.Version)
+	if !ok {
+		log <- cl.Debug{"solution from", addr, "has invalid block version", s.Version}
+		return
+	}
+	// The template is copied so other solutions for the same job are not affected
+	msgBlock, err := c.block(j, s.ExtraNonce)
+	if err != nil {
+		log <- cl.Debug{"solution from", addr, "has invalid extra nonce", s.ExtraNonce, err}
+		return
+	}
+	msgBlock.Header.Version = s.Version
+	msgBlock.Header.Bits = bits
+	msgBlock.Header.Nonce = s.Nonce
+	block := util.NewBlock(msgBlock)
+	block.SetHeight(j.work.Height)
+	log <- cl.Info{"received solution from", addr, "for job", s.JobID,
+		fork.GetAlgoName(c.cfg.ChainParams, s.Version, j.work.Height)}
+	c.submitBlock(block)
+}
+// listen reads and dispatches messages from workers. It must be run as a goroutine.
+func (c *Controller) listen() {
+	defer c.wg.Done()
+	buf := make([]byte, MaxDatagramSize)
 	for {
-		select {
-		case <-c.quit:
-			// fmt.Println("chan:<-c.quit")
-			close(quit)
-			break out
+		n, addr, err := c.conn.ReadFromUDP(buf)
+		if err != nil {
+			select {
+			case <-c.quit:
+				return
+			default:
+			}
+			log <- cl.Debug{"error reading from miner listener:", err}
+			continue
+		}
+		msgType, payload, err := Decode(c.cfg.MinerKey, buf[:n])
+		if err == nil {
+			// a subscription sent again from a spoofed address would otherwise have work sent there
+			err = c.replays.check(buf[:n])
+		}
+		if err != nil {
+			log <- cl.Debug{"rejected message from", addr, err}
+			continue
+		}
+		switch msgType {
+		case MsgSubscribe:
+			c.handleSubscribe(addr)
+		case MsgUnsubscribe:
+			c.handleUnsubscribe(addr)
+		case MsgSolution:
+			p := make([]byte, len(payload))
+			copy(p, payload)
+			go c.handleSolution(addr, p)
 		}
 	}
 }
-// Start begins the miner controller process. Calling this function when the miner controller has already been started will have no effect.
-func (c *Controller) Start() {
+// Start opens the miner listener and begins delivering work to subscribers. Calling this function when the miner controller has already been started will have no effect.
+func (c *Controller) Start() (err error) {
 	c.Lock()
 	defer c.Unlock()
 	if c.started {
 		return
 	}
+	if len(c.cfg.MinerKey) == 0 {
+		return errors.New("miner controller requires a miner key")
+	}
+	if len(c.cfg.MiningAddrs) == 0 {
+		return errors.New("miner controller requires mining addresses")
+	}
+	addr, err := net.ResolveUDPAddr("udp", c.cfg.MinerListener)
+	if err != nil {
+		return
+	}
+	c.conn, err = net.ListenUDP("udp", addr)
+	if err != nil {
+		return
+	}
 	c.quit = make(chan struct{})
-	c.wg.Add(1)
-	go c.minerController()
+	c.wg.Add(2)
+	go c.listen()
+	go c.workUpdater()
 	c.started = true
-	log <- cl.Inf("Miner controller started")
+	log <- cl.Info{"miner controller listening on", c.conn.LocalAddr()}
+	return
 }
-// Stop gracefully stops the mining process by signalling all workers, and the speed monitor to quit.  Calling this function when the miner controller has not already been started will have no effect.
+// Stop gracefully stops the controller, closing the listener and waiting for its goroutines to finish.  Calling this function when the miner controller has not already been started will have no effect.
 func (c *Controller) Stop() {
 	c.Lock()
 	defer c.Unlock()
@@ -242,9 +441,10 @@ func (c *Controller) Stop() {
 		return
 	}
 	close(c.quit)
+	c.conn.Close()
 	c.wg.Wait()
 	c.started = false
-	log <- cl.Inf("Miner controller stopped")
+	log <- cl.Inf("miner controller stopped")
 }
 // IsMining returns whether or not the miner controller has been started and is therefore currenting mining. This function is safe for concurrent access.
 func (c *Controller) IsMining() bool {
@@ -252,12 +452,24 @@ func (c *Controller) IsMining() bool {
 	defer c.Unlock()
 	return c.started
 }
-// New returns a new instance of a CPU miner for the provided configuration. Use Start to begin the mining process.  See the documentation for Controller type for more details.
+// Subscribers returns the number of miner workers currently subscribed. This function is safe for concurrent access.
+func (c *Controller) Subscribers() int {
+	return c.subscriberCount()
+}
+// SetMiningAddrs replaces the payment addresses new work randomly chooses from, taking effect with the next block template. This function is safe for concurrent access.
+func (c *Controller) SetMiningAddrs(addrs []util.Address) {
+	c.submitBlockLock.Lock()
+	c.cfg.MiningAddrs = addrs
+	c.submitBlockLock.Unlock()
+}
+// New returns a new instance of a miner controller for the provided configuration. Use Start to begin delivering work.  See the documentation for Controller type for more details.
 func New(
 	cfg *Config) *Controller {
 	return &Controller{
-		b:   cfg.Blockchain,
-		g:   cfg.BlockTemplateGenerator,
-		cfg: *cfg,
+		b:           cfg.Blockchain,
+		g:           cfg.BlockTemplateGenerator,
+		cfg:         *cfg,
+		subscribers: make(map[string]*subscriber),
+		jobs:        make(map[uint64]*job),
 	}
 }
@@ -1,76 +1,315 @@
-// +build test
-
 package controller
 
 import (
-	"fmt"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
 	"testing"
 	"time"
 
-	"github.com/dogeerf/rpcx"
-	"github.com/xtaci/kcp-go"
-	"golang.org/x/crypto/argon2"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining"
+	database "git.parallelcoin.io/dev/9/pkg/db"
+	_ "git.parallelcoin.io/dev/9/pkg/db/ffldb"
+	"git.parallelcoin.io/dev/9/pkg/util"
 )
 
-const cryptKey = "rpcx-key"
-const cryptSalt = "rpcx-salt"
+var testKey = []byte("correct horse battery staple")
+
+func TestWorkRoundTrip(
+	t *testing.T) {
 
-var minerAddr = "127.0.0.1:11012"
-var nodeAddr = "127.0.0.1:11011"
+	w := &Work{
+		JobID:      42,
+		ExtraNonce: 42<<32 | 3,
+		Height:     1234,
+		Timestamp:  time.Unix(1546300800, 0),
+		Algos: []AlgoTarget{
+			{Version: 5, Bits: 0x1d00ffff},
+			{Version: 6, Bits: 0x1e0fffff},
+		},
+		Coinbase: []byte{1, 2, 3, 4, 5},
+	}
+	w.PrevBlock[0], w.MerkleRoot[31] = 0xab, 0xcd
+	payload, err := w.MarshalBinary()
+	if err != nil {
 
-var ready = make(chan bool)
-var K Kopach
+		t.Fatal(err)
+	}
+	msgType, out, err := Decode(testKey, Encode(testKey, MsgWork, payload))
+	if err != nil {
+
+		t.Fatal(err)
+	}
+	if msgType != MsgWork {
+
+		t.Fatalf("got message type %d, expected %d", msgType, MsgWork)
+	}
+	var got Work
+	if err = got.UnmarshalBinary(out); err != nil {
+
+		t.Fatal(err)
+	}
+	if got.JobID != w.JobID || got.ExtraNonce != w.ExtraNonce || got.Height != w.Height ||
+		got.PrevBlock != w.PrevBlock || got.MerkleRoot != w.MerkleRoot ||
+		!got.Timestamp.Equal(w.Timestamp) ||
+		!bytes.Equal(got.Coinbase, w.Coinbase) ||
+		len(got.Algos) != len(w.Algos) {
+
+		t.Fatalf("decoded work %+v does not match %+v", got, w)
+	}
+	for i := range w.Algos {
+
+		if got.Algos[i] != w.Algos[i] {
+
+			t.Fatalf("algo %d decoded as %+v, expected %+v",
+				i, got.Algos[i], w.Algos[i])
+		}
+		h := got.Header(i)
+		if h.Version != w.Algos[i].Version || h.Bits != w.Algos[i].Bits {
+
+			t.Fatalf("header %d has wrong version or bits", i)
+		}
+	}
+	if bits, ok := got.Target(6); !ok || bits != 0x1e0fffff {
+
+		t.Fatal("Target did not find version 6")
+	}
+	if _, ok := got.Target(7); ok {
 
-func TestKCPClientServer(
+		t.Fatal("Target found version not in work")
+	}
+}
+func TestSolutionRoundTrip(
 	t *testing.T) {
 
-	fmt.Println("Testing KCP client/server connection")
-	go runServer(t)
-	time.Sleep(time.Second)
-	runClient(t)
+	s := Solution{JobID: 7, ExtraNonce: 1<<40 | 9, Version: -3, Nonce: 0xdeadbeef}
+	payload, _ := s.MarshalBinary()
+	var got Solution
+	if err := got.UnmarshalBinary(payload); err != nil {
+
+		t.Fatal(err)
+	}
+	if got != s {
+
+		t.Fatalf("got %+v, expected %+v", got, s)
+	}
+	if err := got.UnmarshalBinary(payload[:23]); err == nil {
+
+		t.Fatal("short solution was accepted")
+	}
 }
-func runServer(
+func TestDecodeRejects(
 	t *testing.T) {
 
-	server := rpcx.NewServer()
-	server.RegisterName("Kopach", &K)
-	pass := argon2.IDKey([]byte(cryptKey), []byte(cryptSalt), 1, 4096, 32, 32)
-	bc, err := kcp.NewAESBlockCrypt(pass)
-	if err != nil {
+	msg := Encode(testKey, MsgSubscribe, nil)
+	if _, _, err := Decode([]byte("wrong key"), msg); err != ErrBadMAC {
 
-		fmt.Println(err)
+		t.Fatalf("wrong key: got %v, expected %v", err, ErrBadMAC)
 	}
-	ln, err := kcp.ListenWithOptions(nodeAddr, bc, 10, 3)
-	if err != nil {
+	tampered := append([]byte{}, msg...)
+	tampered[4] = MsgUnsubscribe
+	if _, _, err := Decode(testKey, tampered); err != ErrBadMAC {
+
+		t.Fatalf("tampered: got %v, expected %v", err, ErrBadMAC)
+	}
+	if _, _, err := Decode(testKey, msg[:20]); err != ErrShortMessage {
+
+		t.Fatalf("short: got %v, expected %v", err, ErrShortMessage)
+	}
+	bad := append([]byte{}, msg...)
+	bad[0] = 'x'
+	if _, _, err := Decode(testKey, bad); err != ErrBadMagic {
+
+		t.Fatalf("magic: got %v, expected %v", err, ErrBadMagic)
+	}
+	// forge a correctly authenticated message that was sent too long ago
+	stale := Encode(testKey, MsgSubscribe, nil)[:headerLen]
+	binary.LittleEndian.PutUint64(stale[5:],
+		uint64(time.Now().Add(-2*MaxClockSkew).UnixNano()))
+	stale = resign(stale)
+	if _, _, err := Decode(testKey, stale); err != ErrStale {
+
+		t.Fatalf("stale: got %v, expected %v", err, ErrStale)
+	}
+	unknown := Encode(testKey, 99, nil)
+	if _, _, err := Decode(testKey, unknown); err == nil {
+
+		t.Fatal("unknown message type was accepted")
+	}
+}
+func TestReplayCache(
+	t *testing.T) {
+
+	var r replayCache
+	msg := Encode(testKey, MsgSubscribe, nil)
+	if err := r.check(msg); err != nil {
+
+		t.Fatal(err)
+	}
+	// the same message again is rejected whichever address it comes from
+	if err := r.check(msg); err != ErrReplayed {
+
+		t.Fatalf("replayed: got %v, expected %v", err, ErrReplayed)
+	}
+	// another message stamped later is accepted
+	time.Sleep(time.Millisecond)
+	if err := r.check(Encode(testKey, MsgSubscribe, nil)); err != nil {
 
-		fmt.Println(err)
+		t.Fatal(err)
 	}
-	fmt.Println("Running server")
-	server.ServeListener(ln)
-	ready <- true
-	fmt.Println("Finished running server")
 }
-func runClient(
+
+// resign appends the authentication code to a message header and payload
+func resign(body []byte) []byte {
+
+	mac := hmac.New(sha256.New, testKey)
+	mac.Write(body)
+	return mac.Sum(body)
+}
+func TestControllerSolution(
 	t *testing.T) {
 
-	pass := argon2.Key([]byte(cryptKey), []byte(cryptSalt), 1, 4096, 32, 32)
-	bc, _ := kcp.NewAESBlockCrypt(pass)
-
-	s := &rpcx.DirectClientSelector{Network: "kcp", Address: nodeAddr}
-	client := rpcx.NewClient(s)
-	client.Block = bc
-	args := &minerAddr
-	var reply *string
-	fmt.Println("server", nodeAddr, "Calling subscribe", *args, client.PluginContainer)
-	err := client.Call("Kopach.Subscribe", &args, &reply)
-	fmt.Println("Got reply", reply)
+	dir, err := ioutil.TempDir("", "dispatch")
 	if err != nil {
 
-		fmt.Printf("error for Kopach: %s, %v\n", *args, err)
-	} else {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	params := chaincfg.RegressionNetParams
+	params.HardForks = []fork.HardForks{fork.Halcyon(0), fork.Plan9(1000)}
+	db, err := database.Create("ffldb", dir, params.Net)
+	if err != nil {
+
+		t.Fatal(err)
+	}
+	defer db.Close()
+	timeSource := blockchain.NewMedianTime()
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:          db,
+		ChainParams: &params,
+		TimeSource:  timeSource,
+	})
+	if err != nil {
+
+		t.Fatal(err)
+	}
+	addr, err := util.NewAddressPubKeyHash(make([]byte, 20), &params)
+	if err != nil {
+
+		t.Fatal(err)
+	}
+	accepted := make(chan *util.Block, 1)
+	c := New(&Config{
+		Blockchain:  chain,
+		ChainParams: &params,
+		BlockTemplateGenerator: mining.NewBlkTmplGenerator(
+			&mining.Policy{BlockMaxWeight: 4000000, BlockMaxSize: 1000000},
+			&params, &emptyTxSource{}, chain, timeSource, nil, nil, "sha256d"),
+		MiningAddrs: []util.Address{addr},
+		ProcessBlock: func(block *util.Block, flags blockchain.BehaviorFlags) (bool, error) {
+			_, isOrphan, err := chain.ProcessBlock(block, flags, block.Height())
+			if err == nil && !isOrphan {
+				accepted <- block
+			}
+			return isOrphan, err
+		},
+		MinerListener:  "127.0.0.1:0",
+		MinerKey:       testKey,
+		ConnectedCount: func() int32 { return 1 },
+		IsCurrent:      func() bool { return true },
+	})
+	if err = c.Start(); err != nil {
+
+		t.Fatal(err)
+	}
+	defer c.Stop()
+	// two workers subscribe and each is sent work with a coinbase of its own
+	var works [2]Work
+	var conns [2]*net.UDPConn
+	for i := range conns {
+
+		if conns[i], err = net.DialUDP("udp", nil, c.conn.LocalAddr().(*net.UDPAddr)); err != nil {
+
+			t.Fatal(err)
+		}
+		defer conns[i].Close()
+		if _, err = conns[i].Write(Encode(testKey, MsgSubscribe, nil)); err != nil {
 
-		fmt.Printf("Subscribe: %s : %s\n", *args, *reply)
+			t.Fatal(err)
+		}
+	}
+	buf := make([]byte, MaxDatagramSize)
+	for i, conn := range conns {
+
+		conn.SetReadDeadline(time.Now().Add(time.Second * 10))
+		n, err := conn.Read(buf)
+		if err != nil {
+
+			t.Fatal("no work received:", err)
+		}
+		msgType, payload, err := Decode(testKey, buf[:n])
+		if err != nil || msgType != MsgWork {
+
+			t.Fatalf("got message type %d, error %v, expected work", msgType, err)
+		}
+		if err = works[i].UnmarshalBinary(payload); err != nil {
+
+			t.Fatal(err)
+		}
+	}
+	if works[0].JobID != works[1].JobID || works[0].ExtraNonce == works[1].ExtraNonce ||
+		works[0].MerkleRoot == works[1].MerkleRoot {
+
+		t.Fatalf("subscribers were sent the same headers: %+v %+v", works[0], works[1])
+	}
+	// the second worker solves its work
+	w := &works[1]
+	header := w.Header(0)
+	algo := fork.GetAlgoName(&params, header.Version, w.Height)
+	target := fork.CompactToBig(header.Bits)
+	for header.Nonce = 0; ; header.Nonce++ {
+
+		var hb bytes.Buffer
+		header.Serialize(&hb)
+		hash := fork.Hash(&params, hb.Bytes(), algo, w.Height)
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+
+			break
+		}
+	}
+	s := Solution{JobID: w.JobID, ExtraNonce: w.ExtraNonce, Version: header.Version, Nonce: header.Nonce}
+	payload, _ := s.MarshalBinary()
+	if _, err = conns[1].Write(Encode(testKey, MsgSolution, payload)); err != nil {
+
+		t.Fatal(err)
+	}
+	select {
+	case block := <-accepted:
+		if got := block.MsgBlock().Header.MerkleRoot; got != w.MerkleRoot {
+
+			t.Fatalf("block has merkle root %s, expected %s from the work", got, w.MerkleRoot)
+		}
+	case <-time.After(time.Second * 10):
+
+		t.Fatal("solution was not accepted")
+	}
+	if best := chain.BestSnapshot(); best.Height != w.Height {
+
+		t.Fatalf("best height is %d, expected %d", best.Height, w.Height)
 	}
-	fmt.Println("closing connection")
-	client.Close()
 }
+
+// emptyTxSource is a mempool without transactions
+type emptyTxSource struct{}
+
+func (*emptyTxSource) LastUpdated() time.Time                    { return time.Time{} }
+func (*emptyTxSource) MiningDescs() []*mining.TxDesc             { return nil }
+func (*emptyTxSource) HaveTransaction(hash *chainhash.Hash) bool { return false }
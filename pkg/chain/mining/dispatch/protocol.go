@@ -0,0 +1,236 @@
+package controller
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+)
+// Message types of the dispatch protocol
+const (
+	// MsgSubscribe is sent by a worker to request work updates, and is repeated periodically to keep the subscription alive
+	MsgSubscribe byte = iota + 1
+	// MsgUnsubscribe is sent by a worker to stop receiving work updates
+	MsgUnsubscribe
+	// MsgWork is sent by the controller to subscribers carrying a Work payload
+	MsgWork
+	// MsgSolution is sent by a worker carrying a Solution payload
+	MsgSolution
+)
+const (
+	// MaxDatagramSize is the largest message the controller or a worker will read from the socket
+	MaxDatagramSize = 65507
+	// MaxClockSkew is the largest difference between the sender's timestamp and the local clock that will be accepted, which bounds the window in which a captured message can be replayed
+	MaxClockSkew = time.Second * 30
+	// maxCoinbaseSize is the largest coinbase transaction that will be decoded from a work message
+	maxCoinbaseSize = 16384
+	// headerLen is the length of the magic, type and timestamp prefix of every message
+	headerLen = 4 + 1 + 8
+)
+var (
+	// magic identifies datagrams of the dispatch protocol
+	magic = [4]byte{'9', 'd', 's', 'p'}
+	// ErrShortMessage is returned for datagrams too short to contain a message
+	ErrShortMessage = errors.New("message is too short")
+	// ErrBadMagic is returned for datagrams that are not of this protocol
+	ErrBadMagic = errors.New("message does not have dispatch protocol magic")
+	// ErrBadMAC is returned when the message authentication code does not match the key
+	ErrBadMAC = errors.New("message authentication failed")
+	// ErrStale is returned when the timestamp of a message is outside of MaxClockSkew
+	ErrStale = errors.New("message timestamp is outside of the allowed window")
+	// ErrReplayed is returned for a message that was already received
+	ErrReplayed = errors.New("message was already received")
+)
+// AlgoTarget is the block version and target bits for one of the algorithms in a Work
+type AlgoTarget struct {
+	Version int32
+	Bits    uint32
+}
+// Work is the data required to construct a block header for each of the available algorithms. The headers are identical except for the version and bits, so a worker may pick any algorithm and only needs to search the nonce. Every subscriber is sent the work of a job with its own extra nonce in the coinbase, so no two workers search the same headers.
+type Work struct {
+	JobID uint64
+	// ExtraNonce is the extra nonce in the coinbase of this copy of the work, which solutions must name so the controller can rebuild the block
+	ExtraNonce uint64
+	Height     int32
+	PrevBlock  chainhash.Hash
+	MerkleRoot chainhash.Hash
+	Timestamp  time.Time
+	Algos      []AlgoTarget
+	// Coinbase is the serialized coinbase transaction of the block so a worker can see what it is mining for
+	Coinbase []byte
+}
+// Solution is a nonce and block version that solves the Work with the matching JobID and ExtraNonce
+type Solution struct {
+	JobID      uint64
+	ExtraNonce uint64
+	Version    int32
+	Nonce      uint32
+}
+// Header returns the block header for the algorithm at index i of Algos
+func (w *Work) Header(i int) *wire.BlockHeader {
+	return &wire.BlockHeader{
+		Version:    w.Algos[i].Version,
+		PrevBlock:  w.PrevBlock,
+		MerkleRoot: w.MerkleRoot,
+		Timestamp:  w.Timestamp,
+		Bits:       w.Algos[i].Bits,
+	}
+}
+// Target returns the target bits for a block version, and false if the version is not in the Work
+func (w *Work) Target(version int32) (bits uint32, ok bool) {
+	for _, x := range w.Algos {
+		if x.Version == version {
+			return x.Bits, true
+		}
+	}
+	return
+}
+// MarshalBinary serializes a Work for sending in a MsgWork
+func (w *Work) MarshalBinary() (out []byte, err error) {
+	var buf bytes.Buffer
+	e := func(data interface{}) {
+		if err == nil {
+			err = binary.Write(&buf, binary.LittleEndian, data)
+		}
+	}
+	e(w.JobID)
+	e(w.ExtraNonce)
+	e(w.Height)
+	e(w.PrevBlock)
+	e(w.MerkleRoot)
+	e(w.Timestamp.Unix())
+	e(uint8(len(w.Algos)))
+	for _, x := range w.Algos {
+		e(x.Version)
+		e(x.Bits)
+	}
+	if err != nil {
+		return
+	}
+	if err = wire.WriteVarBytes(&buf, 0, w.Coinbase); err != nil {
+		return
+	}
+	return buf.Bytes(), nil
+}
+// UnmarshalBinary decodes a Work from the payload of a MsgWork
+func (w *Work) UnmarshalBinary(data []byte) (err error) {
+	r := bytes.NewReader(data)
+	d := func(data interface{}) {
+		if err == nil {
+			err = binary.Read(r, binary.LittleEndian, data)
+		}
+	}
+	var ts int64
+	var n uint8
+	d(&w.JobID)
+	d(&w.ExtraNonce)
+	d(&w.Height)
+	d(&w.PrevBlock)
+	d(&w.MerkleRoot)
+	d(&ts)
+	d(&n)
+	if err != nil {
+		return
+	}
+	w.Timestamp = time.Unix(ts, 0)
+	w.Algos = make([]AlgoTarget, n)
+	for i := range w.Algos {
+		d(&w.Algos[i].Version)
+		d(&w.Algos[i].Bits)
+	}
+	if err != nil {
+		return
+	}
+	w.Coinbase, err = wire.ReadVarBytes(r, 0, maxCoinbaseSize, "coinbase")
+	return
+}
+// MarshalBinary serializes a Solution for sending in a MsgSolution
+func (s *Solution) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 24)
+	binary.LittleEndian.PutUint64(out[0:8], s.JobID)
+	binary.LittleEndian.PutUint64(out[8:16], s.ExtraNonce)
+	binary.LittleEndian.PutUint32(out[16:20], uint32(s.Version))
+	binary.LittleEndian.PutUint32(out[20:24], s.Nonce)
+	return out, nil
+}
+// UnmarshalBinary decodes a Solution from the payload of a MsgSolution
+func (s *Solution) UnmarshalBinary(data []byte) error {
+	if len(data) != 24 {
+		return io.ErrUnexpectedEOF
+	}
+	s.JobID = binary.LittleEndian.Uint64(data[0:8])
+	s.ExtraNonce = binary.LittleEndian.Uint64(data[8:16])
+	s.Version = int32(binary.LittleEndian.Uint32(data[16:20]))
+	s.Nonce = binary.LittleEndian.Uint32(data[20:24])
+	return nil
+}
+// Encode frames a payload as a message of the given type, stamped with the current time and authenticated with a HMAC-SHA256 of the key
+func Encode(key []byte, msgType byte, payload []byte) []byte {
+	out := make([]byte, headerLen, headerLen+len(payload)+sha256.Size)
+	copy(out, magic[:])
+	out[4] = msgType
+	binary.LittleEndian.PutUint64(out[5:headerLen], uint64(time.Now().UnixNano()))
+	out = append(out, payload...)
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(out)
+	return mac.Sum(out)
+}
+// Decode checks the framing, timestamp and authentication code of a message and returns its type and payload
+func Decode(key []byte, data []byte) (msgType byte, payload []byte, err error) {
+	if len(data) < headerLen+sha256.Size {
+		return 0, nil, ErrShortMessage
+	}
+	if !bytes.Equal(data[:4], magic[:]) {
+		return 0, nil, ErrBadMagic
+	}
+	body, sum := data[:len(data)-sha256.Size], data[len(data)-sha256.Size:]
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(body)
+	if !hmac.Equal(sum, mac.Sum(nil)) {
+		return 0, nil, ErrBadMAC
+	}
+	sent := time.Unix(0, int64(binary.LittleEndian.Uint64(body[5:headerLen])))
+	if skew := time.Since(sent); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return 0, nil, ErrStale
+	}
+	msgType = body[4]
+	if msgType < MsgSubscribe || msgType > MsgSolution {
+		return 0, nil, fmt.Errorf("unknown message type %d", msgType)
+	}
+	return msgType, body[headerLen:], nil
+}
+// replayCache remembers the authentication codes of the messages received within the clock skew window, so that a captured message sent again, from any address, is rejected
+type replayCache struct {
+	mx    sync.Mutex
+	seen  map[[sha256.Size]byte]time.Time
+	swept time.Time
+}
+// check returns ErrReplayed if a message that passed Decode was already received, and otherwise remembers it. Codes are forgotten once their messages are too old to pass Decode.
+func (r *replayCache) check(data []byte) error {
+	var sum [sha256.Size]byte
+	copy(sum[:], data[len(data)-sha256.Size:])
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.seen == nil {
+		r.seen = make(map[[sha256.Size]byte]time.Time)
+	}
+	if time.Since(r.swept) > MaxClockSkew {
+		for i, x := range r.seen {
+			if time.Since(x) > MaxClockSkew*2 {
+				delete(r.seen, i)
+			}
+		}
+		r.swept = time.Now()
+	}
+	if _, ok := r.seen[sum]; ok {
+		return ErrReplayed
+	}
+	r.seen[sum] = time.Now()
+	return nil
+}
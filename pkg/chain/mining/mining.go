@@ -288,9 +288,9 @@ func (g *BlkTmplGenerator) NewBlockTemplate(payToAddress util.Address, algo stri
 		algo = "random"
 	}
 	h := g.BestSnapshot().Height + 1
-	vers := fork.GetAlgoVer(algo, h)
-	algo = fork.GetAlgoName(vers, h)
-	// log <- cl.Info{"selected algo", fork.GetAlgoName(vers, h)}
+	vers := fork.GetAlgoVer(g.chainParams, algo, h)
+	algo = fork.GetAlgoName(g.chainParams, vers, h)
+	// log <- cl.Info{"selected algo", fork.GetAlgoName(g.chainParams, vers, h)}
 	// Extend the most recently known best block.
 	best := g.chain.BestSnapshot()
 	nextBlockHeight := best.Height + 1
@@ -0,0 +1,433 @@
+package stratum
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	"git.parallelcoin.io/dev/9/pkg/util"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+const (
+	// retargetShares is the number of shares after which the share difficulty of a miner is adjusted, or the number of share times without them
+	retargetShares = 8
+	// maxRetarget is the largest factor the share difficulty of a miner changes by at once
+	maxRetarget = 4.0
+	// idleTimeout is how long a miner that sends nothing stays connected
+	idleTimeout = 10 * time.Minute
+	// writeTimeout is how long sending a message to a miner may take
+	writeTimeout = 10 * time.Second
+	// maxMessageSize is the longest message accepted from a miner
+	maxMessageSize = 16 * 1024
+)
+// client is the connection of a miner
+type client struct {
+	s    *Server
+	conn net.Conn
+	// fixedAlgo is the algorithm of the listener the miner connected to, empty if the miner chooses it
+	fixedAlgo   string
+	extraNonce1 []byte
+	// mx guards the state below and writing to conn
+	mx         sync.Mutex
+	algo       string
+	subscribed bool
+	started    bool
+	workers    map[string]bool
+	difficulty float64
+	// jobDiff is the share difficulty of each job sent to the miner
+	jobDiff map[string]float64
+	// shares are the shares submitted for the jobs of the miner, to reject duplicates
+	shares map[string]bool
+	// since is the start of the current vardiff window and work the sum of the difficulties of the shares accepted in it
+	since time.Time
+	count int
+	work  float64
+}
+// newClient returns the client of a miner connection with its extra nonce
+func newClient(
+	s *Server, conn net.Conn, algo string, extraNonce uint32) *client {
+	c := &client{
+		s:           s,
+		conn:        conn,
+		fixedAlgo:   algo,
+		extraNonce1: make([]byte, ExtraNonce1Size),
+		workers:     make(map[string]bool),
+		jobDiff:     make(map[string]float64),
+		shares:      make(map[string]bool),
+	}
+	binary.BigEndian.PutUint32(c.extraNonce1, extraNonce)
+	return c
+}
+// serve reads and answers the requests of the miner until the connection is closed
+func (c *client) serve() {
+	defer c.conn.Close()
+	scanner := bufio.NewScanner(c.conn)
+	scanner.Buffer(make([]byte, 4096), maxMessageSize)
+	for {
+		c.conn.SetReadDeadline(time.Now().Add(idleTimeout))
+		if !scanner.Scan() {
+			return
+		}
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var r request
+		if err := json.Unmarshal(line, &r); err != nil {
+			log <- cl.Debug{"malformed Stratum message from", c.conn.RemoteAddr(), err}
+			return
+		}
+		result, e := c.handle(&r)
+		reply := response{ID: r.ID, Result: result}
+		if e != nil {
+			reply.Error = e
+		}
+		c.mx.Lock()
+		err := c.send(reply)
+		if err == nil && e == nil {
+			c.start()
+		}
+		c.mx.Unlock()
+		if err != nil {
+			log <- cl.Debug{"error sending to Stratum miner", c.conn.RemoteAddr(), err}
+			return
+		}
+	}
+}
+// send writes a message to the miner, c.mx must be held
+func (c *client) send(
+	msg interface{}) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_, err = c.conn.Write(append(b, '\n'))
+	return err
+}
+// handle runs a request of the miner
+func (c *client) handle(
+	r *request) (interface{}, *Error) {
+	switch r.Method {
+	case "mining.subscribe":
+		return c.subscribe(r.Params)
+	case "mining.authorize":
+		return c.authorize(r.Params)
+	case "mining.submit":
+		return c.submit(r.Params)
+	case "mining.extranonce.subscribe":
+		return true, nil
+	case "mining.suggest_difficulty":
+		return c.suggestDifficulty(r.Params)
+	case "mining.configure":
+		// the block version selects the algorithm, so it can't be rolled
+		return map[string]interface{}{"version-rolling": false}, nil
+	}
+	return nil, &Error{ErrOther, "Unknown method " + r.Method}
+}
+// param returns a string parameter of a request, empty if it is missing or not a string
+func param(
+	params []json.RawMessage, i int) (s string) {
+	if i < len(params) {
+		json.Unmarshal(params[i], &s)
+	}
+	return
+}
+// subscribe sets the algorithm of the miner, the algorithm of the listener or one named by a parameter, and returns the extra nonce of the connection
+func (c *client) subscribe(
+	params []json.RawMessage) (interface{}, *Error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.algo = c.fixedAlgo
+	if c.algo == "" {
+		c.algo = DefaultAlgo
+		for i := range params {
+			if p := strings.ToLower(param(params, i)); c.s.algos[p] {
+				c.algo = p
+			}
+		}
+	}
+	if !c.subscribed {
+		c.difficulty = c.s.difficulty(c.algo)
+		c.since = time.Now()
+	}
+	c.subscribed = true
+	id := hex.EncodeToString(c.extraNonce1)
+	log <- cl.Debug{"Stratum miner", c.conn.RemoteAddr(), "subscribed for", c.algo}
+	return []interface{}{
+		[][]string{{"mining.set_difficulty", id}, {"mining.notify", id}},
+		id,
+		ExtraNonce2Size,
+	}, nil
+}
+// authorize adds a worker to the connection if its password is the one of the server
+func (c *client) authorize(
+	params []json.RawMessage) (interface{}, *Error) {
+	name, pass := param(params, 0), param(params, 1)
+	if name == "" {
+		return false, &Error{ErrUnauthorized, "Unauthorized worker"}
+	}
+	if c.s.cfg.Password != "" && pass != c.s.cfg.Password {
+		log <- cl.Warn{"Stratum worker", name, "from", c.conn.RemoteAddr(), "failed to authorize"}
+		return false, &Error{ErrUnauthorized, "Unauthorized worker"}
+	}
+	c.mx.Lock()
+	c.workers[name] = true
+	algo, difficulty := c.algo, c.difficulty
+	c.mx.Unlock()
+	c.s.statsMtx.Lock()
+	w := c.s.worker(name)
+	w.Algo, w.Difficulty = algo, difficulty
+	c.s.statsMtx.Unlock()
+	log <- cl.Info{"Stratum worker", name, "authorized from", c.conn.RemoteAddr()}
+	return true, nil
+}
+// suggestDifficulty sets the share difficulty the miner asks for
+func (c *client) suggestDifficulty(
+	params []json.RawMessage) (interface{}, *Error) {
+	var d float64
+	if len(params) == 0 || json.Unmarshal(params[0], &d) != nil || d < MinDifficulty {
+		return false, &Error{ErrOther, "Invalid difficulty"}
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.difficulty = d
+	if c.started {
+		c.send(notification{Method: "mining.set_difficulty", Params: []interface{}{d}})
+	}
+	return true, nil
+}
+// start sends the miner its share difficulty and the current job once it has subscribed and authorized a worker, c.mx must be held
+func (c *client) start() {
+	if c.started || !c.subscribed || len(c.workers) == 0 {
+		return
+	}
+	c.started = true
+	c.send(notification{Method: "mining.set_difficulty", Params: []interface{}{c.difficulty}})
+	if j := c.s.currentJob(); j != nil {
+		c.sendJob(j, true)
+	}
+}
+// notify sends a new job to the miner
+func (c *client) notify(
+	j *job) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if c.started {
+		c.sendJob(j, j.clean)
+	}
+}
+// sendJob sends a job to the miner with the version and target bits of its algorithm, recording the share difficulty of the job. A job sent again keeps the lower of its difficulties. A clean job replaces the older jobs of the miner. c.mx must be held.
+func (c *client) sendJob(
+	j *job, clean bool) {
+	at, ok := j.algos[c.algo]
+	if !ok {
+		log <- cl.Debug{"algorithm", c.algo, "of Stratum miner", c.conn.RemoteAddr(),
+			"is not active at height", j.height}
+		return
+	}
+	if clean {
+		c.jobDiff = make(map[string]float64)
+		c.shares = make(map[string]bool)
+	}
+	for id := range c.jobDiff {
+		if c.s.job(id) == nil {
+			delete(c.jobDiff, id)
+		}
+	}
+	if d, ok := c.jobDiff[j.id]; !ok || c.difficulty < d {
+		c.jobDiff[j.id] = c.difficulty
+	}
+	branch := make([]string, len(j.branch))
+	for i, x := range j.branch {
+		branch[i] = hex.EncodeToString(x[:])
+	}
+	err := c.send(notification{Method: "mining.notify", Params: []interface{}{
+		j.id,
+		prevHash(j.block.Header.PrevBlock),
+		hex.EncodeToString(j.coinb1),
+		hex.EncodeToString(j.coinb2),
+		branch,
+		hexUint32(uint32(at.version)),
+		hexUint32(at.bits),
+		hexUint32(uint32(j.block.Header.Timestamp.Unix())),
+		clean,
+	}})
+	if err != nil {
+		log <- cl.Debug{"error sending job to Stratum miner", c.conn.RemoteAddr(), err}
+		c.conn.Close()
+	}
+}
+// submit checks a share of the miner and submits the block if it solves one
+func (c *client) submit(
+	params []json.RawMessage) (interface{}, *Error) {
+	name, id := param(params, 0), param(params, 1)
+	en2, ntimeHex, nonceHex := param(params, 2), param(params, 3), param(params, 4)
+	c.mx.Lock()
+	if !c.started {
+		c.mx.Unlock()
+		return false, &Error{ErrNotSubscribed, "Not subscribed"}
+	}
+	if !c.workers[name] {
+		c.mx.Unlock()
+		return false, &Error{ErrUnauthorized, "Unauthorized worker"}
+	}
+	algo := c.algo
+	difficulty, sent := c.jobDiff[id]
+	j := c.s.job(id)
+	if j == nil || !sent {
+		c.mx.Unlock()
+		c.account(name, algo, 0, false, true)
+		return false, &Error{ErrJobNotFound, "Job not found"}
+	}
+	key := strings.ToLower(id + en2 + ntimeHex + nonceHex)
+	duplicate := c.shares[key]
+	c.shares[key] = true
+	c.mx.Unlock()
+	header, extraNonce, e := c.header(j, algo, en2, ntimeHex, nonceHex)
+	if e == nil && duplicate {
+		e = &Error{ErrDuplicate, "Duplicate share"}
+	}
+	if e != nil {
+		c.account(name, algo, 0, false, false)
+		return false, e
+	}
+	// the hash is computed without holding the lock as it takes a while for some algorithms
+	hash := header.BlockHashWithAlgos(c.s.cfg.ChainParams, j.height)
+	h := blockchain.HashToBig(&hash)
+	solved := h.Cmp(fork.CompactToBig(header.Bits)) <= 0
+	if !solved && h.Cmp(Target(algo, difficulty)) > 0 {
+		c.account(name, algo, 0, false, false)
+		return false, &Error{ErrLowDifficulty, "Low difficulty share"}
+	}
+	block := false
+	if solved {
+		log <- cl.Info{"Stratum worker", name, "solved a block at height", j.height, "with", algo}
+		block = c.s.submitBlock(j.solve(header, extraNonce))
+	}
+	c.account(name, algo, difficulty, block, false)
+	c.mx.Lock()
+	c.count++
+	c.work += difficulty
+	c.retarget(time.Now())
+	c.mx.Unlock()
+	return true, nil
+}
+// header returns the block header of a share of a job and the extra nonce of its coinbase
+func (c *client) header(
+	j *job, algo, en2, ntimeHex, nonceHex string) (header wire.BlockHeader, extraNonce []byte, e *Error) {
+	at, ok := j.algos[algo]
+	if !ok {
+		return header, nil, &Error{ErrOther, "Algorithm " + algo + " is not active"}
+	}
+	extraNonce2, err := hex.DecodeString(en2)
+	if err != nil || len(extraNonce2) != ExtraNonce2Size {
+		return header, nil, &Error{ErrOther, "Incorrect size of extranonce2"}
+	}
+	ntime, err := parseHexUint32(ntimeHex)
+	if err != nil {
+		return header, nil, &Error{ErrOther, "Invalid ntime"}
+	}
+	if int64(ntime) < j.block.Header.Timestamp.Unix() ||
+		int64(ntime) > time.Now().Unix()+blockchain.MaxTimeOffsetSeconds {
+		return header, nil, &Error{ErrOther, "ntime out of range"}
+	}
+	nonce, err := parseHexUint32(nonceHex)
+	if err != nil {
+		return header, nil, &Error{ErrOther, "Invalid nonce"}
+	}
+	extraNonce = append(append([]byte{}, c.extraNonce1...), extraNonce2...)
+	coinbase := append(append(append([]byte{}, j.coinb1...), extraNonce...), j.coinb2...)
+	header = wire.BlockHeader{
+		Version:    at.version,
+		PrevBlock:  j.block.Header.PrevBlock,
+		MerkleRoot: merkleRoot(coinbase, j.branch),
+		Timestamp:  time.Unix(int64(ntime), 0),
+		Bits:       at.bits,
+		Nonce:      nonce,
+	}
+	return
+}
+// solve returns the block of a job with the header and coinbase extra nonce of a share that solves it
+func (j *job) solve(
+	header wire.BlockHeader, extraNonce []byte) *util.Block {
+	coinbase := j.block.Transactions[0].Copy()
+	script := coinbase.TxIn[0].SignatureScript
+	// the offset was found when the job was made
+	offset, _ := extraNonceOffset(script)
+	copy(script[offset:], extraNonce)
+	msgBlock := *j.block
+	msgBlock.Header = header
+	msgBlock.Transactions = append([]*wire.MsgTx{coinbase}, j.block.Transactions[1:]...)
+	block := util.NewBlock(&msgBlock)
+	block.SetHeight(j.height)
+	return block
+}
+// account records a share of a worker, accepted with its difficulty if it is not 0
+func (c *client) account(
+	name, algo string, difficulty float64, block, stale bool) {
+	c.mx.Lock()
+	current := c.difficulty
+	c.mx.Unlock()
+	c.s.statsMtx.Lock()
+	defer c.s.statsMtx.Unlock()
+	w := c.s.worker(name)
+	w.Algo, w.Difficulty = algo, current
+	switch {
+	case stale:
+		w.Stale++
+	case difficulty == 0:
+		w.Rejected++
+	default:
+		w.Accepted++
+		w.Work += difficulty
+		w.LastShare = time.Now()
+	}
+	if block {
+		w.Blocks++
+	}
+}
+// checkIdle lowers the share difficulty of a miner that has not sent enough shares for a while
+func (c *client) checkIdle(
+	now time.Time) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.retarget(now)
+}
+// retarget adjusts the share difficulty towards one share every ShareTime from the work of the shares accepted since the last adjustment, once retargetShares shares have been accepted or retargetShares share times have passed. The current job is sent again so the miner uses a lower difficulty right away. c.mx must be held.
+func (c *client) retarget(
+	now time.Time) {
+	st := c.s.cfg.ShareTime
+	if st <= 0 || !c.started {
+		return
+	}
+	elapsed := now.Sub(c.since)
+	if c.count < retargetShares && elapsed < retargetShares*st {
+		return
+	}
+	d := c.difficulty / maxRetarget
+	if c.count > 0 {
+		d = c.work * float64(st) / float64(elapsed)
+	}
+	d = math.Max(math.Min(d, c.difficulty*maxRetarget), c.difficulty/maxRetarget)
+	d = math.Max(d, MinDifficulty)
+	c.since, c.count, c.work = now, 0, 0
+	// small changes are not worth the miner restarting its work
+	if d > c.difficulty*0.8 && d < c.difficulty*1.25 {
+		return
+	}
+	log <- cl.Debugf{"Stratum miner %s share difficulty %g -> %g", c.conn.RemoteAddr(), c.difficulty, d}
+	c.difficulty = d
+	c.send(notification{Method: "mining.set_difficulty", Params: []interface{}{d}})
+	if j := c.s.currentJob(); j != nil {
+		c.sendJob(j, false)
+	}
+}
@@ -0,0 +1,8 @@
+package stratum
+import (
+	"git.parallelcoin.io/dev/9/cmd/ll"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// Log is the logger for the stratum package
+var Log = cl.NewSubSystem("chain/mining/stratum", ll.DEFAULT)
+var log = Log.Ch
@@ -0,0 +1,172 @@
+package stratum
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+)
+const (
+	// ExtraNonce1Size is the number of bytes of the extra nonce the server gives each connection
+	ExtraNonce1Size = 4
+	// ExtraNonce2Size is the number of bytes of the extra nonce miners roll themselves
+	ExtraNonce2Size = 4
+	// extraNoncePlaceholder is the extra nonce the templates are made with, the smallest that is pushed with 8 bytes so the extra nonces of the miners fit in its place
+	extraNoncePlaceholder = uint64(1) << 56
+)
+// Stratum error codes
+const (
+	ErrOther         = 20
+	ErrJobNotFound   = 21
+	ErrDuplicate     = 22
+	ErrLowDifficulty = 23
+	ErrUnauthorized  = 24
+	ErrNotSubscribed = 25
+)
+// request is a JSON-RPC request or notification from a miner
+type request struct {
+	ID     interface{}       `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+// response is the reply to a request
+type response struct {
+	ID     interface{} `json:"id"`
+	Result interface{} `json:"result"`
+	Error  interface{} `json:"error"`
+}
+// notification is a message sent to miners that is not a reply
+type notification struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+// Error is a Stratum error, sent as [code, message, null]
+type Error struct {
+	Code    int
+	Message string
+}
+// Error returns the message of the error
+func (e *Error) Error() string {
+	return e.Message
+}
+// MarshalJSON encodes the error the way Stratum miners expect it
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.Code, e.Message, nil})
+}
+// diff1 is the target of a share of difficulty 1
+var diff1 = func() *big.Int {
+	b, _ := hex.DecodeString("00000000ffff0000000000000000000000000000000000000000000000000000")
+	return new(big.Int).SetBytes(b)
+}()
+// maxTarget is the largest target of a 256 bit hash
+var maxTarget = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+// diffMultipliers are the factors the miners of some algorithms scale the share difficulty by, the same as the common pools do
+var diffMultipliers = map[string]int64{
+	"scrypt": 65536,
+	"keccak": 256,
+}
+// Target returns the target a share of the algorithm at difficulty must not exceed
+func Target(
+	algo string, difficulty float64) *big.Int {
+	t := new(big.Float).SetInt(diff1)
+	if m, ok := diffMultipliers[algo]; ok {
+		t.Mul(t, new(big.Float).SetInt64(m))
+	}
+	t.Quo(t, big.NewFloat(difficulty))
+	out, _ := t.Int(nil)
+	if out.Cmp(maxTarget) > 0 {
+		return new(big.Int).Set(maxTarget)
+	}
+	return out
+}
+// splitCoinbase serializes a coinbase made with the placeholder extra nonce without its witness, split around the 8 bytes of the extra nonce
+func splitCoinbase(
+	tx *wire.MsgTx) (coinb1, coinb2 []byte, err error) {
+	script := tx.TxIn[0].SignatureScript
+	offset, err := extraNonceOffset(script)
+	if err != nil {
+		return
+	}
+	var buf bytes.Buffer
+	if err = tx.SerializeNoWitness(&buf); err != nil {
+		return
+	}
+	b := buf.Bytes()
+	i := bytes.Index(b, script)
+	if i < 0 {
+		return nil, nil, errors.New("coinbase script not found in the coinbase")
+	}
+	i += offset
+	return b[:i], b[i+ExtraNonce1Size+ExtraNonce2Size:], nil
+}
+// extraNonceOffset returns where the 8 bytes of the extra nonce start in a coinbase script, after the height and the push of the extra nonce
+func extraNonceOffset(
+	script []byte) (int, error) {
+	if len(script) == 0 {
+		return 0, errors.New("empty coinbase script")
+	}
+	// the height is a small integer opcode or a push of up to 75 bytes
+	n := 1
+	if script[0] >= 1 && script[0] <= 75 {
+		n += int(script[0])
+	}
+	if len(script) < n+1+ExtraNonce1Size+ExtraNonce2Size || script[n] != ExtraNonce1Size+ExtraNonce2Size {
+		return 0, errors.New("coinbase script has no 8 byte extra nonce")
+	}
+	return n + 1, nil
+}
+// merkleBranch returns the hashes a miner combines the hash of the coinbase with to get the merkle root, given the hashes of the transactions after the coinbase
+func merkleBranch(
+	hashes []*chainhash.Hash) (branch []*chainhash.Hash) {
+	level := append([]*chainhash.Hash{nil}, hashes...)
+	for len(level) > 1 {
+		branch = append(branch, level[1])
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		next := []*chainhash.Hash{nil}
+		for i := 2; i < len(level); i += 2 {
+			next = append(next, blockchain.HashMerkleBranches(level[i], level[i+1]))
+		}
+		level = next
+	}
+	return
+}
+// merkleRoot returns the merkle root of a block with the coinbase and the merkle branch of the other transactions
+func merkleRoot(
+	coinbase []byte, branch []*chainhash.Hash) chainhash.Hash {
+	root := chainhash.DoubleHashH(coinbase)
+	for _, x := range branch {
+		root = *blockchain.HashMerkleBranches(&root, x)
+	}
+	return root
+}
+// prevHash encodes the previous block hash the way Stratum miners expect it, with the bytes of each 4 byte word swapped
+func prevHash(
+	h chainhash.Hash) string {
+	var b [chainhash.HashSize]byte
+	for i := 0; i < len(b); i += 4 {
+		binary.BigEndian.PutUint32(b[i:], binary.LittleEndian.Uint32(h[i:]))
+	}
+	return hex.EncodeToString(b[:])
+}
+// hexUint32 encodes a header field as 8 hex digits in big endian order
+func hexUint32(
+	v uint32) string {
+	return fmt.Sprintf("%08x", v)
+}
+// parseHexUint32 decodes a header field sent by a miner as 8 hex digits in big endian order
+func parseHexUint32(
+	s string) (uint32, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 4 {
+		return 0, fmt.Errorf("'%s' is not 8 hex digits", s)
+	}
+	return binary.BigEndian.Uint32(b), nil
+}
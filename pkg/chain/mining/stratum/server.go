@@ -0,0 +1,529 @@
+package stratum
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/chain/mining"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	"git.parallelcoin.io/dev/9/pkg/util"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+const (
+	// updateInterval is how often the server checks for a new best block or mempool change and adjusts the difficulty of idle miners
+	updateInterval = time.Second / 2
+	// minMempoolInterval is the minimum time between new jobs caused only by mempool changes
+	minMempoolInterval = time.Second * 10
+	// maxJobs is the number of recent jobs on the current best block that shares are accepted for
+	maxJobs = 16
+	// DefaultAlgo is the algorithm of miners that connect to a listener without one and don't choose one in mining.subscribe
+	DefaultAlgo = "sha256d"
+	// DefaultDifficulty is the starting share difficulty of the algorithms without one configured
+	DefaultDifficulty = 1.0
+	// MinDifficulty is the lowest share difficulty the server adjusts a miner to
+	MinDifficulty = 1.0 / 65536
+)
+// Listener is an address the server accepts miners on with the algorithm they mine, empty for the miners to choose it in mining.subscribe
+type Listener struct {
+	Addr string
+	Algo string
+}
+// String returns the listener as algorithm:address, or just the address if it has no algorithm
+func (l Listener) String() string {
+	if l.Algo == "" {
+		return l.Addr
+	}
+	return l.Algo + ":" + l.Addr
+}
+// Config is a descriptor containing the Stratum server configuration.
+type Config struct {
+	// Blockchain gives access for the server to information about the chain
+	Blockchain *blockchain.BlockChain
+	// ChainParams identifies which chain parameters the server is associated with.
+	ChainParams *chaincfg.Params
+	// BlockTemplateGenerator identifies the instance to use in order to generate the block templates of the jobs.
+	BlockTemplateGenerator *mining.BlkTmplGenerator
+	// MiningAddrs is a list of payment addresses to use for the generated blocks.  Each generated block will randomly choose one of them.
+	MiningAddrs []util.Address
+	// ProcessBlock defines the function to call with any solved blocks. It typically must run the provided block through the same set of rules and handling as any other block coming from the network.
+	ProcessBlock func(*util.Block, blockchain.BehaviorFlags) (bool, error)
+	// Listeners are the addresses the server accepts miners on
+	Listeners []Listener
+	// Difficulty is the starting share difficulty of the miners of each algorithm, DefaultDifficulty for those not in it
+	Difficulty map[string]float64
+	// ShareTime is the time between the shares of a miner its share difficulty is adjusted towards, 0 keeps the share difficulty fixed
+	ShareTime time.Duration
+	// Password is the password the miners must authorize with, any password is accepted if it is empty
+	Password string
+	// ConnectedCount defines the function to use to obtain how many other peers the server is connected to. There is no point in mining when not connected to any peers since there would no be anyone to send any found blocks to.
+	ConnectedCount func() int32
+	// IsCurrent defines the function to use to obtain whether or not the block chain is current. Solved blocks on a chain that is not current would be on a side chain and end up orphaned anyways.
+	IsCurrent func() bool
+}
+// job is a block template sent out to miners, kept so that shares can be checked and solutions turned back into blocks
+type job struct {
+	n      uint64
+	id     string
+	height int32
+	// block is the template with the placeholder extra nonce
+	block          *wire.MsgBlock
+	coinb1, coinb2 []byte
+	branch         []*chainhash.Hash
+	// algos are the block version and target bits of each algorithm that is active at the height of the job
+	algos map[string]algoTarget
+	// clean is set on the first job on a new best block, the miners drop their older jobs for it
+	clean bool
+}
+// algoTarget is the block version and target bits of an algorithm
+type algoTarget struct {
+	version int32
+	bits    uint32
+}
+// WorkerStats is the share accounting of a worker, the name a miner authorizes with
+type WorkerStats struct {
+	Name string
+	// Algo is the algorithm the worker last submitted a share for
+	Algo string
+	// Difficulty is the share difficulty of the last connection of the worker
+	Difficulty float64
+	Accepted   uint64
+	Rejected   uint64
+	Stale      uint64
+	Blocks     uint64
+	// Work is the sum of the difficulties of the accepted shares
+	Work      float64
+	LastShare time.Time
+}
+// Server is a Stratum v1 mining server that gives miners jobs made from the block templates of the node, checks their shares and submits the blocks they solve
+type Server struct {
+	sync.Mutex
+	b               *blockchain.BlockChain
+	g               *mining.BlkTmplGenerator
+	cfg             Config
+	algos           map[string]bool
+	started         bool
+	submitBlockLock sync.Mutex
+	wg              sync.WaitGroup
+	quit            chan struct{}
+	listeners       []net.Listener
+	clientsMtx      sync.Mutex
+	clients         map[*client]struct{}
+	nextExtraNonce  uint32
+	jobsMtx         sync.Mutex
+	jobs            map[string]*job
+	current         *job
+	nextJob         uint64
+	statsMtx        sync.Mutex
+	workers         map[string]*WorkerStats
+}
+// Algos returns the names of the algorithms of every hard fork of the network
+func Algos(
+	p fork.Params) map[string]bool {
+	algos := make(map[string]bool)
+	for _, x := range p.Forks() {
+		for name := range x.Algos {
+			algos[name] = true
+		}
+	}
+	return algos
+}
+// ParseListeners parses listener entries of an address that may be prefixed with an algorithm and a colon, giving addresses without a port defaultPort
+func ParseListeners(
+	p fork.Params, entries []string, defaultPort string) (listeners []Listener, err error) {
+	algos := Algos(p)
+	for _, x := range entries {
+		l := Listener{Addr: x}
+		if i := strings.Index(x, ":"); i > 0 && algos[x[:i]] {
+			l.Algo, l.Addr = x[:i], x[i+1:]
+		}
+		if l.Addr == "" {
+			return nil, fmt.Errorf("Stratum listener '%s' has no address", x)
+		}
+		l.Addr = util.NormalizeAddress(l.Addr, defaultPort)
+		if _, _, err = net.SplitHostPort(l.Addr); err != nil {
+			return nil, fmt.Errorf("Stratum listener '%s': %v", x, err)
+		}
+		listeners = append(listeners, l)
+	}
+	return
+}
+// ParseDifficulty parses share difficulty entries of a number that may be prefixed with an algorithm and a colon, a number without an algorithm sets the difficulty of all of them
+func ParseDifficulty(
+	p fork.Params, entries []string) (difficulty map[string]float64, err error) {
+	algos := Algos(p)
+	difficulty = make(map[string]float64)
+	var all float64
+	for _, x := range entries {
+		algo, value := "", x
+		if i := strings.Index(x, ":"); i >= 0 {
+			algo, value = x[:i], x[i+1:]
+			if !algos[algo] {
+				return nil, fmt.Errorf("Stratum difficulty '%s' is for an unknown algorithm", x)
+			}
+		}
+		d, e := strconv.ParseFloat(value, 64)
+		if e != nil || d < MinDifficulty {
+			return nil, fmt.Errorf("Stratum difficulty '%s' is not a number of at least %g", x, MinDifficulty)
+		}
+		if algo == "" {
+			all = d
+		} else {
+			difficulty[algo] = d
+		}
+	}
+	if all > 0 {
+		for name := range algos {
+			if _, ok := difficulty[name]; !ok {
+				difficulty[name] = all
+			}
+		}
+	}
+	return
+}
+// submitBlock submits the passed block to network after ensuring it passes all of the consensus validation rules.
+func (s *Server) submitBlock(block *util.Block) bool {
+	s.submitBlockLock.Lock()
+	defer s.submitBlockLock.Unlock()
+	// Ensure the block is not stale since a new block could have shown up while the solution was being found.
+	msgBlock := block.MsgBlock()
+	if !msgBlock.Header.PrevBlock.IsEqual(&s.g.BestSnapshot().Hash) {
+		log <- cl.Debugf{
+			"Block submitted via Stratum with previous block %s is stale",
+			msgBlock.Header.PrevBlock,
+		}
+		return false
+	}
+	// Process this block using the same rules as blocks coming from other nodes.  This will in turn relay it to the network like normal.
+	isOrphan, err := s.cfg.ProcessBlock(block, blockchain.BFNone)
+	if err != nil {
+		// Anything other than a rule violation is an unexpected error, so log that error as an internal error.
+		if _, ok := err.(blockchain.RuleError); !ok {
+			log <- cl.Error{
+				"Unexpected error while processing block submitted via Stratum:",
+				err,
+			}
+			return false
+		}
+		log <- cl.Debug{"block submitted via Stratum rejected:", err}
+		return false
+	}
+	if isOrphan {
+		log <- cl.Dbg("Block submitted via Stratum is an orphan")
+		return false
+	}
+	coinbaseTx := msgBlock.Transactions[0].TxOut[0]
+	Log.Infc(func() string {
+		return fmt.Sprintf(
+			"new block height %d %s %10d %08x %v %s via Stratum",
+			block.Height(),
+			msgBlock.BlockHashWithAlgos(s.cfg.ChainParams, block.Height()),
+			msgBlock.Header.Timestamp.Unix(),
+			msgBlock.Header.Bits,
+			util.Amount(coinbaseTx.Value),
+			fork.GetAlgoName(s.cfg.ChainParams, msgBlock.Header.Version,
+				block.Height()),
+		)
+	})
+	return true
+}
+// newJob creates a block template on the current best block with the placeholder extra nonce and splits its coinbase around it, with the target bits of every algorithm that is active at the next height
+func (s *Server) newJob(
+	clean bool) (j *job, err error) {
+	// Grab the same lock as used for block submission, since the current block will be changing and this would otherwise end up building a new block template on a block that is in the process of becoming stale.
+	s.submitBlockLock.Lock()
+	defer s.submitBlockLock.Unlock()
+	height := s.g.BestSnapshot().Height + 1
+	hf := fork.Get(s.cfg.ChainParams, height)
+	var algos []string
+	for i := range hf.Algos {
+		algos = append(algos, i)
+	}
+	sort.Slice(algos, func(i, j int) bool {
+		return hf.Algos[algos[i]].Version < hf.Algos[algos[j]].Version
+	})
+	// Choose a payment address at random
+	payToAddr := s.cfg.MiningAddrs[rand.Intn(len(s.cfg.MiningAddrs))]
+	template, err := s.g.NewBlockTemplate(payToAddr, algos[0])
+	if err != nil {
+		return
+	}
+	msgBlock := template.Block
+	if err = s.g.UpdateExtraNonce(msgBlock, height, extraNoncePlaceholder); err != nil {
+		return
+	}
+	s.nextJob++
+	j = &job{
+		n:      s.nextJob,
+		id:     fmt.Sprintf("%x", s.nextJob),
+		height: height,
+		block:  msgBlock,
+		algos:  make(map[string]algoTarget),
+		clean:  clean,
+	}
+	if j.coinb1, j.coinb2, err = splitCoinbase(msgBlock.Transactions[0]); err != nil {
+		return
+	}
+	var hashes []*chainhash.Hash
+	for _, x := range util.NewBlock(msgBlock).Transactions()[1:] {
+		hashes = append(hashes, x.Hash())
+	}
+	j.branch = merkleBranch(hashes)
+	for _, x := range algos {
+		var bits uint32
+		bits, err = s.b.CalcNextRequiredDifficulty(msgBlock.Header.Timestamp, x)
+		if err != nil {
+			return
+		}
+		j.algos[x] = algoTarget{version: hf.Algos[x].Version, bits: bits}
+	}
+	return
+}
+// setJob makes a job the current one and forgets jobs that are built on another block or have fallen out of the window of recent jobs
+func (s *Server) setJob(
+	j *job) {
+	s.jobsMtx.Lock()
+	defer s.jobsMtx.Unlock()
+	for i, x := range s.jobs {
+		if x.block.Header.PrevBlock != j.block.Header.PrevBlock ||
+			x.n+maxJobs <= j.n {
+			delete(s.jobs, i)
+		}
+	}
+	s.jobs[j.id] = j
+	s.current = j
+}
+// job returns a job by its ID, nil if it is unknown or stale
+func (s *Server) job(
+	id string) *job {
+	s.jobsMtx.Lock()
+	defer s.jobsMtx.Unlock()
+	return s.jobs[id]
+}
+// currentJob returns the current job, nil if there is none yet
+func (s *Server) currentJob() *job {
+	s.jobsMtx.Lock()
+	defer s.jobsMtx.Unlock()
+	return s.current
+}
+// clientList returns the connected miners
+func (s *Server) clientList() (clients []*client) {
+	s.clientsMtx.Lock()
+	defer s.clientsMtx.Unlock()
+	for c := range s.clients {
+		clients = append(clients, c)
+	}
+	return
+}
+// workUpdater makes a new job when the best block changes, or when the mempool changes and no new job has been made for minMempoolInterval, and sends it to the miners. It also lowers the share difficulty of miners that have stopped sending shares. It must be run as a goroutine.
+func (s *Server) workUpdater() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(updateInterval)
+	defer ticker.Stop()
+	var lastBest = s.g.BestSnapshot().Hash
+	var lastTxUpdate, lastGenerated time.Time
+	var haveWork bool
+out:
+	for {
+		select {
+		case <-s.quit:
+			break out
+		case <-ticker.C:
+		}
+		clients := s.clientList()
+		now := time.Now()
+		for _, c := range clients {
+			c.checkIdle(now)
+		}
+		// There is no point making jobs when nobody is mining, no peers will receive solved blocks, or the chain is not yet synced
+		if len(clients) == 0 || s.cfg.ConnectedCount() == 0 {
+			continue
+		}
+		best := s.g.BestSnapshot()
+		if best.Height != 0 && !s.cfg.IsCurrent() {
+			continue
+		}
+		txUpdate := s.g.TxSource().LastUpdated()
+		newBlock := !haveWork || !best.Hash.IsEqual(&lastBest)
+		if !newBlock && (txUpdate == lastTxUpdate || time.Since(lastGenerated) < minMempoolInterval) {
+			continue
+		}
+		j, err := s.newJob(newBlock)
+		if err != nil {
+			log <- cl.Error{"failed to create new block template:", err}
+			continue
+		}
+		s.setJob(j)
+		haveWork = true
+		lastBest, lastTxUpdate, lastGenerated = best.Hash, txUpdate, time.Now()
+		log <- cl.Tracef{"sending job %s for height %d to %d miners",
+			j.id, j.height, len(clients)}
+		for _, c := range clients {
+			c.notify(j)
+		}
+	}
+}
+// accept accepts miners on a listener until it is closed. It must be run as a goroutine.
+func (s *Server) accept(
+	listener net.Listener, algo string) {
+	defer s.wg.Done()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-s.quit:
+				return
+			default:
+			}
+			log <- cl.Debug{"error accepting Stratum connection:", err}
+			time.Sleep(updateInterval)
+			continue
+		}
+		s.clientsMtx.Lock()
+		s.nextExtraNonce++
+		c := newClient(s, conn, algo, s.nextExtraNonce)
+		s.clients[c] = struct{}{}
+		s.clientsMtx.Unlock()
+		log <- cl.Debug{"new Stratum miner connected from", conn.RemoteAddr()}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			c.serve()
+			s.clientsMtx.Lock()
+			delete(s.clients, c)
+			s.clientsMtx.Unlock()
+			log <- cl.Debug{"Stratum miner disconnected from", conn.RemoteAddr()}
+		}()
+	}
+}
+// difficulty returns the starting share difficulty of an algorithm
+func (s *Server) difficulty(
+	algo string) float64 {
+	if d, ok := s.cfg.Difficulty[algo]; ok {
+		return d
+	}
+	return DefaultDifficulty
+}
+// worker returns the share accounting of a worker, statsMtx must be held
+func (s *Server) worker(
+	name string) *WorkerStats {
+	w, ok := s.workers[name]
+	if !ok {
+		w = &WorkerStats{Name: name}
+		s.workers[name] = w
+	}
+	return w
+}
+// Start opens the listeners and begins giving jobs to miners. Calling this function when the server has already been started will have no effect.
+func (s *Server) Start() (err error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.started {
+		return
+	}
+	if len(s.cfg.MiningAddrs) == 0 {
+		return errors.New("Stratum server requires mining addresses")
+	}
+	if len(s.cfg.Listeners) == 0 {
+		return errors.New("Stratum server has no listeners")
+	}
+	s.quit = make(chan struct{})
+	for _, x := range s.cfg.Listeners {
+		var l net.Listener
+		if l, err = net.Listen("tcp", x.Addr); err != nil {
+			for _, y := range s.listeners {
+				y.Close()
+			}
+			s.listeners = nil
+			return
+		}
+		s.listeners = append(s.listeners, l)
+		s.wg.Add(1)
+		go s.accept(l, x.Algo)
+		if x.Algo == "" {
+			log <- cl.Info{"Stratum server listening on", l.Addr()}
+		} else {
+			log <- cl.Info{"Stratum server listening on", l.Addr(), "for", x.Algo}
+		}
+	}
+	s.wg.Add(1)
+	go s.workUpdater()
+	s.started = true
+	return
+}
+// Stop gracefully stops the server, closing the listeners and the connections of the miners and waiting for its goroutines to finish.  Calling this function when the server has not already been started will have no effect.
+func (s *Server) Stop() {
+	s.Lock()
+	defer s.Unlock()
+	if !s.started {
+		return
+	}
+	close(s.quit)
+	for _, x := range s.listeners {
+		x.Close()
+	}
+	s.listeners = nil
+	for _, c := range s.clientList() {
+		c.conn.Close()
+	}
+	s.wg.Wait()
+	s.started = false
+	log <- cl.Inf("Stratum server stopped")
+}
+// IsRunning returns whether or not the server has been started. This function is safe for concurrent access.
+func (s *Server) IsRunning() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.started
+}
+// Listeners returns the addresses the server accepts miners on. This function is safe for concurrent access.
+func (s *Server) Listeners() (listeners []Listener) {
+	s.Lock()
+	defer s.Unlock()
+	return append(listeners, s.cfg.Listeners...)
+}
+// Miners returns the number of miners currently connected. This function is safe for concurrent access.
+func (s *Server) Miners() int {
+	s.clientsMtx.Lock()
+	defer s.clientsMtx.Unlock()
+	return len(s.clients)
+}
+// Stats returns the share accounting of the workers sorted by name. This function is safe for concurrent access.
+func (s *Server) Stats() (stats []WorkerStats) {
+	s.statsMtx.Lock()
+	defer s.statsMtx.Unlock()
+	for _, x := range s.workers {
+		stats = append(stats, *x)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+	return
+}
+// SetMiningAddrs replaces the payment addresses new jobs randomly choose from, taking effect with the next block template. This function is safe for concurrent access.
+func (s *Server) SetMiningAddrs(addrs []util.Address) {
+	s.submitBlockLock.Lock()
+	s.cfg.MiningAddrs = addrs
+	s.submitBlockLock.Unlock()
+}
+// New returns a new instance of a Stratum server for the provided configuration. Use Start to begin accepting miners.
+func New(
+	cfg *Config) *Server {
+	return &Server{
+		b:       cfg.Blockchain,
+		g:       cfg.BlockTemplateGenerator,
+		cfg:     *cfg,
+		algos:   Algos(cfg.ChainParams),
+		clients: make(map[*client]struct{}),
+		jobs:    make(map[string]*job),
+		workers: make(map[string]*WorkerStats),
+	}
+}
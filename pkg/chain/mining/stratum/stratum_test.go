@@ -0,0 +1,271 @@
+package stratum
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	txscript "git.parallelcoin.io/dev/9/pkg/chain/tx/script"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	"git.parallelcoin.io/dev/9/pkg/util"
+)
+// testJob returns a job on a block of a coinbase with the placeholder extra nonce and n other transactions
+func testJob(
+	t *testing.T, n int) *job {
+	script, err := txscript.NewScriptBuilder().AddInt64(1234).
+		AddInt64(int64(extraNoncePlaceholder)).AddData([]byte("/P2SH/9/")).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex),
+		SignatureScript:  script,
+		Sequence:         wire.MaxTxInSequenceNum,
+	})
+	coinbase.AddTxOut(&wire.TxOut{Value: 5000, PkScript: []byte{txscript.OpTrue}})
+	block := &wire.MsgBlock{Header: wire.BlockHeader{Timestamp: time.Unix(1546300800, 0)}}
+	block.Header.PrevBlock[0] = 0xab
+	block.AddTransaction(coinbase)
+	for i := 0; i < n; i++ {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.LockTime = uint32(i)
+		block.AddTransaction(tx)
+	}
+	j := &job{id: "1", n: 1, height: 1234, block: block,
+		algos: map[string]algoTarget{"sha256d": {version: 2, bits: 0x03000001}}}
+	if j.coinb1, j.coinb2, err = splitCoinbase(coinbase); err != nil {
+		t.Fatal(err)
+	}
+	var hashes []*chainhash.Hash
+	for _, x := range util.NewBlock(block).Transactions()[1:] {
+		hashes = append(hashes, x.Hash())
+	}
+	j.branch = merkleBranch(hashes)
+	return j
+}
+// TestMerkleBranch ensures the merkle root a miner computes from the coinbase and the merkle branch, and the block of a solved share, have the extra nonce of the share and the merkle root of the chain
+func TestMerkleBranch(
+	t *testing.T) {
+	c := newClient(&Server{}, nil, "", 0x01020304)
+	for n := 0; n < 8; n++ {
+		j := testJob(t, n)
+		header, extraNonce, e := c.header(j, "sha256d", "0a0b0c0d", "5c2aad80", "00000001")
+		if e != nil {
+			t.Fatal(e)
+		}
+		if !bytes.Equal(extraNonce, []byte{1, 2, 3, 4, 10, 11, 12, 13}) {
+			t.Fatalf("got extra nonce %x", extraNonce)
+		}
+		block := j.solve(header, extraNonce)
+		merkles := blockchain.BuildMerkleTreeStore(block.Transactions(), false)
+		if *merkles[len(merkles)-1] != header.MerkleRoot {
+			t.Errorf("%d transactions: merkle root from the branch does not match the block", n+1)
+		}
+		script := block.MsgBlock().Transactions[0].TxIn[0].SignatureScript
+		if !bytes.Contains(script, extraNonce) {
+			t.Errorf("coinbase script %x does not have the extra nonce", script)
+		}
+		if j.block.Transactions[0].TxIn[0].SignatureScript[4] != 0 {
+			t.Error("solving a share changed the template")
+		}
+	}
+}
+// TestTarget ensures share difficulties are scaled the way the miners of each algorithm expect
+func TestTarget(
+	t *testing.T) {
+	if Target("sha256d", 1).Cmp(diff1) != 0 {
+		t.Errorf("got target %x for difficulty 1", Target("sha256d", 1))
+	}
+	if got, want := Target("x11", 4), new(big.Int).Rsh(diff1, 2); got.Cmp(want) != 0 {
+		t.Errorf("got target %x, expected %x", got, want)
+	}
+	if got, want := Target("scrypt", 1), new(big.Int).Lsh(diff1, 16); got.Cmp(want) != 0 {
+		t.Errorf("got scrypt target %x, expected %x", got, want)
+	}
+	if Target("sha256d", 1e-30).Cmp(maxTarget) != 0 {
+		t.Error("target was not capped")
+	}
+}
+// TestParse ensures listener and difficulty entries are parsed with their algorithms
+func TestParse(
+	t *testing.T) {
+	p := &chaincfg.MainNetParams
+	listeners, err := ParseListeners(p, []string{"scrypt:127.0.0.1:3333", "0.0.0.0", "[::1]:3334"}, "11044")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Listener{{"127.0.0.1:3333", "scrypt"}, {"0.0.0.0:11044", ""}, {"[::1]:3334", ""}}
+	if !reflect.DeepEqual(listeners, want) {
+		t.Errorf("got listeners %v, expected %v", listeners, want)
+	}
+	d, err := ParseDifficulty(p, []string{"sha256d:65536", "8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d["sha256d"] != 65536 || d["scrypt"] != 8 || d["x11"] != 8 {
+		t.Errorf("got difficulties %v", d)
+	}
+	for _, x := range []string{"sha512:1", "x11:many", "0"} {
+		if _, err = ParseDifficulty(p, []string{x}); err == nil {
+			t.Errorf("difficulty %s was accepted", x)
+		}
+	}
+}
+// miner is the other end of the connection of a client in a test
+type miner struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+	id   int
+}
+// message is a response or notification received by a miner
+type message struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+	Result interface{}   `json:"result"`
+	Error  []interface{} `json:"error"`
+}
+// receive reads the next message sent to the miner
+func (m *miner) receive() (msg message) {
+	line, err := m.r.ReadBytes('\n')
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	if err = json.Unmarshal(line, &msg); err != nil {
+		m.t.Fatal(err)
+	}
+	return
+}
+// call sends a request and returns the response
+func (m *miner) call(
+	method string, params ...interface{}) (result interface{}, e []interface{}) {
+	m.id++
+	b, _ := json.Marshal(map[string]interface{}{"id": m.id, "method": method, "params": params})
+	if _, err := m.conn.Write(append(b, '\n')); err != nil {
+		m.t.Fatal(err)
+	}
+	msg := m.receive()
+	if msg.Method != "" {
+		m.t.Fatalf("got notification %s instead of the response to %s", msg.Method, method)
+	}
+	return msg.Result, msg.Error
+}
+// TestProtocol ensures a miner is given its extra nonce, difficulty and job after subscribing and authorizing, and its shares are checked and accounted
+func TestProtocol(
+	t *testing.T) {
+	s := New(&Config{
+		ChainParams: &chaincfg.MainNetParams,
+		Difficulty:  map[string]float64{"sha256d": 1e-30},
+		Password:    "secret",
+	})
+	s.setJob(testJob(t, 2))
+	server, conn := net.Pipe()
+	c := newClient(s, server, "", 7)
+	done := make(chan struct{})
+	go func() {
+		c.serve()
+		close(done)
+	}()
+	defer func() {
+		conn.Close()
+		<-done
+	}()
+	m := &miner{t: t, conn: conn, r: bufio.NewReader(conn)}
+	result, _ := m.call("mining.subscribe", "cgminer/4.10.0", "sha256d")
+	if r, ok := result.([]interface{}); !ok || len(r) != 3 || r[1] != "00000007" || r[2] != float64(ExtraNonce2Size) {
+		t.Fatalf("got subscribe result %v", result)
+	}
+	if result, _ = m.call("mining.authorize", "rig1", "wrong"); result != false {
+		t.Fatalf("authorized with the wrong password")
+	}
+	if result, _ = m.call("mining.authorize", "rig1", "secret"); result != true {
+		t.Fatalf("got authorize result %v", result)
+	}
+	// the difficulty and job are sent after the reply
+	if msg := m.receive(); msg.Method != "mining.set_difficulty" {
+		t.Fatalf("got %+v instead of the difficulty", msg)
+	}
+	msg := m.receive()
+	if msg.Method != "mining.notify" {
+		t.Fatalf("got %+v instead of the job", msg)
+	}
+	job := msg.Params
+	if job[0] != "1" || job[5] != "00000002" || job[6] != "03000001" || job[7] != "5c2aad80" || job[8] != true {
+		t.Errorf("got job %v", job)
+	}
+	if job[1] != "000000ab"+"00000000000000000000000000000000000000000000000000000000" {
+		t.Errorf("got previous block %v", job[1])
+	}
+	coinb1, _ := hex.DecodeString(job[2].(string))
+	if !bytes.Equal(coinb1, s.currentJob().coinb1) {
+		t.Errorf("got coinb1 %x", coinb1)
+	}
+	if result, _ = m.call("mining.submit", "rig1", "1", "00000000", "5c2aad80", "00000001"); result != true {
+		t.Fatalf("share was rejected")
+	}
+	for _, x := range []struct {
+		params []interface{}
+		code   float64
+	}{
+		{[]interface{}{"rig1", "1", "00000000", "5c2aad80", "00000001"}, ErrDuplicate},
+		{[]interface{}{"rig1", "2", "00000000", "5c2aad80", "00000001"}, ErrJobNotFound},
+		{[]interface{}{"rig2", "1", "00000000", "5c2aad80", "00000002"}, ErrUnauthorized},
+		{[]interface{}{"rig1", "1", "000000", "5c2aad80", "00000002"}, ErrOther},
+		{[]interface{}{"rig1", "1", "00000000", "5c2aad7f", "00000002"}, ErrOther},
+	} {
+		_, e := m.call("mining.submit", x.params...)
+		if len(e) == 0 || e[0] != x.code {
+			t.Errorf("submit %v got error %v, expected code %v", x.params, e, x.code)
+		}
+	}
+	stats := s.Stats()
+	if len(stats) != 1 || stats[0].Name != "rig1" || stats[0].Accepted != 1 || stats[0].Rejected != 3 ||
+		stats[0].Stale != 1 || stats[0].Algo != "sha256d" {
+		t.Errorf("got stats %+v", stats)
+	}
+}
+// TestVardiff ensures the share difficulty follows the rate of the shares of a miner and drops when the miner stops sending them
+func TestVardiff(
+	t *testing.T) {
+	s := New(&Config{ChainParams: &chaincfg.MainNetParams, ShareTime: 10 * time.Second})
+	server, conn := net.Pipe()
+	defer conn.Close()
+	go func() {
+		// drain the messages sent to the miner
+		b := make([]byte, 4096)
+		for {
+			if _, err := conn.Read(b); err != nil {
+				return
+			}
+		}
+	}()
+	c := newClient(s, server, "", 1)
+	start := time.Now()
+	c.started, c.difficulty, c.since = true, 2, start
+	// 8 shares of difficulty 2 in 40 seconds is one every 5 seconds, so the difficulty doubles
+	c.count, c.work = 8, 16
+	c.retarget(start.Add(40 * time.Second))
+	if c.difficulty != 4 {
+		t.Errorf("got difficulty %g, expected 4", c.difficulty)
+	}
+	// a rate close to the target leaves the difficulty alone
+	c.count, c.work = 8, 17
+	c.retarget(start.Add(80 * time.Second))
+	if c.difficulty != 4 {
+		t.Errorf("got difficulty %g, expected no change", c.difficulty)
+	}
+	// no shares for 8 share times lowers it by the most it changes at once
+	c.retarget(start.Add(160 * time.Second))
+	if c.difficulty != 1 {
+		t.Errorf("got difficulty %g, expected 1", c.difficulty)
+	}
+}
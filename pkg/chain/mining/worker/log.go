@@ -0,0 +1,14 @@
+package worker
+import (
+	"git.parallelcoin.io/dev/9/cmd/ll"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// Log is the logger for the worker package
+var Log = cl.NewSubSystem("chain/mining/worker", ll.DEFAULT)
+var log = Log.Ch
+// UseLogger uses a specified Logger to output package logging info.
+func UseLogger(
+	logger *cl.SubSystem) {
+	Log = logger
+	log = Log.Ch
+}
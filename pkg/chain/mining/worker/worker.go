@@ -0,0 +1,387 @@
+package worker
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"net"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	controller "git.parallelcoin.io/dev/9/pkg/chain/mining/dispatch"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// Worker is a standalone miner that receives work from a dispatch controller running in a full node, so it does not need its own copy of the chain. It consists of a connection goroutine that keeps the subscription alive and reconnects when the controller goes quiet, a speed monitor, and a number of hashing goroutines.
+type Worker struct {
+	sync.Mutex
+	cfg        Config
+	started    bool
+	wg         sync.WaitGroup
+	quit       chan struct{}
+	connMtx    sync.Mutex
+	conn       *net.UDPConn
+	workMtx    sync.RWMutex
+	work       *controller.Work
+	workGen    uint64
+	hashesMtx  sync.Mutex
+	hashes     map[string]uint64
+	hashRates  map[string]float64
+	lastUpdate time.Time
+}
+// Config is a descriptor containing the worker configuration.
+type Config struct {
+	// Controller is the address of the miner listener of the node to get work from
+	Controller string
+	// Key is the miner key derived from the mining password, which must be the same as the one the controller uses
+	Key []byte
+	// Algo is the name of the algorithm to mine, or "random" to pick a random algorithm for each round
+	Algo string
+	// NumThreads is the number of hashing goroutines to run. Negative values use one per processor core
+	NumThreads int
+	// Switch is the maximum time to mine one algorithm before picking another
+	Switch time.Duration
+	// ChainParams identifies the network of the controller, whose hard fork schedule decides the algorithms
+	ChainParams *chaincfg.Params
+}
+const (
+	// subscribeInterval is how often the subscription is renewed, which must be well within the controller's subscription timeout
+	subscribeInterval = controller.SubscriptionTimeout / 3
+	// reconnectTimeout is how long the worker waits without hearing from the controller before it reconnects
+	reconnectTimeout = time.Second * 10
+	// hpsUpdateSecs is the number of seconds to wait in between each update to the hashes per second monitor.
+	hpsUpdateSecs = 15
+	// nonceOffset is the position of the nonce in a serialized block header
+	nonceOffset = 76
+	// checkInterval is the number of hashes between checks for new work
+	checkInterval = 8
+)
+// getWork returns the current work and its generation, which changes every time new work arrives
+func (w *Worker) getWork() (*controller.Work, uint64) {
+	w.workMtx.RLock()
+	defer w.workMtx.RUnlock()
+	return w.work, w.workGen
+}
+// workGeneration returns the generation of the current work
+func (w *Worker) workGeneration() uint64 {
+	w.workMtx.RLock()
+	defer w.workMtx.RUnlock()
+	return w.workGen
+}
+// setWork replaces the current work if the job is different, which stops the hashing goroutines from working on the old one
+func (w *Worker) setWork(work *controller.Work) {
+	w.workMtx.Lock()
+	defer w.workMtx.Unlock()
+	if w.work != nil && w.work.JobID == work.JobID &&
+		w.work.PrevBlock == work.PrevBlock {
+		return
+	}
+	log <- cl.Debugf{"new job %d for height %d", work.JobID, work.Height}
+	w.work = work
+	w.workGen++
+}
+// send writes a message to the controller if connected
+func (w *Worker) send(msgType byte, payload []byte) (err error) {
+	w.connMtx.Lock()
+	defer w.connMtx.Unlock()
+	if w.conn == nil {
+		return errors.New("not connected to controller")
+	}
+	_, err = w.conn.Write(controller.Encode(w.cfg.Key, msgType, payload))
+	return
+}
+// connect opens a new socket to the controller and subscribes to work updates
+func (w *Worker) connect() (conn *net.UDPConn, err error) {
+	addr, err := net.ResolveUDPAddr("udp", w.cfg.Controller)
+	if err != nil {
+		return
+	}
+	conn, err = net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return
+	}
+	w.connMtx.Lock()
+	if w.conn != nil {
+		w.conn.Close()
+	}
+	w.conn = conn
+	w.connMtx.Unlock()
+	err = w.send(controller.MsgSubscribe, nil)
+	return
+}
+// reader receives work from the controller until the connection is closed, reporting each valid message on the alive channel. It must be run as a goroutine.
+func (w *Worker) reader(conn *net.UDPConn, alive chan<- struct{}) {
+	defer w.wg.Done()
+	buf := make([]byte, controller.MaxDatagramSize)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			select {
+			case <-w.quit:
+				return
+			default:
+			}
+			log <- cl.Debug{"stopped reading from controller:", err}
+			return
+		}
+		msgType, payload, err := controller.Decode(w.cfg.Key, buf[:n])
+		if err != nil {
+			log <- cl.Debug{"rejected message from controller:", err}
+			continue
+		}
+		if msgType != controller.MsgWork {
+			continue
+		}
+		work := new(controller.Work)
+		if err = work.UnmarshalBinary(payload); err != nil {
+			log <- cl.Debug{"malformed work from controller:", err}
+			continue
+		}
+		w.setWork(work)
+		select {
+		case alive <- struct{}{}:
+		default:
+		}
+	}
+}
+// connectionHandler keeps the worker subscribed to the controller, and opens a new connection when nothing has been heard from it within the reconnect timeout. It must be run as a goroutine.
+func (w *Worker) connectionHandler() {
+	defer w.wg.Done()
+	alive := make(chan struct{}, 1)
+	subscribe := time.NewTicker(subscribeInterval)
+	defer subscribe.Stop()
+	var lastHeard time.Time
+	var conn *net.UDPConn
+	reconnect := func() {
+		var err error
+		if conn, err = w.connect(); err != nil {
+			log <- cl.Warn{"unable to connect to controller", w.cfg.Controller, err}
+			return
+		}
+		log <- cl.Info{"subscribed to controller at", w.cfg.Controller}
+		w.wg.Add(1)
+		go w.reader(conn, alive)
+	}
+	lastHeard = time.Now()
+	reconnect()
+	check := time.NewTicker(time.Second)
+	defer check.Stop()
+out:
+	for {
+		select {
+		case <-w.quit:
+			break out
+		case <-alive:
+			lastHeard = time.Now()
+		case <-subscribe.C:
+			if err := w.send(controller.MsgSubscribe, nil); err != nil {
+				log <- cl.Debug{"error renewing subscription:", err}
+			}
+		case <-check.C:
+			if time.Since(lastHeard) > reconnectTimeout {
+				log <- cl.Warn{"no work from controller for", reconnectTimeout,
+					"reconnecting"}
+				lastHeard = time.Now()
+				reconnect()
+			}
+		}
+	}
+	_ = w.send(controller.MsgUnsubscribe, nil)
+	w.connMtx.Lock()
+	if w.conn != nil {
+		w.conn.Close()
+		w.conn = nil
+	}
+	w.connMtx.Unlock()
+}
+// pickAlgo chooses the algorithm for the next round, randomly if so configured, and returns the index of its target in the work, or false if the work does not have it
+func (w *Worker) pickAlgo(work *controller.Work) (int, bool) {
+	version := fork.GetAlgoVer(w.cfg.ChainParams, w.cfg.Algo, work.Height)
+	for i := range work.Algos {
+		if work.Algos[i].Version == version {
+			return i, true
+		}
+	}
+	return 0, false
+}
+// addHashes counts hashes done with an algorithm for the speed monitor
+func (w *Worker) addHashes(algo string, n uint64) {
+	w.hashesMtx.Lock()
+	w.hashes[algo] += n
+	w.hashesMtx.Unlock()
+}
+// solve hashes one round of the given work with one algorithm, starting at a random nonce, until a solution is found, the round times out or new work arrives
+func (w *Worker) solve(work *controller.Work, gen uint64, algoIndex int) {
+	header := work.Header(algoIndex)
+	algoName := fork.GetAlgoName(w.cfg.ChainParams, header.Version, work.Height)
+	var buf bytes.Buffer
+	if err := header.Serialize(&buf); err != nil {
+		log <- cl.Error{"failed to serialize header:", err}
+		return
+	}
+	data := buf.Bytes()
+	target := fork.CompactToBig(header.Bits)
+	nonce := rand.Uint32()
+	deadline := time.Now().Add(w.cfg.Switch)
+	var hashes uint64
+	defer func() {
+		w.addHashes(algoName, hashes)
+	}()
+	for {
+		for i := 0; i < checkInterval; i++ {
+			nonce++
+			binary.LittleEndian.PutUint32(data[nonceOffset:], nonce)
+			hash := fork.Hash(w.cfg.ChainParams, data, algoName, work.Height)
+			hashes++
+			if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+				log <- cl.Info{"found solution for job", work.JobID,
+					"height", work.Height, algoName, hash}
+				s := controller.Solution{
+					JobID:      work.JobID,
+					ExtraNonce: work.ExtraNonce,
+					Version:    header.Version,
+					Nonce:      nonce,
+				}
+				payload, _ := s.MarshalBinary()
+				if err := w.send(controller.MsgSolution, payload); err != nil {
+					log <- cl.Error{"failed to send solution:", err}
+				}
+				return
+			}
+		}
+		select {
+		case <-w.quit:
+			return
+		default:
+		}
+		if w.workGeneration() != gen || time.Now().After(deadline) {
+			return
+		}
+	}
+}
+// hasher repeatedly picks an algorithm and mines the current work with it. It must be run as a goroutine.
+func (w *Worker) hasher() {
+	defer w.wg.Done()
+	for {
+		select {
+		case <-w.quit:
+			return
+		default:
+		}
+		work, gen := w.getWork()
+		if work == nil {
+			time.Sleep(time.Second / 4)
+			continue
+		}
+		i, ok := w.pickAlgo(work)
+		if !ok {
+			log <- cl.Debug{"work does not have a target for algorithm",
+				w.cfg.Algo}
+			time.Sleep(time.Second)
+			continue
+		}
+		w.solve(work, gen, i)
+	}
+}
+// speedMonitor periodically computes and logs the hashes per second of each algorithm. It must be run as a goroutine.
+func (w *Worker) speedMonitor() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(time.Second * hpsUpdateSecs)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+		}
+		w.hashesMtx.Lock()
+		secs := time.Since(w.lastUpdate).Seconds()
+		w.lastUpdate = time.Now()
+		var algos []string
+		for algo, n := range w.hashes {
+			cur := float64(n) / secs
+			if prev, ok := w.hashRates[algo]; ok {
+				cur = (prev + cur) / 2
+			}
+			w.hashRates[algo] = cur
+			w.hashes[algo] = 0
+			algos = append(algos, algo)
+		}
+		sort.Strings(algos)
+		for _, algo := range algos {
+			log <- cl.Infof{"hash speed %-10s %10.2f hash/s", algo,
+				w.hashRates[algo]}
+		}
+		w.hashesMtx.Unlock()
+	}
+}
+// HashesPerSecond returns the recent hash rate of each algorithm that has been mined. This function is safe for concurrent access.
+func (w *Worker) HashesPerSecond() map[string]float64 {
+	w.hashesMtx.Lock()
+	defer w.hashesMtx.Unlock()
+	out := make(map[string]float64, len(w.hashRates))
+	for algo, r := range w.hashRates {
+		out[algo] = r
+	}
+	return out
+}
+// Start connects to the controller and begins mining. Calling this function when the worker has already been started will have no effect.
+func (w *Worker) Start() (err error) {
+	w.Lock()
+	defer w.Unlock()
+	if w.started {
+		return
+	}
+	if len(w.cfg.Key) == 0 {
+		return errors.New("worker requires a miner key")
+	}
+	if w.cfg.Controller == "" {
+		return errors.New("worker requires a controller address")
+	}
+	threads := w.cfg.NumThreads
+	if threads < 1 {
+		threads = runtime.NumCPU()
+	}
+	w.quit = make(chan struct{})
+	w.lastUpdate = time.Now()
+	w.wg.Add(2 + threads)
+	go w.connectionHandler()
+	go w.speedMonitor()
+	for i := 0; i < threads; i++ {
+		go w.hasher()
+	}
+	w.started = true
+	log <- cl.Info{"worker started mining", w.cfg.Algo, "with", threads, "threads"}
+	return
+}
+// Stop gracefully stops mining, unsubscribes from the controller and waits for all goroutines to finish. Calling this function when the worker has not been started will have no effect.
+func (w *Worker) Stop() {
+	w.Lock()
+	defer w.Unlock()
+	if !w.started {
+		return
+	}
+	close(w.quit)
+	w.wg.Wait()
+	w.started = false
+	log <- cl.Inf("worker stopped")
+}
+// New returns a new worker for the provided configuration. Use Start to begin mining.
+func New(
+	cfg *Config) *Worker {
+	c := *cfg
+	if c.Algo == "" {
+		c.Algo = "random"
+	}
+	if c.Switch <= 0 {
+		c.Switch = time.Second * 2
+	}
+	return &Worker{
+		cfg:       c,
+		hashes:    make(map[string]uint64),
+		hashRates: make(map[string]float64),
+	}
+}
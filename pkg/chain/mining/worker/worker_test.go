@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	controller "git.parallelcoin.io/dev/9/pkg/chain/mining/dispatch"
+)
+
+var testKey = []byte("correct horse battery staple")
+
+// TestWorkerSolves runs a worker against a fake controller that hands out work with a trivial target and checks that a valid solution comes back
+func TestWorkerSolves(
+	t *testing.T) {
+
+	l, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+
+		t.Fatal(err)
+	}
+	defer l.Close()
+	work := &controller.Work{
+		JobID:      3,
+		ExtraNonce: 3<<32 | 1,
+		Height:     1,
+		Timestamp:  time.Unix(time.Now().Unix(), 0),
+		Algos: []controller.AlgoTarget{
+			{Version: fork.GetAlgoVer(&chaincfg.MainNetParams, "sha256d", 1), Bits: 0x207fffff},
+		},
+	}
+	payload, _ := work.MarshalBinary()
+	w := New(&Config{
+		Controller:  l.LocalAddr().String(),
+		Key:         testKey,
+		Algo:        "sha256d",
+		NumThreads:  2,
+		ChainParams: &chaincfg.MainNetParams,
+	})
+	if err = w.Start(); err != nil {
+
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	buf := make([]byte, controller.MaxDatagramSize)
+	l.SetReadDeadline(time.Now().Add(time.Second * 10))
+	for {
+		n, addr, err := l.ReadFromUDP(buf)
+		if err != nil {
+
+			t.Fatal("no solution received:", err)
+		}
+		msgType, p, err := controller.Decode(testKey, buf[:n])
+		if err != nil {
+
+			t.Fatal(err)
+		}
+		switch msgType {
+		case controller.MsgSubscribe:
+			l.WriteToUDP(controller.Encode(testKey, controller.MsgWork, payload), addr)
+		case controller.MsgSolution:
+			var s controller.Solution
+			if err = s.UnmarshalBinary(p); err != nil {
+
+				t.Fatal(err)
+			}
+			if s.JobID != work.JobID || s.ExtraNonce != work.ExtraNonce || s.Version != work.Algos[0].Version {
+
+				t.Fatalf("solution %+v does not match work", s)
+			}
+			header := work.Header(0)
+			header.Nonce = s.Nonce
+			var hb bytes.Buffer
+			header.Serialize(&hb)
+			hash := fork.Hash(&chaincfg.MainNetParams, hb.Bytes(), "sha256d", work.Height)
+			if blockchain.HashToBig(&hash).Cmp(fork.CompactToBig(header.Bits)) > 0 {
+
+				t.Fatal("solution does not meet the target")
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package chain
+import (
+	"runtime"
+	"sync"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+)
+// newPowWorkers returns the semaphore that limits how many proof of work hashes are computed at once ahead of block processing, all cores if workers is not positive
+func newPowWorkers(
+	workers int) chan struct{} {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return make(chan struct{}, workers)
+}
+// PowWorkers returns the number of proof of work hashes that are computed at once ahead of block processing. This function is safe for concurrent access.
+func (b *BlockChain) PowWorkers() int {
+	return cap(b.powWorkers)
+}
+// PrecomputePowHash starts computing the proof of work hash of a header at a height in the background if a worker is free, so that it is already known when the block is processed at that height. Nothing is done if all workers are busy, as the block is then checked as usual. This function is safe for concurrent access.
+func (b *BlockChain) PrecomputePowHash(
+	header *wire.BlockHeader, height int32) {
+	select {
+	case b.powWorkers <- struct{}{}:
+	default:
+		return
+	}
+	go func() {
+		b.powHashCache.Hash(header, height, b.chainParams)
+		<-b.powWorkers
+	}()
+}
+// CheckHeadersPow checks that the proof of work hashes of headers at consecutive heights starting at height are below the targets of their bits, spreading the headers over the workers. The hashes are kept so checking the blocks of the headers later only takes a lookup. The error of the lowest header that fails is returned. This function is safe for concurrent access.
+func (b *BlockChain) CheckHeadersPow(
+	headers []*wire.BlockHeader, height int32) error {
+	errs := make([]error, len(headers))
+	var wg sync.WaitGroup
+	for i := range headers {
+		b.powWorkers <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// The limit of each algorithm is checked against the chain when the block is processed, here only the claimed target is.
+			errs[i] = checkProofOfWork(headers[i], &chaincfg.AllOnes, BFNone,
+				height+int32(i), b.chainParams, b.powHashCache)
+			<-b.powWorkers
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
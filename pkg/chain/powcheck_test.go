@@ -0,0 +1,50 @@
+package chain
+import (
+	"testing"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+)
+// TestCheckHeadersPow ensures the proof of work of a batch of headers is checked in parallel, the hashes are kept for processing the blocks, and a header whose hash is above its target is found.
+func TestCheckHeadersPow(
+	t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	chain, teardown, err := chainSetup("checkheaderspow", &params)
+	if err != nil {
+		t.Fatalf("failed to setup chain instance: %v", err)
+	}
+	defer teardown()
+	if chain.PowWorkers() < 1 {
+		t.Fatalf("chain has %d proof of work workers", chain.PowWorkers())
+	}
+	// Find nonces that solve the easy regression test target.
+	headers := make([]*wire.BlockHeader, 20)
+	for i := range headers {
+		header := params.GenesisBlock.Header
+		header.Nonce = uint32(i) << 16
+		for {
+			if checkProofOfWork(&header, &chaincfg.AllOnes, BFNone,
+				int32(i+1), &params, nil) == nil {
+				break
+			}
+			header.Nonce++
+		}
+		headers[i] = &header
+	}
+	if err = chain.CheckHeadersPow(headers, 1); err != nil {
+		t.Fatalf("valid headers were rejected: %v", err)
+	}
+	for i, header := range headers {
+		key := powHashKey{hash: header.BlockHash(), height: int32(i + 1)}
+		if _, ok := chain.powHashCache.lookup(key); !ok {
+			t.Errorf("hash of header %d was not kept", i)
+		}
+	}
+	// A header claiming a much harder target than its hash meets is rejected.
+	bad := *headers[7]
+	bad.Bits = 0x1d00ffff
+	headers[7] = &bad
+	err = chain.CheckHeadersPow(headers, 1)
+	if rerr, ok := err.(RuleError); !ok || rerr.ErrorCode != ErrHighHash {
+		t.Fatalf("got error %v, expected %v", err, ErrHighHash)
+	}
+}
@@ -0,0 +1,143 @@
+package chain
+import (
+	"container/list"
+	"sync"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	database "git.parallelcoin.io/dev/9/pkg/db"
+)
+// DefaultPowHashCacheSize is the number of proof of work hashes kept in memory when no size is configured
+const DefaultPowHashCacheSize = 10000
+// powHashBucketName is the name of the db bucket used to house the proof of work hashes of the blocks in the block index, keyed by block hash.
+var powHashBucketName = []byte("powhashidx")
+// powHashKey identifies the proof of work hash of a header at a height, as the height decides which algorithms the rules of the hard fork in force chain together
+type powHashKey struct {
+	hash   chainhash.Hash
+	height int32
+}
+// powHashEntry is an item of the least recently used list
+type powHashEntry struct {
+	key     powHashKey
+	powHash chainhash.Hash
+}
+// PowHashCache keeps the proof of work hashes of block headers, which after the Plan 9 hard fork take tens of milliseconds each to compute, in a least recently used list in memory, backed by a bucket of the chain database for the blocks that have been stored. A nil PowHashCache computes every hash. It is safe for concurrent access.
+type PowHashCache struct {
+	mtx     sync.Mutex
+	entries map[powHashKey]*list.Element
+	order   *list.List
+	limit   int
+	db      database.DB
+}
+// NewPowHashCache returns a cache that keeps up to limit hashes in memory and looks up hashes it does not have in db, which can be nil
+func NewPowHashCache(
+	limit int, db database.DB) *PowHashCache {
+	return &PowHashCache{
+		entries: make(map[powHashKey]*list.Element),
+		order:   list.New(),
+		limit:   limit,
+		db:      db,
+	}
+}
+// Hash returns the proof of work hash of a header at a height on the network of params, from memory, the database, or by computing it
+func (c *PowHashCache) Hash(
+	header *wire.BlockHeader, height int32, params fork.Params) chainhash.Hash {
+	if c == nil {
+		return header.BlockHashWithAlgos(params, height)
+	}
+	key := powHashKey{hash: header.BlockHash(), height: height}
+	if powHash, ok := c.lookup(key); ok {
+		return powHash
+	}
+	if c.db != nil {
+		var powHash *chainhash.Hash
+		err := c.db.View(func(dbTx database.Tx) error {
+			powHash = dbFetchPowHash(dbTx, &key.hash, height)
+			return nil
+		})
+		if err == nil && powHash != nil {
+			c.add(key, *powHash)
+			return *powHash
+		}
+	}
+	powHash := header.BlockHashWithAlgos(params, height)
+	c.add(key, powHash)
+	return powHash
+}
+// PowHash returns the proof of work hash of a block header at a height, which is only computed if it is not already known. This function is safe for concurrent access.
+func (b *BlockChain) PowHash(
+	header *wire.BlockHeader, height int32) chainhash.Hash {
+	return b.powHashCache.Hash(header, height, b.chainParams)
+}
+// lookup returns the hash in memory for a key and marks it most recently used
+func (c *PowHashCache) lookup(
+	key powHashKey) (powHash chainhash.Hash, ok bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*powHashEntry).powHash, true
+}
+// add puts a hash in memory, evicting the least recently used one if the limit is reached
+func (c *PowHashCache) add(
+	key powHashKey, powHash chainhash.Hash) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.limit <= 0 {
+		return
+	}
+	if element, exists := c.entries[key]; exists {
+		c.order.MoveToFront(element)
+		return
+	}
+	// Reuse the list node of the evicted entry so a new one doesn't have to be allocated.
+	if len(c.entries) >= c.limit {
+		element := c.order.Back()
+		entry := element.Value.(*powHashEntry)
+		delete(c.entries, entry.key)
+		entry.key, entry.powHash = key, powHash
+		c.order.MoveToFront(element)
+		c.entries[key] = element
+		return
+	}
+	c.entries[key] = c.order.PushFront(&powHashEntry{key: key, powHash: powHash})
+}
+// store uses an existing database transaction to persist the hash in memory of a block that is being stored. Nothing is written if the hash is not in memory, as it is then computed again when needed.
+func (c *PowHashCache) store(
+	dbTx database.Tx, hash *chainhash.Hash, height int32) error {
+	if c == nil {
+		return nil
+	}
+	powHash, ok := c.lookup(powHashKey{hash: *hash, height: height})
+	if !ok {
+		return nil
+	}
+	return dbPutPowHash(dbTx, hash, height, &powHash)
+}
+// dbPutPowHash uses an existing database transaction to store the proof of work hash of a block at a height.
+func dbPutPowHash(
+	dbTx database.Tx, hash *chainhash.Hash, height int32, powHash *chainhash.Hash) error {
+	var serialized [4 + chainhash.HashSize]byte
+	byteOrder.PutUint32(serialized[:4], uint32(height))
+	copy(serialized[4:], powHash[:])
+	return dbTx.Metadata().Bucket(powHashBucketName).Put(hash[:], serialized[:])
+}
+// dbFetchPowHash uses an existing database transaction to fetch the proof of work hash of a block.  It returns nil if none is stored or it was stored for another height.
+func dbFetchPowHash(
+	dbTx database.Tx, hash *chainhash.Hash, height int32) *chainhash.Hash {
+	bucket := dbTx.Metadata().Bucket(powHashBucketName)
+	if bucket == nil {
+		return nil
+	}
+	serialized := bucket.Get(hash[:])
+	if len(serialized) != 4+chainhash.HashSize ||
+		int32(byteOrder.Uint32(serialized[:4])) != height {
+		return nil
+	}
+	var powHash chainhash.Hash
+	copy(powHash[:], serialized[4:])
+	return &powHash
+}
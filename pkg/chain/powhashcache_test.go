@@ -0,0 +1,65 @@
+package chain
+import (
+	"testing"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	database "git.parallelcoin.io/dev/9/pkg/db"
+)
+// TestPowHashCache ensures the proof of work hash cache returns the same hashes as computing them, evicts the least recently used hashes and persists the hashes of stored blocks.
+func TestPowHashCache(
+	t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	params.HardForks = []fork.HardForks{fork.Halcyon(0), fork.Plan9(0)}
+	chain, teardown, err := chainSetup("powhashcache", &params)
+	if err != nil {
+		t.Fatalf("failed to setup chain instance: %v", err)
+	}
+	defer teardown()
+	headers := make([]wire.BlockHeader, 3)
+	for i := range headers {
+		headers[i] = params.GenesisBlock.Header
+		headers[i].Version = fork.GetAlgoVer(&params, "sha256d", 1)
+		headers[i].Nonce = uint32(i)
+	}
+	cache := NewPowHashCache(2, chain.db)
+	for i := range headers {
+		want := headers[i].BlockHashWithAlgos(&params, 1)
+		if got := cache.Hash(&headers[i], 1, &params); got != want {
+			t.Fatalf("header %d: got hash %v, expected %v", i, got, want)
+		}
+	}
+	// Only the two most recently used hashes are kept in memory.
+	for i, want := range []bool{false, true, true} {
+		key := powHashKey{hash: headers[i].BlockHash(), height: 1}
+		if _, ok := cache.lookup(key); ok != want {
+			t.Errorf("header %d: in memory is %v, expected %v", i, ok, want)
+		}
+	}
+	// A stored hash is found by another cache, but not for another height.
+	hash := headers[2].BlockHash()
+	err = chain.db.Update(func(dbTx database.Tx) error {
+		return cache.store(dbTx, &hash, 1)
+	})
+	if err != nil {
+		t.Fatalf("failed to store hash: %v", err)
+	}
+	err = chain.db.View(func(dbTx database.Tx) error {
+		if dbFetchPowHash(dbTx, &hash, 1) == nil {
+			t.Error("stored hash was not found")
+		}
+		if dbFetchPowHash(dbTx, &hash, 2) != nil {
+			t.Error("stored hash was found for another height")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A nil cache computes every hash.
+	var none *PowHashCache
+	if got, want := none.Hash(&headers[0], 1, &params),
+		headers[0].BlockHashWithAlgos(&params, 1); got != want {
+		t.Fatalf("nil cache returned %v, expected %v", got, want)
+	}
+}
@@ -40,9 +40,10 @@ func (
 	defer b.chainLock.Unlock()
 	fastAdd := flags&BFFastAdd == BFFastAdd
 	blockHash := block.Hash()
-	hf := fork.GetCurrent(height)
+	hf := fork.GetCurrent(b.chainParams, height)
 	blockHashWithAlgo := func() string {
-		return block.MsgBlock().BlockHashWithAlgos(height).String()
+		hash := b.powHashCache.Hash(&block.MsgBlock().Header, height, b.chainParams)
+		return hash.String()
 	}
 	log <- cl.Tracec(func() string {
 		return "processing block" + blockHashWithAlgo()
@@ -75,19 +76,19 @@ func (
 	}
 	// Perform preliminary sanity checks on the block and its transactions.
 	var DoNotCheckPow bool
-	pl := fork.GetMinDiff(fork.GetAlgoName(algo, height), height)
+	pl := fork.GetMinDiff(b.chainParams, fork.GetAlgoName(b.chainParams, algo, height), height)
 	ph := &block.MsgBlock().Header.PrevBlock
 	pn := b.Index.LookupNode(ph)
 	if pn == nil {
 		log <- cl.Debug{"found no previous node"}
 		DoNotCheckPow = true
 	}
-	pb := pn.GetLastWithAlgo(algo)
+	pb := pn.GetLastWithAlgo(algo, b.chainParams)
 	if pb == nil {
 		pl = &chaincfg.AllOnes
 		DoNotCheckPow = true
 	}
-	err = checkBlockSanity(block, pl, b.timeSource, flags, DoNotCheckPow, height)
+	err = checkBlockSanity(block, pl, b.timeSource, flags, DoNotCheckPow, height, b.chainParams, b.powHashCache)
 	if err != nil {
 		log <- cl.Debug{"block processing error:", err}
 		return false, false, err
@@ -150,7 +151,7 @@ func (
 		"accepted block %d %v %s ",
 		blockHeight,
 		blockHashWithAlgo(),
-		fork.GetAlgoName(block.MsgBlock().Header.Version, blockHeight),
+		fork.GetAlgoName(b.chainParams, block.MsgBlock().Header.Version, blockHeight),
 	}
 	return isMainChain, false, nil
 }
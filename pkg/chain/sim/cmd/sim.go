@@ -0,0 +1,58 @@
+package main
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"git.parallelcoin.io/dev/9/pkg/chain/sim"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+func main() {
+	scenario := flag.String("scenario", "steady", "name of the scenario to run")
+	blocks := flag.Int("blocks", 3000, "number of blocks to mine")
+	activation := flag.Int("activation", 100, "height after which the Plan 9 hard fork rules apply")
+	seed := flag.Int64("seed", 1, "random seed, the same seed repeats a run")
+	output := flag.String("out", "", "file to write CSV to, standard output if not given")
+	logLevel := flag.String("loglevel", "off", "level of the chain logs")
+	list := flag.Bool("list", false, "list the scenarios")
+	flag.Parse()
+	if *list {
+		var names []string
+		for name := range sim.Scenarios {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("%-10s %s\n", name, sim.Scenarios[name].Description)
+		}
+		return
+	}
+	s, ok := sim.Scenarios[*scenario]
+	if !ok {
+		fmt.Fprintln(os.Stderr, "unknown scenario", *scenario)
+		os.Exit(1)
+	}
+	cl.Register.SetAllLevels(*logLevel)
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
+	}
+	err := sim.Run(sim.Config{
+		Blocks:     int32(*blocks),
+		Activation: int32(*activation),
+		Seed:       *seed,
+		Hashrate:   s.Hashrate(int32(*activation)),
+		Timestamp:  s.Timestamp,
+	}, out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
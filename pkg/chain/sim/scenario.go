@@ -0,0 +1,70 @@
+package sim
+import (
+	"math/rand"
+	"time"
+)
+// Scenario is a named hashrate schedule and timestamp strategy
+type Scenario struct {
+	Description string
+	// Hashrate returns the schedule for a chain where Plan 9 activates after the given height
+	Hashrate  func(activation int32) Hashrate
+	Timestamp Timestamp
+}
+// Scenarios are the built in simulations
+var Scenarios = map[string]Scenario{
+	"steady": {
+		Description: "every algorithm has the same hashrate throughout",
+		Hashrate:    steady,
+		Timestamp:   Honest,
+	},
+	"asic": {
+		Description: "sha256d hashrate rises fifty times for 1000 blocks from 500 blocks after activation",
+		Hashrate: func(activation int32) Hashrate {
+			base := steady(activation)
+			return func(height int32, algo string) float64 {
+				if algo == "sha256d" && height > activation+500 && height <= activation+1500 {
+					return 50 * base(height, algo)
+				}
+				return base(height, algo)
+			}
+		},
+		Timestamp: Honest,
+	},
+	"dropout": {
+		Description: "the miners of four algorithms leave 500 blocks after activation",
+		Hashrate: func(activation int32) Hashrate {
+			base := steady(activation)
+			return func(height int32, algo string) float64 {
+				if height > activation+500 {
+					switch algo {
+					case "blake2b", "keccak", "skein", "x11":
+						return 0
+					}
+				}
+				return base(height, algo)
+			}
+		},
+		Timestamp: Honest,
+	},
+	"timewarp": {
+		Description: "steady hashrate, scrypt miners stamp blocks as early as allowed and x11 miners as late as allowed",
+		Hashrate:    steady,
+		Timestamp: func() Timestamp {
+			early, late := Earliest("scrypt"), Latest("x11")
+			return func(rnd *rand.Rand, height int32, algo string, solved, median time.Time) time.Time {
+				if algo == "scrypt" {
+					return early(rnd, height, algo, solved, median)
+				}
+				return late(rnd, height, algo, solved, median)
+			}
+		}(),
+	},
+}
+// steady gives every algorithm the base hashrate of the network where Plan 9 activates after the given height
+func steady(
+	activation int32) Hashrate {
+	params := Params(activation)
+	return func(height int32, algo string) float64 {
+		return Base(params, height)
+	}
+}
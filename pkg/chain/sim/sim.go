@@ -0,0 +1,290 @@
+// Package sim drives the difficulty adjustment of a throwaway regression test chain with synthetic hashrate schedules and miner timestamp strategies, and writes the bits, block intervals and averager divergences of every block as CSV.
+//
+// Every block is built and processed by the real BlockChain with all rules applied except proof of work, so the bits in the output are exactly what a node would require. Which algorithm finds each block is decided by racing the expected time of every algorithm at its current difficulty and hashrate.
+package sim
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+	blockchain "git.parallelcoin.io/dev/9/pkg/chain"
+	chaincfg "git.parallelcoin.io/dev/9/pkg/chain/config"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
+	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
+	txscript "git.parallelcoin.io/dev/9/pkg/chain/tx/script"
+	"git.parallelcoin.io/dev/9/pkg/chain/wire"
+	database "git.parallelcoin.io/dev/9/pkg/db"
+	_ "git.parallelcoin.io/dev/9/pkg/db/ffldb"
+	"git.parallelcoin.io/dev/9/pkg/util"
+)
+// Hashrate returns the hashes per second mining an algorithm at a height. Algorithms with no hashrate don't find blocks.
+type Hashrate func(height int32, algo string) float64
+// Timestamp returns the time a miner puts in the header of a block it solved at solved. The result is moved to just after median if it is not later, as a block is otherwise invalid, and to no more than two hours after solved, as nodes would not accept it any earlier.
+type Timestamp func(rnd *rand.Rand, height int32, algo string, solved, median time.Time) time.Time
+// Config is the configuration for a simulation
+type Config struct {
+	// Blocks is how many blocks are mined after the genesis block
+	Blocks int32
+	// Activation is the height after which the Plan 9 hard fork rules apply
+	Activation int32
+	// Seed makes a run repeatable
+	Seed     int64
+	Hashrate Hashrate
+	// Timestamp is the strategy of the miners, Honest if not given
+	Timestamp Timestamp
+	// DataDir is where the throwaway chain database is made, the system temporary directory if not given
+	DataDir string
+}
+// maxFutureTime is how far after the time a block was solved its timestamp can be set
+const maxFutureTime = 2 * time.Hour
+// Honest stamps blocks with the time they were solved
+func Honest(
+	rnd *rand.Rand, height int32, algo string, solved, median time.Time) time.Time {
+	return solved
+}
+// Earliest returns a strategy where the miners of the given algorithms stamp their blocks with the earliest time allowed, and the rest are honest
+func Earliest(
+	algos ...string) Timestamp {
+	return func(rnd *rand.Rand, height int32, algo string, solved, median time.Time) time.Time {
+		for _, x := range algos {
+			if x == algo {
+				return median.Add(time.Second)
+			}
+		}
+		return solved
+	}
+}
+// Latest returns a strategy where the miners of the given algorithms stamp their blocks with the latest time allowed, and the rest are honest
+func Latest(
+	algos ...string) Timestamp {
+	return func(rnd *rand.Rand, height int32, algo string, solved, median time.Time) time.Time {
+		for _, x := range algos {
+			if x == algo {
+				return solved.Add(maxFutureTime)
+			}
+		}
+		return solved
+	}
+}
+// Work returns the expected number of hashes to find a block with the given bits, which is zero if the target is not valid
+func Work(
+	bits uint32) float64 {
+	target := blockchain.CompactToBig(bits)
+	if target.Sign() <= 0 {
+		return 0
+	}
+	work := new(big.Int).Lsh(big.NewInt(1), 256)
+	work.Quo(work, target.Add(target, big.NewInt(1)))
+	f, _ := new(big.Float).SetInt(work).Float64()
+	return f
+}
+// Params returns the parameters of a regression test network where Plan 9 activates after the given height
+func Params(
+	activation int32) *chaincfg.Params {
+	params := chaincfg.RegressionNetParams
+	params.HardForks = []fork.HardForks{
+		fork.Halcyon(0),
+		fork.Plan9(activation),
+	}
+	return &params
+}
+// Base returns the hashrate of one algorithm that finds blocks at the minimum difficulty in the target time of the algorithm, for the rules in force at a height, so schedules can be given as multiples of it
+func Base(
+	params fork.Params, height int32) float64 {
+	hf := fork.Get(params, height)
+	numAlgos := int64(len(hf.Algos))
+	var work float64
+	for algo := range hf.Algos {
+		// all algorithms of a fork share the minimum difficulty
+		work = Work(hf.Algos[algo].MinBits)
+		break
+	}
+	return work / float64(int64(hf.TargetTimePerBlock/time.Second)*numAlgos)
+}
+// Run mines the configured number of blocks and writes a line of CSV for each to out
+func Run(
+	cfg Config, out io.Writer) (err error) {
+	if cfg.Hashrate == nil {
+		return errors.New("no hashrate schedule was given")
+	}
+	if cfg.Timestamp == nil {
+		cfg.Timestamp = Honest
+	}
+	dir, err := ioutil.TempDir(cfg.DataDir, "sim")
+	if err != nil {
+		return
+	}
+	defer os.RemoveAll(dir)
+	params := Params(cfg.Activation)
+	db, err := database.Create("ffldb", dir, params.Net)
+	if err != nil {
+		return
+	}
+	defer db.Close()
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:          db,
+		ChainParams: params,
+		TimeSource:  blockchain.NewMedianTime(),
+	})
+	if err != nil {
+		return
+	}
+	s := &simulation{
+		cfg:    cfg,
+		params: params,
+		chain:  chain,
+		rnd:    rand.New(rand.NewSource(cfg.Seed)),
+		solved: params.GenesisBlock.Header.Timestamp,
+		out:    csv.NewWriter(out),
+	}
+	for algo := range params.HardForks[1].Algos {
+		s.columns = append(s.columns, algo)
+	}
+	sort.Strings(s.columns)
+	header := []string{"height", "algo", "timestamp", "interval", "solvetime", "bits",
+		"algo_divergence", "trailing_divergence", "trail_divergence", "alltime_divergence", "adjustment"}
+	for _, algo := range s.columns {
+		header = append(header, "bits_"+algo)
+	}
+	if err = s.out.Write(header); err != nil {
+		return
+	}
+	for i := int32(0); i < cfg.Blocks; i++ {
+		if err = s.mine(); err != nil {
+			break
+		}
+	}
+	s.out.Flush()
+	if err == nil {
+		err = s.out.Error()
+	}
+	return
+}
+// simulation is the state of a run
+type simulation struct {
+	cfg     Config
+	params  *chaincfg.Params
+	chain   *blockchain.BlockChain
+	rnd     *rand.Rand
+	solved  time.Time
+	out     *csv.Writer
+	columns []string
+}
+// mine races the algorithms for the next block, adds the winner to the chain and writes its line
+func (s *simulation) mine() (err error) {
+	best := s.chain.BestSnapshot()
+	height := best.Height + 1
+	hf := fork.Get(s.params, height)
+	var algos []string
+	for algo := range hf.Algos {
+		algos = append(algos, algo)
+	}
+	sort.Strings(algos)
+	bits := make(map[string]uint32)
+	winner, solveTime := "", math.Inf(1)
+	for _, algo := range algos {
+		if bits[algo], err = s.chain.CalcNextRequiredDifficulty(s.solved, algo); err != nil {
+			return
+		}
+		// every algorithm takes a sample so a run only depends on the seed
+		sample := s.rnd.ExpFloat64()
+		rate, work := s.cfg.Hashrate(height, algo), Work(bits[algo])
+		if rate <= 0 || work == 0 {
+			continue
+		}
+		if t := sample * work / rate; t < solveTime {
+			winner, solveTime = algo, t
+		}
+	}
+	if winner == "" {
+		return fmt.Errorf("no algorithm can find block %d", height)
+	}
+	divergence, ok := s.chain.CalcPlan9Divergence(winner)
+	s.solved = s.solved.Add(time.Duration(solveTime * float64(time.Second)))
+	median := best.MedianTime
+	// header timestamps only have whole seconds
+	timestamp := s.cfg.Timestamp(s.rnd, height, winner, s.solved, median).Truncate(time.Second)
+	if !timestamp.After(median) {
+		timestamp = median.Add(time.Second)
+	}
+	if latest := s.solved.Add(maxFutureTime).Truncate(time.Second); timestamp.After(latest) {
+		timestamp = latest
+	}
+	block, err := s.newBlock(height, best.Hash, hf.Algos[winner].Version,
+		timestamp, bits[winner])
+	if err != nil {
+		return
+	}
+	_, isOrphan, err := s.chain.ProcessBlock(block, blockchain.BFNoPoWCheck, height)
+	if err != nil {
+		return fmt.Errorf("block %d was rejected: %v", height, err)
+	}
+	if isOrphan {
+		return fmt.Errorf("block %d is an orphan", height)
+	}
+	parent, err := s.chain.HeaderByHash(&best.Hash)
+	if err != nil {
+		return
+	}
+	line := []string{
+		strconv.Itoa(int(height)),
+		winner,
+		strconv.FormatInt(timestamp.Unix(), 10),
+		strconv.FormatInt(timestamp.Unix()-parent.Timestamp.Unix(), 10),
+		strconv.FormatFloat(solveTime, 'f', 3, 64),
+		fmt.Sprintf("%08x", bits[winner]),
+	}
+	terms := make([]string, 5)
+	if ok {
+		for i, x := range []float64{divergence.Algo, divergence.Trailing,
+			divergence.Trail, divergence.AllTime, divergence.Adjustment} {
+			terms[i] = strconv.FormatFloat(x, 'g', 8, 64)
+		}
+	}
+	line = append(line, terms...)
+	for _, algo := range s.columns {
+		if b, ok := bits[algo]; ok {
+			line = append(line, fmt.Sprintf("%08x", b))
+		} else {
+			line = append(line, "")
+		}
+	}
+	return s.out.Write(line)
+}
+// newBlock makes a block with only a coinbase transaction
+func (s *simulation) newBlock(
+	height int32, prev chainhash.Hash, version int32, timestamp time.Time, bits uint32) (block *util.Block, err error) {
+	script, err := txscript.NewScriptBuilder().AddInt64(int64(height)).
+		AddInt64(s.rnd.Int63()).Script()
+	if err != nil {
+		return
+	}
+	coinbase := wire.NewMsgTx(wire.TxVersion)
+	coinbase.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, wire.MaxPrevOutIndex), script, nil))
+	coinbase.AddTxOut(wire.NewTxOut(blockchain.CalcBlockSubsidy(height, s.params),
+		[]byte{txscript.OpTrue}))
+	txs := []*util.Tx{util.NewTx(coinbase)}
+	merkles := blockchain.BuildMerkleTreeStore(txs, false)
+	msgBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:    version,
+			PrevBlock:  prev,
+			MerkleRoot: *merkles[len(merkles)-1],
+			Timestamp:  timestamp,
+			Bits:       bits,
+			Nonce:      s.rnd.Uint32(),
+		},
+	}
+	if err = msgBlock.AddTransaction(coinbase); err != nil {
+		return
+	}
+	return util.NewBlock(msgBlock), nil
+}
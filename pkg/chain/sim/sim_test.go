@@ -0,0 +1,44 @@
+package sim
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+func TestRun(
+	t *testing.T) {
+	run := func() [][]string {
+		var out bytes.Buffer
+		err := Run(Config{
+			Blocks:     60,
+			Activation: 20,
+			Seed:       3,
+			Hashrate:   Scenarios["steady"].Hashrate(20),
+		}, &out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(&out).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return records
+	}
+	records := run()
+	if len(records) != 61 {
+		t.Fatalf("got %d lines, expected a header and 60 blocks", len(records))
+	}
+	for _, x := range records[1:] {
+		if x[5] == "" {
+			t.Fatalf("block %s has no bits", x[0])
+		}
+	}
+	// the same seed repeats the run exactly
+	again := run()
+	for i := range records {
+		for j := range records[i] {
+			if records[i][j] != again[i][j] {
+				t.Fatalf("line %d differs between runs with the same seed", i)
+			}
+		}
+	}
+}
@@ -179,6 +179,10 @@ func (
 		done <- struct{}{}
 		return
 	}
+	// Start on the proof of work hash while the block waits in the queue, at the height it will be processed with. A block without a readable height is left to be rejected when it is processed.
+	if height, err := coinbaseHeight(block); err == nil {
+		sm.chain.PrecomputePowHash(&block.MsgBlock().Header, height)
+	}
 	sm.msgChan <- &blockMsg{block: block, peer: peer, reply: done}
 }
 // QueueHeaders adds the passed headers message and peer to the block handling queue.
@@ -455,19 +459,15 @@ func (
 	delete(sm.requestedBlocks, *blockHash)
 	var heightUpdate int32
 	var blkHashUpdate *chainhash.Hash
-	header := &bmsg.block.MsgBlock().Header
-	if blockchain.ShouldHaveSerializedBlockHeight(header) {
-		coinbaseTx := bmsg.block.Transactions()[0]
-		cbHeight, err := blockchain.ExtractCoinbaseHeight(coinbaseTx)
-		if err != nil {
-			log <- cl.Warnf{
-				"unable to extract height from coinbase tx: %v",
-				err,
-			}
-		} else {
-			heightUpdate = cbHeight
-			blkHashUpdate = blockHash
+	cbHeight, err := coinbaseHeight(bmsg.block)
+	if err != nil {
+		log <- cl.Warnf{
+			"unable to extract height from coinbase tx: %v",
+			err,
 		}
+	} else if cbHeight != 0 {
+		heightUpdate = cbHeight
+		blkHashUpdate = blockHash
 	}
 	// Process the block to include validation, best chain selection, orphan handling, etc.
 	_, isOrphan, err := sm.chain.ProcessBlock(bmsg.block, behaviorFlags, heightUpdate)
@@ -717,6 +717,18 @@ func (
 	if numHeaders == 0 {
 		return
 	}
+	// Check the proof of work of all of the received headers at once, which also keeps the hashes for when their blocks are processed.
+	if prevNodeEl := sm.headerList.Back(); prevNodeEl != nil {
+		height := prevNodeEl.Value.(*headerNode).height + 1
+		if err := sm.chain.CheckHeadersPow(msg.Headers, height); err != nil {
+			log <- cl.Warnf{
+				"received block header with invalid proof of work from peer %s: %v -- disconnecting",
+				peer, err,
+			}
+			peer.Disconnect()
+			return
+		}
+	}
 	// Process all of the received headers ensuring each one connects to the previous and that checkpoints match.
 	receivedCheckpoint := false
 	var finalHash *chainhash.Hash
@@ -1184,6 +1196,15 @@ func (
 		log <- cl.Wrn("no sync peer candidates available")
 	}
 }
+// coinbaseHeight returns the height serialized in the coinbase transaction of a block, or zero if the version of the block does not have one.
+func coinbaseHeight(
+	block *util.Block) (int32, error) {
+	if len(block.Transactions()) == 0 ||
+		!blockchain.ShouldHaveSerializedBlockHeight(&block.MsgBlock().Header) {
+		return 0, nil
+	}
+	return blockchain.ExtractCoinbaseHeight(block.Transactions()[0])
+}
 // New constructs a new SyncManager. Use Start to begin processing asynchronous block, tx, and inv updates.
 func New(
 	config *Config) (*SyncManager, error) {
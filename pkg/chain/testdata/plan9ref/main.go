@@ -0,0 +1,317 @@
+// Command plan9ref writes the bits the Plan 9 averagers give the blocks of the difficulty corpus. It reads the recorded corpus files named on the command line, keeps the version, timestamp and bits of every block, and adds the bits of the Plan 9 difficulty of pkg/chain/difficulty.go as it was before it was rewritten with exact fractions, running the same float64 code. Only the standard library is used, so the corpus does not come from the code it tests.
+//
+// The chain is the regression test network with the Plan 9 hard fork activating after block 10, as in the corpus test. GetLastWithAlgo has never found a block, which leaves the averagers unused, so the newest block of an algorithm is found by its search without the check in front of it. The main chain is the corpus up to the block before the one calculated, as it was when the block was recorded. Blocks before the hard fork keep their recorded bits.
+//
+// Run it from pkg/chain with
+//	go run ./testdata/plan9ref testdata/plan9-*.txt
+package main
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+const (
+	// activationHeight is the last block before the Plan 9 hard fork
+	activationHeight = 10
+	// averagingInterval is the number of blocks of an algorithm the Plan 9 averagers cover
+	averagingInterval = 9600
+	// targetTimePerBlock is the Plan 9 block time
+	targetTimePerBlock = int64(9 * time.Second)
+	// numAlgos is the number of Plan 9 algorithms
+	numAlgos = 9
+)
+var (
+	firstPowLimitBits  = limitBits("0fffffff00000000000000000000000000000000000000000000000000000000")
+	secondPowLimitBits = limitBits("07fffffff0000000000000000000000000000000000000000000000000000000")
+)
+// block is a block of the corpus
+type block struct {
+	height    int32
+	version   int32
+	timestamp int64
+	bits      uint32
+	parent    *block
+}
+func main() {
+	for _, path := range os.Args[1:] {
+		if err := regenerate(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+// regenerate writes the averaged bits of the blocks of a corpus file
+func regenerate(
+	path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	var out []string
+	var chain []*block
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			out = append(out, line)
+			continue
+		}
+		b := &block{}
+		if _, err = fmt.Sscanf(line, "%d %d %x", &b.version, &b.timestamp, &b.bits); err != nil {
+			f.Close()
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		averaged := b.bits
+		if len(chain) > 0 {
+			b.parent = chain[len(chain)-1]
+			b.height = b.parent.height + 1
+			if b.height > activationHeight {
+				averaged = nextBits(chain, b.version)
+			}
+		}
+		out = append(out, fmt.Sprintf("%d %d %08x %08x", b.version, b.timestamp, b.bits, averaged))
+		chain = append(chain, b)
+	}
+	f.Close()
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(strings.Join(out, "\n")+"\n"), 0644)
+}
+// nextBits is the Plan 9 difficulty of a block of the given version after the end of the chain
+func nextBits(
+	chain []*block, algo int32) uint32 {
+	lastNode := chain[len(chain)-1]
+	if lastNode.height == 0 {
+		return firstPowLimitBits
+	}
+	newTargetBits := firstPowLimitBits
+	last := lastNode
+	// find the most recent block of the same algo
+	if last.version != algo {
+		ln := last.parent
+		ln = getLastWithAlgo(ln, algo)
+		// ignore the first block as its time is not a normal timestamp, and where there is no block of the algorithm, which the chain gives the minimum difficulty
+		if ln == nil || ln.height < 1 {
+			return newTargetBits
+		}
+		last = ln
+	}
+	counter := 1
+	var timestamps []float64
+	timestamps = append(timestamps, float64(last.timestamp))
+	pb := last
+	// collect the timestamps of all the blocks of the same algo until we pass genesis block or get AveragingInterval blocks
+	for ; counter < averagingInterval && pb.height > 2; counter++ {
+		p := pb.parent
+		if p != nil {
+			if p.height == 0 {
+				return secondPowLimitBits
+			}
+			pb = getLastWithAlgo(p, algo)
+		} else {
+			break
+		}
+		if pb != nil && pb.height > 0 {
+			// only add the timestamp if is not the same as the previous
+			timestamps = append(timestamps, float64(pb.timestamp))
+		} else {
+			break
+		}
+	}
+	allTimeAverage, trailTimeAverage := float64(targetTimePerBlock), float64(targetTimePerBlock)
+	startHeight := int32(activationHeight)
+	trailHeight := int32(int64(lastNode.height) - averagingInterval*numAlgos)
+	if trailHeight < 0 {
+		trailHeight = 1
+	}
+	firstBlock := blockByHeight(chain, startHeight)
+	trailBlock := blockByHeight(chain, trailHeight)
+	lastTime := lastNode.timestamp
+	if firstBlock != nil {
+		firstTime := firstBlock.timestamp
+		allTimeAverage = (float64(lastTime) - float64(firstTime)) / (float64(lastNode.height) - float64(firstBlock.height))
+	}
+	if trailBlock != nil {
+		trailTime := trailBlock.timestamp
+		trailTimeAverage = (float64(lastTime) - float64(trailTime)) / (float64(lastNode.height) - float64(trailBlock.height))
+	}
+	if len(timestamps) < 2 {
+		return secondPowLimitBits
+	}
+	var adjusted, targetAdjusted, adjustment float64
+	if len(timestamps) > 1 {
+		target := targetTimePerBlock * numAlgos
+		counter = 0
+		for i := 0; i < len(timestamps)-1; i++ {
+			factor := 0.75
+			if i == 0 {
+				f := factor
+				for j := 0; j < i; j++ {
+					f *= factor
+				}
+				factor = f
+			} else {
+				factor = 1.0
+			}
+			adjustment = timestamps[i] - timestamps[i+1]
+			adjustment *= factor
+			adjusted += adjustment
+			targetAdjusted += float64(target) * factor
+			counter++
+		}
+	} else {
+		targetAdjusted = 100
+		adjusted = 100
+	}
+	var trailingTimestamps []float64
+	pb = lastNode
+	trailingTimestamps = append(
+		trailingTimestamps, float64(pb.timestamp))
+	counter = 1
+	for ; counter < averagingInterval &&
+		pb.height > 2; counter++ {
+		pb = pb.parent
+		trailingTimestamps = append(
+			trailingTimestamps, float64(pb.timestamp))
+		counter++
+	}
+	var trailingAdjusted,
+		trailingTargetAdjusted,
+		trailingAdjustment float64
+	if len(trailingTimestamps) > 1 {
+		target := targetTimePerBlock
+		counter = 0
+		for i := 0; i < len(trailingTimestamps)-1; i++ {
+			factor := 0.81
+			if i == 0 {
+				f := factor
+				for j := 0; j < i; j++ {
+					f *= factor
+				}
+				factor = f
+			} else {
+				factor = 1.0
+			}
+			trailingAdjustment = trailingTimestamps[i] - trailingTimestamps[i+1]
+			trailingAdjustment *= factor
+			trailingAdjusted += trailingAdjustment
+			trailingTargetAdjusted += float64(target) * factor
+			counter++
+		}
+	} else {
+		trailingTargetAdjusted = 100
+		trailingAdjusted = 100
+	}
+	ttpb := float64(targetTimePerBlock)
+	allTimeDivergence := allTimeAverage / ttpb
+	trailTimeDivergence := trailTimeAverage / ttpb
+	trailingTimeDivergence := trailingAdjusted / trailingTargetAdjusted
+	weighted := adjusted / targetAdjusted
+	adjustment = (weighted*weighted*weighted +
+		trailingTimeDivergence*trailingTimeDivergence*trailingTimeDivergence +
+		trailTimeDivergence*trailTimeDivergence*trailTimeDivergence +
+		allTimeDivergence*allTimeDivergence*allTimeDivergence) / 4.0
+	if adjustment < 0 {
+		adjustment = allTimeDivergence
+	}
+	if math.IsNaN(adjustment) {
+		return lastNode.bits
+	}
+	// Bias adjustment for difficulty reductions to reduce incidence of sub 1 second blocks
+	if adjustment < 0 {
+		adjustment = (1 - adjustment) * adjustment
+	}
+	bigadjustment := big.NewFloat(adjustment)
+	bigoldtarget := big.NewFloat(1.0).SetInt(compactToBig(last.bits))
+	bigfnewtarget := big.NewFloat(1.0).Mul(bigadjustment, bigoldtarget)
+	newtarget, _ := bigfnewtarget.Int(nil)
+	if newtarget == nil {
+		return newTargetBits
+	}
+	mintarget := compactToBig(newTargetBits)
+	if newtarget.Cmp(mintarget) < 0 {
+		newTargetBits = bigToCompact(newtarget)
+	}
+	return newTargetBits
+}
+// getLastWithAlgo is the search of GetLastWithAlgo for the newest block of an algorithm from b, where blocks before the hard fork other than scrypt count as sha256d
+func getLastWithAlgo(
+	b *block, algo int32) (prev *block) {
+	prev = b
+	for {
+		if prev == nil {
+			return nil
+		}
+		prevversion := prev.version
+		if prev.height <= activationHeight {
+			if prev.version != 514 &&
+				prev.version != 2 {
+				prevversion = 2
+			}
+		}
+		if prevversion == algo {
+			return
+		}
+		prev = prev.parent
+	}
+}
+// blockByHeight is the block at a height of the main chain, nil if there is none
+func blockByHeight(
+	chain []*block, height int32) *block {
+	if height < 0 || int(height) >= len(chain) {
+		return nil
+	}
+	return chain[height]
+}
+// limitBits is the compact form of a proof of work limit in hexadecimal
+func limitBits(
+	limit string) uint32 {
+	b, _ := hex.DecodeString(limit)
+	return bigToCompact(new(big.Int).SetBytes(b))
+}
+// compactToBig is the target of compact bits
+func compactToBig(
+	compact uint32) *big.Int {
+	mantissa := compact & 0x007fffff
+	exponent := uint(compact >> 24)
+	var n *big.Int
+	if exponent <= 3 {
+		n = big.NewInt(int64(mantissa >> (8 * (3 - exponent))))
+	} else {
+		n = new(big.Int).Lsh(big.NewInt(int64(mantissa)), 8*(exponent-3))
+	}
+	if compact&0x00800000 != 0 {
+		n.Neg(n)
+	}
+	return n
+}
+// bigToCompact is the compact bits of a target, as encoded by btcd, where the mantissa of a negative target is shifted arithmetically
+func bigToCompact(
+	n *big.Int) uint32 {
+	if n.Sign() == 0 {
+		return 0
+	}
+	var mantissa uint32
+	exponent := uint(len(n.Bytes()))
+	if exponent <= 3 {
+		mantissa = uint32(n.Bits()[0]) << (8 * (3 - exponent))
+	} else {
+		mantissa = uint32(new(big.Int).Rsh(n, 8*(exponent-3)).Bits()[0])
+	}
+	if mantissa&0x00800000 != 0 {
+		mantissa >>= 8
+		exponent++
+	}
+	compact := uint32(exponent<<24) | mantissa
+	if n.Sign() < 0 {
+		compact |= 0x00800000
+	}
+	return compact
+}
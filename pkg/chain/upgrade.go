@@ -513,6 +513,11 @@ func (b *BlockChain) maybeUpgradeDbBuckets(interrupt <-chan struct{}) error {
 		var err error
 		utxoSetVersion, err = dbFetchOrCreateVersion(dbTx,
 			utxoSetVersionKeyName, 1)
+		if err != nil {
+			return err
+		}
+		// Create the bucket of proof of work hashes if the database was made before it existed.
+		_, err = dbTx.Metadata().CreateBucketIfNotExists(powHashBucketName)
 		return err
 	})
 	if err != nil {
@@ -51,9 +51,9 @@ func (
 	// log <- cl.Info{"algo", algo}
 	height := block.Height()
 	// log <- cl.Info{"height", height}
-	algoname := fork.GetAlgoName(algo, height)
+	algoname := fork.GetAlgoName(b.chainParams, algo, height)
 	// log <- cl.Info{"algoname", algoname}
-	powLimit := fork.GetMinDiff(algoname, height)
+	powLimit := fork.GetMinDiff(b.chainParams, algoname, height)
 	// log <- cl.Infof{"powLimit %064x", powLimit}
 	// Skip the proof of work check as this is just a block template.
 	flags := BFNoPoWCheck
@@ -64,7 +64,7 @@ func (
 		str := fmt.Sprintf("previous block must be the current chain tip %v, instead got %v", tip.hash, header.PrevBlock)
 		return ruleError(ErrPrevBlockNotBest, str)
 	}
-	err := checkBlockSanity(block, powLimit, b.timeSource, flags, true, block.Height())
+	err := checkBlockSanity(block, powLimit, b.timeSource, flags, true, block.Height(), b.chainParams, b.powHashCache)
 	if err != nil {
 		log <- cl.Error{"block processing error:", err}
 		return err
@@ -76,7 +76,7 @@ func (
 	// Leave the spent txouts entry nil in the state since the information is not needed and thus extra work can be avoided.
 	view := NewUtxoViewpoint()
 	view.SetBestHash(&tip.hash)
-	newNode := newBlockNode(&header, tip)
+	newNode := newBlockNode(&header, tip, b.chainParams)
 	return b.checkConnectBlock(newNode, block, view, nil)
 }
 // checkBIP0030 ensures blocks do not contain duplicate transactions which 'overwrite' older transactions that are not fully spent.  This prevents an attack where a coinbase and all of its dependent transactions could be duplicated to effectively revert the overwritten transactions to a single confirmation thereby making them vulnerable to a double spend.
@@ -200,7 +200,7 @@ func (
 	fastAdd := flags&BFFastAdd == BFFastAdd
 	if !fastAdd {
 		// Ensure the difficulty specified in the block header matches the calculated difficulty based on the previous block and difficulty retarget rules.
-		a := fork.GetAlgoName(header.Version, prevNode.height+1)
+		a := fork.GetAlgoName(b.chainParams, header.Version, prevNode.height+1)
 		log <- cl.Infof{
 			"algo %s %d %8x %d", a, header.Version, header.Bits, prevNode.height + 1}
 		expectedDifficulty, err := b.calcNextRequiredDifficulty(prevNode,
@@ -242,6 +242,13 @@ func (
 			blockHeight, checkpointNode.height)
 		return ruleError(ErrForkTooOld, str)
 	}
+	// Likewise prevent blocks which fork the main chain at or before the automatic checkpoint.
+	if b.forksBeforeAutoCheckpoint(prevNode) {
+		str := fmt.Sprintf("block at height %d forks the main chain "+
+			"before the automatic checkpoint at height %d",
+			blockHeight, b.autoCheckpoint.height)
+		return ruleError(ErrForkTooOld, str)
+	}
 	// Reject outdated block versions once a majority of the network has upgraded.  These were originally voted on by BIP0034, BIP0065, and BIP0066.
 	params := b.chainParams
 	if header.Version < 2 && blockHeight >= params.BIP0034Height ||
@@ -420,7 +427,7 @@ func CalcBlockSubsidy(
 		return baseSubsidy
 	}
 	// Equivalent to: baseSubsidy / 2^(height/subsidyHalvingInterval)
-	switch fork.GetCurrent(height) {
+	switch fork.GetCurrent(chainParams, height) {
 	case 0:
 		return baseSubsidy >> uint(height/chainParams.SubsidyReductionInterval)
 	case 1:
@@ -431,16 +438,17 @@ func CalcBlockSubsidy(
 }
 // CheckBlockSanity performs some preliminary checks on a block to ensure it is sane before continuing with block processing.  These checks are context free.
 func CheckBlockSanity(
-	block *util.Block, powLimit *big.Int, timeSource MedianTimeSource, DoNotCheckPow bool, height int32, testnet bool) error {
-	return checkBlockSanity(block, powLimit, timeSource, BFNone, DoNotCheckPow, height)
+	block *util.Block, powLimit *big.Int, timeSource MedianTimeSource, DoNotCheckPow bool, height int32, chainParams *chaincfg.Params) error {
+	return checkBlockSanity(block, powLimit, timeSource, BFNone, DoNotCheckPow, height, chainParams, nil)
 }
 // CheckProofOfWork ensures the block header bits which indicate the target difficulty is in min/max range and that the block hash is less than the target difficulty as claimed.
 func CheckProofOfWork(
 	block *util.Block,
 	powLimit *big.Int,
 	height int32,
+	chainParams *chaincfg.Params,
 ) error {
-	return checkProofOfWork(&block.MsgBlock().Header, powLimit, BFNone, height)
+	return checkProofOfWork(&block.MsgBlock().Header, powLimit, BFNone, height, chainParams, nil)
 }
 // CheckTransactionInputs performs a series of checks on the inputs to a transaction to ensure they are valid.  An example of some of the checks include verifying all inputs exist, ensuring the coinbase seasoning requirements are met, detecting double spends, validating all values and fees are in the legal range and the total output amount doesn't exceed the input amount, and verifying the signatures to prove the spender was the owner of the bitcoins and therefore allowed to spend them.  As it checks the inputs, it also calculates the total fees for the transaction and returns that value.
 // NOTE: The transaction MUST have already been sanity checked with the CheckTransactionSanity function prior to calling this function.
@@ -751,12 +759,12 @@ func ShouldHaveSerializedBlockHeight(
 	header *wire.BlockHeader) bool {
 	return header.Version >= serializedHeightVersion
 }
-// checkBlockHeaderSanity performs some preliminary checks on a block header to ensure it is sane before continuing with processing.  These checks are context free. The flags do not modify the behavior of this function directly, however they are needed to pass along to checkProofOfWork.
+// checkBlockHeaderSanity performs some preliminary checks on a block header to ensure it is sane before continuing with processing.  These checks are context free. The flags and proof of work hash cache do not modify the behavior of this function directly, however they are needed to pass along to checkProofOfWork.
 func checkBlockHeaderSanity(
-	header *wire.BlockHeader, powLimit *big.Int, timeSource MedianTimeSource, flags BehaviorFlags, height int32, testnet bool) error {
+	header *wire.BlockHeader, powLimit *big.Int, timeSource MedianTimeSource, flags BehaviorFlags, height int32, chainParams *chaincfg.Params, powHashCache *PowHashCache) error {
 	log <- cl.Trc("checkBlockHeaderSanity")
 	// Ensure the proof of work bits in the block header is in min/max range and the block hash is less than the target value described by the bits.
-	err := checkProofOfWork(header, powLimit, flags, height)
+	err := checkProofOfWork(header, powLimit, flags, height, chainParams, powHashCache)
 	if err != nil {
 		return err
 	}
@@ -778,11 +786,11 @@ func checkBlockHeaderSanity(
 }
 // checkBlockSanity performs some preliminary checks on a block to ensure it is sane before continuing with block processing.  These checks are context free. The flags do not modify the behavior of this function directly, however they are needed to pass along to checkBlockHeaderSanity.
 func checkBlockSanity(
-	block *util.Block, powLimit *big.Int, timeSource MedianTimeSource, flags BehaviorFlags, DoNotCheckPow bool, height int32) error {
+	block *util.Block, powLimit *big.Int, timeSource MedianTimeSource, flags BehaviorFlags, DoNotCheckPow bool, height int32, chainParams *chaincfg.Params, powHashCache *PowHashCache) error {
 	log <- cl.Trc("checkBlockSanity")
 	msgBlock := block.MsgBlock()
 	header := &msgBlock.Header
-	err := checkBlockHeaderSanity(header, powLimit, timeSource, flags, height, fork.IsTestnet)
+	err := checkBlockHeaderSanity(header, powLimit, timeSource, flags, height, chainParams, powHashCache)
 	if err != nil {
 		log <- cl.Debug{"block processing error:", err}
 		return err
@@ -867,11 +875,14 @@ func checkBlockSanity(
 }
 // checkProofOfWork ensures the block header bits which indicate the target difficulty is in min/max range and that the block hash is less than the target difficulty as claimed. The flags modify the behavior of this function as follows:
 //  - BFNoPoWCheck: The check to ensure the block hash is less than the target difficulty is not performed.
+// The proof of work hash is looked up in powHashCache, which can be nil to always compute it.
 func checkProofOfWork(
 	header *wire.BlockHeader,
 	powLimit *big.Int,
 	flags BehaviorFlags,
 	height int32,
+	chainParams *chaincfg.Params,
+	powHashCache *PowHashCache,
 ) error {
 	log <- cl.Trc("checkProofOfWork")
 	// The target difficulty must be larger than zero.
@@ -894,7 +905,7 @@ func checkProofOfWork(
 	// The block hash must be less than the claimed target unless the flag to avoid proof of work checks is set.
 	if flags&BFNoPoWCheck != BFNoPoWCheck {
 		// The block hash must be less than the claimed target. Unless there is less than 10 previous with the same version (algo)...
-		hash := header.BlockHashWithAlgos(height)
+		hash := powHashCache.Hash(header, height, chainParams)
 		// log <- cl.Debug{"blockhashwithalgos", hash}
 		hashNum := HashToBig(&hash)
 		if hashNum.Cmp(target) > 0 {
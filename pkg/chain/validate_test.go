@@ -131,7 +131,7 @@ func TestCheckBlockSanity(
 	powLimit := chaincfg.MainNetParams.PowLimit
 	block := util.NewBlock(&Block100000)
 	timeSource := NewMedianTime()
-	err := CheckBlockSanity(block, powLimit, timeSource, false, 1, false)
+	err := CheckBlockSanity(block, powLimit, timeSource, false, 1, &chaincfg.MainNetParams)
 	if err != nil {
 		t.Errorf("CheckBlockSanity: %v", err)
 	}
@@ -139,7 +139,7 @@ func TestCheckBlockSanity(
 	// second fails.
 	timestamp := block.MsgBlock().Header.Timestamp
 	block.MsgBlock().Header.Timestamp = timestamp.Add(time.Nanosecond)
-	err = CheckBlockSanity(block, powLimit, timeSource, false, 1, false)
+	err = CheckBlockSanity(block, powLimit, timeSource, false, 1, &chaincfg.MainNetParams)
 	if err == nil {
 		t.Errorf("CheckBlockSanity: error is nil when it shouldn't be")
 	}
@@ -33,14 +33,14 @@ func (h *BlockHeader) BlockHash() (out chainhash.Hash) {
 	out = chainhash.DoubleHashH(buf.Bytes())
 	return
 }
-// BlockHashWithAlgos computes the block identifier hash for the given block header. This function is additional because the sync manager and the parallelcoin protocol only use SHA256D hashes for inventories and calculating the scrypt (or other) hash for these blocks when requested via that route causes an 'unrequested block' error.
-func (h *BlockHeader) BlockHashWithAlgos(height int32) (out chainhash.Hash) {
+// BlockHashWithAlgos computes the block identifier hash for the given block header. This function is additional because the sync manager and the parallelcoin protocol only use SHA256D hashes for inventories and calculating the scrypt (or other) hash for these blocks when requested via that route causes an 'unrequested block' error. The algorithm is chosen by the rules of the hard fork in force at the height on the network of params.
+func (h *BlockHeader) BlockHashWithAlgos(params fork.Params, height int32) (out chainhash.Hash) {
 	// Encode the header and double sha256 everything prior to the number of transactions.  Ignore the error returns since there is no way the encode could fail except being out of memory which would cause a run-time panic.
 	buf := bytes.NewBuffer(make([]byte, 0, MaxBlockHeaderPayload))
 	_ = writeBlockHeader(buf, 0, h)
 	vers := h.Version
-	algo := fork.GetAlgoName(vers, height)
-	out = fork.Hash(buf.Bytes(), algo, height)
+	algo := fork.GetAlgoName(params, vers, height)
+	out = fork.Hash(params, buf.Bytes(), algo, height)
 	return
 }
 // BtcDecode decodes r using the bitcoin protocol encoding into the receiver. This is part of the Message interface implementation. See Deserialize for decoding block headers stored to disk, such as in a database, as opposed to decoding block headers from the wire.
@@ -3,6 +3,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"git.parallelcoin.io/dev/9/pkg/chain/fork"
 	chainhash "git.parallelcoin.io/dev/9/pkg/chain/hash"
 )
 // defaultTransactionAlloc is the default size used for the backing array for transactions.  The transaction array will dynamically grow as needed, but this figure is intended to provide enough space for the number of transactions in the vast majority of blocks without needing to grow the backing array multiple times.
@@ -161,8 +162,8 @@ func (msg *MsgBlock) BlockHash() chainhash.Hash {
 	return msg.Header.BlockHash()
 }
 // BlockHashWithAlgos computes the block identifier hash for this block.
-func (msg *MsgBlock) BlockHashWithAlgos(h int32) chainhash.Hash {
-	return msg.Header.BlockHashWithAlgos(h)
+func (msg *MsgBlock) BlockHashWithAlgos(params fork.Params, h int32) chainhash.Hash {
+	return msg.Header.BlockHashWithAlgos(params, h)
 }
 // TxHashes returns a slice of hashes of all of transactions in this block.
 func (msg *MsgBlock) TxHashes() ([]chainhash.Hash, error) {
@@ -11,6 +11,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -785,6 +786,27 @@ func (a *AddrManager) AddLocalAddress(na *wire.NetAddress, priority AddressPrior
 	}
 	return nil
 }
+// LocalAddress is a local address advertised to peers and the priority of the method it was discovered with.
+type LocalAddress struct {
+	NA    *wire.NetAddress
+	Score AddressPriority
+}
+// LocalAddresses returns the known local addresses to advertise, highest score first.
+func (a *AddrManager) LocalAddresses() []LocalAddress {
+	a.lamtx.Lock()
+	addrs := make([]LocalAddress, 0, len(a.localAddresses))
+	for _, la := range a.localAddresses {
+		addrs = append(addrs, LocalAddress{NA: la.na, Score: la.score})
+	}
+	a.lamtx.Unlock()
+	sort.Slice(addrs, func(i, j int) bool {
+		if addrs[i].Score != addrs[j].Score {
+			return addrs[i].Score > addrs[j].Score
+		}
+		return NetAddressKey(addrs[i].NA) < NetAddressKey(addrs[j].NA)
+	})
+	return addrs
+}
 // getReachabilityFrom returns the relative reachability of the provided local address to the provided remote address.
 func getReachabilityFrom(
 	localAddr, remoteAddr *wire.NetAddress) int {
@@ -171,7 +171,9 @@ func TestAddLocalAddress(
 	}
 	amgr := addrmgr.New("testaddlocaladdress", nil)
 	for x, test := range tests {
-		result := amgr.AddLocalAddress(&test.address, test.priority)
+		// The address manager keeps the address, so each needs its own.
+		address := test.address
+		result := amgr.AddLocalAddress(&address, test.priority)
 		if result == nil && !test.valid {
 			t.Errorf("TestAddLocalAddress test #%d failed: %s should have "+
 				"been accepted", x, test.address.IP)
@@ -183,6 +185,18 @@ func TestAddLocalAddress(
 			continue
 		}
 	}
+	// The address added again with a higher priority comes first.
+	addrs := amgr.LocalAddresses()
+	if len(addrs) != 2 {
+		t.Fatalf("TestAddLocalAddress: got %d local addresses, expected 2",
+			len(addrs))
+	}
+	if !addrs[0].NA.IP.Equal(net.ParseIP("204.124.1.1")) ||
+		addrs[0].Score <= addrs[1].Score {
+		t.Errorf("TestAddLocalAddress: local addresses are %s with score %d "+
+			"and %s with score %d", addrs[0].NA.IP, addrs[0].Score,
+			addrs[1].NA.IP, addrs[1].Score)
+	}
 }
 func TestAttempt(
 	t *testing.T) {
@@ -113,6 +113,10 @@ type handleFailed struct {
 	c   *ConnReq
 	err error
 }
+// setTargetOutbound is used to change the number of outbound connections to maintain.
+type setTargetOutbound struct {
+	target uint32
+}
 // ConnManager provides a manager to handle network connections.
 type ConnManager struct {
 	// The following variables must only be used atomically.
@@ -230,6 +234,11 @@ out:
 					pending[msg.id] = connReq
 					cm.handleFailedConn(connReq)
 				}
+			case setTargetOutbound:
+				for i := cm.cfg.TargetOutbound; i < msg.target; i++ {
+					go cm.NewConnReq()
+				}
+				cm.cfg.TargetOutbound = msg.target
 			case handleFailed:
 				connReq := msg.c
 				if _, ok := pending[connReq.id]; !ok {
@@ -378,6 +387,16 @@ func (cm *ConnManager) listenHandler(listener net.Listener) {
 		return fmt.Sprint("listener handler done for ", listener.Addr())
 	})
 }
+// SetTargetOutbound changes the number of outbound connections to maintain. More connections are requested at once if it grows, if it shrinks closed connections are not replaced until there are fewer than the target.
+func (cm *ConnManager) SetTargetOutbound(target uint32) {
+	if atomic.LoadInt32(&cm.stop) != 0 {
+		return
+	}
+	select {
+	case cm.requests <- setTargetOutbound{target}:
+	case <-cm.quit:
+	}
+}
 // Start launches the connection manager and begins connecting to the network.
 func (cm *ConnManager) Start() {
 	// Already started?
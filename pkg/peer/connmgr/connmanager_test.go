@@ -191,6 +191,45 @@ func TestTargetOutbound(
 	}
 	cmgr.Stop()
 }
+// TestSetTargetOutbound tests that raising the target number of outbound connections of a running connection manager makes the extra connections at once.
+func TestSetTargetOutbound(
+	t *testing.T) {
+	connected := make(chan *ConnReq)
+	cmgr, err := New(&Config{
+		TargetOutbound: 2,
+		Dial:           mockDialer,
+		GetNewAddress: func() (net.Addr, error) {
+			return &net.TCPAddr{
+				IP:   net.ParseIP("127.0.0.1"),
+				Port: 18555,
+			}, nil
+		},
+		OnConnection: func(c *ConnReq, conn net.Conn) {
+			connected <- c
+		},
+	})
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	cmgr.Start()
+	for i := 0; i < 2; i++ {
+		<-connected
+	}
+	cmgr.SetTargetOutbound(5)
+	for i := 0; i < 3; i++ {
+		select {
+		case <-connected:
+		case <-time.After(time.Second):
+			t.Fatalf("set target outbound: got %d of 3 extra connections", i)
+		}
+	}
+	select {
+	case c := <-connected:
+		t.Fatalf("set target outbound: got unexpected connection - %v", c.Addr)
+	case <-time.After(time.Millisecond):
+	}
+	cmgr.Stop()
+}
 // TestRetryPermanent tests that permanent connection requests are retried. We make a permanent connection request using Connect, disconnect it using Disconnect and we wait for it to be connected back.
 func TestRetryPermanent(
 	t *testing.T) {
@@ -73,6 +73,7 @@ type Config struct {
 	NoPeerBloomFilters       *bool
 	NoCFilters               *bool
 	SigCacheMaxSize          *int
+	PowWorkers               *int
 	BlocksOnly               *bool
 	TxIndex                  *bool
 	AddrIndex                *bool
@@ -86,6 +87,9 @@ type Config struct {
 	CAFile                   *string
 	OneTimeTLSKey            *bool
 	ServerTLS                *bool
+	ClientAuth               *bool
+	ClientCert               *string
+	ClientKey                *string
 	LegacyRPCListeners       *[]string
 	LegacyRPCMaxClients      *int
 	LegacyRPCMaxWebsockets   *int
@@ -157,10 +157,14 @@ func (r FutureGetDifficultyResult) Receive() (float64, error) {
 }
 // GetDifficultyAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetDifficulty for the blocking version and more details.
 func (c *Client) GetDifficultyAsync(algo string) FutureGetDifficultyResult {
-	cmd := json.NewGetDifficultyCmd(algo)
+	var a *string
+	if algo != "" {
+		a = &algo
+	}
+	cmd := json.NewGetDifficultyCmd(a)
 	return c.sendCmd(cmd)
 }
-// GetDifficulty returns the proof-of-work difficulty as a multiple of the minimum difficulty.
+// GetDifficulty returns the proof-of-work difficulty of an algorithm as a multiple of the minimum difficulty, of the algorithm of the RPC endpoint when algo is empty.
 func (c *Client) GetDifficulty(algo string) (float64, error) {
 	return c.GetDifficultyAsync(algo).Receive()
 }
@@ -339,6 +343,24 @@ func (c *Client) GetRawMempoolAsync() FutureGetRawMempoolResult {
 func (c *Client) GetRawMempool() ([]*chainhash.Hash, error) {
 	return c.GetRawMempoolAsync().Receive()
 }
+// GetMempoolAncestorsAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetMempoolAncestors for the blocking version and more details.
+func (c *Client) GetMempoolAncestorsAsync(txHash string) FutureGetRawMempoolResult {
+	cmd := json.NewGetMempoolAncestorsCmd(txHash, json.Bool(false))
+	return c.sendCmd(cmd)
+}
+// GetMempoolAncestors returns the hashes of the transactions in the memory pool that the transaction with the given hash depends on.
+func (c *Client) GetMempoolAncestors(txHash string) ([]*chainhash.Hash, error) {
+	return c.GetMempoolAncestorsAsync(txHash).Receive()
+}
+// GetMempoolDescendantsAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetMempoolDescendants for the blocking version and more details.
+func (c *Client) GetMempoolDescendantsAsync(txHash string) FutureGetRawMempoolResult {
+	cmd := json.NewGetMempoolDescendantsCmd(txHash, json.Bool(false))
+	return c.sendCmd(cmd)
+}
+// GetMempoolDescendants returns the hashes of the transactions in the memory pool that depend on the transaction with the given hash.
+func (c *Client) GetMempoolDescendants(txHash string) ([]*chainhash.Hash, error) {
+	return c.GetMempoolDescendantsAsync(txHash).Receive()
+}
 // FutureGetRawMempoolVerboseResult is a future promise to deliver the result of a GetRawMempoolVerboseAsync RPC invocation (or an applicable error).
 type FutureGetRawMempoolVerboseResult chan *response
 // Receive waits for the response promised by the future and returns a map of transaction hashes to an associated data structure with information about the transaction for all transactions in the memory pool.
@@ -513,6 +535,70 @@ func (c *Client) InvalidateBlockAsync(blockHash *chainhash.Hash) FutureInvalidat
 func (c *Client) InvalidateBlock(blockHash *chainhash.Hash) error {
 	return c.InvalidateBlockAsync(blockHash).Receive()
 }
+// FutureReconsiderBlockResult is a future promise to deliver the result of a ReconsiderBlockAsync RPC invocation (or an applicable error).
+type FutureReconsiderBlockResult chan *response
+// Receive waits for the response promised by the future and returns an error if the block could not be reconsidered.
+func (r FutureReconsiderBlockResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+// ReconsiderBlockAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See ReconsiderBlock for the blocking version and more details.
+func (c *Client) ReconsiderBlockAsync(blockHash *chainhash.Hash) FutureReconsiderBlockResult {
+	hash := ""
+	if blockHash != nil {
+		hash = blockHash.String()
+	}
+	cmd := json.NewReconsiderBlockCmd(hash)
+	return c.sendCmd(cmd)
+}
+// ReconsiderBlock removes the invalid marks from a specific block that was invalidated.
+func (c *Client) ReconsiderBlock(blockHash *chainhash.Hash) error {
+	return c.ReconsiderBlockAsync(blockHash).Receive()
+}
+// FuturePreciousBlockResult is a future promise to deliver the result of a PreciousBlockAsync RPC invocation (or an applicable error).
+type FuturePreciousBlockResult chan *response
+// Receive waits for the response promised by the future and returns an error if the block could not be made precious.
+func (r FuturePreciousBlockResult) Receive() error {
+	_, err := receiveFuture(r)
+	return err
+}
+// PreciousBlockAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See PreciousBlock for the blocking version and more details.
+func (c *Client) PreciousBlockAsync(blockHash *chainhash.Hash) FuturePreciousBlockResult {
+	hash := ""
+	if blockHash != nil {
+		hash = blockHash.String()
+	}
+	cmd := json.NewPreciousBlockCmd(hash)
+	return c.sendCmd(cmd)
+}
+// PreciousBlock treats a specific block as if it was received before the others with the same work.
+func (c *Client) PreciousBlock(blockHash *chainhash.Hash) error {
+	return c.PreciousBlockAsync(blockHash).Receive()
+}
+// FutureGetChainTipsResult is a future promise to deliver the result of a GetChainTipsAsync RPC invocation (or an applicable error).
+type FutureGetChainTipsResult chan *response
+// Receive waits for the response promised by the future and returns the tips of the branches of the block chain known to the server.
+func (r FutureGetChainTipsResult) Receive() ([]json.GetChainTipsResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+	var tips []json.GetChainTipsResult
+	err = js.Unmarshal(res, &tips)
+	if err != nil {
+		return nil, err
+	}
+	return tips, nil
+}
+// GetChainTipsAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetChainTips for the blocking version and more details.
+func (c *Client) GetChainTipsAsync() FutureGetChainTipsResult {
+	cmd := json.NewGetChainTipsCmd()
+	return c.sendCmd(cmd)
+}
+// GetChainTips returns the ends of the main chain and of every side chain known to the server.
+func (c *Client) GetChainTips() ([]json.GetChainTipsResult, error) {
+	return c.GetChainTipsAsync().Receive()
+}
 // FutureGetCFilterResult is a future promise to deliver the result of a GetCFilterAsync RPC invocation (or an applicable error).
 type FutureGetCFilterResult chan *response
 // Receive waits for the response promised by the future and returns the raw filter requested from the server given its block hash.
@@ -771,6 +771,8 @@ type ConnConfig struct {
 	// Certificates are the bytes for a PEM-encoded certificate chain used for the TLS connection.  It has no effect if the DisableTLS parameter
 	// is true.
 	Certificates []byte
+	// ClientCert and ClientKey are the bytes for a PEM-encoded certificate and key presented to servers that require clients to authenticate with a certificate.  They have no effect if the TLS parameter is false.
+	ClientCert, ClientKey []byte
 	// Proxy specifies to connect through a SOCKS 5 proxy server.  It may be an empty string if a proxy is not required.
 	Proxy string
 	// ProxyUser is an optional username to use for the proxy server if it requires authentication.  It has no effect if the Proxy parameter is not set.
@@ -809,6 +811,16 @@ func newHTTPClient(
 				RootCAs: pool,
 			}
 		}
+		if len(config.ClientCert) > 0 {
+			if tlsConfig == nil {
+				tlsConfig = &tls.Config{}
+			}
+			keypair, err := tls.X509KeyPair(config.ClientCert, config.ClientKey)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{keypair}
+		}
 	}
 	client := http.Client{
 		Transport: &http.Transport{
@@ -832,6 +844,13 @@ func dial(config *ConnConfig) (*websocket.Conn, error) {
 			pool.AppendCertsFromPEM(config.Certificates)
 			tlsConfig.RootCAs = pool
 		}
+		if len(config.ClientCert) > 0 {
+			keypair, err := tls.X509KeyPair(config.ClientCert, config.ClientKey)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{keypair}
+		}
 		scheme = "wss"
 	}
 	// Create a websocket dialer that will be used to make the connection. It is modified by the proxy setting below as needed.
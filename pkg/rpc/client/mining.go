@@ -33,7 +33,7 @@ func (r FutureGenerateResult) Receive() ([]*chainhash.Hash, error) {
 }
 // GenerateAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See Generate for the blocking version and more details.
 func (c *Client) GenerateAsync(numBlocks uint32) FutureGenerateResult {
-	cmd := json.NewGenerateCmd(numBlocks)
+	cmd := json.NewGenerateCmd(numBlocks, nil)
 	return c.sendCmd(cmd)
 }
 // Generate generates numBlocks blocks and returns their hashes.
@@ -124,7 +124,7 @@ func (r FutureGetMiningInfoResult) Receive() (*json.GetMiningInfoResult, error)
 }
 // GetMiningInfoAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetMiningInfo for the blocking version and more details.
 func (c *Client) GetMiningInfoAsync() FutureGetMiningInfoResult {
-	cmd := json.NewGetMiningInfoCmd()
+	cmd := json.NewGetMiningInfoCmd(nil)
 	return c.sendCmd(cmd)
 }
 // GetMiningInfo returns mining information.
@@ -149,7 +149,7 @@ func (r FutureGetNetworkHashPS) Receive() (int64, error) {
 }
 // GetNetworkHashPSAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetNetworkHashPS for the blocking version and more details.
 func (c *Client) GetNetworkHashPSAsync() FutureGetNetworkHashPS {
-	cmd := json.NewGetNetworkHashPSCmd(nil, nil)
+	cmd := json.NewGetNetworkHashPSCmd(nil, nil, nil)
 	return c.sendCmd(cmd)
 }
 // GetNetworkHashPS returns the estimated network hashes per second using the default number of blocks and the most recent block height. GetNetworkHashPS2 to override the number of blocks to use and GetNetworkHashPS3 to override the height at which to calculate the estimate.
@@ -158,7 +158,7 @@ func (c *Client) GetNetworkHashPS() (int64, error) {
 }
 // GetNetworkHashPS2Async returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetNetworkHashPS2 for the blocking version and more details.
 func (c *Client) GetNetworkHashPS2Async(blocks int) FutureGetNetworkHashPS {
-	cmd := json.NewGetNetworkHashPSCmd(&blocks, nil)
+	cmd := json.NewGetNetworkHashPSCmd(&blocks, nil, nil)
 	return c.sendCmd(cmd)
 }
 // GetNetworkHashPS2 returns the estimated network hashes per second for the specified previous number of blocks working backwards from the most recent block height.  The blocks parameter can also be -1 in which case the number of blocks since the last difficulty change will be used. See GetNetworkHashPS to use defaults and GetNetworkHashPS3 to override the height at which to calculate the estimate.
@@ -167,7 +167,7 @@ func (c *Client) GetNetworkHashPS2(blocks int) (int64, error) {
 }
 // GetNetworkHashPS3Async returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetNetworkHashPS3 for the blocking version and more details.
 func (c *Client) GetNetworkHashPS3Async(blocks, height int) FutureGetNetworkHashPS {
-	cmd := json.NewGetNetworkHashPSCmd(&blocks, &height)
+	cmd := json.NewGetNetworkHashPSCmd(&blocks, &height, nil)
 	return c.sendCmd(cmd)
 }
 // GetNetworkHashPS3 returns the estimated network hashes per second for the specified previous number of blocks working backwards from the specified block height.  The blocks parameter can also be -1 in which case the number of blocks since the last difficulty change will be used. See GetNetworkHashPS and GetNetworkHashPS2 to use defaults.
@@ -252,7 +252,7 @@ func (c *Client) SubmitBlockAsync(block *util.Block, options *json.SubmitBlockOp
 		}
 		blockHex = hex.EncodeToString(blockBytes)
 	}
-	cmd := json.NewSubmitBlockCmd(blockHex, options)
+	cmd := json.NewSubmitBlockCmd(blockHex, options, nil)
 	return c.sendCmd(cmd)
 }
 // SubmitBlock attempts to submit a new block into the bitcoin network.
@@ -168,6 +168,31 @@ func (c *Client) GetPeerInfoAsync() FutureGetPeerInfoResult {
 func (c *Client) GetPeerInfo() ([]json.GetPeerInfoResult, error) {
 	return c.GetPeerInfoAsync().Receive()
 }
+// FutureGetNetworkInfoResult is a future promise to deliver the result of a GetNetworkInfoAsync RPC invocation (or an applicable error).
+type FutureGetNetworkInfoResult chan *response
+// Receive waits for the response promised by the future and returns information about the peer-to-peer network.
+func (r FutureGetNetworkInfoResult) Receive() (*json.GetNetworkInfoResult, error) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+	// Unmarshal result as a getnetworkinfo result object.
+	var info json.GetNetworkInfoResult
+	err = js.Unmarshal(res, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+// GetNetworkInfoAsync returns an instance of a type that can be used to get the result of the RPC at some future time by invoking the Receive function on the returned instance. See GetNetworkInfo for the blocking version and more details.
+func (c *Client) GetNetworkInfoAsync() FutureGetNetworkInfoResult {
+	cmd := json.NewGetNetworkInfoCmd()
+	return c.sendCmd(cmd)
+}
+// GetNetworkInfo returns information about the peer-to-peer network.
+func (c *Client) GetNetworkInfo() (*json.GetNetworkInfoResult, error) {
+	return c.GetNetworkInfoAsync().Receive()
+}
 // FutureGetNetTotalsResult is a future promise to deliver the result of a GetNetTotalsAsync RPC invocation (or an applicable error).
 type FutureGetNetTotalsResult chan *response
 // Receive waits for the response promised by the future and returns network statistics.
@@ -1,5 +1,6 @@
 // Copyright (c) 2015 The btcsuite developers
-//+build generate
+// +build generate
+
 package main
 import (
 	"fmt"
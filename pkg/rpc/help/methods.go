@@ -1,5 +1,6 @@
 // Copyright (c) 2015 The btcsuite developers
-//+build !generate
+// +build !generate
+
 package rpchelp
 import (
 	"git.parallelcoin.io/dev/9/pkg/rpc/json"
@@ -39,12 +39,27 @@ func NewDebugLevelCmd(
 // GenerateCmd defines the generate JSON-RPC command.
 type GenerateCmd struct {
 	NumBlocks uint32
+	Algo      *string
 }
-// NewGenerateCmd returns a new instance which can be used to issue a generate JSON-RPC command.
+// NewGenerateCmd returns a new instance which can be used to issue a generate JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
 func NewGenerateCmd(
-	numBlocks uint32) *GenerateCmd {
+	numBlocks uint32, algo *string) *GenerateCmd {
 	return &GenerateCmd{
 		NumBlocks: numBlocks,
+		Algo:      algo,
+	}
+}
+// GetAlgoStatsCmd defines the getalgostats JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type GetAlgoStatsCmd struct {
+	Blocks *int `jsonrpcdefault:"120"`
+	Times  *int `jsonrpcdefault:"10"`
+}
+// NewGetAlgoStatsCmd returns a new instance which can be used to issue a getalgostats JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
+func NewGetAlgoStatsCmd(
+	blocks, times *int) *GetAlgoStatsCmd {
+	return &GetAlgoStatsCmd{
+		Blocks: blocks,
+		Times:  times,
 	}
 }
 // GetBestBlockCmd defines the getbestblock JSON-RPC command.
@@ -73,6 +88,24 @@ func NewGetHeadersCmd(
 		HashStop:      hashStop,
 	}
 }
+// GetServiceStatusCmd defines the getservicestatus JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type GetServiceStatusCmd struct{}
+// NewGetServiceStatusCmd returns a new instance which can be used to issue a getservicestatus JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+func NewGetServiceStatusCmd() *GetServiceStatusCmd {
+	return &GetServiceStatusCmd{}
+}
+// GetStratumInfoCmd defines the getstratuminfo JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type GetStratumInfoCmd struct{}
+// NewGetStratumInfoCmd returns a new instance which can be used to issue a getstratuminfo JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+func NewGetStratumInfoCmd() *GetStratumInfoCmd {
+	return &GetStratumInfoCmd{}
+}
+// ReloadConfigCmd defines the reloadconfig JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type ReloadConfigCmd struct{}
+// NewReloadConfigCmd returns a new instance which can be used to issue a reloadconfig JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+func NewReloadConfigCmd() *ReloadConfigCmd {
+	return &ReloadConfigCmd{}
+}
 // VersionCmd defines the version JSON-RPC command. NOTE: This is a btcsuite extension ported from github.com/decred/dcrd/dcrjson.
 type VersionCmd struct{}
 // NewVersionCmd returns a new instance which can be used to issue a JSON-RPC version command. NOTE: This is a btcsuite extension ported from
@@ -86,8 +119,12 @@ func init() {
 	MustRegisterCmd("debuglevel", (*DebugLevelCmd)(nil), flags)
 	MustRegisterCmd("node", (*NodeCmd)(nil), flags)
 	MustRegisterCmd("generate", (*GenerateCmd)(nil), flags)
+	MustRegisterCmd("getalgostats", (*GetAlgoStatsCmd)(nil), flags)
 	MustRegisterCmd("getbestblock", (*GetBestBlockCmd)(nil), flags)
 	MustRegisterCmd("getcurrentnet", (*GetCurrentNetCmd)(nil), flags)
 	MustRegisterCmd("getheaders", (*GetHeadersCmd)(nil), flags)
+	MustRegisterCmd("getservicestatus", (*GetServiceStatusCmd)(nil), flags)
+	MustRegisterCmd("getstratuminfo", (*GetStratumInfoCmd)(nil), flags)
+	MustRegisterCmd("reloadconfig", (*ReloadConfigCmd)(nil), flags)
 	MustRegisterCmd("version", (*VersionCmd)(nil), flags)
 }
@@ -96,13 +96,55 @@ func TestPodExtCmds(
 				return json.NewCmd("generate", 1)
 			},
 			staticCmd: func() interface{} {
-				return json.NewGenerateCmd(1)
+				return json.NewGenerateCmd(1, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"generate","params":[1],"id":1}`,
 			unmarshalled: &json.GenerateCmd{
 				NumBlocks: 1,
 			},
 		},
+		{
+			name: "generate optional",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("generate", 1, "skein")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGenerateCmd(1, json.String("skein"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"generate","params":[1,"skein"],"id":1}`,
+			unmarshalled: &json.GenerateCmd{
+				NumBlocks: 1,
+				Algo:      json.String("skein"),
+			},
+		},
+		{
+			name: "getalgostats",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getalgostats")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetAlgoStatsCmd(nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getalgostats","params":[],"id":1}`,
+			unmarshalled: &json.GetAlgoStatsCmd{
+				Blocks: json.Int(120),
+				Times:  json.Int(10),
+			},
+		},
+		{
+			name: "getalgostats optional",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getalgostats", 9600, 5)
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetAlgoStatsCmd(json.Int(9600), json.Int(5))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getalgostats","params":[9600,5],"id":1}`,
+			unmarshalled: &json.GetAlgoStatsCmd{
+				Blocks: json.Int(9600),
+				Times:  json.Int(5),
+			},
+		},
 		{
 			name: "getbestblock",
 			newCmd: func() (interface{}, error) {
@@ -165,6 +207,39 @@ func TestPodExtCmds(
 				HashStop: "000000000000000000ba33b33e1fad70b69e234fc24414dd47113bff38f523f7",
 			},
 		},
+		{
+			name: "getservicestatus",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getservicestatus")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetServiceStatusCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getservicestatus","params":[],"id":1}`,
+			unmarshalled: &json.GetServiceStatusCmd{},
+		},
+		{
+			name: "getstratuminfo",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getstratuminfo")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetStratumInfoCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getstratuminfo","params":[],"id":1}`,
+			unmarshalled: &json.GetStratumInfoCmd{},
+		},
+		{
+			name: "reloadconfig",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("reloadconfig")
+			},
+			staticCmd: func() interface{} {
+				return json.NewReloadConfigCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"reloadconfig","params":[],"id":1}`,
+			unmarshalled: &json.ReloadConfigCmd{},
+		},
 		{
 			name: "version",
 			newCmd: func() (interface{}, error) {
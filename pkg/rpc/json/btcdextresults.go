@@ -1,4 +1,69 @@
 package json
+// ServiceStatusResult models the state of one service in the data returned from the getservicestatus command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type ServiceStatusResult struct {
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	Restart  string `json:"restart"`
+	Restarts int    `json:"restarts"`
+	Since    int64  `json:"since"`
+	Error    string `json:"error,omitempty"`
+}
+// GetAlgoStatsResult models the data returned from the getalgostats command and sent in the algostats notification.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type GetAlgoStatsResult struct {
+	Hash   string            `json:"hash"`
+	Height int32             `json:"height"`
+	Blocks int32             `json:"blocks"`
+	Algos  []AlgoStatsResult `json:"algos"`
+}
+// AlgoStatsResult models the statistics of an algorithm in the data returned from the getalgostats command.
+type AlgoStatsResult struct {
+	Algo          string               `json:"algo"`
+	Version       int32                `json:"version"`
+	Bits          string               `json:"bits"`
+	Difficulty    float64              `json:"difficulty"`
+	BlockTimes    []int64              `json:"blocktimes"`
+	Blocks        int32                `json:"blocks"`
+	Share         float64              `json:"share"`
+	NetworkHashPS float64              `json:"networkhashps"`
+	Averagers     *AlgoAveragersResult `json:"averagers,omitempty"`
+}
+// AlgoAveragersResult models the terms of the Plan 9 difficulty averagers for the next block of an algorithm in the data returned from the getalgostats command.
+type AlgoAveragersResult struct {
+	AllTime          float64 `json:"alltime"`
+	Trailing         float64 `json:"trailing"`
+	TrailingWeighted float64 `json:"trailingweighted"`
+	AlgoWeighted     float64 `json:"algoweighted"`
+	Adjustment       float64 `json:"adjustment"`
+}
+// GetStratumInfoResult models the data returned from the getstratuminfo command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type GetStratumInfoResult struct {
+	Running   bool                    `json:"running"`
+	Listeners []StratumListenerResult `json:"listeners"`
+	Miners    int                     `json:"miners"`
+	Workers   []StratumWorkerResult   `json:"workers"`
+}
+// StratumListenerResult models a listener of the Stratum server in the data returned from the getstratuminfo command.
+type StratumListenerResult struct {
+	Address string `json:"address"`
+	Algo    string `json:"algo,omitempty"`
+}
+// StratumWorkerResult models the share accounting of a worker in the data returned from the getstratuminfo command.
+type StratumWorkerResult struct {
+	Name       string  `json:"name"`
+	Algo       string  `json:"algo"`
+	Difficulty float64 `json:"difficulty"`
+	Accepted   uint64  `json:"accepted"`
+	Rejected   uint64  `json:"rejected"`
+	Stale      uint64  `json:"stale"`
+	Blocks     uint64  `json:"blocks"`
+	Work       float64 `json:"work"`
+	LastShare  int64   `json:"lastshare"`
+}
+// ReloadConfigResult models the data returned from the reloadconfig command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type ReloadConfigResult struct {
+	Changed []string `json:"changed"`
+	Restart []string `json:"restart"`
+}
 // VersionResult models objects included in the version response.  In the actual result, these objects are keyed by the program or API name. NOTE: This is a btcsuite extension ported from github.com/decred/dcrd/dcrjson.
 type VersionResult struct {
 	VersionString string `json:"versionstring"`
@@ -198,12 +198,14 @@ func (t *TemplateRequest) UnmarshalJSON(data []byte) error {
 // GetBlockTemplateCmd defines the getblocktemplate JSON-RPC command.
 type GetBlockTemplateCmd struct {
 	Request *TemplateRequest
+	Algo    *string
 }
 // NewGetBlockTemplateCmd returns a new instance which can be used to issue a getblocktemplate JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
 func NewGetBlockTemplateCmd(
-	request *TemplateRequest) *GetBlockTemplateCmd {
+	request *TemplateRequest, algo *string) *GetBlockTemplateCmd {
 	return &GetBlockTemplateCmd{
 		Request: request,
+		Algo:    algo,
 	}
 }
 // GetCFilterCmd defines the getcfilter JSON-RPC command.
@@ -247,11 +249,11 @@ func NewGetConnectionCountCmd() *GetConnectionCountCmd {
 }
 // GetDifficultyCmd defines the getdifficulty JSON-RPC command.
 type GetDifficultyCmd struct {
-	Algo string
+	Algo *string
 }
-// NewGetDifficultyCmd returns a new instance which can be used to issue a getdifficulty JSON-RPC command.
+// NewGetDifficultyCmd returns a new instance which can be used to issue a getdifficulty JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
 func NewGetDifficultyCmd(
-	algo string) *GetDifficultyCmd {
+	algo *string) *GetDifficultyCmd {
 	return &GetDifficultyCmd{
 		Algo: algo,
 	}
@@ -274,6 +276,32 @@ type GetInfoCmd struct{}
 func NewGetInfoCmd() *GetInfoCmd {
 	return &GetInfoCmd{}
 }
+// GetMempoolAncestorsCmd defines the getmempoolancestors JSON-RPC command.
+type GetMempoolAncestorsCmd struct {
+	TxID    string
+	Verbose *bool `jsonrpcdefault:"false"`
+}
+// NewGetMempoolAncestorsCmd returns a new instance which can be used to issue a getmempoolancestors JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
+func NewGetMempoolAncestorsCmd(
+	txHash string, verbose *bool) *GetMempoolAncestorsCmd {
+	return &GetMempoolAncestorsCmd{
+		TxID:    txHash,
+		Verbose: verbose,
+	}
+}
+// GetMempoolDescendantsCmd defines the getmempooldescendants JSON-RPC command.
+type GetMempoolDescendantsCmd struct {
+	TxID    string
+	Verbose *bool `jsonrpcdefault:"false"`
+}
+// NewGetMempoolDescendantsCmd returns a new instance which can be used to issue a getmempooldescendants JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
+func NewGetMempoolDescendantsCmd(
+	txHash string, verbose *bool) *GetMempoolDescendantsCmd {
+	return &GetMempoolDescendantsCmd{
+		TxID:    txHash,
+		Verbose: verbose,
+	}
+}
 // GetMempoolEntryCmd defines the getmempoolentry JSON-RPC command.
 type GetMempoolEntryCmd struct {
 	TxID string
@@ -292,10 +320,15 @@ func NewGetMempoolInfoCmd() *GetMempoolInfoCmd {
 	return &GetMempoolInfoCmd{}
 }
 // GetMiningInfoCmd defines the getmininginfo JSON-RPC command.
-type GetMiningInfoCmd struct{}
-// NewGetMiningInfoCmd returns a new instance which can be used to issue a getmininginfo JSON-RPC command.
-func NewGetMiningInfoCmd() *GetMiningInfoCmd {
-	return &GetMiningInfoCmd{}
+type GetMiningInfoCmd struct {
+	Algo *string
+}
+// NewGetMiningInfoCmd returns a new instance which can be used to issue a getmininginfo JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
+func NewGetMiningInfoCmd(
+	algo *string) *GetMiningInfoCmd {
+	return &GetMiningInfoCmd{
+		Algo: algo,
+	}
 }
 // GetNetworkInfoCmd defines the getnetworkinfo JSON-RPC command.
 type GetNetworkInfoCmd struct{}
@@ -313,13 +346,15 @@ func NewGetNetTotalsCmd() *GetNetTotalsCmd {
 type GetNetworkHashPSCmd struct {
 	Blocks *int `jsonrpcdefault:"120"`
 	Height *int `jsonrpcdefault:"-1"`
+	Algo   *string
 }
 // NewGetNetworkHashPSCmd returns a new instance which can be used to issue a getnetworkhashps JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
 func NewGetNetworkHashPSCmd(
-	numBlocks, height *int) *GetNetworkHashPSCmd {
+	numBlocks, height *int, algo *string) *GetNetworkHashPSCmd {
 	return &GetNetworkHashPSCmd{
 		Blocks: numBlocks,
 		Height: height,
+		Algo:   algo,
 	}
 }
 // GetPeerInfoCmd defines the getpeerinfo JSON-RPC command.
@@ -511,13 +546,15 @@ type SubmitBlockOptions struct {
 type SubmitBlockCmd struct {
 	HexBlock string
 	Options  *SubmitBlockOptions
+	Algo     *string
 }
 // NewSubmitBlockCmd returns a new instance which can be used to issue a submitblock JSON-RPC command. The parameters which are pointers indicate they are optional.  Passing nil for optional parameters will use the default value.
 func NewSubmitBlockCmd(
-	hexBlock string, options *SubmitBlockOptions) *SubmitBlockCmd {
+	hexBlock string, options *SubmitBlockOptions, algo *string) *SubmitBlockCmd {
 	return &SubmitBlockCmd{
 		HexBlock: hexBlock,
 		Options:  options,
+		Algo:     algo,
 	}
 }
 // UptimeCmd defines the uptime JSON-RPC command.
@@ -599,6 +636,8 @@ func init() {
 	MustRegisterCmd("getgenerate", (*GetGenerateCmd)(nil), flags)
 	MustRegisterCmd("gethashespersec", (*GetHashesPerSecCmd)(nil), flags)
 	MustRegisterCmd("getinfo", (*GetInfoCmd)(nil), flags)
+	MustRegisterCmd("getmempoolancestors", (*GetMempoolAncestorsCmd)(nil), flags)
+	MustRegisterCmd("getmempooldescendants", (*GetMempoolDescendantsCmd)(nil), flags)
 	MustRegisterCmd("getmempoolentry", (*GetMempoolEntryCmd)(nil), flags)
 	MustRegisterCmd("getmempoolinfo", (*GetMempoolInfoCmd)(nil), flags)
 	MustRegisterCmd("getmininginfo", (*GetMiningInfoCmd)(nil), flags)
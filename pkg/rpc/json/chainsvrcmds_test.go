@@ -264,11 +264,26 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewGetBlockTemplateCmd(nil)
+				return json.NewGetBlockTemplateCmd(nil, nil)
 			},
 			marshalled:   `{"jsonrpc":"1.0","method":"getblocktemplate","params":[],"id":1}`,
 			unmarshalled: &json.GetBlockTemplateCmd{Request: nil},
 		},
+		{
+			name: "getblocktemplate optional - algo",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getblocktemplate", `{"mode":"template"}`, "x11")
+			},
+			staticCmd: func() interface{} {
+				template := json.TemplateRequest{Mode: "template"}
+				return json.NewGetBlockTemplateCmd(&template, json.String("x11"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getblocktemplate","params":[{"mode":"template"},"x11"],"id":1}`,
+			unmarshalled: &json.GetBlockTemplateCmd{
+				Request: &json.TemplateRequest{Mode: "template"},
+				Algo:    json.String("x11"),
+			},
+		},
 		{
 			name: "getblocktemplate optional - template request",
 			newCmd: func() (interface{}, error) {
@@ -281,7 +296,7 @@ func TestChainSvrCmds(
 					Mode:         "template",
 					Capabilities: []string{"longpoll", "coinbasetxn"},
 				}
-				return json.NewGetBlockTemplateCmd(&template)
+				return json.NewGetBlockTemplateCmd(&template, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getblocktemplate","params":[{"mode":"template","capabilities":["longpoll","coinbasetxn"]}],"id":1}`,
 			unmarshalled: &json.GetBlockTemplateCmd{
@@ -306,7 +321,7 @@ func TestChainSvrCmds(
 					SizeLimit:    100000000,
 					MaxVersion:   2,
 				}
-				return json.NewGetBlockTemplateCmd(&template)
+				return json.NewGetBlockTemplateCmd(&template, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getblocktemplate","params":[{"mode":"template","capabilities":["longpoll","coinbasetxn"],"sigoplimit":500,"sizelimit":100000000,"maxversion":2}],"id":1}`,
 			unmarshalled: &json.GetBlockTemplateCmd{
@@ -334,7 +349,7 @@ func TestChainSvrCmds(
 					SizeLimit:    100000000,
 					MaxVersion:   2,
 				}
-				return json.NewGetBlockTemplateCmd(&template)
+				return json.NewGetBlockTemplateCmd(&template, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getblocktemplate","params":[{"mode":"template","capabilities":["longpoll","coinbasetxn"],"sigoplimit":true,"sizelimit":100000000,"maxversion":2}],"id":1}`,
 			unmarshalled: &json.GetBlockTemplateCmd{
@@ -417,10 +432,21 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewGetDifficultyCmd("123")
+				return json.NewGetDifficultyCmd(json.String("123"))
 			},
 			marshalled:   `{"jsonrpc":"1.0","method":"getdifficulty","params":["123"],"id":1}`,
-			unmarshalled: &json.GetDifficultyCmd{Algo: "123"},
+			unmarshalled: &json.GetDifficultyCmd{Algo: json.String("123")},
+		},
+		{
+			name: "getdifficulty default algo",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getdifficulty")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetDifficultyCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getdifficulty","params":[],"id":1}`,
+			unmarshalled: &json.GetDifficultyCmd{},
 		},
 		{
 			name: "getgenerate",
@@ -461,6 +487,70 @@ func TestChainSvrCmds(
 			marshalled:   `{"jsonrpc":"1.0","method":"getinfo","params":[],"id":1}`,
 			unmarshalled: &json.GetInfoCmd{},
 		},
+		{
+			name: "getmempoolancestors",
+			newCmd: func() (interface{}, error) {
+
+				return json.NewCmd("getmempoolancestors", "txhash")
+			},
+			staticCmd: func() interface{} {
+
+				return json.NewGetMempoolAncestorsCmd("txhash", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolancestors","params":["txhash"],"id":1}`,
+			unmarshalled: &json.GetMempoolAncestorsCmd{
+				TxID:    "txhash",
+				Verbose: json.Bool(false),
+			},
+		},
+		{
+			name: "getmempoolancestors verbose",
+			newCmd: func() (interface{}, error) {
+
+				return json.NewCmd("getmempoolancestors", "txhash", true)
+			},
+			staticCmd: func() interface{} {
+
+				return json.NewGetMempoolAncestorsCmd("txhash", json.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempoolancestors","params":["txhash",true],"id":1}`,
+			unmarshalled: &json.GetMempoolAncestorsCmd{
+				TxID:    "txhash",
+				Verbose: json.Bool(true),
+			},
+		},
+		{
+			name: "getmempooldescendants",
+			newCmd: func() (interface{}, error) {
+
+				return json.NewCmd("getmempooldescendants", "txhash")
+			},
+			staticCmd: func() interface{} {
+
+				return json.NewGetMempoolDescendantsCmd("txhash", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempooldescendants","params":["txhash"],"id":1}`,
+			unmarshalled: &json.GetMempoolDescendantsCmd{
+				TxID:    "txhash",
+				Verbose: json.Bool(false),
+			},
+		},
+		{
+			name: "getmempooldescendants verbose",
+			newCmd: func() (interface{}, error) {
+
+				return json.NewCmd("getmempooldescendants", "txhash", true)
+			},
+			staticCmd: func() interface{} {
+
+				return json.NewGetMempoolDescendantsCmd("txhash", json.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getmempooldescendants","params":["txhash",true],"id":1}`,
+			unmarshalled: &json.GetMempoolDescendantsCmd{
+				TxID:    "txhash",
+				Verbose: json.Bool(true),
+			},
+		},
 		{
 			name: "getmempoolentry",
 			newCmd: func() (interface{}, error) {
@@ -497,11 +587,22 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewGetMiningInfoCmd()
+				return json.NewGetMiningInfoCmd(nil)
 			},
 			marshalled:   `{"jsonrpc":"1.0","method":"getmininginfo","params":[],"id":1}`,
 			unmarshalled: &json.GetMiningInfoCmd{},
 		},
+		{
+			name: "getmininginfo optional",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getmininginfo", "scrypt")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetMiningInfoCmd(json.String("scrypt"))
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getmininginfo","params":["scrypt"],"id":1}`,
+			unmarshalled: &json.GetMiningInfoCmd{Algo: json.String("scrypt")},
+		},
 		{
 			name: "getnetworkinfo",
 			newCmd: func() (interface{}, error) {
@@ -536,7 +637,7 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewGetNetworkHashPSCmd(nil, nil)
+				return json.NewGetNetworkHashPSCmd(nil, nil, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getnetworkhashps","params":[],"id":1}`,
 			unmarshalled: &json.GetNetworkHashPSCmd{
@@ -552,7 +653,7 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewGetNetworkHashPSCmd(json.Int(200), nil)
+				return json.NewGetNetworkHashPSCmd(json.Int(200), nil, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getnetworkhashps","params":[200],"id":1}`,
 			unmarshalled: &json.GetNetworkHashPSCmd{
@@ -568,7 +669,7 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewGetNetworkHashPSCmd(json.Int(200), json.Int(123))
+				return json.NewGetNetworkHashPSCmd(json.Int(200), json.Int(123), nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"getnetworkhashps","params":[200,123],"id":1}`,
 			unmarshalled: &json.GetNetworkHashPSCmd{
@@ -576,6 +677,21 @@ func TestChainSvrCmds(
 				Height: json.Int(123),
 			},
 		},
+		{
+			name: "getnetworkhashps optional3",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("getnetworkhashps", 200, 123, "keccak")
+			},
+			staticCmd: func() interface{} {
+				return json.NewGetNetworkHashPSCmd(json.Int(200), json.Int(123), json.String("keccak"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getnetworkhashps","params":[200,123,"keccak"],"id":1}`,
+			unmarshalled: &json.GetNetworkHashPSCmd{
+				Blocks: json.Int(200),
+				Height: json.Int(123),
+				Algo:   json.String("keccak"),
+			},
+		},
 		{
 			name: "getpeerinfo",
 			newCmd: func() (interface{}, error) {
@@ -1088,7 +1204,7 @@ func TestChainSvrCmds(
 			},
 			staticCmd: func() interface{} {
 
-				return json.NewSubmitBlockCmd("112233", nil)
+				return json.NewSubmitBlockCmd("112233", nil, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"submitblock","params":["112233"],"id":1}`,
 			unmarshalled: &json.SubmitBlockCmd{
@@ -1107,7 +1223,7 @@ func TestChainSvrCmds(
 				options := json.SubmitBlockOptions{
 					WorkID: "12345",
 				}
-				return json.NewSubmitBlockCmd("112233", &options)
+				return json.NewSubmitBlockCmd("112233", &options, nil)
 			},
 			marshalled: `{"jsonrpc":"1.0","method":"submitblock","params":["112233",{"workid":"12345"}],"id":1}`,
 			unmarshalled: &json.SubmitBlockCmd{
@@ -1117,6 +1233,21 @@ func TestChainSvrCmds(
 				},
 			},
 		},
+		{
+			name: "submitblock optional - algo",
+			newCmd: func() (interface{}, error) {
+				return json.NewCmd("submitblock", "112233", `{}`, "blake2b")
+			},
+			staticCmd: func() interface{} {
+				return json.NewSubmitBlockCmd("112233", &json.SubmitBlockOptions{}, json.String("blake2b"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"submitblock","params":["112233",{},"blake2b"],"id":1}`,
+			unmarshalled: &json.SubmitBlockCmd{
+				HexBlock: "112233",
+				Options:  &json.SubmitBlockOptions{},
+				Algo:     json.String("blake2b"),
+			},
+		},
 		{
 			name: "uptime",
 			newCmd: func() (interface{}, error) {
@@ -48,6 +48,12 @@ type GetBlockChainInfoResult struct {
 	ChainWork            string                              `json:"chainwork,omitempty"`
 	SoftForks            []*SoftForkDescription              `json:"softforks"`
 	Bip9SoftForks        map[string]*Bip9SoftForkDescription `json:"bip9_softforks"`
+	AutoCheckpoint       *AutoCheckpointResult               `json:"autocheckpoint,omitempty"`
+}
+// AutoCheckpointResult models the automatic checkpoint returned from the getblockchaininfo command.
+type AutoCheckpointResult struct {
+	Height int32  `json:"height"`
+	Hash   string `json:"hash"`
 }
 // GetBlockHeaderVerboseResult models the data from the getblockheader command when the verbose flag is set.  When the verbose flag is not set, getblockheader returns a hex-encoded string.
 type GetBlockHeaderVerboseResult struct {
@@ -134,9 +140,17 @@ type GetBlockVerboseResult struct {
 	PreviousHash  string        `json:"previousblockhash"`
 	NextHash      string        `json:"nextblockhash,omitempty"`
 }
+// GetChainTipsResult models the data returned from the getchaintips command.
+type GetChainTipsResult struct {
+	Height    int32  `json:"height"`
+	Hash      string `json:"hash"`
+	BranchLen int32  `json:"branchlen"`
+	Status    string `json:"status"`
+}
 // GetMempoolEntryResult models the data returned from the getmempoolentry command.
 type GetMempoolEntryResult struct {
 	Size             int32    `json:"size"`
+	Vsize            int32    `json:"vsize"`
 	Fee              float64  `json:"fee"`
 	ModifiedFee      float64  `json:"modifiedfee"`
 	Time             int64    `json:"time"`
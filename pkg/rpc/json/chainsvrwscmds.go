@@ -13,6 +13,18 @@ func NewAuthenticateCmd(
 		Passphrase: passphrase,
 	}
 }
+// NotifyAlgoStatsCmd defines the notifyalgostats JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type NotifyAlgoStatsCmd struct{}
+// NewNotifyAlgoStatsCmd returns a new instance which can be used to issue a notifyalgostats JSON-RPC command.
+func NewNotifyAlgoStatsCmd() *NotifyAlgoStatsCmd {
+	return &NotifyAlgoStatsCmd{}
+}
+// StopNotifyAlgoStatsCmd defines the stopnotifyalgostats JSON-RPC command.  This command is not a standard Bitcoin command.  It is an extension for pod.
+type StopNotifyAlgoStatsCmd struct{}
+// NewStopNotifyAlgoStatsCmd returns a new instance which can be used to issue a stopnotifyalgostats JSON-RPC command.
+func NewStopNotifyAlgoStatsCmd() *StopNotifyAlgoStatsCmd {
+	return &StopNotifyAlgoStatsCmd{}
+}
 // NotifyBlocksCmd defines the notifyblocks JSON-RPC command.
 type NotifyBlocksCmd struct{}
 // NewNotifyBlocksCmd returns a new instance which can be used to issue a notifyblocks JSON-RPC command.
@@ -144,11 +156,13 @@ func init() {
 	flags := UFWebsocketOnly
 	MustRegisterCmd("authenticate", (*AuthenticateCmd)(nil), flags)
 	MustRegisterCmd("loadtxfilter", (*LoadTxFilterCmd)(nil), flags)
+	MustRegisterCmd("notifyalgostats", (*NotifyAlgoStatsCmd)(nil), flags)
 	MustRegisterCmd("notifyblocks", (*NotifyBlocksCmd)(nil), flags)
 	MustRegisterCmd("notifynewtransactions", (*NotifyNewTransactionsCmd)(nil), flags)
 	MustRegisterCmd("notifyreceived", (*NotifyReceivedCmd)(nil), flags)
 	MustRegisterCmd("notifyspent", (*NotifySpentCmd)(nil), flags)
 	MustRegisterCmd("session", (*SessionCmd)(nil), flags)
+	MustRegisterCmd("stopnotifyalgostats", (*StopNotifyAlgoStatsCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyblocks", (*StopNotifyBlocksCmd)(nil), flags)
 	MustRegisterCmd("stopnotifynewtransactions", (*StopNotifyNewTransactionsCmd)(nil), flags)
 	MustRegisterCmd("stopnotifyspent", (*StopNotifySpentCmd)(nil), flags)
@@ -1,6 +1,8 @@
 // NOTE: This file is intended to house the RPC websocket notifications that are supported by a chain server.
 package json
 const (
+	// AlgoStatsNtfnMethod is the method used for notifications from the chain server of the statistics of the algorithms after a block has been connected.  This is an extension for pod.
+	AlgoStatsNtfnMethod = "algostats"
 	// BlockConnectedNtfnMethod is the legacy, deprecated method used for notifications from the chain server that a block has been connected. NOTE: Deprecated. Use FilteredBlockConnectedNtfnMethod instead.
 	BlockConnectedNtfnMethod = "blockconnected"
 	// BlockDisconnectedNtfnMethod is the legacy, deprecated method used for notifications from the chain server that a block has been disconnected. NOTE: Deprecated. Use FilteredBlockDisconnectedNtfnMethod instead.
@@ -24,6 +26,17 @@ const (
 	// RelevantTxAcceptedNtfnMethod is the new method used for notifications from the chain server that inform a client that a transaction that matches the loaded filter was accepted by the mempool.
 	RelevantTxAcceptedNtfnMethod = "relevanttxaccepted"
 )
+// AlgoStatsNtfn defines the algostats JSON-RPC notification.
+type AlgoStatsNtfn struct {
+	Stats GetAlgoStatsResult
+}
+// NewAlgoStatsNtfn returns a new instance which can be used to issue an algostats JSON-RPC notification.
+func NewAlgoStatsNtfn(
+	stats GetAlgoStatsResult) *AlgoStatsNtfn {
+	return &AlgoStatsNtfn{
+		Stats: stats,
+	}
+}
 // BlockConnectedNtfn defines the blockconnected JSON-RPC notification. NOTE: Deprecated. Use FilteredBlockConnectedNtfn instead.
 type BlockConnectedNtfn struct {
 	Hash   string
@@ -181,6 +194,7 @@ func NewRelevantTxAcceptedNtfn(
 func init() {
 	// The commands in this file are only usable by websockets and are notifications.
 	flags := UFWebsocketOnly | UFNotification
+	MustRegisterCmd(AlgoStatsNtfnMethod, (*AlgoStatsNtfn)(nil), flags)
 	MustRegisterCmd(BlockConnectedNtfnMethod, (*BlockConnectedNtfn)(nil), flags)
 	MustRegisterCmd(BlockDisconnectedNtfnMethod, (*BlockDisconnectedNtfn)(nil), flags)
 	MustRegisterCmd(FilteredBlockConnectedNtfnMethod, (*FilteredBlockConnectedNtfn)(nil), flags)
@@ -22,6 +22,49 @@ func TestChainSvrWsNtfns(
 		marshalled   string
 		unmarshalled interface{}
 	}{
+		{
+			name: "algostats",
+			newNtfn: func() (interface{}, error) {
+
+				return json.NewCmd("algostats", `{"hash":"123","height":100000,"blocks":120,"algos":[{"algo":"x11","version":8,"bits":"1d00ffff","difficulty":1,"blocktimes":[72,90],"blocks":13,"share":0.1083,"networkhashps":1000}]}`)
+			},
+			staticNtfn: func() interface{} {
+
+				return json.NewAlgoStatsNtfn(json.GetAlgoStatsResult{
+					Hash:   "123",
+					Height: 100000,
+					Blocks: 120,
+					Algos: []json.AlgoStatsResult{{
+						Algo:          "x11",
+						Version:       8,
+						Bits:          "1d00ffff",
+						Difficulty:    1,
+						BlockTimes:    []int64{72, 90},
+						Blocks:        13,
+						Share:         0.1083,
+						NetworkHashPS: 1000,
+					}},
+				})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"algostats","params":[{"hash":"123","height":100000,"blocks":120,"algos":[{"algo":"x11","version":8,"bits":"1d00ffff","difficulty":1,"blocktimes":[72,90],"blocks":13,"share":0.1083,"networkhashps":1000}]}],"id":null}`,
+			unmarshalled: &json.AlgoStatsNtfn{
+				Stats: json.GetAlgoStatsResult{
+					Hash:   "123",
+					Height: 100000,
+					Blocks: 120,
+					Algos: []json.AlgoStatsResult{{
+						Algo:          "x11",
+						Version:       8,
+						Bits:          "1d00ffff",
+						Difficulty:    1,
+						BlockTimes:    []int64{72, 90},
+						Blocks:        13,
+						Share:         0.1083,
+						NetworkHashPS: 1000,
+					}},
+				},
+			},
+		},
 		{
 			name: "blockconnected",
 			newNtfn: func() (interface{}, error) {
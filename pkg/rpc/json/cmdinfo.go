@@ -186,3 +186,20 @@ func MethodUsageText(
 	registerLock.Unlock()
 	return usage, nil
 }
+// MethodParamNames returns the names of the parameters of the provided method in the order they are given, the same names its usage shows.  The provided method must be associated with a registered type.
+func MethodParamNames(
+	method string) ([]string, error) {
+	registerLock.RLock()
+	rtp, ok := methodToConcreteType[method]
+	registerLock.RUnlock()
+	if !ok {
+		str := fmt.Sprintf("%q is not registered", method)
+		return nil, makeError(ErrUnregisteredMethod, str)
+	}
+	rt := rtp.Elem()
+	names := make([]string, rt.NumField())
+	for i := range names {
+		names[i] = strings.ToLower(rt.Field(i).Name)
+	}
+	return names, nil
+}
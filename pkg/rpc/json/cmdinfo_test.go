@@ -176,6 +176,25 @@ func TestMethodUsageText(
 		}
 	}
 }
+// TestMethodParamNames tests the MethodParamNames function to ensure it returns the names of the parameters in order and errors for unregistered methods.
+func TestMethodParamNames(
+	t *testing.T) {
+	t.Parallel()
+	names, err := json.MethodParamNames("getblock")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"hash", "verbose", "verbosetx"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("mismatched names - got %v, want %v", names, want)
+	}
+	if names, _ = json.MethodParamNames("getblockcount"); len(names) != 0 {
+		t.Errorf("got names %v for a method without parameters", names)
+	}
+	_, err = json.MethodParamNames("bogusmethod")
+	if jerr, ok := err.(json.Error); !ok || jerr.ErrorCode != json.ErrUnregisteredMethod {
+		t.Errorf("got error %v, want %v", err, json.ErrUnregisteredMethod)
+	}
+}
 // TestFieldUsage tests the internal fieldUsage function ensure it returns the expected text.
 func TestFieldUsage(
 	t *testing.T) {
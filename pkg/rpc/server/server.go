@@ -704,7 +704,7 @@ func (s *loaderServer) StartConsensusRPC(ctx context.Context, req *pb.StartConse
 			"wallet is loaded and already synchronizing")
 	}
 	rpcClient, err := chain.NewRPCClient(s.activeNet.Params, networkAddress, req.Username,
-		string(req.Password), req.Certificate, len(req.Certificate) == 0, 1)
+		string(req.Password), req.Certificate, nil, nil, len(req.Certificate) == 0, 1)
 	if err != nil {
 		return nil, translateError(err)
 	}
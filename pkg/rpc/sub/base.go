@@ -1,25 +1,61 @@
 package sub
 import (
+	"crypto/rand"
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"hash/crc32"
-	"math/rand"
 	"net"
 	"time"
+	"golang.org/x/crypto/ed25519"
 )
 // Implementations of common parts for node and worker
+var (
+	// ErrStale is returned for messages with a timestamp outside of the replay window
+	ErrStale = errors.New("message timestamp is outside of the replay window")
+	// ErrUnknownSender is returned for messages from a sender whose key is not known
+	ErrUnknownSender = errors.New("message sender is not known")
+	// ErrReplayed is returned for messages with an id already received from the key that signed them
+	ErrReplayed = errors.New("message was already received")
+)
 // NewBase creates a new base listener
 func NewBase(
-	cfg BaseCfg) (b *Base) {
-	b = &Base{
-		cfg:       cfg,
-		packets:   make(chan Packet, baseChanBufs),
-		incoming:  make(chan Bundle, baseChanBufs),
-		returning: make(chan Bundle, baseChanBufs),
-		trash:     make(chan Bundle),
-		quit:      make(chan bool),
+	cfg BaseCfg) (b *Base, err error) {
+	b = new(Base)
+	err = b.init(cfg)
+	return
+}
+// init derives the keys from the password and sets up the state of a Base
+func (b *Base) init(
+	cfg BaseCfg) (err error) {
+	if cfg.BufferSize == 0 {
+		cfg.BufferSize = defaultBufferSize
+	}
+	if cfg.Required == 0 && cfg.Total == 0 {
+		cfg.Required, cfg.Total = rsRequired, rsTotal
+	}
+	if _, err = getFEC(cfg.Required, cfg.Total); err != nil {
+		return
+	}
+	if cfg.MaxMessageSize == 0 {
+		cfg.MaxMessageSize = defaultMaxMessageSize
 	}
+	if cfg.MaxMessageSize/bundleCapacity(cfg.Required) >= 1<<16 {
+		return fmt.Errorf("maximum message size %d needs too many bundles",
+			cfg.MaxMessageSize)
+	}
+	if cfg.Key == nil {
+		if cfg.Key, err = newKey(); err != nil {
+			return
+		}
+	}
+	if b.keys, err = deriveKeys(cfg.Password); err != nil {
+		return
+	}
+	b.cfg = cfg
+	b.partials = make(map[bundleID]*partial)
+	b.seen = make(map[bundleID]time.Time)
+	b.nonces = make(map[nonceID]time.Time)
+	b.keyFor = func(byte, string, []byte) ed25519.PublicKey { return nil }
 	return
 }
 // Start attempts to open a listener and commences receiving packets and assembling them into messages
@@ -27,159 +63,304 @@ func (b *Base) Start() (err error) {
 	var addr *net.UDPAddr
 	addr, err = net.ResolveUDPAddr(uNet, b.cfg.Listener)
 	if err != nil {
-		panic(err)
+		return
 	}
 	b.listener, err = net.ListenUDP(uNet, addr)
 	if err != nil {
-		panic(err)
+		return
 	}
-	// Start up reader to push packets into packet channel
+	b.quit = make(chan struct{})
+	b.wg.Add(2)
+	// Start up reader to push packets into bundles, and the collector for expired bundles
 	go b.readFromSocket()
-	go b.processPackets()
-	go b.processBundles()
-	go func() {
-		for {
-			select {
-			case <-b.quit:
-				// fmt.Println("chan:<-b.quit")
-				break
-			default:
-			}
-			select {
-			case msg := <-b.message:
-				// fmt.Println("chan:msg := <-b.message")
-				go b.cfg.Handler(msg)
-				continue
-			default:
-			}
-		}
-	}()
+	go b.collectGarbage()
 	return
 }
 // Stop shuts down the listener
 func (b *Base) Stop() {
-	b.quit <- true
+	close(b.quit)
 	b.listener.Close()
+	b.wg.Wait()
+}
+// Addr returns the address the listener is bound to
+func (b *Base) Addr() *net.UDPAddr {
+	return b.listener.LocalAddr().(*net.UDPAddr)
+}
+// MaxPayload returns the largest payload that can be sent in one message
+func (b *Base) MaxPayload() int {
+	return b.cfg.MaxMessageSize - sealOverhead - headerLen - ed25519.SignatureSize
+}
+// PublicKey returns the key that messages from this Base can be verified with
+func (b *Base) PublicKey() ed25519.PublicKey {
+	return b.cfg.Key.Public().(ed25519.PublicKey)
 }
 func (b *Base) readFromSocket() {
+	defer b.wg.Done()
 	for {
-		select {
-		case <-b.quit:
-			break
-		default:
-		}
 		var data = make([]byte, b.cfg.BufferSize)
-		count, _, err := b.listener.ReadFromUDP(data[0:])
+		count, addr, err := b.listener.ReadFromUDP(data[0:])
 		if err != nil {
-			continue
-		}
-		if count > 12 {
-			data = data[:count]
-			sender := string(data[:6])
-			body := data[:count-4]
-			check := data[count-4:]
-			checkSum := binary.LittleEndian.Uint32(check)
-			cs := crc32.Checksum(body, crc32.MakeTable(crc32.Castagnoli))
-			if cs != checkSum {
-				continue
-			}
-			b.packets <- Packet{
-				sender: sender,
-				bytes:  data,
+			select {
+			case <-b.quit:
+				return
+			default:
 			}
+			continue
 		}
+		b.processPacket(Packet{
+			sender: addr.String(),
+			bytes:  data[:count],
+		}, addr)
 	}
 }
-func (b *Base) processPackets() {
-	for {
-		select {
-		case <-b.quit:
-			break
-		default:
+// processPacket authenticates a packet and adds it to the bundle it belongs to, decodes the bundle once enough packets have arrived, and processes the message once all of its bundles are decoded
+func (b *Base) processPacket(
+	p Packet, addr *net.UDPAddr) {
+	body, ok := b.keys.checkPacket(p.bytes)
+	// a chunk has at least the share number and checksum
+	if !ok || len(body) < packetHeaderLen+5 {
+		return
+	}
+	id := bundleID{
+		sender: p.sender,
+		uuid:   binary.LittleEndian.Uint32(body[:uuidLen]),
+	}
+	index := int(binary.LittleEndian.Uint16(body[uuidLen:]))
+	count := int(binary.LittleEndian.Uint16(body[uuidLen+2:]))
+	required, total := int(body[uuidLen+4]), int(body[uuidLen+5])
+	chunk := body[packetHeaderLen:]
+	if index >= count || required < 1 || required > total ||
+		(count-1)*bundleCapacity(required) >= b.cfg.MaxMessageSize {
+		return
+	}
+	b.mx.Lock()
+	if _, done := b.seen[id]; done {
+		b.mx.Unlock()
+		return
+	}
+	msg, ok := b.partials[id]
+	if !ok {
+		msg = &partial{
+			uuid:     id.uuid,
+			sender:   p.sender,
+			required: required,
+			total:    total,
+			bundles:  make([]*Bundle, count),
 		}
-		select {
-		case p := <-b.packets:
-			sender := string(p.bytes[:6])
-			go func() {
-				for {
-					select {
-					case <-b.doneRet:
-						for i := range b.returning {
-							b.incoming <- i
-						}
-						break
-					case <-b.returning:
-						continue
-					case <-b.trash:
-						continue
-					}
-				}
-			}()
-			for bi := range b.incoming {
-				if bi.sender == sender {
-					bi.packets = append(bi.packets, p.bytes)
-					b.returning <- bi
-					break
-				}
-				if len(bi.packets) > 2 {
-					b.incoming <- bi
-					continue
-				}
-				if bi.received.Sub(time.Now()) > latencyMax {
-					b.trash <- bi
-					break
-				} else {
-					b.incoming <- bi
-				}
-				b.doneRet <- true
-			}
-			continue
+		b.partials[id] = msg
+	}
+	msg.updated = time.Now()
+	if len(msg.bundles) != count || msg.required != required ||
+		msg.total != total {
+		b.mx.Unlock()
+		return
+	}
+	bundle := msg.bundles[index]
+	if bundle == nil {
+		bundle = &Bundle{
+			uuid:     id.uuid,
+			sender:   p.sender,
+			received: msg.updated,
 		}
+		msg.bundles[index] = bundle
+	}
+	if bundle.data != nil {
+		b.mx.Unlock()
+		return
+	}
+	for _, x := range bundle.packets {
+		// the first byte of a chunk is the share number
+		if x[0] == chunk[0] {
+			b.mx.Unlock()
+			return
+		}
+	}
+	bundle.packets = append(bundle.packets, chunk)
+	if len(bundle.packets) < required {
+		b.mx.Unlock()
+		return
+	}
+	data, err := rsDecode(bundle.packets, required, total)
+	if err == nil {
+		bundle.data, err = unpadData(data)
+	}
+	if err != nil {
+		// the message cannot be recovered
+		delete(b.partials, id)
+		b.seen[id] = time.Now()
+		b.mx.Unlock()
+		return
+	}
+	bundle.packets = nil
+	msg.complete++
+	if msg.complete < len(msg.bundles) {
+		b.mx.Unlock()
+		return
+	}
+	delete(b.partials, id)
+	b.seen[id] = time.Now()
+	b.mx.Unlock()
+	msgType, m, err := b.processMessage(msg)
+	if err != nil {
+		return
+	}
+	if b.control != nil && b.control(msgType, addr, m.bytes) {
+		return
+	}
+	if msgType == msgData && b.cfg.Handler != nil {
+		go b.cfg.Handler(m)
 	}
 }
-func (b *Base) processBundles() {
+// processMessage joins the bundles of a message, decrypts and verifies it
+func (b *Base) processMessage(
+	p *partial) (msgType byte, msg Message, err error) {
+	var sealed []byte
+	for _, x := range p.bundles {
+		sealed = append(sealed, x.data...)
+	}
+	uuid := make([]byte, uuidLen)
+	binary.LittleEndian.PutUint32(uuid, p.uuid)
+	plain, err := b.keys.open(sealed, uuid)
+	if err != nil {
+		return
+	}
+	if len(plain) < headerLen+ed25519.SignatureSize {
+		err = errors.New("message is too short")
+		return
+	}
+	msgType = plain[0]
+	timestamp := time.Unix(0, int64(binary.LittleEndian.Uint64(plain[1:9])))
+	if binary.LittleEndian.Uint32(plain[9:headerLen]) != p.uuid {
+		err = errors.New("message id does not match bundle")
+		return
+	}
+	if skew := time.Since(timestamp); skew > replayWindow || skew < -replayWindow {
+		err = ErrStale
+		return
+	}
+	payload := plain[headerLen : len(plain)-ed25519.SignatureSize]
+	pub := b.keyFor(msgType, p.sender, payload)
+	if pub == nil {
+		err = ErrUnknownSender
+		return
+	}
+	if _, err = verify(pub, plain); err != nil {
+		return
+	}
+	// the id is signed, so a copy of the message sent from another address is caught here
+	id := nonceID{key: string(pub), uuid: p.uuid}
+	b.mx.Lock()
+	_, replayed := b.nonces[id]
+	if !replayed {
+		b.nonces[id] = time.Now()
+	}
+	b.mx.Unlock()
+	if replayed {
+		err = ErrReplayed
+		return
+	}
+	msg = Message{
+		uuid:      p.uuid,
+		sender:    p.sender,
+		timestamp: timestamp,
+		bytes:     payload,
+	}
+	return
+}
+// collectGarbage discards partial messages that have not received a packet within the latency maximum, and forgets the ids of messages that are too old to pass the replay window anyway
+func (b *Base) collectGarbage() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(latencyMax)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-b.quit:
-			break
-		default:
+			return
+		case <-ticker.C:
 		}
-		var uuid int32
-		select {
-		case bundle := <-b.incoming:
-			data, err := rsDecode(bundle.packets)
-			if err == nil &&
-				bundle.uuid != uuid {
-				rand.Seed(time.Now().Unix())
-				uuid = rand.Int31()
-				b.message <- Message{
-					uuid:      bundle.uuid,
-					sender:    bundle.sender,
-					timestamp: bundle.received,
-					bytes:     data,
-				}
-				uuid = bundle.uuid
-				b.trash <- bundle
+		b.mx.Lock()
+		for i, x := range b.partials {
+			if time.Since(x.updated) > latencyMax {
+				delete(b.partials, i)
 			}
 		}
+		for i, x := range b.seen {
+			if time.Since(x) > replayWindow*2 {
+				delete(b.seen, i)
+			}
+		}
+		for i, x := range b.nonces {
+			if time.Since(x) > replayWindow*2 {
+				delete(b.nonces, i)
+			}
+		}
+		b.mx.Unlock()
 	}
 }
-// Send a message of up to maxMessageSize bytes to a given UDP address
-func (b *Base) Send(data []byte, addr *net.UDPAddr) (err error) {
-	if len(data) > 3072 {
-		err = errors.New("maximum message size is " + fmt.Sprint(maxMessageSize) + " bytes")
+// encode signs and encrypts a message, splits it into as many bundles as needed and encodes those into authenticated packets
+func (b *Base) encode(
+	msgType byte, data []byte, timestamp time.Time) (packets [][]byte, err error) {
+	if len(data) > b.MaxPayload() {
+		err = fmt.Errorf("%v: maximum message size is %d bytes",
+			ErrTooLarge, b.MaxPayload())
+		return
 	}
-	addr, err = net.ResolveUDPAddr(uNet, addr.String())
-	if err != nil {
-		panic(err)
+	uuid := make([]byte, uuidLen)
+	if _, err = rand.Read(uuid); err != nil {
+		return
 	}
-	conn, err := net.DialUDP(uNet, nil, addr)
+	plain := make([]byte, headerLen, headerLen+len(data)+ed25519.SignatureSize)
+	plain[0] = msgType
+	binary.LittleEndian.PutUint64(plain[1:9], uint64(timestamp.UnixNano()))
+	copy(plain[9:headerLen], uuid)
+	plain = sign(b.cfg.Key, append(plain, data...))
+	sealed, err := b.keys.seal(plain, uuid)
 	if err != nil {
-		panic(err)
+		return
 	}
-	_, err = conn.Write(data)
+	capacity := bundleCapacity(b.cfg.Required)
+	count := (len(sealed) + capacity - 1) / capacity
+	header := make([]byte, packetHeaderLen)
+	copy(header, uuid)
+	binary.LittleEndian.PutUint16(header[uuidLen+2:], uint16(count))
+	header[uuidLen+4], header[uuidLen+5] = byte(b.cfg.Required), byte(b.cfg.Total)
+	for i := 0; i < count; i++ {
+		end := (i + 1) * capacity
+		if end > len(sealed) {
+			end = len(sealed)
+		}
+		var chunks [][]byte
+		chunks, err = rsEncode(sealed[i*capacity:end], b.cfg.Required, b.cfg.Total)
+		if err != nil {
+			return nil, err
+		}
+		binary.LittleEndian.PutUint16(header[uuidLen:], uint16(i))
+		for _, chunk := range chunks {
+			packet := append(append([]byte{}, header...), chunk...)
+			packets = append(packets, b.keys.signPacket(packet))
+		}
+	}
+	return
+}
+// send encodes a message and sends its packets from the listener to a given UDP address
+func (b *Base) send(
+	msgType byte, data []byte, addr *net.UDPAddr) (err error) {
+	packets, err := b.encode(msgType, data, time.Now())
 	if err != nil {
-		panic(err)
+		return
+	}
+	for i, x := range packets {
+		// pause between bundles so long messages don't overrun the receive buffer of the other end
+		if i > 0 && i%b.cfg.Total == 0 {
+			time.Sleep(bundleInterval)
+		}
+		if _, err = b.listener.WriteToUDP(x, addr); err != nil {
+			return
+		}
 	}
 	return
 }
+// Send a message of up to MaxPayload bytes to a given UDP address
+func (b *Base) Send(data []byte, addr *net.UDPAddr) (err error) {
+	return b.send(msgData, data, addr)
+}
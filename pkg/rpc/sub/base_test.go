@@ -1,7 +1,319 @@
 package sub
 import (
+	"bytes"
+	"net"
 	"testing"
+	"time"
 )
+var testPassword = []byte("pa55word")
+// collector returns a handler that sends received payloads to a channel
+func collector() (chan []byte, func(Message)) {
+	c := make(chan []byte, 16)
+	return c, func(m Message) {
+		c <- m.Bytes()
+	}
+}
+// expect waits for a message with the given payload
+func expect(
+	t *testing.T, c chan []byte, want []byte) {
+	select {
+	case got := <-c:
+		if !bytes.Equal(got, want) {
+			t.Fatalf("got message of %d bytes '%.32s', expected %d bytes '%.32s'",
+				len(got), got, len(want), want)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("message of %d bytes '%.32s' was not delivered", len(want), want)
+	}
+}
+// expectNone checks that no message is delivered
+func expectNone(
+	t *testing.T, c chan []byte, what string) {
+	select {
+	case got := <-c:
+		t.Fatalf("%s was delivered: '%s'", what, got)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+// newPair starts a node and a worker subscribed to it
+func newPair(
+	t *testing.T) (n *Node, nc chan []byte, w *Worker, wc chan []byte) {
+	nc, nh := collector()
+	n, err := NewNode(BaseCfg{
+		Handler:  nh,
+		Listener: "127.0.0.1:0",
+		Password: testPassword,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = n.Start(); err != nil {
+		t.Fatal(err)
+	}
+	wc, wh := collector()
+	w, err = NewWorker(BaseCfg{
+		Handler:  wh,
+		Listener: "127.0.0.1:0",
+		Password: testPassword,
+	}, n.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Subscribe(time.Second * 5); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w.NodeKey(), n.PublicKey()) {
+		t.Fatal("worker did not learn the node key")
+	}
+	return
+}
 func TestBase(
 	t *testing.T) {
+	n, nc, w, wc := newPair(t)
+	defer n.Stop()
+	defer w.Stop()
+	if s := n.Subscribers(); len(s) != 1 || s[0] != w.Addr().String() {
+		t.Fatalf("node has subscribers %v, expected %s", s, w.Addr())
+	}
+	if err := n.Publish([]byte("new work")); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, wc, []byte("new work"))
+	if err := w.Send([]byte("solution")); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, nc, []byte("solution"))
+	if err := n.Publish(make([]byte, n.MaxPayload()+1)); err == nil {
+		t.Fatal("oversized message was accepted")
+	}
+	if err := w.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond * 100)
+	if s := n.Subscribers(); len(s) != 0 {
+		t.Fatalf("node still has subscribers %v after unsubscribe", s)
+	}
+}
+func TestReplay(
+	t *testing.T) {
+	n, _, w, wc := newPair(t)
+	defer n.Stop()
+	defer w.Stop()
+	from := n.Addr()
+	packets, err := n.encode(msgData, []byte("once"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range packets {
+		w.processPacket(Packet{sender: from.String(), bytes: x}, from)
+	}
+	expect(t, wc, []byte("once"))
+	// the same packets again are rejected by the message id
+	for _, x := range packets {
+		w.processPacket(Packet{sender: from.String(), bytes: x}, from)
+	}
+	expectNone(t, wc, "replayed message")
+	// a message stamped outside the replay window is rejected even once the id is forgotten
+	packets, err = n.encode(msgData, []byte("stale"), time.Now().Add(-replayWindow*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range packets {
+		w.processPacket(Packet{sender: from.String(), bytes: x}, from)
+	}
+	expectNone(t, wc, "stale message")
+}
+func TestSpoof(
+	t *testing.T) {
+	n, _, w, wc := newPair(t)
+	defer n.Stop()
+	defer w.Stop()
+	from := n.Addr()
+	deliver := func(b *Base, payload string) {
+		packets, err := b.encode(msgData, []byte(payload), time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, x := range packets {
+			w.processPacket(Packet{sender: from.String(), bytes: x}, from)
+		}
+	}
+	// without the password the packets fail authentication
+	outsider, err := NewBase(BaseCfg{Password: []byte("guess")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliver(outsider, "outsider")
+	expectNone(t, wc, "message with wrong password")
+	// with the password but not the node's key the signature fails
+	insider, err := NewBase(BaseCfg{Password: testPassword})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliver(insider, "insider")
+	expectNone(t, wc, "message signed by another key")
+	// a tampered packet is dropped, and the message is still recovered from the others
+	packets, err := n.encode(msgData, []byte("tampered"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	packets[0][uuidLen+3] ^= 0xff
+	for _, x := range packets[:4] {
+		w.processPacket(Packet{sender: from.String(), bytes: x}, from)
+	}
+	expect(t, wc, []byte("tampered"))
+	// messages from an address that is not the node are rejected
+	other := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 2), Port: from.Port}
+	packets, err = n.encode(msgData, []byte("elsewhere"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range packets {
+		w.processPacket(Packet{sender: other.String(), bytes: x}, other)
+	}
+	expectNone(t, wc, "message from another address")
+}
+func TestSubscriptions(
+	t *testing.T) {
+	n, _, w, _ := newPair(t)
+	defer n.Stop()
+	defer w.Stop()
+	from := w.Addr()
+	// a captured subscription sent again from another address is rejected by its signed id
+	packets, err := w.encode(msgSubscribe, w.PublicKey(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range packets {
+		n.processPacket(Packet{sender: from.String(), bytes: x}, from)
+	}
+	spoofed := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 2), Port: from.Port}
+	for _, x := range packets {
+		n.processPacket(Packet{sender: spoofed.String(), bytes: x}, spoofed)
+	}
+	time.Sleep(time.Millisecond * 100)
+	if s := n.Subscribers(); len(s) != 1 || s[0] != from.String() {
+		t.Fatalf("node has subscribers %v, expected %s", s, from)
+	}
+	// a subscription that is not heard from within the timeout is dropped
+	n.subsMtx.Lock()
+	n.subscribers[string(w.PublicKey())].seen = time.Now().Add(-subscriptionTimeout * 2)
+	n.subsMtx.Unlock()
+	if s := n.Subscribers(); len(s) != 0 {
+		t.Fatalf("node still has expired subscribers %v", s)
+	}
+}
+func TestLostShards(
+	t *testing.T) {
+	n, _, w, wc := newPair(t)
+	defer n.Stop()
+	defer w.Stop()
+	from := n.Addr()
+	packets, err := n.encode(msgData, []byte("lossy"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// two packets are not enough
+	for _, i := range []int{8, 2} {
+		w.processPacket(Packet{sender: from.String(), bytes: packets[i]}, from)
+	}
+	expectNone(t, wc, "message from two packets")
+	// a duplicate of a packet already received does not count
+	w.processPacket(Packet{sender: from.String(), bytes: packets[2]}, from)
+	expectNone(t, wc, "message from duplicated packet")
+	// any third packet completes the message
+	w.processPacket(Packet{sender: from.String(), bytes: packets[5]}, from)
+	expect(t, wc, []byte("lossy"))
+	// partial messages are discarded after the latency maximum
+	packets, err = n.encode(msgData, []byte("partial"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.processPacket(Packet{sender: from.String(), bytes: packets[0]}, from)
+	time.Sleep(latencyMax * 3)
+	w.mx.Lock()
+	pending := len(w.partials)
+	w.mx.Unlock()
+	if pending != 0 {
+		t.Fatalf("%d partial messages were not discarded", pending)
+	}
+}
+func TestLargeMessage(
+	t *testing.T) {
+	n, _, w, wc := newPair(t)
+	defer n.Stop()
+	defer w.Stop()
+	big := make([]byte, 100000)
+	for i := range big {
+		big[i] = byte(i * 7)
+	}
+	if err := n.Publish(big); err != nil {
+		t.Fatal(err)
+	}
+	expect(t, wc, big)
+	// any 3 packets of each bundle in any order recover the message
+	from := n.Addr()
+	packets, err := n.encode(msgData, big[:20000], time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packets) <= rsTotal {
+		t.Fatalf("message was not split, got %d packets", len(packets))
+	}
+	for i := len(packets) - 1; i >= 0; i-- {
+		if i%rsTotal < 6 {
+			continue
+		}
+		w.processPacket(Packet{sender: from.String(), bytes: packets[i]}, from)
+	}
+	expect(t, wc, big[:20000])
+}
+func TestRedundancyRatio(
+	t *testing.T) {
+	nc, nh := collector()
+	n, err := NewNode(BaseCfg{
+		Handler:  nh,
+		Password: testPassword,
+		Required: 2,
+		Total:    4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 12345}
+	sender, err := NewBase(BaseCfg{
+		Password: testPassword,
+		Required: 2,
+		Total:    4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 5000)
+	dataPackets, err := sender.encode(msgData, data, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dataPackets)%4 != 0 {
+		t.Fatalf("got %d packets, expected a multiple of 4", len(dataPackets))
+	}
+	n.subscribers[string(sender.PublicKey())] = &Subscription{
+		address: from.String(),
+		addr:    from,
+		pubKey:  sender.PublicKey(),
+		seen:    time.Now(),
+	}
+	// half of the packets of each bundle are enough
+	for i, x := range dataPackets {
+		if i%4 == 1 || i%4 == 2 {
+			n.processPacket(Packet{sender: from.String(), bytes: x}, from)
+		}
+	}
+	expect(t, nc, data)
+	if _, err = NewBase(BaseCfg{Password: testPassword, Required: 5, Total: 4}); err == nil {
+		t.Fatal("invalid redundancy ratio was accepted")
+	}
 }
@@ -0,0 +1,84 @@
+package sub
+// AES-256-GCM authenticated encryption of messages and HMAC authentication of packets, with keys derived from the pre-shared password
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"golang.org/x/crypto/argon2"
+)
+var (
+	// kdfSalt separates keys derived for this protocol from other uses of the same password
+	kdfSalt = []byte("parallelcoin/sub")
+	// packetMACLen is the length of the truncated HMAC-SHA256 on every packet
+	packetMACLen = 16
+	// ErrDecrypt is returned when a message fails authenticated decryption
+	ErrDecrypt = errors.New("message failed authenticated decryption")
+)
+// keys are the symmetric keys derived from the password
+type keys struct {
+	aead      cipher.AEAD
+	packetKey []byte
+}
+// deriveKeys stretches the password with Argon2id into a key for message encryption and a key for authenticating packets
+func deriveKeys(
+	password []byte) (k *keys, err error) {
+	if len(password) == 0 {
+		return nil, errors.New("a password is required")
+	}
+	material := argon2.IDKey(password, kdfSalt, 1, 32*1024, 2, 64)
+	block, err := aes.NewCipher(material[:32])
+	if err != nil {
+		return
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return
+	}
+	return &keys{aead: aead, packetKey: material[32:]}, nil
+}
+// seal encrypts and authenticates a message, binding it to the additional data, and prefixes the random nonce
+func (k *keys) seal(
+	plaintext, additional []byte) (out []byte, err error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return
+	}
+	return k.aead.Seal(nonce, nonce, plaintext, additional), nil
+}
+// open checks and decrypts a message produced by seal
+func (k *keys) open(
+	sealed, additional []byte) (plaintext []byte, err error) {
+	ns := k.aead.NonceSize()
+	if len(sealed) < ns+k.aead.Overhead() {
+		return nil, ErrDecrypt
+	}
+	plaintext, err = k.aead.Open(nil, sealed[:ns], sealed[ns:], additional)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return
+}
+// packetMAC computes the truncated authentication code of a packet body
+func (k *keys) packetMAC(
+	body []byte) []byte {
+	mac := hmac.New(sha256.New, k.packetKey)
+	_, _ = mac.Write(body)
+	return mac.Sum(nil)[:packetMACLen]
+}
+// signPacket appends the authentication code to a packet body
+func (k *keys) signPacket(
+	body []byte) []byte {
+	return append(body, k.packetMAC(body)...)
+}
+// checkPacket returns the body of a packet if its authentication code is correct
+func (k *keys) checkPacket(
+	packet []byte) (body []byte, ok bool) {
+	if len(packet) <= packetMACLen {
+		return
+	}
+	body = packet[:len(packet)-packetMACLen]
+	return body, hmac.Equal(packet[len(packet)-packetMACLen:], k.packetMAC(body))
+}
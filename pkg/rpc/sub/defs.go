@@ -1,18 +1,39 @@
 package sub
 import (
 	"net"
+	"sync"
 	"time"
+	"golang.org/x/crypto/ed25519"
 )
 var (
 	uNet = "udp4"
 	// Maximum of 9 packets per message, so 16kb round is enough
 	defaultBufferSize = 16384
-	// FEC expands message by 150%, we don't split message chunks over more than one packet
-	maxMessageSize = 3072
-	// default channel buffer sizes for Base
-	baseChanBufs = 128
+	// defaultMaxMessageSize is the largest encrypted message accepted unless configured otherwise, which is split into as many bundles as it needs
+	defaultMaxMessageSize = 1 << 22
 	// latency maximum
 	latencyMax = time.Millisecond * 250
+	// bundleInterval is the pause between sending the bundles of a message
+	bundleInterval = time.Millisecond / 4
+	// replayWindow is how far the timestamp of a message may be from the local clock, and how long message ids are remembered to reject duplicates
+	replayWindow = time.Second * 10
+	// subscriptionTimeout is how long a node keeps a subscription without a message from the worker, which renews it at half of this interval
+	subscriptionTimeout = time.Minute
+	// uuidLen is the length of the message id prefixed to every packet
+	uuidLen = 4
+	// packetHeaderLen is the length of the message id, bundle index, bundle count and redundancy ratio prefixed to every packet
+	packetHeaderLen = uuidLen + 2 + 2 + 1 + 1
+	// headerLen is the length of the message type, timestamp and uuid at the front of every message
+	headerLen = 1 + 8 + 4
+	// sealOverhead is the nonce and tag added by message encryption
+	sealOverhead = 12 + 16
+)
+// Message types
+const (
+	msgData byte = iota
+	msgSubscribe
+	msgConfirm
+	msgUnsubscribe
 )
 // BaseInterface is the core functions required for a Base
 type BaseInterface interface {
@@ -24,44 +45,85 @@ type BaseCfg struct {
 	Listener   string
 	Password   []byte
 	BufferSize int
+	// Key is the private key that messages are signed with. A new key is generated if none is given
+	Key ed25519.PrivateKey
+	// NodeKey is the public key of the node a worker subscribes to. If not given, the key in the node's confirmation is trusted
+	NodeKey ed25519.PublicKey
+	// Required and Total set the redundancy of sent bundles, each is sent as Total packets of which any Required recover it. The default is 3 of 9
+	Required int
+	Total    int
+	// MaxMessageSize is the largest encrypted message that will be sent or reassembled
+	MaxMessageSize int
 }
 // Base is the common structure between a worker and a node
 type Base struct {
-	cfg       BaseCfg
-	listener  *net.UDPConn
-	packets   chan Packet
-	incoming  chan Bundle
-	returning chan Bundle
-	trash     chan Bundle
-	doneRet   chan bool
-	message   chan Message
-	quit      chan bool
+	cfg      BaseCfg
+	keys     *keys
+	listener *net.UDPConn
+	// keyFor returns the public key that a message from a sender must be signed with
+	keyFor func(msgType byte, sender string, payload []byte) ed25519.PublicKey
+	// control handles subscription messages and returns false if the message is for the handler
+	control func(msgType byte, sender *net.UDPAddr, payload []byte) bool
+	mx       sync.Mutex
+	partials map[bundleID]*partial
+	seen     map[bundleID]time.Time
+	// nonces are the ids of the messages received from each signing key within the replay window
+	nonces map[nonceID]time.Time
+	quit    chan struct{}
+	wg      sync.WaitGroup
 }
 // A Node is a server with some number of subscribers
 type Node struct {
 	Base
-	subscribers []*net.UDPAddr
+	subsMtx     sync.Mutex
+	subscribers map[string]*Subscription
 }
 // A Worker is a node that subscribes to a Node's messages
 type Worker struct {
 	Base
-	node *net.UDPAddr
+	node      *net.UDPAddr
+	nodeMtx   sync.Mutex
+	nodeKey   ed25519.PublicKey
+	confirmed chan Confirmation
+	// subscribed is set while the subscription is renewed
+	subscribed bool
 }
 // Packet is the structure of individual encoded packets of the message. These are made from a 9/3 Reed Solomon code and 9 are sent in distinct packets and only 3 are required to guarantee retransmit-free delivery.
 type Packet struct {
 	sender string // address packet was received from
 	bytes  []byte // raw FEC encoded bytes of packet
 }
-// A Bundle is a collection of the received packets received from the same sender with up to 9 pieces.
+// bundleID identifies the packets of one message from one sender
+type bundleID struct {
+	sender string
+	uuid   uint32
+}
+// nonceID identifies a message by the key that signed it and the id in its signed header, whichever address it came from
+type nonceID struct {
+	key  string
+	uuid uint32
+}
+// A Bundle is a collection of the received packets received from the same sender with up to 9 pieces, which decode to one fragment of a message.
 type Bundle struct {
-	uuid     int32
+	uuid     uint32
 	sender   string
 	received time.Time
 	packets  [][]byte
+	data     []byte
+}
+// partial is a message from a sender whose bundles are still arriving
+type partial struct {
+	uuid     uint32
+	sender   string
+	updated  time.Time
+	required int
+	total    int
+	bundles  []*Bundle
+	complete int
 }
-// Message is the data reconstructed from a complete Bundle, containing data in messagepack format
+// Message is the data reconstructed from a complete Bundle, after decryption and signature verification
 type Message struct {
-	uuid      int32
+	uuid      uint32
 	sender    string
 	timestamp time.Time
 	bytes     []byte
@@ -69,10 +131,24 @@ type Message struct {
 // Subscription is the message sent by a worker node to request updates from the node
 type Subscription struct {
 	address string
-	pubKey  []byte
+	addr    *net.UDPAddr
+	pubKey  ed25519.PublicKey
+	seen    time.Time
 }
 // Confirmation is the reply message for a subscription request
 type Confirmation struct {
 	subscriber string // confirming address of subscriber
 	pubKey     []byte // public key of server for message verification
 }
+// Bytes returns the payload of the message
+func (m Message) Bytes() []byte {
+	return m.bytes
+}
+// Sender returns the address the message came from
+func (m Message) Sender() string {
+	return m.sender
+}
+// Timestamp returns the time the sender stamped the message with
+func (m Message) Timestamp() time.Time {
+	return m.timestamp
+}
@@ -2,7 +2,13 @@
 //
 // To prevent retransmits for messages up to 3kb in size, data sent in a burst as 9 packets containing a 9/3 Reed Solomon encoding such that any 3 packets received guarantee retransmit-less delivery, covering the worst case for packet loss and corruption over a network
 //
-// Payload can be encrypted via AES-256 encryption using a pre-shared key known by both ends to function as both access control and security against eavesdropping and spoofing attacks.
+// Larger messages are split into bundles of up to 3kb that are each encoded the same way, and reassembled once every bundle has been recovered. The redundancy ratio can be configured in place of the default 3 of 9, and partial messages that stop receiving packets are discarded after the latency maximum.
 //
-// Authentication of data is done using an ED25119 EC key for which each known endpoint has shared the public key as part of the subscription request
+// Payload is encrypted via AES-256-GCM using a key stretched with Argon2id from a pre-shared password known by both ends to function as both access control and security against eavesdropping and spoofing attacks. Every packet also carries a HMAC made with a second key from the password, so forged or corrupted packets are dropped before they can spoil the decoding of a message.
+//
+// Authentication of data is done using an ED25519 EC key for which each known endpoint has shared the public key as part of the subscription request. A Worker sends its public key in its subscription, and the Node replies with a confirmation carrying the Node's key, which the Worker then requires on every message, unless a NodeKey was configured in which case only that key is accepted.
+//
+// Each message is stamped with the time it was sent and a random id. Messages outside of the replay window, or with an id already received from the same address or signed by the same key, are discarded, so a captured subscription sent again from a spoofed address is not accepted.
+//
+// A Node keeps each subscription by the key that signed it, at the address it was last sent from, and drops subscriptions it has not heard from within the subscription timeout. A Worker renews its subscription at half of that interval until it unsubscribes.
 package sub
@@ -1,2 +1,33 @@
 package sub
 // ED25519 elliptic curve signatures and verification
+import (
+	"crypto/rand"
+	"errors"
+	"golang.org/x/crypto/ed25519"
+)
+var (
+	// ErrBadSignature is returned when a message is not signed by the expected key
+	ErrBadSignature = errors.New("message signature is not valid")
+)
+// newKey generates a new signing key
+func newKey() (ed25519.PrivateKey, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	return priv, err
+}
+// sign appends the signature of the key over the data
+func sign(
+	key ed25519.PrivateKey, data []byte) []byte {
+	return append(data, ed25519.Sign(key, data)...)
+}
+// verify checks the signature at the end of signed data against a public key and returns the data without it
+func verify(
+	pub ed25519.PublicKey, signed []byte) (data []byte, err error) {
+	if len(pub) != ed25519.PublicKeySize || len(signed) < ed25519.SignatureSize {
+		return nil, ErrBadSignature
+	}
+	data = signed[:len(signed)-ed25519.SignatureSize]
+	if !ed25519.Verify(pub, data, signed[len(signed)-ed25519.SignatureSize:]) {
+		return nil, ErrBadSignature
+	}
+	return
+}
@@ -1,53 +1,94 @@
 package sub
-// Reed Solomon 9/3 forward error correction, intended to be sent as 9 pieces where 3 uncorrupted parts allows assembly of the message
+// Reed Solomon forward error correction, by default 9/3, intended to be sent as 9 pieces where 3 uncorrupted parts allows assembly of the message
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"hash/crc32"
 	"log"
+	"sync"
 	"github.com/vivint/infectious"
 )
 var (
 	rsTotal    = 9
 	rsRequired = 3
-	rsFEC      = func() *infectious.FEC {
-		fec, err := infectious.NewFEC(3, 9)
-		if err != nil {
-			panic(err)
-		}
-		return fec
-	}()
+	// maxShardSize is the largest piece of a bundle sent in one packet, which keeps packets under the usual network MTU whatever the redundancy ratio
+	maxShardSize = 1024
+	fecMtx       sync.Mutex
+	fecs         = make(map[[2]int]*infectious.FEC)
+	// ErrTooLarge is returned when data is too large to be encoded
+	ErrTooLarge = errors.New("data is too large to encode")
 )
-// padData appends a 2 byte length prefix, and pads to a multiple of rsTotal. An empty slice will be returned if the total length is greater than maxMessageSize.
+// getFEC returns the codec for a redundancy ratio
+func getFEC(
+	required, total int) (fec *infectious.FEC, err error) {
+	fecMtx.Lock()
+	defer fecMtx.Unlock()
+	if fec, ok := fecs[[2]int{required, total}]; ok {
+		return fec, nil
+	}
+	if required < 1 || total < required || total > 255 {
+		return nil, fmt.Errorf("invalid redundancy %d of %d", required, total)
+	}
+	if fec, err = infectious.NewFEC(required, total); err != nil {
+		return
+	}
+	fecs[[2]int{required, total}] = fec
+	return
+}
+// bundleCapacity is the largest amount of data that fits in one bundle with the given number of required shares
+func bundleCapacity(
+	required int) int {
+	return required*maxShardSize - 2
+}
+// padData appends a 2 byte length prefix, and pads to a multiple of required. An error is returned if the data does not fit in one bundle.
 func padData(
-	data []byte) (out []byte) {
+	data []byte, required int) (out []byte, err error) {
 	dataLen := len(data)
+	if dataLen > bundleCapacity(required) {
+		return nil, ErrTooLarge
+	}
 	prefixBytes := make([]byte, 2)
 	binary.LittleEndian.PutUint16(prefixBytes, uint16(dataLen))
 	data = append(prefixBytes, data...)
 	dataLen = len(data)
-	if dataLen > maxMessageSize {
-		return []byte{}
-	}
-	chunkLen := (dataLen) / rsTotal
-	chunkMod := (dataLen) % rsTotal
+	chunkLen := (dataLen) / required
+	chunkMod := (dataLen) % required
 	if chunkMod != 0 {
 		chunkLen++
 	}
-	padLen := rsTotal*chunkLen - dataLen
+	padLen := required*chunkLen - dataLen
 	out = append(data, make([]byte, padLen)...)
 	return
 }
+// unpadData returns the data inside the length prefix and padding added by padData
+func unpadData(
+	data []byte) (out []byte, err error) {
+	if len(data) < 2 {
+		return nil, errors.New("padded data is too short")
+	}
+	dataLen := int(binary.LittleEndian.Uint16(data))
+	if dataLen+2 > len(data) {
+		return nil, errors.New("padded data length prefix is invalid")
+	}
+	return data[2 : dataLen+2], nil
+}
 func rsEncode(
-	data []byte) (chunks [][]byte) {
+	data []byte, required, total int) (chunks [][]byte, err error) {
+	fec, err := getFEC(required, total)
+	if err != nil {
+		return
+	}
 	// First we must pad the data
-	data = padData(data)
-	shares := make([]infectious.Share, rsTotal)
+	if data, err = padData(data, required); err != nil {
+		return
+	}
+	shares := make([]infectious.Share, total)
 	output := func(s infectious.Share) {
 		shares[s.Number] = s.DeepCopy()
 	}
-	err := rsFEC.Encode(data, output)
-	if err != nil {
-		panic(err)
+	if err = fec.Encode(data, output); err != nil {
+		return
 	}
 	for i := range shares {
 		// Append the chunk number to the front of the chunk
@@ -62,12 +103,16 @@ func rsEncode(
 	return
 }
 func rsDecode(
-	chunks [][]byte) (data []byte, err error) {
+	chunks [][]byte, required, total int) (data []byte, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Print("Recovered in f", r)
 		}
 	}()
+	fec, err := getFEC(required, total)
+	if err != nil {
+		return
+	}
 	var shares []infectious.Share
 	for i := range chunks {
 		bodyLen := len(chunks[i])
@@ -78,6 +123,6 @@ func rsDecode(
 		}
 		shares = append(shares, share)
 	}
-	data, err = rsFEC.Decode(nil, shares)
+	data, err = fec.Decode(nil, shares)
 	return
 }
@@ -7,13 +7,21 @@ import (
 var (
 	testDataAligned   = []byte("123456789123456789123456789123456789123456789123456789123456789123456789123456789")
 	testDataUnaligned = []byte("1234567891234567891234567891234567891234")
-	expectedAligned   = "510031323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383900000000000000"
-	expectedUnaligned = "280031323334353637383931323334353637383931323334353637383931323334353637383931323334000000"
+	expectedAligned   = "510031323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383931323334353637383900"
+	expectedUnaligned = "280031323334353637383931323334353637383931323334353637383931323334353637383931323334"
 )
 func TestPadData(
 	t *testing.T) {
-	actualAligned := hex.EncodeToString(padData(testDataAligned))
-	actualUnaligned := hex.EncodeToString(padData(testDataUnaligned))
+	paddedAligned, err := padData(testDataAligned, rsRequired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paddedUnaligned, err := padData(testDataUnaligned, rsRequired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actualAligned := hex.EncodeToString(paddedAligned)
+	actualUnaligned := hex.EncodeToString(paddedUnaligned)
 	if actualAligned != expectedAligned {
 		t.Fatalf("Padding did not produce expected result:\ngot      '%s'\nexpected '%s'",
 			actualAligned, expectedAligned)
@@ -22,6 +30,9 @@ func TestPadData(
 		t.Fatalf("Padding did not produce expected result:\ngot      '%s'\nexpected '%s'",
 			actualUnaligned, expectedUnaligned)
 	}
+	if _, err = padData(make([]byte, bundleCapacity(rsRequired)+1), rsRequired); err != ErrTooLarge {
+		t.Fatalf("oversized data gave error %v, expected %v", err, ErrTooLarge)
+	}
 }
 func TestFECCodec(
 	t *testing.T) {
@@ -30,16 +41,19 @@ func TestFECCodec(
 			t.Log("Recovered in f", r)
 		}
 	}()
-	chunks := rsEncode(testDataAligned)
+	chunks, err := rsEncode(testDataAligned, rsRequired, rsTotal)
+	if err != nil {
+		panic(err)
+	}
 	// Deface one of the pieces
 	chunks[4][3] = ^chunks[4][3]
 	// Here we only need 3 packets
-	data, err := rsDecode(chunks[4:7])
+	data, err := rsDecode(chunks[4:7], rsRequired, rsTotal)
 	if err != nil {
 		panic(err)
 	}
 	// Requires one more across the punctured chunk to recover. This would not normally happen as the checksums would usually filter out incorrect chunks.
-	data, err = rsDecode(chunks[3:6])
+	data, err = rsDecode(chunks[3:6], rsRequired, rsTotal)
 	if err != nil {
 		panic(err)
 	}
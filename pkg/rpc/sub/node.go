@@ -0,0 +1,126 @@
+package sub
+import (
+	"net"
+	"sort"
+	"time"
+	"golang.org/x/crypto/ed25519"
+)
+// NewNode creates a node that accepts subscriptions from workers with the same password
+func NewNode(
+	cfg BaseCfg) (n *Node, err error) {
+	n = &Node{subscribers: make(map[string]*Subscription)}
+	if err = n.init(cfg); err != nil {
+		return nil, err
+	}
+	n.keyFor = n.subscriberKey
+	n.control = n.handleControl
+	return
+}
+// subscriberKey returns the key a message from a subscriber must be signed with. Subscriptions are signed by the key they carry, which proves the worker holds it, and cancellations by the key of a current subscription they carry.
+func (n *Node) subscriberKey(
+	msgType byte, sender string, payload []byte) ed25519.PublicKey {
+	switch msgType {
+	case msgSubscribe, msgUnsubscribe:
+		if len(payload) != ed25519.PublicKeySize {
+			return nil
+		}
+		if msgType == msgSubscribe {
+			return payload
+		}
+	}
+	n.subsMtx.Lock()
+	defer n.subsMtx.Unlock()
+	n.expire()
+	if msgType == msgUnsubscribe {
+		if s, ok := n.subscribers[string(payload)]; ok {
+			return s.pubKey
+		}
+		return nil
+	}
+	if s := n.subscriberAt(sender); s != nil {
+		return s.pubKey
+	}
+	return nil
+}
+// handleControl records subscriptions by the key that signed them and confirms them with the node's public key. Any message from a subscriber keeps its subscription from expiring.
+func (n *Node) handleControl(
+	msgType byte, sender *net.UDPAddr, payload []byte) bool {
+	switch msgType {
+	case msgSubscribe:
+		n.subsMtx.Lock()
+		// a worker has one subscription, at the address it last subscribed from
+		if s := n.subscriberAt(sender.String()); s != nil {
+			delete(n.subscribers, string(s.pubKey))
+		}
+		n.subscribers[string(payload)] = &Subscription{
+			address: sender.String(),
+			addr:    sender,
+			pubKey:  append(ed25519.PublicKey{}, payload...),
+			seen:    time.Now(),
+		}
+		n.subsMtx.Unlock()
+		confirm := append(append([]byte{}, n.PublicKey()...), sender.String()...)
+		_ = n.send(msgConfirm, confirm, sender)
+		return true
+	case msgUnsubscribe:
+		n.subsMtx.Lock()
+		delete(n.subscribers, string(payload))
+		n.subsMtx.Unlock()
+		return true
+	case msgConfirm:
+		return true
+	case msgData:
+		n.subsMtx.Lock()
+		if s := n.subscriberAt(sender.String()); s != nil {
+			s.seen = time.Now()
+		}
+		n.subsMtx.Unlock()
+	}
+	return false
+}
+// subscriberAt returns the subscription at an address, if there is one. The caller must hold subsMtx.
+func (n *Node) subscriberAt(
+	address string) *Subscription {
+	for _, x := range n.subscribers {
+		if x.address == address {
+			return x
+		}
+	}
+	return nil
+}
+// expire drops the subscriptions that have not been heard from within the subscription timeout. The caller must hold subsMtx.
+func (n *Node) expire() {
+	for i, x := range n.subscribers {
+		if time.Since(x.seen) > subscriptionTimeout {
+			delete(n.subscribers, i)
+		}
+	}
+}
+// Publish sends a message to every subscriber
+func (n *Node) Publish(
+	data []byte) (err error) {
+	n.subsMtx.Lock()
+	n.expire()
+	var addrs []*net.UDPAddr
+	for _, x := range n.subscribers {
+		addrs = append(addrs, x.addr)
+	}
+	n.subsMtx.Unlock()
+	for _, x := range addrs {
+		if e := n.send(msgData, data, x); e != nil && err == nil {
+			err = e
+		}
+	}
+	return
+}
+// Subscribers returns the addresses of the current subscribers
+func (n *Node) Subscribers() (out []string) {
+	n.subsMtx.Lock()
+	defer n.subsMtx.Unlock()
+	n.expire()
+	for _, x := range n.subscribers {
+		out = append(out, x.address)
+	}
+	sort.Strings(out)
+	return
+}
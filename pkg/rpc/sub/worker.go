@@ -0,0 +1,141 @@
+package sub
+import (
+	"bytes"
+	"errors"
+	"net"
+	"time"
+	"golang.org/x/crypto/ed25519"
+)
+var (
+	// ErrNotConfirmed is returned when a subscription is not confirmed in time
+	ErrNotConfirmed = errors.New("subscription was not confirmed")
+)
+// NewWorker creates a worker that will subscribe to the node at the given address
+func NewWorker(
+	cfg BaseCfg, node string) (w *Worker, err error) {
+	addr, err := net.ResolveUDPAddr(uNet, node)
+	if err != nil {
+		return
+	}
+	w = &Worker{
+		node:      addr,
+		nodeKey:   cfg.NodeKey,
+		confirmed: make(chan Confirmation, 1),
+	}
+	if err = w.init(cfg); err != nil {
+		return nil, err
+	}
+	w.keyFor = w.nodeKeyFor
+	w.control = w.handleControl
+	return
+}
+// nodeKeyFor returns the key messages from the node must be signed with. Until the key is known only a confirmation signed by the key it carries is accepted.
+func (w *Worker) nodeKeyFor(
+	msgType byte, sender string, payload []byte) ed25519.PublicKey {
+	if sender != w.node.String() {
+		return nil
+	}
+	w.nodeMtx.Lock()
+	defer w.nodeMtx.Unlock()
+	if w.nodeKey != nil {
+		return w.nodeKey
+	}
+	if msgType == msgConfirm && len(payload) >= ed25519.PublicKeySize {
+		return payload[:ed25519.PublicKeySize]
+	}
+	return nil
+}
+// handleControl processes subscription confirmations from the node
+func (w *Worker) handleControl(
+	msgType byte, sender *net.UDPAddr, payload []byte) bool {
+	if msgType != msgConfirm {
+		return msgType != msgData
+	}
+	if len(payload) < ed25519.PublicKeySize {
+		return true
+	}
+	c := Confirmation{
+		subscriber: string(payload[ed25519.PublicKeySize:]),
+		pubKey:     append([]byte{}, payload[:ed25519.PublicKeySize]...),
+	}
+	w.nodeMtx.Lock()
+	if w.nodeKey == nil {
+		w.nodeKey = c.pubKey
+	}
+	ok := bytes.Equal(w.nodeKey, c.pubKey)
+	w.nodeMtx.Unlock()
+	if ok {
+		select {
+		case w.confirmed <- c:
+		default:
+		}
+	}
+	return true
+}
+// Start opens the listener and starts renewing the subscription once there is one
+func (w *Worker) Start() (err error) {
+	if err = w.Base.Start(); err != nil {
+		return
+	}
+	w.wg.Add(1)
+	go w.renew()
+	return
+}
+// renew subscribes again at half of the subscription timeout while subscribed, so the node keeps sending updates
+func (w *Worker) renew() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(subscriptionTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+		}
+		w.nodeMtx.Lock()
+		subscribed := w.subscribed
+		w.nodeMtx.Unlock()
+		if subscribed {
+			_ = w.send(msgSubscribe, w.PublicKey(), w.node)
+		}
+	}
+}
+// Subscribe requests updates from the node and waits for the confirmation
+func (w *Worker) Subscribe(
+	timeout time.Duration) (err error) {
+	// a confirmation of a renewal is not the confirmation of this request
+	select {
+	case <-w.confirmed:
+	default:
+	}
+	if err = w.send(msgSubscribe, w.PublicKey(), w.node); err != nil {
+		return
+	}
+	select {
+	case <-w.confirmed:
+		w.nodeMtx.Lock()
+		w.subscribed = true
+		w.nodeMtx.Unlock()
+		return
+	case <-time.After(timeout):
+		return ErrNotConfirmed
+	}
+}
+// Unsubscribe asks the node to stop sending updates
+func (w *Worker) Unsubscribe() error {
+	w.nodeMtx.Lock()
+	w.subscribed = false
+	w.nodeMtx.Unlock()
+	return w.send(msgUnsubscribe, w.PublicKey(), w.node)
+}
+// Send a message to the node
+func (w *Worker) Send(
+	data []byte) error {
+	return w.send(msgData, data, w.node)
+}
+// NodeKey returns the public key of the node, once it is known
+func (w *Worker) NodeKey() ed25519.PublicKey {
+	w.nodeMtx.Lock()
+	defer w.nodeMtx.Unlock()
+	return w.nodeKey
+}
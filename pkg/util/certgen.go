@@ -1,15 +1,18 @@
 package util
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/tls"
 	_ "crypto/sha512" // Needed for RegisterHash in init
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"net"
 	"os"
@@ -18,14 +21,80 @@ import (
 // NewTLSCertPair returns a new PEM-encoded x.509 certificate pair based on a 521-bit ECDSA private key.  The machine's local interface addresses and all variants of IPv4 and IPv6 localhost are included as valid IP addresses.
 func NewTLSCertPair(
 	organization string, validUntil time.Time, extraHosts []string) (cert, key []byte, err error) {
-	now := time.Now()
-	if validUntil.Before(now) {
-		return nil, nil, errors.New("validUntil would create an already-expired certificate")
+	template, err := newCertTemplate(organization, validUntil, extraHosts)
+	if err != nil {
+		return nil, nil, err
 	}
-	priv, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	template.KeyUsage |= x509.KeyUsageCertSign
+	template.IsCA = true // so can sign self.
+	return newCertPair(template, nil, nil)
+}
+// NewTLSCA returns a new PEM-encoded x.509 certificate authority certificate and signing key based on a 521-bit ECDSA private key, for signing the certificates made with NewSignedTLSCertPair.
+func NewTLSCA(
+	organization string, validUntil time.Time) (cert, key []byte, err error) {
+	template, err := newCertTemplate(organization, validUntil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
+	template.Subject.CommonName = organization
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign |
+		x509.KeyUsageCRLSign
+	template.IsCA = true
+	template.DNSNames, template.IPAddresses = nil, nil
+	return newCertPair(template, nil, nil)
+}
+// NewSignedTLSCertPair returns a new PEM-encoded x.509 certificate pair like NewTLSCertPair, signed by the PEM-encoded certificate authority caCert and caKey instead of by itself. The certificate is valid for both servers and clients so it can be used for mutual authentication.
+func NewSignedTLSCertPair(
+	organization string, validUntil time.Time, extraHosts []string, caCert, caKey []byte) (cert, key []byte, err error) {
+	ca, err := ParseTLSCA(caCert, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	template, err := newCertTemplate(organization, validUntil, extraHosts)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth,
+		x509.ExtKeyUsageClientAuth}
+	if template.NotAfter.After(ca.Leaf.NotAfter) {
+		template.NotAfter = ca.Leaf.NotAfter
+	}
+	return newCertPair(template, ca.Leaf, ca.PrivateKey)
+}
+// ParseTLSCA parses a PEM-encoded certificate authority certificate and key such as made by NewTLSCA, with the parsed certificate in Leaf
+func ParseTLSCA(
+	caCert, caKey []byte) (ca tls.Certificate, err error) {
+	if ca, err = tls.X509KeyPair(caCert, caKey); err != nil {
+		return
+	}
+	if ca.Leaf, err = x509.ParseCertificate(ca.Certificate[0]); err != nil {
+		return
+	}
+	if !ca.Leaf.IsCA {
+		err = errors.New("certificate is not a certificate authority")
+	}
+	return
+}
+// LoadCertPool returns a pool of the PEM-encoded certificates in a file, such as a certificate authority made by NewTLSCA
+func LoadCertPool(
+	path string) (*x509.CertPool, error) {
+	pemCerts, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+// newCertTemplate returns a certificate template for the machine's local interface addresses, the variants of localhost and extraHosts
+func newCertTemplate(
+	organization string, validUntil time.Time, extraHosts []string) (*x509.Certificate, error) {
+	now := time.Now()
+	if validUntil.Before(now) {
+		return nil, errors.New("validUntil would create an already-expired certificate")
+	}
 	// end of ASN.1 time
 	endOfTime := time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC)
 	if validUntil.After(endOfTime) {
@@ -34,11 +103,11 @@ func NewTLSCertPair(
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate serial number: %s", err)
+		return nil, fmt.Errorf("failed to generate serial number: %s", err)
 	}
 	host, err := os.Hostname()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	ipAddresses := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
 	dnsNames := []string{host}
@@ -63,7 +132,7 @@ func NewTLSCertPair(
 	}
 	addrs, err := interfaceAddrs()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	for _, a := range addrs {
 		ipAddr, _, err := net.ParseCIDR(a.String())
@@ -78,27 +147,36 @@ func NewTLSCertPair(
 		}
 		if ip := net.ParseIP(host); ip != nil {
 			addIP(ip)
-		} else {
+		} else if host != "" {
 			addHost(host)
 		}
 	}
-	template := x509.Certificate{
+	return &x509.Certificate{
 		SerialNumber: serialNumber,
 		Subject: pkix.Name{
 			Organization: []string{organization},
 			CommonName:   host,
 		},
-		NotBefore: now.Add(-time.Hour * 24),
-		NotAfter:  validUntil,
-		KeyUsage: x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature |
-			x509.KeyUsageCertSign,
-		IsCA:                  true, // so can sign self.
+		NotBefore:             now.Add(-time.Hour * 24),
+		NotAfter:              validUntil,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		BasicConstraintsValid: true,
 		DNSNames:              dnsNames,
 		IPAddresses:           ipAddresses,
+	}, nil
+}
+// newCertPair generates a key and returns the PEM-encoded certificate made from template with it, signed by parent and parentKey, or by itself if parent is nil
+func newCertPair(
+	template, parent *x509.Certificate, parentKey crypto.PrivateKey) (cert, key []byte, err error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parent == nil {
+		parent, parentKey = template, priv
 	}
-	derBytes, err := x509.CreateCertificate(rand.Reader, &template,
-		&template, &priv.PublicKey, priv)
+	derBytes, err := x509.CreateCertificate(rand.Reader, template,
+		parent, &priv.PublicKey, parentKey)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create certificate: %v", err)
 	}
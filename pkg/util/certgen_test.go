@@ -1,5 +1,6 @@
 package util_test
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"net"
@@ -102,3 +103,66 @@ func TestNewTLSCertPair(
 		t.Fatal("generated cert does not have valid basic constraints")
 	}
 }
+// TestNewSignedTLSCertPair ensures certificates issued by a certificate authority made with NewTLSCA verify against it for both servers and clients.
+func TestNewSignedTLSCertPair(
+	t *testing.T) {
+	validUntil := time.Unix(time.Now().Add(10*365*24*time.Hour).Unix(), 0)
+	caCert, caKey, err := util.NewTLSCA("test autogenerated ca", validUntil)
+	if err != nil {
+		t.Fatalf("failed to make certificate authority: %v", err)
+	}
+	extraHosts := []string{"testtlscert.bogus:11048", "10.1.2.3", ":11046"}
+	cert, key, err := util.NewSignedTLSCertPair("test autogenerated cert",
+		validUntil.Add(time.Hour), extraHosts, caCert, caKey)
+	if err != nil {
+		t.Fatalf("failed with unexpected error: %v", err)
+	}
+	if _, err = tls.X509KeyPair(cert, key); err != nil {
+		t.Fatalf("certificate does not match key: %v", err)
+	}
+	pemCert, _ := pem.Decode(cert)
+	if pemCert == nil {
+		t.Fatalf("pem.Decode was unable to decode the certificate")
+	}
+	x509Cert, err := x509.ParseCertificate(pemCert.Bytes)
+	if err != nil {
+		t.Fatalf("failed with unexpected error: %v", err)
+	}
+	if x509Cert.IsCA {
+		t.Fatal("issued cert is a certificate authority")
+	}
+	// A certificate can't outlive the authority that issued it.
+	if !x509Cert.NotAfter.Equal(validUntil) {
+		t.Fatalf("issued cert valid until %v, want %v", x509Cert.NotAfter, validUntil)
+	}
+	for _, host := range []string{"testtlscert.bogus", "10.1.2.3", "localhost"} {
+		if err := x509Cert.VerifyHostname(host); err != nil {
+			t.Errorf("failed to verify extra host '%s'", host)
+		}
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caCert)
+	for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth,
+		x509.ExtKeyUsageClientAuth} {
+		_, err = x509Cert.Verify(x509.VerifyOptions{
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{usage},
+		})
+		if err != nil {
+			t.Errorf("issued cert does not verify for usage %v: %v", usage, err)
+		}
+	}
+	// A self signed certificate can't issue others.
+	_, selfKey, err := util.NewTLSCertPair("test", validUntil, nil)
+	if err != nil {
+		t.Fatalf("failed with unexpected error: %v", err)
+	}
+	_, _, err = util.NewSignedTLSCertPair("test", validUntil, nil, cert, key)
+	if err == nil {
+		t.Error("a certificate that is not an authority issued a certificate")
+	}
+	_, _, err = util.NewSignedTLSCertPair("test", validUntil, nil, caCert, selfKey)
+	if err == nil {
+		t.Error("an authority with the wrong key issued a certificate")
+	}
+}
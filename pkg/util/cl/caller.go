@@ -3,8 +3,17 @@ import (
 	"fmt"
 	"runtime"
 )
+// Location is a place in the source code, the JSON log lines have the first one among the items of a log value as their caller
+type Location struct {
+	File string
+	Line int
+}
+// Error returns the location as [file:line]
+func (l Location) Error() string {
+	return fmt.Sprintf("[%s:%d]", l.File, l.Line)
+}
 // Ine (cl.Ine) returns caller location in source code
 var Ine = func() error {
 	_, file, line, _ := runtime.Caller(1)
-	return fmt.Errorf("[%s:%d]", file, line)
+	return Location{File: file, Line: line}
 }
@@ -0,0 +1,272 @@
+package cl
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"github.com/mitchellh/colorstring"
+)
+// The formats of the log lines
+const (
+	// Text is the log line format for reading, the time, level tag, subsystem and message
+	Text = "text"
+	// JSON is the log line format for log pipelines, a JSON object on each line
+	JSON = "json"
+)
+// Entry is a log value sent by a subsystem with the time it was logged at
+type Entry struct {
+	Time      time.Time
+	Subsystem string
+	Value     interface{}
+}
+// jsonEntry is the JSON object of a log line
+type jsonEntry struct {
+	Time      string `json:"time"`
+	Level     string `json:"level"`
+	Subsystem string `json:"subsystem,omitempty"`
+	Message   string `json:"msg"`
+	Caller    string `json:"caller,omitempty"`
+}
+// fileSink is a log file with the subsystems written to it, all of them if there are none
+type fileSink struct {
+	file       *RotatingFile
+	subsystems map[string]bool
+}
+// output is the format of the log lines and the log files they are written to besides Writer
+var output struct {
+	sync.Mutex
+	format string
+	files  []fileSink
+}
+// SetFormat sets the format of the log lines written to Writer and the log files to Text or JSON
+func SetFormat(format string) error {
+	switch format {
+	case Text, JSON:
+	default:
+		return fmt.Errorf("unknown log format '%s', it is %s or %s", format, Text, JSON)
+	}
+	output.Lock()
+	output.format = format
+	output.Unlock()
+	return nil
+}
+// AddFile writes the log lines of the subsystems, or of all of them if none are given, to a file at path as well, rotating it when it grows past maxSize bytes and deleting the rotated files older than maxAge. A limit of 0 is no limit.
+func AddFile(
+	path string, maxSize int64, maxAge time.Duration, subsystems ...string) error {
+	f, err := NewRotatingFile(path, maxSize, maxAge)
+	if err != nil {
+		return err
+	}
+	sink := fileSink{file: f}
+	if len(subsystems) > 0 {
+		sink.subsystems = make(map[string]bool)
+		for _, x := range subsystems {
+			sink.subsystems[x] = true
+		}
+	}
+	output.Lock()
+	output.files = append(output.files, sink)
+	output.Unlock()
+	return nil
+}
+// CloseFiles closes the log files, the log lines are only written to Writer after
+func CloseFiles() {
+	output.Lock()
+	defer output.Unlock()
+	for _, x := range output.files {
+		x.file.Close()
+	}
+	output.files = nil
+}
+// write writes a log entry to Writer and the log files of its subsystem
+func write(
+	e Entry) {
+	output.Lock()
+	defer output.Unlock()
+	isJSON := output.format == JSON
+	msg, caller := message(e.Value, isJSON)
+	var line, plain string
+	if isJSON {
+		line = jsonLine(e, msg, caller)
+		plain = line
+	} else {
+		line = textLine(e, msg, Color)
+	}
+	fmt.Fprint(Writer, line)
+	for _, x := range output.files {
+		if x.subsystems != nil && !x.subsystems[e.Subsystem] {
+			continue
+		}
+		// the log files are never coloured
+		if plain == "" {
+			plain = textLine(e, msg, false)
+		}
+		if _, err := x.file.Write([]byte(plain)); err != nil {
+			fmt.Fprintln(os.Stderr, "error writing log file:", err)
+		}
+	}
+}
+// levelOf returns the level of a log value, _off if it is not one
+func levelOf(
+	i interface{}) int {
+	switch i.(type) {
+	case Ftl, Fatal, Fatalf, Fatalc:
+		return _fatal
+	case Err, Error, Errorf, Errorc:
+		return _error
+	case Wrn, Warn, Warnf, Warnc:
+		return _warn
+	case Inf, Info, Infof, Infoc:
+		return _info
+	case Dbg, Debug, Debugf, Debugc:
+		return _debug
+	case Trc, Trace, Tracef, Tracec:
+		return _trace
+	}
+	return _off
+}
+// message returns the text of a log value and the location of its caller if one of its items is a Location, which is left out of the text if dropCaller is set
+func message(
+	i interface{}, dropCaller bool) (msg, caller string) {
+	switch ii := i.(type) {
+	case Fatalc:
+		return ii(), ""
+	case Errorc:
+		return ii(), ""
+	case Warnc:
+		return ii(), ""
+	case Infoc:
+		return ii(), ""
+	case Debugc:
+		return ii(), ""
+	case Tracec:
+		return ii(), ""
+	case Ftl:
+		return string(ii), ""
+	case Err:
+		return string(ii), ""
+	case Wrn:
+		return string(ii), ""
+	case Inf:
+		return string(ii), ""
+	case Dbg:
+		return string(ii), ""
+	case Trc:
+		return string(ii), ""
+	case Fatal:
+		return values(Value(ii), dropCaller)
+	case Error:
+		return values(Value(ii), dropCaller)
+	case Warn:
+		return values(Value(ii), dropCaller)
+	case Info:
+		return values(Value(ii), dropCaller)
+	case Debug:
+		return values(Value(ii), dropCaller)
+	case Trace:
+		return values(Value(ii), dropCaller)
+	case Fatalf:
+		return format(Value(ii))
+	case Errorf:
+		return format(Value(ii))
+	case Warnf:
+		return format(Value(ii))
+	case Infof:
+		return format(Value(ii))
+	case Debugf:
+		return format(Value(ii))
+	case Tracef:
+		return format(Value(ii))
+	}
+	return "", ""
+}
+// values returns the items of a log value separated by spaces
+func values(
+	v Value, dropCaller bool) (msg, caller string) {
+	items := make([]interface{}, 0, len(v))
+	for _, x := range v {
+		if l, ok := x.(Location); ok && caller == "" {
+			caller = fmt.Sprintf("%s:%d", l.File, l.Line)
+			if dropCaller {
+				continue
+			}
+		}
+		items = append(items, x)
+	}
+	return strings.TrimSuffix(fmt.Sprintln(items...), "\n"), caller
+}
+// format returns the items of a log value after the first formatted by the first
+func format(
+	v Value) (msg, caller string) {
+	if len(v) == 0 {
+		return "", ""
+	}
+	f, ok := v[0].(string)
+	if !ok {
+		return "", ""
+	}
+	for _, x := range v[1:] {
+		if l, ok := x.(Location); ok {
+			caller = fmt.Sprintf("%s:%d", l.File, l.Line)
+			break
+		}
+	}
+	return fmt.Sprintf(f, v[1:]...), caller
+}
+// textLine returns the log line of an entry in the Text format
+func textLine(
+	e Entry, msg string, color bool) string {
+	var tag func(bool) string
+	switch levelOf(e.Value) {
+	case _fatal:
+		tag = ftlTag
+	case _error:
+		tag = errTag
+	case _warn:
+		tag = wrnTag
+	case _info:
+		tag = infTag
+	case _debug:
+		tag = dbgTag
+	default:
+		tag = trcTag
+	}
+	t := e.Time.UTC().Format("06-01-02 15:04:05.000")
+	s := ""
+	if color {
+		t = colorstring.Color("[light_gray]" + t + "[dark_gray]")
+		s = colorstring.Color("[reset]")
+	}
+	if e.Subsystem != "" {
+		n := fmt.Sprintf("%-"+fmt.Sprint(maxLen)+"v", e.Subsystem)
+		if color {
+			n = colorstring.Color("[bold]" + n + "[reset]")
+		} else {
+			n += ":"
+		}
+		s += n + " "
+	}
+	return t + tag(color) + s + msg + "\n"
+}
+// jsonLine returns the log line of an entry in the JSON format
+func jsonLine(
+	e Entry, msg, caller string) string {
+	j := jsonEntry{
+		Time:      e.Time.UTC().Format(time.RFC3339Nano),
+		Subsystem: e.Subsystem,
+		Message:   msg,
+		Caller:    caller,
+	}
+	for name, l := range Levels {
+		if l == levelOf(e.Value) {
+			j.Level = name
+		}
+	}
+	b, err := json.Marshal(j)
+	if err != nil {
+		return ""
+	}
+	return string(b) + "\n"
+}
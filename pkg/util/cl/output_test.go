@@ -0,0 +1,74 @@
+package cl
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+// TestJSONLine ensures JSON log lines have the level, subsystem, message and the caller from Ine
+func TestJSONLine(
+	t *testing.T) {
+	e := Entry{
+		Time:      time.Date(2019, 5, 1, 12, 0, 0, 0, time.UTC),
+		Subsystem: "node",
+		Value:     Warn{"peer", 5, "misbehaving", Ine()},
+	}
+	msg, caller := message(e.Value, true)
+	var got jsonEntry
+	if err := json.Unmarshal([]byte(jsonLine(e, msg, caller)), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Time != "2019-05-01T12:00:00Z" || got.Level != "warn" || got.Subsystem != "node" ||
+		got.Message != "peer 5 misbehaving" || !strings.HasSuffix(got.Caller, "output_test.go:17") {
+		t.Errorf("got %+v", got)
+	}
+	if msg, _ = message(Infof{"%d blocks", 3}, true); msg != "3 blocks" {
+		t.Errorf("got message %q", msg)
+	}
+	if msg, _ = message(e.Value, false); !strings.Contains(msg, "output_test.go:17]") {
+		t.Errorf("got text message %q, expected the caller in it", msg)
+	}
+}
+// TestRotatingFile ensures a log file is rotated when it grows past its size limit and rotated files past the age limit are deleted
+func TestRotatingFile(
+	t *testing.T) {
+	dir, err := ioutil.TempDir("", "cl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs", "node.log")
+	old := filepath.Join(dir, "logs", "node-"+time.Now().Add(-48*time.Hour).UTC().Format(rotatedFormat)+".log")
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(old, []byte("old\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRotatingFile(path, 10, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if _, err = os.Stat(old); !os.IsNotExist(err) {
+		t.Errorf("rotated file older than the age limit was not deleted")
+	}
+	for _, x := range []string{"first\n", "second\n"} {
+		if _, err = r.Write([]byte(x)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rotated, _ := filepath.Glob(filepath.Join(dir, "logs", "node-*.log"))
+	if len(rotated) != 1 {
+		t.Fatalf("got rotated files %v, expected one", rotated)
+	}
+	if b, _ := ioutil.ReadFile(rotated[0]); string(b) != "first\n" {
+		t.Errorf("got rotated file %q", b)
+	}
+	if b, _ := ioutil.ReadFile(path); string(b) != "second\n" {
+		t.Errorf("got log file %q", b)
+	}
+}
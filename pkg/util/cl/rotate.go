@@ -0,0 +1,111 @@
+package cl
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+// rotatedFormat is the time a log file was rotated at in the names of the rotated files
+const rotatedFormat = "2006-01-02T15-04-05.000"
+// RotatingFile is a log file that is renamed with the time it was rotated at and started again once it grows past MaxSize bytes, deleting the rotated files older than MaxAge. A limit of 0 is no limit. This type is safe for concurrent access.
+type RotatingFile struct {
+	Path    string
+	MaxSize int64
+	MaxAge  time.Duration
+	mx      sync.Mutex
+	f       *os.File
+	size    int64
+}
+// NewRotatingFile opens the log file at path for appending, creating it and its directory if they don't exist
+func NewRotatingFile(
+	path string, maxSize int64, maxAge time.Duration) (r *RotatingFile, err error) {
+	r = &RotatingFile{Path: path, MaxSize: maxSize, MaxAge: maxAge}
+	if err = r.open(); err != nil {
+		return nil, err
+	}
+	r.prune()
+	return
+}
+// Write appends p to the file, rotating it first if p would take it past MaxSize
+func (r *RotatingFile) Write(p []byte) (n int, err error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.f == nil {
+		return 0, os.ErrClosed
+	}
+	if r.MaxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.MaxSize {
+		if err = r.rotate(); err != nil {
+			return
+		}
+	}
+	n, err = r.f.Write(p)
+	r.size += int64(n)
+	return
+}
+// Close closes the file
+func (r *RotatingFile) Close() (err error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.f == nil {
+		return
+	}
+	err = r.f.Close()
+	r.f = nil
+	return
+}
+// open opens the file at Path for appending
+func (r *RotatingFile) open() (err error) {
+	if err = os.MkdirAll(filepath.Dir(r.Path), 0700); err != nil {
+		return
+	}
+	if r.f, err = os.OpenFile(r.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600); err != nil {
+		return
+	}
+	fi, err := r.f.Stat()
+	if err != nil {
+		r.f.Close()
+		r.f = nil
+		return
+	}
+	r.size = fi.Size()
+	return
+}
+// rotate renames the file with the current time, starts a new one and deletes the rotated files older than MaxAge
+func (r *RotatingFile) rotate() (err error) {
+	if err = r.f.Close(); err != nil {
+		return
+	}
+	prefix, ext := r.parts()
+	if err = os.Rename(r.Path, prefix+"-"+time.Now().UTC().Format(rotatedFormat)+ext); err != nil {
+		return
+	}
+	if err = r.open(); err != nil {
+		return
+	}
+	r.prune()
+	return
+}
+// prune deletes the rotated files older than MaxAge
+func (r *RotatingFile) prune() {
+	if r.MaxAge <= 0 {
+		return
+	}
+	prefix, ext := r.parts()
+	rotated, _ := filepath.Glob(prefix + "-*" + ext)
+	for _, x := range rotated {
+		stamp := strings.TrimSuffix(strings.TrimPrefix(x, prefix+"-"), ext)
+		t, err := time.Parse(rotatedFormat, stamp)
+		if err != nil {
+			continue
+		}
+		if time.Since(t) > r.MaxAge {
+			os.Remove(x)
+		}
+	}
+}
+// parts returns the path of the file without its extension and the extension
+func (r *RotatingFile) parts() (prefix, ext string) {
+	ext = filepath.Ext(r.Path)
+	return strings.TrimSuffix(r.Path, ext), ext
+}
@@ -37,7 +37,7 @@ var ColorChan = make(chan bool)
 var ShuttingDown bool
 // Writer is the place thelogs put out
 var Writer = io.MultiWriter(os.Stdout)
-// Og is the root channel that processes logging messages, so, cl.Og <- Fatalf{"format string %s %d", stringy, inty} sends to the root
+// Og is the root channel that processes logging messages, so, cl.Og <- Fatalf{"format string %s %d", stringy, inty} sends to the root. The subsystems send their messages as an Entry.
 var Og = make(chan interface{}, 1)
 var wg sync.WaitGroup
 // Quit signals the logger to stop. Invoke like this:
@@ -0,0 +1,8 @@
+package supervisor
+import (
+	"git.parallelcoin.io/dev/9/cmd/ll"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// Log is the logger for the supervisor package
+var Log = cl.NewSubSystem("util/supervisor", ll.DEFAULT)
+var log = Log.Ch
@@ -0,0 +1,315 @@
+// Package supervisor runs the services of a process, starting them in order, checking their health while they run, restarting them by their restart policies when they stop and stopping them in the reverse order they were started in.
+package supervisor
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	cl "git.parallelcoin.io/dev/9/pkg/util/cl"
+)
+// Policy is whether a service that has stopped is started again
+type Policy int
+const (
+	// Never leaves a service that has stopped stopped
+	Never Policy = iota
+	// OnFailure starts a service again if it stopped with an error or was stopped for failing its health checks
+	OnFailure
+	// Always starts a service again whenever it stops
+	Always
+)
+// String returns the name of the policy
+func (p Policy) String() string {
+	switch p {
+	case OnFailure:
+		return "on-failure"
+	case Always:
+		return "always"
+	}
+	return "never"
+}
+// The states of a service
+const (
+	// Starting is a service that is running but not ready yet
+	Starting = "starting"
+	// Running is a service that is ready
+	Running = "running"
+	// Restarting is a service that stopped and is waiting to be started again
+	Restarting = "restarting"
+	// Stopped is a service that stopped without an error and is not started again
+	Stopped = "stopped"
+	// Failed is a service that stopped with an error and is not started again
+	Failed = "failed"
+)
+// ErrStopped is returned by Start when the supervisor is stopped before all of the services are started
+var ErrStopped = errors.New("supervisor stopped while starting services")
+// Service is a part of the process run by the supervisor
+type Service struct {
+	Name string
+	// Run runs the service until quit is closed, calling ready once the service is up so the services after it can be started. It returns an error if the service fails.
+	Run func(ready func(), quit <-chan struct{}) error
+	// Health returns an error if the running service is not working. A service without a health check is healthy while it runs.
+	Health func() error
+	// Restart is whether the service is started again when it stops
+	Restart Policy
+	// Critical services stop the supervisor and all the other services when they stop and are not started again
+	Critical bool
+}
+// Status is the state of a service
+type Status struct {
+	Name     string
+	State    string
+	Restart  Policy
+	Restarts int
+	// Since is when the service came into its state
+	Since time.Time
+	// Err is the error the service last stopped with or last failed its health check with
+	Err string
+}
+// service is a service with its state
+type service struct {
+	Service
+	status Status
+	// up is closed the first time the service is ready and exited when the supervisor stops supervising it
+	up, exited chan struct{}
+	started    bool
+	// quit is closed to stop the current run of the service
+	quit     chan struct{}
+	quitOnce *sync.Once
+}
+// Supervisor runs services
+type Supervisor struct {
+	// HealthInterval is how often the health of a running service is checked
+	HealthInterval time.Duration
+	// MaxFailedChecks is how many health checks in a row a service fails before it is restarted
+	MaxFailedChecks int
+	// MinBackoff is how long a service that stopped waits before it is started again, doubling every time it stops again soon after until MaxBackoff
+	MinBackoff, MaxBackoff time.Duration
+	mx       sync.Mutex
+	services []*service
+	quit     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+// New returns a supervisor of the services, started in the order they are given and stopped in the reverse order
+func New(
+	services ...Service) *Supervisor {
+	s := &Supervisor{
+		HealthInterval:  10 * time.Second,
+		MaxFailedChecks: 3,
+		MinBackoff:      time.Second,
+		MaxBackoff:      time.Minute,
+		quit:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+	for _, x := range services {
+		s.services = append(s.services, &service{
+			Service: x,
+			status:  Status{Name: x.Name, State: Stopped, Restart: x.Restart},
+			up:      make(chan struct{}),
+			exited:  make(chan struct{}),
+		})
+	}
+	return s
+}
+// Start starts the services in order, each once the one before it is ready or has stopped without being started again. It returns ErrStopped if the supervisor is stopped before they are all started.
+func (s *Supervisor) Start() error {
+	for _, sv := range s.services {
+		select {
+		case <-s.quit:
+			return ErrStopped
+		default:
+		}
+		s.mx.Lock()
+		sv.started = true
+		s.mx.Unlock()
+		go s.supervise(sv)
+		select {
+		case <-sv.up:
+		case <-sv.exited:
+		case <-s.quit:
+			return ErrStopped
+		}
+	}
+	return nil
+}
+// Stop stops the services in the reverse order they were started in and returns once they have all stopped. The services are not started again.
+func (s *Supervisor) Stop() {
+	s.stopOnce.Do(func() {
+		log <- cl.Info{"stopping services"}
+		close(s.quit)
+		for i := len(s.services) - 1; i >= 0; i-- {
+			sv := s.services[i]
+			s.mx.Lock()
+			started := sv.started
+			if sv.quit != nil {
+				sv.quitOnce.Do(func() { close(sv.quit) })
+			}
+			s.mx.Unlock()
+			if started {
+				<-sv.exited
+			}
+		}
+		close(s.done)
+	})
+}
+// Done returns a channel that is closed when all of the services have stopped
+func (s *Supervisor) Done() <-chan struct{} {
+	return s.done
+}
+// Status returns the state of the services in the order they are started in. This function is safe for concurrent access.
+func (s *Supervisor) Status() (status []Status) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	for _, sv := range s.services {
+		status = append(status, sv.status)
+	}
+	return
+}
+// setState puts a service into a state, keeping the error it last stopped with if err is empty
+func (s *Supervisor) setState(
+	sv *service, state string, err string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	sv.status.State, sv.status.Since = state, time.Now()
+	if err != "" {
+		sv.status.Err = err
+	}
+}
+// supervise runs a service and starts it again by its restart policy until it is not started again or the supervisor is stopped. It must be run as a goroutine.
+func (s *Supervisor) supervise(
+	sv *service) {
+	defer close(sv.exited)
+	backoff := s.MinBackoff
+	var upOnce sync.Once
+	for {
+		quit := make(chan struct{})
+		stop := new(sync.Once)
+		s.mx.Lock()
+		// the supervisor may have been stopped before the service is run again
+		select {
+		case <-s.quit:
+			s.mx.Unlock()
+			s.setState(sv, Stopped, "")
+			return
+		default:
+		}
+		sv.quit, sv.quitOnce = quit, stop
+		s.mx.Unlock()
+		s.setState(sv, Starting, "")
+		log <- cl.Info{"starting", sv.Name}
+		ready := make(chan struct{})
+		var readyOnce sync.Once
+		unhealthy := make(chan error, 1)
+		go s.checkHealth(sv, ready, quit, unhealthy, stop)
+		started := time.Now()
+		err := run(sv, func() {
+			readyOnce.Do(func() {
+				s.setState(sv, Running, "")
+				log <- cl.Info{sv.Name, "is running"}
+				close(ready)
+				upOnce.Do(func() { close(sv.up) })
+			})
+		}, quit)
+		stop.Do(func() { close(quit) })
+		select {
+		case e := <-unhealthy:
+			err = e
+		default:
+		}
+		select {
+		case <-s.quit:
+			if err != nil {
+				log <- cl.Warn{sv.Name, "stopped:", err}
+				s.setState(sv, Failed, err.Error())
+			} else {
+				log <- cl.Info{sv.Name, "stopped"}
+				s.setState(sv, Stopped, "")
+			}
+			return
+		default:
+		}
+		if sv.Restart == Never || (sv.Restart == OnFailure && err == nil) {
+			if err != nil {
+				log <- cl.Error{sv.Name, "failed:", err}
+				s.setState(sv, Failed, err.Error())
+			} else {
+				log <- cl.Info{sv.Name, "stopped"}
+				s.setState(sv, Stopped, "")
+			}
+			if sv.Critical {
+				log <- cl.Warn{sv.Name, "is critical, stopping the other services"}
+				go s.Stop()
+			}
+			return
+		}
+		// a service that ran for a while before it stopped is started again quickly
+		if time.Since(started) > s.MaxBackoff {
+			backoff = s.MinBackoff
+		}
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		log <- cl.Warn{sv.Name, "stopped:", err, "- starting it again in", backoff}
+		s.setState(sv, Restarting, msg)
+		s.mx.Lock()
+		sv.status.Restarts++
+		s.mx.Unlock()
+		select {
+		case <-s.quit:
+			s.setState(sv, Stopped, "")
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > s.MaxBackoff {
+			backoff = s.MaxBackoff
+		}
+	}
+}
+// run runs a service once, returning a panic of the service as an error
+func run(
+	sv *service, ready func(), quit <-chan struct{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return sv.Run(ready, quit)
+}
+// checkHealth checks the health of a service once it is ready until quit is closed, stopping it with stop after it fails MaxFailedChecks checks in a row and sending the error of the last check to unhealthy
+func (s *Supervisor) checkHealth(
+	sv *service, ready, quit chan struct{}, unhealthy chan<- error, stop *sync.Once) {
+	if sv.Health == nil {
+		return
+	}
+	select {
+	case <-ready:
+	case <-quit:
+		return
+	}
+	ticker := time.NewTicker(s.HealthInterval)
+	defer ticker.Stop()
+	failed := 0
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+		}
+		err := sv.Health()
+		if err == nil {
+			failed = 0
+			continue
+		}
+		failed++
+		log <- cl.Warn{sv.Name, "failed health check", failed, "of", s.MaxFailedChecks, ":", err}
+		s.mx.Lock()
+		sv.status.Err = "health check: " + err.Error()
+		s.mx.Unlock()
+		if failed >= s.MaxFailedChecks {
+			unhealthy <- fmt.Errorf("health check: %v", err)
+			stop.Do(func() { close(quit) })
+			return
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package supervisor
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+// recorder records the order services are started and stopped in
+type recorder struct {
+	mx     sync.Mutex
+	events []string
+}
+func (r *recorder) add(
+	event string) {
+	r.mx.Lock()
+	r.events = append(r.events, event)
+	r.mx.Unlock()
+}
+func (r *recorder) get() []string {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	return append([]string(nil), r.events...)
+}
+// service returns a service that records its starts and stops, failing its first fails runs with an error and its first panics runs after those with a panic
+func (r *recorder) service(
+	name string, fails, panics int) Service {
+	runs := 0
+	return Service{
+		Name:    name,
+		Restart: OnFailure,
+		Run: func(ready func(), quit <-chan struct{}) error {
+			runs++
+			r.add("start " + name)
+			if runs <= fails {
+				return errors.New("failed")
+			}
+			if runs <= fails+panics {
+				panic("panicked")
+			}
+			ready()
+			<-quit
+			r.add("stop " + name)
+			return nil
+		},
+	}
+}
+// fast sets the timings of a supervisor for a test
+func fast(
+	s *Supervisor) *Supervisor {
+	s.HealthInterval, s.MinBackoff, s.MaxBackoff = time.Millisecond, time.Millisecond, 4*time.Millisecond
+	return s
+}
+// waitFor waits for the state of the service at index i to become state
+func waitFor(
+	t *testing.T, s *Supervisor, i int, state string) Status {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if st := s.Status()[i]; st.State == state {
+			return st
+		}
+	}
+	t.Fatalf("%s did not become %s, it is %s", s.Status()[i].Name, state, s.Status()[i].State)
+	return Status{}
+}
+// TestOrder ensures services are started in order once the one before is ready, restarted after failing or panicking, and stopped in reverse order
+func TestOrder(
+	t *testing.T) {
+	r := &recorder{}
+	s := fast(New(r.service("node", 0, 0), r.service("wallet", 2, 1), r.service("miner", 0, 0)))
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	s.Stop()
+	<-s.Done()
+	want := []string{"start node", "start wallet", "start wallet", "start wallet", "start wallet", "start miner",
+		"stop miner", "stop wallet", "stop node"}
+	if got := r.get(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+	st := s.Status()[1]
+	if st.State != Stopped || st.Restarts != 3 || st.Err != "panic: panicked" {
+		t.Errorf("got wallet status %+v, expected stopped after 3 restarts with the panic", st)
+	}
+}
+// TestHealth ensures a service failing its health checks is restarted
+func TestHealth(
+	t *testing.T) {
+	r := &recorder{}
+	sv := r.service("wallet", 0, 0)
+	var mx sync.Mutex
+	healthy := false
+	sv.Health = func() error {
+		mx.Lock()
+		defer mx.Unlock()
+		if !healthy {
+			healthy = true
+			return errors.New("not synced")
+		}
+		return nil
+	}
+	s := fast(New(sv))
+	s.MaxFailedChecks = 1
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	for waitFor(t, s, 0, Running).Restarts == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	s.Stop()
+	if st := s.Status()[0]; st.Restarts != 1 || st.Err != "health check: not synced" {
+		t.Errorf("got status %+v, expected one restart for the failed health check", st)
+	}
+}
+// TestCritical ensures a critical service that fails and is not restarted stops the others
+func TestCritical(
+	t *testing.T) {
+	r := &recorder{}
+	quit := make(chan struct{})
+	node := Service{
+		Name:     "node",
+		Critical: true,
+		Run: func(ready func(), _ <-chan struct{}) error {
+			ready()
+			<-quit
+			return errors.New("database corrupted")
+		},
+	}
+	s := fast(New(node, r.service("wallet", 0, 0)))
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	close(quit)
+	select {
+	case <-s.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("the supervisor did not stop after its critical service failed")
+	}
+	status := s.Status()
+	if status[0].State != Failed || status[0].Restarts != 0 || status[1].State != Stopped {
+		t.Errorf("got %+v, expected the node failed and the wallet stopped", status)
+	}
+	if got := r.get(); !reflect.DeepEqual(got, []string{"start wallet", "stop wallet"}) {
+		t.Errorf("got %q", got)
+	}
+}
@@ -32,12 +32,13 @@ type RPCClient struct {
 }
 // NewRPCClient creates a client connection to the server described by the
 // connect string.  If disableTLS is false, the remote RPC certificate must be
-// provided in the certs slice.  The connection is not established immediately,
+// provided in the certs slice, and clientCert and clientKey, which may be nil,
+// are presented to servers that require clients to have a certificate.  The connection is not established immediately,
 // but must be done using the Start method.  If the remote server does not
 // operate on the same bitcoin network as described by the passed chain
 // parameters, the connection will be disconnected.
 func NewRPCClient(
-	chainParams *chaincfg.Params, connect, user, pass string, certs []byte,
+	chainParams *chaincfg.Params, connect, user, pass string, certs, clientCert, clientKey []byte,
 	disableTLS bool, reconnectAttempts int) (*RPCClient, error) {
 	if reconnectAttempts < 0 {
 		return nil, errors.New("reconnectAttempts must be positive")
@@ -49,6 +50,8 @@ func NewRPCClient(
 			User:                 user,
 			Pass:                 pass,
 			Certificates:         certs,
+			ClientCert:           clientCert,
+			ClientKey:            clientKey,
 			DisableAutoReconnect: false,
 			DisableConnectOnNew:  true,
 			TLS:                  disableTLS,
@@ -2581,6 +2581,18 @@ func (w *Wallet) newAddress(addrmgrNs walletdb.ReadWriteBucket, account uint32,
 	}
 	return addrs[0].Address(), props, nil
 }
+// NextExternalAddress returns the next external chained address for a wallet without notifying a consensus RPC server, so it can be used before the wallet is synchronized, such as to set the mining address of a new wallet.
+func (w *Wallet) NextExternalAddress(account uint32,
+	scope waddrmgr.KeyScope) (util.Address, error) {
+	var addr util.Address
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		addr, _, err = w.newAddress(addrmgrNs, account, scope)
+		return err
+	})
+	return addr, err
+}
 // NewChangeAddress returns a new change address for a wallet.
 func (w *Wallet) NewChangeAddress(account uint32,
 	scope waddrmgr.KeyScope) (util.Address, error) {